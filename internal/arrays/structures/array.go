@@ -1,7 +1,13 @@
 package structures
 
+import "errors"
+
 const ErrorIndexOutOfRange = "index is out of the range of possible values"
 
+// ErrIndexOutOfRange is returned when accessing a position outside an
+// array.
+var ErrIndexOutOfRange = errors.New(ErrorIndexOutOfRange)
+
 // Array defines the interface for a fixed-size indexed collection.
 // Elements are accessed and updated by zero-based index in O(1) time.
 //
@@ -17,14 +23,14 @@ const ErrorIndexOutOfRange = "index is out of the range of possible values"
 type Array[T any] interface {
 	// GetAt returns the element at the specified index.
 	// Valid indices are 0 to Size()-1.
-	// Returns ErrorIndexOutOfRange if index is invalid.
+	// Returns ErrIndexOutOfRange if index is invalid.
 	// Time complexity: O(1)
 	GetAt(index int) (T, error)
 
 	// UpdateAt updates a value at the specified index.
 	// Valid indices are 0 to Size()-1.
 	// Returns the old value at the specified index.
-	// Returns ErrorIndexOutOfRange if index is invalid.
+	// Returns ErrIndexOutOfRange if index is invalid.
 	// Time complexity: O(1)
 	UpdateAt(index int, value T) (T, error)
 
@@ -0,0 +1,97 @@
+package structures
+
+import (
+	"slices"
+	"sort"
+)
+
+// Compile-time interface verifications
+var _ sort.Interface = &ArraySorter[int]{}
+
+// ArraySorter adapts an Array to sort.Interface, so it can be passed to
+// sort.Sort, sort.Stable and the other functions of package sort.
+//
+// Len, Less and Swap go through GetAt and UpdateAt, so sorting takes
+// O(n log n) calls of those. SortArray is usually faster, as it sorts a
+// copy of the elements with the slices package instead.
+//
+// Example:
+//
+//	arr := NewStandardArray(3, 1, 2)
+//	sort.Sort(NewArraySorter(arr, cmp.Less[int]))  // Array is now [1, 2, 3]
+type ArraySorter[T any] struct {
+	array Array[T]
+	less  func(a, b T) bool
+}
+
+// NewArraySorter creates an adapter that orders the elements of the
+// array by less, which must be a strict weak ordering such as cmp.Less.
+func NewArraySorter[T any](array Array[T], less func(a, b T) bool) *ArraySorter[T] {
+	return &ArraySorter[T]{array: array, less: less}
+}
+
+// Len returns the number of elements in the array.
+func (s *ArraySorter[T]) Len() int {
+	return s.array.Size()
+}
+
+// Less reports whether the element at index i orders before the element
+// at index j.
+func (s *ArraySorter[T]) Less(i, j int) bool {
+	a, _ := s.array.GetAt(i)
+	b, _ := s.array.GetAt(j)
+	return s.less(a, b)
+}
+
+// Swap exchanges the elements at indices i and j.
+func (s *ArraySorter[T]) Swap(i, j int) {
+	a, _ := s.array.GetAt(i)
+	b, _ := s.array.UpdateAt(j, a)
+	s.array.UpdateAt(i, b)
+}
+
+// SortArray sorts the array in ascending order according to cmp, as
+// slices.SortFunc does. The sort is not stable.
+//
+// The elements are copied out, sorted with slices.SortFunc and written
+// back, which needs O(n) extra space but only n calls of GetAt and
+// UpdateAt each.
+//
+// Time complexity: O(n log n)
+//
+// Example:
+//
+//	arr := NewStandardArray("b", "c", "a")
+//	SortArray(arr, strings.Compare)  // Array is now ["a", "b", "c"]
+func SortArray[T any](array Array[T], cmp func(a, b T) int) {
+	values := arrayValues(array)
+	slices.SortFunc(values, cmp)
+	setArrayValues(array, values)
+}
+
+// SortArrayStable sorts the array like SortArray, keeping the original
+// order of equal elements, as slices.SortStableFunc does.
+//
+// Time complexity: O(n log n)
+func SortArrayStable[T any](array Array[T], cmp func(a, b T) int) {
+	values := arrayValues(array)
+	slices.SortStableFunc(values, cmp)
+	setArrayValues(array, values)
+}
+
+// Returns a copy of the elements of the array, in index order.
+func arrayValues[T any](array Array[T]) []T {
+	values := make([]T, array.Size())
+	for i := range values {
+		values[i], _ = array.GetAt(i)
+	}
+
+	return values
+}
+
+// Writes the values into the array, in index order.
+func setArrayValues[T any](array Array[T], values []T) {
+	for i, v := range values {
+		array.UpdateAt(i, v)
+	}
+}
@@ -0,0 +1,61 @@
+package structures
+
+/*
+Test Coverage
+=============
+ArraySorter:
+  ✓ sort.Sort and sort.Stable through Len, Less and Swap
+  ✓ Empty array
+
+SortArray/SortArrayStable:
+  ✓ Ascending order, empty array
+  ✓ Stable sort keeps the order of equal elements
+*/
+
+import (
+	"cmp"
+	"sort"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies sort.Sort and sort.Stable order an array through the adapter
+func TestArraySorter(t *testing.T) {
+	arr := NewStandardArray(5, 3, 8, 1, 9, 2)
+	s := NewArraySorter[int](arr, cmp.Less[int])
+	test.GotWant(t, s.Len(), 6)
+	test.GotWant(t, s.Less(1, 0), true)
+
+	sort.Sort(s)
+	test.GotWantSlice(t, arr.data, []int{1, 2, 3, 5, 8, 9})
+
+	pairs := NewStandardArray([2]int{2, 0}, [2]int{1, 1}, [2]int{2, 2}, [2]int{1, 3})
+	sort.Stable(NewArraySorter[[2]int](pairs, func(a, b [2]int) bool { return a[0] < b[0] }))
+	test.GotWantSlice(t, pairs.data, [][2]int{{1, 1}, {1, 3}, {2, 0}, {2, 2}})
+}
+
+// Verifies sorting an empty array through the adapter
+func TestArraySorter_Empty(t *testing.T) {
+	arr := NewStandardArray[int]()
+	sort.Sort(NewArraySorter[int](arr, cmp.Less[int]))
+	test.GotWant(t, arr.IsEmpty(), true)
+}
+
+// Verifies SortArray sorts in ascending order, including an empty array
+func TestSortArray(t *testing.T) {
+	arr := NewStandardArray("d", "b", "a", "c", "b")
+	SortArray[string](arr, cmp.Compare[string])
+	test.GotWantSlice(t, arr.data, []string{"a", "b", "b", "c", "d"})
+
+	empty := NewStandardArray[string]()
+	SortArray[string](empty, cmp.Compare[string])
+	test.GotWant(t, empty.Size(), 0)
+}
+
+// Verifies SortArrayStable keeps the order of equal elements
+func TestSortArrayStable(t *testing.T) {
+	arr := NewStandardArray([2]int{2, 0}, [2]int{1, 1}, [2]int{2, 2}, [2]int{1, 3}, [2]int{0, 4})
+	SortArrayStable[[2]int](arr, func(a, b [2]int) int { return cmp.Compare(a[0], b[0]) })
+	test.GotWantSlice(t, arr.data, [][2]int{{0, 4}, {1, 1}, {1, 3}, {2, 0}, {2, 2}})
+}
@@ -1,9 +1,17 @@
 package structures
 
-import "errors"
+import (
+	"iter"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+)
 
 // Compile-time interface verifications
 var _ Array[int] = &StandardArray[int]{}
+var _ collections.Iterable[int] = &StandardArray[int]{}
 
 // StandardArray implements a fixed-size array using a slice.
 //
@@ -35,13 +43,13 @@ func NewStandardArray[T any](values ...T) *StandardArray[T] {
 
 // GetAt returns the element at the specified index.
 // Valid indices are 0 to Size()-1.
-// Returns ErrorIndexOutOfRange if index is invalid.
+// Returns ErrIndexOutOfRange if index is invalid.
 //
 // Time complexity: O(1)
 func (a *StandardArray[T]) GetAt(index int) (T, error) {
 	if index < 0 || index >= len(a.data) {
 		var zero T
-		return zero, errors.New(ErrorIndexOutOfRange)
+		return zero, ErrIndexOutOfRange
 	}
 
 	return a.data[index], nil
@@ -49,13 +57,13 @@ func (a *StandardArray[T]) GetAt(index int) (T, error) {
 
 // UpdateAt updates the value at the specified index and returns the old value.
 // Valid indices are 0 to Size()-1.
-// Returns ErrorIndexOutOfRange if index is invalid.
+// Returns ErrIndexOutOfRange if index is invalid.
 //
 // Time complexity: O(1)
 func (a *StandardArray[T]) UpdateAt(index int, value T) (T, error) {
 	if index < 0 || index >= len(a.data) {
 		var zero T
-		return zero, errors.New(ErrorIndexOutOfRange)
+		return zero, ErrIndexOutOfRange
 	}
 
 	old := a.data[index]
@@ -63,6 +71,71 @@ func (a *StandardArray[T]) UpdateAt(index int, value T) (T, error) {
 	return old, nil
 }
 
+// Reverse reverses the order of the elements in place.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	arr := NewStandardArray(1, 2, 3)
+//	arr.Reverse()  // Array is now [3, 2, 1]
+func (a *StandardArray[T]) Reverse() {
+	algorithms.Reverse(a.data)
+}
+
+// Shuffle reorders the elements into a uniformly random permutation.
+// A seeded source makes the order reproducible.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	arr := NewStandardArray(1, 2, 3, 4)
+//	arr.Shuffle(rand.NewPCG(1, 2))  // Same order on every run
+func (a *StandardArray[T]) Shuffle(src rand.Source) {
+	algorithms.Shuffle(a.data, src)
+}
+
+// Page returns page index of the elements, split into pages of size
+// elements, along with the total count. The items are a copy, so changes
+// to the array do not affect the page.
+//
+// Panics if index is negative or size is not positive.
+//
+// Time complexity: O(size)
+//
+// Example:
+//
+//	arr := NewStandardArray(1, 2, 3, 4, 5)
+//	p := arr.Page(1, 2)  // p.Items is [3, 4], p.Total is 5
+func (a *StandardArray[T]) Page(index int, size int) collections.Page[T] {
+	start, end := collections.PageBounds(index, size, len(a.data))
+	items := slices.Clone(a.data[start:end:end])
+	return collections.Page[T]{Items: items, Index: index, Size: size, Total: len(a.data)}
+}
+
+// All returns an iterator over the elements in index order. Updates made
+// during iteration are seen by the elements not yet yielded.
+//
+// Time complexity: O(n) for a full iteration
+func (a *StandardArray[T]) All() iter.Seq[T] {
+	return slices.Values(a.data)
+}
+
+// Backward returns an iterator over the elements from the last index to
+// the first.
+//
+// Time complexity: O(n) for a full iteration
+func (a *StandardArray[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(a.data) - 1; i >= 0; i-- {
+			if !yield(a.data[i]) {
+				return
+			}
+		}
+	}
+}
+
 // IsEmpty returns true if the array contains no elements.
 //
 // Time complexity: O(1)
@@ -25,12 +25,26 @@ UpdateAt:
   ✓ Update in middle
   ✓ Order preservation after update
 
+Reverse:
+  ✓ Even and odd sizes
+
+Shuffle:
+  ✓ Elements are kept, seeded order is reproducible
+
 IsEmpty/Size:
   ✓ On empty list
   ✓ On non-empty list
+
+Page:
+  ✓ Items copied, total count, last and past-the-end pages
+
+All/Backward:
+  ✓ Both directions, early termination, empty array
 */
 
 import (
+	"math/rand/v2"
+	"slices"
 	"testing"
 
 	"github.com/apotourlyan/godatastructures/internal/utilities/test"
@@ -70,7 +84,7 @@ func TestStandardArray_NewStandardArray_Order(t *testing.T) {
 func TestStandardArray_GetAt_NegativeIndex(t *testing.T) {
 	a := NewStandardArray[int]()
 	v, err := a.GetAt(-1)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, v, 0)
 }
 
@@ -78,7 +92,7 @@ func TestStandardArray_GetAt_NegativeIndex(t *testing.T) {
 func TestStandardArray_GetAt_InvalidIndex(t *testing.T) {
 	a := NewStandardArray(1, 2, 3)
 	v, err := a.GetAt(3)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, v, 0)
 }
 
@@ -86,7 +100,7 @@ func TestStandardArray_GetAt_InvalidIndex(t *testing.T) {
 func TestStandardArray_GetAt_Start(t *testing.T) {
 	a := NewStandardArray(1, 2, 3)
 	v, err := a.GetAt(0)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, v, 1)
 }
 
@@ -94,7 +108,7 @@ func TestStandardArray_GetAt_Start(t *testing.T) {
 func TestStandardArray_GetAt_End(t *testing.T) {
 	a := NewStandardArray(1, 2, 3)
 	v, err := a.GetAt(2)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, v, 3)
 }
 
@@ -102,7 +116,7 @@ func TestStandardArray_GetAt_End(t *testing.T) {
 func TestStandardArray_GetAt_Middle(t *testing.T) {
 	a := NewStandardArray(1, 2, 3)
 	v, err := a.GetAt(1)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, v, 2)
 }
 
@@ -112,7 +126,7 @@ func TestStandardArray_GetAt_Order(t *testing.T) {
 
 	for i := range a.Size() {
 		v, err := a.GetAt(i)
-		test.GotWant(t, err, nil)
+		test.GotWantNoError(t, err)
 		test.GotWant(t, v, i+1)
 	}
 }
@@ -121,7 +135,7 @@ func TestStandardArray_GetAt_Order(t *testing.T) {
 func TestStandardArray_UpdateAt_NegativeIndex(t *testing.T) {
 	a := NewStandardArray[int]()
 	old, err := a.UpdateAt(-1, 0)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, old, 0)
 }
 
@@ -129,7 +143,7 @@ func TestStandardArray_UpdateAt_NegativeIndex(t *testing.T) {
 func TestStandardArray_UpdateAt_InvalidIndex(t *testing.T) {
 	a := NewStandardArray(1, 2, 3)
 	old, err := a.UpdateAt(3, 4)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, old, 0)
 }
 
@@ -137,7 +151,7 @@ func TestStandardArray_UpdateAt_InvalidIndex(t *testing.T) {
 func TestStandardArray_UpdateAt_Start(t *testing.T) {
 	a := NewStandardArray(1, 2, 3)
 	old, err := a.UpdateAt(0, 4)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, old, 1)
 	new, _ := a.GetAt(0)
 	test.GotWant(t, new, 4)
@@ -147,7 +161,7 @@ func TestStandardArray_UpdateAt_Start(t *testing.T) {
 func TestStandardArray_UpdateAt_End(t *testing.T) {
 	a := NewStandardArray(1, 2, 3)
 	old, err := a.UpdateAt(2, 4)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, old, 3)
 	new, _ := a.GetAt(2)
 	test.GotWant(t, new, 4)
@@ -157,7 +171,7 @@ func TestStandardArray_UpdateAt_End(t *testing.T) {
 func TestStandardArray_UpdateAt_Middle(t *testing.T) {
 	a := NewStandardArray(1, 2, 3)
 	old, err := a.UpdateAt(1, 4)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, old, 2)
 	new, _ := a.GetAt(1)
 	test.GotWant(t, new, 4)
@@ -200,3 +214,60 @@ func TestStandardArray_Size_NonEmptyArray(t *testing.T) {
 	a := NewStandardArray(1, 2, 3)
 	test.GotWant(t, a.Size(), 3)
 }
+
+// Verifies Shuffle keeps the elements and a seeded source reproduces the order
+func TestStandardArray_Shuffle(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	a, b := NewStandardArray(values...), NewStandardArray(values...)
+	a.Shuffle(rand.NewPCG(1, 2))
+	b.Shuffle(rand.NewPCG(1, 2))
+
+	test.GotWantSlice(t, a.data, b.data)
+	got := slices.Clone(a.data)
+	slices.Sort(got)
+	test.GotWantSlice(t, got, values)
+}
+
+// Verifies Reverse on even and odd sizes
+func TestStandardArray_Reverse(t *testing.T) {
+	even, odd := NewStandardArray(1, 2, 3, 4), NewStandardArray(1, 2, 3)
+	even.Reverse()
+	odd.Reverse()
+	test.GotWantSlice(t, even.data, []int{4, 3, 2, 1})
+	test.GotWantSlice(t, odd.data, []int{3, 2, 1})
+}
+
+// Verifies pages copy their items and report the total count
+func TestStandardArray_Page(t *testing.T) {
+	arr := NewStandardArray(1, 2, 3, 4, 5)
+	p := arr.Page(1, 2)
+	test.GotWantSlice(t, p.Items, []int{3, 4})
+	test.GotWant(t, p.Total, 5)
+	test.GotWant(t, p.HasNext(), true)
+
+	p.Items[0] = 30
+	v, _ := arr.GetAt(2)
+	test.GotWant(t, v, 3)
+
+	test.GotWantSlice(t, arr.Page(2, 2).Items, []int{5})
+	test.GotWant(t, len(arr.Page(3, 2).Items), 0)
+}
+
+// Verifies iteration in both directions and early termination
+func TestStandardArray_All(t *testing.T) {
+	arr := NewStandardArray(1, 2, 3)
+	test.GotWantSlice(t, slices.Collect(arr.All()), []int{1, 2, 3})
+	test.GotWantSlice(t, slices.Collect(arr.Backward()), []int{3, 2, 1})
+
+	var got []int
+	for v := range arr.Backward() {
+		got = append(got, v)
+		break
+	}
+
+	test.GotWantSlice(t, got, []int{3})
+
+	empty := NewStandardArray[int]()
+	test.GotWant(t, len(slices.Collect(empty.All())), 0)
+	test.GotWant(t, len(slices.Collect(empty.Backward())), 0)
+}
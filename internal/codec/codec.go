@@ -0,0 +1,432 @@
+// Package codec implements the compact binary format behind the
+// MarshalBinary/UnmarshalBinary and WriteTo/ReadFrom methods of the
+// structures. Both pairs produce and accept the same bytes, so data
+// written by one can be read by the other.
+//
+// Every encoding starts with a header and is followed by records:
+//
+//	magic    "GDS"
+//	version  1 byte, currently Version
+//	kind     uvarint length + name of the structure, e.g. "SliceStack"
+//	count    uvarint number of elements
+//	records  structure-specific; elements are uvarint length + payload
+//
+// The kind guards against decoding one structure as another, and the
+// length prefixes let a decoder skip or reject a record without
+// understanding its payload.
+//
+// Elements are encoded with their own MarshalBinary if they implement
+// encoding.BinaryMarshaler. Otherwise strings and byte slices are stored
+// as is, int and uint (and types based on them) as 8 little-endian bytes,
+// and every other fixed-size type with encoding/binary in little-endian
+// order. Types outside these rules, such as maps or structs holding
+// strings, fail with ErrUnsupportedType.
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Version is the format version written by Encoder and the only one
+// accepted by Decoder.
+const Version = 1
+
+const magic = "GDS"
+
+// Buffered bytes at which a stream encoder writes to its writer.
+const spillSize = 64 << 10
+
+const ErrorInvalidData = "invalid binary data"
+const ErrorUnsupportedVersion = "unsupported format version"
+const ErrorUnsupportedType = "unsupported element type"
+
+// Errors wrapped by the decoders, with detail on the offending header or
+// record, when they reject their input.
+var (
+	ErrInvalidData        = errors.New(ErrorInvalidData)
+	ErrUnsupportedVersion = errors.New(ErrorUnsupportedVersion)
+	ErrUnsupportedType    = errors.New(ErrorUnsupportedType)
+)
+
+// Encoder writes the header and records of one structure, either to a
+// buffer (NewEncoder) or to an io.Writer (NewStreamEncoder).
+//
+// Errors are sticky: after the first failure every call is a no-op and
+// Bytes or Flush reports the error, so structures can write all records
+// and check once at the end.
+//
+// Example:
+//
+//	e := NewEncoder("SliceStack", len(data))
+//	for _, v := range data {
+//		Append(e, v)
+//	}
+//	return e.Bytes()
+type Encoder struct {
+	buf     []byte
+	w       io.Writer // nil for a buffer encoder
+	written int64     // Bytes already written to w
+	err     error
+}
+
+// NewEncoder creates an encoder into a buffer and writes the header for
+// a structure of the given kind holding count elements.
+func NewEncoder(kind string, count int) *Encoder {
+	e := &Encoder{buf: make([]byte, 0, 16+len(kind))}
+	e.header(kind, count)
+	return e
+}
+
+// NewStreamEncoder creates an encoder into w and writes the header for a
+// structure of the given kind holding count elements. The records are
+// buffered in chunks of a fixed size, so memory use does not grow with
+// the structure; call Flush to write the rest.
+func NewStreamEncoder(w io.Writer, kind string, count int) *Encoder {
+	e := &Encoder{buf: make([]byte, 0, spillSize+spillSize/4), w: w}
+	e.header(kind, count)
+	return e
+}
+
+// Byte appends a single byte, used by structures for shape flags.
+func (e *Encoder) Byte(b byte) {
+	if e.err == nil {
+		e.buf = append(e.buf, b)
+		e.spill()
+	}
+}
+
+// Uvarint appends an unsigned integer in varint encoding.
+func (e *Encoder) Uvarint(x uint64) {
+	if e.err == nil {
+		e.buf = binary.AppendUvarint(e.buf, x)
+		e.spill()
+	}
+}
+
+// Bytes returns the encoding of a buffer encoder, or the first error met
+// while writing it.
+func (e *Encoder) Bytes() ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	return e.buf, nil
+}
+
+// Flush writes the buffered rest of a stream encoder to its writer.
+// Returns the number of bytes written in total and the first error met
+// while encoding or writing.
+func (e *Encoder) Flush() (int64, error) {
+	if e.err == nil && len(e.buf) > 0 {
+		e.write()
+	}
+
+	return e.written, e.err
+}
+
+// Writes the format header.
+func (e *Encoder) header(kind string, count int) {
+	e.buf = append(e.buf, magic...)
+	e.buf = append(e.buf, Version)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(kind)))
+	e.buf = append(e.buf, kind...)
+	e.buf = binary.AppendUvarint(e.buf, uint64(count))
+}
+
+// Writes the buffer of a stream encoder once it reaches spillSize.
+func (e *Encoder) spill() {
+	if e.w != nil && len(e.buf) >= spillSize {
+		e.write()
+	}
+}
+
+// Writes the buffer to w and empties it.
+func (e *Encoder) write() {
+	n, err := e.w.Write(e.buf)
+	e.written += int64(n)
+	e.buf = e.buf[:0]
+	if err != nil {
+		e.err = err
+	}
+}
+
+// Append appends the value as a length-prefixed record.
+// Records an ErrUnsupportedType error if T cannot be encoded.
+func Append[T any](e *Encoder, value T) {
+	if e.err != nil {
+		return
+	}
+
+	payload, err := marshal(value)
+	if err != nil {
+		e.err = err
+		return
+	}
+
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(payload)))
+	e.buf = append(e.buf, payload...)
+	e.spill()
+}
+
+// Decoder reads the records of one structure back, in the order they
+// were written, either from a byte slice (NewDecoder) or from an
+// io.Reader (NewStreamDecoder).
+//
+// Errors are sticky like those of Encoder: after the first failure
+// every read returns a zero value and Finish reports the error.
+//
+// Example:
+//
+//	d, n, err := NewDecoder(data, "SliceStack")
+//	if err != nil {
+//		return err
+//	}
+//	values := make([]T, n)
+//	for i := range values {
+//		values[i] = Read[T](d)
+//	}
+//	if err := d.Finish(); err != nil {
+//		return err
+//	}
+type Decoder struct {
+	in      *bytes.Reader // Source of a slice decoder, nil for a stream
+	stream  *bufio.Reader // Source of a stream decoder, nil for a slice
+	counter *countingReader
+	payload bytes.Buffer // Scratch space for stream records
+	err     error
+}
+
+// NewDecoder checks the header of data against the kind and returns a
+// decoder positioned at the first record, along with the element count.
+//
+// Returns ErrUnsupportedVersion for data written by another format
+// version, and ErrInvalidData for anything else that is not a valid
+// header of the kind. The count is checked against the length of data,
+// so callers may allocate for it without trusting the input.
+func NewDecoder(data []byte, kind string) (*Decoder, int, error) {
+	d, n, err := (&Decoder{in: bytes.NewReader(data)}).header(kind)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return d, n, nil
+}
+
+// NewStreamDecoder checks the header read from r against the kind and
+// returns a decoder positioned at the first record, along with the
+// element count. The decoder reads ahead through a buffer and expects r
+// to end after the last record.
+//
+// Returns the same errors as NewDecoder, or the error of r. The count
+// cannot be checked against the input, so callers must not allocate for
+// it up front; each record is only read as far as r delivers it. On error
+// the decoder is still returned, for BytesRead.
+func NewStreamDecoder(r io.Reader, kind string) (*Decoder, int, error) {
+	c := &countingReader{r: r}
+	d := &Decoder{stream: bufio.NewReader(c), counter: c}
+	return d.header(kind)
+}
+
+// Byte reads a single byte.
+func (d *Decoder) Byte() byte {
+	if d.err != nil {
+		return 0
+	}
+
+	b, err := d.source().ReadByte()
+	if err != nil {
+		d.failRead(err)
+		return 0
+	}
+
+	return b
+}
+
+// Uvarint reads an unsigned integer in varint encoding.
+func (d *Decoder) Uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	x, err := binary.ReadUvarint(d.source())
+	if err != nil {
+		d.failRead(err)
+		return 0
+	}
+
+	return x
+}
+
+// Finish returns the first error met while reading, or an error if data
+// is left over after the last record.
+func (d *Decoder) Finish() error {
+	if d.err != nil {
+		return d.err
+	}
+
+	if d.in != nil {
+		if d.in.Len() > 0 {
+			d.fail("%d trailing bytes", d.in.Len())
+		}
+	} else if _, err := d.stream.ReadByte(); err == nil {
+		d.fail("trailing bytes")
+	} else if err != io.EOF {
+		d.failRead(err)
+	}
+
+	return d.err
+}
+
+// Fail records an ErrInvalidData error with the formatted detail, for
+// checks of the structure-specific records. Like every error it is kept
+// until Finish.
+func (d *Decoder) Fail(format string, args ...any) {
+	if d.err == nil {
+		d.fail(format, args...)
+	}
+}
+
+// BytesRead returns the number of bytes a stream decoder has read from
+// its reader, including any read ahead into its buffer.
+func (d *Decoder) BytesRead() int64 {
+	if d.counter == nil {
+		return 0
+	}
+
+	return d.counter.n
+}
+
+// Read reads a length-prefixed record written by Append.
+func Read[T any](d *Decoder) T {
+	var value T
+	payload := d.bytes()
+	if d.err != nil {
+		return value
+	}
+
+	if err := unmarshal(payload, &value); err != nil {
+		d.err = err
+	}
+
+	return value
+}
+
+// Reads and checks the format header.
+// Returns the decoder, the element count and the first error.
+func (d *Decoder) header(kind string) (*Decoder, int, error) {
+	var head [len(magic) + 1]byte
+	if _, err := io.ReadFull(d.source(), head[:]); err != nil || string(head[:len(magic)]) != magic {
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return d, 0, err
+		}
+
+		return d, 0, fmt.Errorf("%w: missing header", ErrInvalidData)
+	}
+
+	if v := head[len(magic)]; v != Version {
+		return d, 0, fmt.Errorf("%w: %d", ErrUnsupportedVersion, v)
+	}
+
+	if got := string(d.bytes()); d.err == nil && got != kind {
+		return d, 0, fmt.Errorf("%w: kind %q, want %q", ErrInvalidData, got, kind)
+	}
+
+	count := d.Uvarint()
+	switch {
+	case d.err != nil:
+	case count > math.MaxInt:
+		d.fail("count %d exceeds the maximum of %d", count, math.MaxInt)
+	case d.in != nil && count > uint64(d.in.Len()):
+		d.fail("count %d exceeds remaining %d bytes", count, d.in.Len())
+	}
+
+	if d.err != nil {
+		return d, 0, d.err
+	}
+
+	return d, int(count), nil
+}
+
+// Reads a length-prefixed byte string. The result of a stream decoder is
+// only valid until the next read.
+func (d *Decoder) bytes() []byte {
+	n := d.Uvarint()
+	if d.err != nil {
+		return nil
+	}
+
+	if d.in != nil {
+		if n > uint64(d.in.Len()) {
+			d.fail("record of %d bytes exceeds remaining %d", n, d.in.Len())
+			return nil
+		}
+
+		b := make([]byte, n)
+		d.in.Read(b)
+		return b
+	}
+
+	// Grow with the data actually delivered, never by the claimed length
+	d.payload.Reset()
+	if _, err := io.CopyN(&d.payload, d.stream, int64(n)); err != nil {
+		d.failRead(err)
+		return nil
+	}
+
+	return d.payload.Bytes()
+}
+
+// Returns the source of the decoder.
+func (d *Decoder) source() interface {
+	io.Reader
+	io.ByteReader
+} {
+	if d.in != nil {
+		return d.in
+	}
+
+	return d.stream
+}
+
+// Records an ErrInvalidData error with the formatted detail.
+func (d *Decoder) fail(format string, args ...any) {
+	d.err = fmt.Errorf("%w: %s", ErrInvalidData, fmt.Sprintf(format, args...))
+}
+
+// Records a read error: the end of the input becomes ErrInvalidData, an
+// error of the stream's reader is kept as is, and anything else can only
+// be a varint overflow.
+func (d *Decoder) failRead(err error) {
+	switch {
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		d.fail("unexpected end of data")
+	case d.counter != nil && d.counter.err != nil:
+		d.err = d.counter.err
+	default:
+		d.fail("malformed varint")
+	}
+}
+
+// Counts the bytes read through it and keeps the first error other than
+// io.EOF, so the decoder can tell failures of the reader from bad data.
+type countingReader struct {
+	r   io.Reader
+	n   int64
+	err error
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	if err != nil && err != io.EOF && c.err == nil {
+		c.err = err
+	}
+
+	return n, err
+}
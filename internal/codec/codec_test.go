@@ -0,0 +1,371 @@
+package codec
+
+/*
+Test Coverage
+=============
+Elements:
+  ✓ Round trip of strings, byte slices, int/uint, named ints, floats, bools and fixed-size structs
+  ✓ Element types implementing encoding.BinaryMarshaler
+  ✓ Unsupported element types
+
+Header:
+  ✓ Missing magic
+  ✓ Unsupported version
+  ✓ Mismatched kind
+  ✓ Count exceeding the data
+
+Records:
+  ✓ Truncated record
+  ✓ Trailing bytes
+  ✓ Payload of the wrong size
+  ✓ Shape bytes and varints
+  ✓ Pairs round trip
+
+Fail:
+  ✓ Records ErrInvalidData once, keeping the first error
+
+Streams:
+  ✓ Stream encoding equals buffer encoding, across chunk boundaries
+  ✓ Stream decoding, bytes written and read reported
+  ✓ Writer errors reported
+  ✓ Reader errors kept apart from invalid data
+  ✓ Trailing and truncated streams rejected
+  ✓ Forged count does not allocate up front
+  ✓ Count beyond the int range rejected in both modes
+  ✓ Header errors report the bytes read
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"testing"
+	"testing/iotest"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Represents a fixed-size element type.
+type testPoint struct {
+	X, Y int32
+}
+
+// Represents a named integer element type.
+type testColor int
+
+// Represents an element type with its own text-based binary encoding.
+type testVersion struct {
+	major, minor int
+}
+
+func (v testVersion) MarshalBinary() ([]byte, error) {
+	return []byte(strconv.Itoa(v.major) + "." + strconv.Itoa(v.minor)), nil
+}
+
+func (v *testVersion) UnmarshalBinary(data []byte) error {
+	var err error
+	before, after, _ := bytes.Cut(data, []byte{'.'})
+	if v.major, err = strconv.Atoi(string(before)); err != nil {
+		return err
+	}
+	v.minor, err = strconv.Atoi(string(after))
+	return err
+}
+
+// Encodes the values as a sequence and decodes them back.
+func roundTrip[T any](t *testing.T, values ...T) []T {
+	t.Helper()
+	data, err := EncodeSeq("Test", len(values), slices.Values(values))
+	test.GotWantNoError(t, err)
+
+	got, err := DecodeSlice[T](data, "Test")
+	test.GotWantNoError(t, err)
+	return got
+}
+
+// Verifies every supported kind of element survives a round trip
+func TestCodec_Elements(t *testing.T) {
+	test.GotWantSlice(t, roundTrip(t, "", "go", "héllo"), []string{"", "go", "héllo"})
+	test.GotWantSlice(t, roundTrip(t, -1, 0, 1<<40), []int{-1, 0, 1 << 40})
+	test.GotWantSlice(t, roundTrip[uint](t, 0, 1<<63), []uint{0, 1 << 63})
+	test.GotWantSlice(t, roundTrip[testColor](t, 3, -7), []testColor{3, -7})
+	test.GotWantSlice(t, roundTrip(t, 1.5, -0.25), []float64{1.5, -0.25})
+	test.GotWantSlice(t, roundTrip(t, true, false), []bool{true, false})
+	test.GotWantSlice(t, roundTrip(t, testPoint{1, -2}), []testPoint{{1, -2}})
+	test.GotWantSlice(t, roundTrip(t, struct{}{}, struct{}{}), []struct{}{{}, {}})
+	test.GotWantDeep(t, roundTrip(t, []byte("ab"), []byte{}), [][]byte{[]byte("ab"), {}})
+}
+
+// Verifies elements implementing encoding.BinaryMarshaler use their own
+// encoding
+func TestCodec_Elements_BinaryMarshaler(t *testing.T) {
+	got := roundTrip(t, testVersion{1, 26}, testVersion{0, 3})
+	test.GotWantSlice(t, got, []testVersion{{1, 26}, {0, 3}})
+
+	data, _ := EncodeSeq("Test", 1, slices.Values([]testVersion{{1, 26}}))
+	test.GotWant(t, string(data[len(data)-4:]), "1.26")
+}
+
+// Verifies element types outside the rules fail with ErrUnsupportedType
+func TestCodec_Elements_Unsupported(t *testing.T) {
+	_, err := EncodeSeq("Test", 1, slices.Values([]map[int]int{{}}))
+	test.GotWantErrorIs(t, err, ErrUnsupportedType)
+
+	_, err = EncodeSeq("Test", 1, slices.Values([]struct{ Name string }{{"a"}}))
+	test.GotWantErrorIs(t, err, ErrUnsupportedType)
+
+	data, _ := EncodeSeq("Test", 1, slices.Values([]string{"a"}))
+	_, err = DecodeSlice[map[int]int](data, "Test")
+	test.GotWantErrorIs(t, err, ErrUnsupportedType)
+}
+
+// Verifies data without the magic is rejected
+func TestCodec_Header_Magic(t *testing.T) {
+	for _, data := range [][]byte{nil, []byte("GD"), []byte("XYZ\x01")} {
+		_, _, err := NewDecoder(data, "Test")
+		test.GotWantErrorIs(t, err, ErrInvalidData)
+	}
+}
+
+// Verifies data of another format version is rejected
+func TestCodec_Header_Version(t *testing.T) {
+	data, _ := NewEncoder("Test", 0).Bytes()
+	data[len(magic)] = Version + 1
+
+	_, _, err := NewDecoder(data, "Test")
+	test.GotWantErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+// Verifies data of another kind is rejected
+func TestCodec_Header_Kind(t *testing.T) {
+	data, _ := NewEncoder("SliceStack", 0).Bytes()
+
+	_, _, err := NewDecoder(data, "SliceQueue")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+	test.GotWant(t, err.Error(), `invalid binary data: kind "SliceStack", want "SliceQueue"`)
+}
+
+// Verifies a count larger than the data can hold is rejected before any
+// allocation
+func TestCodec_Header_Count(t *testing.T) {
+	data, _ := NewEncoder("Test", 1<<40).Bytes()
+
+	_, err := DecodeSlice[int](data, "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+}
+
+// Verifies a record cut short is rejected
+func TestCodec_Records_Truncated(t *testing.T) {
+	data, _ := EncodeSeq("Test", 2, slices.Values([]string{"ab", "cd"}))
+
+	_, err := DecodeSlice[string](data[:len(data)-1], "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+}
+
+// Verifies bytes after the last record are rejected
+func TestCodec_Records_Trailing(t *testing.T) {
+	data, _ := EncodeSeq("Test", 1, slices.Values([]string{"ab"}))
+
+	_, err := DecodeSlice[string](append(data, 0), "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+	test.GotWant(t, err.Error(), "invalid binary data: 1 trailing bytes")
+}
+
+// Verifies a payload whose size does not match the element type is
+// rejected
+func TestCodec_Records_PayloadSize(t *testing.T) {
+	data, _ := EncodeSeq("Test", 1, slices.Values([]string{"abc"}))
+
+	_, err := DecodeSlice[int](data, "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+
+	_, err = DecodeSlice[testPoint](data, "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+}
+
+// Verifies shape bytes and varints are read back in order, and reading
+// past the end records an error
+func TestCodec_Records_Shape(t *testing.T) {
+	e := NewEncoder("Test", 1)
+	e.Byte(3)
+	e.Uvarint(300)
+	Append(e, "x")
+	data, err := e.Bytes()
+	test.GotWantNoError(t, err)
+
+	d, n, err := NewDecoder(data, "Test")
+	test.GotWantNoError(t, err)
+	test.GotWant(t, n, 1)
+	test.GotWant(t, d.Byte(), 3)
+	test.GotWant(t, d.Uvarint(), 300)
+	test.GotWant(t, Read[string](d), "x")
+	test.GotWantNoError(t, d.Finish())
+
+	test.GotWant(t, d.Byte(), 0)
+	test.GotWantErrorIs(t, d.Finish(), ErrInvalidData)
+}
+
+// Verifies pairs survive a round trip in order
+func TestCodec_Records_Pairs(t *testing.T) {
+	seq := func(yield func(string, int) bool) {
+		_ = yield("a", 1) && yield("b", 2)
+	}
+	data, err := EncodeSeq2("Test", 2, seq)
+	test.GotWantNoError(t, err)
+
+	keys, values, err := DecodeSlice2[string, int](data, "Test")
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, keys, []string{"a", "b"})
+	test.GotWantSlice(t, values, []int{1, 2})
+
+	_, _, err = DecodeSlice2[string, int](data[:len(data)-2], "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+}
+
+// Verifies Fail records ErrInvalidData and keeps the first error
+func TestCodec_Fail(t *testing.T) {
+	data, _ := NewEncoder("Test", 0).Bytes()
+	d, _, _ := NewDecoder(data, "Test")
+
+	d.Fail("bad %s", "shape")
+	d.Fail("ignored")
+	test.GotWant(t, d.Finish().Error(), "invalid binary data: bad shape")
+}
+
+// Verifies a stream encoding spanning several chunks equals the buffer
+// encoding, and decodes back with the byte counts reported
+func TestCodec_Streams_RoundTrip(t *testing.T) {
+	values := make([]string, 20000)
+	for i := range values {
+		values[i] = strconv.Itoa(i)
+	}
+	data, _ := EncodeSeq("Test", len(values), slices.Values(values))
+
+	var buf bytes.Buffer
+	written, err := WriteSeq(&buf, "Test", len(values), slices.Values(values))
+	test.GotWantNoError(t, err)
+	test.GotWant(t, written, int64(len(data)))
+	test.GotWant(t, bytes.Equal(buf.Bytes(), data), true)
+
+	got, read, err := ReadSlice[string](&buf, "Test")
+	test.GotWantNoError(t, err)
+	test.GotWant(t, read, int64(len(data)))
+	test.GotWantSlice(t, got, values)
+}
+
+// Verifies pairs stream in order
+func TestCodec_Streams_Pairs(t *testing.T) {
+	seq := func(yield func(string, int) bool) {
+		_ = yield("a", 1) && yield("b", 2)
+	}
+	var buf bytes.Buffer
+	_, err := WriteSeq2(&buf, "Test", 2, seq)
+	test.GotWantNoError(t, err)
+
+	keys, values, _, err := ReadSlice2[string, int](&buf, "Test")
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, keys, []string{"a", "b"})
+	test.GotWantSlice(t, values, []int{1, 2})
+}
+
+// Represents a writer that fails after accepting limit bytes.
+type testFailingWriter struct {
+	limit int
+}
+
+var errTestWrite = errors.New("disk full")
+
+func (w *testFailingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n := w.limit
+		w.limit = 0
+		return n, errTestWrite
+	}
+
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+// Verifies write errors are returned with the bytes written before them
+func TestCodec_Streams_WriterError(t *testing.T) {
+	written, err := WriteSeq(&testFailingWriter{limit: 5}, "Test", 3, slices.Values([]int{1, 2, 3}))
+	test.GotWantErrorIs(t, err, errTestWrite)
+	test.GotWant(t, written, 5)
+}
+
+// Verifies errors of the reader are returned as is, not as invalid data
+func TestCodec_Streams_ReaderError(t *testing.T) {
+	errRead := errors.New("connection reset")
+	data, _ := EncodeSeq("Test", 2, slices.Values([]int{1, 2}))
+	r := io.MultiReader(bytes.NewReader(data[:12]), iotest.ErrReader(errRead))
+
+	_, _, err := ReadSlice[int](r, "Test")
+	test.GotWantErrorIs(t, err, errRead)
+	test.GotWant(t, errors.Is(err, ErrInvalidData), false)
+
+	_, _, err = ReadSlice[int](iotest.ErrReader(errRead), "Test")
+	test.GotWantErrorIs(t, err, errRead)
+}
+
+// Verifies streams with data after the last record or cut short are
+// rejected
+func TestCodec_Streams_Invalid(t *testing.T) {
+	data, _ := EncodeSeq("Test", 2, slices.Values([]int{1, 2}))
+
+	_, _, err := ReadSlice[int](bytes.NewReader(append(data, 0)), "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+
+	_, _, err = ReadSlice[int](iotest.OneByteReader(bytes.NewReader(data[:len(data)-3])), "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+}
+
+// Verifies a forged count or record length fails at the end of the data
+// instead of allocating for the claimed size
+func TestCodec_Streams_ForgedLengths(t *testing.T) {
+	data, _ := NewEncoder("Test", 1<<40).Bytes()
+	_, _, err := ReadSlice[int](bytes.NewReader(data), "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+
+	e := NewEncoder("Test", 1)
+	e.Uvarint(1 << 40)
+	data, _ = e.Bytes()
+	_, _, err = ReadSlice[string](bytes.NewReader(append(data, "abc"...)), "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+}
+
+// Verifies a stream decoder that rejects the header still reports the
+// bytes it read
+func TestCodec_Streams_HeaderBytesRead(t *testing.T) {
+	data, _ := NewEncoder("Other", 0).Bytes()
+
+	_, read, err := ReadSlice[int](bytes.NewReader(data), "Test")
+	test.GotWantErrorIs(t, err, ErrInvalidData)
+	test.GotWant(t, read, int64(len(data)))
+}
+
+// Returns a header for the kind claiming count elements, beyond what
+// NewEncoder can write.
+func forgedHeader(kind string, count uint64) []byte {
+	data := append([]byte(magic), Version)
+	data = binary.AppendUvarint(data, uint64(len(kind)))
+	data = append(data, kind...)
+	return binary.AppendUvarint(data, count)
+}
+
+// Verifies a count that does not fit an int is rejected by both decoders
+// instead of wrapping negative
+func TestCodec_Header_CountOverflow(t *testing.T) {
+	for _, count := range []uint64{1 << 63, 1<<64 - 1} {
+		data := forgedHeader("Test", count)
+
+		_, err := DecodeSlice[int](data, "Test")
+		test.GotWantErrorIs(t, err, ErrInvalidData)
+		_, _, err = ReadSlice[int](bytes.NewReader(data), "Test")
+		test.GotWantErrorIs(t, err, ErrInvalidData)
+		_, _, _, err = ReadSlice2[int, int](bytes.NewReader(data), "Test")
+		test.GotWantErrorIs(t, err, ErrInvalidData)
+	}
+}
@@ -0,0 +1,84 @@
+package codec
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+)
+
+// Encodes a single element following the package rules.
+func marshal[T any](value T) ([]byte, error) {
+	if m, ok := any(value).(encoding.BinaryMarshaler); ok {
+		return m.MarshalBinary()
+	}
+
+	if m, ok := any(&value).(encoding.BinaryMarshaler); ok {
+		return m.MarshalBinary()
+	}
+
+	v := reflect.ValueOf(&value).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Bytes(), nil
+		}
+	case reflect.Int:
+		return binary.LittleEndian.AppendUint64(nil, uint64(v.Int())), nil
+	case reflect.Uint:
+		return binary.LittleEndian.AppendUint64(nil, v.Uint()), nil
+	}
+
+	b, err := binary.Append(nil, binary.LittleEndian, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, v.Type())
+	}
+
+	return b, nil
+}
+
+// Decodes a single element following the package rules.
+func unmarshal[T any](payload []byte, value *T) error {
+	if u, ok := any(value).(encoding.BinaryUnmarshaler); ok {
+		return u.UnmarshalBinary(payload)
+	}
+
+	v := reflect.ValueOf(value).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(string(payload))
+		return nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes(bytes.Clone(payload))
+			return nil
+		}
+	case reflect.Int, reflect.Uint:
+		if len(payload) != 8 {
+			return fmt.Errorf("%w: %s of %d bytes", ErrInvalidData, v.Type(), len(payload))
+		}
+
+		x := binary.LittleEndian.Uint64(payload)
+		if v.Kind() == reflect.Int {
+			v.SetInt(int64(x))
+		} else {
+			v.SetUint(x)
+		}
+
+		return nil
+	}
+
+	if binary.Size(value) < 0 {
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, v.Type())
+	}
+
+	n, err := binary.Decode(payload, binary.LittleEndian, value)
+	if err != nil || n != len(payload) {
+		return fmt.Errorf("%w: %s of %d bytes", ErrInvalidData, v.Type(), len(payload))
+	}
+
+	return nil
+}
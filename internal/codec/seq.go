@@ -0,0 +1,143 @@
+package codec
+
+import (
+	"io"
+	"iter"
+)
+
+// Elements a stream decode allocates for before the data proves the
+// count, so a forged count cannot force a large allocation.
+const maxPrealloc = 1 << 12
+
+// EncodeSeq encodes the count elements yielded by seq as a structure of
+// the given kind, for structures that are plain sequences.
+func EncodeSeq[T any](kind string, count int, seq iter.Seq[T]) ([]byte, error) {
+	e := NewEncoder(kind, count)
+	appendSeq(e, seq)
+	return e.Bytes()
+}
+
+// WriteSeq streams the count elements yielded by seq to w as a structure
+// of the given kind, in the format of EncodeSeq.
+// Returns the number of bytes written and the first error.
+func WriteSeq[T any](w io.Writer, kind string, count int, seq iter.Seq[T]) (int64, error) {
+	e := NewStreamEncoder(w, kind, count)
+	appendSeq(e, seq)
+	return e.Flush()
+}
+
+// DecodeSlice decodes the elements written by EncodeSeq or WriteSeq for
+// the kind, in their original order.
+func DecodeSlice[T any](data []byte, kind string) ([]T, error) {
+	d, n, err := NewDecoder(data, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]T, 0, n)
+	return readSlice(d, n, values)
+}
+
+// ReadSlice reads the elements written by EncodeSeq or WriteSeq for the
+// kind from r, which must end after the last element, in their original
+// order. Returns the elements, the number of bytes read and the first
+// error.
+func ReadSlice[T any](r io.Reader, kind string) ([]T, int64, error) {
+	d, n, err := NewStreamDecoder(r, kind)
+	if err != nil {
+		return nil, d.BytesRead(), err
+	}
+
+	values, err := readSlice(d, n, make([]T, 0, min(n, maxPrealloc)))
+	return values, d.BytesRead(), err
+}
+
+// EncodeSeq2 encodes the count pairs yielded by seq as a structure of the
+// given kind, each pair as a key record followed by a value record.
+func EncodeSeq2[K any, V any](kind string, count int, seq iter.Seq2[K, V]) ([]byte, error) {
+	e := NewEncoder(kind, count)
+	appendSeq2(e, seq)
+	return e.Bytes()
+}
+
+// WriteSeq2 streams the count pairs yielded by seq to w as a structure of
+// the given kind, in the format of EncodeSeq2.
+// Returns the number of bytes written and the first error.
+func WriteSeq2[K any, V any](w io.Writer, kind string, count int, seq iter.Seq2[K, V]) (int64, error) {
+	e := NewStreamEncoder(w, kind, count)
+	appendSeq2(e, seq)
+	return e.Flush()
+}
+
+// DecodeSlice2 decodes the pairs written by EncodeSeq2 or WriteSeq2 for
+// the kind, in their original order.
+func DecodeSlice2[K any, V any](data []byte, kind string) ([]K, []V, error) {
+	d, n, err := NewDecoder(data, kind)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return readSlice2(d, n, make([]K, 0, n), make([]V, 0, n))
+}
+
+// ReadSlice2 reads the pairs written by EncodeSeq2 or WriteSeq2 for the
+// kind from r, which must end after the last pair, in their original
+// order. Returns the keys, the values, the number of bytes read and the
+// first error.
+func ReadSlice2[K any, V any](r io.Reader, kind string) ([]K, []V, int64, error) {
+	d, n, err := NewStreamDecoder(r, kind)
+	if err != nil {
+		return nil, nil, d.BytesRead(), err
+	}
+
+	keys, values, err := readSlice2(d, n, make([]K, 0, min(n, maxPrealloc)), make([]V, 0, min(n, maxPrealloc)))
+	return keys, values, d.BytesRead(), err
+}
+
+// Appends a record for every element of seq.
+func appendSeq[T any](e *Encoder, seq iter.Seq[T]) {
+	for v := range seq {
+		Append(e, v)
+	}
+}
+
+// Appends a key record and a value record for every pair of seq.
+func appendSeq2[K any, V any](e *Encoder, seq iter.Seq2[K, V]) {
+	for k, v := range seq {
+		Append(e, k)
+		Append(e, v)
+	}
+}
+
+// Reads n element records into values, stopping at the first error.
+func readSlice[T any](d *Decoder, n int, values []T) ([]T, error) {
+	for range n {
+		v := Read[T](d)
+		if d.err != nil {
+			break
+		}
+		values = append(values, v)
+	}
+	if err := d.Finish(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// Reads n pairs of records into keys and values, stopping at the first
+// error.
+func readSlice2[K any, V any](d *Decoder, n int, keys []K, values []V) ([]K, []V, error) {
+	for range n {
+		k, v := Read[K](d), Read[V](d)
+		if d.err != nil {
+			break
+		}
+		keys, values = append(keys, k), append(values, v)
+	}
+	if err := d.Finish(); err != nil {
+		return nil, nil, err
+	}
+
+	return keys, values, nil
+}
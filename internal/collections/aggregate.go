@@ -0,0 +1,154 @@
+package collections
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/constraints"
+
+// Count returns the number of elements the container yields.
+// Containers that implement Sized report the same number in O(1); Count
+// serves those that only iterate.
+//
+// Time complexity: O(n)
+func Count[T any](src Iterable[T]) int {
+	n := 0
+	for range src.All() {
+		n++
+	}
+
+	return n
+}
+
+// CountFunc returns the number of elements for which pred returns true.
+//
+// Time complexity: O(n) calls of pred
+//
+// Example:
+//
+//	l := structures.NewDoublyLinkedList(1, 2, 3, 4)
+//	CountFunc(l, func(v int) bool { return v%2 == 0 })  // Returns 2
+func CountFunc[T any](src Iterable[T], pred func(T) bool) int {
+	n := 0
+	for v := range src.All() {
+		if pred(v) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Min returns the smallest element, and false if the container is empty.
+// For floating-point elements a NaN anywhere yields NaN, as with the
+// built-in min.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	s := structures.NewHashSet(3, 1, 2)
+//	Min(s)  // Returns 1, true
+func Min[T constraints.Ordered](src Iterable[T]) (T, bool) {
+	var result T
+	found := false
+	for v := range src.All() {
+		if !found {
+			result, found = v, true
+		} else {
+			result = min(result, v)
+		}
+	}
+
+	return result, found
+}
+
+// Max returns the largest element, and false if the container is empty.
+// For floating-point elements a NaN anywhere yields NaN, as with the
+// built-in max.
+//
+// Time complexity: O(n)
+func Max[T constraints.Ordered](src Iterable[T]) (T, bool) {
+	var result T
+	found := false
+	for v := range src.All() {
+		if !found {
+			result, found = v, true
+		} else {
+			result = max(result, v)
+		}
+	}
+
+	return result, found
+}
+
+// MinFunc returns the first smallest element according to cmp, and false
+// if the container is empty.
+//
+// Time complexity: O(n) calls of cmp
+//
+// Example:
+//
+//	l := structures.NewDoublyLinkedList("ccc", "a", "bb")
+//	MinFunc(l, func(a, b string) int { return len(a) - len(b) })  // Returns "a", true
+func MinFunc[T any](src Iterable[T], cmp func(a, b T) int) (T, bool) {
+	var result T
+	found := false
+	for v := range src.All() {
+		if !found || cmp(v, result) < 0 {
+			result, found = v, true
+		}
+	}
+
+	return result, found
+}
+
+// MaxFunc returns the first largest element according to cmp, and false
+// if the container is empty.
+//
+// Time complexity: O(n) calls of cmp
+func MaxFunc[T any](src Iterable[T], cmp func(a, b T) int) (T, bool) {
+	var result T
+	found := false
+	for v := range src.All() {
+		if !found || cmp(v, result) > 0 {
+			result, found = v, true
+		}
+	}
+
+	return result, found
+}
+
+// Sum returns the sum of the elements, 0 for an empty container. Integer
+// sums wrap around on overflow like the + operator. Complex elements are
+// accepted as well.
+//
+// Time complexity: O(n)
+func Sum[T constraints.Number | constraints.Complex](src Iterable[T]) T {
+	var sum T
+	for v := range src.All() {
+		sum += v
+	}
+
+	return sum
+}
+
+// Average returns the arithmetic mean of the elements, and false if the
+// container is empty. The elements are summed as float64, so integer
+// elements do not overflow or truncate the result.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	l := structures.NewDoublyLinkedList(1, 2)
+//	Average(l)  // Returns 1.5, true
+func Average[T constraints.Number](src Iterable[T]) (float64, bool) {
+	sum, n := 0.0, 0
+	for v := range src.All() {
+		sum += float64(v)
+		n++
+	}
+
+	if n == 0 {
+		return 0, false
+	}
+
+	return sum / float64(n), true
+}
@@ -0,0 +1,130 @@
+package collections
+
+/*
+Test Coverage
+=============
+Count/CountFunc:
+  ✓ Empty container, all and matching elements
+
+Min/Max:
+  ✓ Empty container
+  ✓ Integers and strings
+  ✓ NaN propagates
+
+MinFunc/MaxFunc:
+  ✓ Empty container, first of equal elements wins
+
+Sum/Average:
+  ✓ Empty container
+  ✓ Integers average without truncation or overflow
+  ✓ Floats
+  ✓ Complex sums
+*/
+
+import (
+	"iter"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Represents a container that yields the values of a slice.
+type sequence[T any] []T
+
+func (s sequence[T]) All() iter.Seq[T] {
+	return slices.Values(s)
+}
+
+// Verifies counting all and matching elements
+func TestCount(t *testing.T) {
+	test.GotWant(t, Count(sequence[int]{}), 0)
+	test.GotWant(t, Count(sequence[int]{1, 2, 3}), 3)
+
+	even := func(v int) bool { return v%2 == 0 }
+	test.GotWant(t, CountFunc(sequence[int]{}, even), 0)
+	test.GotWant(t, CountFunc(sequence[int]{1, 2, 3, 4}, even), 2)
+}
+
+// Verifies Min and Max on an empty container
+func TestMinMax_Empty(t *testing.T) {
+	_, ok := Min(sequence[int]{})
+	test.GotWant(t, ok, false)
+	_, ok = Max(sequence[string]{})
+	test.GotWant(t, ok, false)
+}
+
+// Verifies Min and Max on integers and strings
+func TestMinMax(t *testing.T) {
+	v, ok := Min(sequence[int]{3, -1, 2})
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, -1)
+	v, _ = Max(sequence[int]{3, -1, 2})
+	test.GotWant(t, v, 3)
+
+	s, _ := Min(sequence[string]{"b", "c", "a"})
+	test.GotWant(t, s, "a")
+	s, _ = Max(sequence[string]{"b", "c", "a"})
+	test.GotWant(t, s, "c")
+}
+
+// Verifies a NaN element yields NaN
+func TestMinMax_NaN(t *testing.T) {
+	values := sequence[float64]{1, math.NaN(), -1}
+	v, _ := Min(values)
+	test.GotWant(t, math.IsNaN(v), true)
+	v, _ = Max(values)
+	test.GotWant(t, math.IsNaN(v), true)
+}
+
+// Verifies MinFunc and MaxFunc return the first of equal elements
+func TestMinFuncMaxFunc(t *testing.T) {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	_, ok := MinFunc(sequence[string]{}, byLen)
+	test.GotWant(t, ok, false)
+	_, ok = MaxFunc(sequence[string]{}, byLen)
+	test.GotWant(t, ok, false)
+
+	words := sequence[string]{"bb", "a", "ccc", "d", "eee"}
+	v, ok := MinFunc(words, byLen)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, "a")
+	v, _ = MaxFunc(words, byLen)
+	test.GotWant(t, v, "ccc")
+	v, _ = MaxFunc(words, strings.Compare)
+	test.GotWant(t, v, "eee")
+}
+
+// Verifies Sum and Average on an empty container
+func TestSumAverage_Empty(t *testing.T) {
+	test.GotWant(t, Sum(sequence[int]{}), 0)
+	avg, ok := Average(sequence[int]{})
+	test.GotWant(t, ok, false)
+	test.GotWant(t, avg, 0.0)
+}
+
+// Verifies integer averages are neither truncated nor overflowed
+func TestSumAverage_Integers(t *testing.T) {
+	test.GotWant(t, Sum(sequence[int]{1, 2}), 3)
+	avg, ok := Average(sequence[int]{1, 2})
+	test.GotWant(t, ok, true)
+	test.GotWant(t, avg, 1.5)
+
+	avg, _ = Average(sequence[uint8]{200, 250})
+	test.GotWant(t, avg, 225.0)
+	test.GotWant(t, Sum(sequence[uint8]{200, 250}), 194)
+}
+
+// Verifies Sum and Average on floats
+func TestSumAverage_Floats(t *testing.T) {
+	test.GotWant(t, Sum(sequence[float64]{0.5, 0.25, -1}), -0.25)
+	avg, _ := Average(sequence[float64]{0.5, 0.25, 0.75})
+	test.GotWant(t, avg, 0.5)
+}
+
+// Verifies Sum on complex numbers
+func TestSum_Complex(t *testing.T) {
+	test.GotWant(t, Sum(sequence[complex64]{1 + 2i, 3 - 1i}), 4+1i)
+}
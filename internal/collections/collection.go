@@ -0,0 +1,86 @@
+// Package collections defines the interfaces shared by the structures of
+// every category, so generic algorithms can be written once against any
+// container.
+//
+// Element containers such as sets, lists, stacks, queues and heaps
+// implement Sized, Iterable and Addable. Heaps iterate in the order of
+// their storage, which is not sorted. MultiSet is the exception among
+// sets: it iterates distinct elements with their counts and implements
+// Iterable2 instead. Sets and the lists and stacks over comparable
+// elements also implement Collection. Heaps, queues and
+// most stacks take elements of any type, which cannot be compared for
+// equality, so they cannot offer Contains and do not implement it.
+//
+// Key-value containers such as maps and search trees implement Sized and
+// Iterable2, and those that own their pairs also implement Collection over
+// their keys: Contains looks up a key and Clear removes every pair.
+// Wrappers such as BoundedMap leave Clear to the map they wrap, and
+// persistent structures such as HAMT never change in place, so they are
+// not Collections.
+package collections
+
+import "iter"
+
+// Sized is implemented by containers that know their number of elements.
+type Sized interface {
+	// Size returns the number of elements currently in the container.
+	Size() int
+
+	// IsEmpty returns true if the container holds no elements.
+	IsEmpty() bool
+}
+
+// Iterable is implemented by containers that can yield their elements.
+// The order is the container's natural one: insertion order for lists
+// and queues, pop order for stacks, ascending order for ordered sets.
+type Iterable[T any] interface {
+	// All returns an iterator over all elements.
+	All() iter.Seq[T]
+}
+
+// Iterable2 is implemented by containers that yield pairs, such as the
+// keys and values of a map.
+type Iterable2[K any, V any] interface {
+	// All returns an iterator over all pairs.
+	All() iter.Seq2[K, V]
+}
+
+// Addable is implemented by containers that accept new elements.
+type Addable[T any] interface {
+	// Add inserts the element where the container's policy puts it.
+	// Returns true if the container changed, false if it rejected the
+	// element, as sets do with duplicates.
+	Add(value T) bool
+}
+
+// Collection is implemented by mutable containers that can search their
+// elements.
+type Collection[T any] interface {
+	Sized
+
+	// Clear removes all elements.
+	Clear()
+
+	// Contains returns true if the element is present.
+	Contains(value T) bool
+}
+
+// AddAll adds every value of the sequence to the container.
+// Returns the number of values the container accepted.
+//
+// Time complexity: O(n) Add operations where n is the number of values
+//
+// Example:
+//
+//	s := structures.NewHashSet[int]()
+//	AddAll(s, slices.Values([]int{1, 2, 2}))  // Returns 2, s is {1, 2}
+func AddAll[T any](dst Addable[T], values iter.Seq[T]) int {
+	added := 0
+	for v := range values {
+		if dst.Add(v) {
+			added++
+		}
+	}
+
+	return added
+}
@@ -0,0 +1,42 @@
+package collections
+
+/*
+Test Coverage
+=============
+AddAll:
+  ✓ Empty sequence
+  ✓ Accepted values counted, rejected values skipped
+*/
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Represents a container that accepts every value once.
+type distinct map[int]bool
+
+func (d distinct) Add(value int) bool {
+	if d[value] {
+		return false
+	}
+
+	d[value] = true
+	return true
+}
+
+// Verifies AddAll on an empty sequence adds nothing
+func TestAddAll_Empty(t *testing.T) {
+	d := distinct{}
+	test.GotWant(t, AddAll(d, slices.Values([]int{})), 0)
+	test.GotWant(t, len(d), 0)
+}
+
+// Verifies AddAll counts only the values the container accepted
+func TestAddAll_Counts(t *testing.T) {
+	d := distinct{}
+	test.GotWant(t, AddAll(d, slices.Values([]int{1, 2, 2, 3, 1})), 3)
+	test.GotWant(t, len(d), 3)
+}
@@ -0,0 +1,432 @@
+package collections
+
+import "math/rand/v2"
+
+// Compile-time interface verifications
+var _ EvictionPolicy[int] = &LRUPolicy[int]{}
+var _ EvictionPolicy[int] = &LFUPolicy[int]{}
+var _ EvictionPolicy[int] = &FIFOPolicy[int]{}
+var _ EvictionPolicy[int] = &RandomPolicy[int]{}
+
+// EvictionPolicy decides which key a bounded container evicts when it is
+// full, so capacity limits can be combined with any replacement strategy
+// instead of being built into each cache.
+//
+// The container reports what happens to its keys and asks for a victim
+// when it needs room; the policy only tracks keys and never touches the
+// container. Keys are whatever identifies an element to the container:
+// map keys, or node handles for lists that may hold duplicate values.
+//
+// Implementations need not be safe for concurrent use; containers call
+// them under their own synchronization, if any.
+type EvictionPolicy[T comparable] interface {
+	// Add starts tracking a key the container has just stored. Adding a
+	// tracked key counts as an access.
+	Add(key T)
+
+	// Access records that the container read or replaced the value of a
+	// tracked key. Untracked keys are ignored.
+	Access(key T)
+
+	// Remove stops tracking a key the container removed on its own, other
+	// than by evicting it. Untracked keys are ignored.
+	Remove(key T)
+
+	// Evict chooses the key to evict next and stops tracking it.
+	// Returns false if no keys are tracked.
+	Evict() (T, bool)
+}
+
+// Links a tracked key into a circular list with a sentinel.
+type policyNode[T comparable] struct {
+	key    T
+	prev   *policyNode[T]
+	next   *policyNode[T]
+	bucket *lfuBucket[T] // Owning frequency bucket, LFUPolicy only
+}
+
+// Makes the node an empty ring, for use as a sentinel.
+func (n *policyNode[T]) init() {
+	n.prev, n.next = n, n
+}
+
+// Links the node in immediately before the mark.
+func (n *policyNode[T]) linkBefore(mark *policyNode[T]) {
+	n.prev, n.next = mark.prev, mark
+	mark.prev.next = n
+	mark.prev = n
+}
+
+// Unlinks the node from its ring.
+func (n *policyNode[T]) unlink() {
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	n.prev, n.next = nil, nil // Help GC
+}
+
+// Tracks keys in the order they were added, oldest at the front.
+type policyQueue[T comparable] struct {
+	nodes map[T]*policyNode[T]
+	root  policyNode[T] // Sentinel: root.next is the oldest key
+}
+
+// Prepares an empty queue in place.
+func (q *policyQueue[T]) init() {
+	q.nodes = make(map[T]*policyNode[T])
+	q.root.init()
+}
+
+// Appends an untracked key to the back. Returns false if the key is
+// already tracked.
+func (q *policyQueue[T]) push(key T) bool {
+	if _, ok := q.nodes[key]; ok {
+		return false
+	}
+
+	n := &policyNode[T]{key: key}
+	n.linkBefore(&q.root)
+	q.nodes[key] = n
+	return true
+}
+
+// Moves a tracked key to the back.
+func (q *policyQueue[T]) moveToBack(key T) {
+	if n, ok := q.nodes[key]; ok {
+		n.unlink()
+		n.linkBefore(&q.root)
+	}
+}
+
+// Stops tracking the key.
+func (q *policyQueue[T]) remove(key T) {
+	if n, ok := q.nodes[key]; ok {
+		n.unlink()
+		delete(q.nodes, key)
+	}
+}
+
+// Removes and returns the key at the front.
+func (q *policyQueue[T]) pop() (T, bool) {
+	if q.root.next == &q.root {
+		var zero T
+		return zero, false
+	}
+
+	key := q.root.next.key
+	q.remove(key)
+	return key, true
+}
+
+// LRUPolicy evicts the least recently used key: the one added or accessed
+// longest ago.
+//
+// Keys are kept in a linked list from least to most recently used, with a
+// map from key to node, so every operation takes O(1).
+//
+// Space complexity: O(n) where n is the number of tracked keys.
+type LRUPolicy[T comparable] struct {
+	queue policyQueue[T]
+}
+
+// NewLRUPolicy creates a least recently used policy tracking no keys.
+//
+// Example:
+//
+//	p := NewLRUPolicy[string]()
+//	p.Add("a")
+//	p.Add("b")
+//	p.Access("a")
+//	p.Evict()  // Returns "b"
+func NewLRUPolicy[T comparable]() *LRUPolicy[T] {
+	p := &LRUPolicy[T]{}
+	p.queue.init()
+	return p
+}
+
+// Add starts tracking the key as the most recently used.
+//
+// Time complexity: O(1)
+func (p *LRUPolicy[T]) Add(key T) {
+	if !p.queue.push(key) {
+		p.queue.moveToBack(key)
+	}
+}
+
+// Access marks the key as the most recently used.
+//
+// Time complexity: O(1)
+func (p *LRUPolicy[T]) Access(key T) {
+	p.queue.moveToBack(key)
+}
+
+// Remove stops tracking the key.
+//
+// Time complexity: O(1)
+func (p *LRUPolicy[T]) Remove(key T) {
+	p.queue.remove(key)
+}
+
+// Evict stops tracking and returns the least recently used key.
+// Returns false if no keys are tracked.
+//
+// Time complexity: O(1)
+func (p *LRUPolicy[T]) Evict() (T, bool) {
+	return p.queue.pop()
+}
+
+// FIFOPolicy evicts the key added longest ago, whatever its use since.
+//
+// Keys are kept in a linked list in the order they were added, with a map
+// from key to node, so every operation takes O(1).
+//
+// Space complexity: O(n) where n is the number of tracked keys.
+type FIFOPolicy[T comparable] struct {
+	queue policyQueue[T]
+}
+
+// NewFIFOPolicy creates a first-in first-out policy tracking no keys.
+//
+// Example:
+//
+//	p := NewFIFOPolicy[string]()
+//	p.Add("a")
+//	p.Add("b")
+//	p.Access("a")
+//	p.Evict()  // Returns "a"
+func NewFIFOPolicy[T comparable]() *FIFOPolicy[T] {
+	p := &FIFOPolicy[T]{}
+	p.queue.init()
+	return p
+}
+
+// Add starts tracking the key as the newest. Adding a tracked key keeps
+// its position.
+//
+// Time complexity: O(1)
+func (p *FIFOPolicy[T]) Add(key T) {
+	p.queue.push(key)
+}
+
+// Access does nothing: the order of eviction ignores use.
+func (p *FIFOPolicy[T]) Access(key T) {}
+
+// Remove stops tracking the key.
+//
+// Time complexity: O(1)
+func (p *FIFOPolicy[T]) Remove(key T) {
+	p.queue.remove(key)
+}
+
+// Evict stops tracking and returns the oldest key.
+// Returns false if no keys are tracked.
+//
+// Time complexity: O(1)
+func (p *FIFOPolicy[T]) Evict() (T, bool) {
+	return p.queue.pop()
+}
+
+// Holds the keys that were used the same number of times, oldest first.
+type lfuBucket[T comparable] struct {
+	count int
+	keys  policyNode[T] // Sentinel: keys.next is the least recently used
+	prev  *lfuBucket[T]
+	next  *lfuBucket[T]
+}
+
+// LFUPolicy evicts the least frequently used key, counting the Add and
+// every Access. Ties go to the least recently used of the keys.
+//
+// Keys with equal counts share a bucket, and buckets are linked in
+// ascending order of count, so the victim is always the oldest key of the
+// first bucket and an access moves a key to the next bucket. Every
+// operation takes O(1).
+//
+// Counts never decay: a key used heavily long ago outlives keys that are
+// new but active, which suits stable popularity and not shifting
+// workloads.
+//
+// Space complexity: O(n) where n is the number of tracked keys.
+type LFUPolicy[T comparable] struct {
+	nodes   map[T]*policyNode[T]
+	buckets lfuBucket[T] // Sentinel: buckets.next has the lowest count
+}
+
+// NewLFUPolicy creates a least frequently used policy tracking no keys.
+//
+// Example:
+//
+//	p := NewLFUPolicy[string]()
+//	p.Add("a")
+//	p.Add("b")
+//	p.Access("a")
+//	p.Access("b")
+//	p.Access("b")
+//	p.Evict()  // Returns "a"
+func NewLFUPolicy[T comparable]() *LFUPolicy[T] {
+	p := &LFUPolicy[T]{nodes: make(map[T]*policyNode[T])}
+	p.buckets.prev, p.buckets.next = &p.buckets, &p.buckets
+	return p
+}
+
+// Add starts tracking the key with a count of 1.
+//
+// Time complexity: O(1)
+func (p *LFUPolicy[T]) Add(key T) {
+	if _, ok := p.nodes[key]; ok {
+		p.Access(key)
+		return
+	}
+
+	first := p.bucketAfter(&p.buckets, 1)
+	n := &policyNode[T]{key: key, bucket: first}
+	n.linkBefore(&first.keys)
+	p.nodes[key] = n
+}
+
+// Access increments the count of the key.
+//
+// Time complexity: O(1)
+func (p *LFUPolicy[T]) Access(key T) {
+	n, ok := p.nodes[key]
+	if !ok {
+		return
+	}
+
+	old := n.bucket
+	next := p.bucketAfter(old, old.count+1)
+	n.unlink()
+	n.linkBefore(&next.keys)
+	n.bucket = next
+	p.dropIfEmpty(old)
+}
+
+// Remove stops tracking the key.
+//
+// Time complexity: O(1)
+func (p *LFUPolicy[T]) Remove(key T) {
+	n, ok := p.nodes[key]
+	if !ok {
+		return
+	}
+
+	n.unlink()
+	p.dropIfEmpty(n.bucket)
+	n.bucket = nil
+	delete(p.nodes, key)
+}
+
+// Evict stops tracking and returns the least frequently used key.
+// Returns false if no keys are tracked.
+//
+// Time complexity: O(1)
+func (p *LFUPolicy[T]) Evict() (T, bool) {
+	if p.buckets.next == &p.buckets {
+		var zero T
+		return zero, false
+	}
+
+	key := p.buckets.next.keys.next.key
+	p.Remove(key)
+	return key, true
+}
+
+// Returns the bucket for the count right after b, creating it if needed.
+func (p *LFUPolicy[T]) bucketAfter(b *lfuBucket[T], count int) *lfuBucket[T] {
+	if b.next != &p.buckets && b.next.count == count {
+		return b.next
+	}
+
+	next := &lfuBucket[T]{count: count, prev: b, next: b.next}
+	next.keys.init()
+	b.next.prev = next
+	b.next = next
+	return next
+}
+
+// Unlinks the bucket if it holds no keys.
+func (p *LFUPolicy[T]) dropIfEmpty(b *lfuBucket[T]) {
+	if b.keys.next == &b.keys {
+		b.prev.next = b.next
+		b.next.prev = b.prev
+		b.prev, b.next = nil, nil // Help GC
+	}
+}
+
+// RandomPolicy evicts a key chosen uniformly at random. It needs no
+// bookkeeping on access, and unlike LRU it has no access pattern that
+// makes it evict every key just before its next use.
+//
+// Keys are kept in a slice with a map from key to position; removal moves
+// the last key into the gap, so every operation takes O(1).
+//
+// Space complexity: O(n) where n is the number of tracked keys.
+type RandomPolicy[T comparable] struct {
+	keys  []T
+	index map[T]int
+	intN  func(n int) int
+}
+
+// NewRandomPolicy creates a random policy tracking no keys, drawing from
+// the global source of math/rand/v2.
+func NewRandomPolicy[T comparable]() *RandomPolicy[T] {
+	return &RandomPolicy[T]{index: make(map[T]int), intN: rand.IntN}
+}
+
+// NewRandomPolicyWithRand creates a random policy tracking no keys,
+// drawing from r, for reproducible evictions.
+//
+// Example:
+//
+//	p := NewRandomPolicyWithRand[string](rand.New(rand.NewPCG(1, 2)))
+func NewRandomPolicyWithRand[T comparable](r *rand.Rand) *RandomPolicy[T] {
+	return &RandomPolicy[T]{index: make(map[T]int), intN: r.IntN}
+}
+
+// Add starts tracking the key.
+//
+// Time complexity: O(1) amortized
+func (p *RandomPolicy[T]) Add(key T) {
+	if _, ok := p.index[key]; ok {
+		return
+	}
+
+	p.index[key] = len(p.keys)
+	p.keys = append(p.keys, key)
+}
+
+// Access does nothing: the choice of victim ignores use.
+func (p *RandomPolicy[T]) Access(key T) {}
+
+// Remove stops tracking the key.
+//
+// Time complexity: O(1)
+func (p *RandomPolicy[T]) Remove(key T) {
+	i, ok := p.index[key]
+	if !ok {
+		return
+	}
+
+	last := len(p.keys) - 1
+	p.keys[i] = p.keys[last]
+	p.index[p.keys[i]] = i
+
+	var zero T
+	p.keys[last] = zero // Help GC
+	p.keys = p.keys[:last]
+	delete(p.index, key)
+}
+
+// Evict stops tracking and returns a random key.
+// Returns false if no keys are tracked.
+//
+// Time complexity: O(1)
+func (p *RandomPolicy[T]) Evict() (T, bool) {
+	if len(p.keys) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	key := p.keys[p.intN(len(p.keys))]
+	p.Remove(key)
+	return key, true
+}
@@ -0,0 +1,228 @@
+package collections
+
+/*
+Test Coverage
+=============
+LRUPolicy:
+  ✓ Evicts least recently added or accessed, re-adding counts as access
+  ✓ Remove and untracked keys
+
+FIFOPolicy:
+  ✓ Evicts in insertion order, access and re-adding ignored
+  ✓ Remove and untracked keys
+
+LFUPolicy:
+  ✓ Evicts least frequently used, ties to least recently used
+  ✓ Remove and untracked keys
+  ✓ Matches a brute-force model on random operations
+
+RandomPolicy:
+  ✓ Evicts every key exactly once, seeded sources reproduce the order
+  ✓ Remove and untracked keys
+
+All policies:
+  ✓ Evict on no tracked keys
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Evicts every key tracked by the policy, in eviction order.
+func drainPolicy[T comparable](p EvictionPolicy[T]) []T {
+	var keys []T
+	for {
+		key, ok := p.Evict()
+		if !ok {
+			return keys
+		}
+
+		keys = append(keys, key)
+	}
+}
+
+// Verifies LRU evicts the key added or accessed longest ago
+func TestLRUPolicy(t *testing.T) {
+	p := NewLRUPolicy[string]()
+	p.Add("a")
+	p.Add("b")
+	p.Add("c")
+	p.Access("a")
+	p.Add("b")
+
+	test.GotWantSlice(t, drainPolicy(p), []string{"c", "a", "b"})
+}
+
+// Verifies LRU forgets removed keys and ignores untracked ones
+func TestLRUPolicy_Remove(t *testing.T) {
+	p := NewLRUPolicy[string]()
+	p.Add("a")
+	p.Add("b")
+	p.Remove("a")
+	p.Remove("x")
+	p.Access("x")
+
+	test.GotWantSlice(t, drainPolicy(p), []string{"b"})
+}
+
+// Verifies FIFO evicts in insertion order whatever the use since
+func TestFIFOPolicy(t *testing.T) {
+	p := NewFIFOPolicy[string]()
+	p.Add("a")
+	p.Add("b")
+	p.Add("c")
+	p.Access("a")
+	p.Add("a")
+
+	test.GotWantSlice(t, drainPolicy(p), []string{"a", "b", "c"})
+}
+
+// Verifies FIFO forgets removed keys and ignores untracked ones
+func TestFIFOPolicy_Remove(t *testing.T) {
+	p := NewFIFOPolicy[string]()
+	p.Add("a")
+	p.Add("b")
+	p.Add("c")
+	p.Remove("b")
+	p.Remove("x")
+
+	test.GotWantSlice(t, drainPolicy(p), []string{"a", "c"})
+}
+
+// Verifies LFU evicts the least used key, the least recently used first
+// among equals
+func TestLFUPolicy(t *testing.T) {
+	p := NewLFUPolicy[string]()
+	p.Add("a")
+	p.Add("b")
+	p.Add("c")
+	p.Add("d")
+	p.Access("c")
+	p.Access("a")
+	p.Access("c")
+	p.Add("b")
+
+	// Counts: a=2, b=2, c=3, d=1
+	test.GotWantSlice(t, drainPolicy(p), []string{"d", "a", "b", "c"})
+}
+
+// Verifies LFU forgets removed keys and ignores untracked ones
+func TestLFUPolicy_Remove(t *testing.T) {
+	p := NewLFUPolicy[string]()
+	p.Add("a")
+	p.Add("b")
+	p.Access("b")
+	p.Remove("a")
+	p.Remove("x")
+	p.Access("x")
+	p.Add("c")
+
+	test.GotWantSlice(t, drainPolicy(p), []string{"c", "b"})
+}
+
+// Verifies LFU matches a model that scans every key for the lowest count
+// and oldest use
+func TestLFUPolicy_Randomized(t *testing.T) {
+	type usage struct{ count, last int }
+
+	r := rand.New(rand.NewPCG(1, 2))
+	p := NewLFUPolicy[int]()
+	model := map[int]usage{}
+	for step := range 5000 {
+		key := r.IntN(20)
+		switch r.IntN(4) {
+		case 0:
+			p.Add(key)
+			model[key] = usage{model[key].count + 1, step}
+		case 1:
+			p.Access(key)
+			if u, ok := model[key]; ok {
+				model[key] = usage{u.count + 1, step}
+			}
+		case 2:
+			p.Remove(key)
+			delete(model, key)
+		case 3:
+			want, found := -1, false
+			for k, u := range model {
+				w := model[want]
+				if !found || u.count < w.count || u.count == w.count && u.last < w.last {
+					want, found = k, true
+				}
+			}
+
+			got, ok := p.Evict()
+			test.GotWant(t, ok, found)
+			if found {
+				test.GotWant(t, got, want)
+				delete(model, want)
+			}
+		}
+	}
+}
+
+// Verifies a random policy evicts every tracked key exactly once, and in
+// the same order for equally seeded sources
+func TestRandomPolicy(t *testing.T) {
+	evict := func() []int {
+		p := NewRandomPolicyWithRand[int](rand.New(rand.NewPCG(1, 2)))
+		for i := range 100 {
+			p.Add(i)
+		}
+
+		p.Add(5)
+		p.Access(7)
+		return drainPolicy(p)
+	}
+
+	got := evict()
+	test.GotWantSlice(t, evict(), got)
+
+	want := make([]int, 100)
+	for i := range want {
+		want[i] = i
+	}
+
+	test.GotWantSlice(t, slices.Sorted(slices.Values(got)), want)
+}
+
+// Verifies a random policy forgets removed keys and ignores untracked ones
+func TestRandomPolicy_Remove(t *testing.T) {
+	p := NewRandomPolicy[string]()
+	p.Add("a")
+	p.Add("b")
+	p.Add("c")
+	p.Remove("a")
+	p.Remove("c")
+	p.Remove("x")
+
+	test.GotWantSlice(t, drainPolicy(p), []string{"b"})
+}
+
+// Verifies every policy reports when it tracks no keys
+func TestEvictionPolicy_Empty(t *testing.T) {
+	policies := map[string]EvictionPolicy[int]{
+		"LRU":    NewLRUPolicy[int](),
+		"LFU":    NewLFUPolicy[int](),
+		"FIFO":   NewFIFOPolicy[int](),
+		"Random": NewRandomPolicy[int](),
+	}
+
+	for name, p := range policies {
+		t.Run(name, func(t *testing.T) {
+			_, ok := p.Evict()
+			test.GotWant(t, ok, false)
+
+			p.Add(1)
+			key, ok := p.Evict()
+			test.GotWant(t, ok, true)
+			test.GotWant(t, key, 1)
+			_, ok = p.Evict()
+			test.GotWant(t, ok, false)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package collections
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Page is one page of the elements of a collection, along with what a
+// paginated API needs to describe it: its position, the page size and the
+// number of elements in the whole collection.
+//
+// Pages are numbered from 0. A page past the end of the collection is
+// valid and holds no items, so clients can tell "no more results" from an
+// error.
+//
+// Example:
+//
+//	p := arr.Page(1, 10)  // Elements 10 to 19
+//	p.Pages()             // Returns 3 for 25 elements
+//	p.HasNext()           // Returns true
+type Page[T any] struct {
+	Items []T // Elements of the page, at most Size
+	Index int // Zero-based position of the page
+	Size  int // Requested number of elements per page
+	Total int // Number of elements in the collection
+}
+
+// Pages returns the number of pages needed to hold every element, 0 for
+// an empty collection.
+func (p Page[T]) Pages() int {
+	return (p.Total + p.Size - 1) / p.Size
+}
+
+// HasNext returns true if a page after this one holds elements.
+func (p Page[T]) HasNext() bool {
+	return p.Index < p.Pages()-1
+}
+
+// HasPrevious returns true if this is not the first page.
+func (p Page[T]) HasPrevious() bool {
+	return p.Index > 0
+}
+
+// PageBounds returns the positions [start, end) of the elements on page
+// index of a collection of total elements, split into pages of size
+// elements. Both are total for a page past the end.
+//
+// Panics if index is negative or size is not positive.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	PageBounds(2, 10, 25)  // Returns 20, 25
+func PageBounds(index int, size int, total int) (int, int) {
+	panics.RequireNonNegative(index, "page index")
+	panics.RequireGreaterThan(size, 0, "page size")
+
+	if index > total/size {
+		return total, total
+	}
+
+	start := index * size
+	return start, min(start+size, total)
+}
+
+// Paginate returns page index of the values, given as a sequence of total
+// elements, split into pages of size elements. The values before the page
+// are skipped and those after it are not read.
+//
+// Panics if index is negative or size is not positive.
+//
+// Time complexity: O(start + size) where start is the position of the
+// first element on the page
+//
+// Example:
+//
+//	Paginate(l.All(), l.Size(), 0, 2)  // First two elements of l
+func Paginate[T any](values iter.Seq[T], total int, index int, size int) Page[T] {
+	start, end := PageBounds(index, size, total)
+	items := make([]T, 0, end-start)
+	if start < end {
+		i := 0
+		for v := range values {
+			if i >= start {
+				items = append(items, v)
+				if len(items) == end-start {
+					break
+				}
+			}
+			i++
+		}
+	}
+
+	return Page[T]{Items: items, Index: index, Size: size, Total: total}
+}
@@ -0,0 +1,103 @@
+package collections
+
+/*
+Test Coverage
+=============
+PageBounds:
+  ✓ First, middle, last and past-the-end pages
+  ✓ Invalid index and size (panic)
+  ✓ Huge index does not overflow
+
+Paginate:
+  ✓ Items of the page, values after it not read
+  ✓ Empty collection and page past the end
+
+Page:
+  ✓ Pages, HasNext and HasPrevious
+*/
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the bounds of the first, middle, last and past-the-end pages
+func TestPageBounds(t *testing.T) {
+	for _, c := range []struct {
+		index, size, total int
+		start, end         int
+	}{
+		{0, 10, 25, 0, 10},
+		{1, 10, 25, 10, 20},
+		{2, 10, 25, 20, 25},
+		{3, 10, 25, 25, 25},
+		{0, 10, 0, 0, 0},
+		{1, 5, 10, 5, 10},
+		{2, 5, 10, 10, 10},
+		{math.MaxInt, 2, 10, 10, 10},
+	} {
+		start, end := PageBounds(c.index, c.size, c.total)
+		test.GotWant(t, start, c.start)
+		test.GotWant(t, end, c.end)
+	}
+}
+
+// Verifies a negative index and a non-positive size panic
+func TestPageBounds_Invalid(t *testing.T) {
+	test.GotWantPanic(t, func() { PageBounds(-1, 10, 5) }, `"page index" must be >= 0, got -1`)
+	test.GotWantPanic(t, func() { PageBounds(0, 0, 5) }, `"page size" must be > 0, got 0`)
+}
+
+// Verifies Paginate returns the items of the page without reading past it
+func TestPaginate(t *testing.T) {
+	read := 0
+	values := func(yield func(int) bool) {
+		for i := range 10 {
+			read++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	p := Paginate(values, 10, 1, 3)
+	test.GotWantSlice(t, p.Items, []int{3, 4, 5})
+	test.GotWant(t, p.Index, 1)
+	test.GotWant(t, p.Size, 3)
+	test.GotWant(t, p.Total, 10)
+	test.GotWant(t, read, 6)
+}
+
+// Verifies pages of an empty collection and past the end hold no items
+func TestPaginate_Empty(t *testing.T) {
+	p := Paginate(slices.Values([]int{}), 0, 0, 3)
+	test.GotWant(t, len(p.Items), 0)
+
+	p = Paginate(slices.Values([]int{1, 2}), 2, 5, 3)
+	test.GotWant(t, len(p.Items), 0)
+	test.GotWant(t, p.Total, 2)
+}
+
+// Verifies the page count and the neighbor checks
+func TestPage_Navigation(t *testing.T) {
+	for _, c := range []struct {
+		index, size, total int
+		pages              int
+		next, previous     bool
+	}{
+		{0, 10, 25, 3, true, false},
+		{1, 10, 25, 3, true, true},
+		{2, 10, 25, 3, false, true},
+		{3, 10, 25, 3, false, true},
+		{0, 10, 20, 2, true, false},
+		{0, 10, 0, 0, false, false},
+	} {
+		p := Page[int]{Index: c.index, Size: c.size, Total: c.total}
+		test.GotWant(t, p.Pages(), c.pages)
+		test.GotWant(t, p.HasNext(), c.next)
+		test.GotWant(t, p.HasPrevious(), c.previous)
+	}
+}
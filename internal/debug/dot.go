@@ -0,0 +1,114 @@
+// Package debug renders the internal layout of structures, for
+// investigating corrupted links and for teaching material.
+package debug
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DOT builds a graph in the Graphviz DOT language. Structures add their
+// nodes and pointers with Node and Edge, then write the graph with
+// WriteTo; render it with `dot -Tsvg`.
+//
+// Nodes are identified by keys of any comparable type, usually node
+// pointers, and receive the ids n0, n1, ... in the order they are first
+// seen, so the output of one structure is the same on every run.
+//
+// Example:
+//
+//	d := NewDOT(true)
+//	d.Node(a, "1")
+//	d.Node(b, "2")
+//	d.Edge(a, b, "next")
+//	d.WriteTo(os.Stdout)
+//	// digraph {
+//	//     n0 [label="1"];
+//	//     n1 [label="2"];
+//	//     n0 -> n1 [label="next"];
+//	// }
+type DOT struct {
+	directed bool
+	ids      map[any]int
+	added    map[any]bool // Keys passed to Node
+	lines    []string
+}
+
+// NewDOT creates an empty graph. A directed graph draws its edges as
+// arrows, which suits pointers; an undirected one draws plain lines.
+func NewDOT(directed bool) *DOT {
+	return &DOT{directed: directed, ids: make(map[any]int), added: make(map[any]bool)}
+}
+
+// Node adds a node for the key with the label.
+// Returns true if the node was added, false if the key was already
+// added, in which case the node is left unchanged. Walkers stop at a
+// false result, so a cycle in corrupted links is drawn instead of
+// followed forever.
+//
+// Time complexity: O(1) expected
+func (d *DOT) Node(key any, label string) bool {
+	if d.added[key] {
+		return false
+	}
+
+	d.added[key] = true
+	d.lines = append(d.lines, fmt.Sprintf("%s [label=%s];", d.id(key), strconv.Quote(label)))
+	return true
+}
+
+// Edge adds an edge between the nodes of two keys, with the label unless
+// it is empty. Keys without a node yet receive an id, and Graphviz draws
+// them as nodes labeled with that id.
+//
+// Time complexity: O(1) expected
+func (d *DOT) Edge(from any, to any, label string) {
+	op := "--"
+	if d.directed {
+		op = "->"
+	}
+
+	line := fmt.Sprintf("%s %s %s", d.id(from), op, d.id(to))
+	if label != "" {
+		line += " [label=" + strconv.Quote(label) + "]"
+	}
+
+	d.lines = append(d.lines, line+";")
+}
+
+// WriteTo writes the graph in the DOT language, nodes and edges in the
+// order they were added.
+// Returns the number of bytes written and the first write error.
+//
+// Time complexity: O(n + e) where n is the number of nodes and e the
+// number of edges
+func (d *DOT) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	if d.directed {
+		b.WriteString("digraph {\n")
+	} else {
+		b.WriteString("graph {\n")
+	}
+	for _, line := range d.lines {
+		b.WriteString("    ")
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	b.WriteString("}\n")
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// Returns the id of the key's node, assigning the next one on first use.
+func (d *DOT) id(key any) string {
+	id, ok := d.ids[key]
+	if !ok {
+		id = len(d.ids)
+		d.ids[key] = id
+	}
+
+	return "n" + strconv.Itoa(id)
+}
@@ -0,0 +1,83 @@
+package debug
+
+/*
+Test Coverage
+=============
+Node/Edge/WriteTo:
+  ✓ Empty directed and undirected graphs
+  ✓ Ids in first-seen order, labels quoted and escaped
+  ✓ Duplicate nodes rejected, edges with and without labels
+  ✓ Edges to keys without a node
+  ✓ Write errors are returned
+*/
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Represents a writer that always fails.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+// Verifies empty graphs of both kinds
+func TestDOT_Empty(t *testing.T) {
+	var b strings.Builder
+	n, err := NewDOT(true).WriteTo(&b)
+	test.GotWantNoError(t, err)
+	test.GotWant(t, b.String(), "digraph {\n}\n")
+	test.GotWant(t, n, int64(b.Len()))
+
+	b.Reset()
+	NewDOT(false).WriteTo(&b)
+	test.GotWant(t, b.String(), "graph {\n}\n")
+}
+
+// Verifies node ids, label escaping, duplicate nodes and edges
+func TestDOT_NodesAndEdges(t *testing.T) {
+	a, c := new(int), new(int)
+	d := NewDOT(true)
+	test.GotWant(t, d.Node(a, `say "hi"`), true)
+	test.GotWant(t, d.Node(c, "line\nbreak"), true)
+	test.GotWant(t, d.Node(a, "again"), false)
+	d.Edge(a, c, "next")
+	d.Edge(c, a, "")
+	d.Edge(c, "dangling", "")
+
+	var b strings.Builder
+	d.WriteTo(&b)
+	test.GotWant(t, b.String(), `digraph {
+    n0 [label="say \"hi\""];
+    n1 [label="line\nbreak"];
+    n0 -> n1 [label="next"];
+    n1 -> n0;
+    n1 -> n2;
+}
+`)
+}
+
+// Verifies undirected edges are drawn as lines
+func TestDOT_Undirected(t *testing.T) {
+	d := NewDOT(false)
+	d.Node("a", "a")
+	d.Node("b", "b")
+	d.Edge("a", "b", "5")
+
+	var b strings.Builder
+	d.WriteTo(&b)
+	test.GotWant(t, b.String(), "graph {\n    n0 [label=\"a\"];\n    n1 [label=\"b\"];\n    n0 -- n1 [label=\"5\"];\n}\n")
+}
+
+// Verifies write errors are returned
+func TestDOT_WriteError(t *testing.T) {
+	d := NewDOT(true)
+	d.Node(1, "1")
+	_, err := d.WriteTo(failingWriter{})
+	test.GotWantError(t, err, "disk full")
+}
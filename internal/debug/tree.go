@@ -0,0 +1,62 @@
+package debug
+
+import (
+	"io"
+	"strings"
+)
+
+// TreeNode is a node of a tree drawn by PrintTree. The label carries the
+// node's content and annotations, such as its key and height.
+type TreeNode struct {
+	Label    string
+	Children []*TreeNode
+}
+
+// Tree is implemented by the tree-shaped structures that PrintTree can
+// draw. DebugTree returns a snapshot of the structure's current shape, or
+// nil if it is empty.
+type Tree interface {
+	DebugTree() *TreeNode
+}
+
+// PrintTree draws the tree to w as an indented outline, one node per
+// line, with box-drawing branches connecting every node to its children.
+// Structures label binary children with L: or R:, so a lone child still
+// shows its side.
+// Returns the first write error.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	PrintTree(os.Stdout, avl)
+//	// 2 (h=3, bf=-1)
+//	// ├── L: 1 (h=1, bf=0)
+//	// └── R: 3 (h=2, bf=-1)
+//	//     └── R: 4 (h=1, bf=0)
+func PrintTree(w io.Writer, t Tree) error {
+	var b strings.Builder
+	if root := t.DebugTree(); root == nil {
+		b.WriteString("(empty)\n")
+	} else {
+		b.WriteString(root.Label + "\n")
+		printChildren(&b, root, "")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Writes the children of n, each line starting with the prefix that
+// continues the branches of its ancestors.
+func printChildren(b *strings.Builder, n *TreeNode, prefix string) {
+	for i, c := range n.Children {
+		branch, indent := "├── ", "│   "
+		if i == len(n.Children)-1 {
+			branch, indent = "└── ", "    "
+		}
+
+		b.WriteString(prefix + branch + c.Label + "\n")
+		printChildren(b, c, prefix+indent)
+	}
+}
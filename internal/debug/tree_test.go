@@ -0,0 +1,65 @@
+package debug
+
+/*
+Test Coverage
+=============
+PrintTree:
+  ✓ Empty tree
+  ✓ Single node
+  ✓ Branches continue past nested children, last children close them
+  ✓ Write errors are returned
+*/
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Represents a tree with a fixed shape.
+type fixedTree struct {
+	root *TreeNode
+}
+
+func (f fixedTree) DebugTree() *TreeNode {
+	return f.root
+}
+
+// Verifies an empty tree is drawn as a placeholder
+func TestPrintTree_Empty(t *testing.T) {
+	var b strings.Builder
+	test.GotWantNoError(t, PrintTree(&b, fixedTree{}))
+	test.GotWant(t, b.String(), "(empty)\n")
+}
+
+// Verifies a single node is drawn without branches
+func TestPrintTree_Single(t *testing.T) {
+	var b strings.Builder
+	PrintTree(&b, fixedTree{&TreeNode{Label: "root"}})
+	test.GotWant(t, b.String(), "root\n")
+}
+
+// Verifies branches of earlier siblings continue past nested children
+func TestPrintTree_Nested(t *testing.T) {
+	root := &TreeNode{Label: "a", Children: []*TreeNode{
+		{Label: "b", Children: []*TreeNode{{Label: "d"}, {Label: "e"}}},
+		{Label: "c", Children: []*TreeNode{{Label: "f"}}},
+	}}
+
+	var b strings.Builder
+	PrintTree(&b, fixedTree{root})
+	test.GotWant(t, b.String(), `a
+├── b
+│   ├── d
+│   └── e
+└── c
+    └── f
+`)
+}
+
+// Verifies write errors are returned
+func TestPrintTree_WriteError(t *testing.T) {
+	err := PrintTree(failingWriter{}, fixedTree{&TreeNode{Label: "a"}})
+	test.GotWantError(t, err, "disk full")
+}
@@ -0,0 +1,116 @@
+// Package find provides predicate-based queries over the elements of any
+// structure.
+//
+// Every function takes a collections.Iterable, so the same query works on
+// lists, queues, stacks, sets and arrays, in the natural order of the
+// container. Queries stop reading elements as soon as their result is
+// known, and never modify the container.
+//
+// Example:
+//
+//	l := structures.NewDoublyLinkedList(3, 8, 5, 12)
+//	find.Any[int](l, func(v int) bool { return v > 10 })   // Returns true
+//	find.Find[int](l, func(v int) bool { return v > 4 })   // Returns 8, true
+//
+// To count the matching elements, use collections.CountFunc. For chains of
+// queries, such as filtering before counting, see package streams.
+package find
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Implemented by containers that can also yield their elements last to
+// first, which lets FindLast stop at the first match from the back.
+type backward[T any] interface {
+	Backward() iter.Seq[T]
+}
+
+// Any returns true if pred returns true for at least one element, false
+// for an empty container. It stops at the first match.
+//
+// Time complexity: O(n) calls of pred
+func Any[T any](src collections.Iterable[T], pred func(T) bool) bool {
+	_, found := Find(src, pred)
+	return found
+}
+
+// All returns true if pred returns true for every element, true for an
+// empty container. It stops at the first mismatch.
+//
+// Time complexity: O(n) calls of pred
+//
+// Example:
+//
+//	s := structures.NewHashSet(2, 4, 6)
+//	All[int](s, func(v int) bool { return v%2 == 0 })  // Returns true
+func All[T any](src collections.Iterable[T], pred func(T) bool) bool {
+	for v := range src.All() {
+		if !pred(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// None returns true if pred returns false for every element, true for an
+// empty container. It stops at the first match.
+//
+// Time complexity: O(n) calls of pred
+func None[T any](src collections.Iterable[T], pred func(T) bool) bool {
+	return !Any(src, pred)
+}
+
+// Find returns the first element for which pred returns true.
+// Returns false if no element matches.
+//
+// Time complexity: O(n) calls of pred
+//
+// Example:
+//
+//	q := structures.NewSliceQueue("ab", "cde", "fgh")
+//	Find[string](q, func(s string) bool { return len(s) == 3 })  // Returns "cde", true
+func Find[T any](src collections.Iterable[T], pred func(T) bool) (T, bool) {
+	for v := range src.All() {
+		if pred(v) {
+			return v, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// FindLast returns the last element for which pred returns true.
+// Returns false if no element matches.
+//
+// Containers with a Backward iterator, such as DoublyLinkedList,
+// RingDeque and StandardArray, are searched from the back and stop at the
+// first match; the others are read in full.
+//
+// Time complexity: O(n) calls of pred
+func FindLast[T any](src collections.Iterable[T], pred func(T) bool) (T, bool) {
+	if b, ok := src.(backward[T]); ok {
+		for v := range b.Backward() {
+			if pred(v) {
+				return v, true
+			}
+		}
+
+		var zero T
+		return zero, false
+	}
+
+	var last T
+	found := false
+	for v := range src.All() {
+		if pred(v) {
+			last, found = v, true
+		}
+	}
+
+	return last, found
+}
@@ -0,0 +1,184 @@
+package find
+
+/*
+Test Coverage
+=============
+Any/All/None:
+  ✓ Empty container
+  ✓ Matching, mismatching and mixed elements
+  ✓ Stop at the first element that decides the result
+
+Find/FindLast:
+  ✓ Empty container and no match
+  ✓ First and last of several matches
+  ✓ Backward iterator searched from the back, stops at the first match
+  ✓ Containers without a backward iterator read in full
+
+Structures:
+  ✓ Lists, queues, stacks, sets and arrays
+*/
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	arrays "github.com/apotourlyan/godatastructures/internal/arrays/structures"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	lists "github.com/apotourlyan/godatastructures/internal/lists/structures"
+	queues "github.com/apotourlyan/godatastructures/internal/queues/structures"
+	sets "github.com/apotourlyan/godatastructures/internal/sets/structures"
+	stacks "github.com/apotourlyan/godatastructures/internal/stacks/structures"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Represents a container that yields the values of a slice and counts
+// the values read.
+type counted struct {
+	values []int
+	reads  int
+}
+
+func (c *counted) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, v := range c.values {
+			c.reads++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Represents a counted container that can also yield its values last to
+// first.
+type reversible struct {
+	counted
+}
+
+func (r *reversible) Backward() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, v := range slices.Backward(r.values) {
+			r.reads++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func isEven(v int) bool { return v%2 == 0 }
+
+// Verifies the predicates over an empty container
+func TestFind_Empty(t *testing.T) {
+	src := &counted{}
+	test.GotWant(t, Any(src, isEven), false)
+	test.GotWant(t, All(src, isEven), true)
+	test.GotWant(t, None(src, isEven), true)
+
+	_, ok := Find(src, isEven)
+	test.GotWant(t, ok, false)
+	_, ok = FindLast(src, isEven)
+	test.GotWant(t, ok, false)
+	_, ok = FindLast(&reversible{}, isEven)
+	test.GotWant(t, ok, false)
+}
+
+// Verifies Any, All and None over matching, mismatching and mixed
+// elements
+func TestFind_AnyAllNone(t *testing.T) {
+	tests := []struct {
+		values         []int
+		any, all, none bool
+	}{
+		{[]int{2, 4, 6}, true, true, false},
+		{[]int{1, 3, 5}, false, false, true},
+		{[]int{1, 2, 3}, true, false, false},
+	}
+
+	for _, tt := range tests {
+		src := &counted{values: tt.values}
+		test.GotWant(t, Any(src, isEven), tt.any)
+		test.GotWant(t, All(src, isEven), tt.all)
+		test.GotWant(t, None(src, isEven), tt.none)
+	}
+}
+
+// Verifies Any, All, None and Find stop at the element that decides the
+// result
+func TestFind_ShortCircuit(t *testing.T) {
+	src := &counted{values: []int{1, 2, 3, 4}}
+	Any(src, isEven)
+	test.GotWant(t, src.reads, 2)
+
+	src.reads = 0
+	All(src, isEven)
+	test.GotWant(t, src.reads, 1)
+
+	src.reads = 0
+	None(src, isEven)
+	test.GotWant(t, src.reads, 2)
+
+	src.reads = 0
+	v, ok := Find(src, func(v int) bool { return v > 2 })
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 3)
+	test.GotWant(t, src.reads, 3)
+}
+
+// Verifies Find returns the first and FindLast the last of several
+// matches, and that neither finds a value that is absent
+func TestFind_FindFindLast(t *testing.T) {
+	src := &counted{values: []int{1, 2, 3, 4, 5}}
+	v, ok := Find(src, isEven)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 2)
+
+	v, ok = FindLast(src, isEven)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 4)
+
+	_, ok = Find(src, func(v int) bool { return v > 5 })
+	test.GotWant(t, ok, false)
+	_, ok = FindLast(src, func(v int) bool { return v > 5 })
+	test.GotWant(t, ok, false)
+}
+
+// Verifies FindLast searches containers with a backward iterator from the
+// back and reads the others in full
+func TestFind_FindLast_Backward(t *testing.T) {
+	forward := &counted{values: []int{1, 2, 3, 4, 5}}
+	FindLast(forward, isEven)
+	test.GotWant(t, forward.reads, 5)
+
+	back := &reversible{counted{values: []int{1, 2, 3, 4, 5}}}
+	v, ok := FindLast(back, isEven)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 4)
+	test.GotWant(t, back.reads, 2)
+}
+
+// Verifies the queries work alike across the structure categories
+func TestFind_Structures(t *testing.T) {
+	tests := map[string]collections.Iterable[int]{
+		"DoublyLinkedList": lists.NewDoublyLinkedList(1, 2, 3, 4),
+		"LinkedList":       lists.NewLinkedList(1, 2, 3, 4),
+		"SliceQueue":       queues.NewSliceQueue(1, 2, 3, 4),
+		"SliceStack":       stacks.NewSliceStack(4, 3, 2, 1),
+		"OrderedSet":       sets.NewOrderedSet(4, 2, 3, 1),
+		"StandardArray":    arrays.NewStandardArray(1, 2, 3, 4),
+	}
+
+	for name, src := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.GotWant(t, Any(src, isEven), true)
+			test.GotWant(t, All(src, func(v int) bool { return v > 0 }), true)
+			test.GotWant(t, None(src, func(v int) bool { return v > 4 }), true)
+
+			v, _ := Find(src, isEven)
+			test.GotWant(t, v, 2)
+			v, _ = FindLast(src, isEven)
+			test.GotWant(t, v, 4)
+		})
+	}
+}
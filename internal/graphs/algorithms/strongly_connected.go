@@ -0,0 +1,153 @@
+package algorithms
+
+import (
+	"slices"
+
+	graphs "github.com/apotourlyan/godatastructures/internal/graphs/structures"
+	stacks "github.com/apotourlyan/godatastructures/internal/stacks/structures"
+)
+
+// Condensation is the DAG obtained by contracting every strongly connected
+// component of a graph into a single vertex.
+//
+// Vertex i of DAG stands for Components[i], and DAG has an edge from i to
+// j when the original graph has at least one edge from a vertex of
+// Components[i] to a vertex of Components[j]. Components are numbered in
+// topological order, so every edge of DAG goes from a lower to a higher
+// index.
+type Condensation[V comparable] struct {
+	Components [][]V
+	DAG        *graphs.AdjacencyListGraph[int, graphs.Unweighted]
+	component  map[V]int
+}
+
+// ComponentOf returns the index of the component containing the vertex.
+// Returns false if the vertex was not in the condensed graph.
+//
+// Time complexity: O(1) expected
+func (c *Condensation[V]) ComponentOf(v V) (int, bool) {
+	i, ok := c.component[v]
+	return i, ok
+}
+
+// StronglyConnectedComponents partitions the vertices into maximal sets in
+// which every vertex can reach every other, using Tarjan's algorithm.
+//
+// Components are returned in topological order of the condensation: no
+// edge leads from a later component back to an earlier one. Within a
+// component, vertices are listed in depth-first discovery order. In an
+// undirected graph the components are the connected components.
+//
+// Time complexity: O(V + E)
+//
+// Space complexity: O(V + E)
+//
+// Example:
+//
+//	// a -> b, b -> a, b -> c, c -> d, d -> c
+//	StronglyConnectedComponents(g)  // Returns [[a, b], [c, d]]
+func StronglyConnectedComponents[V comparable, W any](g graphs.Graph[V, W]) [][]V {
+	index := make(map[V]int, g.VertexCount())
+	lowlink := make(map[V]int, g.VertexCount())
+	onStack := make(map[V]bool, g.VertexCount())
+	pending := stacks.NewSliceStack[V]() // Visited vertices not yet assigned a component
+	components := [][]V{}
+
+	discover := func(v V) *dfsFrame[V] {
+		index[v] = len(index)
+		lowlink[v] = index[v]
+		onStack[v] = true
+		pending.Push(v)
+		return &dfsFrame[V]{vertex: v, successors: slices.Collect(g.Successors(v))}
+	}
+
+	for root := range g.Vertices() {
+		if _, ok := index[root]; ok {
+			continue
+		}
+
+		path := stacks.NewSliceStack(discover(root))
+		for !path.IsEmpty() {
+			top, _ := path.Peek()
+			v := top.vertex
+			if top.next < len(top.successors) {
+				w := top.successors[top.next]
+				top.next++
+				if _, ok := index[w]; !ok {
+					path.Push(discover(w))
+				} else if onStack[w] {
+					lowlink[v] = min(lowlink[v], index[w])
+				}
+				continue
+			}
+
+			// v is the root of a component: everything above it is in it
+			if lowlink[v] == index[v] {
+				component := []V{}
+				for {
+					w, _ := pending.Pop()
+					onStack[w] = false
+					component = append(component, w)
+					if w == v {
+						break
+					}
+				}
+				slices.Reverse(component)
+				components = append(components, component)
+			}
+
+			path.Pop()
+			if parent, err := path.Peek(); err == nil {
+				lowlink[parent.vertex] = min(lowlink[parent.vertex], lowlink[v])
+			}
+		}
+	}
+
+	// Tarjan's algorithm completes sink components first
+	slices.Reverse(components)
+	return components
+}
+
+// Condense contracts every strongly connected component of the graph into
+// a single vertex, producing the DAG of dependencies between components.
+// See Condensation for how the result is numbered. Edges within a
+// component are dropped and parallel edges between two components are
+// merged into one.
+//
+// Time complexity: O(V + E)
+//
+// Space complexity: O(V + E)
+//
+// Example:
+//
+//	// a -> b, b -> a, b -> c, c -> d, d -> c
+//	c := Condense(g)
+//	c.Components       // Returns [[a, b], [c, d]]
+//	c.DAG.HasEdge(0, 1)  // Returns true
+//	c.ComponentOf("d")   // Returns 1, true
+func Condense[V comparable, W any](g graphs.Graph[V, W]) *Condensation[V] {
+	components := StronglyConnectedComponents(g)
+	c := &Condensation[V]{
+		Components: components,
+		DAG:        graphs.NewAdjacencyListGraph[int, graphs.Unweighted](),
+		component:  make(map[V]int, g.VertexCount()),
+	}
+
+	for i, component := range components {
+		c.DAG.AddVertex(i)
+		for _, v := range component {
+			c.component[v] = i
+		}
+	}
+
+	linked := make(map[[2]int]bool)
+	for e := range g.Edges() {
+		link := [2]int{c.component[e.From], c.component[e.To]}
+		if link[0] != link[1] && !linked[link] {
+			linked[link] = true
+			c.DAG.AddEdge(link[0], link[1], graphs.Unweighted{})
+		}
+	}
+
+	return c
+}
@@ -0,0 +1,188 @@
+package algorithms
+
+/*
+Test Coverage
+=============
+StronglyConnectedComponents:
+  ✓ Empty graph
+  ✓ DAG: every vertex is its own component, in topological order
+  ✓ Single cycle, self-loop
+  ✓ Several components in topological order, discovery order within
+  ✓ Undirected graph yields connected components
+  ✓ Randomized: matches mutual reachability, no backward edges
+
+Condense:
+  ✓ Component DAG edges, intra-component and parallel edges dropped
+  ✓ ComponentOf present and missing vertices
+  ✓ Condensation is acyclic and topologically numbered
+*/
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	graphs "github.com/apotourlyan/godatastructures/internal/graphs/structures"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the components partition the vertices, two vertices share a
+// component exactly when they reach each other, and no edge leads from a
+// later component to an earlier one.
+func checkComponents[V comparable, W any](t *testing.T, g graphs.Graph[V, W], components [][]V) {
+	t.Helper()
+	component := map[V]int{}
+	for i, c := range components {
+		for _, v := range c {
+			if _, ok := component[v]; ok {
+				t.Errorf("vertex %v is in several components", v)
+			}
+			component[v] = i
+		}
+	}
+	test.GotWant(t, len(component), g.VertexCount())
+
+	for u := range g.Vertices() {
+		reached := Reachable(g, u)
+		for v := range g.Vertices() {
+			mutual := reached.Contains(v) && IsReachable(g, v, u)
+			if mutual != (component[u] == component[v]) {
+				t.Errorf("%v and %v: mutual reachability %v, components %d and %d",
+					u, v, mutual, component[u], component[v])
+			}
+		}
+	}
+
+	for e := range g.Edges() {
+		if component[e.From] > component[e.To] {
+			t.Errorf("edge %v -> %v leads to an earlier component", e.From, e.To)
+		}
+	}
+}
+
+// Verifies an empty graph has no components
+func TestStronglyConnectedComponents_Empty(t *testing.T) {
+	test.GotWant(t, len(StronglyConnectedComponents(newDirected())), 0)
+}
+
+// Verifies every vertex of a DAG is its own component, in topological order
+func TestStronglyConnectedComponents_DAG(t *testing.T) {
+	g := newDirected([2]string{"c", "d"}, [2]string{"a", "b"}, [2]string{"b", "c"})
+	components := StronglyConnectedComponents(g)
+	test.GotWant(t, len(components), 4)
+	test.GotWantSlice(t, components[0], []string{"a"})
+	test.GotWantSlice(t, components[3], []string{"d"})
+	checkComponents(t, g, components)
+}
+
+// Verifies a single cycle forms one component and a self-loop does not
+// merge anything
+func TestStronglyConnectedComponents_Cycle(t *testing.T) {
+	g := newDirected([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}, [2]string{"c", "c"})
+	components := StronglyConnectedComponents(g)
+	test.GotWant(t, len(components), 1)
+	test.GotWantSlice(t, components[0], []string{"a", "b", "c"})
+
+	loop := newDirected([2]string{"a", "a"}, [2]string{"a", "b"})
+	components = StronglyConnectedComponents(loop)
+	test.GotWant(t, len(components), 2)
+	test.GotWantSlice(t, components[0], []string{"a"})
+}
+
+// Verifies several components come in topological order with vertices in
+// discovery order
+func TestStronglyConnectedComponents_Several(t *testing.T) {
+	g := newDirected(
+		[2]string{"a", "b"}, [2]string{"b", "a"}, [2]string{"b", "c"},
+		[2]string{"c", "d"}, [2]string{"d", "e"}, [2]string{"e", "c"},
+		[2]string{"a", "f"}, [2]string{"f", "e"},
+	)
+	components := StronglyConnectedComponents(g)
+	test.GotWant(t, len(components), 3)
+	test.GotWantSlice(t, components[0], []string{"a", "b"})
+	test.GotWantSlice(t, components[1], []string{"f"})
+	test.GotWantSlice(t, components[2], []string{"c", "d", "e"})
+	checkComponents(t, g, components)
+}
+
+// Verifies an undirected graph yields its connected components
+func TestStronglyConnectedComponents_Undirected(t *testing.T) {
+	g := newUndirected([2]string{"a", "b"}, [2]string{"c", "b"}, [2]string{"d", "e"})
+	g.AddVertex("f")
+	components := StronglyConnectedComponents(g)
+	test.GotWant(t, len(components), 3)
+	checkComponents(t, g, components)
+}
+
+// Verifies components of random graphs match mutual reachability
+func TestStronglyConnectedComponents_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(61, 62))
+	for range 100 {
+		g := graphs.NewAdjacencyListGraphWithConfig[int, int](graphs.AdjacencyListGraphConfig{
+			Directed:   true,
+			Multigraph: true,
+			SelfLoops:  graphs.SelfLoopsAllow,
+		})
+		n := 1 + r.IntN(20)
+		for v := range n {
+			g.AddVertex(v)
+		}
+		for range r.IntN(2 * n) {
+			g.AddEdge(r.IntN(n), r.IntN(n), 0)
+		}
+
+		checkComponents(t, g, StronglyConnectedComponents(g))
+	}
+}
+
+// Verifies the condensation links components once and drops edges inside
+// a component
+func TestCondense(t *testing.T) {
+	g := newDirected(
+		[2]string{"a", "b"}, [2]string{"b", "a"}, [2]string{"b", "c"}, [2]string{"a", "c"},
+		[2]string{"c", "d"}, [2]string{"d", "c"}, [2]string{"x", "c"},
+	)
+	c := Condense(g)
+
+	test.GotWant(t, len(c.Components), 3)
+	test.GotWant(t, c.DAG.VertexCount(), 3)
+	test.GotWant(t, c.DAG.EdgeCount(), 2)
+
+	ab, _ := c.ComponentOf("a")
+	cd, _ := c.ComponentOf("d")
+	x, _ := c.ComponentOf("x")
+	test.GotWant(t, c.DAG.HasEdge(ab, cd), true)
+	test.GotWant(t, c.DAG.HasEdge(x, cd), true)
+	test.GotWantSlice(t, c.Components[cd], []string{"c", "d"})
+
+	_, ok := c.ComponentOf("missing")
+	test.GotWant(t, ok, false)
+}
+
+// Verifies the condensation of random graphs is acyclic, numbered in
+// topological order, and preserves reachability between components
+func TestCondense_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(71, 72))
+	for range 100 {
+		g := graphs.NewAdjacencyListGraphWithConfig[int, int](graphs.AdjacencyListGraphConfig{
+			Directed:  true,
+			SelfLoops: graphs.SelfLoopsAllow,
+		})
+		n := 1 + r.IntN(20)
+		for range r.IntN(3 * n) {
+			g.AddEdge(r.IntN(n), r.IntN(n), 0)
+		}
+
+		c := Condense(g)
+		test.GotWant(t, HasCycle(c.DAG), false)
+		for e := range c.DAG.Edges() {
+			if e.From >= e.To {
+				t.Errorf("condensed edge %d -> %d is not in topological order", e.From, e.To)
+			}
+		}
+		for e := range g.Edges() {
+			from, _ := c.ComponentOf(e.From)
+			to, _ := c.ComponentOf(e.To)
+			test.GotWant(t, from == to || c.DAG.HasEdge(from, to), true)
+		}
+	}
+}
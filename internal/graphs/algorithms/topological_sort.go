@@ -0,0 +1,106 @@
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	graphs "github.com/apotourlyan/godatastructures/internal/graphs/structures"
+	queues "github.com/apotourlyan/godatastructures/internal/queues/structures"
+)
+
+const ErrorCycle = "graph contains a cycle"
+const ErrorUndirectedGraph = "graph is undirected"
+
+// Errors returned by the orderings that require a directed acyclic graph.
+// A *CycleError unwraps to ErrCycle.
+var (
+	ErrCycle           = errors.New(ErrorCycle)
+	ErrUndirectedGraph = errors.New(ErrorUndirectedGraph)
+)
+
+// CycleError reports a cycle that prevents an operation requiring an
+// acyclic graph. Cycle lists the vertices in path order; the last vertex
+// has an edge back to the first.
+type CycleError[V comparable] struct {
+	Cycle []V
+}
+
+// Error describes the cycle, for example "graph contains a cycle: a -> b -> a".
+func (e *CycleError[V]) Error() string {
+	var b strings.Builder
+	b.WriteString(ErrorCycle)
+	b.WriteString(": ")
+	for _, v := range e.Cycle {
+		fmt.Fprintf(&b, "%v -> ", v)
+	}
+	fmt.Fprint(&b, e.Cycle[0])
+	return b.String()
+}
+
+// Unwrap returns ErrCycle, so errors.Is(err, ErrCycle) detects any cycle.
+func (e *CycleError[V]) Unwrap() error {
+	return ErrCycle
+}
+
+// TopologicalSort returns the vertices of a directed acyclic graph in an
+// order where every edge leads from an earlier vertex to a later one,
+// using Kahn's algorithm.
+//
+// Vertices are released in the order the graph yields them whenever
+// several are ready, so the result is deterministic for a given graph.
+//
+// Returns a *CycleError identifying one cycle if the graph is not acyclic,
+// or an error if the graph is undirected.
+//
+// Time complexity: O(V + E)
+//
+// Space complexity: O(V)
+//
+// Example:
+//
+//	// shirt -> tie, tie -> jacket, trousers -> jacket
+//	TopologicalSort(g)  // Returns [shirt, trousers, tie, jacket], nil
+//
+//	// a -> b, b -> a
+//	_, err := TopologicalSort(g)
+//	err.Error()  // Returns "graph contains a cycle: a -> b -> a"
+func TopologicalSort[V comparable, W any](g graphs.Graph[V, W]) ([]V, error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirectedGraph
+	}
+
+	indegree := make(map[V]int, g.VertexCount())
+	for v := range g.Vertices() {
+		for w := range g.Successors(v) {
+			indegree[w]++
+		}
+	}
+
+	ready := queues.NewSliceQueue[V]()
+	for v := range g.Vertices() {
+		if indegree[v] == 0 {
+			ready.Enqueue(v)
+		}
+	}
+
+	order := make([]V, 0, g.VertexCount())
+	for !ready.IsEmpty() {
+		v, _ := ready.Dequeue()
+		order = append(order, v)
+		for w := range g.Successors(v) {
+			indegree[w]--
+			if indegree[w] == 0 {
+				ready.Enqueue(w)
+			}
+		}
+	}
+
+	// Vertices on or behind a cycle never reach in-degree zero
+	if len(order) < g.VertexCount() {
+		cycle, _ := FindCycle(g)
+		return nil, &CycleError[V]{Cycle: cycle}
+	}
+
+	return order, nil
+}
@@ -0,0 +1,160 @@
+package algorithms
+
+/*
+Test Coverage
+=============
+TopologicalSort:
+  ✓ Empty graph
+  ✓ Isolated vertices keep graph order
+  ✓ Dependency chain, ready vertices released in graph order
+  ✓ Parallel edges
+  ✓ Cycle reported as a CycleError naming the cycle
+  ✓ Self-loop reported as a one-vertex cycle
+  ✓ Vertices downstream of a cycle
+  ✓ Undirected graph rejected
+  ✓ Randomized: every edge points forward in the order
+
+CycleError:
+  ✓ Message lists the cycle and closes it
+  ✓ Matches ErrCycle
+*/
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	graphs "github.com/apotourlyan/godatastructures/internal/graphs/structures"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies every edge leads from an earlier vertex to a later one and
+// every vertex appears exactly once.
+func checkTopologicalOrder[V comparable, W any](t *testing.T, g graphs.Graph[V, W], order []V) {
+	t.Helper()
+	test.GotWant(t, len(order), g.VertexCount())
+
+	position := map[V]int{}
+	for i, v := range order {
+		position[v] = i
+	}
+	test.GotWant(t, len(position), len(order))
+
+	for e := range g.Edges() {
+		if position[e.From] >= position[e.To] {
+			t.Errorf("edge %v -> %v points backward", e.From, e.To)
+		}
+	}
+}
+
+// Verifies an empty graph sorts to an empty order
+func TestTopologicalSort_Empty(t *testing.T) {
+	order, err := TopologicalSort(newDirected())
+	test.GotWantNoError(t, err)
+	test.GotWant(t, len(order), 0)
+}
+
+// Verifies isolated vertices keep the order the graph yields them
+func TestTopologicalSort_IsolatedVertices(t *testing.T) {
+	g := newDirected()
+	for _, v := range []string{"c", "a", "b"} {
+		g.AddVertex(v)
+	}
+
+	order, err := TopologicalSort(g)
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, order, []string{"c", "a", "b"})
+}
+
+// Verifies dependencies come first and ready vertices are released in
+// graph order
+func TestTopologicalSort_Dependencies(t *testing.T) {
+	g := newDirected(
+		[2]string{"shirt", "tie"}, [2]string{"tie", "jacket"},
+		[2]string{"trousers", "jacket"}, [2]string{"trousers", "shoes"},
+		[2]string{"socks", "shoes"},
+	)
+
+	order, err := TopologicalSort(g)
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, order, []string{"shirt", "trousers", "socks", "tie", "shoes", "jacket"})
+	checkTopologicalOrder(t, g, order)
+}
+
+// Verifies parallel edges count once per edge toward in-degree
+func TestTopologicalSort_ParallelEdges(t *testing.T) {
+	g := newDirected([2]string{"a", "b"}, [2]string{"a", "b"}, [2]string{"b", "c"})
+	order, err := TopologicalSort(g)
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, order, []string{"a", "b", "c"})
+}
+
+// Verifies a cycle is reported as a CycleError naming its vertices
+func TestTopologicalSort_Cycle(t *testing.T) {
+	g := newDirected([2]string{"x", "a"}, [2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"})
+	order, err := TopologicalSort(g)
+	test.GotWant(t, order == nil, true)
+	test.GotWantError(t, err, "graph contains a cycle: a -> b -> c -> a")
+	test.GotWantErrorIs(t, err, ErrCycle)
+
+	var cycleErr *CycleError[string]
+	test.GotWant(t, errors.As(err, &cycleErr), true)
+	test.GotWantSlice(t, cycleErr.Cycle, []string{"a", "b", "c"})
+}
+
+// Verifies a self-loop is reported as a one-vertex cycle
+func TestTopologicalSort_SelfLoop(t *testing.T) {
+	g := newDirected([2]string{"a", "b"}, [2]string{"b", "b"})
+	_, err := TopologicalSort(g)
+	test.GotWantError(t, err, "graph contains a cycle: b -> b")
+}
+
+// Verifies a cycle is found even when every vertex outside it is
+// downstream of it
+func TestTopologicalSort_DownstreamOfCycle(t *testing.T) {
+	g := newDirected([2]string{"c", "d"}, [2]string{"a", "b"}, [2]string{"b", "a"}, [2]string{"b", "c"})
+	_, err := TopologicalSort(g)
+	test.GotWantError(t, err, "graph contains a cycle: a -> b -> a")
+}
+
+// Verifies undirected graphs are rejected
+func TestTopologicalSort_Undirected(t *testing.T) {
+	_, err := TopologicalSort(newUndirected([2]string{"a", "b"}))
+	test.GotWantErrorIs(t, err, ErrUndirectedGraph)
+}
+
+// Verifies random DAGs sort with every edge pointing forward and random
+// graphs with cycles report a real one
+func TestTopologicalSort_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(51, 52))
+	for range 200 {
+		g := graphs.NewAdjacencyListGraphWithConfig[int, int](graphs.AdjacencyListGraphConfig{
+			Directed:   true,
+			Multigraph: true,
+			SelfLoops:  graphs.SelfLoopsAllow,
+		})
+
+		// Hidden order defines which edges keep the graph acyclic
+		n := 1 + r.IntN(20)
+		rank := r.Perm(n)
+		cyclic := r.IntN(2) == 0
+		for range r.IntN(3 * n) {
+			from, to := r.IntN(n), r.IntN(n)
+			if cyclic || rank[from] < rank[to] {
+				g.AddEdge(from, to, 0)
+			}
+		}
+
+		order, err := TopologicalSort(g)
+		if err == nil {
+			checkTopologicalOrder(t, g, order)
+			continue
+		}
+
+		var cycleErr *CycleError[int]
+		if !cyclic || !errors.As(err, &cycleErr) {
+			t.Fatalf("unexpected error %v", err)
+		}
+		checkCycle(t, g, cycleErr.Cycle)
+	}
+}
@@ -0,0 +1,230 @@
+package algorithms
+
+import (
+	"iter"
+	"slices"
+
+	graphs "github.com/apotourlyan/godatastructures/internal/graphs/structures"
+	queues "github.com/apotourlyan/godatastructures/internal/queues/structures"
+	sets "github.com/apotourlyan/godatastructures/internal/sets/structures"
+	stacks "github.com/apotourlyan/godatastructures/internal/stacks/structures"
+)
+
+// Traversal state of a vertex during cycle detection.
+type visitState uint8
+
+const (
+	unvisited visitState = iota
+	active               // On the current depth-first path
+	finished             // All descendants explored
+)
+
+// Represents a vertex on the depth-first path with its remaining successors.
+type dfsFrame[V comparable] struct {
+	vertex     V
+	successors []V
+	next       int  // Index of the next successor to explore
+	skipParent bool // Undirected graphs: the tree edge back to the parent is still unseen
+}
+
+// BreadthFirst returns an iterator over the vertices reachable from start,
+// in breadth-first order: start, then its successors, then theirs.
+// Successors are visited in the order the graph yields them. Yields
+// nothing if start is not in the graph.
+//
+// The graph must not be modified during iteration.
+//
+// Time complexity: O(V + E) for a full iteration
+//
+// Space complexity: O(V) for the queue and visited set
+//
+// Example:
+//
+//	// a -> b, a -> c, b -> d
+//	for v := range BreadthFirst(g, "a") {
+//	    fmt.Println(v)  // a, b, c, d
+//	}
+func BreadthFirst[V comparable, W any](g graphs.Graph[V, W], start V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if !g.HasVertex(start) {
+			return
+		}
+
+		visited := sets.NewHashSet(start)
+		queue := queues.NewSliceQueue(start)
+		for !queue.IsEmpty() {
+			v, _ := queue.Dequeue()
+			if !yield(v) {
+				return
+			}
+
+			for w := range g.Successors(v) {
+				if visited.Add(w) {
+					queue.Enqueue(w)
+				}
+			}
+		}
+	}
+}
+
+// DepthFirst returns an iterator over the vertices reachable from start,
+// in depth-first preorder: each vertex is yielded before any vertex first
+// discovered through it, and successors are explored in the order the
+// graph yields them, matching a recursive traversal. Yields nothing if
+// start is not in the graph.
+//
+// The graph must not be modified during iteration.
+//
+// Time complexity: O(V + E) for a full iteration
+//
+// Space complexity: O(E) for the stack, O(V) for the visited set
+//
+// Example:
+//
+//	// a -> b, a -> c, b -> d
+//	for v := range DepthFirst(g, "a") {
+//	    fmt.Println(v)  // a, b, d, c
+//	}
+func DepthFirst[V comparable, W any](g graphs.Graph[V, W], start V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if !g.HasVertex(start) {
+			return
+		}
+
+		visited := sets.NewHashSet[V]()
+		stack := stacks.NewSliceStack(start)
+		for !stack.IsEmpty() {
+			v, _ := stack.Pop()
+			if !visited.Add(v) {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+
+			// Push in reverse so the first successor is explored first
+			successors := slices.Collect(g.Successors(v))
+			for _, w := range slices.Backward(successors) {
+				if !visited.Contains(w) {
+					stack.Push(w)
+				}
+			}
+		}
+	}
+}
+
+// Reachable returns the set of vertices reachable from start, including
+// start itself. Returns an empty set if start is not in the graph.
+//
+// Time complexity: O(V + E)
+//
+// Space complexity: O(V)
+func Reachable[V comparable, W any](g graphs.Graph[V, W], start V) *sets.HashSet[V] {
+	reached := sets.NewHashSet[V]()
+	for v := range BreadthFirst(g, start) {
+		reached.Add(v)
+	}
+
+	return reached
+}
+
+// IsReachable returns true if there is a path from one vertex to another.
+// Every vertex in the graph reaches itself. The search stops as soon as
+// the target is found.
+//
+// Time complexity: O(V + E) worst case
+//
+// Space complexity: O(V)
+func IsReachable[V comparable, W any](g graphs.Graph[V, W], from V, to V) bool {
+	for v := range BreadthFirst(g, from) {
+		if v == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FindCycle returns the vertices of a cycle in the graph, in path order
+// with the first vertex not repeated at the end. Returns false if the
+// graph is acyclic.
+//
+// In a directed graph a cycle follows edge directions. In an undirected
+// graph a cycle must not reuse an edge, so a single edge is not a cycle
+// but two parallel edges between the same vertices are. A self-loop is a
+// cycle of one vertex in both.
+//
+// Time complexity: O(V + E)
+//
+// Space complexity: O(V + E)
+//
+// Example:
+//
+//	// a -> b, b -> c, c -> a, c -> d
+//	FindCycle(g)  // Returns [a, b, c], true
+func FindCycle[V comparable, W any](g graphs.Graph[V, W]) ([]V, bool) {
+	state := make(map[V]visitState, g.VertexCount())
+	parent := make(map[V]V, g.VertexCount())
+	directed := g.IsDirected()
+
+	for root := range g.Vertices() {
+		if state[root] != unvisited {
+			continue
+		}
+
+		state[root] = active
+		stack := stacks.NewSliceStack(&dfsFrame[V]{
+			vertex:     root,
+			successors: slices.Collect(g.Successors(root)),
+		})
+		for !stack.IsEmpty() {
+			top, _ := stack.Peek()
+			if top.next == len(top.successors) {
+				state[top.vertex] = finished
+				stack.Pop()
+				continue
+			}
+
+			w := top.successors[top.next]
+			top.next++
+			if top.skipParent && w == parent[top.vertex] {
+				top.skipParent = false
+				continue
+			}
+
+			switch state[w] {
+			case unvisited:
+				state[w] = active
+				parent[w] = top.vertex
+				stack.Push(&dfsFrame[V]{
+					vertex:     w,
+					successors: slices.Collect(g.Successors(w)),
+					skipParent: !directed,
+				})
+			case active:
+				// Back edge: the path from w down to top closes a cycle
+				cycle := []V{top.vertex}
+				for v := top.vertex; v != w; {
+					v = parent[v]
+					cycle = append(cycle, v)
+				}
+				slices.Reverse(cycle)
+				return cycle, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// HasCycle returns true if the graph contains a cycle.
+// See FindCycle for what counts as a cycle in directed and undirected
+// graphs.
+//
+// Time complexity: O(V + E)
+//
+// Space complexity: O(V + E)
+func HasCycle[V comparable, W any](g graphs.Graph[V, W]) bool {
+	_, ok := FindCycle(g)
+	return ok
+}
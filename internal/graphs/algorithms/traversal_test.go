@@ -0,0 +1,275 @@
+package algorithms
+
+/*
+Test Coverage
+=============
+BreadthFirst:
+  ✓ Missing start vertex
+  ✓ Level order, successors in graph order, unreachable vertices skipped
+  ✓ Cycles and undirected edges visited once
+  ✓ Early termination
+
+DepthFirst:
+  ✓ Missing start vertex
+  ✓ Preorder matches a recursive traversal
+  ✓ Cycles visited once, early termination
+
+Reachable/IsReachable:
+  ✓ Reachable set, missing start
+  ✓ Self, forward, backward and missing vertices
+
+FindCycle/HasCycle:
+  ✓ Empty graph, DAG with shared descendants
+  ✓ Directed cycle is returned in path order
+  ✓ Self-loops
+  ✓ Undirected trees, undirected cycles, parallel edges
+  ✓ Randomized: returned cycles are real cycles, DAGs report none
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	graphs "github.com/apotourlyan/godatastructures/internal/graphs/structures"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns a directed graph with the given edges.
+func newDirected(edges ...[2]string) *graphs.AdjacencyListGraph[string, graphs.Unweighted] {
+	g := graphs.NewAdjacencyListGraphWithConfig[string, graphs.Unweighted](graphs.AdjacencyListGraphConfig{
+		Directed:   true,
+		Multigraph: true,
+		SelfLoops:  graphs.SelfLoopsAllow,
+	})
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1], graphs.Unweighted{})
+	}
+	return g
+}
+
+// Returns an undirected graph with the given edges.
+func newUndirected(edges ...[2]string) *graphs.AdjacencyListGraph[string, graphs.Unweighted] {
+	g := graphs.NewAdjacencyListGraphWithConfig[string, graphs.Unweighted](graphs.AdjacencyListGraphConfig{
+		Multigraph: true,
+		SelfLoops:  graphs.SelfLoopsAllow,
+	})
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1], graphs.Unweighted{})
+	}
+	return g
+}
+
+// Verifies the cycle is non-empty, has no repeated vertex and every
+// consecutive pair, including last to first, is connected by an edge.
+func checkCycle[V comparable, W any](t *testing.T, g graphs.Graph[V, W], cycle []V) {
+	t.Helper()
+	if len(cycle) == 0 {
+		t.Fatalf("cycle is empty")
+	}
+
+	seen := map[V]bool{}
+	for i, v := range cycle {
+		if seen[v] {
+			t.Errorf("vertex %v repeats in cycle %v", v, cycle)
+		}
+		seen[v] = true
+		if next := cycle[(i+1)%len(cycle)]; !g.HasEdge(v, next) {
+			t.Errorf("cycle %v has no edge %v -> %v", cycle, v, next)
+		}
+	}
+}
+
+// Verifies traversal from a missing vertex yields nothing
+func TestBreadthFirst_MissingStart(t *testing.T) {
+	g := newDirected([2]string{"a", "b"})
+	test.GotWant(t, len(slices.Collect(BreadthFirst(g, "x"))), 0)
+	test.GotWant(t, len(slices.Collect(DepthFirst(g, "x"))), 0)
+}
+
+// Verifies vertices are visited level by level in successor order, and
+// unreachable vertices are skipped
+func TestBreadthFirst_Order(t *testing.T) {
+	g := newDirected(
+		[2]string{"a", "b"}, [2]string{"a", "c"},
+		[2]string{"b", "d"}, [2]string{"c", "e"}, [2]string{"b", "e"},
+		[2]string{"d", "f"}, [2]string{"x", "a"},
+	)
+	test.GotWantSlice(t, slices.Collect(BreadthFirst(g, "a")), []string{"a", "b", "c", "d", "e", "f"})
+	test.GotWantSlice(t, slices.Collect(BreadthFirst(g, "d")), []string{"d", "f"})
+}
+
+// Verifies cycles and undirected edges do not cause repeated visits
+func TestBreadthFirst_Cycles(t *testing.T) {
+	directed := newDirected([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}, [2]string{"c", "c"})
+	test.GotWantSlice(t, slices.Collect(BreadthFirst(directed, "b")), []string{"b", "c", "a"})
+
+	undirected := newUndirected([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"a", "c"})
+	test.GotWantSlice(t, slices.Collect(BreadthFirst(undirected, "c")), []string{"c", "b", "a"})
+}
+
+// Verifies traversal stops when the consumer breaks
+func TestBreadthFirst_EarlyTermination(t *testing.T) {
+	g := newDirected([2]string{"a", "b"}, [2]string{"b", "c"})
+	visited := []string{}
+	for v := range BreadthFirst(g, "a") {
+		visited = append(visited, v)
+		if v == "b" {
+			break
+		}
+	}
+	test.GotWantSlice(t, visited, []string{"a", "b"})
+}
+
+// Verifies depth-first preorder matches a recursive traversal that
+// explores successors in graph order
+func TestDepthFirst_Order(t *testing.T) {
+	g := newDirected(
+		[2]string{"a", "b"}, [2]string{"a", "c"},
+		[2]string{"b", "d"}, [2]string{"d", "c"}, [2]string{"c", "e"},
+	)
+	test.GotWantSlice(t, slices.Collect(DepthFirst(g, "a")), []string{"a", "b", "d", "c", "e"})
+
+	// Recursive reference traversal
+	want := []string{}
+	seen := map[string]bool{}
+	var visit func(v string)
+	visit = func(v string) {
+		seen[v] = true
+		want = append(want, v)
+		for w := range g.Successors(v) {
+			if !seen[w] {
+				visit(w)
+			}
+		}
+	}
+	visit("a")
+	test.GotWantSlice(t, slices.Collect(DepthFirst(g, "a")), want)
+}
+
+// Verifies cycles are visited once and traversal stops when the consumer
+// breaks
+func TestDepthFirst_Cycles(t *testing.T) {
+	g := newUndirected([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}, [2]string{"a", "d"})
+	test.GotWantSlice(t, slices.Collect(DepthFirst(g, "a")), []string{"a", "b", "c", "d"})
+
+	count := 0
+	for range DepthFirst(g, "a") {
+		count++
+		break
+	}
+	test.GotWant(t, count, 1)
+}
+
+// Verifies the reachable set and reachability queries
+func TestReachable(t *testing.T) {
+	g := newDirected([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"d", "c"})
+	reached := Reachable(g, "a")
+	test.GotWant(t, reached.Size(), 3)
+	for _, v := range []string{"a", "b", "c"} {
+		test.GotWant(t, reached.Contains(v), true)
+	}
+	test.GotWant(t, Reachable(g, "x").IsEmpty(), true)
+
+	test.GotWant(t, IsReachable(g, "a", "a"), true)
+	test.GotWant(t, IsReachable(g, "a", "c"), true)
+	test.GotWant(t, IsReachable(g, "c", "a"), false)
+	test.GotWant(t, IsReachable(g, "a", "d"), false)
+	test.GotWant(t, IsReachable(g, "x", "x"), false)
+}
+
+// Verifies graphs without cycles report none, including DAGs whose
+// vertices are reached along several paths
+func TestFindCycle_Acyclic(t *testing.T) {
+	_, ok := FindCycle(newDirected())
+	test.GotWant(t, ok, false)
+
+	dag := newDirected(
+		[2]string{"a", "b"}, [2]string{"a", "c"},
+		[2]string{"b", "d"}, [2]string{"c", "d"}, [2]string{"a", "d"},
+	)
+	test.GotWant(t, HasCycle(dag), false)
+}
+
+// Verifies a directed cycle is returned in path order
+func TestFindCycle_Directed(t *testing.T) {
+	g := newDirected([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}, [2]string{"c", "d"})
+	cycle, ok := FindCycle(g)
+	test.GotWant(t, ok, true)
+	test.GotWantSlice(t, cycle, []string{"a", "b", "c"})
+
+	// Two opposite edges form a cycle in a directed graph
+	cycle, ok = FindCycle(newDirected([2]string{"a", "b"}, [2]string{"b", "a"}))
+	test.GotWant(t, ok, true)
+	test.GotWantSlice(t, cycle, []string{"a", "b"})
+}
+
+// Verifies a self-loop is a cycle of one vertex
+func TestFindCycle_SelfLoop(t *testing.T) {
+	for _, g := range []*graphs.AdjacencyListGraph[string, graphs.Unweighted]{
+		newDirected([2]string{"a", "b"}, [2]string{"b", "b"}),
+		newUndirected([2]string{"a", "b"}, [2]string{"b", "b"}),
+	} {
+		cycle, ok := FindCycle(g)
+		test.GotWant(t, ok, true)
+		test.GotWantSlice(t, cycle, []string{"b"})
+	}
+}
+
+// Verifies undirected trees have no cycles while closed paths and
+// parallel edges do
+func TestFindCycle_Undirected(t *testing.T) {
+	tree := newUndirected([2]string{"a", "b"}, [2]string{"a", "c"}, [2]string{"c", "d"})
+	test.GotWant(t, HasCycle(tree), false)
+
+	triangle := newUndirected([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"})
+	cycle, ok := FindCycle(triangle)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, len(cycle), 3)
+	checkCycle(t, triangle, cycle)
+
+	parallel := newUndirected([2]string{"a", "b"}, [2]string{"b", "a"})
+	cycle, ok = FindCycle(parallel)
+	test.GotWant(t, ok, true)
+	test.GotWantSlice(t, cycle, []string{"a", "b"})
+}
+
+// Verifies cycles found in random graphs are real and random DAGs report
+// none
+func TestFindCycle_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(41, 42))
+	for range 200 {
+		for _, directed := range []bool{true, false} {
+			g := graphs.NewAdjacencyListGraphWithConfig[int, int](graphs.AdjacencyListGraphConfig{
+				Directed: directed,
+			})
+			dag := graphs.NewAdjacencyListGraph[int, int]()
+			n := 2 + r.IntN(15)
+			for range r.IntN(2 * n) {
+				from, to := r.IntN(n), r.IntN(n)
+				g.AddEdge(from, to, 0)
+				if from < to {
+					dag.AddEdge(from, to, 0)
+				}
+			}
+
+			if cycle, ok := FindCycle(g); ok {
+				checkCycle(t, g, cycle)
+			} else if !directed {
+				// An acyclic undirected graph is a forest
+				components := 0
+				seen := map[int]bool{}
+				for v := range g.Vertices() {
+					if !seen[v] {
+						components++
+						for w := range BreadthFirst(g, v) {
+							seen[w] = true
+						}
+					}
+				}
+				test.GotWant(t, g.EdgeCount(), g.VertexCount()-components)
+			}
+			test.GotWant(t, HasCycle(dag), false)
+		}
+	}
+}
@@ -0,0 +1,424 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+
+	"github.com/apotourlyan/godatastructures/internal/debug"
+)
+
+// Compile-time interface verifications
+var _ Graph[int, float64] = &AdjacencyListGraph[int, float64]{}
+
+// Represents one end of an edge stored in a vertex's adjacency list.
+type arc[V comparable, W any] struct {
+	to     V // Other endpoint (the source for incoming arcs)
+	weight W
+	mirror bool // Reverse copy of an undirected edge, skipped by Edges
+}
+
+// Represents a vertex's incident edges.
+type adjacency[V comparable, W any] struct {
+	out []arc[V, W] // Edges leaving the vertex (all incident edges if undirected)
+	in  []arc[V, W] // Edges entering the vertex (directed graphs only)
+}
+
+// AdjacencyListGraph implements a graph that stores, for every vertex,
+// the list of edges incident to it.
+//
+// The edge model is configurable (see AdjacencyListGraphConfig): edges
+// may be directed or undirected, parallel edges between the same pair of
+// vertices may be kept as a multigraph, and self-loops may be rejected,
+// ignored or stored. Every edge carries a weight of type W; use
+// Unweighted for graphs without edge data.
+//
+// Design decisions:
+//   - Adjacency lists: Space proportional to edges, fast successor scans
+//   - Incoming lists for directed graphs: O(1) in-degree, cheap vertex removal
+//   - Mirrored arcs for undirected graphs: Both endpoints see the edge
+//   - Insertion order: Vertices and edges iterate in the order they were
+//     added, so traversals are deterministic
+//
+// Space complexity: O(V + E) where V is the number of vertices and E the
+// number of edges.
+type AdjacencyListGraph[V comparable, W any] struct {
+	vertices map[V]*adjacency[V, W]
+	order    []V // Vertices in insertion order
+	edges    int
+	config   AdjacencyListGraphConfig
+}
+
+// NewAdjacencyListGraph creates an empty directed graph that rejects
+// parallel edges and self-loops.
+//
+// For other edge models, use NewAdjacencyListGraphWith with WithDirected,
+// WithMultigraph and WithSelfLoops.
+//
+// Example:
+//
+//	g := NewAdjacencyListGraph[string, int]()
+//	g.AddEdge("a", "b", 5)
+//	g.HasEdge("a", "b")  // Returns true
+//	g.HasEdge("b", "a")  // Returns false
+func NewAdjacencyListGraph[V comparable, W any]() *AdjacencyListGraph[V, W] {
+	return newAdjacencyListGraph[V, W](defaultAdjacencyListGraphConfig())
+}
+
+// NewAdjacencyListGraphWith creates an empty graph from the default
+// configuration adjusted by the given options. Options are applied in
+// order.
+//
+// Example:
+//
+//	g := NewAdjacencyListGraphWith[string, int](
+//	    WithDirected(false),
+//	    WithSelfLoops(SelfLoopsAllow),
+//	)
+//
+//	multi := NewAdjacencyListGraphWith[string, float64](WithMultigraph(true))
+//	multi.AddEdge("a", "b", 1.5)
+//	multi.AddEdge("b", "a", 2.5)
+//	multi.EdgeCount()  // Returns 2
+func NewAdjacencyListGraphWith[V comparable, W any](opts ...AdjacencyListGraphOption) *AdjacencyListGraph[V, W] {
+	c := defaultAdjacencyListGraphConfig()
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return newAdjacencyListGraph[V, W](c)
+}
+
+// NewAdjacencyListGraphWithConfig creates an empty graph with a custom
+// edge model. See AdjacencyListGraphConfig for configuration options.
+//
+// Deprecated: Use NewAdjacencyListGraphWith with WithDirected,
+// WithMultigraph and WithSelfLoops.
+func NewAdjacencyListGraphWithConfig[V comparable, W any](config AdjacencyListGraphConfig) *AdjacencyListGraph[V, W] {
+	return newAdjacencyListGraph[V, W](config)
+}
+
+// Creates an empty graph with the given edge model.
+func newAdjacencyListGraph[V comparable, W any](config AdjacencyListGraphConfig) *AdjacencyListGraph[V, W] {
+	return &AdjacencyListGraph[V, W]{
+		vertices: make(map[V]*adjacency[V, W]),
+		config:   config,
+	}
+}
+
+// AddVertex adds the vertex.
+// Returns true if the vertex was added, false if it was already present.
+//
+// Time complexity: O(1) amortized
+func (g *AdjacencyListGraph[V, W]) AddVertex(v V) bool {
+	if _, ok := g.vertices[v]; ok {
+		return false
+	}
+
+	g.vertices[v] = &adjacency[V, W]{}
+	g.order = append(g.order, v)
+	return true
+}
+
+// RemoveVertex removes the vertex and every edge incident to it.
+// Returns true if the vertex was found and removed, false otherwise.
+//
+// Time complexity: O(V + sum of the degrees of the vertex's neighbors)
+func (g *AdjacencyListGraph[V, W]) RemoveVertex(v V) bool {
+	adj, ok := g.vertices[v]
+	if !ok {
+		return false
+	}
+
+	g.edges -= len(adj.out)
+	for _, a := range adj.out {
+		if a.to == v {
+			continue
+		}
+		if g.config.Directed {
+			removeArcs(&g.vertices[a.to].in, v)
+		} else {
+			removeArcs(&g.vertices[a.to].out, v)
+		}
+	}
+	for _, a := range adj.in {
+		if a.to != v {
+			g.edges -= removeArcs(&g.vertices[a.to].out, v)
+		}
+	}
+
+	delete(g.vertices, v)
+	i := slices.Index(g.order, v)
+	g.order = slices.Delete(g.order, i, i+1)
+	return true
+}
+
+// HasVertex returns true if the vertex is present.
+//
+// Time complexity: O(1) expected
+func (g *AdjacencyListGraph[V, W]) HasVertex(v V) bool {
+	_, ok := g.vertices[v]
+	return ok
+}
+
+// AddEdge adds an edge from one vertex to another with the weight,
+// adding either vertex if missing. In an undirected graph the edge
+// connects both vertices regardless of argument order.
+//
+// Returns an error if the edge is a self-loop and self-loops are
+// rejected, or if the vertices are already connected and the graph is
+// not a multigraph. Nothing is added in either case. An ignored self-loop
+// still adds its vertex.
+//
+// Time complexity: O(1) amortized for multigraphs, O(deg(from)) otherwise
+func (g *AdjacencyListGraph[V, W]) AddEdge(from V, to V, weight W) error {
+	if from == to {
+		switch g.config.SelfLoops {
+		case SelfLoopsReject:
+			return ErrSelfLoop
+		case SelfLoopsIgnore:
+			g.AddVertex(from)
+			return nil
+		}
+	}
+
+	if !g.config.Multigraph && g.HasEdge(from, to) {
+		return ErrParallelEdge
+	}
+
+	g.AddVertex(from)
+	g.AddVertex(to)
+
+	source := g.vertices[from]
+	target := g.vertices[to]
+	source.out = append(source.out, arc[V, W]{to: to, weight: weight})
+	if g.config.Directed {
+		target.in = append(target.in, arc[V, W]{to: from, weight: weight})
+	} else if from != to {
+		target.out = append(target.out, arc[V, W]{to: from, weight: weight, mirror: true})
+	}
+
+	g.edges++
+	return nil
+}
+
+// RemoveEdge removes every edge from one vertex to another, including all
+// parallel edges in a multigraph.
+// Returns the number of edges removed.
+//
+// Time complexity: O(deg(from) + deg(to))
+func (g *AdjacencyListGraph[V, W]) RemoveEdge(from V, to V) int {
+	source, ok := g.vertices[from]
+	if !ok {
+		return 0
+	}
+
+	removed := removeArcs(&source.out, to)
+	if removed == 0 {
+		return 0
+	}
+
+	if g.config.Directed {
+		removeArcs(&g.vertices[to].in, from)
+	} else if from != to {
+		removeArcs(&g.vertices[to].out, from)
+	}
+
+	g.edges -= removed
+	return removed
+}
+
+// HasEdge returns true if there is an edge from one vertex to another.
+//
+// Time complexity: O(deg(from))
+func (g *AdjacencyListGraph[V, W]) HasEdge(from V, to V) bool {
+	_, ok := g.Weight(from, to)
+	return ok
+}
+
+// Weight returns the weight of the edge from one vertex to another.
+// In a multigraph this is the weight of the earliest such edge still
+// present. Returns false if there is no such edge.
+//
+// Time complexity: O(deg(from))
+func (g *AdjacencyListGraph[V, W]) Weight(from V, to V) (W, bool) {
+	if source, ok := g.vertices[from]; ok {
+		for _, a := range source.out {
+			if a.to == to {
+				return a.weight, true
+			}
+		}
+	}
+
+	var zero W
+	return zero, false
+}
+
+// Vertices returns an iterator over all vertices in insertion order.
+// The graph must not be modified during iteration.
+//
+// Time complexity: O(V) for a full iteration
+func (g *AdjacencyListGraph[V, W]) Vertices() iter.Seq[V] {
+	return slices.Values(g.order)
+}
+
+// Successors returns an iterator over the vertices reachable from the
+// vertex by a single edge, once per edge, in edge insertion order.
+// The graph must not be modified during iteration.
+//
+// Time complexity: O(deg(v)) for a full iteration
+func (g *AdjacencyListGraph[V, W]) Successors(v V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for e := range g.OutEdges(v) {
+			if !yield(e.To) {
+				return
+			}
+		}
+	}
+}
+
+// Predecessors returns an iterator over the vertices with an edge to the
+// vertex, once per edge. In an undirected graph these are the successors.
+// The graph must not be modified during iteration.
+//
+// Time complexity: O(deg(v)) for a full iteration
+func (g *AdjacencyListGraph[V, W]) Predecessors(v V) iter.Seq[V] {
+	if !g.config.Directed {
+		return g.Successors(v)
+	}
+
+	return func(yield func(V) bool) {
+		if adj, ok := g.vertices[v]; ok {
+			for _, a := range adj.in {
+				if !yield(a.to) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// OutEdges returns an iterator over the edges leaving the vertex in
+// insertion order. In an undirected graph these are all edges incident to
+// the vertex, with From set to the vertex.
+// The graph must not be modified during iteration.
+//
+// Time complexity: O(deg(v)) for a full iteration
+func (g *AdjacencyListGraph[V, W]) OutEdges(v V) iter.Seq[Edge[V, W]] {
+	return func(yield func(Edge[V, W]) bool) {
+		if adj, ok := g.vertices[v]; ok {
+			for _, a := range adj.out {
+				if !yield(Edge[V, W]{From: v, To: a.to, Weight: a.weight}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Edges returns an iterator over all edges, each yielded once, grouped by
+// source vertex in insertion order. Undirected edges are yielded in the
+// orientation they were added.
+// The graph must not be modified during iteration.
+//
+// Time complexity: O(V + E) for a full iteration
+func (g *AdjacencyListGraph[V, W]) Edges() iter.Seq[Edge[V, W]] {
+	return func(yield func(Edge[V, W]) bool) {
+		for _, v := range g.order {
+			for _, a := range g.vertices[v].out {
+				if !a.mirror && !yield(Edge[V, W]{From: v, To: a.to, Weight: a.weight}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// OutDegree returns the number of edges leaving the vertex, or 0 if the
+// vertex is not present.
+//
+// Time complexity: O(1) expected
+func (g *AdjacencyListGraph[V, W]) OutDegree(v V) int {
+	if adj, ok := g.vertices[v]; ok {
+		return len(adj.out)
+	}
+
+	return 0
+}
+
+// InDegree returns the number of edges entering the vertex, or 0 if the
+// vertex is not present. In an undirected graph this is the out-degree.
+//
+// Time complexity: O(1) expected
+func (g *AdjacencyListGraph[V, W]) InDegree(v V) int {
+	adj, ok := g.vertices[v]
+	if !ok {
+		return 0
+	}
+
+	if g.config.Directed {
+		return len(adj.in)
+	}
+	return len(adj.out)
+}
+
+// ToDOT writes the graph to w in the Graphviz DOT language (see
+// debug.DOT): vertices labeled with their values and edges with their
+// weights, in insertion order. Directed graphs are drawn with arrows.
+// Weights of Unweighted graphs are omitted.
+// Returns the first write error.
+//
+// Time complexity: O(V + E)
+//
+// Example:
+//
+//	g := NewAdjacencyListGraph[string, int]()
+//	g.AddEdge("a", "b", 5)
+//	g.ToDOT(os.Stdout)  // digraph { ... n0 -> n1 [label="5"]; }
+func (g *AdjacencyListGraph[V, W]) ToDOT(w io.Writer) error {
+	d := debug.NewDOT(g.config.Directed)
+	for _, v := range g.order {
+		d.Node(v, fmt.Sprint(v))
+	}
+
+	for e := range g.Edges() {
+		label := ""
+		if _, unweighted := any(e.Weight).(Unweighted); !unweighted {
+			label = fmt.Sprint(e.Weight)
+		}
+		d.Edge(e.From, e.To, label)
+	}
+
+	_, err := d.WriteTo(w)
+	return err
+}
+
+// IsDirected returns true if edges have a direction.
+//
+// Time complexity: O(1)
+func (g *AdjacencyListGraph[V, W]) IsDirected() bool {
+	return g.config.Directed
+}
+
+// VertexCount returns the number of vertices.
+//
+// Time complexity: O(1)
+func (g *AdjacencyListGraph[V, W]) VertexCount() int {
+	return len(g.vertices)
+}
+
+// EdgeCount returns the number of edges.
+//
+// Time complexity: O(1)
+func (g *AdjacencyListGraph[V, W]) EdgeCount() int {
+	return g.edges
+}
+
+// Removes every arc pointing to the vertex from the list.
+// Returns the number of arcs removed.
+func removeArcs[V comparable, W any](arcs *[]arc[V, W], to V) int {
+	before := len(*arcs)
+	*arcs = slices.DeleteFunc(*arcs, func(a arc[V, W]) bool { return a.to == to })
+	return before - len(*arcs)
+}
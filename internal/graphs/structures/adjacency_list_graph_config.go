@@ -0,0 +1,81 @@
+package structures
+
+// SelfLoopPolicy decides what AddEdge does with an edge from a vertex to
+// itself.
+type SelfLoopPolicy uint8
+
+const (
+	SelfLoopsReject SelfLoopPolicy = iota // AddEdge returns an error
+	SelfLoopsIgnore                       // AddEdge adds the vertex but drops the edge
+	SelfLoopsAllow                        // AddEdge stores the edge
+)
+
+// AdjacencyListGraphConfig controls the edge model of an
+// AdjacencyListGraph.
+//
+// Default configuration (NewAdjacencyListGraph):
+//
+//	Directed:   true             // edges have a direction
+//	Multigraph: false            // at most one edge per ordered pair
+//	SelfLoops:  SelfLoopsReject  // edges to the same vertex are errors
+//
+// Example configurations:
+//
+//	// Road network: two-way streets, several roads between two towns
+//	config := AdjacencyListGraphConfig{Multigraph: true}
+//
+//	// State machine: transitions may return to the same state
+//	config := AdjacencyListGraphConfig{Directed: true, SelfLoops: SelfLoopsAllow}
+type AdjacencyListGraphConfig struct {
+	// Directed makes every edge go from one vertex to another.
+	//
+	// When disabled, an edge connects both endpoints symmetrically and
+	// appears in the out-edges of both.
+	Directed bool
+
+	// Multigraph allows several edges between the same pair of vertices,
+	// each with its own weight.
+	//
+	// When disabled, adding a second edge between a pair returns an error.
+	Multigraph bool
+
+	// SelfLoops decides whether edges from a vertex to itself are
+	// rejected with an error, silently dropped, or stored.
+	SelfLoops SelfLoopPolicy
+}
+
+// AdjacencyListGraphOption configures an AdjacencyListGraph created with
+// NewAdjacencyListGraphWith. Options are applied in order on top of the
+// default configuration.
+type AdjacencyListGraphOption func(*AdjacencyListGraphConfig)
+
+// WithDirected selects directed or undirected edges.
+// See AdjacencyListGraphConfig.Directed.
+func WithDirected(enabled bool) AdjacencyListGraphOption {
+	return func(c *AdjacencyListGraphConfig) {
+		c.Directed = enabled
+	}
+}
+
+// WithMultigraph allows or forbids parallel edges.
+// See AdjacencyListGraphConfig.Multigraph.
+func WithMultigraph(enabled bool) AdjacencyListGraphOption {
+	return func(c *AdjacencyListGraphConfig) {
+		c.Multigraph = enabled
+	}
+}
+
+// WithSelfLoops sets how edges from a vertex to itself are handled.
+// See AdjacencyListGraphConfig.SelfLoops.
+func WithSelfLoops(policy SelfLoopPolicy) AdjacencyListGraphOption {
+	return func(c *AdjacencyListGraphConfig) {
+		c.SelfLoops = policy
+	}
+}
+
+// Returns the configuration used by NewAdjacencyListGraph.
+func defaultAdjacencyListGraphConfig() AdjacencyListGraphConfig {
+	return AdjacencyListGraphConfig{
+		Directed: true,
+	}
+}
@@ -0,0 +1,460 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewAdjacencyListGraph/NewAdjacencyListGraphWithConfig):
+  ✓ Empty directed graph
+  ✓ Custom edge model
+
+AddVertex/RemoveVertex/HasVertex:
+  ✓ Add new and existing vertices
+  ✓ Remove absent vertex, remove with incident edges (directed, undirected)
+
+AddEdge/RemoveEdge/HasEdge/Weight:
+  ✓ Directed edges have a direction, missing vertices are added
+  ✓ Undirected edges connect both endpoints
+  ✓ Parallel edges rejected in simple graphs, kept in multigraphs
+  ✓ Self-loop policies: reject, ignore, allow (directed, undirected)
+  ✓ RemoveEdge removes all parallel edges, absent edges
+
+Iteration (Vertices/Successors/Predecessors/OutEdges/Edges):
+  ✓ Insertion order, undirected edges yielded once, early termination
+  ✓ Missing vertices yield nothing
+
+Degrees:
+  ✓ In- and out-degree, directed and undirected
+
+Randomized:
+  ✓ Mixed operations keep both adjacency views consistent
+
+ToDOT:
+  ✓ Directed graph with weights, undirected graph without weights
+
+Options (NewAdjacencyListGraphWith):
+  ✓ No options yields default configuration
+  ✓ Options applied in order
+*/
+
+import (
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies every arc has a matching arc at its other endpoint, that the
+// vertex order matches the vertex map, and that the edge count matches.
+func checkAdjacencyListGraph[V comparable, W comparable](t *testing.T, g *AdjacencyListGraph[V, W]) {
+	t.Helper()
+	test.GotWant(t, len(g.order), len(g.vertices))
+
+	type key struct {
+		from, to V
+		weight   W
+	}
+	balance := map[key]int{}
+	edges := 0
+	for _, v := range g.order {
+		adj, ok := g.vertices[v]
+		if !ok {
+			t.Fatalf("vertex %v is ordered but not mapped", v)
+		}
+		for _, a := range adj.out {
+			if _, ok := g.vertices[a.to]; !ok {
+				t.Errorf("arc %v -> %v leads to a missing vertex", v, a.to)
+			}
+			if !a.mirror {
+				edges++
+			}
+			if g.config.Directed {
+				balance[key{v, a.to, a.weight}]++
+			} else if v != a.to {
+				// Forward and mirrored copies cancel out
+				from, to := v, a.to
+				if a.mirror {
+					from, to = to, from
+				}
+				delta := 1
+				if a.mirror {
+					delta = -1
+				}
+				balance[key{from, to, a.weight}] += delta
+			}
+		}
+		for _, a := range adj.in {
+			balance[key{a.to, v, a.weight}]--
+		}
+	}
+
+	for k, n := range balance {
+		if n != 0 {
+			t.Errorf("edge %v -> %v is unbalanced by %d", k.from, k.to, n)
+		}
+	}
+	test.GotWant(t, g.EdgeCount(), edges)
+}
+
+// Returns the edges as a slice.
+func collectEdges[V comparable, W any](seq iter.Seq[Edge[V, W]]) []Edge[V, W] {
+	edges := []Edge[V, W]{}
+	for e := range seq {
+		edges = append(edges, e)
+	}
+	return edges
+}
+
+// Verifies the creation of an empty directed graph
+func TestAdjacencyListGraph_NewAdjacencyListGraph_Empty(t *testing.T) {
+	g := NewAdjacencyListGraph[string, int]()
+	test.GotWant(t, g.VertexCount(), 0)
+	test.GotWant(t, g.EdgeCount(), 0)
+	test.GotWant(t, g.IsDirected(), true)
+	checkAdjacencyListGraph(t, g)
+}
+
+// Verifies the creation of a graph with a custom edge model
+func TestAdjacencyListGraph_NewAdjacencyListGraphWithConfig(t *testing.T) {
+	g := NewAdjacencyListGraphWithConfig[string, int](AdjacencyListGraphConfig{Multigraph: true})
+	test.GotWant(t, g.IsDirected(), false)
+	test.GotWant(t, g.config.Multigraph, true)
+	test.GotWant(t, g.config.SelfLoops, SelfLoopsReject)
+}
+
+// Verifies adding new and existing vertices
+func TestAdjacencyListGraph_AddVertex(t *testing.T) {
+	g := NewAdjacencyListGraph[string, Unweighted]()
+	test.GotWant(t, g.AddVertex("a"), true)
+	test.GotWant(t, g.AddVertex("b"), true)
+	test.GotWant(t, g.AddVertex("a"), false)
+	test.GotWant(t, g.HasVertex("a"), true)
+	test.GotWant(t, g.HasVertex("c"), false)
+	test.GotWant(t, g.VertexCount(), 2)
+	test.GotWantSlice(t, slices.Collect(g.Vertices()), []string{"a", "b"})
+}
+
+// Verifies removing a vertex from a directed graph removes edges in both
+// directions
+func TestAdjacencyListGraph_RemoveVertex_Directed(t *testing.T) {
+	g := NewAdjacencyListGraphWithConfig[string, int](AdjacencyListGraphConfig{
+		Directed:   true,
+		Multigraph: true,
+		SelfLoops:  SelfLoopsAllow,
+	})
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "b", 2)
+	g.AddEdge("b", "c", 3)
+	g.AddEdge("c", "b", 4)
+	g.AddEdge("b", "b", 5)
+	g.AddEdge("a", "c", 6)
+
+	test.GotWant(t, g.RemoveVertex("x"), false)
+	test.GotWant(t, g.RemoveVertex("b"), true)
+	checkAdjacencyListGraph(t, g)
+	test.GotWant(t, g.EdgeCount(), 1)
+	test.GotWant(t, g.HasVertex("b"), false)
+	test.GotWant(t, g.InDegree("c"), 1)
+	test.GotWantSlice(t, slices.Collect(g.Vertices()), []string{"a", "c"})
+}
+
+// Verifies removing a vertex from an undirected graph removes it from its
+// neighbors' lists
+func TestAdjacencyListGraph_RemoveVertex_Undirected(t *testing.T) {
+	g := NewAdjacencyListGraphWithConfig[int, int](AdjacencyListGraphConfig{
+		Multigraph: true,
+		SelfLoops:  SelfLoopsAllow,
+	})
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(2, 1, 0)
+	g.AddEdge(2, 3, 0)
+	g.AddEdge(2, 2, 0)
+	g.AddEdge(1, 3, 0)
+
+	g.RemoveVertex(2)
+	checkAdjacencyListGraph(t, g)
+	test.GotWant(t, g.EdgeCount(), 1)
+	test.GotWantSlice(t, slices.Collect(g.Successors(1)), []int{3})
+	test.GotWantSlice(t, slices.Collect(g.Successors(3)), []int{1})
+}
+
+// Verifies directed edges have a direction and add missing vertices
+func TestAdjacencyListGraph_AddEdge_Directed(t *testing.T) {
+	g := NewAdjacencyListGraph[string, float64]()
+	test.GotWant(t, g.AddEdge("a", "b", 1.5), nil)
+	test.GotWant(t, g.VertexCount(), 2)
+	test.GotWant(t, g.HasEdge("a", "b"), true)
+	test.GotWant(t, g.HasEdge("b", "a"), false)
+
+	w, ok := g.Weight("a", "b")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, w, 1.5)
+	_, ok = g.Weight("b", "a")
+	test.GotWant(t, ok, false)
+	_, ok = g.Weight("x", "a")
+	test.GotWant(t, ok, false)
+
+	test.GotWant(t, g.AddEdge("b", "a", 2.5), nil)
+	test.GotWant(t, g.EdgeCount(), 2)
+	checkAdjacencyListGraph(t, g)
+}
+
+// Verifies undirected edges connect both endpoints
+func TestAdjacencyListGraph_AddEdge_Undirected(t *testing.T) {
+	g := NewAdjacencyListGraphWithConfig[string, int](AdjacencyListGraphConfig{})
+	g.AddEdge("a", "b", 7)
+	test.GotWant(t, g.HasEdge("a", "b"), true)
+	test.GotWant(t, g.HasEdge("b", "a"), true)
+
+	w, _ := g.Weight("b", "a")
+	test.GotWant(t, w, 7)
+	test.GotWant(t, g.EdgeCount(), 1)
+	checkAdjacencyListGraph(t, g)
+}
+
+// Verifies simple graphs reject parallel edges in either orientation
+// when undirected, leaving the graph unchanged
+func TestAdjacencyListGraph_AddEdge_ParallelRejected(t *testing.T) {
+	directed := NewAdjacencyListGraph[string, int]()
+	directed.AddEdge("a", "b", 1)
+	test.GotWantError(t, directed.AddEdge("a", "b", 2), ErrorParallelEdge)
+	test.GotWant(t, directed.AddEdge("b", "a", 3), nil)
+
+	w, _ := directed.Weight("a", "b")
+	test.GotWant(t, w, 1)
+	test.GotWant(t, directed.EdgeCount(), 2)
+
+	undirected := NewAdjacencyListGraphWithConfig[string, int](AdjacencyListGraphConfig{})
+	undirected.AddEdge("a", "b", 1)
+	test.GotWantError(t, undirected.AddEdge("b", "a", 2), ErrorParallelEdge)
+	test.GotWant(t, undirected.EdgeCount(), 1)
+	checkAdjacencyListGraph(t, undirected)
+}
+
+// Verifies multigraphs keep parallel edges with their own weights
+func TestAdjacencyListGraph_AddEdge_Multigraph(t *testing.T) {
+	g := NewAdjacencyListGraphWithConfig[string, int](AdjacencyListGraphConfig{
+		Directed:   true,
+		Multigraph: true,
+	})
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "b", 2)
+	g.AddEdge("a", "b", 3)
+
+	test.GotWant(t, g.EdgeCount(), 3)
+	test.GotWant(t, g.OutDegree("a"), 3)
+	test.GotWant(t, g.InDegree("b"), 3)
+	test.GotWantSlice(t, collectEdges(g.OutEdges("a")), []Edge[string, int]{
+		{"a", "b", 1}, {"a", "b", 2}, {"a", "b", 3},
+	})
+	checkAdjacencyListGraph(t, g)
+}
+
+// Verifies self-loops are rejected by default, leaving the graph unchanged
+func TestAdjacencyListGraph_AddEdge_SelfLoopReject(t *testing.T) {
+	g := NewAdjacencyListGraph[string, int]()
+	test.GotWantError(t, g.AddEdge("a", "a", 1), ErrorSelfLoop)
+	test.GotWant(t, g.VertexCount(), 0)
+	test.GotWant(t, g.EdgeCount(), 0)
+}
+
+// Verifies ignored self-loops add the vertex but not the edge
+func TestAdjacencyListGraph_AddEdge_SelfLoopIgnore(t *testing.T) {
+	g := NewAdjacencyListGraphWithConfig[string, int](AdjacencyListGraphConfig{SelfLoops: SelfLoopsIgnore})
+	test.GotWant(t, g.AddEdge("a", "a", 1), nil)
+	test.GotWant(t, g.HasVertex("a"), true)
+	test.GotWant(t, g.HasEdge("a", "a"), false)
+	test.GotWant(t, g.EdgeCount(), 0)
+}
+
+// Verifies allowed self-loops are stored once in directed and undirected
+// graphs
+func TestAdjacencyListGraph_AddEdge_SelfLoopAllow(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		g := NewAdjacencyListGraphWithConfig[string, int](AdjacencyListGraphConfig{
+			Directed:  directed,
+			SelfLoops: SelfLoopsAllow,
+		})
+		test.GotWant(t, g.AddEdge("a", "a", 1), nil)
+		test.GotWant(t, g.HasEdge("a", "a"), true)
+		test.GotWantError(t, g.AddEdge("a", "a", 2), ErrorParallelEdge)
+		test.GotWant(t, g.EdgeCount(), 1)
+		test.GotWant(t, g.OutDegree("a"), 1)
+		test.GotWantSlice(t, collectEdges(g.Edges()), []Edge[string, int]{{"a", "a", 1}})
+		checkAdjacencyListGraph(t, g)
+
+		test.GotWant(t, g.RemoveEdge("a", "a"), 1)
+		test.GotWant(t, g.EdgeCount(), 0)
+		checkAdjacencyListGraph(t, g)
+	}
+}
+
+// Verifies removing edges, including all parallel edges and absent edges
+func TestAdjacencyListGraph_RemoveEdge(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		g := NewAdjacencyListGraphWithConfig[string, int](AdjacencyListGraphConfig{
+			Directed:   directed,
+			Multigraph: true,
+		})
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("a", "b", 2)
+		g.AddEdge("b", "c", 3)
+
+		test.GotWant(t, g.RemoveEdge("x", "a"), 0)
+		test.GotWant(t, g.RemoveEdge("a", "c"), 0)
+		test.GotWant(t, g.RemoveEdge("a", "b"), 2)
+		test.GotWant(t, g.HasEdge("a", "b"), false)
+		test.GotWant(t, g.HasEdge("b", "a"), false)
+		test.GotWant(t, g.EdgeCount(), 1)
+		test.GotWant(t, g.VertexCount(), 3)
+		checkAdjacencyListGraph(t, g)
+	}
+}
+
+// Verifies iteration follows insertion order and undirected edges are
+// yielded once in the orientation they were added
+func TestAdjacencyListGraph_Iteration(t *testing.T) {
+	g := NewAdjacencyListGraphWithConfig[string, int](AdjacencyListGraphConfig{})
+	g.AddEdge("c", "a", 1)
+	g.AddEdge("a", "b", 2)
+	g.AddEdge("b", "c", 3)
+
+	test.GotWantSlice(t, slices.Collect(g.Vertices()), []string{"c", "a", "b"})
+	test.GotWantSlice(t, slices.Collect(g.Successors("a")), []string{"c", "b"})
+	test.GotWantSlice(t, slices.Collect(g.Predecessors("a")), []string{"c", "b"})
+	test.GotWantSlice(t, collectEdges(g.OutEdges("a")), []Edge[string, int]{
+		{"a", "c", 1}, {"a", "b", 2},
+	})
+	test.GotWantSlice(t, collectEdges(g.Edges()), []Edge[string, int]{
+		{"c", "a", 1}, {"a", "b", 2}, {"b", "c", 3},
+	})
+}
+
+// Verifies predecessors of a directed graph follow incoming edges
+func TestAdjacencyListGraph_Predecessors_Directed(t *testing.T) {
+	g := NewAdjacencyListGraph[int, Unweighted]()
+	g.AddEdge(1, 3, Unweighted{})
+	g.AddEdge(2, 3, Unweighted{})
+	g.AddEdge(3, 4, Unweighted{})
+
+	test.GotWantSlice(t, slices.Collect(g.Predecessors(3)), []int{1, 2})
+	test.GotWantSlice(t, slices.Collect(g.Successors(3)), []int{4})
+	test.GotWant(t, g.InDegree(3), 2)
+	test.GotWant(t, g.OutDegree(3), 1)
+}
+
+// Verifies iterating a missing vertex yields nothing and iterators stop
+// when the consumer breaks
+func TestAdjacencyListGraph_Iteration_EdgeCases(t *testing.T) {
+	g := NewAdjacencyListGraph[int, int]()
+	test.GotWant(t, len(slices.Collect(g.Successors(1))), 0)
+	test.GotWant(t, len(slices.Collect(g.Predecessors(1))), 0)
+	test.GotWant(t, g.OutDegree(1), 0)
+	test.GotWant(t, g.InDegree(1), 0)
+
+	for i := range 5 {
+		g.AddEdge(0, i+1, i)
+	}
+	count := 0
+	for range g.Successors(0) {
+		count++
+		break
+	}
+	for range g.Predecessors(1) {
+		count++
+		break
+	}
+	for range g.Edges() {
+		count++
+		break
+	}
+	test.GotWant(t, count, 3)
+}
+
+// Verifies random operations keep the adjacency views and counts
+// consistent under every edge model
+func TestAdjacencyListGraph_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(31, 32))
+	for _, directed := range []bool{true, false} {
+		for _, multigraph := range []bool{true, false} {
+			g := NewAdjacencyListGraphWithConfig[int, int](AdjacencyListGraphConfig{
+				Directed:   directed,
+				Multigraph: multigraph,
+				SelfLoops:  SelfLoopsAllow,
+			})
+
+			for i := range 5000 {
+				from, to := r.IntN(30), r.IntN(30)
+				switch r.IntN(10) {
+				case 0:
+					g.RemoveVertex(from)
+				case 1, 2:
+					g.RemoveEdge(from, to)
+				default:
+					g.AddEdge(from, to, i)
+				}
+				if i%500 == 0 {
+					checkAdjacencyListGraph(t, g)
+				}
+			}
+			checkAdjacencyListGraph(t, g)
+		}
+	}
+}
+
+// Verifies ToDOT draws directed graphs with arrows and weights, and
+// undirected unweighted graphs with plain lines and no labels
+func TestAdjacencyListGraph_ToDOT(t *testing.T) {
+	var b strings.Builder
+	g := NewAdjacencyListGraph[string, int]()
+	g.AddEdge("a", "b", 5)
+	g.AddVertex("c")
+	test.GotWantNoError(t, g.ToDOT(&b))
+	test.GotWant(t, b.String(), `digraph {
+    n0 [label="a"];
+    n1 [label="b"];
+    n2 [label="c"];
+    n0 -> n1 [label="5"];
+}
+`)
+
+	u := NewAdjacencyListGraphWithConfig[int, Unweighted](AdjacencyListGraphConfig{})
+	u.AddEdge(1, 2, Unweighted{})
+	u.AddEdge(2, 3, Unweighted{})
+	b.Reset()
+	test.GotWantNoError(t, u.ToDOT(&b))
+	test.GotWant(t, b.String(), `graph {
+    n0 [label="1"];
+    n1 [label="2"];
+    n2 [label="3"];
+    n0 -- n1;
+    n1 -- n2;
+}
+`)
+}
+
+// Verifies no options yields the default configuration
+func TestAdjacencyListGraph_NewAdjacencyListGraphWith_Defaults(t *testing.T) {
+	g := NewAdjacencyListGraphWith[string, int]()
+	test.GotWant(t, g.config, NewAdjacencyListGraph[string, int]().config)
+}
+
+// Verifies options are applied in order on top of the defaults
+func TestAdjacencyListGraph_NewAdjacencyListGraphWith_Options(t *testing.T) {
+	g := NewAdjacencyListGraphWith[string, int](
+		WithDirected(false),
+		WithMultigraph(true),
+		WithSelfLoops(SelfLoopsIgnore),
+		WithSelfLoops(SelfLoopsAllow),
+	)
+	test.GotWant(t, g.config, AdjacencyListGraphConfig{
+		Directed:   false,
+		Multigraph: true,
+		SelfLoops:  SelfLoopsAllow,
+	})
+
+	g.AddEdge("a", "b", 1)
+	test.GotWant(t, g.HasEdge("b", "a"), true)
+}
@@ -0,0 +1,90 @@
+// Package structures provides generic graph data structures and their implementations.
+package structures
+
+import (
+	"errors"
+	"iter"
+)
+
+const ErrorSelfLoop = "self-loops are not allowed"
+const ErrorParallelEdge = "parallel edges are not allowed"
+const ErrorVertexNotFound = "vertex not found"
+
+// Errors for edges a graph does not allow and for vertices it does not
+// contain.
+var (
+	ErrSelfLoop       = errors.New(ErrorSelfLoop)
+	ErrParallelEdge   = errors.New(ErrorParallelEdge)
+	ErrVertexNotFound = errors.New(ErrorVertexNotFound)
+)
+
+// Edge represents a connection from one vertex to another carrying a
+// weight. In undirected graphs From is the vertex the edge was reached from.
+type Edge[V comparable, W any] struct {
+	From   V
+	To     V
+	Weight W
+}
+
+// Unweighted is the weight type for graphs whose edges carry no data.
+type Unweighted = struct{}
+
+// Graph defines the interface for a set of vertices connected by weighted
+// edges.
+//
+// All implementations guarantee:
+//   - Vertices are unique; adding an existing vertex is a no-op
+//   - AddEdge adds missing endpoints before adding the edge
+//   - Removing a vertex removes every edge incident to it
+//   - Successors and OutEdges of an undirected graph cover every edge
+//     incident to the vertex, oriented away from it
+//
+// Whether parallel edges and self-loops are accepted is
+// implementation-dependent. Iteration order is implementation-dependent.
+// Thread safety is implementation-dependent. Check specific implementation
+// documentation for ordering and concurrency guarantees.
+type Graph[V comparable, W any] interface {
+	// AddVertex adds the vertex.
+	// Returns true if the vertex was added, false if it was already present.
+	AddVertex(v V) bool
+
+	// RemoveVertex removes the vertex and every edge incident to it.
+	// Returns true if the vertex was found and removed, false otherwise.
+	RemoveVertex(v V) bool
+
+	// HasVertex returns true if the vertex is present.
+	HasVertex(v V) bool
+
+	// AddEdge adds an edge from one vertex to another with the weight.
+	// Returns an error if the edge violates the graph's edge policy.
+	AddEdge(from V, to V, weight W) error
+
+	// RemoveEdge removes every edge from one vertex to another.
+	// Returns the number of edges removed.
+	RemoveEdge(from V, to V) int
+
+	// HasEdge returns true if there is an edge from one vertex to another.
+	HasEdge(from V, to V) bool
+
+	// Vertices returns an iterator over all vertices.
+	Vertices() iter.Seq[V]
+
+	// Successors returns an iterator over the vertices reachable from the
+	// vertex by a single edge, once per edge.
+	Successors(v V) iter.Seq[V]
+
+	// OutEdges returns an iterator over the edges leaving the vertex.
+	OutEdges(v V) iter.Seq[Edge[V, W]]
+
+	// Edges returns an iterator over all edges, each yielded once.
+	Edges() iter.Seq[Edge[V, W]]
+
+	// IsDirected returns true if edges have a direction.
+	IsDirected() bool
+
+	// VertexCount returns the number of vertices.
+	VertexCount() int
+
+	// EdgeCount returns the number of edges.
+	EdgeCount() int
+}
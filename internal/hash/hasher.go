@@ -0,0 +1,134 @@
+// Package hash provides the hash functions used by hash-based structures,
+// and lets callers plug in their own.
+package hash
+
+import (
+	"hash/maphash"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/constraints"
+)
+
+// Hasher computes 64-bit hash codes for keys of type K.
+//
+// Implementations must return equal hashes for keys the structure treats
+// as equal, and should spread distinct keys over all 64 bits: structures
+// take the low bits for table positions and the high bits for
+// fingerprints. The hashes of one Hasher value must not change over its
+// lifetime, but may differ between values, which seeded implementations
+// use to hinder crafted collisions.
+type Hasher[K any] interface {
+	Hash(key K) uint64
+}
+
+// Func adapts an ordinary function to a Hasher.
+//
+// Example:
+//
+//	// Case-insensitive string keys
+//	s := NewString()
+//	h := Func[string](func(key string) uint64 { return s.Hash(strings.ToLower(key)) })
+type Func[K any] func(key K) uint64
+
+// Hash returns f(key).
+func (f Func[K]) Hash(key K) uint64 {
+	return f(key)
+}
+
+// Comparable hashes any Hashable key with maphash.Comparable. It is the
+// default hasher of the hash-based structures.
+//
+// Keys are hashed by their memory representation: pointers by address,
+// and strings and interfaces by content. Floating-point keys follow ==, so
+// +0 and -0 hash alike while NaN never matches itself.
+type Comparable[K constraints.Hashable] struct {
+	seed maphash.Seed
+}
+
+// NewComparable creates a hasher for comparable keys with a random seed.
+//
+// Example:
+//
+//	h := NewComparable[point]()
+//	h.Hash(point{1, 2}) == h.Hash(point{1, 2})  // true
+func NewComparable[K constraints.Hashable]() Comparable[K] {
+	return Comparable[K]{seed: maphash.MakeSeed()}
+}
+
+// NewComparableWithSeed creates a hasher for comparable keys with the
+// given seed. Hashers sharing a seed hash alike, within one process.
+func NewComparableWithSeed[K constraints.Hashable](seed maphash.Seed) Comparable[K] {
+	return Comparable[K]{seed: seed}
+}
+
+// Hash returns the hash of the key.
+//
+// Time complexity: O(size of the key)
+func (h Comparable[K]) Hash(key K) uint64 {
+	return maphash.Comparable(h.seed, key)
+}
+
+// String hashes strings with maphash.String, which is faster than
+// Comparable for string keys.
+type String struct {
+	seed maphash.Seed
+}
+
+// NewString creates a string hasher with a random seed.
+func NewString() String {
+	return String{seed: maphash.MakeSeed()}
+}
+
+// Hash returns the hash of the string.
+//
+// Time complexity: O(len(key))
+func (h String) Hash(key string) uint64 {
+	return maphash.String(h.seed, key)
+}
+
+// Bytes hashes byte slices by content with maphash.Bytes. Byte slices are
+// not comparable, so structures keyed by them need a matching equality
+// function as well.
+type Bytes struct {
+	seed maphash.Seed
+}
+
+// NewBytes creates a byte slice hasher with a random seed.
+func NewBytes() Bytes {
+	return Bytes{seed: maphash.MakeSeed()}
+}
+
+// Hash returns the hash of the byte slice's content.
+//
+// Time complexity: O(len(key))
+func (h Bytes) Hash(key []byte) uint64 {
+	return maphash.Bytes(h.seed, key)
+}
+
+// Integer hashes integers with a seeded 64-bit finalizer, which avalanches
+// every input bit into every output bit. It is faster than Comparable for
+// integer keys, and sequential keys still spread over all table positions.
+type Integer[K constraints.Integer] struct {
+	seed uint64
+}
+
+// NewInteger creates an integer hasher with a random seed.
+func NewInteger[K constraints.Integer]() Integer[K] {
+	return Integer[K]{seed: maphash.Comparable(maphash.MakeSeed(), 0)}
+}
+
+// Hash returns the hash of the integer.
+//
+// Time complexity: O(1)
+func (h Integer[K]) Hash(key K) uint64 {
+	return mix(uint64(key) ^ h.seed)
+}
+
+// Returns the murmur3 64-bit finalizer of x.
+func mix(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
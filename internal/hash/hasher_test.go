@@ -0,0 +1,90 @@
+package hash
+
+/*
+Test Coverage
+=============
+Func:
+  ✓ Calls the function
+
+Comparable/String/Bytes/Integer:
+  ✓ Equal keys hash alike
+  ✓ Hashers with different seeds differ
+  ✓ Shared seed hashes alike
+
+Integer:
+  ✓ Sequential keys spread over the low bits
+*/
+
+import (
+	"hash/maphash"
+	"math/bits"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Represents a composite comparable key.
+type testKey struct {
+	name string
+	id   int
+}
+
+// Verifies Func forwards to the function
+func TestFunc_Hash(t *testing.T) {
+	h := Func[string](func(s string) uint64 { return uint64(len(s)) })
+	test.GotWant(t, h.Hash("abc"), 3)
+}
+
+// Verifies every hasher returns equal hashes for equal keys, including
+// strings and byte slices with distinct backing memory
+func TestHashers_EqualKeys(t *testing.T) {
+	a, b := string([]byte("key")), string([]byte("key"))
+
+	c := NewComparable[testKey]()
+	test.GotWant(t, c.Hash(testKey{a, 1}), c.Hash(testKey{b, 1}))
+
+	s := NewString()
+	test.GotWant(t, s.Hash(a), s.Hash(b))
+
+	by := NewBytes()
+	test.GotWant(t, by.Hash([]byte(a)), by.Hash([]byte(b)))
+
+	i := NewInteger[int32]()
+	test.GotWant(t, i.Hash(-5), i.Hash(-5))
+}
+
+// Verifies hashers with random seeds hash differently, and hashers with
+// a shared seed alike
+func TestHashers_Seeds(t *testing.T) {
+	test.GotWant(t, NewString().Hash("key") != NewString().Hash("key"), true)
+	test.GotWant(t, NewBytes().Hash([]byte("key")) != NewBytes().Hash([]byte("key")), true)
+	test.GotWant(t, NewInteger[int]().Hash(1) != NewInteger[int]().Hash(1), true)
+	test.GotWant(t, NewComparable[int]().Hash(1) != NewComparable[int]().Hash(1), true)
+
+	seed := maphash.MakeSeed()
+	a, b := NewComparableWithSeed[testKey](seed), NewComparableWithSeed[testKey](seed)
+	test.GotWant(t, a.Hash(testKey{"x", 1}), b.Hash(testKey{"x", 1}))
+}
+
+// Verifies sequential integers fill every slot of a small table about
+// evenly, and flipping one input bit flips about half the output bits
+func TestInteger_Spread(t *testing.T) {
+	h := NewInteger[uint64]()
+	counts := make([]int, 64)
+	for k := range uint64(64 * 100) {
+		counts[h.Hash(k)&63]++
+	}
+	for slot, c := range counts {
+		if c < 50 || c > 150 {
+			t.Errorf("slot %d holds %d of 6400 keys, want about 100", slot, c)
+		}
+	}
+
+	flipped := 0
+	for k := range uint64(1000) {
+		flipped += bits.OnesCount64(h.Hash(k) ^ h.Hash(k^1))
+	}
+	if mean := flipped / 1000; mean < 28 || mean > 36 {
+		t.Errorf("one flipped input bit flips %d output bits on average, want about 32", mean)
+	}
+}
@@ -0,0 +1,77 @@
+package structures
+
+import "container/heap"
+
+// Compile-time interface verifications
+var _ heap.Interface = &ContainerHeap[int]{}
+
+// ContainerHeap adapts a Heap to container/heap.Interface, so code written
+// against the standard library can run on a Heap while it migrates to the
+// Heap methods.
+//
+// The adapter works directly on the storage of the heap and adds no state
+// of its own. Both use the same layout with the children of index i at
+// 2i+1 and 2i+2, so the functions of container/heap and the methods of
+// the heap can be mixed freely: heap.Push(c, x) and h.Push(x) leave the
+// heap in the same valid state, and indices seen in Swap are the ones
+// accepted by Fix and Remove on either side.
+//
+// Push and Pop are meant for container/heap only, as with any
+// heap.Interface. Called directly they append or remove the last
+// element without restoring the heap order.
+//
+// Example:
+//
+//	h := NewMinHeap(5, 1, 3)
+//	c := NewContainerHeap(h)
+//	heap.Push(c, 0)
+//	heap.Pop(c)  // Returns 0
+//	h.Pop()      // Returns 1
+type ContainerHeap[T any] struct {
+	heap *Heap[T]
+}
+
+// NewContainerHeap creates an adapter over the heap. The heap stays
+// usable on its own and shares every change with the adapter.
+func NewContainerHeap[T any](h *Heap[T]) *ContainerHeap[T] {
+	return &ContainerHeap[T]{heap: h}
+}
+
+// Heap returns the heap behind the adapter.
+func (c *ContainerHeap[T]) Heap() *Heap[T] {
+	return c.heap
+}
+
+// Len returns the number of elements in the heap.
+func (c *ContainerHeap[T]) Len() int {
+	return len(c.heap.data)
+}
+
+// Less reports whether the element at index i orders before the element
+// at index j under the less function of the heap.
+func (c *ContainerHeap[T]) Less(i, j int) bool {
+	return c.heap.less(c.heap.data[i], c.heap.data[j])
+}
+
+// Swap exchanges the elements at indices i and j.
+func (c *ContainerHeap[T]) Swap(i, j int) {
+	c.heap.data[i], c.heap.data[j] = c.heap.data[j], c.heap.data[i]
+}
+
+// Push appends x to the storage of the heap, for heap.Push.
+//
+// Panics if x is not a T.
+func (c *ContainerHeap[T]) Push(x any) {
+	c.heap.data = append(c.heap.data, x.(T))
+}
+
+// Pop removes and returns the last element of the storage, for heap.Pop.
+func (c *ContainerHeap[T]) Pop() any {
+	last := len(c.heap.data) - 1
+	value := c.heap.data[last]
+
+	var zero T
+	c.heap.data[last] = zero // Help GC
+	c.heap.data = c.heap.data[:last]
+	return value
+}
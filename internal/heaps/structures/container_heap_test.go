@@ -0,0 +1,130 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewContainerHeap):
+  ✓ Adapter shares the heap
+
+heap.Push/heap.Pop/heap.Fix/heap.Remove:
+  ✓ Standard library operations keep the heap valid
+  ✓ Mixed with Heap methods, ascending pops
+  ✓ heap.Init heapifies storage filled through Push
+  ✓ Popped slots are cleared
+
+Push:
+  ✓ Value of another type (panic)
+*/
+
+import (
+	"container/heap"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the adapter shares the heap it was created from
+func TestContainerHeap_NewContainerHeap(t *testing.T) {
+	h := NewMinHeap(3, 1, 2)
+	c := NewContainerHeap(h)
+	test.GotWant(t, c.Heap(), h)
+	test.GotWant(t, c.Len(), 3)
+
+	h.Push(0)
+	test.GotWant(t, c.Len(), 4)
+	test.GotWant(t, c.Less(0, 1), true)
+}
+
+// Verifies the standard library operations keep the heap valid
+func TestContainerHeap_StandardLibrary(t *testing.T) {
+	h := NewMinHeap[int]()
+	c := NewContainerHeap(h)
+	for _, v := range []int{5, 3, 8, 1, 9, 2, 7} {
+		heap.Push(c, v)
+		test.GotWantNoError(t, h.CheckInvariants())
+	}
+
+	v, _ := h.Get(0)
+	test.GotWant(t, v, 1)
+	h.data[0] = 10
+	heap.Fix(c, 0)
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	want := []int{2, 3, 5, 7, 8, 9, 10}
+	removed := heap.Remove(c, 2).(int)
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWant(t, h.Size(), 6)
+	want = slices.DeleteFunc(want, func(v int) bool { return v == removed })
+
+	var got []int
+	for c.Len() > 0 {
+		got = append(got, heap.Pop(c).(int))
+	}
+
+	test.GotWantSlice(t, got, want)
+}
+
+// Verifies standard library operations and Heap methods can be mixed
+func TestContainerHeap_MixedWithHeap(t *testing.T) {
+	h := NewMinHeap[int]()
+	c := NewContainerHeap(h)
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := range 200 {
+		if i%2 == 0 {
+			heap.Push(c, r.IntN(100))
+		} else {
+			h.Push(r.IntN(100))
+		}
+	}
+
+	prev := -1
+	for i := 0; !h.IsEmpty(); i++ {
+		var v int
+		if i%2 == 0 {
+			v = heap.Pop(c).(int)
+		} else {
+			v, _ = h.Pop()
+		}
+
+		if v < prev {
+			t.Fatalf("pop %d returned %d after %d", i, v, prev)
+		}
+		prev = v
+	}
+}
+
+// Verifies heap.Init heapifies storage filled through Push
+func TestContainerHeap_Init(t *testing.T) {
+	h := NewMinHeap[int]()
+	c := NewContainerHeap(h)
+	for _, v := range []int{9, 8, 7, 6, 5, 4} {
+		c.Push(v)
+	}
+
+	heap.Init(c)
+	test.GotWantNoError(t, h.CheckInvariants())
+	v, _ := h.Peek()
+	test.GotWant(t, v, 4)
+}
+
+// Verifies popped slots are cleared so they do not hold on to values
+func TestContainerHeap_Pop_ClearsSlot(t *testing.T) {
+	h := NewHeap(func(a, b *int) bool { return *a < *b })
+	c := NewContainerHeap(h)
+	one, two := 1, 2
+	heap.Push(c, &one)
+	heap.Push(c, &two)
+
+	heap.Pop(c)
+	test.GotWant(t, h.data[:2][1], nil)
+}
+
+// Verifies pushing a value of another type panics
+func TestContainerHeap_Push_WrongType(t *testing.T) {
+	c := NewContainerHeap(NewMinHeap[int]())
+	test.GotWantPanic(t, func() {
+		heap.Push(c, "a")
+	}, "interface conversion: interface {} is string, not int")
+}
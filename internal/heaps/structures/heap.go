@@ -0,0 +1,459 @@
+// Package structures provides generic heap data structures and their implementations.
+//
+// Every heap is ordered by a less function. NewX takes it as the first
+// argument together with initial values; NewXWith takes it as the
+// WithComparator option, next to options such as WithCapacity. Options
+// are generic in the element type, and those that apply only to the
+// slice-backed heaps, Heap and MinMaxHeap, fail to compile elsewhere.
+package structures
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/debug"
+)
+
+const ErrorEmptyHeap = "heap is empty"
+const ErrorIndexOutOfRange = "index is out of the range of possible values"
+const ErrorInvariantViolation = "invariant violation"
+
+// Errors returned by the heap operations that cannot complete on an empty
+// heap or an invalid position. ErrInvariantViolation is wrapped by
+// CheckInvariants.
+var (
+	ErrEmptyHeap          = errors.New(ErrorEmptyHeap)
+	ErrIndexOutOfRange    = errors.New(ErrorIndexOutOfRange)
+	ErrInvariantViolation = errors.New(ErrorInvariantViolation)
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &Heap[int]{}
+var _ collections.Addable[int] = &Heap[int]{}
+var _ collections.Iterable[int] = &Heap[int]{}
+
+// Heap implements a binary heap ordered by a caller-supplied less function.
+//
+// The element for which less reports true against every other element is
+// always at the root, so a less of a < b yields a min-heap and a > b a
+// max-heap. The heap is stored in a slice with the children of index i at
+// 2i+1 and 2i+2.
+//
+// Unlike container/heap, the heap owns its storage and needs no interface
+// implementation, and unlike a priority queue it exposes index-based Fix
+// and Remove, so other structures can track element positions and build
+// on it directly.
+//
+// Design decisions:
+//   - Slice storage: No per-element allocation, cache-friendly sifting
+//   - Less function: One type serves min-heaps, max-heaps and custom orders
+//   - Bottom-up heapify: O(n) construction from an initial set of values
+//
+// Space complexity: O(n) where n is the number of elements.
+type Heap[T any] struct {
+	data []T
+	less func(a T, b T) bool
+}
+
+// NewHeap creates a heap ordered by less containing the given values.
+// The values are arranged in O(n) with bottom-up heapify.
+//
+// Example:
+//
+//	// Longest string first
+//	h := NewHeap(func(a, b string) bool { return len(a) > len(b) }, "go", "heap")
+//	h.Peek()  // Returns "heap"
+func NewHeap[T any](less func(a T, b T) bool, values ...T) *Heap[T] {
+	h := &Heap[T]{data: append([]T(nil), values...), less: less}
+	for i := len(h.data)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+
+	return h
+}
+
+// NewHeapWith creates an empty heap from the given options. Options are
+// applied in order, and WithComparator is required.
+//
+// Panics if no comparator is given or the capacity is negative.
+//
+// Example:
+//
+//	h := NewHeapWith(
+//	    WithComparator(cmp.Less[int]),
+//	    WithCapacity[int](1000),
+//	)
+//	for i := range 1000 {
+//	    h.Push(i)  // Never reallocates
+//	}
+func NewHeapWith[T any](opts ...HeapOption[T]) *Heap[T] {
+	s := heapSettingsOf(opts)
+	return &Heap[T]{data: make([]T, 0, s.capacity), less: s.less}
+}
+
+// NewHeapWithCapacity creates an empty heap ordered by less with room
+// for capacity elements, so pushing up to that many elements does not
+// reallocate, like make([]T, 0, capacity).
+//
+// Panics if capacity is negative.
+//
+// Deprecated: Use NewHeapWith with WithComparator and WithCapacity.
+func NewHeapWithCapacity[T any](less func(a T, b T) bool, capacity int) *Heap[T] {
+	return NewHeapWith(WithComparator(less), WithCapacity[T](capacity))
+}
+
+// NewMinHeap creates a heap with the smallest value at the root.
+//
+// Example:
+//
+//	h := NewMinHeap(5, 1, 3)
+//	h.Pop()  // Returns 1
+func NewMinHeap[T cmp.Ordered](values ...T) *Heap[T] {
+	return NewHeap(cmp.Less[T], values...)
+}
+
+// NewMaxHeap creates a heap with the largest value at the root.
+//
+// Example:
+//
+//	h := NewMaxHeap(5, 1, 3)
+//	h.Pop()  // Returns 5
+func NewMaxHeap[T cmp.Ordered](values ...T) *Heap[T] {
+	return NewHeap(func(a T, b T) bool { return cmp.Less(b, a) }, values...)
+}
+
+// Push adds an element to the heap.
+//
+// Time complexity: O(log n), amortized for slice growth
+func (h *Heap[T]) Push(value T) {
+	h.data = append(h.data, value)
+	h.up(len(h.data) - 1)
+}
+
+// Add pushes the element onto the heap, as Push does.
+// Always returns true.
+//
+// Time complexity: O(log n), amortized for slice growth
+func (h *Heap[T]) Add(value T) bool {
+	h.Push(value)
+	return true
+}
+
+// Pop removes and returns the root element.
+// Returns an error if the heap is empty.
+//
+// Time complexity: O(log n)
+func (h *Heap[T]) Pop() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+
+	return h.removeAt(0), nil
+}
+
+// Peek returns the root element without removing it.
+// Returns an error if the heap is empty.
+//
+// Time complexity: O(1)
+func (h *Heap[T]) Peek() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+
+	return h.data[0], nil
+}
+
+// Fix re-establishes the heap order after the element at index i changed
+// its priority. Calling Fix is cheaper than Remove followed by Push.
+// Returns an error if the index is out of range.
+//
+// Time complexity: O(log n)
+func (h *Heap[T]) Fix(i int) error {
+	if i < 0 || i >= len(h.data) {
+		return ErrIndexOutOfRange
+	}
+
+	if !h.down(i) {
+		h.up(i)
+	}
+
+	return nil
+}
+
+// Remove removes and returns the element at index i.
+// Returns an error if the index is out of range.
+//
+// Time complexity: O(log n)
+func (h *Heap[T]) Remove(i int) (T, error) {
+	if i < 0 || i >= len(h.data) {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+
+	return h.removeAt(i), nil
+}
+
+// Get returns the element at index i, for locating elements to pass to
+// Fix or Remove. Returns an error if the index is out of range.
+//
+// Time complexity: O(1)
+func (h *Heap[T]) Get(i int) (T, error) {
+	if i < 0 || i >= len(h.data) {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+
+	return h.data[i], nil
+}
+
+// Set replaces the element at index i and restores the heap order.
+// Returns an error if the index is out of range.
+//
+// Time complexity: O(log n)
+func (h *Heap[T]) Set(i int, value T) error {
+	if i < 0 || i >= len(h.data) {
+		return ErrIndexOutOfRange
+	}
+
+	h.data[i] = value
+	return h.Fix(i)
+}
+
+// All returns an iterator over the elements in storage order, which
+// satisfies the heap property but is not sorted. The heap must not be
+// modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (h *Heap[T]) All() iter.Seq[T] {
+	return slices.Values(h.data)
+}
+
+// Indexed returns an iterator over the indices and elements in storage
+// order, for callers that track positions to pass to Fix, Set and Remove.
+// The heap must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (h *Heap[T]) Indexed() iter.Seq2[int, T] {
+	return slices.All(h.data)
+}
+
+// Clear removes all elements.
+//
+// Time complexity: O(1)
+func (h *Heap[T]) Clear() {
+	h.data = nil
+}
+
+// ShrinkToFit reallocates the underlying slice to exactly Size()
+// elements, releasing the capacity left behind by earlier pushes.
+//
+// Time complexity: O(n)
+func (h *Heap[T]) ShrinkToFit() {
+	if cap(h.data) == len(h.data) {
+		return
+	}
+
+	if len(h.data) == 0 {
+		h.data = nil
+	} else {
+		data := make([]T, len(h.data))
+		copy(data, h.data)
+		h.data = data
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements in storage order in the format of package codec, so the
+// layout of the heap is preserved. The less function is not encoded.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (h *Heap[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("Heap", len(h.data), slices.Values(h.data))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the heap with those encoded by MarshalBinary. Data encoded
+// by a heap with the same less function keeps its layout; any other
+// order is heapified, so the result is always a valid heap under h's less.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded heap, in which case the heap is left unchanged.
+//
+// Time complexity: O(n)
+func (h *Heap[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "Heap")
+	if err != nil {
+		return err
+	}
+
+	h.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (h *Heap[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "Heap", len(h.data), slices.Values(h.data))
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// heap with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The heap is
+// left unchanged on error.
+//
+// Time complexity: O(n)
+func (h *Heap[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "Heap")
+	if err != nil {
+		return n, err
+	}
+
+	h.restore(values)
+	return n, nil
+}
+
+// IsEmpty returns true if the heap contains no elements.
+//
+// Time complexity: O(1)
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.data) == 0
+}
+
+// Size returns the number of elements in the heap.
+//
+// Time complexity: O(1)
+func (h *Heap[T]) Size() int {
+	return len(h.data)
+}
+
+// DebugTree returns a snapshot of the heap's implicit binary tree for
+// debug.PrintTree, each node labeled with its value and its index in the
+// underlying slice.
+// Returns nil if the heap is empty.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	debug.PrintTree(os.Stdout, NewMinHeap(3, 1, 2))
+//	// 1 [0]
+//	// ├── L: 3 [1]
+//	// └── R: 2 [2]
+func (h *Heap[T]) DebugTree() *debug.TreeNode {
+	return heapDebugTree(len(h.data), 0, "", func(i int) string {
+		return fmt.Sprintf("%v [%d]", h.data[i], i)
+	})
+}
+
+// CheckInvariants verifies the heap property: no element sorts before
+// its parent. Intended for tests and debugging, for example after
+// elements were modified in place without calling Fix.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the heap is consistent.
+//
+// Time complexity: O(n)
+func (h *Heap[T]) CheckInvariants() error {
+	for i := 1; i < len(h.data); i++ {
+		if parent := (i - 1) / 2; h.less(h.data[i], h.data[parent]) {
+			return fmt.Errorf("%w: element %d sorts before its parent %d", ErrInvariantViolation, i, parent)
+		}
+	}
+
+	return nil
+}
+
+// Removes the element at a valid index by swapping in the last element.
+func (h *Heap[T]) removeAt(i int) T {
+	last := len(h.data) - 1
+	value := h.data[i]
+	h.data[i] = h.data[last]
+
+	var zero T
+	h.data[last] = zero // Help GC
+	h.data = h.data[:last]
+
+	if i < last && !h.down(i) {
+		h.up(i)
+	}
+
+	return value
+}
+
+// Moves the element at index i toward the root until its parent is not
+// greater.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.data[i], h.data[parent]) {
+			break
+		}
+
+		h.data[i], h.data[parent] = h.data[parent], h.data[i]
+		i = parent
+	}
+}
+
+// Moves the element at index i toward the leaves until neither child is
+// smaller. Returns true if the element moved.
+func (h *Heap[T]) down(i int) bool {
+	start := i
+	n := len(h.data)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < n && h.less(h.data[left], h.data[smallest]) {
+			smallest = left
+		}
+		if right < n && h.less(h.data[right], h.data[smallest]) {
+			smallest = right
+		}
+		if smallest == i {
+			break
+		}
+
+		h.data[i], h.data[smallest] = h.data[smallest], h.data[i]
+		i = smallest
+	}
+
+	return i > start
+}
+
+// Returns the snapshot of the subtree at index i of an implicit binary
+// tree of n elements, labeling every node with its side and label(i), or
+// nil if the index is outside the tree.
+func heapDebugTree(n int, i int, side string, label func(i int) string) *debug.TreeNode {
+	if i >= n {
+		return nil
+	}
+
+	node := &debug.TreeNode{Label: side + label(i)}
+	if l := heapDebugTree(n, 2*i+1, "L: ", label); l != nil {
+		node.Children = append(node.Children, l)
+	}
+	if r := heapDebugTree(n, 2*i+2, "R: ", label); r != nil {
+		node.Children = append(node.Children, r)
+	}
+
+	return node
+}
+
+// Replaces the elements with the decoded values, heapifying them unless
+// they already satisfy the heap property.
+func (h *Heap[T]) restore(values []T) {
+	h.data = values
+	for i := len(h.data)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+}
@@ -0,0 +1,145 @@
+package structures
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
+
+const ErrorMissingComparator = "heap has no comparator, pass WithComparator"
+
+// Compile-time interface verifications
+var _ HeapOption[int] = ComparatorOption[int]{}
+var _ MergeableHeapOption[int] = ComparatorOption[int]{}
+
+// HeapOption configures a slice-backed heap created with NewHeapWith or
+// NewMinMaxHeapWith. Options are applied in order.
+//
+// HeapOption is generic in the element type, so that a comparator for
+// another element type fails to compile. Options that carry no element,
+// such as WithCapacity, therefore take the element type as a type
+// argument.
+type HeapOption[T any] interface {
+	applyHeap(s *heapSettings[T])
+}
+
+// MergeableHeapOption configures a node-based heap created with
+// NewLeftistHeapWith or NewSkewHeapWith. Options are applied in order.
+//
+// Slice-only options such as WithCapacity are not MergeableHeapOptions,
+// so passing one to a node-based heap fails to compile, and neither is
+// WithFreeListCapacity a HeapOption.
+type MergeableHeapOption[T any] interface {
+	applyMergeableHeap(s *heapSettings[T])
+}
+
+// ComparatorOption selects the order of a heap. It is both a HeapOption
+// and a MergeableHeapOption; see WithComparator.
+type ComparatorOption[T any] struct {
+	less func(a T, b T) bool
+}
+
+// WithComparator orders the heap by less: the element for which less
+// reports true against every other element is at the root, so cmp.Less
+// yields a min-heap. Every heap built from options requires it.
+//
+// Example:
+//
+//	h := NewHeapWith(WithComparator(cmp.Less[int]))
+func WithComparator[T any](less func(a T, b T) bool) ComparatorOption[T] {
+	return ComparatorOption[T]{less: less}
+}
+
+func (o ComparatorOption[T]) applyHeap(s *heapSettings[T]) {
+	s.less = o.less
+}
+
+func (o ComparatorOption[T]) applyMergeableHeap(s *heapSettings[T]) {
+	s.less = o.less
+}
+
+// Adapts a change of the settings to a HeapOption.
+type heapSettingsOption[T any] func(*heapSettings[T])
+
+func (f heapSettingsOption[T]) applyHeap(s *heapSettings[T]) {
+	f(s)
+}
+
+// WithCapacity gives a slice-backed heap room for capacity elements, so
+// pushing up to that many elements does not reallocate, like
+// make([]T, 0, capacity).
+//
+// The constructor panics if capacity is negative.
+//
+// Example:
+//
+//	h := NewHeapWith(WithComparator(cmp.Less[int]), WithCapacity[int](1000))
+func WithCapacity[T any](capacity int) HeapOption[T] {
+	return heapSettingsOption[T](func(s *heapSettings[T]) {
+		s.capacity = capacity
+	})
+}
+
+// Adapts a change of the settings to a MergeableHeapOption.
+type mergeableHeapSettingsOption[T any] func(*heapSettings[T])
+
+func (f mergeableHeapSettingsOption[T]) applyMergeableHeap(s *heapSettings[T]) {
+	f(s)
+}
+
+// WithFreeListCapacity enables node recycling for a node-based heap: up
+// to capacity popped nodes are kept on a memory.FreeList and handed out
+// again by later pushes, so a heap whose size stays around a steady
+// state, such as a scheduler's queue, stops allocating once it has warmed
+// up. Nodes popped while the free list is full are left to the garbage
+// collector. Clear does not recycle the nodes it drops.
+//
+// The constructor panics if capacity is negative.
+//
+// Example:
+//
+//	h := NewLeftistHeapWith(
+//	    WithComparator(cmp.Less[int]),
+//	    WithFreeListCapacity[int](256),
+//	)
+func WithFreeListCapacity[T any](capacity int) MergeableHeapOption[T] {
+	return mergeableHeapSettingsOption[T](func(s *heapSettings[T]) {
+		s.freeListCapacity = capacity
+	})
+}
+
+// Settings collected from the options of a heap.
+type heapSettings[T any] struct {
+	less             func(a T, b T) bool
+	capacity         int // Ignored by node-based heaps
+	freeListCapacity int // Ignored by slice-backed heaps
+}
+
+// Returns the settings of the slice-backed heap options.
+// Panics if no comparator was given or the capacity is negative.
+func heapSettingsOf[T any](opts []HeapOption[T]) heapSettings[T] {
+	var s heapSettings[T]
+	for _, opt := range opts {
+		opt.applyHeap(&s)
+	}
+
+	s.requireComparator()
+	panics.RequireNonNegative(s.capacity, "capacity")
+	return s
+}
+
+// Returns the settings of the node-based heap options.
+// Panics if no comparator was given or the free list capacity is negative.
+func mergeableHeapSettingsOf[T any](opts []MergeableHeapOption[T]) heapSettings[T] {
+	var s heapSettings[T]
+	for _, opt := range opts {
+		opt.applyMergeableHeap(&s)
+	}
+
+	s.requireComparator()
+	panics.RequireNonNegative(s.freeListCapacity, "free list capacity")
+	return s
+}
+
+// Panics if no comparator was given.
+func (s heapSettings[T]) requireComparator() {
+	if s.less == nil {
+		panic(ErrorMissingComparator)
+	}
+}
@@ -0,0 +1,434 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewHeap/NewMinHeap/NewMaxHeap):
+  ✓ Empty heap
+  ✓ Heapify initial values (min, max, custom order)
+  ✓ Initial values slice is not aliased
+
+Constructor (NewHeapWith):
+  ✓ Missing comparator and negative capacity (panic)
+  ✓ Empty heap ordered by the comparator with the requested capacity
+
+Constructor (NewHeapWithCapacity):
+  ✓ Negative capacity (panic)
+  ✓ Empty heap with the requested capacity
+
+Push/Pop/Peek:
+  ✓ Pop and Peek on an empty heap
+  ✓ Pop yields sorted order
+  ✓ Duplicates
+
+Fix/Set/Get:
+  ✓ Invalid index
+  ✓ Priority decreased and increased
+
+Remove:
+  ✓ Invalid index
+  ✓ Remove root, middle and last element
+
+All/Indexed:
+  ✓ All yields every element in storage order, usable as an Iterable
+  ✓ Indexed yields every element with its index
+
+ShrinkToFit:
+  ✓ Capacity equals size, heap order preserved
+  ✓ Empty heap releases the slice
+
+CheckInvariants:
+  ✓ Valid heap, child sorting before its parent
+
+Randomized:
+  ✓ Mixed operations keep the heap property and match a sorted model
+
+DebugTree:
+  ✓ Empty heap, values with slice indices in heap order
+
+Add/Clear:
+  ✓ Add pushes and always returns true
+  ✓ Clear empties the heap, which stays usable
+
+Binary encoding:
+  ✓ Round trip preserves the layout, invalid data rejected
+  ✓ Foreign order is heapified
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the heap unchanged
+*/
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/debug"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies every parent is not greater than its children.
+func checkHeap[T any](t *testing.T, h *Heap[T]) {
+	t.Helper()
+	test.GotWantNoError(t, h.CheckInvariants())
+	for i := 1; i < len(h.data); i++ {
+		if h.less(h.data[i], h.data[(i-1)/2]) {
+			t.Errorf("heap property violated at index %d", i)
+		}
+	}
+}
+
+// Pops every element, returning them in pop order.
+func drainHeap[T any](h *Heap[T]) []T {
+	values := []T{}
+	for !h.IsEmpty() {
+		v, _ := h.Pop()
+		values = append(values, v)
+	}
+	return values
+}
+
+// Verifies the creation of an empty heap
+func TestHeap_NewHeap_Empty(t *testing.T) {
+	h := NewMinHeap[int]()
+	test.GotWant(t, h.Size(), 0)
+	test.GotWant(t, h.IsEmpty(), true)
+}
+
+// Verifies initial values are heapified for min, max and custom orders
+func TestHeap_NewHeap_Heapify(t *testing.T) {
+	values := []int{5, 3, 8, 1, 9, 2, 7}
+
+	minHeap := NewMinHeap(values...)
+	checkHeap(t, minHeap)
+	test.GotWantSlice(t, drainHeap(minHeap), []int{1, 2, 3, 5, 7, 8, 9})
+
+	maxHeap := NewMaxHeap(values...)
+	checkHeap(t, maxHeap)
+	test.GotWantSlice(t, drainHeap(maxHeap), []int{9, 8, 7, 5, 3, 2, 1})
+
+	byLength := NewHeap(func(a, b string) bool { return len(a) < len(b) }, "ccc", "a", "bb")
+	test.GotWantSlice(t, drainHeap(byLength), []string{"a", "bb", "ccc"})
+}
+
+// Verifies the heap does not reorder the caller's slice
+func TestHeap_NewHeap_NoAliasing(t *testing.T) {
+	values := []int{3, 2, 1}
+	h := NewMinHeap(values...)
+	h.Push(0)
+	test.GotWantSlice(t, values, []int{3, 2, 1})
+}
+
+// Verifies NewHeapWith panics without a comparator or with a negative
+// capacity
+func TestHeap_NewHeapWith_Panics(t *testing.T) {
+	test.GotWantPanic(t, func() { NewHeapWith[int]() }, ErrorMissingComparator)
+	test.GotWantPanic(t, func() { NewHeapWith(WithCapacity[int](10)) }, ErrorMissingComparator)
+	test.GotWantPanic(t, func() {
+		NewHeapWith(WithComparator(cmp.Less[int]), WithCapacity[int](-1))
+	}, `"capacity" must be >= 0, got -1`)
+}
+
+// Verifies the heap is created empty, ordered by the comparator and with
+// the requested capacity
+func TestHeap_NewHeapWith(t *testing.T) {
+	h := NewHeapWith(
+		WithComparator(func(a, b int) bool { return a > b }),
+		WithCapacity[int](10),
+	)
+	test.GotWant(t, h.IsEmpty(), true)
+	test.GotWant(t, cap(h.data), 10)
+
+	for i := range 10 {
+		h.Push(i)
+	}
+	test.GotWant(t, cap(h.data), 10)
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWantSlice(t, drainHeap(h), []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0})
+}
+
+// Verifies NewHeapWithCapacity panics on a negative capacity
+func TestHeap_NewHeapWithCapacity_Negative(t *testing.T) {
+	test.GotWantPanic(t, func() { NewHeapWithCapacity(cmp.Less[int], -1) }, `"capacity" must be >= 0, got -1`)
+}
+
+// Verifies the heap is created empty with the requested capacity and
+// pushes within it do not reallocate
+func TestHeap_NewHeapWithCapacity(t *testing.T) {
+	h := NewHeapWithCapacity(cmp.Less[int], 10)
+	test.GotWant(t, h.IsEmpty(), true)
+	test.GotWant(t, cap(h.data), 10)
+
+	for i := range 10 {
+		h.Push(10 - i)
+	}
+	test.GotWant(t, cap(h.data), 10)
+	test.GotWantNoError(t, h.CheckInvariants())
+}
+
+// Verifies Pop and Peek on an empty heap
+func TestHeap_PopPeek_EmptyHeap(t *testing.T) {
+	h := NewMinHeap[int]()
+	_, err := h.Pop()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+	_, err = h.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+}
+
+// Verifies pushed elements are popped in sorted order
+func TestHeap_Push_PopSorted(t *testing.T) {
+	h := NewMinHeap[int]()
+	for _, v := range []int{4, 1, 3, 2} {
+		h.Push(v)
+		checkHeap(t, h)
+	}
+
+	top, err := h.Peek()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, top, 1)
+	test.GotWant(t, h.Size(), 4)
+	test.GotWantSlice(t, drainHeap(h), []int{1, 2, 3, 4})
+}
+
+// Verifies duplicate elements are all kept
+func TestHeap_Push_Duplicates(t *testing.T) {
+	h := NewMinHeap(2, 1, 2, 1)
+	test.GotWantSlice(t, drainHeap(h), []int{1, 1, 2, 2})
+}
+
+// Verifies index-based operations reject invalid indices
+func TestHeap_IndexOperations_InvalidIndex(t *testing.T) {
+	h := NewMinHeap(1, 2, 3)
+	for _, i := range []int{-1, 3} {
+		test.GotWantErrorIs(t, h.Fix(i), ErrIndexOutOfRange)
+		test.GotWantError(t, h.Set(i, 0), ErrorIndexOutOfRange)
+		_, err := h.Get(i)
+		test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
+		_, err = h.Remove(i)
+		test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
+	}
+	test.GotWant(t, h.Size(), 3)
+}
+
+// Verifies Fix restores order after a priority moves in either direction
+func TestHeap_Fix(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	less := func(a, b *task) bool { return a.priority < b.priority }
+	tasks := []*task{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}}
+	h := NewHeap(less, tasks...)
+
+	// Decrease: "d" becomes the most urgent
+	tasks[3].priority = 0
+	index := -1
+	for i, tk := range h.Indexed() {
+		if tk == tasks[3] {
+			index = i
+		}
+	}
+	test.GotWant(t, h.Fix(index), nil)
+	top, _ := h.Peek()
+	test.GotWant(t, top.name, "d")
+
+	// Increase: "d" becomes the least urgent again
+	test.GotWant(t, h.Set(0, &task{"d", 9}), nil)
+	checkHeap(t, h)
+
+	names := []string{}
+	for _, tk := range drainHeap(h) {
+		names = append(names, tk.name)
+	}
+	test.GotWantSlice(t, names, []string{"a", "b", "c", "d"})
+}
+
+// Verifies removing the root, a middle and the last element
+func TestHeap_Remove(t *testing.T) {
+	h := NewMinHeap(1, 2, 3, 4, 5, 6, 7)
+
+	v, err := h.Remove(0)
+	test.GotWantNoError(t, err)
+	test.GotWant(t, v, 1)
+	checkHeap(t, h)
+
+	mid, _ := h.Get(2)
+	v, _ = h.Remove(2)
+	test.GotWant(t, v, mid)
+	checkHeap(t, h)
+
+	last, _ := h.Get(h.Size() - 1)
+	v, _ = h.Remove(h.Size() - 1)
+	test.GotWant(t, v, last)
+	checkHeap(t, h)
+
+	test.GotWant(t, h.Size(), 4)
+}
+
+// Verifies All yields every element in storage order and lets the heap
+// feed the collections algorithms
+func TestHeap_All(t *testing.T) {
+	h := NewMinHeap(3, 1, 2)
+	got := slices.Collect(h.All())
+	test.GotWant(t, got[0], 1)
+	slices.Sort(got)
+	test.GotWantSlice(t, got, []int{1, 2, 3})
+	test.GotWant(t, collections.Sum[int](h), 6)
+}
+
+// Verifies Indexed yields every element with its index
+func TestHeap_Indexed(t *testing.T) {
+	h := NewMinHeap(3, 1, 2)
+	got := []int{}
+	for i, v := range h.Indexed() {
+		stored, _ := h.Get(i)
+		test.GotWant(t, v, stored)
+		got = append(got, v)
+	}
+	slices.Sort(got)
+	test.GotWantSlice(t, got, []int{1, 2, 3})
+}
+
+// Verifies ShrinkToFit reallocates to exactly the live size and keeps the
+// heap order
+func TestHeap_ShrinkToFit(t *testing.T) {
+	h := NewMinHeap[int]()
+	for i := range 100 {
+		h.Push(100 - i)
+	}
+	for range 97 {
+		h.Pop()
+	}
+
+	h.ShrinkToFit()
+	test.GotWant(t, cap(h.data), 3)
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	v, _ := h.Pop()
+	test.GotWant(t, v, 98)
+
+	for h.Size() > 0 {
+		h.Pop()
+	}
+	h.ShrinkToFit()
+	test.GotWant(t, cap(h.data), 0)
+}
+
+// Verifies CheckInvariants accepts a valid heap and reports a child that
+// sorts before its parent
+func TestHeap_CheckInvariants(t *testing.T) {
+	h := NewMinHeap(1, 2, 3, 4, 5, 6, 7)
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	h.data[0], h.data[1] = h.data[1], h.data[0]
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: element 1 sorts before its parent 0")
+	test.GotWantErrorIs(t, h.CheckInvariants(), ErrInvariantViolation)
+}
+
+// Verifies random pushes, pops and in-place replacements keep the heap property
+// and match a sorted model
+func TestHeap_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+	h := NewMinHeap[int]()
+	model := []int{}
+
+	for range 3000 {
+		switch r.IntN(4) {
+		case 0, 1:
+			v := r.IntN(1000)
+			h.Push(v)
+			model = append(model, v)
+		case 2:
+			if len(model) > 0 {
+				v, _ := h.Pop()
+				slices.Sort(model)
+				test.GotWant(t, v, model[0])
+				model = model[1:]
+			}
+		case 3:
+			if len(model) > 0 {
+				i := r.IntN(h.Size())
+				old, _ := h.Get(i)
+				v := r.IntN(1000)
+				h.Set(i, v)
+				model[slices.Index(model, old)] = v
+			}
+		}
+		checkHeap(t, h)
+	}
+
+	slices.Sort(model)
+	test.GotWantSlice(t, drainHeap(h), model)
+}
+
+// Verifies DebugTree draws the implicit tree with slice indices
+func TestHeap_DebugTree(t *testing.T) {
+	test.GotWant(t, NewMinHeap[int]().DebugTree() == nil, true)
+
+	var b strings.Builder
+	test.GotWantNoError(t, debug.PrintTree(&b, NewMinHeap(1, 2, 3, 4)))
+	test.GotWant(t, b.String(), `1 [0]
+├── L: 2 [1]
+│   └── L: 4 [3]
+└── R: 3 [2]
+`)
+}
+
+// Verifies Add pushes the element into heap order
+func TestHeap_Add(t *testing.T) {
+	h := NewMinHeap(5, 1, 3)
+	test.GotWant(t, h.Add(0), true)
+	test.GotWant(t, h.Size(), 4)
+
+	v, _ := h.Pop()
+	test.GotWant(t, v, 0)
+}
+
+// Verifies Clear empties the heap and later pushes work
+func TestHeap_Clear(t *testing.T) {
+	h := NewMinHeap(5, 1, 3)
+	h.Clear()
+	test.GotWant(t, h.IsEmpty(), true)
+	test.GotWant(t, h.Size(), 0)
+
+	_, err := h.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+
+	h.Push(7)
+	v, _ := h.Pop()
+	test.GotWant(t, v, 7)
+}
+
+// Verifies a round trip preserves the storage layout and invalid data is
+// rejected
+func TestHeap_MarshalBinary(t *testing.T) {
+	other, _ := codec.EncodeSeq("Heap", 1, slices.Values([]string{"x"}))
+	dst := NewMinHeap(7)
+	codectest.RoundTrip(t, NewMinHeap(5, 3, 8, 1, 9, 2), dst,
+		func(h *Heap[int]) []int { return slices.Clone(h.data) }, other)
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
+
+// Verifies elements encoded in another order are heapified under the
+// receiving heap's less function
+func TestHeap_UnmarshalBinary_Heapifies(t *testing.T) {
+	data, _ := codec.EncodeSeq("Heap", 4, slices.Values([]int{4, 3, 2, 1}))
+
+	h := NewMinHeap[int]()
+	test.GotWantNoError(t, h.UnmarshalBinary(data))
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWant(t, h.Size(), 4)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the storage layout of the heap
+func TestHeap_WriteTo_ReadFrom(t *testing.T) {
+	src := NewMinHeap(3, 1, 2)
+	dst := NewMinHeap(9)
+	codectest.StreamRoundTrip(t, src, dst, func(h *Heap[int]) []int { return slices.Clone(h.data) })
+}
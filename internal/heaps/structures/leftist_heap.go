@@ -0,0 +1,355 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/memory"
+)
+
+// Compile-time interface verifications
+var _ MergeableHeap[int, *LeftistHeap[int]] = &LeftistHeap[int]{}
+var _ collections.Sized = &LeftistHeap[int]{}
+var _ collections.Addable[int] = &LeftistHeap[int]{}
+var _ collections.Iterable[int] = &LeftistHeap[int]{}
+
+// Represents a single node in a leftist heap.
+// Rank is the length of the shortest path to a missing child.
+type leftistNode[T any] struct {
+	value T
+	left  *leftistNode[T]
+	right *leftistNode[T]
+	rank  int
+}
+
+// Returns the rank of the subtree, 0 for nil.
+func (n *leftistNode[T]) rankOf() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.rank
+}
+
+// LeftistHeap implements a mergeable heap as a leftist tree ordered by a
+// caller-supplied less function.
+//
+// Every node's left child has a rank at least as large as its right
+// child's, so the right spine of a heap with n elements has at most
+// log2(n+1) nodes. Merging walks only the two right spines, giving a
+// worst-case O(log n) bound for Merge, Push and Pop alike.
+//
+// Design decisions:
+//   - Pointer-based tree: Merge splices nodes without copying
+//   - Stored ranks: The leftist invariant is restored on the way back up
+//   - Worst-case bounds: Unlike the skew heap, no operation is amortized
+//   - Optional free list: Popped nodes can be recycled (see
+//     WithFreeListCapacity)
+//
+// Space complexity: O(n) where n is the number of elements.
+type LeftistHeap[T any] struct {
+	root *leftistNode[T]
+	less func(a T, b T) bool
+	size int
+	free *memory.FreeList[leftistNode[T]] // nil unless node recycling is enabled
+}
+
+// NewLeftistHeap creates a leftist heap ordered by less containing the
+// given values.
+//
+// Example:
+//
+//	a := NewLeftistHeap(cmp.Less[int], 5, 1)
+//	b := NewLeftistHeap(cmp.Less[int], 3)
+//	a.Merge(b)
+//	a.Pop()  // Returns 1
+func NewLeftistHeap[T any](less func(a T, b T) bool, values ...T) *LeftistHeap[T] {
+	h := &LeftistHeap[T]{less: less}
+	for _, v := range values {
+		h.Push(v)
+	}
+
+	return h
+}
+
+// NewLeftistHeapWith creates an empty leftist heap from the given
+// options. Options are applied in order, and WithComparator is required.
+//
+// Panics if no comparator is given.
+//
+// Example:
+//
+//	h := NewLeftistHeapWith(WithComparator(cmp.Less[int]))
+func NewLeftistHeapWith[T any](opts ...MergeableHeapOption[T]) *LeftistHeap[T] {
+	s := mergeableHeapSettingsOf(opts)
+	h := &LeftistHeap[T]{less: s.less}
+	if s.freeListCapacity > 0 {
+		h.free = memory.NewFreeListWith(
+			memory.WithCapacity[leftistNode[T]](s.freeListCapacity),
+		)
+	}
+
+	return h
+}
+
+// Push adds an element to the heap by merging in a single-node heap.
+//
+// Time complexity: O(log n)
+func (h *LeftistHeap[T]) Push(value T) {
+	h.root = h.merge(h.root, h.newNode(value))
+	h.size++
+}
+
+// Add pushes the element onto the heap, as Push does.
+// Always returns true.
+//
+// Time complexity: O(log n)
+func (h *LeftistHeap[T]) Add(value T) bool {
+	h.Push(value)
+	return true
+}
+
+// Pop removes and returns the root element by merging its subtrees.
+// Returns an error if the heap is empty.
+//
+// Time complexity: O(log n)
+func (h *LeftistHeap[T]) Pop() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+
+	root := h.root
+	h.root = h.merge(root.left, root.right)
+	h.size--
+	value := root.value
+	h.recycle(root)
+	return value, nil
+}
+
+// Peek returns the root element without removing it.
+// Returns an error if the heap is empty.
+//
+// Time complexity: O(1)
+func (h *LeftistHeap[T]) Peek() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+
+	return h.root.value, nil
+}
+
+// Merge moves all elements of other into this heap and empties other.
+// Both heaps must use the same ordering.
+//
+// Time complexity: O(log n + log m) where m is the size of other
+func (h *LeftistHeap[T]) Merge(other *LeftistHeap[T]) {
+	if other == h {
+		return
+	}
+
+	h.root = h.merge(h.root, other.root)
+	h.size += other.size
+	other.root = nil
+	other.size = 0
+}
+
+// Clear removes all elements.
+//
+// Time complexity: O(1)
+func (h *LeftistHeap[T]) Clear() {
+	h.root = nil
+	h.size = 0
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements in preorder in the format of package codec. The less function
+// is not encoded.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (h *LeftistHeap[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("LeftistHeap", h.size, h.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the heap with those encoded by MarshalBinary, pushing them
+// in their encoded order, so the result is a valid heap under h's less
+// whatever less the data was encoded with.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded heap, in which case the heap is left unchanged.
+//
+// Time complexity: O(n log n)
+func (h *LeftistHeap[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "LeftistHeap")
+	if err != nil {
+		return err
+	}
+
+	h.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (h *LeftistHeap[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "LeftistHeap", h.size, h.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// heap with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The heap is
+// left unchanged on error.
+//
+// Time complexity: O(n log n)
+func (h *LeftistHeap[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "LeftistHeap")
+	if err != nil {
+		return n, err
+	}
+
+	h.restore(values)
+	return n, nil
+}
+
+// All returns an iterator over the elements in preorder, which puts every
+// element after its parent but is not sorted. The tree is walked with an
+// explicit stack so that long spines cannot overflow the call stack. The
+// heap must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (h *LeftistHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []*leftistNode[T]
+		if h.root != nil {
+			stack = append(stack, h.root)
+		}
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.value) {
+				return
+			}
+			if n.right != nil {
+				stack = append(stack, n.right)
+			}
+			if n.left != nil {
+				stack = append(stack, n.left)
+			}
+		}
+	}
+}
+
+// IsEmpty returns true if the heap contains no elements.
+//
+// Time complexity: O(1)
+func (h *LeftistHeap[T]) IsEmpty() bool {
+	return h.size == 0
+}
+
+// Size returns the number of elements in the heap.
+//
+// Time complexity: O(1)
+func (h *LeftistHeap[T]) Size() int {
+	return h.size
+}
+
+// CheckInvariants verifies the leftist tree: no element sorts before its
+// parent, every left child has a rank at least that of its right sibling,
+// every stored rank is one more than its right child's, and the number of
+// nodes matches Size. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the heap is consistent.
+//
+// Time complexity: O(n)
+func (h *LeftistHeap[T]) CheckInvariants() error {
+	count := 0
+	var stack []*leftistNode[T]
+	if h.root != nil {
+		stack = append(stack, h.root)
+	}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		count++
+		if n.rank != n.right.rankOf()+1 {
+			return fmt.Errorf("%w: node %v has rank %d, want %d", ErrInvariantViolation, n.value, n.rank, n.right.rankOf()+1)
+		}
+		if n.left.rankOf() < n.right.rankOf() {
+			return fmt.Errorf("%w: node %v has left rank %d below right rank %d", ErrInvariantViolation, n.value, n.left.rankOf(), n.right.rankOf())
+		}
+		for _, c := range []*leftistNode[T]{n.left, n.right} {
+			if c == nil {
+				continue
+			}
+			if h.less(c.value, n.value) {
+				return fmt.Errorf("%w: element %v sorts before its parent %v", ErrInvariantViolation, c.value, n.value)
+			}
+			stack = append(stack, c)
+		}
+	}
+	if count != h.size {
+		return fmt.Errorf("%w: size is %d, tree holds %d nodes", ErrInvariantViolation, h.size, count)
+	}
+
+	return nil
+}
+
+// Merges two subtrees along their right spines and returns the new root,
+// swapping children where needed to keep the left rank the larger one.
+func (h *LeftistHeap[T]) merge(a *leftistNode[T], b *leftistNode[T]) *leftistNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	a.right = h.merge(a.right, b)
+	if a.left.rankOf() < a.right.rankOf() {
+		a.left, a.right = a.right, a.left
+	}
+
+	a.rank = a.right.rankOf() + 1
+	return a
+}
+
+// Replaces the elements with the decoded ones.
+func (h *LeftistHeap[T]) restore(values []T) {
+	h.Clear()
+	for _, v := range values {
+		h.Push(v)
+	}
+}
+
+// Returns a single-node heap holding the value, taken from the free list
+// if node recycling is enabled.
+func (h *LeftistHeap[T]) newNode(value T) *leftistNode[T] {
+	if h.free == nil {
+		return &leftistNode[T]{value: value, rank: 1}
+	}
+
+	n := h.free.Get()
+	n.value, n.rank = value, 1
+	return n
+}
+
+// Hands a popped node to the free list, if node recycling is enabled.
+// The node must not be used afterwards.
+func (h *LeftistHeap[T]) recycle(n *leftistNode[T]) {
+	if h.free != nil {
+		h.free.Put(n)
+	}
+}
@@ -0,0 +1,352 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewLeftistHeap):
+  ✓ Empty heap
+  ✓ With values
+
+Constructor (NewLeftistHeapWith):
+  ✓ Missing comparator (panic)
+  ✓ Empty heap ordered by the comparator
+
+Node recycling (WithFreeListCapacity):
+  ✓ Negative capacity (panic)
+  ✓ Popped nodes are recycled zeroed, pushes reuse them
+  ✓ Free list bounded by its capacity
+
+Push/Pop/Peek:
+  ✓ Pop and Peek on an empty heap
+  ✓ Pop yields sorted order
+
+Merge:
+  ✓ Merge two non-empty heaps, other is emptied
+  ✓ Merge with empty heaps
+  ✓ Merge with itself is a no-op
+
+Properties:
+  ✓ Rank invariant, stored ranks and heap order hold under random
+    pushes, pops and merges
+  ✓ Right spine length is logarithmic
+
+CheckInvariants:
+  ✓ Valid heaps, wrong rank, leftist order, heap order and size
+
+All:
+  ✓ Yields every element once, root first, usable as an Iterable
+
+Add/Clear:
+  ✓ Add pushes and always returns true
+  ✓ Clear empties the heap, which stays usable
+
+Binary encoding:
+  ✓ Round trip into a heap of another order, invalid data rejected
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+*/
+
+import (
+	"cmp"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the elements of the heap in ascending order without popping them.
+func leftistValues(h *LeftistHeap[int]) []int {
+	values := []int{}
+	var walk func(n *leftistNode[int])
+	walk = func(n *leftistNode[int]) {
+		if n != nil {
+			values = append(values, n.value)
+			walk(n.left)
+			walk(n.right)
+		}
+	}
+	walk(h.root)
+	slices.Sort(values)
+	return values
+}
+
+// Pops every element of a mergeable heap, returning them in pop order.
+func drainMergeable[H MergeableHeap[int, H]](h H) []int {
+	values := []int{}
+	for !h.IsEmpty() {
+		v, _ := h.Pop()
+		values = append(values, v)
+	}
+	return values
+}
+
+// Verifies the creation of an empty heap
+func TestLeftistHeap_NewLeftistHeap_Empty(t *testing.T) {
+	h := NewLeftistHeap(cmp.Less[int])
+	test.GotWant(t, h.Size(), 0)
+	test.GotWant(t, h.IsEmpty(), true)
+}
+
+// Verifies the creation of a heap with values
+func TestLeftistHeap_NewLeftistHeap_WithValues(t *testing.T) {
+	h := NewLeftistHeap(cmp.Less[int], 5, 3, 8, 1)
+	test.GotWant(t, h.Size(), 4)
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWantSlice(t, drainMergeable(h), []int{1, 3, 5, 8})
+}
+
+// Verifies NewLeftistHeapWith panics without a comparator
+func TestLeftistHeap_NewLeftistHeapWith_MissingComparator(t *testing.T) {
+	test.GotWantPanic(t, func() { NewLeftistHeapWith[int]() }, ErrorMissingComparator)
+}
+
+// Verifies the heap is created empty and ordered by the comparator
+func TestLeftistHeap_NewLeftistHeapWith(t *testing.T) {
+	h := NewLeftistHeapWith(WithComparator(func(a, b int) bool { return a > b }))
+	test.GotWant(t, h.IsEmpty(), true)
+
+	for _, v := range []int{5, 3, 8, 1} {
+		h.Push(v)
+	}
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWantSlice(t, drainMergeable(h), []int{8, 5, 3, 1})
+}
+
+// Verifies a negative free list capacity panics
+func TestLeftistHeap_InvalidFreeListCapacity(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewLeftistHeapWith(WithComparator(cmp.Less[int]), WithFreeListCapacity[int](-1))
+	}, `"free list capacity" must be >= 0, got -1`)
+}
+
+// Verifies every popped node is handed to the free list zeroed, and
+// pushes take recycled nodes before allocating
+func TestLeftistHeap_FreeList_Reuse(t *testing.T) {
+	h := NewLeftistHeapWith(WithComparator(cmp.Less[int]), WithFreeListCapacity[int](8))
+	for _, v := range []int{5, 3, 8, 1} {
+		h.Push(v)
+	}
+	root := h.root
+
+	h.Pop()
+	h.Pop()
+	test.GotWant(t, h.free.Size(), 2)
+	test.GotWant(t, *root, leftistNode[int]{})
+
+	h.Push(2)
+	h.Push(9)
+	h.Push(4)
+	test.GotWant(t, h.free.Size(), 0)
+	test.GotWant(t, h.free.Stats().Reused, 2)
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWantSlice(t, drainMergeable(h), []int{2, 4, 5, 8, 9})
+}
+
+// Verifies nodes popped while the free list is full are dropped
+func TestLeftistHeap_FreeList_Capacity(t *testing.T) {
+	h := NewLeftistHeapWith(WithComparator(cmp.Less[int]), WithFreeListCapacity[int](2))
+	for i := range 4 {
+		h.Push(i)
+	}
+	for range 4 {
+		h.Pop()
+	}
+
+	test.GotWant(t, h.free.Size(), 2)
+	test.GotWant(t, h.free.Stats().Dropped, 2)
+}
+
+// Verifies Pop and Peek on an empty heap
+func TestLeftistHeap_PopPeek_EmptyHeap(t *testing.T) {
+	h := NewLeftistHeap(cmp.Less[int])
+	_, err := h.Pop()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+	_, err = h.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+}
+
+// Verifies pushed elements are popped in sorted order
+func TestLeftistHeap_Push_PopSorted(t *testing.T) {
+	h := NewLeftistHeap(func(a, b int) bool { return a > b })
+	for _, v := range []int{2, 9, 4, 7} {
+		h.Push(v)
+	}
+
+	top, err := h.Peek()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, top, 9)
+	test.GotWantSlice(t, drainMergeable(h), []int{9, 7, 4, 2})
+}
+
+// Verifies merging moves every element and empties the other heap
+func TestLeftistHeap_Merge(t *testing.T) {
+	a := NewLeftistHeap(cmp.Less[int], 1, 4, 7)
+	b := NewLeftistHeap(cmp.Less[int], 2, 3, 9)
+
+	a.Merge(b)
+	test.GotWant(t, a.Size(), 6)
+	test.GotWant(t, b.Size(), 0)
+	test.GotWant(t, b.IsEmpty(), true)
+	test.GotWantNoError(t, a.CheckInvariants())
+	test.GotWantSlice(t, drainMergeable(a), []int{1, 2, 3, 4, 7, 9})
+}
+
+// Verifies merging with empty heaps on either side
+func TestLeftistHeap_Merge_Empty(t *testing.T) {
+	a := NewLeftistHeap(cmp.Less[int], 1, 2)
+	a.Merge(NewLeftistHeap(cmp.Less[int]))
+	test.GotWant(t, a.Size(), 2)
+
+	empty := NewLeftistHeap(cmp.Less[int])
+	empty.Merge(a)
+	test.GotWant(t, empty.Size(), 2)
+	test.GotWant(t, a.Size(), 0)
+	test.GotWantSlice(t, drainMergeable(empty), []int{1, 2})
+}
+
+// Verifies merging a heap with itself changes nothing
+func TestLeftistHeap_Merge_Self(t *testing.T) {
+	h := NewLeftistHeap(cmp.Less[int], 3, 1, 2)
+	h.Merge(h)
+	test.GotWant(t, h.Size(), 3)
+	test.GotWantSlice(t, drainMergeable(h), []int{1, 2, 3})
+}
+
+// Verifies the leftist invariants and heap order after every step of
+// random pushes, pops and merges, and that pops match a sorted model
+func TestLeftistHeap_Properties(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+	h := NewLeftistHeap(cmp.Less[int])
+	model := []int{}
+
+	for range 2000 {
+		switch r.IntN(4) {
+		case 0:
+			v := r.IntN(1000)
+			h.Push(v)
+			model = append(model, v)
+		case 1:
+			if len(model) > 0 {
+				v, _ := h.Pop()
+				slices.Sort(model)
+				test.GotWant(t, v, model[0])
+				model = model[1:]
+			}
+		default:
+			other := NewLeftistHeap(cmp.Less[int])
+			for range r.IntN(8) {
+				v := r.IntN(1000)
+				other.Push(v)
+				model = append(model, v)
+			}
+			h.Merge(other)
+		}
+		test.GotWantNoError(t, h.CheckInvariants())
+	}
+
+	slices.Sort(model)
+	test.GotWantSlice(t, drainMergeable(h), model)
+}
+
+// Verifies the right spine never exceeds log2(n+1) nodes, even for
+// sorted input
+func TestLeftistHeap_Properties_RightSpine(t *testing.T) {
+	h := NewLeftistHeap(cmp.Less[int])
+	for i := range 4096 {
+		h.Push(4096 - i)
+
+		spine := 0
+		for n := h.root; n != nil; n = n.right {
+			spine++
+		}
+		limit := bits.Len(uint(h.Size() + 1))
+		if spine > limit {
+			t.Fatalf("right spine of %d nodes exceeds %d at size %d", spine, limit, h.Size())
+		}
+	}
+}
+
+// Verifies CheckInvariants accepts valid heaps and reports a wrong rank,
+// a right child outranking the left, a child sorting first and a wrong
+// size
+func TestLeftistHeap_CheckInvariants(t *testing.T) {
+	h := NewLeftistHeap(cmp.Less[int])
+	test.GotWantNoError(t, h.CheckInvariants())
+	h = NewLeftistHeap(cmp.Less[int], 1, 2, 3)
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	h.root.rank = 5
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: node 1 has rank 5, want 2")
+	h.root.rank = 2
+
+	left := h.root.left
+	h.root.left = nil
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: node 1 has left rank 0 below right rank 1")
+	h.root.left = left
+
+	h.root.value = 4
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: element 2 sorts before its parent 4")
+	h.root.value = 1
+
+	h.size++
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: size is 4, tree holds 3 nodes")
+	test.GotWantErrorIs(t, h.CheckInvariants(), ErrInvariantViolation)
+	h.size--
+	test.GotWantNoError(t, h.CheckInvariants())
+}
+
+// Verifies All yields every element once in preorder, starting at the root,
+// and lets the heap feed the collections algorithms
+func TestLeftistHeap_All(t *testing.T) {
+	h := NewLeftistHeap(cmp.Less[int], 5, 1, 9, 3)
+	got := slices.Collect(h.All())
+	test.GotWant(t, got[0], 1)
+	slices.Sort(got)
+	test.GotWantSlice(t, got, []int{1, 3, 5, 9})
+	test.GotWant(t, collections.Sum[int](h), 18)
+}
+
+// Verifies Add pushes the element into heap order
+func TestLeftistHeap_Add(t *testing.T) {
+	h := NewLeftistHeap(cmp.Less[int], 5, 1, 3)
+	test.GotWant(t, h.Add(0), true)
+	test.GotWant(t, h.Size(), 4)
+
+	v, _ := h.Pop()
+	test.GotWant(t, v, 0)
+}
+
+// Verifies Clear empties the heap and later pushes work
+func TestLeftistHeap_Clear(t *testing.T) {
+	h := NewLeftistHeap(cmp.Less[int], 5, 1, 3)
+	h.Clear()
+	test.GotWant(t, h.IsEmpty(), true)
+	test.GotWant(t, h.Size(), 0)
+
+	_, err := h.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+
+	h.Push(7)
+	v, _ := h.Pop()
+	test.GotWant(t, v, 7)
+}
+
+// Verifies data encoded by a min-heap decodes into a valid max-heap and
+// invalid data is rejected
+func TestLeftistHeap_MarshalBinary(t *testing.T) {
+	other, _ := NewHeap(cmp.Less[int], 1).MarshalBinary()
+	dst := NewLeftistHeap(func(a, b int) bool { return a > b }, 7)
+	codectest.RoundTrip(t, NewLeftistHeap(cmp.Less[int], 5, 3, 8, 1, 9, 2), dst, leftistValues, other)
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements as a valid heap
+func TestLeftistHeap_WriteTo_ReadFrom(t *testing.T) {
+	src := NewLeftistHeap(cmp.Less[int], 3, 1, 2)
+	dst := NewLeftistHeap(cmp.Less[int])
+	codectest.StreamRoundTrip(t, src, dst, leftistValues)
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
@@ -0,0 +1,39 @@
+package structures
+
+// MergeableHeap defines the interface for a priority heap that supports
+// efficiently melding two heaps into one.
+//
+// Type parameter H is the implementing heap type itself, so Merge only
+// accepts heaps of the same implementation, whose internal node layout it
+// can splice.
+//
+// All implementations guarantee:
+//   - Push operations add elements while preserving heap order
+//   - Pop operations remove the root element
+//   - Peek operations observe the root without removal
+//   - Merge operations move every element of the other heap into this one
+//     in O(log n), leaving the other heap empty
+//   - Size and IsEmpty operations reflect current state
+//
+// Both heaps passed to Merge must use the same ordering.
+type MergeableHeap[T any, H any] interface {
+	// Push adds an element to the heap.
+	Push(value T)
+
+	// Pop removes and returns the root element.
+	// Returns an error if the heap is empty.
+	Pop() (T, error)
+
+	// Peek returns the root element without removing it.
+	// Returns an error if the heap is empty.
+	Peek() (T, error)
+
+	// Merge moves all elements of other into this heap and empties other.
+	Merge(other H)
+
+	// IsEmpty returns true if the heap contains no elements.
+	IsEmpty() bool
+
+	// Size returns the number of elements currently in the heap.
+	Size() int
+}
@@ -0,0 +1,422 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"math/bits"
+	"slices"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/debug"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &MinMaxHeap[int]{}
+var _ collections.Addable[int] = &MinMaxHeap[int]{}
+var _ collections.Iterable[int] = &MinMaxHeap[int]{}
+
+// MinMaxHeap implements a double-ended priority queue as a min-max heap
+// ordered by a caller-supplied less function.
+//
+// The heap is a complete binary tree stored in a slice, like Heap, whose
+// levels alternate between min levels (even depth, starting at the root)
+// and max levels (odd depth). Every node on a min level is not greater
+// than any of its descendants, and every node on a max level is not less
+// than any of its descendants. The minimum is therefore the root and the
+// maximum one of its two children, so both ends are reachable in O(1) and
+// removable in O(log n) from a single structure.
+//
+// Typical uses are bounded collections that evict from both ends, such as
+// keeping the k best candidates while discarding the worst, or tracking a
+// sliding median with two bounded halves.
+//
+// Design decisions:
+//   - Slice storage: No per-element allocation, same layout as Heap
+//   - Grandparent sifting: Elements move two levels at a time within
+//     their own kind of level
+//   - Bottom-up heapify: O(n) construction from an initial set of values
+//
+// Space complexity: O(n) where n is the number of elements.
+type MinMaxHeap[T any] struct {
+	data []T
+	less func(a T, b T) bool
+}
+
+// NewMinMaxHeap creates a min-max heap ordered by less containing the
+// given values. The values are arranged in O(n) with bottom-up heapify.
+//
+// Example:
+//
+//	h := NewMinMaxHeap(cmp.Less[int], 5, 1, 9, 3)
+//	h.PopMin()  // Returns 1
+//	h.PopMax()  // Returns 9
+func NewMinMaxHeap[T any](less func(a T, b T) bool, values ...T) *MinMaxHeap[T] {
+	h := &MinMaxHeap[T]{data: append([]T(nil), values...), less: less}
+	for i := len(h.data)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+
+	return h
+}
+
+// NewMinMaxHeapWith creates an empty min-max heap from the given options.
+// Options are applied in order, and WithComparator is required.
+//
+// Panics if no comparator is given or the capacity is negative.
+//
+// Example:
+//
+//	h := NewMinMaxHeapWith(
+//	    WithComparator(cmp.Less[int]),
+//	    WithCapacity[int](1000),
+//	)
+func NewMinMaxHeapWith[T any](opts ...HeapOption[T]) *MinMaxHeap[T] {
+	s := heapSettingsOf(opts)
+	return &MinMaxHeap[T]{data: make([]T, 0, s.capacity), less: s.less}
+}
+
+// NewMinMaxHeapWithCapacity creates an empty min-max heap ordered by
+// less with room for capacity elements, so pushing up to that many
+// elements does not reallocate, like make([]T, 0, capacity).
+//
+// Panics if capacity is negative.
+//
+// Deprecated: Use NewMinMaxHeapWith with WithComparator and WithCapacity.
+func NewMinMaxHeapWithCapacity[T any](less func(a T, b T) bool, capacity int) *MinMaxHeap[T] {
+	return NewMinMaxHeapWith(WithComparator(less), WithCapacity[T](capacity))
+}
+
+// Push adds an element to the heap.
+//
+// Time complexity: O(log n), amortized for slice growth
+func (h *MinMaxHeap[T]) Push(value T) {
+	h.data = append(h.data, value)
+	h.up(len(h.data) - 1)
+}
+
+// Add pushes the element onto the heap, as Push does.
+// Always returns true.
+//
+// Time complexity: O(log n), amortized for slice growth
+func (h *MinMaxHeap[T]) Add(value T) bool {
+	h.Push(value)
+	return true
+}
+
+// PeekMin returns the smallest element without removing it.
+// Returns an error if the heap is empty.
+//
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) PeekMin() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+
+	return h.data[0], nil
+}
+
+// PeekMax returns the largest element without removing it.
+// Returns an error if the heap is empty.
+//
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) PeekMax() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+
+	return h.data[h.maxIndex()], nil
+}
+
+// PopMin removes and returns the smallest element.
+// Returns an error if the heap is empty.
+//
+// Time complexity: O(log n)
+func (h *MinMaxHeap[T]) PopMin() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+
+	return h.removeAt(0), nil
+}
+
+// PopMax removes and returns the largest element.
+// Returns an error if the heap is empty.
+//
+// Time complexity: O(log n)
+func (h *MinMaxHeap[T]) PopMax() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+
+	return h.removeAt(h.maxIndex()), nil
+}
+
+// Clear removes all elements.
+//
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) Clear() {
+	h.data = nil
+}
+
+// ShrinkToFit reallocates the underlying slice to exactly Size()
+// elements, releasing the capacity left behind by earlier pushes.
+//
+// Time complexity: O(n)
+func (h *MinMaxHeap[T]) ShrinkToFit() {
+	if cap(h.data) == len(h.data) {
+		return
+	}
+
+	if len(h.data) == 0 {
+		h.data = nil
+	} else {
+		data := make([]T, len(h.data))
+		copy(data, h.data)
+		h.data = data
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements in storage order in the format of package codec, so the
+// layout of the heap is preserved. The less function is not encoded.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (h *MinMaxHeap[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("MinMaxHeap", len(h.data), slices.Values(h.data))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the heap with those encoded by MarshalBinary. Data encoded
+// by a heap with the same less function keeps its layout; any other
+// order is heapified, so the result is always a valid heap under h's less.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded heap, in which case the heap is left unchanged.
+//
+// Time complexity: O(n)
+func (h *MinMaxHeap[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "MinMaxHeap")
+	if err != nil {
+		return err
+	}
+
+	h.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (h *MinMaxHeap[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "MinMaxHeap", len(h.data), slices.Values(h.data))
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// heap with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The heap is
+// left unchanged on error.
+//
+// Time complexity: O(n)
+func (h *MinMaxHeap[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "MinMaxHeap")
+	if err != nil {
+		return n, err
+	}
+
+	h.restore(values)
+	return n, nil
+}
+
+// All returns an iterator over the elements in storage order, which
+// satisfies the min-max property but is not sorted. The heap must not be
+// modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (h *MinMaxHeap[T]) All() iter.Seq[T] {
+	return slices.Values(h.data)
+}
+
+// IsEmpty returns true if the heap contains no elements.
+//
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) IsEmpty() bool {
+	return len(h.data) == 0
+}
+
+// Size returns the number of elements in the heap.
+//
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) Size() int {
+	return len(h.data)
+}
+
+// Returns the index of the largest element of a non-empty heap:
+// the root if it is alone, otherwise the larger of its children.
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch len(h.data) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+
+	if h.less(h.data[1], h.data[2]) {
+		return 2
+	}
+
+	return 1
+}
+
+// Removes the element at a valid index by moving the last element into
+// its place and sifting it down.
+func (h *MinMaxHeap[T]) removeAt(i int) T {
+	last := len(h.data) - 1
+	value := h.data[i]
+	h.data[i] = h.data[last]
+
+	var zero T
+	h.data[last] = zero // Help GC
+	h.data = h.data[:last]
+
+	if i < last {
+		h.down(i)
+	}
+
+	return value
+}
+
+// DebugTree returns a snapshot of the heap's implicit binary tree for
+// debug.PrintTree, each node labeled with its value, its index in the
+// underlying slice and whether it lies on a min or a max level.
+// Returns nil if the heap is empty.
+//
+// Time complexity: O(n)
+func (h *MinMaxHeap[T]) DebugTree() *debug.TreeNode {
+	return heapDebugTree(len(h.data), 0, "", func(i int) string {
+		level := "max"
+		if isMinLevel(i) {
+			level = "min"
+		}
+		return fmt.Sprintf("%v [%d] %s", h.data[i], i, level)
+	})
+}
+
+// CheckInvariants verifies the min-max heap property: every element on a
+// min level is not greater, and every element on a max level not less,
+// than its descendants. Comparing each element with its parent and
+// grandparent covers all ancestors. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the heap is consistent.
+//
+// Time complexity: O(n)
+func (h *MinMaxHeap[T]) CheckInvariants() error {
+	for i := 1; i < len(h.data); i++ {
+		ancestors := []int{(i - 1) / 2}
+		if i > 2 {
+			ancestors = append(ancestors, ((i-1)/2-1)/2)
+		}
+
+		for _, a := range ancestors {
+			if h.before(a, h.data[i], h.data[a]) {
+				return fmt.Errorf("%w: element %d belongs above its ancestor %d", ErrInvariantViolation, i, a)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Returns true if the index lies on a min level (even depth).
+func isMinLevel(i int) bool {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+// Returns true if a should sit above b on the level kind of the index:
+// smaller on min levels, larger on max levels.
+func (h *MinMaxHeap[T]) before(i int, a T, b T) bool {
+	if isMinLevel(i) {
+		return h.less(a, b)
+	}
+
+	return h.less(b, a)
+}
+
+// Moves a newly appended element up to its place. The element first
+// settles whether it belongs to the min or max levels by comparing with
+// its parent, then climbs through grandparents on those levels.
+func (h *MinMaxHeap[T]) up(i int) {
+	if i == 0 {
+		return
+	}
+
+	parent := (i - 1) / 2
+	if h.before(parent, h.data[i], h.data[parent]) {
+		// Wrong kind of level: e.g. larger than a max-level parent
+		h.data[i], h.data[parent] = h.data[parent], h.data[i]
+		i = parent
+	}
+
+	for i > 2 {
+		grandparent := (i - 3) / 4
+		if !h.before(i, h.data[i], h.data[grandparent]) {
+			break
+		}
+
+		h.data[i], h.data[grandparent] = h.data[grandparent], h.data[i]
+		i = grandparent
+	}
+}
+
+// Moves the element at index i down to its place, comparing it with the
+// most extreme of its children and grandchildren for its level kind.
+func (h *MinMaxHeap[T]) down(i int) {
+	n := len(h.data)
+	for {
+		// Find the most extreme descendant within two levels
+		best := -1
+		first := 2*i + 1
+		for _, c := range []int{first, first + 1, 2*first + 1, 2*first + 2, 2*first + 3, 2*first + 4} {
+			if c < n && (best == -1 || h.before(i, h.data[c], h.data[best])) {
+				best = c
+			}
+		}
+
+		if best == -1 || !h.before(i, h.data[best], h.data[i]) {
+			return
+		}
+
+		h.data[i], h.data[best] = h.data[best], h.data[i]
+		if best <= first+1 {
+			// A child won: its own children are grandchildren of i
+			// that already lost the comparison, so the order holds
+			return
+		}
+
+		// The element moved to the grandchild may now be on the wrong
+		// side of its parent, which sits on the opposite level kind
+		parent := (best - 1) / 2
+		if h.before(parent, h.data[best], h.data[parent]) {
+			h.data[best], h.data[parent] = h.data[parent], h.data[best]
+		}
+
+		i = best
+	}
+}
+
+// Replaces the elements with the decoded values, heapifying them unless
+// they already satisfy the heap property.
+func (h *MinMaxHeap[T]) restore(values []T) {
+	h.data = values
+	for i := len(h.data)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+}
@@ -0,0 +1,404 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewMinMaxHeap):
+  ✓ Empty heap
+  ✓ Heapify initial values
+  ✓ Initial values slice is not aliased
+
+Constructor (NewMinMaxHeapWith):
+  ✓ Missing comparator and negative capacity (panic)
+  ✓ Empty heap ordered by the comparator with the requested capacity
+
+Constructor (NewMinMaxHeapWithCapacity):
+  ✓ Negative capacity (panic)
+  ✓ Empty heap with the requested capacity
+
+PeekMin/PeekMax/PopMin/PopMax:
+  ✓ Empty heap
+  ✓ Single and two element heaps
+  ✓ PopMin yields ascending order, PopMax descending order
+  ✓ Duplicates
+
+ShrinkToFit:
+  ✓ Capacity equals size, heap order preserved
+  ✓ Empty heap releases the slice
+
+CheckInvariants:
+  ✓ Valid heap, element out of min-max order
+
+Randomized:
+  ✓ Mixed operations keep the min-max property and match a sorted model
+  ✓ Evicting from both ends leaves the median
+
+DebugTree:
+  ✓ Empty heap, values with slice indices and level kinds
+
+All:
+  ✓ Yields every element once, root first, usable as an Iterable
+
+Add/Clear:
+  ✓ Add pushes and always returns true
+  ✓ Clear empties the heap, which stays usable
+
+Binary encoding:
+  ✓ Round trip preserves the layout, invalid data rejected
+  ✓ Foreign order is heapified
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the heap unchanged
+*/
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/debug"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies every node on a min level is not greater than its children and
+// grandchildren, and every node on a max level is not less than them.
+func checkMinMaxHeap[T any](t *testing.T, h *MinMaxHeap[T]) {
+	t.Helper()
+	test.GotWantNoError(t, h.CheckInvariants())
+	n := len(h.data)
+	for i := range n {
+		first := 2*i + 1
+		for _, c := range []int{first, first + 1, 2*first + 1, 2*first + 2, 2*first + 3, 2*first + 4} {
+			if c < n && h.before(i, h.data[c], h.data[i]) {
+				t.Errorf("min-max property violated between index %d and %d", i, c)
+			}
+		}
+	}
+}
+
+// Verifies the creation of an empty heap
+func TestMinMaxHeap_NewMinMaxHeap_Empty(t *testing.T) {
+	h := NewMinMaxHeap(cmp.Less[int])
+	test.GotWant(t, h.Size(), 0)
+	test.GotWant(t, h.IsEmpty(), true)
+}
+
+// Verifies initial values are arranged into a valid min-max heap
+func TestMinMaxHeap_NewMinMaxHeap_Heapify(t *testing.T) {
+	values := []int{9, 4, 7, 1, 8, 2, 6, 3, 5, 0, 11, 10}
+	h := NewMinMaxHeap(cmp.Less[int], values...)
+	test.GotWant(t, h.Size(), len(values))
+	checkMinMaxHeap(t, h)
+
+	lo, _ := h.PeekMin()
+	hi, _ := h.PeekMax()
+	test.GotWant(t, lo, 0)
+	test.GotWant(t, hi, 11)
+}
+
+// Verifies the heap does not modify the slice passed to the constructor
+func TestMinMaxHeap_NewMinMaxHeap_NoAliasing(t *testing.T) {
+	values := []int{3, 1, 2}
+	h := NewMinMaxHeap(cmp.Less[int], values...)
+	h.PopMin()
+	h.Push(9)
+	test.GotWantSlice(t, values, []int{3, 1, 2})
+}
+
+// Verifies NewMinMaxHeapWith panics without a comparator or with a
+// negative capacity
+func TestMinMaxHeap_NewMinMaxHeapWith_Panics(t *testing.T) {
+	test.GotWantPanic(t, func() { NewMinMaxHeapWith[int]() }, ErrorMissingComparator)
+	test.GotWantPanic(t, func() {
+		NewMinMaxHeapWith(WithComparator(cmp.Less[int]), WithCapacity[int](-1))
+	}, `"capacity" must be >= 0, got -1`)
+}
+
+// Verifies the heap is created empty, ordered by the comparator and with
+// the requested capacity
+func TestMinMaxHeap_NewMinMaxHeapWith(t *testing.T) {
+	h := NewMinMaxHeapWith(WithComparator(cmp.Less[int]), WithCapacity[int](10))
+	test.GotWant(t, h.IsEmpty(), true)
+	test.GotWant(t, cap(h.data), 10)
+
+	for i := range 10 {
+		h.Push(10 - i)
+	}
+	test.GotWant(t, cap(h.data), 10)
+	test.GotWantNoError(t, h.CheckInvariants())
+	lo, _ := h.PeekMin()
+	hi, _ := h.PeekMax()
+	test.GotWant(t, lo, 1)
+	test.GotWant(t, hi, 10)
+}
+
+// Verifies NewMinMaxHeapWithCapacity panics on a negative capacity
+func TestMinMaxHeap_NewMinMaxHeapWithCapacity_Negative(t *testing.T) {
+	test.GotWantPanic(t, func() { NewMinMaxHeapWithCapacity(cmp.Less[int], -1) }, `"capacity" must be >= 0, got -1`)
+}
+
+// Verifies the heap is created empty with the requested capacity and
+// pushes within it do not reallocate
+func TestMinMaxHeap_NewMinMaxHeapWithCapacity(t *testing.T) {
+	h := NewMinMaxHeapWithCapacity(cmp.Less[int], 10)
+	test.GotWant(t, h.IsEmpty(), true)
+	test.GotWant(t, cap(h.data), 10)
+
+	for i := range 10 {
+		h.Push(10 - i)
+	}
+	test.GotWant(t, cap(h.data), 10)
+	test.GotWantNoError(t, h.CheckInvariants())
+}
+
+// Verifies every accessor returns an error on an empty heap
+func TestMinMaxHeap_PeekPop_EmptyHeap(t *testing.T) {
+	h := NewMinMaxHeap(cmp.Less[int])
+	_, err := h.PeekMin()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+	_, err = h.PeekMax()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+	_, err = h.PopMin()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+	_, err = h.PopMax()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+}
+
+// Verifies both ends of heaps with one and two elements
+func TestMinMaxHeap_PeekPop_SmallHeaps(t *testing.T) {
+	h := NewMinMaxHeap(cmp.Less[int], 5)
+	lo, _ := h.PeekMin()
+	hi, _ := h.PeekMax()
+	test.GotWant(t, lo, 5)
+	test.GotWant(t, hi, 5)
+
+	h.Push(2)
+	lo, _ = h.PeekMin()
+	hi, _ = h.PeekMax()
+	test.GotWant(t, lo, 2)
+	test.GotWant(t, hi, 5)
+
+	v, err := h.PopMax()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, v, 5)
+	v, _ = h.PopMax()
+	test.GotWant(t, v, 2)
+	test.GotWant(t, h.IsEmpty(), true)
+}
+
+// Verifies PopMin drains in ascending order and PopMax in descending order
+func TestMinMaxHeap_Pop_SortedOrder(t *testing.T) {
+	values := []int{5, 3, 8, 1, 9, 2, 7, 4, 6, 0}
+
+	h := NewMinMaxHeap(cmp.Less[int])
+	for _, v := range values {
+		h.Push(v)
+		checkMinMaxHeap(t, h)
+	}
+	ascending := []int{}
+	for !h.IsEmpty() {
+		v, _ := h.PopMin()
+		ascending = append(ascending, v)
+		checkMinMaxHeap(t, h)
+	}
+	test.GotWantSlice(t, ascending, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	h = NewMinMaxHeap(cmp.Less[int], values...)
+	descending := []int{}
+	for !h.IsEmpty() {
+		v, _ := h.PopMax()
+		descending = append(descending, v)
+		checkMinMaxHeap(t, h)
+	}
+	test.GotWantSlice(t, descending, []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0})
+}
+
+// Verifies duplicate elements are all kept and returned
+func TestMinMaxHeap_Pop_Duplicates(t *testing.T) {
+	h := NewMinMaxHeap(cmp.Less[int], 2, 2, 1, 2, 1, 3, 3)
+	checkMinMaxHeap(t, h)
+
+	values := []int{}
+	for !h.IsEmpty() {
+		v, _ := h.PopMax()
+		values = append(values, v)
+	}
+	test.GotWantSlice(t, values, []int{3, 3, 2, 2, 2, 1, 1})
+}
+
+// Verifies ShrinkToFit reallocates to exactly the live size and keeps the
+// heap order
+func TestMinMaxHeap_ShrinkToFit(t *testing.T) {
+	h := NewMinMaxHeap(cmp.Less[int])
+	for i := range 100 {
+		h.Push(100 - i)
+	}
+	for range 97 {
+		h.PopMin()
+	}
+
+	h.ShrinkToFit()
+	test.GotWant(t, cap(h.data), 3)
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	v, _ := h.PopMin()
+	test.GotWant(t, v, 98)
+
+	for h.Size() > 0 {
+		h.PopMin()
+	}
+	h.ShrinkToFit()
+	test.GotWant(t, cap(h.data), 0)
+}
+
+// Verifies CheckInvariants accepts a valid heap and reports an element on
+// the wrong side of a grandparent
+func TestMinMaxHeap_CheckInvariants(t *testing.T) {
+	h := NewMinMaxHeap(cmp.Less[int], 1, 2, 3, 4, 5, 6, 7, 8)
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	last := len(h.data) - 1
+	h.data[0], h.data[last] = h.data[last], h.data[0]
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: element 3 belongs above its ancestor 0")
+}
+
+// Verifies random pushes, PopMin and PopMax keep the min-max property and
+// return the same elements as a sorted model
+func TestMinMaxHeap_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	h := NewMinMaxHeap(cmp.Less[int])
+	model := []int{}
+
+	for range 5000 {
+		switch r.IntN(4) {
+		case 0, 1:
+			v := r.IntN(500)
+			h.Push(v)
+			model = append(model, v)
+		case 2:
+			v, err := h.PopMin()
+			if len(model) == 0 {
+				test.GotWantErrorIs(t, err, ErrEmptyHeap)
+				continue
+			}
+			slices.Sort(model)
+			test.GotWant(t, v, model[0])
+			model = model[1:]
+		case 3:
+			v, err := h.PopMax()
+			if len(model) == 0 {
+				test.GotWantErrorIs(t, err, ErrEmptyHeap)
+				continue
+			}
+			slices.Sort(model)
+			test.GotWant(t, v, model[len(model)-1])
+			model = model[:len(model)-1]
+		}
+		checkMinMaxHeap(t, h)
+		test.GotWant(t, h.Size(), len(model))
+	}
+}
+
+// Verifies evicting from both ends of an odd-length stream in pairs
+// leaves the true median
+func TestMinMaxHeap_Randomized_BoundedMedian(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	stream := make([]int, 1001)
+	for i := range stream {
+		stream[i] = r.IntN(10_000)
+	}
+
+	h := NewMinMaxHeap(cmp.Less[int], stream...)
+	for h.Size() > 1 {
+		h.PopMin()
+		h.PopMax()
+	}
+
+	median, _ := h.PeekMin()
+	sorted := slices.Clone(stream)
+	slices.Sort(sorted)
+	test.GotWant(t, median, sorted[len(sorted)/2])
+}
+
+// Verifies DebugTree marks min and max levels
+func TestMinMaxHeap_DebugTree(t *testing.T) {
+	test.GotWant(t, NewMinMaxHeap(cmp.Less[int]).DebugTree() == nil, true)
+
+	h := NewMinMaxHeap(cmp.Less[int], 1, 2, 3, 4)
+	var b strings.Builder
+	test.GotWantNoError(t, debug.PrintTree(&b, h))
+	test.GotWant(t, strings.Count(b.String(), " min\n"), 2)
+	test.GotWant(t, strings.Count(b.String(), " max\n"), 2)
+	test.GotWant(t, strings.HasPrefix(b.String(), "1 [0] min\n"), true)
+}
+
+// Verifies All yields every element once in storage order, starting at the root,
+// and lets the heap feed the collections algorithms
+func TestMinMaxHeap_All(t *testing.T) {
+	h := NewMinMaxHeap(cmp.Less[int], 5, 1, 9, 3)
+	got := slices.Collect(h.All())
+	test.GotWant(t, got[0], 1)
+	slices.Sort(got)
+	test.GotWantSlice(t, got, []int{1, 3, 5, 9})
+	test.GotWant(t, collections.Sum[int](h), 18)
+}
+
+// Verifies Add pushes the element into heap order
+func TestMinMaxHeap_Add(t *testing.T) {
+	h := NewMinMaxHeap(cmp.Less[int], 5, 1, 3)
+	test.GotWant(t, h.Add(0), true)
+	test.GotWant(t, h.Size(), 4)
+
+	v, _ := h.PopMin()
+	test.GotWant(t, v, 0)
+}
+
+// Verifies Clear empties the heap and later pushes work
+func TestMinMaxHeap_Clear(t *testing.T) {
+	h := NewMinMaxHeap(cmp.Less[int], 5, 1, 3)
+	h.Clear()
+	test.GotWant(t, h.IsEmpty(), true)
+	test.GotWant(t, h.Size(), 0)
+
+	_, err := h.PeekMin()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+
+	h.Push(7)
+	v, _ := h.PopMin()
+	test.GotWant(t, v, 7)
+}
+
+// Verifies a round trip preserves the storage layout and invalid data is
+// rejected
+func TestMinMaxHeap_MarshalBinary(t *testing.T) {
+	other, _ := codec.EncodeSeq("MinMaxHeap", 1, slices.Values([]string{"x"}))
+	dst := NewMinMaxHeap(cmp.Less[int], 7)
+	codectest.RoundTrip(t, NewMinMaxHeap(cmp.Less[int], 5, 3, 8, 1, 9, 2), dst,
+		func(h *MinMaxHeap[int]) []int { return slices.Clone(h.data) }, other)
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
+
+// Verifies elements encoded in another order are heapified under the
+// receiving heap's less function
+func TestMinMaxHeap_UnmarshalBinary_Heapifies(t *testing.T) {
+	data, _ := codec.EncodeSeq("MinMaxHeap", 4, slices.Values([]int{4, 3, 2, 1}))
+
+	h := NewMinMaxHeap[int](cmp.Less[int])
+	test.GotWantNoError(t, h.UnmarshalBinary(data))
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWant(t, h.Size(), 4)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the storage layout of the heap
+func TestMinMaxHeap_WriteTo_ReadFrom(t *testing.T) {
+	src := NewMinMaxHeap(cmp.Less[int], 3, 1, 2)
+	dst := NewMinMaxHeap(cmp.Less[int], 9)
+	codectest.StreamRoundTrip(t, src, dst,
+		func(h *MinMaxHeap[int]) []int { return slices.Clone(h.data) })
+}
@@ -0,0 +1,349 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/memory"
+)
+
+// Compile-time interface verifications
+var _ MergeableHeap[int, *SkewHeap[int]] = &SkewHeap[int]{}
+var _ collections.Sized = &SkewHeap[int]{}
+var _ collections.Addable[int] = &SkewHeap[int]{}
+var _ collections.Iterable[int] = &SkewHeap[int]{}
+
+// Represents a single node in a skew heap.
+type skewNode[T any] struct {
+	value T
+	left  *skewNode[T]
+	right *skewNode[T]
+}
+
+// SkewHeap implements a mergeable heap as a self-adjusting binary tree
+// ordered by a caller-supplied less function.
+//
+// A skew heap is the self-adjusting cousin of the leftist heap: it stores
+// no rank and instead unconditionally swaps the children of every node on
+// the merge path. Individual merges may walk a long right spine, but the
+// swapping keeps the amortized cost of Merge, Push and Pop at O(log n).
+//
+// Design decisions:
+//   - No balance metadata: Nodes store only the value and two children
+//   - Iterative top-down merge: Long right spines cannot overflow the stack
+//   - Amortized bounds: Prefer LeftistHeap when every operation must be fast
+//   - Optional free list: Popped nodes can be recycled (see
+//     WithFreeListCapacity)
+//
+// Space complexity: O(n) where n is the number of elements.
+type SkewHeap[T any] struct {
+	root *skewNode[T]
+	less func(a T, b T) bool
+	size int
+	free *memory.FreeList[skewNode[T]] // nil unless node recycling is enabled
+}
+
+// NewSkewHeap creates a skew heap ordered by less containing the given
+// values.
+//
+// Example:
+//
+//	a := NewSkewHeap(cmp.Less[int], 5, 1)
+//	b := NewSkewHeap(cmp.Less[int], 3)
+//	a.Merge(b)
+//	a.Pop()  // Returns 1
+func NewSkewHeap[T any](less func(a T, b T) bool, values ...T) *SkewHeap[T] {
+	h := &SkewHeap[T]{less: less}
+	for _, v := range values {
+		h.Push(v)
+	}
+
+	return h
+}
+
+// NewSkewHeapWith creates an empty skew heap from the given options.
+// Options are applied in order, and WithComparator is required.
+//
+// Panics if no comparator is given.
+//
+// Example:
+//
+//	h := NewSkewHeapWith(WithComparator(cmp.Less[int]))
+func NewSkewHeapWith[T any](opts ...MergeableHeapOption[T]) *SkewHeap[T] {
+	s := mergeableHeapSettingsOf(opts)
+	h := &SkewHeap[T]{less: s.less}
+	if s.freeListCapacity > 0 {
+		h.free = memory.NewFreeListWith(
+			memory.WithCapacity[skewNode[T]](s.freeListCapacity),
+		)
+	}
+
+	return h
+}
+
+// Push adds an element to the heap by merging in a single-node heap.
+//
+// Time complexity: O(log n) amortized
+func (h *SkewHeap[T]) Push(value T) {
+	h.root = h.merge(h.root, h.newNode(value))
+	h.size++
+}
+
+// Add pushes the element onto the heap, as Push does.
+// Always returns true.
+//
+// Time complexity: O(log n) amortized
+func (h *SkewHeap[T]) Add(value T) bool {
+	h.Push(value)
+	return true
+}
+
+// Pop removes and returns the root element by merging its subtrees.
+// Returns an error if the heap is empty.
+//
+// Time complexity: O(log n) amortized
+func (h *SkewHeap[T]) Pop() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+
+	root := h.root
+	h.root = h.merge(root.left, root.right)
+	h.size--
+	value := root.value
+	h.recycle(root)
+	return value, nil
+}
+
+// Peek returns the root element without removing it.
+// Returns an error if the heap is empty.
+//
+// Time complexity: O(1)
+func (h *SkewHeap[T]) Peek() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, ErrEmptyHeap
+	}
+
+	return h.root.value, nil
+}
+
+// Merge moves all elements of other into this heap and empties other.
+// Both heaps must use the same ordering.
+//
+// Time complexity: O(log n + log m) amortized where m is the size of other
+func (h *SkewHeap[T]) Merge(other *SkewHeap[T]) {
+	if other == h {
+		return
+	}
+
+	h.root = h.merge(h.root, other.root)
+	h.size += other.size
+	other.root = nil
+	other.size = 0
+}
+
+// Clear removes all elements.
+//
+// Time complexity: O(1)
+func (h *SkewHeap[T]) Clear() {
+	h.root = nil
+	h.size = 0
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements in preorder in the format of package codec. The less function
+// is not encoded.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (h *SkewHeap[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("SkewHeap", h.size, h.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the heap with those encoded by MarshalBinary, pushing them
+// in their encoded order, so the result is a valid heap under h's less
+// whatever less the data was encoded with.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded heap, in which case the heap is left unchanged.
+//
+// Time complexity: O(n log n) amortized
+func (h *SkewHeap[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "SkewHeap")
+	if err != nil {
+		return err
+	}
+
+	h.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (h *SkewHeap[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "SkewHeap", h.size, h.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// heap with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The heap is
+// left unchanged on error.
+//
+// Time complexity: O(n log n) amortized
+func (h *SkewHeap[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "SkewHeap")
+	if err != nil {
+		return n, err
+	}
+
+	h.restore(values)
+	return n, nil
+}
+
+// All returns an iterator over the elements in preorder, which puts every
+// element after its parent but is not sorted. The tree is walked with an
+// explicit stack so that long spines cannot overflow the call stack. The
+// heap must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (h *SkewHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []*skewNode[T]
+		if h.root != nil {
+			stack = append(stack, h.root)
+		}
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.value) {
+				return
+			}
+			if n.right != nil {
+				stack = append(stack, n.right)
+			}
+			if n.left != nil {
+				stack = append(stack, n.left)
+			}
+		}
+	}
+}
+
+// IsEmpty returns true if the heap contains no elements.
+//
+// Time complexity: O(1)
+func (h *SkewHeap[T]) IsEmpty() bool {
+	return h.size == 0
+}
+
+// Size returns the number of elements in the heap.
+//
+// Time complexity: O(1)
+func (h *SkewHeap[T]) Size() int {
+	return h.size
+}
+
+// CheckInvariants verifies the heap order, that no element sorts before
+// its parent, and that the number of nodes matches Size. The tree is
+// walked without recursion, so long spines are fine. Intended for tests
+// and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the heap is consistent.
+//
+// Time complexity: O(n)
+func (h *SkewHeap[T]) CheckInvariants() error {
+	count := 0
+	var stack []*skewNode[T]
+	if h.root != nil {
+		stack = append(stack, h.root)
+	}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		count++
+		for _, c := range []*skewNode[T]{n.left, n.right} {
+			if c == nil {
+				continue
+			}
+			if h.less(c.value, n.value) {
+				return fmt.Errorf("%w: element %v sorts before its parent %v", ErrInvariantViolation, c.value, n.value)
+			}
+			stack = append(stack, c)
+		}
+	}
+	if count != h.size {
+		return fmt.Errorf("%w: size is %d, tree holds %d nodes", ErrInvariantViolation, h.size, count)
+	}
+
+	return nil
+}
+
+// Merges two subtrees top-down and returns the new root. At each node on
+// the merge path the old left child moves right and the merge continues
+// into the new left child, which is the iterative form of
+// merge(a, b) = a{left: merge(a.right, b), right: a.left}.
+func (h *SkewHeap[T]) merge(a *skewNode[T], b *skewNode[T]) *skewNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	root := a
+	for {
+		next := a.right
+		a.right = a.left
+		if next == nil {
+			a.left = b
+			return root
+		}
+
+		if h.less(b.value, next.value) {
+			next, b = b, next
+		}
+
+		a.left = next
+		a = next
+	}
+}
+
+// Replaces the elements with the decoded ones.
+func (h *SkewHeap[T]) restore(values []T) {
+	h.Clear()
+	for _, v := range values {
+		h.Push(v)
+	}
+}
+
+// Returns a single-node heap holding the value, taken from the free list
+// if node recycling is enabled.
+func (h *SkewHeap[T]) newNode(value T) *skewNode[T] {
+	if h.free == nil {
+		return &skewNode[T]{value: value}
+	}
+
+	n := h.free.Get()
+	n.value = value
+	return n
+}
+
+// Hands a popped node to the free list, if node recycling is enabled.
+// The node must not be used afterwards.
+func (h *SkewHeap[T]) recycle(n *skewNode[T]) {
+	if h.free != nil {
+		h.free.Put(n)
+	}
+}
@@ -0,0 +1,333 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewSkewHeap):
+  ✓ Empty heap
+  ✓ With values
+
+Constructor (NewSkewHeapWith):
+  ✓ Missing comparator (panic)
+  ✓ Empty heap ordered by the comparator
+
+Node recycling (WithFreeListCapacity):
+  ✓ Negative capacity (panic)
+  ✓ Popped nodes are recycled zeroed, pushes reuse them
+  ✓ Free list bounded by its capacity
+
+Push/Pop/Peek:
+  ✓ Pop and Peek on an empty heap
+  ✓ Pop yields sorted order
+
+Merge:
+  ✓ Merge two non-empty heaps, other is emptied
+  ✓ Merge with empty heaps
+  ✓ Merge with itself is a no-op
+
+Properties:
+  ✓ Heap order holds under random pushes, pops and merges
+  ✓ Long right spines do not overflow the stack
+
+CheckInvariants:
+  ✓ Valid heaps, heap order and size
+
+All:
+  ✓ Yields every element once, root first, usable as an Iterable
+
+Add/Clear:
+  ✓ Add pushes and always returns true
+  ✓ Clear empties the heap, which stays usable
+
+Binary encoding:
+  ✓ Round trip into a heap of another order, invalid data rejected
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+*/
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the elements of the heap in ascending order without popping them.
+func skewValues(h *SkewHeap[int]) []int {
+	values := []int{}
+	stack := []*skewNode[int]{}
+	if h.root != nil {
+		stack = append(stack, h.root)
+	}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		values = append(values, n.value)
+		for _, c := range []*skewNode[int]{n.left, n.right} {
+			if c != nil {
+				stack = append(stack, c)
+			}
+		}
+	}
+	slices.Sort(values)
+	return values
+}
+
+// Verifies the creation of an empty heap
+func TestSkewHeap_NewSkewHeap_Empty(t *testing.T) {
+	h := NewSkewHeap(cmp.Less[int])
+	test.GotWant(t, h.Size(), 0)
+	test.GotWant(t, h.IsEmpty(), true)
+}
+
+// Verifies the creation of a heap with values
+func TestSkewHeap_NewSkewHeap_WithValues(t *testing.T) {
+	h := NewSkewHeap(cmp.Less[int], 5, 3, 8, 1)
+	test.GotWant(t, h.Size(), 4)
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWantSlice(t, drainMergeable(h), []int{1, 3, 5, 8})
+}
+
+// Verifies NewSkewHeapWith panics without a comparator
+func TestSkewHeap_NewSkewHeapWith_MissingComparator(t *testing.T) {
+	test.GotWantPanic(t, func() { NewSkewHeapWith[int]() }, ErrorMissingComparator)
+}
+
+// Verifies the heap is created empty and ordered by the comparator
+func TestSkewHeap_NewSkewHeapWith(t *testing.T) {
+	h := NewSkewHeapWith(WithComparator(func(a, b int) bool { return a > b }))
+	test.GotWant(t, h.IsEmpty(), true)
+
+	for _, v := range []int{5, 3, 8, 1} {
+		h.Push(v)
+	}
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWantSlice(t, drainMergeable(h), []int{8, 5, 3, 1})
+}
+
+// Verifies a negative free list capacity panics
+func TestSkewHeap_InvalidFreeListCapacity(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewSkewHeapWith(WithComparator(cmp.Less[int]), WithFreeListCapacity[int](-1))
+	}, `"free list capacity" must be >= 0, got -1`)
+}
+
+// Verifies every popped node is handed to the free list zeroed, and
+// pushes take recycled nodes before allocating
+func TestSkewHeap_FreeList_Reuse(t *testing.T) {
+	h := NewSkewHeapWith(WithComparator(cmp.Less[int]), WithFreeListCapacity[int](8))
+	for _, v := range []int{5, 3, 8, 1} {
+		h.Push(v)
+	}
+	root := h.root
+
+	h.Pop()
+	h.Pop()
+	test.GotWant(t, h.free.Size(), 2)
+	test.GotWant(t, *root, skewNode[int]{})
+
+	h.Push(2)
+	h.Push(9)
+	h.Push(4)
+	test.GotWant(t, h.free.Size(), 0)
+	test.GotWant(t, h.free.Stats().Reused, 2)
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWantSlice(t, drainMergeable(h), []int{2, 4, 5, 8, 9})
+}
+
+// Verifies nodes popped while the free list is full are dropped
+func TestSkewHeap_FreeList_Capacity(t *testing.T) {
+	h := NewSkewHeapWith(WithComparator(cmp.Less[int]), WithFreeListCapacity[int](2))
+	for i := range 4 {
+		h.Push(i)
+	}
+	for range 4 {
+		h.Pop()
+	}
+
+	test.GotWant(t, h.free.Size(), 2)
+	test.GotWant(t, h.free.Stats().Dropped, 2)
+}
+
+// Verifies Pop and Peek on an empty heap
+func TestSkewHeap_PopPeek_EmptyHeap(t *testing.T) {
+	h := NewSkewHeap(cmp.Less[int])
+	_, err := h.Pop()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+	_, err = h.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+}
+
+// Verifies pushed elements are popped in sorted order
+func TestSkewHeap_Push_PopSorted(t *testing.T) {
+	h := NewSkewHeap(func(a, b int) bool { return a > b })
+	for _, v := range []int{2, 9, 4, 7} {
+		h.Push(v)
+	}
+
+	top, err := h.Peek()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, top, 9)
+	test.GotWantSlice(t, drainMergeable(h), []int{9, 7, 4, 2})
+}
+
+// Verifies merging moves every element and empties the other heap
+func TestSkewHeap_Merge(t *testing.T) {
+	a := NewSkewHeap(cmp.Less[int], 1, 4, 7)
+	b := NewSkewHeap(cmp.Less[int], 2, 3, 9)
+
+	a.Merge(b)
+	test.GotWant(t, a.Size(), 6)
+	test.GotWant(t, b.Size(), 0)
+	test.GotWant(t, b.IsEmpty(), true)
+	test.GotWantNoError(t, a.CheckInvariants())
+	test.GotWantSlice(t, drainMergeable(a), []int{1, 2, 3, 4, 7, 9})
+}
+
+// Verifies merging with empty heaps on either side
+func TestSkewHeap_Merge_Empty(t *testing.T) {
+	a := NewSkewHeap(cmp.Less[int], 1, 2)
+	a.Merge(NewSkewHeap(cmp.Less[int]))
+	test.GotWant(t, a.Size(), 2)
+
+	empty := NewSkewHeap(cmp.Less[int])
+	empty.Merge(a)
+	test.GotWant(t, empty.Size(), 2)
+	test.GotWant(t, a.Size(), 0)
+	test.GotWantSlice(t, drainMergeable(empty), []int{1, 2})
+}
+
+// Verifies merging a heap with itself changes nothing
+func TestSkewHeap_Merge_Self(t *testing.T) {
+	h := NewSkewHeap(cmp.Less[int], 3, 1, 2)
+	h.Merge(h)
+	test.GotWant(t, h.Size(), 3)
+	test.GotWantSlice(t, drainMergeable(h), []int{1, 2, 3})
+}
+
+// Verifies heap order after every step of random pushes, pops and merges,
+// and that pops match a sorted model
+func TestSkewHeap_Properties(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+	h := NewSkewHeap(cmp.Less[int])
+	model := []int{}
+
+	for range 2000 {
+		switch r.IntN(4) {
+		case 0:
+			v := r.IntN(1000)
+			h.Push(v)
+			model = append(model, v)
+		case 1:
+			if len(model) > 0 {
+				v, _ := h.Pop()
+				slices.Sort(model)
+				test.GotWant(t, v, model[0])
+				model = model[1:]
+			}
+		default:
+			other := NewSkewHeap(cmp.Less[int])
+			for range r.IntN(8) {
+				v := r.IntN(1000)
+				other.Push(v)
+				model = append(model, v)
+			}
+			h.Merge(other)
+		}
+		test.GotWantNoError(t, h.CheckInvariants())
+	}
+
+	slices.Sort(model)
+	test.GotWantSlice(t, drainMergeable(h), model)
+}
+
+// Verifies merging along a very long right spine completes, since the
+// merge is iterative
+func TestSkewHeap_Properties_LongSpine(t *testing.T) {
+	// Build a right-leaning chain directly, the worst case for one merge
+	h := NewSkewHeap(cmp.Less[int])
+	for i := 1_000_000; i > 0; i-- {
+		h.root = &skewNode[int]{value: i, right: h.root}
+	}
+	h.size = 1_000_000
+
+	h.Push(1_000_001)
+	test.GotWant(t, h.Size(), 1_000_001)
+	v, _ := h.Pop()
+	test.GotWant(t, v, 1)
+}
+
+// Verifies CheckInvariants accepts valid heaps and reports a child
+// sorting first and a wrong size
+func TestSkewHeap_CheckInvariants(t *testing.T) {
+	h := NewSkewHeap(cmp.Less[int])
+	test.GotWantNoError(t, h.CheckInvariants())
+	h = NewSkewHeap(cmp.Less[int], 1, 2, 3)
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	h.root.left.value = 0
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: element 0 sorts before its parent 1")
+	h.root.left.value = 2
+
+	h.size++
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: size is 4, tree holds 3 nodes")
+	test.GotWantErrorIs(t, h.CheckInvariants(), ErrInvariantViolation)
+	h.size--
+	test.GotWantNoError(t, h.CheckInvariants())
+}
+
+// Verifies All yields every element once in preorder, starting at the root,
+// and lets the heap feed the collections algorithms
+func TestSkewHeap_All(t *testing.T) {
+	h := NewSkewHeap(cmp.Less[int], 5, 1, 9, 3)
+	got := slices.Collect(h.All())
+	test.GotWant(t, got[0], 1)
+	slices.Sort(got)
+	test.GotWantSlice(t, got, []int{1, 3, 5, 9})
+	test.GotWant(t, collections.Sum[int](h), 18)
+}
+
+// Verifies Add pushes the element into heap order
+func TestSkewHeap_Add(t *testing.T) {
+	h := NewSkewHeap(cmp.Less[int], 5, 1, 3)
+	test.GotWant(t, h.Add(0), true)
+	test.GotWant(t, h.Size(), 4)
+
+	v, _ := h.Pop()
+	test.GotWant(t, v, 0)
+}
+
+// Verifies Clear empties the heap and later pushes work
+func TestSkewHeap_Clear(t *testing.T) {
+	h := NewSkewHeap(cmp.Less[int], 5, 1, 3)
+	h.Clear()
+	test.GotWant(t, h.IsEmpty(), true)
+	test.GotWant(t, h.Size(), 0)
+
+	_, err := h.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyHeap)
+
+	h.Push(7)
+	v, _ := h.Pop()
+	test.GotWant(t, v, 7)
+}
+
+// Verifies data encoded by a min-heap decodes into a valid max-heap and
+// invalid data is rejected
+func TestSkewHeap_MarshalBinary(t *testing.T) {
+	other, _ := NewHeap(cmp.Less[int], 1).MarshalBinary()
+	dst := NewSkewHeap(func(a, b int) bool { return a > b }, 7)
+	codectest.RoundTrip(t, NewSkewHeap(cmp.Less[int], 5, 3, 8, 1, 9, 2), dst, skewValues, other)
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements as a valid heap
+func TestSkewHeap_WriteTo_ReadFrom(t *testing.T) {
+	src := NewSkewHeap(cmp.Less[int], 3, 1, 2)
+	dst := NewSkewHeap(cmp.Less[int])
+	codectest.StreamRoundTrip(t, src, dst, skewValues)
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
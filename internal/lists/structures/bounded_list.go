@@ -0,0 +1,174 @@
+package structures
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Compile-time interface verifications
+var _ collections.Iterable[int] = &BoundedList[int]{}
+var _ collections.Addable[int] = &BoundedList[int]{}
+
+// BoundedList is a doubly linked list with a capacity, which asks an
+// EvictionPolicy which element to evict when a new one does not fit.
+//
+// The policy tracks the node handles of the elements rather than their
+// values, so the list may hold duplicates, and is told of every Push and
+// Access. With a FIFO policy the list keeps the latest elements, with LRU
+// the most recently accessed ones, with LFU the most accessed ones.
+//
+// Design decisions:
+//   - Policy over node handles: Elements are told apart even when their
+//     values are equal, and eviction removes a node in O(1)
+//   - Evict before insert: The list never holds more than capacity
+//     elements, not even for the duration of a Push
+//   - Explicit Access: Reading through a handle is not a use unless
+//     reported, so iteration never disturbs the eviction order
+//
+// Policies are created for the node type of the list, such as
+// collections.NewLRUPolicy[*DoublyLinkedListNode[string]](), and must not
+// be shared with another container.
+//
+// Space complexity: O(n) where n is the number of elements.
+type BoundedList[T any] struct {
+	list     DoublyLinkedList[T]
+	capacity int
+	policy   collections.EvictionPolicy[*DoublyLinkedListNode[T]]
+	onEvict  func(value T)
+}
+
+// NewBoundedList creates an empty list with the capacity and eviction
+// policy.
+//
+// Panics if capacity is not positive.
+//
+// Example:
+//
+//	// The 10 most recently viewed pages, most recent at the back
+//	recent := NewBoundedList(10, collections.NewLRUPolicy[*DoublyLinkedListNode[string]]())
+//	node := recent.PushBack("/home")
+//	recent.Access(node)  // Viewed again
+func NewBoundedList[T any](capacity int, policy collections.EvictionPolicy[*DoublyLinkedListNode[T]]) *BoundedList[T] {
+	panics.RequireGreaterThan(capacity, 0, "capacity")
+	return &BoundedList[T]{capacity: capacity, policy: policy}
+}
+
+// SetOnEvict installs a callback invoked for every element evicted to make
+// room, replacing any installed before. Elements removed by Remove are not
+// reported. A nil callback removes it.
+//
+// The callback runs synchronously inside the Push, after the element has
+// left the list, so it should be cheap and must not modify the list.
+func (l *BoundedList[T]) SetOnEvict(onEvict func(value T)) {
+	l.onEvict = onEvict
+}
+
+// Capacity returns the maximum number of elements the list holds.
+func (l *BoundedList[T]) Capacity() int {
+	return l.capacity
+}
+
+// PushFront inserts the value at the front of the list, evicting the
+// element chosen by the policy first if the list is full.
+// Returns the node of the new element.
+//
+// Time complexity: O(1) plus that of the policy
+func (l *BoundedList[T]) PushFront(value T) *DoublyLinkedListNode[T] {
+	l.makeRoom()
+	node := l.list.PushFront(value)
+	l.policy.Add(node)
+	return node
+}
+
+// PushBack inserts the value at the back of the list, evicting the
+// element chosen by the policy first if the list is full.
+// Returns the node of the new element.
+//
+// Time complexity: O(1) plus that of the policy
+func (l *BoundedList[T]) PushBack(value T) *DoublyLinkedListNode[T] {
+	l.makeRoom()
+	node := l.list.PushBack(value)
+	l.policy.Add(node)
+	return node
+}
+
+// Add inserts the value at the back of the list, as PushBack does.
+// Always returns true; lists accept duplicates.
+//
+// Time complexity: O(1) plus that of the policy
+func (l *BoundedList[T]) Add(value T) bool {
+	l.PushBack(value)
+	return true
+}
+
+// Access returns the value of the node and reports the use to the policy.
+// Returns false if the node is not in the list, such as after it was
+// evicted.
+//
+// Time complexity: O(1) plus that of the policy
+func (l *BoundedList[T]) Access(node *DoublyLinkedListNode[T]) (T, bool) {
+	if node.list != &l.list {
+		var zero T
+		return zero, false
+	}
+
+	l.policy.Access(node)
+	return node.Value, true
+}
+
+// Remove removes the node from the list and the policy, and returns its
+// value.
+// Returns false if the node is not in the list.
+//
+// Time complexity: O(1) plus that of the policy
+func (l *BoundedList[T]) Remove(node *DoublyLinkedListNode[T]) (T, bool) {
+	value, ok := l.list.Remove(node)
+	if ok {
+		l.policy.Remove(node)
+	}
+
+	return value, ok
+}
+
+// Front returns the node at the front of the list, or nil if it is empty.
+func (l *BoundedList[T]) Front() *DoublyLinkedListNode[T] {
+	return l.list.Front()
+}
+
+// Back returns the node at the back of the list, or nil if it is empty.
+func (l *BoundedList[T]) Back() *DoublyLinkedListNode[T] {
+	return l.list.Back()
+}
+
+// All returns an iterator over the elements from front to back, without
+// counting as uses. The list must not be modified during iteration.
+func (l *BoundedList[T]) All() iter.Seq[T] {
+	return l.list.All()
+}
+
+// IsEmpty returns true if the list contains no elements.
+func (l *BoundedList[T]) IsEmpty() bool {
+	return l.list.IsEmpty()
+}
+
+// Size returns the number of elements in the list.
+func (l *BoundedList[T]) Size() int {
+	return l.list.Size()
+}
+
+// Evicts the element chosen by the policy if the list is full.
+func (l *BoundedList[T]) makeRoom() {
+	for l.list.Size() >= l.capacity {
+		node, ok := l.policy.Evict()
+		if !ok {
+			return
+		}
+
+		value, found := l.list.Remove(node)
+		if found && l.onEvict != nil {
+			l.onEvict(value)
+		}
+	}
+}
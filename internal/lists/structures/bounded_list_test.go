@@ -0,0 +1,150 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewBoundedList):
+  ✓ Empty list with the capacity
+  ✓ Non-positive capacity (panic)
+
+PushFront/PushBack/Add:
+  ✓ FIFO keeps the latest elements, duplicates told apart
+  ✓ Eviction callback with the evicted value, capacity never exceeded
+
+Access:
+  ✓ LRU keeps accessed elements, LFU the most accessed
+  ✓ Evicted and foreign nodes rejected
+
+Remove:
+  ✓ Removed elements leave room and are never evicted
+  ✓ Removed and foreign nodes rejected
+*/
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty list with the capacity
+func TestBoundedList_NewBoundedList(t *testing.T) {
+	l := NewBoundedList(3, collections.NewFIFOPolicy[*DoublyLinkedListNode[int]]())
+	test.GotWant(t, l.Capacity(), 3)
+	test.GotWant(t, l.Size(), 0)
+	test.GotWant(t, l.IsEmpty(), true)
+	test.GotWant(t, l.Front(), nil)
+	test.GotWant(t, l.Back(), nil)
+}
+
+// Verifies a non-positive capacity panics
+func TestBoundedList_NewBoundedList_Invalid(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewBoundedList(-1, collections.NewFIFOPolicy[*DoublyLinkedListNode[int]]())
+	}, `"capacity" must be > 0, got -1`)
+}
+
+// Verifies a FIFO list keeps the latest elements, telling equal values
+// apart
+func TestBoundedList_FIFO(t *testing.T) {
+	l := NewBoundedList(3, collections.NewFIFOPolicy[*DoublyLinkedListNode[string]]())
+	l.PushBack("a")
+	l.PushBack("a")
+	l.PushFront("b")
+	test.GotWant(t, l.Add("c"), true)
+
+	test.GotWantSlice(t, slices.Collect(l.All()), []string{"b", "a", "c"})
+	test.GotWant(t, l.Front().Value, "b")
+	test.GotWant(t, l.Back().Value, "c")
+	test.GotWant(t, l.IsEmpty(), false)
+}
+
+// Verifies every eviction is reported with its value and the capacity is
+// never exceeded
+func TestBoundedList_OnEvict(t *testing.T) {
+	l := NewBoundedList(4, collections.NewRandomPolicy[*DoublyLinkedListNode[int]]())
+	var evicted []int
+	l.SetOnEvict(func(value int) {
+		evicted = append(evicted, value)
+		test.GotWant(t, l.Size(), 3)
+	})
+
+	for i := range 20 {
+		l.PushBack(i)
+		test.GotWant(t, l.Size(), min(i+1, 4))
+	}
+
+	got := slices.Concat(evicted, slices.Collect(l.All()))
+	slices.Sort(got)
+	test.GotWant(t, len(evicted), 16)
+	for i, v := range got {
+		test.GotWant(t, v, i)
+	}
+}
+
+// Verifies LRU keeps the accessed elements and LFU the most accessed
+func TestBoundedList_Access(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy collections.EvictionPolicy[*DoublyLinkedListNode[string]]
+		want   []string
+	}{
+		{"LRU", collections.NewLRUPolicy[*DoublyLinkedListNode[string]](), []string{"a", "c", "d"}},
+		{"LFU", collections.NewLFUPolicy[*DoublyLinkedListNode[string]](), []string{"a", "b", "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewBoundedList(3, tt.policy)
+			a := l.PushBack("a")
+			b := l.PushBack("b")
+			c := l.PushBack("c")
+			l.Access(b)
+			l.Access(b)
+			l.Access(c)
+			v, ok := l.Access(a)
+			test.GotWant(t, ok, true)
+			test.GotWant(t, v, "a")
+			l.PushBack("d")
+
+			test.GotWantSlice(t, slices.Collect(l.All()), tt.want)
+		})
+	}
+}
+
+// Verifies evicted nodes and nodes of other lists cannot be accessed
+func TestBoundedList_Access_Invalid(t *testing.T) {
+	l := NewBoundedList(1, collections.NewLRUPolicy[*DoublyLinkedListNode[int]]())
+	first := l.PushBack(1)
+	l.PushBack(2)
+
+	_, ok := l.Access(first)
+	test.GotWant(t, ok, false)
+	_, ok = l.Access(NewDoublyLinkedList(3).Front())
+	test.GotWant(t, ok, false)
+}
+
+// Verifies removed elements leave room and are never chosen for eviction
+func TestBoundedList_Remove(t *testing.T) {
+	l := NewBoundedList(2, collections.NewFIFOPolicy[*DoublyLinkedListNode[int]]())
+	var evicted []int
+	l.SetOnEvict(func(value int) { evicted = append(evicted, value) })
+
+	first := l.PushBack(1)
+	l.PushBack(2)
+	v, ok := l.Remove(first)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 1)
+	l.PushBack(3)
+	test.GotWant(t, len(evicted), 0)
+
+	l.PushBack(4)
+	test.GotWantSlice(t, evicted, []int{2})
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{3, 4})
+
+	_, ok = l.Remove(first)
+	test.GotWant(t, ok, false)
+	_, ok = l.Remove(NewDoublyLinkedList(5).Front())
+	test.GotWant(t, ok, false)
+}
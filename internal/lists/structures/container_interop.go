@@ -0,0 +1,134 @@
+package structures
+
+import (
+	"container/list"
+	"container/ring"
+	"fmt"
+	"reflect"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// FromContainerList copies the elements of a container/list.List into a
+// new DoublyLinkedList, front to back. The source is left unchanged.
+//
+// Every element must hold a T. A nil element is accepted only if T is an
+// interface type, and becomes its nil value.
+// Returns ErrElementType, naming the first offending position, otherwise.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	src := list.New()
+//	src.PushBack(1)
+//	src.PushBack(2)
+//	l, err := FromContainerList[int](src)  // l is [1, 2]
+func FromContainerList[T any](src *list.List) (*DoublyLinkedList[T], error) {
+	l := NewDoublyLinkedList[T]()
+	i := 0
+	for e := src.Front(); e != nil; e = e.Next() {
+		v, err := convertElement[T](e.Value, i)
+		if err != nil {
+			return nil, err
+		}
+
+		l.PushBack(v)
+		i++
+	}
+
+	return l, nil
+}
+
+// ToContainerList copies the elements of any iterable structure into a
+// new container/list.List, in the order All yields them.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	l := ToContainerList[int](NewDoublyLinkedList(1, 2))
+//	l.Front().Value  // Returns 1
+func ToContainerList[T any](src collections.Iterable[T]) *list.List {
+	l := list.New()
+	for v := range src.All() {
+		l.PushBack(v)
+	}
+
+	return l
+}
+
+// FromContainerRing copies the elements of a container/ring.Ring into a
+// new DoublyLinkedList, starting with r and following Next. A nil ring
+// yields an empty list. The source is left unchanged.
+//
+// Elements are converted like those of FromContainerList.
+// Returns ErrElementType, naming the first offending position, otherwise.
+//
+// Time complexity: O(n)
+func FromContainerRing[T any](r *ring.Ring) (*DoublyLinkedList[T], error) {
+	l := NewDoublyLinkedList[T]()
+	if r == nil {
+		return l, nil
+	}
+
+	i := 0
+	for p := r; ; p = p.Next() {
+		v, err := convertElement[T](p.Value, i)
+		if err != nil {
+			return nil, err
+		}
+
+		l.PushBack(v)
+		i++
+		if p.Next() == r {
+			break
+		}
+	}
+
+	return l, nil
+}
+
+// ToContainerRing copies the elements of any iterable structure into a
+// new container/ring.Ring, in the order All yields them. The returned
+// ring points at the first element; it is nil if there are none, as
+// ring.New(0) is.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	r := ToContainerRing[int](NewDoublyLinkedList(1, 2, 3))
+//	r.Value         // Returns 1
+//	r.Prev().Value  // Returns 3
+func ToContainerRing[T any](src collections.Iterable[T]) *ring.Ring {
+	var first *ring.Ring
+	for v := range src.All() {
+		r := ring.New(1)
+		r.Value = v
+		if first == nil {
+			first = r
+			continue
+		}
+
+		first.Prev().Link(r)
+	}
+
+	return first
+}
+
+// Converts an element of a standard library container at position i.
+// Returns ErrElementType if the value does not hold a T.
+func convertElement[T any](value any, i int) (T, error) {
+	if v, ok := value.(T); ok {
+		return v, nil
+	}
+
+	var zero T
+	want := reflect.TypeFor[T]()
+	if value == nil && want.Kind() == reflect.Interface {
+		return zero, nil
+	}
+
+	return zero, fmt.Errorf("%w: element %d is %T, want %v", ErrElementType, i, value, want)
+}
@@ -0,0 +1,117 @@
+package structures
+
+/*
+Test Coverage
+=============
+FromContainerList/ToContainerList:
+  ✓ Round trip keeps the order, empty lists
+  ✓ Source left unchanged
+  ✓ Element of another type (error), nil elements
+
+FromContainerRing/ToContainerRing:
+  ✓ Round trip keeps the order starting at the given element
+  ✓ Nil ring and empty structure
+  ✓ Element of another type (error)
+*/
+
+import (
+	"container/list"
+	"container/ring"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the values of a container/list.List, front to back
+func containerListValues(l *list.List) []any {
+	var values []any
+	for e := l.Front(); e != nil; e = e.Next() {
+		values = append(values, e.Value)
+	}
+
+	return values
+}
+
+// Verifies converting to and from container/list keeps the order and
+// leaves the source unchanged
+func TestContainerList_RoundTrip(t *testing.T) {
+	src := list.New()
+	for _, v := range []string{"a", "b", "c"} {
+		src.PushBack(v)
+	}
+
+	l, err := FromContainerList[string](src)
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, slices.Collect(l.All()), []string{"a", "b", "c"})
+	test.GotWant(t, src.Len(), 3)
+
+	back := ToContainerList[string](l)
+	test.GotWantSlice(t, containerListValues(back), []any{"a", "b", "c"})
+
+	empty, err := FromContainerList[string](list.New())
+	test.GotWantNoError(t, err)
+	test.GotWant(t, empty.IsEmpty(), true)
+	test.GotWant(t, ToContainerList[string](empty).Len(), 0)
+}
+
+// Verifies elements of another type are rejected and nil elements are
+// accepted only for interface types
+func TestFromContainerList_ElementType(t *testing.T) {
+	src := list.New()
+	src.PushBack(1)
+	src.PushBack("two")
+	_, err := FromContainerList[int](src)
+	test.GotWantErrorIs(t, err, ErrElementType)
+	test.GotWantError(t, err, "element has the wrong type: element 1 is string, want int")
+
+	src = list.New()
+	src.PushBack(nil)
+	_, err = FromContainerList[*int](src)
+	test.GotWantError(t, err, "element has the wrong type: element 0 is <nil>, want *int")
+
+	src.PushBack(1)
+	l, err := FromContainerList[any](src)
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, slices.Collect(l.All()), []any{nil, 1})
+}
+
+// Verifies converting to and from container/ring keeps the order,
+// starting at the given element
+func TestContainerRing_RoundTrip(t *testing.T) {
+	r := ToContainerRing[int](NewDoublyLinkedList(1, 2, 3, 4))
+	test.GotWant(t, r.Len(), 4)
+	test.GotWant(t, r.Value, 1)
+	test.GotWant(t, r.Prev().Value, 4)
+
+	l, err := FromContainerRing[int](r)
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 3, 4})
+
+	l, err = FromContainerRing[int](r.Move(2))
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{3, 4, 1, 2})
+	test.GotWant(t, r.Len(), 4)
+
+	l, err = FromContainerRing[int](ToContainerRing[int](NewDoublyLinkedList(7)))
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{7})
+}
+
+// Verifies a nil ring and an empty structure convert to each other
+func TestContainerRing_Empty(t *testing.T) {
+	test.GotWant(t, ToContainerRing[int](NewDoublyLinkedList[int]()), (*ring.Ring)(nil))
+
+	l, err := FromContainerRing[int](nil)
+	test.GotWantNoError(t, err)
+	test.GotWant(t, l.IsEmpty(), true)
+}
+
+// Verifies ring elements of another type are rejected
+func TestFromContainerRing_ElementType(t *testing.T) {
+	r := ring.New(3)
+	r.Value = 1
+	r.Next().Value = 2
+	_, err := FromContainerRing[int](r)
+	test.GotWantError(t, err, "element has the wrong type: element 2 is <nil>, want int")
+}
@@ -0,0 +1,354 @@
+package structures
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Represents a single node of a DancingLinks matrix.
+// Every node sits in a circular doubly linked row and a circular doubly
+// linked column. Column headers are nodes too: their row is -1 and size
+// counts the nodes below them.
+type dlxNode struct {
+	left   *dlxNode
+	right  *dlxNode
+	up     *dlxNode
+	down   *dlxNode
+	header *dlxNode // Column header, the node itself for headers
+	row    int      // Row id, -1 for headers
+	column int      // Column index
+	size   int      // Nodes in the column, headers only
+}
+
+// DancingLinks implements Knuth's sparse 0/1 matrix for exact cover
+// problems, with the Algorithm X solver on top.
+//
+// Each 1 in the matrix is a node linked into a circular doubly linked list
+// for its row and another for its column, and every column has a header
+// linked into a list headed by a root. Covering a column unlinks it from
+// the header list and unlinks every row that has a 1 in it from the other
+// columns; a removed node keeps its own links, so uncovering in reverse
+// order splices everything back in O(1) per node. These "dancing" links
+// let the backtracking search undo each step without copying the matrix.
+//
+// Primary columns must be covered exactly once by a solution. Secondary
+// columns may be covered at most once: they are never chosen for
+// branching, but selecting a row still covers them, which is how
+// constraints such as the diagonals of N-queens are expressed.
+//
+// Design decisions:
+//   - Pointer nodes: Every 1 is a node with four links, as in Knuth's paper
+//   - Minimum remaining values: The solver branches on the primary column
+//     with the fewest rows, which prunes the search tree drastically
+//   - Lazy solutions: Solutions yields exact covers one at a time and
+//     restores the matrix even when iteration stops early
+//
+// Space complexity: O(c + k) where c is the number of columns and k the
+// number of 1s in the matrix.
+type DancingLinks struct {
+	root    *dlxNode
+	columns []*dlxNode
+	primary int // Columns [0, primary) are primary, the rest secondary
+	rows    int
+}
+
+// NewDancingLinks creates an empty matrix with the given numbers of
+// primary and secondary columns. Primary columns have indices
+// [0, primary) and secondary columns [primary, primary+secondary).
+//
+// Panics if either count is negative.
+//
+// Example:
+//
+//	// Cover {0, 1, 2} exactly with subsets {0, 1}, {2}, {1, 2}, {0}
+//	d := NewDancingLinks(3, 0)
+//	d.AddRow(0, 1)
+//	d.AddRow(2)
+//	d.AddRow(1, 2)
+//	d.AddRow(0)
+//	for rows := range d.Solutions() {
+//	    fmt.Println(rows)  // [0 1], then [3 2]
+//	}
+func NewDancingLinks(primary int, secondary int) *DancingLinks {
+	panics.RequireNonNegative(primary, "primary columns")
+	panics.RequireNonNegative(secondary, "secondary columns")
+
+	root := &dlxNode{row: -1, column: -1}
+	root.left, root.right = root, root
+
+	d := &DancingLinks{
+		root:    root,
+		columns: make([]*dlxNode, primary+secondary),
+		primary: primary,
+	}
+	for c := range d.columns {
+		h := &dlxNode{row: -1, column: c}
+		h.up, h.down, h.header = h, h, h
+		if c < primary {
+			h.left, h.right = root.left, root
+			root.left.right = h
+			root.left = h
+		} else {
+			// Secondary headers are not reachable from the root, so the
+			// solver never branches on them
+			h.left, h.right = h, h
+		}
+		d.columns[c] = h
+	}
+
+	return d
+}
+
+// AddRow appends a row with 1s in the given columns and returns its id.
+// Row ids are assigned consecutively from 0. Rows must not be added while
+// columns are covered.
+//
+// Panics if a column is out of range or listed twice.
+//
+// Time complexity: O(k) where k is the number of columns given
+func (d *DancingLinks) AddRow(columns ...int) int {
+	id := d.rows
+	var first *dlxNode
+	for i, c := range columns {
+		panics.RequireNonNegative(c, "column")
+		panics.RequireLessThan(c, len(d.columns), "column")
+		for _, prev := range columns[:i] {
+			if prev == c {
+				panic(fmt.Sprintf("column %d appears twice in row %d", c, id))
+			}
+		}
+
+		h := d.columns[c]
+		n := &dlxNode{header: h, row: id, column: c}
+
+		// Append at the bottom of the column
+		n.up, n.down = h.up, h
+		h.up.down = n
+		h.up = n
+		h.size++
+
+		// Append at the end of the row
+		if first == nil {
+			first = n
+			n.left, n.right = n, n
+		} else {
+			n.left, n.right = first.left, first
+			first.left.right = n
+			first.left = n
+		}
+	}
+
+	d.rows++
+	return id
+}
+
+// Cover removes the column from the matrix together with every row that
+// has a 1 in it. Covers must be undone by Uncover in reverse order.
+//
+// Panics if the column is out of range.
+//
+// Time complexity: O(k) where k is the number of 1s in the removed rows
+func (d *DancingLinks) Cover(column int) {
+	panics.RequireNonNegative(column, "column")
+	panics.RequireLessThan(column, len(d.columns), "column")
+	d.cover(d.columns[column])
+}
+
+// Uncover restores a column removed by Cover, together with its rows.
+// Columns must be uncovered in the reverse order of covering.
+//
+// Panics if the column is out of range.
+//
+// Time complexity: O(k) where k is the number of 1s in the restored rows
+func (d *DancingLinks) Uncover(column int) {
+	panics.RequireNonNegative(column, "column")
+	panics.RequireLessThan(column, len(d.columns), "column")
+	d.uncover(d.columns[column])
+}
+
+// Solutions returns an iterator over every exact cover of the matrix, each
+// given as the ids of its rows in the order they were chosen. A solution
+// covers every primary column exactly once and every secondary column at
+// most once. Rows without a 1 in a primary column are never chosen, as
+// adding them would only repeat an existing cover. The matrix is restored
+// when iteration ends, also when it stops early, and must not be modified
+// during iteration.
+//
+// Time complexity: Exponential in the worst case, as exact cover is
+// NP-complete
+func (d *DancingLinks) Solutions() iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		d.search([]int{}, yield)
+	}
+}
+
+// Solve returns the first exact cover found.
+// Returns false if the matrix has no exact cover.
+//
+// Time complexity: Exponential in the worst case
+func (d *DancingLinks) Solve() ([]int, bool) {
+	for rows := range d.Solutions() {
+		return rows, true
+	}
+
+	return nil, false
+}
+
+// Columns returns the number of primary and secondary columns.
+//
+// Time complexity: O(1)
+func (d *DancingLinks) Columns() int {
+	return len(d.columns)
+}
+
+// Rows returns the number of rows added.
+//
+// Time complexity: O(1)
+func (d *DancingLinks) Rows() int {
+	return d.rows
+}
+
+// CheckInvariants verifies the links of the matrix in its current state,
+// covered columns included: the header list runs from the root through
+// uncovered primary columns in increasing order, every column list is a
+// consistent circular list of its own nodes in increasing row order whose
+// length matches the column size, and every node is linked back by its
+// row neighbors, which share its row id. Walks are bounded, so a list
+// that does not close is reported rather than looped over. Intended for
+// tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the matrix is consistent.
+//
+// Time complexity: O(c + k) where c is the number of columns and k the
+// number of 1s
+func (d *DancingLinks) CheckInvariants() error {
+	prev, steps := -1, 0
+	for h := d.root.right; h != d.root; h = h.right {
+		if steps++; steps > len(d.columns) {
+			return fmt.Errorf("%w: header list does not return to the root", ErrInvariantViolation)
+		}
+		if h.right.left != h || h.left.right != h {
+			return fmt.Errorf("%w: header list: column %d is not linked back", ErrInvariantViolation, h.column)
+		}
+		if h.column >= d.primary {
+			return fmt.Errorf("%w: header list holds secondary column %d", ErrInvariantViolation, h.column)
+		}
+		if h.column <= prev {
+			return fmt.Errorf("%w: header list: column %d follows column %d", ErrInvariantViolation, h.column, prev)
+		}
+		prev = h.column
+	}
+
+	for c, h := range d.columns {
+		if h.header != h || h.row != -1 || h.column != c {
+			return fmt.Errorf("%w: header of column %d is corrupt", ErrInvariantViolation, c)
+		}
+
+		count, last := 0, -1
+		for n := h.down; n != h; n = n.down {
+			if count++; count > d.rows {
+				return fmt.Errorf("%w: column %d does not return to its header", ErrInvariantViolation, c)
+			}
+			if n.down.up != n || n.up.down != n {
+				return fmt.Errorf("%w: column %d: node of row %d is not linked back vertically", ErrInvariantViolation, c, n.row)
+			}
+			if n.header != h || n.column != c {
+				return fmt.Errorf("%w: column %d: node of row %d belongs to column %d", ErrInvariantViolation, c, n.row, n.column)
+			}
+			if n.row < 0 || n.row >= d.rows {
+				return fmt.Errorf("%w: column %d: row %d does not exist", ErrInvariantViolation, c, n.row)
+			}
+			if n.row <= last {
+				return fmt.Errorf("%w: column %d: row %d follows row %d", ErrInvariantViolation, c, n.row, last)
+			}
+			if n.right.left != n || n.left.right != n || n.right.row != n.row {
+				return fmt.Errorf("%w: row %d: node in column %d is not linked back horizontally", ErrInvariantViolation, n.row, c)
+			}
+			last = n.row
+		}
+		if count != h.size {
+			return fmt.Errorf("%w: column %d has size %d, holds %d nodes", ErrInvariantViolation, c, h.size, count)
+		}
+	}
+
+	return nil
+}
+
+// Runs Algorithm X below the partial solution, yielding every completion.
+// Returns false if the iteration must stop; the matrix is restored either
+// way.
+func (d *DancingLinks) search(partial []int, yield func([]int) bool) bool {
+	if d.root.right == d.root {
+		// Every primary column is covered
+		solution := make([]int, len(partial))
+		copy(solution, partial)
+		return yield(solution)
+	}
+
+	column := d.choose()
+	if column.size == 0 {
+		return true // Dead end: this column can no longer be covered
+	}
+
+	d.cover(column)
+	defer d.uncover(column)
+
+	for r := column.down; r != column; r = r.down {
+		for n := r.right; n != r; n = n.right {
+			d.cover(n.header)
+		}
+
+		more := d.search(append(partial, r.row), yield)
+
+		// Uncover in reverse order of covering
+		for n := r.left; n != r; n = n.left {
+			d.uncover(n.header)
+		}
+		if !more {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns the primary column with the fewest rows, the first on ties.
+func (d *DancingLinks) choose() *dlxNode {
+	best := d.root.right
+	for h := best.right; h != d.root; h = h.right {
+		if h.size < best.size {
+			best = h
+		}
+	}
+
+	return best
+}
+
+// Unlinks the column header and removes every row of the column from the
+// other columns it touches.
+func (d *DancingLinks) cover(h *dlxNode) {
+	h.right.left = h.left
+	h.left.right = h.right
+	for r := h.down; r != h; r = r.down {
+		for n := r.right; n != r; n = n.right {
+			n.down.up = n.up
+			n.up.down = n.down
+			n.header.size--
+		}
+	}
+}
+
+// Reverses cover, relinking in exactly the opposite order.
+func (d *DancingLinks) uncover(h *dlxNode) {
+	for r := h.up; r != h; r = r.up {
+		for n := r.left; n != r; n = n.left {
+			n.header.size++
+			n.down.up = n
+			n.up.down = n
+		}
+	}
+	h.right.left = h
+	h.left.right = h
+}
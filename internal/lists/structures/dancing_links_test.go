@@ -0,0 +1,305 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewDancingLinks):
+  ✓ Empty matrix has exactly one (empty) solution
+  ✓ Negative column counts panic
+
+AddRow:
+  ✓ Consecutive ids, links in both directions
+  ✓ Out-of-range and duplicate columns panic
+
+Cover/Uncover:
+  ✓ Covering removes the column and its rows, uncovering restores them
+
+Solutions/Solve:
+  ✓ Knuth's example matrix
+  ✓ No solution, uncoverable column
+  ✓ Secondary columns (N-queens counts)
+  ✓ Early termination restores the matrix
+
+CheckInvariants:
+  ✓ Valid matrices, covered columns included
+  ✓ Broken header, column and row links, rows out of order, wrong size
+
+Randomized:
+  ✓ Solutions match brute-force enumeration of row subsets
+*/
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the matrix is consistent and returns it as sorted column lists
+// per row id for comparisons before and after modifications.
+func checkDancingLinks(t *testing.T, d *DancingLinks) [][]int {
+	t.Helper()
+	test.GotWantNoError(t, d.CheckInvariants())
+	rows := make([][]int, d.Rows())
+	for c, h := range d.columns {
+		for n := h.down; n != h; n = n.down {
+			rows[n.row] = append(rows[n.row], c)
+		}
+	}
+
+	for _, r := range rows {
+		slices.Sort(r)
+	}
+	return rows
+}
+
+// Returns the solutions with rows sorted, in sorted order, for comparison.
+func collectSolutions(d *DancingLinks) []string {
+	solutions := []string{}
+	for rows := range d.Solutions() {
+		rows = slices.Clone(rows)
+		slices.Sort(rows)
+		solutions = append(solutions, fmt.Sprint(rows))
+	}
+	slices.Sort(solutions)
+	return solutions
+}
+
+// Builds Knuth's example matrix from "Dancing Links", whose unique exact
+// cover is rows 0, 3 and 4.
+func newKnuthMatrix() *DancingLinks {
+	d := NewDancingLinks(7, 0)
+	d.AddRow(2, 4, 5)
+	d.AddRow(0, 3, 6)
+	d.AddRow(1, 2, 5)
+	d.AddRow(0, 3)
+	d.AddRow(1, 6)
+	d.AddRow(3, 4, 6)
+	return d
+}
+
+// Builds the N-queens problem: primary columns for ranks and files,
+// secondary columns for both diagonal directions.
+func newQueens(n int) *DancingLinks {
+	d := NewDancingLinks(2*n, 2*(2*n-1))
+	for r := range n {
+		for f := range n {
+			d.AddRow(r, n+f, 2*n+r+f, 2*n+(2*n-1)+r-f+n-1)
+		}
+	}
+	return d
+}
+
+// Verifies a matrix without primary columns has exactly the empty cover
+func TestDancingLinks_NewDancingLinks_Empty(t *testing.T) {
+	d := NewDancingLinks(0, 0)
+	test.GotWant(t, d.Columns(), 0)
+	test.GotWant(t, d.Rows(), 0)
+	test.GotWantSlice(t, collectSolutions(d), []string{"[]"})
+}
+
+// Verifies negative column counts panic
+func TestDancingLinks_NewDancingLinks_Negative(t *testing.T) {
+	test.GotWantPanic(t, func() { NewDancingLinks(-1, 0) }, `"primary columns" must be >= 0, got -1`)
+	test.GotWantPanic(t, func() { NewDancingLinks(0, -2) }, `"secondary columns" must be >= 0, got -2`)
+}
+
+// Verifies rows get consecutive ids and are linked in both directions
+func TestDancingLinks_AddRow(t *testing.T) {
+	d := NewDancingLinks(3, 1)
+	test.GotWant(t, d.AddRow(0, 2), 0)
+	test.GotWant(t, d.AddRow(3, 1), 1)
+	test.GotWant(t, d.AddRow(), 2)
+	test.GotWant(t, d.Rows(), 3)
+	test.GotWant(t, d.Columns(), 4)
+
+	rows := checkDancingLinks(t, d)
+	test.GotWantSlice(t, rows[0], []int{0, 2})
+	test.GotWantSlice(t, rows[1], []int{1, 3})
+	test.GotWant(t, len(rows[2]), 0)
+}
+
+// Verifies invalid columns panic
+func TestDancingLinks_AddRow_InvalidColumn(t *testing.T) {
+	d := NewDancingLinks(2, 1)
+	test.GotWantPanic(t, func() { d.AddRow(3) }, `"column" must be < 3, got 3`)
+	test.GotWantPanic(t, func() { d.AddRow(-1) }, `"column" must be >= 0, got -1`)
+	test.GotWantPanic(t, func() { d.AddRow(0, 1, 0) }, "column 0 appears twice in row 0")
+	test.GotWantPanic(t, func() { d.Cover(5) }, `"column" must be < 3, got 5`)
+}
+
+// Verifies covering removes the column and the rows through it from the
+// other columns, and uncovering restores the original matrix
+func TestDancingLinks_CoverUncover(t *testing.T) {
+	d := newKnuthMatrix()
+	before := checkDancingLinks(t, d)
+
+	d.Cover(0) // Removes rows 1 and 3
+	test.GotWant(t, d.columns[3].size, 1)
+	test.GotWant(t, d.columns[6].size, 2)
+	d.Cover(4) // Removes rows 0 and 5
+	test.GotWant(t, d.columns[2].size, 1)
+	test.GotWant(t, d.columns[3].size, 0)
+
+	d.Uncover(4)
+	d.Uncover(0)
+	after := checkDancingLinks(t, d)
+	test.GotWant(t, fmt.Sprint(after), fmt.Sprint(before))
+}
+
+// Verifies the unique exact cover of Knuth's example is found
+func TestDancingLinks_Solutions_Knuth(t *testing.T) {
+	d := newKnuthMatrix()
+	test.GotWantSlice(t, collectSolutions(d), []string{"[0 3 4]"})
+
+	rows, ok := d.Solve()
+	test.GotWant(t, ok, true)
+	slices.Sort(rows)
+	test.GotWantSlice(t, rows, []int{0, 3, 4})
+}
+
+// Verifies matrices without an exact cover yield nothing
+func TestDancingLinks_Solutions_NoSolution(t *testing.T) {
+	overlapping := NewDancingLinks(3, 0)
+	overlapping.AddRow(0, 1)
+	overlapping.AddRow(1, 2)
+	test.GotWant(t, len(collectSolutions(overlapping)), 0)
+
+	uncoverable := NewDancingLinks(2, 0)
+	uncoverable.AddRow(0)
+	_, ok := uncoverable.Solve()
+	test.GotWant(t, ok, false)
+	checkDancingLinks(t, uncoverable)
+}
+
+// Verifies secondary columns are covered at most once by counting the
+// solutions of N-queens
+func TestDancingLinks_Solutions_Secondary(t *testing.T) {
+	for n, want := range map[int]int{1: 1, 2: 0, 3: 0, 4: 2, 5: 10, 6: 4, 8: 92} {
+		d := newQueens(n)
+		count := 0
+		for rows := range d.Solutions() {
+			test.GotWant(t, len(rows), n)
+			count++
+		}
+		test.GotWant(t, count, want)
+	}
+}
+
+// Verifies the matrix is restored when iteration stops early
+func TestDancingLinks_Solutions_EarlyTermination(t *testing.T) {
+	d := newQueens(6)
+	before := checkDancingLinks(t, d)
+
+	count := 0
+	for range d.Solutions() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	test.GotWant(t, count, 2)
+
+	after := checkDancingLinks(t, d)
+	test.GotWant(t, fmt.Sprint(after), fmt.Sprint(before))
+	test.GotWant(t, len(collectSolutions(d)), 4)
+}
+
+// Verifies CheckInvariants accepts valid matrices, also with columns
+// covered
+func TestDancingLinks_CheckInvariants(t *testing.T) {
+	test.GotWantNoError(t, NewDancingLinks(0, 0).CheckInvariants())
+	d := newKnuthMatrix()
+	test.GotWantNoError(t, d.CheckInvariants())
+	d.Cover(0)
+	d.Cover(4)
+	test.GotWantNoError(t, d.CheckInvariants())
+	d.Uncover(4)
+	d.Uncover(0)
+	test.GotWantNoError(t, newQueens(4).CheckInvariants())
+}
+
+// Verifies CheckInvariants reports each kind of corruption
+func TestDancingLinks_CheckInvariants_Corrupted(t *testing.T) {
+	d := newKnuthMatrix()
+
+	h := d.columns[2]
+	h.right = h
+	test.GotWantError(t, d.CheckInvariants(), "invariant violation: header list: column 2 is not linked back")
+	h.right = d.columns[3]
+
+	d.columns[0].size = 3
+	test.GotWantError(t, d.CheckInvariants(), "invariant violation: column 0 has size 3, holds 2 nodes")
+	d.columns[0].size = 2
+
+	n := d.columns[3].down // Row 1, followed by rows 3 and 5
+	up := n.up
+	n.up = n
+	test.GotWantError(t, d.CheckInvariants(), "invariant violation: column 3: node of row 1 is not linked back vertically")
+	n.up = up
+
+	first := d.columns[0].down // Row 1, also in columns 3 and 6
+	left := first.left
+	first.left = first
+	test.GotWantError(t, d.CheckInvariants(), "invariant violation: row 1: node in column 0 is not linked back horizontally")
+	first.left = left
+	test.GotWantNoError(t, d.CheckInvariants())
+
+	single := NewDancingLinks(1, 0)
+	single.AddRow(0)
+	single.AddRow(0)
+	single.columns[0].up.row = 0
+	test.GotWantError(t, single.CheckInvariants(), "invariant violation: column 0: row 0 follows row 0")
+}
+
+// Verifies the solutions of random matrices match brute-force enumeration
+// of the row subsets in which every row has a 1 in a primary column
+func TestDancingLinks_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(46, 47))
+	for range 200 {
+		primary, secondary := 1+r.IntN(6), r.IntN(3)
+		d := NewDancingLinks(primary, secondary)
+		matrix := [][]int{}
+		for range r.IntN(12) {
+			row := []int{}
+			for c := range primary + secondary {
+				if r.IntN(3) == 0 {
+					row = append(row, c)
+				}
+			}
+			d.AddRow(row...)
+			matrix = append(matrix, row)
+		}
+
+		want := []string{}
+		for mask := range 1 << len(matrix) {
+			covered := make([]int, primary+secondary)
+			rows := []int{}
+			exact := true
+			for i, row := range matrix {
+				if mask&(1<<i) != 0 {
+					rows = append(rows, i)
+					for _, c := range row {
+						covered[c]++
+					}
+					exact = exact && len(row) > 0 && row[0] < primary
+				}
+			}
+			for c, n := range covered {
+				if n > 1 || (c < primary && n == 0) {
+					exact = false
+				}
+			}
+			if exact {
+				want = append(want, fmt.Sprint(rows))
+			}
+		}
+		slices.Sort(want)
+
+		before := checkDancingLinks(t, d)
+		test.GotWantSlice(t, collectSolutions(d), want)
+		test.GotWant(t, fmt.Sprint(checkDancingLinks(t, d)), fmt.Sprint(before))
+	}
+}
@@ -0,0 +1,524 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/debug"
+)
+
+// Compile-time interface verifications
+var _ BasicList[int] = &DoublyLinkedList[int]{}
+var _ collections.Iterable[int] = &DoublyLinkedList[int]{}
+var _ collections.Addable[int] = &DoublyLinkedList[int]{}
+
+// DoublyLinkedListNode is a handle to an element of a DoublyLinkedList.
+// A handle stays valid until its element is removed, so callers can keep
+// it next to their own data and later move or remove the element in O(1).
+type DoublyLinkedListNode[T any] struct {
+	Value T
+	prev  *DoublyLinkedListNode[T]
+	next  *DoublyLinkedListNode[T]
+	list  *DoublyLinkedList[T] // nil once removed
+}
+
+// Next returns the following node, or nil at the back of the list or if
+// the node was removed.
+//
+// Time complexity: O(1)
+func (n *DoublyLinkedListNode[T]) Next() *DoublyLinkedListNode[T] {
+	if n.list == nil || n.next == &n.list.root {
+		return nil
+	}
+
+	return n.next
+}
+
+// Prev returns the preceding node, or nil at the front of the list or if
+// the node was removed.
+//
+// Time complexity: O(1)
+func (n *DoublyLinkedListNode[T]) Prev() *DoublyLinkedListNode[T] {
+	if n.list == nil || n.prev == &n.list.root {
+		return nil
+	}
+
+	return n.prev
+}
+
+// DoublyLinkedList implements a circular doubly linked list with a
+// sentinel node, in the style of container/list but generic.
+//
+// The sentinel sits between the back and the front of the ring, so every
+// element has a real predecessor and successor and insertion or removal
+// never has to special-case an empty list or the ends. Operations that
+// create elements return node handles, and the Move operations relink an
+// existing node without allocating, which makes the list the backbone for
+// recency orders such as those of LinkedHashMap and LRU caches.
+//
+// Design decisions:
+//   - Embedded sentinel: The zero value is an empty list ready to use
+//   - Node handles: O(1) Remove and Move given a node, no search needed
+//   - Owner check: Operations on nodes of another list, or on removed
+//     nodes, are ignored instead of corrupting either list
+//   - Size counter: Enables O(1) Size and IsEmpty operations
+//
+// Space complexity: O(n) where n is the number of elements.
+type DoublyLinkedList[T any] struct {
+	root DoublyLinkedListNode[T] // Sentinel: root.next is the front, root.prev the back
+	size int
+}
+
+// NewDoublyLinkedList creates a list with the given values, the first
+// value at the front.
+//
+// Example:
+//
+//	l := NewDoublyLinkedList(1, 2, 3)
+//	n := l.Front()
+//	l.MoveToBack(n)  // List is now [2, 3, 1]
+func NewDoublyLinkedList[T any](values ...T) *DoublyLinkedList[T] {
+	l := &DoublyLinkedList[T]{}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+
+	return l
+}
+
+// Front returns the node at the front of the list, or nil if it is empty.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) Front() *DoublyLinkedListNode[T] {
+	if l.size == 0 {
+		return nil
+	}
+
+	return l.root.next
+}
+
+// Back returns the node at the back of the list, or nil if it is empty.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) Back() *DoublyLinkedListNode[T] {
+	if l.size == 0 {
+		return nil
+	}
+
+	return l.root.prev
+}
+
+// PushFront inserts the value at the front of the list and returns its
+// node.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) PushFront(value T) *DoublyLinkedListNode[T] {
+	l.init()
+	return l.insert(&DoublyLinkedListNode[T]{Value: value}, &l.root)
+}
+
+// PushBack inserts the value at the back of the list and returns its node.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) PushBack(value T) *DoublyLinkedListNode[T] {
+	l.init()
+	return l.insert(&DoublyLinkedListNode[T]{Value: value}, l.root.prev)
+}
+
+// InsertBefore inserts the value immediately before the mark and returns
+// its node. Returns nil without inserting if the mark is not in the list.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) InsertBefore(value T, mark *DoublyLinkedListNode[T]) *DoublyLinkedListNode[T] {
+	if mark.list != l {
+		return nil
+	}
+
+	return l.insert(&DoublyLinkedListNode[T]{Value: value}, mark.prev)
+}
+
+// InsertAfter inserts the value immediately after the mark and returns its
+// node. Returns nil without inserting if the mark is not in the list.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) InsertAfter(value T, mark *DoublyLinkedListNode[T]) *DoublyLinkedListNode[T] {
+	if mark.list != l {
+		return nil
+	}
+
+	return l.insert(&DoublyLinkedListNode[T]{Value: value}, mark)
+}
+
+// Remove removes the node from the list and returns its value.
+// Returns false if the node is not in the list.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) Remove(node *DoublyLinkedListNode[T]) (T, bool) {
+	if node.list != l {
+		var zero T
+		return zero, false
+	}
+
+	l.unlink(node)
+	return node.Value, true
+}
+
+// MoveToFront moves the node to the front of the list.
+// Does nothing if the node is not in the list.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) MoveToFront(node *DoublyLinkedListNode[T]) {
+	if node.list != l || l.root.next == node {
+		return
+	}
+
+	l.move(node, &l.root)
+}
+
+// MoveToBack moves the node to the back of the list.
+// Does nothing if the node is not in the list.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	// Mark an entry as most recently used
+//	l.MoveToBack(node)
+func (l *DoublyLinkedList[T]) MoveToBack(node *DoublyLinkedListNode[T]) {
+	if node.list != l || l.root.prev == node {
+		return
+	}
+
+	l.move(node, l.root.prev)
+}
+
+// MoveBefore moves the node immediately before the mark.
+// Does nothing if either node is not in the list or they are the same.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) MoveBefore(node *DoublyLinkedListNode[T], mark *DoublyLinkedListNode[T]) {
+	if node.list != l || mark.list != l || node == mark {
+		return
+	}
+
+	l.move(node, mark.prev)
+}
+
+// MoveAfter moves the node immediately after the mark.
+// Does nothing if either node is not in the list or they are the same.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) MoveAfter(node *DoublyLinkedListNode[T], mark *DoublyLinkedListNode[T]) {
+	if node.list != l || mark.list != l || node == mark {
+		return
+	}
+
+	l.move(node, mark)
+}
+
+// AddFirst inserts the value at the front of the list.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) AddFirst(value T) {
+	l.PushFront(value)
+}
+
+// AddLast inserts the value at the back of the list.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) AddLast(value T) {
+	l.PushBack(value)
+}
+
+// Add inserts the value at the back of the list, as AddLast does.
+// Always returns true; lists accept duplicates.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) Add(value T) bool {
+	l.PushBack(value)
+	return true
+}
+
+// RemoveFirst removes the element at the front of the list.
+// Returns false if the list is empty.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) RemoveFirst() bool {
+	if l.size == 0 {
+		return false
+	}
+
+	l.unlink(l.root.next)
+	return true
+}
+
+// RemoveLast removes the element at the back of the list.
+// Returns false if the list is empty.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) RemoveLast() bool {
+	if l.size == 0 {
+		return false
+	}
+
+	l.unlink(l.root.prev)
+	return true
+}
+
+// First returns the element at the front of the list.
+// Returns an error if the list is empty.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) First() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+
+	return l.root.next.Value, nil
+}
+
+// Last returns the element at the back of the list.
+// Returns an error if the list is empty.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) Last() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+
+	return l.root.prev.Value, nil
+}
+
+// All returns an iterator over the elements from front to back.
+// The list must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (l *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.Front(); n != nil; n = n.Next() {
+			if !yield(n.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements from back to front.
+// The list must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (l *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.Back(); n != nil; n = n.Prev() {
+			if !yield(n.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all elements. Handles of the removed elements become
+// invalid, as with Remove.
+//
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) Clear() {
+	for n := l.Front(); n != nil; n = l.Front() {
+		l.unlink(n)
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements from front to back in the format of package codec.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("DoublyLinkedList", l.size, l.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the list with those encoded by MarshalBinary. Handles of
+// the previous elements become detached, as after Clear.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded list, in which case the list is left unchanged.
+//
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "DoublyLinkedList")
+	if err != nil {
+		return err
+	}
+
+	l.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "DoublyLinkedList", l.size, l.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// list with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The list is
+// left unchanged on error.
+//
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "DoublyLinkedList")
+	if err != nil {
+		return n, err
+	}
+
+	l.restore(values)
+	return n, nil
+}
+
+// Page returns page index of the elements, split into pages of size
+// elements, along with the total count. The list is walked from the
+// front to the end of the page.
+//
+// Panics if index is negative or size is not positive.
+//
+// Time complexity: O(index*size + size)
+func (l *DoublyLinkedList[T]) Page(index int, size int) collections.Page[T] {
+	return collections.Paginate(l.All(), l.size, index, size)
+}
+
+// IsEmpty returns true if the list contains no elements.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Size returns the number of elements in the list.
+//
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) Size() int {
+	return l.size
+}
+
+// ToDOT writes the nodes of the list to w in the Graphviz DOT language
+// (see debug.DOT), with both the next and the prev pointer of every node.
+// The sentinel is drawn as a node for the list itself, so the ring closes
+// through it. A cycle in corrupted links is drawn once instead of followed
+// forever.
+// Returns the first write error.
+//
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) ToDOT(w io.Writer) error {
+	d := debug.NewDOT(true)
+	d.Node(&l.root, fmt.Sprintf("DoublyLinkedList\nsize %d", l.size))
+	nodes := []*DoublyLinkedListNode[T]{&l.root}
+	for n := l.root.next; n != nil && d.Node(n, fmt.Sprint(n.Value)); n = n.next {
+		nodes = append(nodes, n)
+	}
+
+	for _, n := range nodes {
+		if n.next != nil {
+			d.Edge(n, n.next, "next")
+		}
+		if n.prev != nil {
+			d.Edge(n, n.prev, "prev")
+		}
+	}
+
+	_, err := d.WriteTo(w)
+	return err
+}
+
+// CheckInvariants verifies the internal consistency of the list: every
+// node's neighbors link back to it, every node belongs to this list, and
+// their number matches Size. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the list is consistent.
+//
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) CheckInvariants() error {
+	if l.root.next == nil {
+		if l.size != 0 {
+			return fmt.Errorf("%w: size is %d, list is uninitialized", ErrInvariantViolation, l.size)
+		}
+		return nil
+	}
+
+	count := 0
+	for n := l.root.next; n != &l.root; n = n.next {
+		if count >= l.size {
+			return fmt.Errorf("%w: size is %d, traversal found more nodes", ErrInvariantViolation, l.size)
+		}
+		if n.list != l {
+			return fmt.Errorf("%w: node %d belongs to another list", ErrInvariantViolation, count)
+		}
+		if n.prev.next != n || n.next.prev != n {
+			return fmt.Errorf("%w: node %d is not linked back by its neighbors", ErrInvariantViolation, count)
+		}
+		count++
+	}
+	if count != l.size {
+		return fmt.Errorf("%w: size is %d, traversal found %d nodes", ErrInvariantViolation, l.size, count)
+	}
+
+	return nil
+}
+
+// Closes the sentinel ring of a zero-value list.
+func (l *DoublyLinkedList[T]) init() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+}
+
+// Links a detached node after the given node and returns it.
+func (l *DoublyLinkedList[T]) insert(n *DoublyLinkedListNode[T], after *DoublyLinkedListNode[T]) *DoublyLinkedListNode[T] {
+	n.prev = after
+	n.next = after.next
+	after.next.prev = n
+	after.next = n
+	n.list = l
+	l.size++
+	return n
+}
+
+// Detaches a node of the list and marks it removed.
+func (l *DoublyLinkedList[T]) unlink(n *DoublyLinkedListNode[T]) {
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	n.prev, n.next, n.list = nil, nil, nil // Help GC, invalidate the handle
+	l.size--
+}
+
+// Relinks a node of the list after the given node.
+func (l *DoublyLinkedList[T]) move(n *DoublyLinkedListNode[T], after *DoublyLinkedListNode[T]) {
+	if after == n || after.next == n {
+		return
+	}
+
+	n.prev.next = n.next
+	n.next.prev = n.prev
+
+	n.prev = after
+	n.next = after.next
+	after.next.prev = n
+	after.next = n
+}
+
+// Replaces the elements with the decoded values, front to back.
+func (l *DoublyLinkedList[T]) restore(values []T) {
+	l.Clear()
+	for _, v := range values {
+		l.PushBack(v)
+	}
+}
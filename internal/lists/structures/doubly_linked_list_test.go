@@ -0,0 +1,399 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewDoublyLinkedList):
+  ✓ Empty list, zero value is usable
+  ✓ Multiple values, first at the front
+
+PushFront/PushBack/InsertBefore/InsertAfter:
+  ✓ Node handles, Next/Prev stop at the ends
+  ✓ Marks from another list are rejected
+
+Remove:
+  ✓ Front, middle and back, handle invalidated
+  ✓ Removed and foreign nodes are ignored
+
+MoveToFront/MoveToBack/MoveBefore/MoveAfter:
+  ✓ Moves across the list, no-op moves
+  ✓ Foreign nodes are ignored
+
+BasicList (AddFirst/AddLast/RemoveFirst/RemoveLast/First/Last):
+  ✓ Empty list errors, both ends
+
+All/Backward:
+  ✓ Both directions, early termination
+
+CheckInvariants:
+  ✓ Valid lists, wrong size, broken back link, foreign node
+
+Randomized:
+  ✓ Mixed operations match a slice model
+
+ToDOT:
+  ✓ Zero-value list, ring of next and prev pointers through the sentinel
+
+Add/Clear:
+  ✓ Add appends and always returns true
+  ✓ Clear empties the list and invalidates handles
+
+Binary encoding:
+  ✓ Round trip detaches the previous handles, invalid data rejected
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the list unchanged
+
+Page:
+  ✓ Items and total count
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the ring links agree in both directions, every node belongs to
+// the list, and the node count matches the size.
+func checkDoublyLinkedList[T any](t *testing.T, l *DoublyLinkedList[T]) {
+	t.Helper()
+	test.GotWantNoError(t, l.CheckInvariants())
+	if l.root.next == nil {
+		test.GotWant(t, l.size, 0)
+		return
+	}
+
+	count := 0
+	for n := l.root.next; n != &l.root; n = n.next {
+		count++
+		if n.next.prev != n || n.prev.next != n {
+			t.Errorf("node %d has broken links", count)
+		}
+		if n.list != l {
+			t.Errorf("node %d does not belong to the list", count)
+		}
+	}
+	test.GotWant(t, count, l.size)
+}
+
+// Verifies the creation of an empty list and that the zero value works
+func TestDoublyLinkedList_NewDoublyLinkedList_Empty(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	test.GotWant(t, l.Size(), 0)
+	test.GotWant(t, l.IsEmpty(), true)
+	test.GotWant(t, l.Front(), nil)
+	test.GotWant(t, l.Back(), nil)
+
+	var zero DoublyLinkedList[int]
+	zero.PushBack(1)
+	zero.PushFront(0)
+	test.GotWantSlice(t, slices.Collect(zero.All()), []int{0, 1})
+	checkDoublyLinkedList(t, &zero)
+}
+
+// Verifies the creation of a multi-element list in order
+func TestDoublyLinkedList_NewDoublyLinkedList_ManyValues(t *testing.T) {
+	l := NewDoublyLinkedList(1, 2, 3)
+	test.GotWant(t, l.Size(), 3)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 3})
+	checkDoublyLinkedList(t, l)
+}
+
+// Verifies insertions return handles whose neighbors stop at the ends
+func TestDoublyLinkedList_Insert_Handles(t *testing.T) {
+	l := NewDoublyLinkedList[string]()
+	b := l.PushBack("b")
+	a := l.PushFront("a")
+	d := l.PushBack("d")
+	c := l.InsertBefore("c", d)
+	e := l.InsertAfter("e", d)
+
+	test.GotWantSlice(t, slices.Collect(l.All()), []string{"a", "b", "c", "d", "e"})
+	test.GotWant(t, l.Front(), a)
+	test.GotWant(t, l.Back(), e)
+	test.GotWant(t, a.Prev(), nil)
+	test.GotWant(t, e.Next(), nil)
+	test.GotWant(t, b.Next(), c)
+	test.GotWant(t, c.Prev(), b)
+	checkDoublyLinkedList(t, l)
+}
+
+// Verifies inserting around a mark of another list is rejected
+func TestDoublyLinkedList_Insert_ForeignMark(t *testing.T) {
+	l, other := NewDoublyLinkedList(1), NewDoublyLinkedList(2)
+	test.GotWant(t, l.InsertBefore(0, other.Front()), nil)
+	test.GotWant(t, l.InsertAfter(0, other.Front()), nil)
+	test.GotWant(t, l.Size(), 1)
+	test.GotWant(t, other.Size(), 1)
+}
+
+// Verifies removal at the front, middle and back invalidates the handles
+func TestDoublyLinkedList_Remove(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	nodes := []*DoublyLinkedListNode[int]{}
+	for i := range 5 {
+		nodes = append(nodes, l.PushBack(i))
+	}
+
+	for _, i := range []int{2, 0, 4} {
+		v, ok := l.Remove(nodes[i])
+		test.GotWant(t, ok, true)
+		test.GotWant(t, v, i)
+		test.GotWant(t, nodes[i].Next(), nil)
+		test.GotWant(t, nodes[i].Prev(), nil)
+		checkDoublyLinkedList(t, l)
+	}
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 3})
+}
+
+// Verifies removing a removed node or a node of another list does nothing
+func TestDoublyLinkedList_Remove_Foreign(t *testing.T) {
+	l, other := NewDoublyLinkedList(1, 2), NewDoublyLinkedList(3)
+	n := l.Front()
+	l.Remove(n)
+
+	_, ok := l.Remove(n)
+	test.GotWant(t, ok, false)
+	_, ok = l.Remove(other.Front())
+	test.GotWant(t, ok, false)
+	test.GotWant(t, l.Size(), 1)
+	test.GotWant(t, other.Size(), 1)
+}
+
+// Verifies moves relink nodes and moves to the current position do nothing
+func TestDoublyLinkedList_Move(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	nodes := []*DoublyLinkedListNode[int]{}
+	for i := range 5 {
+		nodes = append(nodes, l.PushBack(i))
+	}
+
+	l.MoveToFront(nodes[3])
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{3, 0, 1, 2, 4})
+	l.MoveToBack(nodes[3])
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{0, 1, 2, 4, 3})
+	l.MoveBefore(nodes[3], nodes[0])
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{3, 0, 1, 2, 4})
+	l.MoveAfter(nodes[3], nodes[4])
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{0, 1, 2, 4, 3})
+
+	// No-op moves
+	l.MoveToFront(nodes[0])
+	l.MoveToBack(nodes[3])
+	l.MoveBefore(nodes[1], nodes[2])
+	l.MoveAfter(nodes[2], nodes[1])
+	l.MoveBefore(nodes[1], nodes[1])
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{0, 1, 2, 4, 3})
+	test.GotWantSlice(t, slices.Collect(l.Backward()), []int{3, 4, 2, 1, 0})
+	checkDoublyLinkedList(t, l)
+}
+
+// Verifies moves involving nodes of another list are ignored
+func TestDoublyLinkedList_Move_Foreign(t *testing.T) {
+	l, other := NewDoublyLinkedList(1, 2), NewDoublyLinkedList(3)
+	l.MoveToFront(other.Front())
+	l.MoveToBack(other.Front())
+	l.MoveBefore(l.Back(), other.Front())
+	l.MoveAfter(other.Front(), l.Front())
+
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2})
+	test.GotWantSlice(t, slices.Collect(other.All()), []int{3})
+	checkDoublyLinkedList(t, l)
+	checkDoublyLinkedList(t, other)
+}
+
+// Verifies the BasicList operations on empty and non-empty lists
+func TestDoublyLinkedList_BasicList(t *testing.T) {
+	var l BasicList[int] = NewDoublyLinkedList[int]()
+	_, err := l.First()
+	test.GotWantErrorIs(t, err, ErrEmptyList)
+	_, err = l.Last()
+	test.GotWantErrorIs(t, err, ErrEmptyList)
+	test.GotWant(t, l.RemoveFirst(), false)
+	test.GotWant(t, l.RemoveLast(), false)
+
+	l.AddLast(2)
+	l.AddFirst(1)
+	l.AddLast(3)
+	first, _ := l.First()
+	last, _ := l.Last()
+	test.GotWant(t, first, 1)
+	test.GotWant(t, last, 3)
+
+	test.GotWant(t, l.RemoveFirst(), true)
+	test.GotWant(t, l.RemoveLast(), true)
+	first, _ = l.First()
+	test.GotWant(t, first, 2)
+	test.GotWant(t, l.Size(), 1)
+}
+
+// Verifies early termination of the iterators
+func TestDoublyLinkedList_All_EarlyTermination(t *testing.T) {
+	l := NewDoublyLinkedList(1, 2, 3)
+	got := []int{}
+	for v := range l.All() {
+		got = append(got, v)
+		break
+	}
+	for v := range l.Backward() {
+		got = append(got, v)
+		break
+	}
+	test.GotWantSlice(t, got, []int{1, 3})
+}
+
+// Verifies CheckInvariants accepts valid lists and reports a wrong size, a
+// broken back link and a node of another list
+func TestDoublyLinkedList_CheckInvariants(t *testing.T) {
+	var zero DoublyLinkedList[int]
+	test.GotWantNoError(t, zero.CheckInvariants())
+	zero.size = 1
+	test.GotWantError(t, zero.CheckInvariants(), "invariant violation: size is 1, list is uninitialized")
+
+	l := NewDoublyLinkedList(1, 2, 3)
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	l.size = 4
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: size is 4, traversal found 3 nodes")
+	l.size = 2
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: size is 2, traversal found more nodes")
+	l.size = 3
+
+	mid := l.Front().next
+	mid.list = NewDoublyLinkedList[int]()
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: node 1 belongs to another list")
+	mid.list = l
+
+	mid.next.prev = l.Front()
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: node 1 is not linked back by its neighbors")
+}
+
+// Verifies random operations match a slice model
+func TestDoublyLinkedList_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(47, 48))
+	l := NewDoublyLinkedList[int]()
+	nodes := []*DoublyLinkedListNode[int]{} // Model: nodes in list order
+
+	for i := range 5000 {
+		switch op := r.IntN(6); {
+		case op == 0 || len(nodes) == 0:
+			nodes = append(nodes, l.PushBack(i))
+		case op == 1:
+			nodes = slices.Insert(nodes, 0, l.PushFront(i))
+		case op == 2:
+			j := r.IntN(len(nodes))
+			l.Remove(nodes[j])
+			nodes = slices.Delete(nodes, j, j+1)
+		case op == 3:
+			j := r.IntN(len(nodes))
+			n := nodes[j]
+			l.MoveToFront(n)
+			nodes = slices.Insert(slices.Delete(nodes, j, j+1), 0, n)
+		case op == 4:
+			j, k := r.IntN(len(nodes)), r.IntN(len(nodes))
+			n, mark := nodes[j], nodes[k]
+			l.MoveBefore(n, mark)
+			if n != mark {
+				nodes = slices.Delete(nodes, j, j+1)
+				nodes = slices.Insert(nodes, slices.Index(nodes, mark), n)
+			}
+		case op == 5:
+			j := r.IntN(len(nodes))
+			n := l.InsertAfter(i, nodes[j])
+			nodes = slices.Insert(nodes, j+1, n)
+		}
+		test.GotWant(t, l.Size(), len(nodes))
+	}
+
+	checkDoublyLinkedList(t, l)
+	want := []int{}
+	for _, n := range nodes {
+		want = append(want, n.Value)
+	}
+	test.GotWantSlice(t, slices.Collect(l.All()), want)
+}
+
+// Verifies ToDOT draws the ring of next and prev pointers through the
+// sentinel
+func TestDoublyLinkedList_ToDOT(t *testing.T) {
+	var b strings.Builder
+	var empty DoublyLinkedList[int]
+	test.GotWantNoError(t, empty.ToDOT(&b))
+	test.GotWant(t, b.String(), "digraph {\n    n0 [label=\"DoublyLinkedList\\nsize 0\"];\n}\n")
+
+	b.Reset()
+	test.GotWantNoError(t, NewDoublyLinkedList(1, 2).ToDOT(&b))
+	test.GotWant(t, b.String(), `digraph {
+    n0 [label="DoublyLinkedList\nsize 2"];
+    n1 [label="1"];
+    n2 [label="2"];
+    n0 -> n1 [label="next"];
+    n0 -> n2 [label="prev"];
+    n1 -> n2 [label="next"];
+    n1 -> n0 [label="prev"];
+    n2 -> n0 [label="next"];
+    n2 -> n1 [label="prev"];
+}
+`)
+}
+
+// Verifies Add appends to the back of the list
+func TestDoublyLinkedList_Add(t *testing.T) {
+	l := NewDoublyLinkedList(1)
+	test.GotWant(t, l.Add(2), true)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2})
+}
+
+// Verifies Clear empties the list and invalidates the handles of the
+// removed elements
+func TestDoublyLinkedList_Clear(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	n := l.PushBack(1)
+	l.PushBack(2)
+
+	l.Clear()
+	test.GotWant(t, l.Size(), 0)
+	test.GotWant(t, l.Front() == nil, true)
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	_, ok := l.Remove(n)
+	test.GotWant(t, ok, false)
+
+	l.Add(3)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{3})
+}
+
+// Verifies a round trip preserves the order and detaches the handles of
+// the replaced elements, and invalid data is rejected
+func TestDoublyLinkedList_MarshalBinary(t *testing.T) {
+	other, _ := NewLinkedList("a").MarshalBinary()
+	dst := NewDoublyLinkedList[string]()
+	old := dst.PushBack("z")
+	codectest.RoundTrip(t, NewDoublyLinkedList("a", "b"), dst,
+		func(l *DoublyLinkedList[string]) []string { return slices.Collect(l.Backward()) }, other)
+	test.GotWant(t, old.list == nil, true)
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements in list order
+func TestDoublyLinkedList_WriteTo_ReadFrom(t *testing.T) {
+	src := NewDoublyLinkedList(1, 2, 3)
+	dst := NewDoublyLinkedList(9)
+	codectest.StreamRoundTrip(t, src, dst,
+		func(l *DoublyLinkedList[int]) []int { return slices.Collect(l.All()) })
+}
+
+// Verifies pages of a doubly linked list and their total count
+func TestDoublyLinkedList_Page(t *testing.T) {
+	l := NewDoublyLinkedList("a", "b", "c")
+	p := l.Page(1, 2)
+	test.GotWantSlice(t, p.Items, []string{"c"})
+	test.GotWant(t, p.Total, 3)
+	test.GotWant(t, p.HasPrevious(), true)
+}
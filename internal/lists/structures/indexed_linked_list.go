@@ -0,0 +1,507 @@
+package structures
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ List[int] = &IndexedLinkedList[int]{}
+var _ collections.Collection[int] = &IndexedLinkedList[int]{}
+var _ collections.Iterable[int] = &IndexedLinkedList[int]{}
+var _ collections.Addable[int] = &IndexedLinkedList[int]{}
+
+// Represents an element of an IndexedLinkedList, linked both into the
+// list and into the ring of the elements holding the same value.
+type indexedNode[T comparable] struct {
+	value    T
+	prev     *indexedNode[T]
+	next     *indexedNode[T]
+	prevSame *indexedNode[T] // Previous occurrence of the value, the last one for the first
+	nextSame *indexedNode[T] // Next occurrence of the value, the first one for the last
+}
+
+// IndexedLinkedList implements a doubly linked list with a hash index
+// from each value to its occurrences, so value lookups do not scan the
+// list.
+//
+// Every node is also linked into a ring of the nodes holding the same
+// value, in list order, and a map points each distinct value to its first
+// occurrence. Contains and Remove(value) take O(1) on average instead of
+// O(n), and IndexOf answers -1 for absent values in O(1) and otherwise
+// counts only the elements before the first occurrence.
+//
+// Design decisions:
+//   - Doubly linked nodes: Removing a found node needs no search for its
+//     predecessor, and GetAt walks from the nearer end
+//   - Rings of occurrences in list order: The first occurrence, which
+//     Remove and Update act on, stays known after any mutation
+//   - Index kept by every mutation: Positional inserts and updates of a
+//     value that already occurs walk back to its previous occurrence
+//   - Sentinel node: No special cases for the ends or an empty list
+//
+// The index costs two pointers per element and one map entry per
+// distinct value, on top of a doubly linked list. It pays off for large
+// lists searched by value; for short lists or positional access only,
+// LinkedList is leaner. The zero value is not ready to use; create lists
+// with NewIndexedLinkedList.
+//
+// Space complexity: O(n) where n is the number of elements.
+type IndexedLinkedList[T comparable] struct {
+	root  indexedNode[T]        // Sentinel: root.next is the first element, root.prev the last
+	index map[T]*indexedNode[T] // First occurrence of each value
+	size  int
+}
+
+// NewIndexedLinkedList creates a list with the given values, the first
+// value at the front.
+//
+// Time complexity: O(n) where n is the number of values
+//
+// Example:
+//
+//	l := NewIndexedLinkedList("a", "b", "a")
+//	l.Contains("b")  // Returns true without a scan
+//	l.Remove("a")    // List is now ["b", "a"]
+func NewIndexedLinkedList[T comparable](values ...T) *IndexedLinkedList[T] {
+	l := &IndexedLinkedList[T]{index: make(map[T]*indexedNode[T], len(values))}
+	l.root.prev, l.root.next = &l.root, &l.root
+	for _, v := range values {
+		l.AddLast(v)
+	}
+
+	return l
+}
+
+// AddFirst prepends a value to the list.
+//
+// Time complexity: O(1) average
+func (l *IndexedLinkedList[T]) AddFirst(value T) {
+	l.insert(value, &l.root, nil)
+}
+
+// AddLast appends a value to the list.
+//
+// Time complexity: O(1) average
+func (l *IndexedLinkedList[T]) AddLast(value T) {
+	var prevSame *indexedNode[T]
+	if first, ok := l.index[value]; ok {
+		prevSame = first.prevSame
+	}
+
+	l.insert(value, l.root.prev, prevSame)
+}
+
+// Add appends a value to the list, as AddLast does.
+// Always returns true; lists accept duplicates.
+//
+// Time complexity: O(1) average
+func (l *IndexedLinkedList[T]) Add(value T) bool {
+	l.AddLast(value)
+	return true
+}
+
+// RemoveFirst removes the first element of the list.
+// Returns false if the list is empty.
+//
+// Time complexity: O(1) average
+func (l *IndexedLinkedList[T]) RemoveFirst() bool {
+	if l.size == 0 {
+		return false
+	}
+
+	l.unlink(l.root.next)
+	return true
+}
+
+// RemoveLast removes the last element of the list.
+// Returns false if the list is empty.
+//
+// Time complexity: O(1) average
+func (l *IndexedLinkedList[T]) RemoveLast() bool {
+	if l.size == 0 {
+		return false
+	}
+
+	l.unlink(l.root.prev)
+	return true
+}
+
+// First returns the first element in the list.
+// Returns ErrEmptyList if the list is empty.
+//
+// Time complexity: O(1)
+func (l *IndexedLinkedList[T]) First() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+
+	return l.root.next.value, nil
+}
+
+// Last returns the last element in the list.
+// Returns ErrEmptyList if the list is empty.
+//
+// Time complexity: O(1)
+func (l *IndexedLinkedList[T]) Last() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+
+	return l.root.prev.value, nil
+}
+
+// InsertAt inserts a value at the specified index.
+// Valid indices are 0 to Size() inclusive.
+// Returns ErrIndexOutOfRange if index is invalid.
+//
+// Time complexity: O(min(index, n-index)) if the value does not occur in
+// the list yet, otherwise up to O(index) to find its previous occurrence
+//
+// Example:
+//
+//	l := NewIndexedLinkedList(1, 3)
+//	l.InsertAt(1, 2)  // List is now [1, 2, 3]
+func (l *IndexedLinkedList[T]) InsertAt(index int, value T) error {
+	if index < 0 || index > l.size {
+		return ErrIndexOutOfRange
+	}
+
+	var at *indexedNode[T]
+	if index == l.size {
+		at = &l.root
+	} else {
+		at = l.nodeAt(index)
+	}
+
+	l.insert(value, at.prev, l.precedingSame(at, value))
+	return nil
+}
+
+// UpdateAt updates a value at the specified index and returns the old
+// value.
+// Valid indices are 0 to Size()-1.
+// Returns ErrIndexOutOfRange if index is invalid.
+//
+// Time complexity: O(min(index, n-index)) if the value does not occur in
+// the list yet, otherwise up to O(index) to find its previous occurrence
+func (l *IndexedLinkedList[T]) UpdateAt(index int, value T) (T, error) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+
+	node := l.nodeAt(index)
+	old := node.value
+	l.replace(node, value)
+	return old, nil
+}
+
+// RemoveAt removes the element at the specified index.
+// Valid indices are 0 to Size()-1.
+// Returns ErrIndexOutOfRange if index is invalid.
+//
+// Time complexity: O(min(index, n-index))
+func (l *IndexedLinkedList[T]) RemoveAt(index int) error {
+	if index < 0 || index >= l.size {
+		return ErrIndexOutOfRange
+	}
+
+	l.unlink(l.nodeAt(index))
+	return nil
+}
+
+// GetAt returns the element at the specified index.
+// Valid indices are 0 to Size()-1.
+// Returns ErrIndexOutOfRange if index is invalid.
+//
+// Time complexity: O(min(index, n-index))
+func (l *IndexedLinkedList[T]) GetAt(index int) (T, error) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+
+	return l.nodeAt(index).value, nil
+}
+
+// IndexOf returns the index of the first occurrence of the value.
+// Returns -1 if the value is not found.
+//
+// Time complexity: O(1) average for absent values, otherwise O(index)
+// to count the elements before the first occurrence
+func (l *IndexedLinkedList[T]) IndexOf(value T) int {
+	first, ok := l.index[value]
+	if !ok {
+		return -1
+	}
+
+	index := 0
+	for n := l.root.next; n != first; n = n.next {
+		index++
+	}
+
+	return index
+}
+
+// Contains returns true if the list contains the value.
+//
+// Time complexity: O(1) average
+func (l *IndexedLinkedList[T]) Contains(value T) bool {
+	_, ok := l.index[value]
+	return ok
+}
+
+// Count returns the number of occurrences of the value.
+//
+// Time complexity: O(k) where k is the number of occurrences
+func (l *IndexedLinkedList[T]) Count(value T) int {
+	first, ok := l.index[value]
+	if !ok {
+		return 0
+	}
+
+	count := 1
+	for n := first.nextSame; n != first; n = n.nextSame {
+		count++
+	}
+
+	return count
+}
+
+// Remove removes the first occurrence of the value.
+// Returns true if the value was found and removed, false otherwise.
+//
+// Time complexity: O(1) average
+//
+// Example:
+//
+//	l := NewIndexedLinkedList(1, 2, 1)
+//	l.Remove(1)  // List is now [2, 1]
+func (l *IndexedLinkedList[T]) Remove(value T) bool {
+	first, ok := l.index[value]
+	if !ok {
+		return false
+	}
+
+	l.unlink(first)
+	return true
+}
+
+// Update replaces the first occurrence of oldValue with newValue.
+// Returns true if the value was found and updated, false otherwise.
+//
+// Time complexity: O(1) average if newValue does not occur in the list
+// yet, otherwise up to O(index) to find its previous occurrence
+func (l *IndexedLinkedList[T]) Update(oldValue T, newValue T) bool {
+	first, ok := l.index[oldValue]
+	if !ok {
+		return false
+	}
+
+	l.replace(first, newValue)
+	return true
+}
+
+// All returns an iterator over the elements from first to last.
+// The list must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (l *IndexedLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.root.next; n != &l.root; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements from last to first.
+// The list must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (l *IndexedLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.root.prev; n != &l.root; n = n.prev {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all elements.
+//
+// Time complexity: O(1)
+func (l *IndexedLinkedList[T]) Clear() {
+	l.root.prev, l.root.next = &l.root, &l.root
+	l.index = make(map[T]*indexedNode[T])
+	l.size = 0
+}
+
+// IsEmpty returns true if the list contains no elements.
+//
+// Time complexity: O(1)
+func (l *IndexedLinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Size returns the number of elements in the list.
+//
+// Time complexity: O(1)
+func (l *IndexedLinkedList[T]) Size() int {
+	return l.size
+}
+
+// CheckInvariants verifies the internal consistency of the list and its
+// value index: every node's neighbors link back to it, the index points
+// each value to its first occurrence and holds no other values, the ring
+// of each value links its occurrences in list order and closes from the
+// last back to the first, and the number of nodes matches Size. Intended
+// for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the list is consistent.
+//
+// Time complexity: O(n) average
+func (l *IndexedLinkedList[T]) CheckInvariants() error {
+	count := 0
+	last := make(map[T]*indexedNode[T], len(l.index))
+	for n := l.root.next; n != &l.root; n = n.next {
+		if count >= l.size {
+			return fmt.Errorf("%w: size is %d, traversal found more nodes", ErrInvariantViolation, l.size)
+		}
+		if n.prev.next != n || n.next.prev != n {
+			return fmt.Errorf("%w: node %d is not linked back by its neighbors", ErrInvariantViolation, count)
+		}
+
+		if prev, ok := last[n.value]; !ok {
+			if l.index[n.value] != n {
+				return fmt.Errorf("%w: value %v: index does not point to its first occurrence", ErrInvariantViolation, n.value)
+			}
+		} else if prev.nextSame != n || n.prevSame != prev {
+			return fmt.Errorf("%w: value %v: ring is not in list order at node %d", ErrInvariantViolation, n.value, count)
+		}
+		last[n.value] = n
+		count++
+	}
+	if count != l.size {
+		return fmt.Errorf("%w: size is %d, traversal found %d nodes", ErrInvariantViolation, l.size, count)
+	}
+	if len(l.index) != len(last) {
+		return fmt.Errorf("%w: index holds %d values, list holds %d distinct values", ErrInvariantViolation, len(l.index), len(last))
+	}
+	for value, n := range last {
+		if first := l.index[value]; n.nextSame != first || first.prevSame != n {
+			return fmt.Errorf("%w: value %v: ring does not close from the last to the first occurrence", ErrInvariantViolation, value)
+		}
+	}
+
+	return nil
+}
+
+// Returns the node at the index, walking from the nearer end. The index
+// must be valid.
+func (l *IndexedLinkedList[T]) nodeAt(index int) *indexedNode[T] {
+	if index < l.size/2 {
+		n := l.root.next
+		for range index {
+			n = n.next
+		}
+
+		return n
+	}
+
+	n := l.root.prev
+	for range l.size - 1 - index {
+		n = n.prev
+	}
+
+	return n
+}
+
+// Returns the last occurrence of the value before the node, or nil if
+// there is none. Scans backwards only if the value occurs at all.
+func (l *IndexedLinkedList[T]) precedingSame(node *indexedNode[T], value T) *indexedNode[T] {
+	if _, ok := l.index[value]; !ok {
+		return nil
+	}
+
+	for n := node.prev; n != &l.root; n = n.prev {
+		if n.value == value {
+			return n
+		}
+	}
+
+	return nil
+}
+
+// Links a new node holding the value after prev in the list and after
+// prevSame in the ring of its value, or first in the ring if prevSame is
+// nil.
+func (l *IndexedLinkedList[T]) insert(value T, prev *indexedNode[T], prevSame *indexedNode[T]) {
+	n := &indexedNode[T]{value: value, prev: prev, next: prev.next}
+	prev.next.prev = n
+	prev.next = n
+	l.linkSame(n, prevSame)
+	l.size++
+}
+
+// Unlinks the node from the list and from the ring of its value.
+func (l *IndexedLinkedList[T]) unlink(n *indexedNode[T]) {
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	n.prev, n.next = nil, nil // Help GC
+	l.unlinkSame(n)
+	l.size--
+}
+
+// Moves the node from the ring of its value to the ring of the new value.
+func (l *IndexedLinkedList[T]) replace(n *indexedNode[T], value T) {
+	if n.value == value {
+		return
+	}
+
+	l.unlinkSame(n)
+	n.value = value
+	l.linkSame(n, l.precedingSame(n, value))
+}
+
+// Links the node into the ring of its value after prevSame, or as the
+// first occurrence if prevSame is nil.
+func (l *IndexedLinkedList[T]) linkSame(n *indexedNode[T], prevSame *indexedNode[T]) {
+	if prevSame == nil {
+		first, ok := l.index[n.value]
+		l.index[n.value] = n
+		if !ok {
+			n.prevSame, n.nextSame = n, n
+			return
+		}
+
+		prevSame = first.prevSame // The ring closes from the last to the new first
+	}
+
+	n.prevSame, n.nextSame = prevSame, prevSame.nextSame
+	prevSame.nextSame.prevSame = n
+	prevSame.nextSame = n
+}
+
+// Unlinks the node from the ring of its value, forgetting the value if it
+// was the only occurrence.
+func (l *IndexedLinkedList[T]) unlinkSame(n *indexedNode[T]) {
+	if n.nextSame == n {
+		delete(l.index, n.value)
+	} else {
+		n.prevSame.nextSame = n.nextSame
+		n.nextSame.prevSame = n.prevSame
+		if l.index[n.value] == n {
+			l.index[n.value] = n.nextSame
+		}
+	}
+
+	n.prevSame, n.nextSame = nil, nil // Help GC
+}
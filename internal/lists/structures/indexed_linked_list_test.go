@@ -0,0 +1,315 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewIndexedLinkedList):
+  ✓ Empty list
+  ✓ Values in order, duplicates indexed
+
+Add/Remove at the ends:
+  ✓ AddFirst, AddLast and Add keep the first occurrence
+  ✓ RemoveFirst and RemoveLast on empty and non-empty lists
+  ✓ First and Last
+
+Positional operations:
+  ✓ InsertAt, UpdateAt, RemoveAt and GetAt at both ends and in the middle
+  ✓ Invalid indices (error)
+
+Value operations:
+  ✓ Contains and Count of present and absent values
+  ✓ IndexOf, Remove and Update act on the first occurrence
+  ✓ Update to the same value, to a value that already occurs
+
+Iteration/Clear:
+  ✓ All and Backward, early termination
+  ✓ Clear empties the list and its index
+
+CheckInvariants:
+  ✓ Valid lists, broken links, index not at the first occurrence, ring
+    out of list order or not closed, stale index entry, wrong size
+
+Randomized:
+  ✓ Contents and index match a slice model
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty list
+func TestIndexedLinkedList_NewIndexedLinkedList_Empty(t *testing.T) {
+	l := NewIndexedLinkedList[int]()
+	test.GotWant(t, l.Size(), 0)
+	test.GotWant(t, l.IsEmpty(), true)
+	test.GotWant(t, l.Contains(0), false)
+	_, err := l.First()
+	test.GotWantErrorIs(t, err, ErrEmptyList)
+	_, err = l.Last()
+	test.GotWantErrorIs(t, err, ErrEmptyList)
+	test.GotWantNoError(t, l.CheckInvariants())
+}
+
+// Verifies the creation of a list with values, duplicates included
+func TestIndexedLinkedList_NewIndexedLinkedList(t *testing.T) {
+	l := NewIndexedLinkedList("a", "b", "a", "c", "a")
+	test.GotWantSlice(t, slices.Collect(l.All()), []string{"a", "b", "a", "c", "a"})
+	test.GotWant(t, l.Size(), 5)
+	test.GotWant(t, l.IsEmpty(), false)
+	test.GotWant(t, l.Count("a"), 3)
+	test.GotWantNoError(t, l.CheckInvariants())
+}
+
+// Verifies additions at both ends keep the first occurrence of a value
+// correct
+func TestIndexedLinkedList_Add(t *testing.T) {
+	l := NewIndexedLinkedList[int]()
+	l.AddLast(1)
+	l.AddFirst(2)
+	l.AddFirst(1)
+	test.GotWant(t, l.Add(2), true)
+
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 1, 2})
+	test.GotWant(t, l.IndexOf(1), 0)
+	test.GotWant(t, l.IndexOf(2), 1)
+	test.GotWantNoError(t, l.CheckInvariants())
+}
+
+// Verifies removals at both ends
+func TestIndexedLinkedList_RemoveFirstLast(t *testing.T) {
+	l := NewIndexedLinkedList[int]()
+	test.GotWant(t, l.RemoveFirst(), false)
+	test.GotWant(t, l.RemoveLast(), false)
+
+	l = NewIndexedLinkedList(1, 2, 1, 3)
+	test.GotWant(t, l.RemoveFirst(), true)
+	test.GotWant(t, l.RemoveLast(), true)
+	first, _ := l.First()
+	last, _ := l.Last()
+	test.GotWant(t, first, 2)
+	test.GotWant(t, last, 1)
+	test.GotWant(t, l.IndexOf(1), 1)
+	test.GotWant(t, l.Contains(3), false)
+	test.GotWantNoError(t, l.CheckInvariants())
+}
+
+// Verifies positional operations at both ends and in the middle
+func TestIndexedLinkedList_Positional(t *testing.T) {
+	l := NewIndexedLinkedList(1, 2, 3)
+	test.GotWantNoError(t, l.InsertAt(0, 3))
+	test.GotWantNoError(t, l.InsertAt(4, 1))
+	test.GotWantNoError(t, l.InsertAt(2, 2))
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{3, 1, 2, 2, 3, 1})
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	old, err := l.UpdateAt(3, 1)
+	test.GotWantNoError(t, err)
+	test.GotWant(t, old, 2)
+	old, _ = l.UpdateAt(5, 4)
+	test.GotWant(t, old, 1)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{3, 1, 2, 1, 3, 4})
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	test.GotWantNoError(t, l.RemoveAt(1))
+	test.GotWantNoError(t, l.RemoveAt(4))
+	test.GotWantNoError(t, l.RemoveAt(0))
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{2, 1, 3})
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	for i, want := range []int{2, 1, 3} {
+		v, err := l.GetAt(i)
+		test.GotWantNoError(t, err)
+		test.GotWant(t, v, want)
+	}
+}
+
+// Verifies invalid indices are rejected
+func TestIndexedLinkedList_Positional_Invalid(t *testing.T) {
+	l := NewIndexedLinkedList(1, 2)
+	test.GotWantErrorIs(t, l.InsertAt(-1, 0), ErrIndexOutOfRange)
+	test.GotWantErrorIs(t, l.InsertAt(3, 0), ErrIndexOutOfRange)
+	_, err := l.UpdateAt(2, 0)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
+	test.GotWantErrorIs(t, l.RemoveAt(-1), ErrIndexOutOfRange)
+	_, err = l.GetAt(2)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2})
+}
+
+// Verifies Contains and Count of present and absent values
+func TestIndexedLinkedList_ContainsCount(t *testing.T) {
+	l := NewIndexedLinkedList("a", "b", "a")
+	test.GotWant(t, l.Contains("a"), true)
+	test.GotWant(t, l.Contains("c"), false)
+	test.GotWant(t, l.Count("a"), 2)
+	test.GotWant(t, l.Count("b"), 1)
+	test.GotWant(t, l.Count("c"), 0)
+}
+
+// Verifies IndexOf, Remove and Update act on the first occurrence
+func TestIndexedLinkedList_ValueOperations(t *testing.T) {
+	l := NewIndexedLinkedList(1, 2, 3, 2, 1)
+	test.GotWant(t, l.IndexOf(2), 1)
+	test.GotWant(t, l.IndexOf(4), -1)
+
+	test.GotWant(t, l.Remove(2), true)
+	test.GotWant(t, l.IndexOf(2), 2)
+	test.GotWant(t, l.Remove(4), false)
+
+	test.GotWant(t, l.Update(1, 5), true)
+	test.GotWant(t, l.Update(4, 5), false)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{5, 3, 2, 1})
+	test.GotWant(t, l.IndexOf(1), 3)
+	test.GotWantNoError(t, l.CheckInvariants())
+}
+
+// Verifies updates to the same value and to a value that already occurs
+// before and after the updated element
+func TestIndexedLinkedList_Update_Existing(t *testing.T) {
+	l := NewIndexedLinkedList(1, 2, 3, 1)
+	test.GotWant(t, l.Update(2, 2), true)
+	test.GotWant(t, l.Update(3, 1), true)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 1, 1})
+	test.GotWant(t, l.Count(1), 3)
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	test.GotWant(t, l.Update(2, 1), true)
+	test.GotWant(t, l.Update(1, 4), true)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{4, 1, 1, 1})
+	test.GotWant(t, l.IndexOf(1), 1)
+	test.GotWantNoError(t, l.CheckInvariants())
+}
+
+// Verifies iteration in both directions and early termination
+func TestIndexedLinkedList_All(t *testing.T) {
+	l := NewIndexedLinkedList(1, 2, 3)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 3})
+	test.GotWantSlice(t, slices.Collect(l.Backward()), []int{3, 2, 1})
+
+	var got []int
+	for v := range l.Backward() {
+		got = append(got, v)
+		break
+	}
+
+	test.GotWantSlice(t, got, []int{3})
+}
+
+// Verifies Clear empties the list and its index
+func TestIndexedLinkedList_Clear(t *testing.T) {
+	l := NewIndexedLinkedList(1, 2, 1)
+	l.Clear()
+	test.GotWant(t, l.IsEmpty(), true)
+	test.GotWant(t, l.Contains(1), false)
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	l.AddLast(1)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1})
+	test.GotWantNoError(t, l.CheckInvariants())
+}
+
+// Verifies CheckInvariants accepts valid lists and reports each kind of
+// corruption of the list and its value index
+func TestIndexedLinkedList_CheckInvariants(t *testing.T) {
+	test.GotWantNoError(t, NewIndexedLinkedList[string]().CheckInvariants())
+	l := NewIndexedLinkedList("a", "b", "a", "c", "a")
+	test.GotWantNoError(t, l.CheckInvariants())
+	a0 := l.root.next
+	b := a0.next
+	a2, a4 := b.next, l.root.prev
+
+	l.size = 6
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: size is 6, traversal found 5 nodes")
+	l.size = 5
+
+	b.prev = b
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: node 0 is not linked back by its neighbors")
+	b.prev = a0
+
+	l.index["a"] = a2
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: value a: index does not point to its first occurrence")
+	l.index["a"] = a0
+
+	a0.nextSame = a4
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: value a: ring is not in list order at node 2")
+	a0.nextSame = a2
+
+	a4.nextSame = a4
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: value a: ring does not close from the last to the first occurrence")
+	a4.nextSame = a0
+
+	l.index["z"] = b
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: index holds 4 values, list holds 3 distinct values")
+	delete(l.index, "z")
+	test.GotWantNoError(t, l.CheckInvariants())
+}
+
+// Verifies the list and its index match a slice model under random
+// operations on a small set of values
+func TestIndexedLinkedList_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	l := NewIndexedLinkedList[int]()
+	var model []int
+	for range 3000 {
+		value := r.IntN(6)
+		size := len(model)
+		switch r.IntN(9) {
+		case 0:
+			l.AddFirst(value)
+			model = slices.Insert(model, 0, value)
+		case 1:
+			l.AddLast(value)
+			model = append(model, value)
+		case 2:
+			i := r.IntN(size + 1)
+			test.GotWantNoError(t, l.InsertAt(i, value))
+			model = slices.Insert(model, i, value)
+		case 3:
+			if size > 0 {
+				i := r.IntN(size)
+				old, err := l.UpdateAt(i, value)
+				test.GotWantNoError(t, err)
+				test.GotWant(t, old, model[i])
+				model[i] = value
+			}
+		case 4:
+			if size > 0 {
+				i := r.IntN(size)
+				test.GotWantNoError(t, l.RemoveAt(i))
+				model = slices.Delete(model, i, i+1)
+			}
+		case 5:
+			i := slices.Index(model, value)
+			test.GotWant(t, l.Remove(value), i >= 0)
+			if i >= 0 {
+				model = slices.Delete(model, i, i+1)
+			}
+		case 6:
+			newValue := r.IntN(6)
+			i := slices.Index(model, value)
+			test.GotWant(t, l.Update(value, newValue), i >= 0)
+			if i >= 0 {
+				model[i] = newValue
+			}
+		case 7:
+			test.GotWant(t, l.RemoveFirst(), size > 0)
+			if size > 0 {
+				model = model[1:]
+			}
+		case 8:
+			test.GotWant(t, l.RemoveLast(), size > 0)
+			if size > 0 {
+				model = model[:size-1]
+			}
+		}
+
+		test.GotWant(t, l.IndexOf(value), slices.Index(model, value))
+		test.GotWant(t, l.Contains(value), slices.Contains(model, value))
+		test.GotWantSlice(t, slices.Collect(l.All()), model)
+		test.GotWantNoError(t, l.CheckInvariants())
+	}
+}
@@ -1,10 +1,22 @@
 package structures
 
-import "errors"
+import (
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/debug"
+	"github.com/apotourlyan/godatastructures/internal/memory"
+)
 
 // Compile-time interface verifications
 var _ List[int] = &LinkedList[int]{}
 var _ BasicList[int] = &BasicLinkedList[int]{}
+var _ collections.Collection[int] = &LinkedList[int]{}
+var _ collections.Iterable[int] = &BasicLinkedList[int]{}
+var _ collections.Addable[int] = &BasicLinkedList[int]{}
 
 // Represents a single node in a singly-linked list.
 // Each node contains a value and a pointer to the next node.
@@ -24,12 +36,16 @@ type LinkedListNode[T any] struct {
 //   - Size counter: Enables O(1) Size and IsEmpty operations
 //   - No prev pointers: Keeps memory overhead low (not doubly-linked)
 //   - No comparable constraint: Works with any type
+//   - Optional arena: Nodes can come from slabs (see LinkedListConfig)
+//   - Optional free list: Removed nodes can be recycled (see LinkedListConfig)
 //
 // Space complexity: O(n) where n is the number of elements.
 type BasicLinkedList[T any] struct {
-	head *LinkedListNode[T]
-	tail *LinkedListNode[T]
-	size int
+	head  *LinkedListNode[T]
+	tail  *LinkedListNode[T]
+	size  int
+	arena *memory.Arena[LinkedListNode[T]]    // nil unless arena allocation is enabled
+	free  *memory.FreeList[LinkedListNode[T]] // nil unless node recycling is enabled
 }
 
 // Represents a singly-linked list implementation with head and tail pointers.
@@ -58,7 +74,36 @@ type LinkedList[T comparable] struct {
 //	empty := NewBasicLinkedList[int]()
 //	withValues := NewBasicLinkedList(1, 2, 3)
 func NewBasicLinkedList[T any](values ...T) *BasicLinkedList[T] {
+	return newBasicLinkedList(LinkedListConfig{}, values...)
+}
+
+// Creates a new BasicLinkedList with custom allocation settings and
+// optional initial values. See LinkedListConfig for the options.
+//
+// Panics if the configuration is invalid.
+//
+// Time complexity: O(n) where n is the number of initial values.
+//
+// Deprecated: Use NewBasicLinkedListWith with WithArenaSlabSize and
+// WithFreeListCapacity, then AddLast.
+func NewBasicLinkedListWithConfig[T any](config LinkedListConfig, values ...T) *BasicLinkedList[T] {
+	return newBasicLinkedList(config, values...)
+}
+
+// Creates a new BasicLinkedList with the given settings and initial values.
+// Panics if the configuration is invalid.
+func newBasicLinkedList[T any](config LinkedListConfig, values ...T) *BasicLinkedList[T] {
+	config.validate()
 	l := &BasicLinkedList[T]{}
+	if config.ArenaSlabSize > 0 {
+		l.arena = memory.NewArena[LinkedListNode[T]](config.ArenaSlabSize)
+	}
+	if config.FreeListCapacity > 0 {
+		l.free = memory.NewFreeListWith(
+			memory.WithCapacity[LinkedListNode[T]](config.FreeListCapacity),
+		)
+	}
+
 	size := len(values)
 	if size == 0 {
 		return l
@@ -68,7 +113,7 @@ func NewBasicLinkedList[T any](values ...T) *BasicLinkedList[T] {
 	dummy := &LinkedListNode[T]{}
 	tail := dummy
 	for _, v := range values {
-		tail.Next = &LinkedListNode[T]{Value: v}
+		tail.Next = l.newNode(v, nil)
 		tail = tail.Next
 	}
 
@@ -78,6 +123,20 @@ func NewBasicLinkedList[T any](values ...T) *BasicLinkedList[T] {
 	return l
 }
 
+// Creates a new empty BasicLinkedList from the default configuration
+// adjusted by the given options. Options are applied in order.
+//
+// Panics if the resulting configuration is invalid.
+//
+// Example:
+//
+//	l := NewBasicLinkedListWith[int](WithArenaSlabSize(1024))
+//	// ... bulk work ...
+//	l.Release()  // Drops every node in O(1)
+func NewBasicLinkedListWith[T any](opts ...LinkedListOption) *BasicLinkedList[T] {
+	return newBasicLinkedList[T](newLinkedListConfig(opts))
+}
+
 // Creates a new LinkedList with optional initial values.
 //
 // Values are inserted in the order provided. If no values are given,
@@ -90,7 +149,26 @@ func NewBasicLinkedList[T any](values ...T) *BasicLinkedList[T] {
 //	empty := NewLinkedList[int]()
 //	withValues := NewLinkedList(1, 2, 3)
 func NewLinkedList[T comparable](values ...T) *LinkedList[T] {
-	basic := NewBasicLinkedList(values...)
+	return newLinkedList(LinkedListConfig{}, values...)
+}
+
+// Creates a new LinkedList with custom allocation settings and optional
+// initial values. See LinkedListConfig for the options.
+//
+// Panics if the configuration is invalid.
+//
+// Time complexity: O(n) where n is the number of initial values.
+//
+// Deprecated: Use NewLinkedListWith with WithArenaSlabSize and
+// WithFreeListCapacity, then AddLast.
+func NewLinkedListWithConfig[T comparable](config LinkedListConfig, values ...T) *LinkedList[T] {
+	return newLinkedList(config, values...)
+}
+
+// Creates a new LinkedList with the given settings and initial values.
+// Panics if the configuration is invalid.
+func newLinkedList[T comparable](config LinkedListConfig, values ...T) *LinkedList[T] {
+	basic := newBasicLinkedList(config, values...)
 	l := &LinkedList[T]{
 		BasicLinkedList: *basic,
 	}
@@ -98,6 +176,18 @@ func NewLinkedList[T comparable](values ...T) *LinkedList[T] {
 	return l
 }
 
+// Creates a new empty LinkedList from the default configuration adjusted
+// by the given options. Options are applied in order.
+//
+// Panics if the resulting configuration is invalid.
+//
+// Example:
+//
+//	l := NewLinkedListWith[string](WithArenaSlabSize(1024))
+func NewLinkedListWith[T comparable](opts ...LinkedListOption) *LinkedList[T] {
+	return newLinkedList[T](newLinkedListConfig(opts))
+}
+
 // Prepends a value to the start of the list.
 //
 // Time complexity: O(1)
@@ -109,7 +199,7 @@ func NewLinkedList[T comparable](values ...T) *LinkedList[T] {
 //	l := NewLinkedList(1, 2)
 //	l.AddFirst(0)  // List is now [0, 1, 2]
 func (l *BasicLinkedList[T]) AddFirst(value T) {
-	head := &LinkedListNode[T]{Value: value, Next: l.head}
+	head := l.newNode(value, l.head)
 
 	l.head = head
 	if l.tail == nil {
@@ -131,7 +221,7 @@ func (l *BasicLinkedList[T]) AddFirst(value T) {
 //	l := NewLinkedList(1, 2)
 //	l.AddLast(3)  // List is now [1, 2, 3]
 func (l *BasicLinkedList[T]) AddLast(value T) {
-	tail := &LinkedListNode[T]{Value: value}
+	tail := l.newNode(value, nil)
 
 	if l.head == nil {
 		// Empty list: new node becomes both head and tail
@@ -163,6 +253,7 @@ func (l *BasicLinkedList[T]) RemoveFirst() bool {
 
 	// Special case: one element in the list
 	if l.head == l.tail {
+		l.recycle(l.head)
 		l.head = nil
 		l.tail = nil
 		l.size--
@@ -171,6 +262,7 @@ func (l *BasicLinkedList[T]) RemoveFirst() bool {
 
 	head := l.head.Next
 	l.head.Next = nil // Help GC
+	l.recycle(l.head)
 	l.head = head
 	l.size--
 	return true
@@ -193,6 +285,7 @@ func (l *BasicLinkedList[T]) RemoveLast() bool {
 
 	// Special case: one element in the list
 	if l.head == l.tail {
+		l.recycle(l.head)
 		l.head = nil
 		l.tail = nil
 		l.size--
@@ -204,6 +297,7 @@ func (l *BasicLinkedList[T]) RemoveLast() bool {
 		node = node.Next
 	}
 
+	l.recycle(l.tail)
 	l.tail = node
 	l.tail.Next = nil
 	l.size--
@@ -212,7 +306,7 @@ func (l *BasicLinkedList[T]) RemoveLast() bool {
 
 // Returns the first element in the list.
 //
-// Returns ErrorEmptyList if the list is empty.
+// Returns ErrEmptyList if the list is empty.
 //
 // Time complexity: O(1)
 //
@@ -225,7 +319,7 @@ func (l *BasicLinkedList[T]) RemoveLast() bool {
 func (l *BasicLinkedList[T]) First() (T, error) {
 	if l.head == nil {
 		var zero T
-		return zero, errors.New(ErrorEmptyList)
+		return zero, ErrEmptyList
 	}
 
 	return l.head.Value, nil
@@ -233,7 +327,7 @@ func (l *BasicLinkedList[T]) First() (T, error) {
 
 // Returns the last element in the list.
 //
-// Returns ErrorEmptyList if the list is empty.
+// Returns ErrEmptyList if the list is empty.
 //
 // Time complexity: O(1) - uses tail pointer
 //
@@ -246,12 +340,67 @@ func (l *BasicLinkedList[T]) First() (T, error) {
 func (l *BasicLinkedList[T]) Last() (T, error) {
 	if l.tail == nil {
 		var zero T
-		return zero, errors.New(ErrorEmptyList)
+		return zero, ErrEmptyList
 	}
 
 	return l.tail.Value, nil
 }
 
+// Appends a value to the end of the list, as AddLast does.
+// Always returns true; lists accept duplicates.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	l := NewLinkedList(1)
+//	l.Add(2)  // Returns true, list is [1, 2]
+func (l *BasicLinkedList[T]) Add(value T) bool {
+	l.AddLast(value)
+	return true
+}
+
+// All returns an iterator over the elements from head to tail.
+// The list must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+//
+// Example:
+//
+//	l := NewLinkedList(1, 2, 3)
+//	for v := range l.All() {
+//	    fmt.Println(v)  // 1, 2, 3
+//	}
+func (l *BasicLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.head; n != nil; n = n.Next {
+			if !yield(n.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Returns page index of the elements, split into pages of size elements,
+// along with the total count. The list is walked from the head to the end
+// of the page.
+//
+// Panics if index is negative or size is not positive.
+//
+// Time complexity: O(index*size + size)
+//
+// Space complexity: O(size)
+//
+// Example:
+//
+//	l := NewLinkedList(1, 2, 3, 4, 5)
+//	p := l.Page(2, 2)  // p.Items is [5], p.HasNext() is false
+func (l *BasicLinkedList[T]) Page(index int, size int) collections.Page[T] {
+	return collections.Paginate(l.All(), l.size, index, size)
+}
+
 // Returns true if the list contains no elements.
 //
 // Time complexity: O(1)
@@ -282,12 +431,217 @@ func (l *BasicLinkedList[T]) Size() int {
 	return l.size
 }
 
+// Reverses the order of the elements in place by relinking the nodes.
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	l := NewLinkedList(1, 2, 3)
+//	l.Reverse()  // List is now [3, 2, 1]
+func (l *BasicLinkedList[T]) Reverse() {
+	var prev *LinkedListNode[T]
+	for n := l.head; n != nil; {
+		next := n.Next
+		n.Next = prev
+		prev, n = n, next
+	}
+
+	l.head, l.tail = l.tail, l.head
+}
+
+// Removes all elements at once. With arena allocation the slabs are
+// released as well, so the nodes are reclaimed together by the garbage
+// collector; later insertions start a new slab. The nodes are not
+// recycled, and with arena allocation the recycled nodes are dropped too,
+// as they would keep the released slabs alive.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	l := NewBasicLinkedListWith[int](WithArenaSlabSize(1024))
+//	l.AddLast(1)
+//	l.Release()  // List is now empty
+func (l *BasicLinkedList[T]) Release() {
+	l.head = nil
+	l.tail = nil
+	l.size = 0
+	if l.arena != nil {
+		l.arena.Release()
+		if l.free != nil {
+			l.free.Clear()
+		}
+	}
+}
+
+// Removes all elements, as Release does.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	l := NewLinkedList(1, 2, 3)
+//	l.Clear()  // List is now empty
+func (l *BasicLinkedList[T]) Clear() {
+	l.Release()
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements from head to tail in the format of package codec.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (l *BasicLinkedList[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("BasicLinkedList", l.size, l.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the list with those encoded by MarshalBinary, keeping the
+// allocation settings. LinkedList shares the format.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded list, in which case the list is left unchanged.
+//
+// Time complexity: O(n)
+func (l *BasicLinkedList[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "BasicLinkedList")
+	if err != nil {
+		return err
+	}
+
+	l.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (l *BasicLinkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "BasicLinkedList", l.size, l.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// list with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The list is
+// left unchanged on error.
+//
+// Time complexity: O(n)
+func (l *BasicLinkedList[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "BasicLinkedList")
+	if err != nil {
+		return n, err
+	}
+
+	l.restore(values)
+	return n, nil
+}
+
+// ToDOT writes the nodes and next pointers of the list to w in the
+// Graphviz DOT language (see debug.DOT), with the head and tail pointers
+// drawn from a node for the list itself. A cycle in corrupted links is
+// drawn once instead of followed forever.
+// Returns the first write error.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	l := NewBasicLinkedList(1, 2, 3)
+//	l.ToDOT(os.Stdout)  // Render with: dot -Tsvg
+func (l *BasicLinkedList[T]) ToDOT(w io.Writer) error {
+	d := debug.NewDOT(true)
+	d.Node(l, fmt.Sprintf("BasicLinkedList\nsize %d", l.size))
+	for n := l.head; n != nil && d.Node(n, fmt.Sprint(n.Value)); n = n.Next {
+		if n.Next != nil {
+			d.Edge(n, n.Next, "next")
+		}
+	}
+
+	if l.head != nil {
+		d.Edge(l, l.head, "head")
+	}
+	if l.tail != nil {
+		d.Node(l.tail, fmt.Sprint(l.tail.Value)) // Unreachable if the links are broken
+		d.Edge(l, l.tail, "tail")
+	}
+
+	_, err := d.WriteTo(w)
+	return err
+}
+
+// CheckInvariants verifies the internal consistency of the list: the
+// nodes reachable from head end at tail, tail has no successor, and their
+// number matches Size. Intended for tests and debugging, to localize
+// corruption close to the operation that caused it.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the list is consistent.
+//
+// Time complexity: O(n)
+func (l *BasicLinkedList[T]) CheckInvariants() error {
+	if (l.head == nil) != (l.tail == nil) {
+		return fmt.Errorf("%w: head is nil: %t, tail is nil: %t", ErrInvariantViolation, l.head == nil, l.tail == nil)
+	}
+	if l.tail != nil && l.tail.Next != nil {
+		return fmt.Errorf("%w: tail has a successor", ErrInvariantViolation)
+	}
+
+	// Stop one node past the expected size to survive cycles
+	count := 0
+	var last *LinkedListNode[T]
+	for n := l.head; n != nil && count <= l.size; n = n.Next {
+		last = n
+		count++
+	}
+	if count != l.size {
+		return fmt.Errorf("%w: size is %d, traversal found %d or more nodes", ErrInvariantViolation, l.size, count)
+	}
+	if last != l.tail {
+		return fmt.Errorf("%w: traversal does not end at tail", ErrInvariantViolation)
+	}
+
+	return nil
+}
+
+// Returns a node holding the value, taken from the free list if it has
+// a recycled node, otherwise from the arena if enabled.
+func (l *BasicLinkedList[T]) newNode(value T, next *LinkedListNode[T]) *LinkedListNode[T] {
+	var n *LinkedListNode[T]
+	switch {
+	case l.free != nil && (l.free.Size() > 0 || l.arena == nil):
+		n = l.free.Get()
+	case l.arena != nil:
+		n = l.arena.Alloc()
+	default:
+		return &LinkedListNode[T]{Value: value, Next: next}
+	}
+
+	n.Value, n.Next = value, next
+	return n
+}
+
+// Hands a node that was unlinked from the list to the free list, if
+// node recycling is enabled. The node must not be used afterwards.
+func (l *BasicLinkedList[T]) recycle(n *LinkedListNode[T]) {
+	if l.free != nil {
+		l.free.Put(n)
+	}
+}
+
 // Inserts a value at the specified index.
 //
 // Valid indices are 0 to Size() inclusive. Index 0 inserts at the head,
 // index Size() appends to the end (equivalent to Add).
 //
-// Returns ErrorIndexOutOfRange if index is invalid.
+// Returns ErrIndexOutOfRange if index is invalid.
 //
 // Time complexity: O(n) where n is the index
 //
@@ -300,12 +654,12 @@ func (l *BasicLinkedList[T]) Size() int {
 //	l.InsertAt(0, 0)  // List is now [0, 1, 2, 3, 4]
 func (l *LinkedList[T]) InsertAt(index int, value T) error {
 	if index < 0 || index > l.size {
-		return errors.New(ErrorIndexOutOfRange)
+		return ErrIndexOutOfRange
 	}
 
 	// Special case: insert at head
 	if index == 0 {
-		l.head = &LinkedListNode[T]{Value: value, Next: l.head}
+		l.head = l.newNode(value, l.head)
 		if l.size == 0 {
 			l.tail = l.head // Was empty, update tail
 		}
@@ -315,7 +669,7 @@ func (l *LinkedList[T]) InsertAt(index int, value T) error {
 
 	// Special case: insert at tail
 	if index == l.size {
-		l.tail.Next = &LinkedListNode[T]{Value: value}
+		l.tail.Next = l.newNode(value, nil)
 		l.tail = l.tail.Next
 		l.size++
 		return nil
@@ -327,7 +681,7 @@ func (l *LinkedList[T]) InsertAt(index int, value T) error {
 		prev = prev.Next
 	}
 
-	prev.Next = &LinkedListNode[T]{Value: value, Next: prev.Next}
+	prev.Next = l.newNode(value, prev.Next)
 	l.size++
 	return nil
 }
@@ -335,7 +689,7 @@ func (l *LinkedList[T]) InsertAt(index int, value T) error {
 // Updates the element at the specified index.
 //
 // Valid indices are 0 to Size()-1.
-// Returns ErrorIndexOutOfRange if index is invalid.
+// Returns ErrIndexOutOfRange if index is invalid.
 //
 // Time complexity: O(n) where n is the index
 //
@@ -348,7 +702,7 @@ func (l *LinkedList[T]) InsertAt(index int, value T) error {
 func (l *LinkedList[T]) UpdateAt(index int, value T) (T, error) {
 	if index < 0 || index >= l.size {
 		var zero T
-		return zero, errors.New(ErrorIndexOutOfRange)
+		return zero, ErrIndexOutOfRange
 	}
 
 	node := l.head
@@ -364,7 +718,7 @@ func (l *LinkedList[T]) UpdateAt(index int, value T) (T, error) {
 // Removes the element at the specified index.
 //
 // Valid indices are 0 to Size()-1.
-// Returns ErrorIndexOutOfRange if index is invalid.
+// Returns ErrIndexOutOfRange if index is invalid.
 //
 // Time complexity: O(n) where n is the index
 //
@@ -376,15 +730,17 @@ func (l *LinkedList[T]) UpdateAt(index int, value T) (T, error) {
 //	l.RemoveAt(1)  // Removes 2, list is now [1, 3]
 func (l *LinkedList[T]) RemoveAt(index int) error {
 	if index < 0 || index >= l.size {
-		return errors.New(ErrorIndexOutOfRange)
+		return ErrIndexOutOfRange
 	}
 
 	// Special case: remove head
 	if index == 0 {
-		l.head = l.head.Next
+		head := l.head
+		l.head = head.Next
 		if l.head == nil {
 			l.tail = nil // List becomes empty
 		}
+		l.recycle(head)
 		l.size--
 		return nil
 	}
@@ -402,6 +758,7 @@ func (l *LinkedList[T]) RemoveAt(index int) error {
 	if target == l.tail {
 		l.tail = prev
 	}
+	l.recycle(target)
 	l.size--
 	return nil
 }
@@ -409,7 +766,7 @@ func (l *LinkedList[T]) RemoveAt(index int) error {
 // Returns the element at the specified index.
 //
 // Valid indices are 0 to Size()-1.
-// Returns ErrorIndexOutOfRange if index is invalid.
+// Returns ErrIndexOutOfRange if index is invalid.
 //
 // Time complexity: O(n) where n is the index
 //
@@ -422,7 +779,7 @@ func (l *LinkedList[T]) RemoveAt(index int) error {
 func (l *LinkedList[T]) GetAt(index int) (T, error) {
 	if index < 0 || index >= l.size {
 		var zero T
-		return zero, errors.New(ErrorIndexOutOfRange)
+		return zero, ErrIndexOutOfRange
 	}
 
 	// Traverse to index
@@ -510,7 +867,9 @@ func (l *LinkedList[T]) Remove(value T) bool {
 			l.tail = nil // List becomes empty
 		}
 
-		l.head = l.head.Next
+		head := l.head
+		l.head = head.Next
+		l.recycle(head)
 		l.size--
 		return true
 	}
@@ -526,6 +885,7 @@ func (l *LinkedList[T]) Remove(value T) bool {
 			if target == l.tail {
 				l.tail = prev
 			}
+			l.recycle(target)
 			l.size--
 			return true
 		}
@@ -566,3 +926,11 @@ func (l *LinkedList[T]) Update(oldValue T, newValue T) bool {
 
 	return false
 }
+
+// Replaces the elements with the decoded values, head to tail.
+func (l *BasicLinkedList[T]) restore(values []T) {
+	l.Clear()
+	for _, v := range values {
+		l.AddLast(v)
+	}
+}
@@ -0,0 +1,91 @@
+package structures
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
+
+// LinkedListConfig controls node allocation for BasicLinkedList and
+// LinkedList.
+//
+// The lists support two optional allocation strategies, which can be
+// combined:
+//
+// Arena allocation (bulk-lifetime optimization):
+//
+// Nodes are carved from slabs of a memory.Arena instead of being
+// allocated one by one, so building a list of n elements takes n/slab
+// allocations and Release discards the whole list in O(1). Removed nodes
+// are not reused until Release, so the mode suits lists that are built,
+// traversed and dropped as a whole rather than long-lived queues.
+//
+// Node recycling (churn optimization):
+//
+// Removed nodes are kept on a memory.FreeList and handed out again by
+// later insertions, so a list whose size fluctuates around a steady
+// state, such as a queue, stops allocating once it has warmed up.
+type LinkedListConfig struct {
+	// ArenaSlabSize represents the number of nodes allocated per slab.
+	//
+	// Larger slabs mean fewer allocations but more memory pinned by a
+	// single live node and more waste in the last, partly used slab.
+	//
+	// Recommended values:
+	//   0:         Disabled, nodes are allocated individually (default)
+	//   256-4096:  Bulk lists of thousands to millions of elements
+	//
+	// Valid range: [0, ...]
+	ArenaSlabSize int
+
+	// FreeListCapacity represents the maximum number of removed nodes kept
+	// for reuse by later insertions.
+	//
+	// Nodes removed while the free list is full are left to the garbage
+	// collector, so the capacity bounds the memory held by idle nodes
+	// after the list shrinks.
+	//
+	// Recommended values:
+	//   0:         Disabled, removed nodes are dropped (default)
+	//   64-1024:   Queues and lists with a steady stream of adds and removes
+	//
+	// Valid range: [0, ...]
+	FreeListCapacity int
+}
+
+// Validates the configuration values.
+//
+// Panics if values are invalid:
+//   - ArenaSlabSize < 0
+//   - FreeListCapacity < 0
+func (c *LinkedListConfig) validate() {
+	panics.RequireNonNegative(c.ArenaSlabSize, "arena slab size")
+	panics.RequireNonNegative(c.FreeListCapacity, "free list capacity")
+}
+
+// LinkedListOption configures a list created with NewBasicLinkedListWith
+// or NewLinkedListWith. Options are applied in order on top of the
+// default configuration.
+type LinkedListOption func(*LinkedListConfig)
+
+// WithArenaSlabSize enables arena allocation with the given slab size.
+// See LinkedListConfig.ArenaSlabSize.
+func WithArenaSlabSize(size int) LinkedListOption {
+	return func(c *LinkedListConfig) {
+		c.ArenaSlabSize = size
+	}
+}
+
+// WithFreeListCapacity enables node recycling, keeping up to capacity
+// removed nodes for reuse. See LinkedListConfig.FreeListCapacity.
+func WithFreeListCapacity(capacity int) LinkedListOption {
+	return func(c *LinkedListConfig) {
+		c.FreeListCapacity = capacity
+	}
+}
+
+// Returns the default configuration adjusted by the options.
+func newLinkedListConfig(opts []LinkedListOption) LinkedListConfig {
+	var c LinkedListConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
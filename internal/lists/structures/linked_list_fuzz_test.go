@@ -0,0 +1,29 @@
+package structures
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/proptest"
+)
+
+// Fuzzes LinkedList with operation sequences decoded from the input. The
+// first byte selects the configuration, the rest drives the operations of
+// the property tests, which compare every step against a slice model.
+//
+// Run: go test ./internal/lists/structures -run '^$' -fuzz FuzzLinkedList
+func FuzzLinkedList(f *testing.F) {
+	f.Add([]byte{0, 10, 5, 50, 7, 120, 150, 230, 3})
+	f.Add([]byte{1, 10, 1, 10, 2, 10, 3, 190, 0, 210, 128, 255, 2})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) == 0 {
+			return
+		}
+
+		config := linkedListModelConfigs[int(data[0])%len(linkedListModelConfigs)].config
+		setup := func() (*LinkedList[int], []int) {
+			return NewLinkedListWithConfig[int](config), []int{}
+		}
+		proptest.Replay(t, data[1:], 1000, setup, checkLinkedListModel, linkedListOps()...)
+	})
+}
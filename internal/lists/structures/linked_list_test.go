@@ -101,11 +101,64 @@ Update:
   ✓ Update non-existent element
   ✓ Update existing element
   ✓ Update elements in order
+
+Reverse:
+  ✓ Empty and single-element lists
+  ✓ Head, tail and order after reversal
+
+Arena allocation (NewLinkedListWithConfig/Release):
+  ✓ Invalid slab size panics
+  ✓ Operations across several slabs
+  ✓ Release empties the list, list stays usable
+
+Node recycling (FreeListCapacity):
+  ✓ Invalid capacity panics
+  ✓ Removed nodes are reused by later insertions, zeroed on removal
+  ✓ Free list bounded by its capacity
+  ✓ Recycled nodes served before the arena, dropped on Release
+
+Properties:
+  ✓ Random operation sequences match a slice model, heap, arena and
+    recycled nodes
+
+CheckInvariants:
+  ✓ Valid lists, inconsistent ends, wrong size, cycle
+
+ToDOT:
+  ✓ Empty list, nodes with next, head and tail pointers
+  ✓ Cycle drawn once
+
+Add/All/Clear:
+  ✓ Add appends and always returns true
+  ✓ All yields head to tail, early termination
+  ✓ Clear empties the list, which stays usable
+
+Options (NewBasicLinkedListWith/NewLinkedListWith):
+  ✓ No options allocates nodes individually
+  ✓ WithArenaSlabSize enables arena allocation
+  ✓ WithFreeListCapacity enables node recycling
+  ✓ Invalid options (panic)
+
+Binary encoding:
+  ✓ Round trip keeps the arena settings, invalid data rejected
+  ✓ Encoding shared by basic and comparable lists
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the list unchanged
+
+Page:
+  ✓ Items, total count, last and past-the-end pages
 */
 
 import (
+	"math/rand/v2"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/proptest"
 	"github.com/apotourlyan/godatastructures/internal/utilities/test"
 )
 
@@ -380,7 +433,7 @@ func TestLinkedList_RemoveLast_Order(t *testing.T) {
 func TestLinkedList_First_EmptyList(t *testing.T) {
 	l := NewLinkedList[int]()
 	f, err := l.First()
-	test.GotWantError(t, err, ErrorEmptyList)
+	test.GotWantErrorIs(t, err, ErrEmptyList)
 	test.GotWant(t, f, 0)
 	test.GotWant(t, l.size, 0)
 	test.GotWant(t, l.head, nil)
@@ -391,7 +444,7 @@ func TestLinkedList_First_EmptyList(t *testing.T) {
 func TestLinkedList_First_NonEmptyList(t *testing.T) {
 	l := NewLinkedList(1, 2, 3, 4)
 	f, err := l.First()
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, f, 1)
 	test.GotWant(t, l.size, 4)
 	test.GotWant(t, l.head.Value, 1)
@@ -403,7 +456,7 @@ func TestLinkedList_First_NonEmptyList(t *testing.T) {
 func TestLinkedList_Last_EmptyList(t *testing.T) {
 	l := NewLinkedList[int]()
 	la, err := l.Last()
-	test.GotWantError(t, err, ErrorEmptyList)
+	test.GotWantErrorIs(t, err, ErrEmptyList)
 	test.GotWant(t, la, 0)
 	test.GotWant(t, l.size, 0)
 	test.GotWant(t, l.head, nil)
@@ -414,7 +467,7 @@ func TestLinkedList_Last_EmptyList(t *testing.T) {
 func TestLinkedList_Last_NonEmptyList(t *testing.T) {
 	l := NewLinkedList(1, 2, 3, 4)
 	la, err := l.Last()
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, la, 4)
 	test.GotWant(t, l.size, 4)
 	test.GotWant(t, l.head.Value, 1)
@@ -468,7 +521,7 @@ func TestLinkedList_Size_NonEmptyList(t *testing.T) {
 func TestLinkedList_InsertAt_NegativeIndex(t *testing.T) {
 	l := NewLinkedList[int]()
 	err := l.InsertAt(-1, 1)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, l.size, 0)
 	test.GotWant(t, l.head, nil)
 	test.GotWant(t, l.tail, nil)
@@ -478,7 +531,7 @@ func TestLinkedList_InsertAt_NegativeIndex(t *testing.T) {
 func TestLinkedList_InsertAt_InvalidIndex(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	err := l.InsertAt(4, 4)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, l.size, 3)
 	test.GotWant(t, l.head.Value, 1)
 	test.GotWant(t, l.tail.Value, 3)
@@ -489,7 +542,7 @@ func TestLinkedList_InsertAt_InvalidIndex(t *testing.T) {
 func TestLinkedList_InsertAt_EmptyList(t *testing.T) {
 	l := NewLinkedList[int]()
 	err := l.InsertAt(0, 1)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, l.size, 1)
 	test.GotWant(t, l.head, l.tail)
 	test.GotWant(t, l.head.Value, 1)
@@ -501,7 +554,7 @@ func TestLinkedList_InsertAt_EmptyList(t *testing.T) {
 func TestLinkedList_InsertAt_Start_OneElementList(t *testing.T) {
 	l := NewLinkedList(1)
 	err := l.InsertAt(0, 0)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, l.size, 2)
 	test.GotWant(t, l.head.Value, 0)
 	test.GotWant(t, l.tail.Value, 1)
@@ -512,7 +565,7 @@ func TestLinkedList_InsertAt_Start_OneElementList(t *testing.T) {
 func TestLinkedList_InsertAt_End_OneElementList(t *testing.T) {
 	l := NewLinkedList(1)
 	err := l.InsertAt(1, 2)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, l.size, 2)
 	test.GotWant(t, l.head.Value, 1)
 	test.GotWant(t, l.tail.Value, 2)
@@ -523,7 +576,7 @@ func TestLinkedList_InsertAt_End_OneElementList(t *testing.T) {
 func TestLinkedList_InsertAt_Start_ManyElementList(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	err := l.InsertAt(0, 0)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, l.size, 4)
 	test.GotWant(t, l.head.Value, 0)
 	test.GotWant(t, l.tail.Value, 3)
@@ -534,7 +587,7 @@ func TestLinkedList_InsertAt_Start_ManyElementList(t *testing.T) {
 func TestLinkedList_InsertAt_End_ManyElementList(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	err := l.InsertAt(3, 4)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, l.size, 4)
 	test.GotWant(t, l.head.Value, 1)
 	test.GotWant(t, l.tail.Value, 4)
@@ -545,7 +598,7 @@ func TestLinkedList_InsertAt_End_ManyElementList(t *testing.T) {
 func TestLinkedList_InsertAt_Middle_ManyElementList(t *testing.T) {
 	l := NewLinkedList(1, 2, 4)
 	err := l.InsertAt(2, 3)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, l.size, 4)
 	test.GotWant(t, l.head.Value, 1)
 	test.GotWant(t, l.tail.Value, 4)
@@ -568,7 +621,7 @@ func TestLinkedList_InsertAt_Order(t *testing.T) {
 func TestLinkedList_UpdateAt_NegativeIndex(t *testing.T) {
 	l := NewLinkedList[int]()
 	old, err := l.UpdateAt(-1, 0)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, old, 0)
 	test.GotWant(t, l.size, 0)
 	test.GotWant(t, l.head, nil)
@@ -579,7 +632,7 @@ func TestLinkedList_UpdateAt_NegativeIndex(t *testing.T) {
 func TestLinkedList_UpdateAt_InvalidIndex(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	old, err := l.UpdateAt(3, 4)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, old, 0)
 	test.GotWant(t, l.size, 3)
 	test.GotWant(t, l.head.Value, 1)
@@ -591,7 +644,7 @@ func TestLinkedList_UpdateAt_InvalidIndex(t *testing.T) {
 func TestLinkedList_UpdateAt_Start(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	old, err := l.UpdateAt(0, 4)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, old, 1)
 	test.GotWant(t, l.size, 3)
 	test.GotWant(t, l.head.Value, 4)
@@ -603,7 +656,7 @@ func TestLinkedList_UpdateAt_Start(t *testing.T) {
 func TestLinkedList_UpdateAt_End(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	old, err := l.UpdateAt(2, 4)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, old, 3)
 	test.GotWant(t, l.size, 3)
 	test.GotWant(t, l.head.Value, 1)
@@ -615,7 +668,7 @@ func TestLinkedList_UpdateAt_End(t *testing.T) {
 func TestLinkedList_UpdateAt_Middle(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	old, err := l.UpdateAt(1, 4)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, old, 2)
 	test.GotWant(t, l.size, 3)
 	test.GotWant(t, l.head.Value, 1)
@@ -641,7 +694,7 @@ func TestLinkedList_UpdateAt_Order(t *testing.T) {
 func TestLinkedList_RemoveAt_NegativeIndex(t *testing.T) {
 	l := NewLinkedList[int]()
 	err := l.RemoveAt(-1)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, l.size, 0)
 	test.GotWant(t, l.head, nil)
 	test.GotWant(t, l.tail, nil)
@@ -651,7 +704,7 @@ func TestLinkedList_RemoveAt_NegativeIndex(t *testing.T) {
 func TestLinkedList_RemoveAt_InvalidIndex(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	err := l.RemoveAt(3)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, l.size, 3)
 	test.GotWant(t, l.head.Value, 1)
 	test.GotWant(t, l.tail.Value, 3)
@@ -662,7 +715,7 @@ func TestLinkedList_RemoveAt_InvalidIndex(t *testing.T) {
 func TestLinkedList_RemoveAt_OneElementList(t *testing.T) {
 	l := NewLinkedList(1)
 	err := l.RemoveAt(0)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, l.size, 0)
 	test.GotWant(t, l.head, nil)
 	test.GotWant(t, l.tail, nil)
@@ -672,7 +725,7 @@ func TestLinkedList_RemoveAt_OneElementList(t *testing.T) {
 func TestLinkedList_RemoveAt_Start(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	err := l.RemoveAt(0)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, l.size, 2)
 	test.GotWant(t, l.head.Value, 2)
 	test.GotWant(t, l.tail.Value, 3)
@@ -683,7 +736,7 @@ func TestLinkedList_RemoveAt_Start(t *testing.T) {
 func TestLinkedList_RemoveAt_End(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	err := l.RemoveAt(2)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, l.size, 2)
 	test.GotWant(t, l.head.Value, 1)
 	test.GotWant(t, l.tail.Value, 2)
@@ -694,7 +747,7 @@ func TestLinkedList_RemoveAt_End(t *testing.T) {
 func TestLinkedList_RemoveAt_Middle(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	err := l.RemoveAt(1)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, l.size, 2)
 	test.GotWant(t, l.head.Value, 1)
 	test.GotWant(t, l.tail.Value, 3)
@@ -717,7 +770,7 @@ func TestLinkedList_RemoveAt_Order(t *testing.T) {
 func TestLinkedList_GetAt_NegativeIndex(t *testing.T) {
 	l := NewLinkedList[int]()
 	v, err := l.GetAt(-1)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, v, 0)
 	test.GotWant(t, l.size, 0)
 	test.GotWant(t, l.head, nil)
@@ -728,7 +781,7 @@ func TestLinkedList_GetAt_NegativeIndex(t *testing.T) {
 func TestLinkedList_GetAt_InvalidIndex(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	v, err := l.GetAt(3)
-	test.GotWantError(t, err, ErrorIndexOutOfRange)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
 	test.GotWant(t, v, 0)
 	test.GotWant(t, l.size, 3)
 	test.GotWant(t, l.head.Value, 1)
@@ -740,7 +793,7 @@ func TestLinkedList_GetAt_InvalidIndex(t *testing.T) {
 func TestLinkedList_GetAt_Start(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	v, err := l.GetAt(0)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, v, 1)
 	test.GotWant(t, l.size, 3)
 	test.GotWant(t, l.head.Value, 1)
@@ -752,7 +805,7 @@ func TestLinkedList_GetAt_Start(t *testing.T) {
 func TestLinkedList_GetAt_End(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	v, err := l.GetAt(2)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, v, 3)
 	test.GotWant(t, l.size, 3)
 	test.GotWant(t, l.head.Value, 1)
@@ -764,7 +817,7 @@ func TestLinkedList_GetAt_End(t *testing.T) {
 func TestLinkedList_GetAt_Middle(t *testing.T) {
 	l := NewLinkedList(1, 2, 3)
 	v, err := l.GetAt(1)
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, v, 2)
 	test.GotWant(t, l.size, 3)
 	test.GotWant(t, l.head.Value, 1)
@@ -778,7 +831,7 @@ func TestLinkedList_GetAt_Order(t *testing.T) {
 
 	for i := range l.size {
 		v, err := l.GetAt(i)
-		test.GotWant(t, err, nil)
+		test.GotWantNoError(t, err)
 		test.GotWant(t, v, i+1)
 	}
 }
@@ -992,3 +1045,397 @@ func TestLinkedList_Update_Order(t *testing.T) {
 	test.GotWant(t, l.tail.Value, 4)
 	test.GotWant(t, l.tail.Next, nil)
 }
+
+// Returns the list values from head to tail.
+func linkedListValues[T comparable](l *LinkedList[T]) []T {
+	values := []T{}
+	for n := l.head; n != nil; n = n.Next {
+		values = append(values, n.Value)
+	}
+	return values
+}
+
+// Verifies reversing empty and single-element lists
+func TestLinkedList_Reverse_Short(t *testing.T) {
+	empty := NewLinkedList[int]()
+	empty.Reverse()
+	test.GotWant(t, empty.head, nil)
+	test.GotWant(t, empty.tail, nil)
+
+	single := NewLinkedList(1)
+	single.Reverse()
+	test.GotWantSlice(t, linkedListValues(single), []int{1})
+	test.GotWant(t, single.head, single.tail)
+}
+
+// Verifies head, tail and order after reversal
+func TestLinkedList_Reverse(t *testing.T) {
+	l := NewLinkedList(1, 2, 3, 4)
+	l.Reverse()
+	test.GotWantSlice(t, linkedListValues(l), []int{4, 3, 2, 1})
+	test.GotWant(t, l.head.Value, 4)
+	test.GotWant(t, l.tail.Value, 1)
+	test.GotWant(t, l.tail.Next, nil)
+
+	l.AddLast(0)
+	test.GotWantSlice(t, linkedListValues(l), []int{4, 3, 2, 1, 0})
+}
+
+// Verifies a negative arena slab size panics
+func TestLinkedList_NewLinkedListWithConfig_InvalidSlabSize(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewLinkedListWithConfig(LinkedListConfig{ArenaSlabSize: -1}, 1)
+	}, `"arena slab size" must be >= 0, got -1`)
+}
+
+// Verifies an arena-backed list behaves like a heap-backed one across
+// several slabs
+func TestLinkedList_Arena_Operations(t *testing.T) {
+	l := NewLinkedListWithConfig(LinkedListConfig{ArenaSlabSize: 4}, 1, 2, 3)
+	l.AddFirst(0)
+	l.AddLast(5)
+	l.InsertAt(4, 4)
+	l.Remove(2)
+	for i := range 10 {
+		l.AddLast(6 + i)
+	}
+
+	test.GotWant(t, l.Size(), 15)
+	test.GotWant(t, l.arena.Slabs(), 4)
+	test.GotWantSlice(t, linkedListValues(l), []int{0, 1, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15})
+	test.GotWant(t, l.tail.Next, nil)
+}
+
+// Verifies Release empties the list and later insertions start a new slab
+func TestLinkedList_Arena_Release(t *testing.T) {
+	l := NewLinkedListWithConfig(LinkedListConfig{ArenaSlabSize: 2}, 1, 2, 3)
+	l.Release()
+	test.GotWant(t, l.Size(), 0)
+	test.GotWant(t, l.IsEmpty(), true)
+	test.GotWant(t, l.head, nil)
+	test.GotWant(t, l.tail, nil)
+	test.GotWant(t, l.arena.Slabs(), 0)
+
+	l.AddLast(7)
+	l.AddFirst(6)
+	test.GotWantSlice(t, linkedListValues(l), []int{6, 7})
+	test.GotWant(t, l.arena.Slabs(), 1)
+}
+
+// Verifies a negative free list capacity panics
+func TestLinkedList_NewLinkedListWithConfig_InvalidFreeListCapacity(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewLinkedListWithConfig(LinkedListConfig{FreeListCapacity: -1}, 1)
+	}, `"free list capacity" must be >= 0, got -1`)
+}
+
+// Verifies every removal hands its node to the free list zeroed, and
+// insertions take recycled nodes before allocating
+func TestLinkedList_FreeList_Reuse(t *testing.T) {
+	l := NewLinkedListWithConfig(LinkedListConfig{FreeListCapacity: 8}, 1, 2, 3, 4, 5, 6)
+	head := l.head
+	l.RemoveFirst()
+	l.RemoveLast()
+	l.RemoveAt(1)
+	l.Remove(5)
+	test.GotWant(t, l.free.Size(), 4)
+	test.GotWant(t, *head, LinkedListNode[int]{})
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	l.AddLast(7)
+	test.GotWant(t, l.free.Size(), 3)
+	test.GotWant(t, l.free.Stats().Reused, 1)
+	test.GotWantSlice(t, linkedListValues(l), []int{2, 4, 7})
+
+	l.RemoveFirst()
+	l.RemoveFirst()
+	l.RemoveFirst()
+	test.GotWant(t, l.free.Size(), 6)
+	test.GotWant(t, l.IsEmpty(), true)
+}
+
+// Verifies nodes removed while the free list is full are dropped
+func TestLinkedList_FreeList_Capacity(t *testing.T) {
+	l := NewBasicLinkedListWithConfig(LinkedListConfig{FreeListCapacity: 2}, 1, 2, 3, 4)
+	for range 4 {
+		l.RemoveFirst()
+	}
+
+	test.GotWant(t, l.free.Size(), 2)
+	test.GotWant(t, l.free.Stats().Dropped, 2)
+}
+
+// Verifies recycled nodes are served before the arena, and Release drops
+// them along with the slabs they point into
+func TestLinkedList_FreeList_Arena(t *testing.T) {
+	l := NewLinkedListWithConfig(LinkedListConfig{ArenaSlabSize: 4, FreeListCapacity: 4}, 1, 2, 3, 4)
+	l.RemoveFirst()
+	l.AddLast(5)
+	test.GotWant(t, l.arena.Size(), 4)
+	test.GotWant(t, l.arena.Slabs(), 1)
+
+	l.RemoveFirst()
+	l.Release()
+	test.GotWant(t, l.free.Size(), 0)
+
+	l.AddLast(6)
+	test.GotWant(t, l.arena.Size(), 1)
+	test.GotWantSlice(t, linkedListValues(l), []int{6})
+}
+
+// Verifies CheckInvariants accepts valid lists and reports inconsistent
+// ends, a wrong size and a cycle
+func TestLinkedList_CheckInvariants(t *testing.T) {
+	l := NewLinkedList[int]()
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	l.AddLast(1)
+	l.AddLast(2)
+	l.AddLast(3)
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	l.size = 4
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: size is 4, traversal found 3 or more nodes")
+	l.size = 3
+
+	tail := l.tail
+	l.tail = nil
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: head is nil: false, tail is nil: true")
+
+	l.tail = l.head.Next
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: tail has a successor")
+
+	l.tail = tail
+	tail.Next = l.head
+	test.GotWantError(t, l.CheckInvariants(), "invariant violation: tail has a successor")
+}
+
+// Verifies random operation sequences keep the list equivalent to a slice
+// model, with heap and arena node allocation
+func TestLinkedList_Properties(t *testing.T) {
+	for _, c := range linkedListModelConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			setup := func() (*LinkedList[int], []int) {
+				return NewLinkedListWithConfig[int](c.config), []int{}
+			}
+			proptest.Run(t, proptest.Config{Seed: 63, Runs: 30, Steps: 400}, setup, checkLinkedListModel, linkedListOps()...)
+		})
+	}
+}
+
+// Returns the operations of the LinkedList property and fuzz tests
+func linkedListOps() []proptest.Op[*LinkedList[int], []int] {
+	type op = proptest.Op[*LinkedList[int], []int]
+	return []op{
+		{Name: "AddFirst", Weight: 2, Apply: func(t *testing.T, r *rand.Rand, l *LinkedList[int], m *[]int) {
+			v := r.IntN(20)
+			l.AddFirst(v)
+			*m = slices.Insert(*m, 0, v)
+		}},
+		{Name: "AddLast", Weight: 2, Apply: func(t *testing.T, r *rand.Rand, l *LinkedList[int], m *[]int) {
+			v := r.IntN(20)
+			l.AddLast(v)
+			*m = append(*m, v)
+		}},
+		{Name: "RemoveFirst", Apply: func(t *testing.T, r *rand.Rand, l *LinkedList[int], m *[]int) {
+			test.GotWant(t, l.RemoveFirst(), len(*m) > 0)
+			if len(*m) > 0 {
+				*m = (*m)[1:]
+			}
+		}},
+		{Name: "RemoveLast", Apply: func(t *testing.T, r *rand.Rand, l *LinkedList[int], m *[]int) {
+			test.GotWant(t, l.RemoveLast(), len(*m) > 0)
+			if len(*m) > 0 {
+				*m = (*m)[:len(*m)-1]
+			}
+		}},
+		{Name: "InsertAt", Apply: func(t *testing.T, r *rand.Rand, l *LinkedList[int], m *[]int) {
+			i, v := r.IntN(len(*m)+1), r.IntN(20)
+			test.GotWant(t, l.InsertAt(i, v), nil)
+			*m = slices.Insert(*m, i, v)
+		}},
+		{Name: "RemoveAt", Apply: func(t *testing.T, r *rand.Rand, l *LinkedList[int], m *[]int) {
+			if len(*m) == 0 {
+				test.GotWantErrorIs(t, l.RemoveAt(0), ErrIndexOutOfRange)
+				return
+			}
+			i := r.IntN(len(*m))
+			test.GotWant(t, l.RemoveAt(i), nil)
+			*m = slices.Delete(*m, i, i+1)
+		}},
+		{Name: "Remove", Apply: func(t *testing.T, r *rand.Rand, l *LinkedList[int], m *[]int) {
+			v := r.IntN(20)
+			i := slices.Index(*m, v)
+			test.GotWant(t, l.Remove(v), i >= 0)
+			if i >= 0 {
+				*m = slices.Delete(*m, i, i+1)
+			}
+		}},
+	}
+}
+
+// Verifies the list matches its slice model
+func checkLinkedListModel(t *testing.T, l *LinkedList[int], m []int) {
+	t.Helper()
+	test.GotWantNoError(t, l.CheckInvariants())
+	test.GotWant(t, l.Size(), len(m))
+	test.GotWantSlice(t, linkedListValues(l), m)
+	if len(m) > 0 {
+		last, _ := l.Last()
+		test.GotWant(t, last, m[len(m)-1])
+	}
+}
+
+// Configurations the LinkedList property and fuzz tests run under
+var linkedListModelConfigs = []struct {
+	name   string
+	config LinkedListConfig
+}{
+	{"heap", LinkedListConfig{}},
+	{"arena", LinkedListConfig{ArenaSlabSize: 8}},
+	{"free list", LinkedListConfig{FreeListCapacity: 4}},
+	{"arena and free list", LinkedListConfig{ArenaSlabSize: 8, FreeListCapacity: 4}},
+}
+
+// Verifies ToDOT draws the nodes, next pointers and both ends
+func TestBasicLinkedList_ToDOT(t *testing.T) {
+	var b strings.Builder
+	test.GotWantNoError(t, NewBasicLinkedList[int]().ToDOT(&b))
+	test.GotWant(t, b.String(), "digraph {\n    n0 [label=\"BasicLinkedList\\nsize 0\"];\n}\n")
+
+	b.Reset()
+	test.GotWantNoError(t, NewBasicLinkedList(1, 2, 3).ToDOT(&b))
+	test.GotWant(t, b.String(), `digraph {
+    n0 [label="BasicLinkedList\nsize 3"];
+    n1 [label="1"];
+    n1 -> n2 [label="next"];
+    n2 [label="2"];
+    n2 -> n3 [label="next"];
+    n3 [label="3"];
+    n0 -> n1 [label="head"];
+    n0 -> n3 [label="tail"];
+}
+`)
+}
+
+// Verifies ToDOT terminates on a corrupted list whose tail links back to
+// the head, and draws the back link
+func TestBasicLinkedList_ToDOT_Cycle(t *testing.T) {
+	l := NewBasicLinkedList(1, 2)
+	l.tail.Next = l.head
+
+	var b strings.Builder
+	test.GotWantNoError(t, l.ToDOT(&b))
+	test.GotWant(t, strings.Contains(b.String(), `n2 -> n1 [label="next"];`), true)
+	test.GotWant(t, strings.Count(b.String(), "[label=\"1\"]"), 1)
+}
+
+// Verifies Add appends to the list like AddLast
+func TestLinkedList_Add(t *testing.T) {
+	l := NewBasicLinkedList(1)
+	test.GotWant(t, l.Add(2), true)
+	test.GotWant(t, l.Add(2), true)
+	test.GotWant(t, l.Size(), 3)
+
+	last, _ := l.Last()
+	test.GotWant(t, last, 2)
+}
+
+// Verifies All yields the elements from head to tail and stops early
+func TestLinkedList_All(t *testing.T) {
+	test.GotWantSlice(t, slices.Collect(NewBasicLinkedList[int]().All()), []int{})
+
+	l := NewLinkedList(1, 2, 3)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 3})
+
+	got := []int{}
+	for v := range l.All() {
+		got = append(got, v)
+		if v == 2 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []int{1, 2})
+}
+
+// Verifies Clear empties the list and later insertions work
+func TestLinkedList_Clear(t *testing.T) {
+	l := NewLinkedList(1, 2, 3)
+	l.Clear()
+	test.GotWant(t, l.IsEmpty(), true)
+	test.GotWant(t, l.Contains(1), false)
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	l.Add(4)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{4})
+}
+
+// Verifies no options allocates nodes individually
+func TestLinkedList_NewLinkedListWith_Defaults(t *testing.T) {
+	test.GotWant(t, NewBasicLinkedListWith[int]().arena == nil, true)
+	test.GotWant(t, NewLinkedListWith[int]().arena == nil, true)
+}
+
+// Verifies WithArenaSlabSize enables arena allocation
+func TestLinkedList_NewLinkedListWith_ArenaSlabSize(t *testing.T) {
+	l := NewLinkedListWith[int](WithArenaSlabSize(16))
+	test.GotWant(t, l.arena != nil, true)
+
+	l.AddLast(1)
+	l.AddLast(2)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2})
+	test.GotWant(t, NewBasicLinkedListWith[int](WithArenaSlabSize(16)).arena != nil, true)
+}
+
+// Verifies WithFreeListCapacity enables node recycling
+func TestLinkedList_NewLinkedListWith_FreeListCapacity(t *testing.T) {
+	l := NewLinkedListWith[int](WithFreeListCapacity(16))
+	test.GotWant(t, l.free != nil, true)
+	test.GotWant(t, l.arena == nil, true)
+	test.GotWant(t, NewLinkedListWith[int]().free == nil, true)
+}
+
+// Verifies invalid options panic at construction
+func TestLinkedList_NewLinkedListWith_InvalidOptions(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewBasicLinkedListWith[int](WithArenaSlabSize(-1))
+	}, `"arena slab size" must be >= 0, got -1`)
+}
+
+// Verifies a round trip keeps the arena settings of the receiving list,
+// invalid data is rejected and basic lists read the same encoding
+func TestLinkedList_MarshalBinary(t *testing.T) {
+	other, _ := NewDoublyLinkedList(1).MarshalBinary()
+	dst := NewLinkedListWith[int](WithArenaSlabSize(4))
+	dst.Add(9)
+	codectest.RoundTrip(t, NewLinkedList(1, 2, 3), dst,
+		func(l *LinkedList[int]) []int { return slices.Collect(l.All()) }, other)
+	test.GotWant(t, dst.arena != nil, true)
+	test.GotWantNoError(t, dst.CheckInvariants())
+
+	data, _ := NewBasicLinkedList(1, 2, 3).MarshalBinary()
+	test.GotWantNoError(t, dst.UnmarshalBinary(data))
+	test.GotWantSlice(t, slices.Collect(dst.All()), []int{1, 2, 3})
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements in list order
+func TestLinkedList_WriteTo_ReadFrom(t *testing.T) {
+	src := NewLinkedList(1, 2, 3)
+	dst := NewLinkedList(9)
+	codectest.StreamRoundTrip(t, src, dst,
+		func(l *LinkedList[int]) []int { return slices.Collect(l.All()) })
+}
+
+// Verifies pages of a linked list and their total count
+func TestLinkedList_Page(t *testing.T) {
+	l := NewLinkedList(1, 2, 3, 4, 5)
+	p := l.Page(0, 2)
+	test.GotWantSlice(t, p.Items, []int{1, 2})
+	test.GotWant(t, p.Total, 5)
+	test.GotWant(t, p.Pages(), 3)
+
+	p = l.Page(2, 2)
+	test.GotWantSlice(t, p.Items, []int{5})
+	test.GotWant(t, p.HasNext(), false)
+	test.GotWant(t, len(l.Page(3, 2).Items), 0)
+}
@@ -1,8 +1,22 @@
 // Package structures provides generic list data structures and their implementations.
 package structures
 
+import "errors"
+
 const ErrorEmptyList = "list is empty"
+const ErrorElementType = "element has the wrong type"
 const ErrorIndexOutOfRange = "index is out of the range of possible values"
+const ErrorInvariantViolation = "invariant violation"
+
+// Errors returned by the list operations on an empty list or a position
+// outside it, and by the container/list conversions for elements of the
+// wrong type. ErrInvariantViolation is wrapped by CheckInvariants.
+var (
+	ErrEmptyList          = errors.New(ErrorEmptyList)
+	ErrElementType        = errors.New(ErrorElementType)
+	ErrIndexOutOfRange    = errors.New(ErrorIndexOutOfRange)
+	ErrInvariantViolation = errors.New(ErrorInvariantViolation)
+)
 
 // Provides fundamental list operations without requiring element comparison.
 type BasicList[T any] interface {
@@ -25,12 +39,12 @@ type BasicList[T any] interface {
 	RemoveLast() bool
 
 	// Returns the first element in the list.
-	// Returns ErrorEmptyList if the list is empty.
+	// Returns ErrEmptyList if the list is empty.
 	// Time complexity depends on implementation.
 	First() (T, error)
 
 	// Returns the last element in the list.
-	// Returns ErrorEmptyList if the list is empty.
+	// Returns ErrEmptyList if the list is empty.
 	// Time complexity depends on implementation.
 	Last() (T, error)
 
@@ -47,26 +61,26 @@ type BasicList[T any] interface {
 type IndexedList[T any] interface {
 	// Inserts a value at the specified index.
 	// Valid indices are 0 to Size() inclusive (append at end).
-	// Returns ErrorIndexOutOfRange if index is invalid.
+	// Returns ErrIndexOutOfRange if index is invalid.
 	// Time complexity depends on implementation.
 	InsertAt(index int, value T) error
 
 	// Updates a value at the specified index.
 	// Valid indices are 0 to Size()-1.
 	// Returns the old value at the specified index.
-	// Returns ErrorIndexOutOfRange if index is invalid.
+	// Returns ErrIndexOutOfRange if index is invalid.
 	// Time complexity depends on implementation.
 	UpdateAt(index int, value T) (T, error)
 
 	// Removes the element at the specified index.
 	// Valid indices are 0 to Size()-1.
-	// Returns ErrorIndexOutOfRange if index is invalid.
+	// Returns ErrIndexOutOfRange if index is invalid.
 	// Time complexity depends on implementation.
 	RemoveAt(index int) error
 
 	// Returns the element at the specified index.
 	// Valid indices are 0 to Size()-1.
-	// Returns ErrorIndexOutOfRange if index is invalid.
+	// Returns ErrIndexOutOfRange if index is invalid.
 	// Time complexity depends on implementation.
 	GetAt(index int) (T, error)
 }
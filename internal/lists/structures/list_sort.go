@@ -0,0 +1,108 @@
+package structures
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ sort.Interface = &ListSorter[int]{}
+
+// ListSorter adapts a List to sort.Interface, so it can be passed to
+// sort.Sort, sort.Stable and the other functions of package sort.
+//
+// Less and Swap go through GetAt and UpdateAt, which take O(n) on linked
+// lists, so sorting a linked list this way takes O(n² log n). It suits
+// short lists and code written against sort.Interface; SortList sorts
+// any list in O(n log n).
+//
+// Example:
+//
+//	l := NewLinkedList(3, 1, 2)
+//	sort.Sort(NewListSorter(l, cmp.Less[int]))  // List is now [1, 2, 3]
+type ListSorter[T comparable] struct {
+	list List[T]
+	less func(a, b T) bool
+}
+
+// NewListSorter creates an adapter that orders the elements of the list
+// by less, which must be a strict weak ordering such as cmp.Less.
+func NewListSorter[T comparable](list List[T], less func(a, b T) bool) *ListSorter[T] {
+	return &ListSorter[T]{list: list, less: less}
+}
+
+// Len returns the number of elements in the list.
+func (s *ListSorter[T]) Len() int {
+	return s.list.Size()
+}
+
+// Less reports whether the element at index i orders before the element
+// at index j.
+func (s *ListSorter[T]) Less(i, j int) bool {
+	a, _ := s.list.GetAt(i)
+	b, _ := s.list.GetAt(j)
+	return s.less(a, b)
+}
+
+// Swap exchanges the elements at indices i and j.
+func (s *ListSorter[T]) Swap(i, j int) {
+	a, _ := s.list.GetAt(i)
+	b, _ := s.list.UpdateAt(j, a)
+	s.list.UpdateAt(i, b)
+}
+
+// SortList sorts the list in ascending order according to cmp, as
+// slices.SortFunc does. The sort is not stable.
+//
+// The elements are copied out, sorted with slices.SortFunc and added
+// back from first to last. Lists that implement collections.Iterable are
+// read through All, so linked lists sort in O(n log n) with O(n) extra
+// space.
+//
+// Time complexity: O(n log n) plus n RemoveFirst and AddLast operations
+//
+// Example:
+//
+//	l := NewLinkedList("b", "c", "a")
+//	SortList(l, strings.Compare)  // List is now ["a", "b", "c"]
+func SortList[T comparable](list List[T], cmp func(a, b T) int) {
+	values := listValues(list)
+	slices.SortFunc(values, cmp)
+	setListValues(list, values)
+}
+
+// SortListStable sorts the list like SortList, keeping the original
+// order of equal elements, as slices.SortStableFunc does.
+//
+// Time complexity: O(n log n) plus n RemoveFirst and AddLast operations
+func SortListStable[T comparable](list List[T], cmp func(a, b T) int) {
+	values := listValues(list)
+	slices.SortStableFunc(values, cmp)
+	setListValues(list, values)
+}
+
+// Returns a copy of the elements of the list, first to last.
+func listValues[T comparable](list List[T]) []T {
+	if it, ok := list.(collections.Iterable[T]); ok {
+		return slices.AppendSeq(make([]T, 0, list.Size()), it.All())
+	}
+
+	values := make([]T, list.Size())
+	for i := range values {
+		values[i], _ = list.GetAt(i)
+	}
+
+	return values
+}
+
+// Replaces the elements of the list with the values, first to last.
+func setListValues[T comparable](list List[T], values []T) {
+	for list.RemoveFirst() {
+	}
+
+	for _, v := range values {
+		list.AddLast(v)
+	}
+}
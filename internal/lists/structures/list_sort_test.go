@@ -0,0 +1,87 @@
+package structures
+
+/*
+Test Coverage
+=============
+ListSorter:
+  ✓ sort.Sort and sort.Stable through Len, Less and Swap
+  ✓ Empty list
+
+SortList/SortListStable:
+  ✓ Ascending order, empty and single-element lists
+  ✓ Stable sort keeps the order of equal elements
+  ✓ Lists without All are read by index
+*/
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Hides the All method of a list, so only the List interface is left
+type indexOnlyList[T comparable] struct {
+	List[T]
+}
+
+// Orders pairs by their first element only
+func comparePairKeys(a, b [2]int) int {
+	return cmp.Compare(a[0], b[0])
+}
+
+// Verifies sort.Sort and sort.Stable order a list through the adapter
+func TestListSorter(t *testing.T) {
+	l := NewLinkedList(5, 3, 8, 1, 9, 2)
+	s := NewListSorter[int](l, cmp.Less[int])
+	test.GotWant(t, s.Len(), 6)
+	test.GotWant(t, s.Less(0, 1), false)
+
+	sort.Sort(s)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 3, 5, 8, 9})
+
+	pairs := NewLinkedList([2]int{2, 0}, [2]int{1, 1}, [2]int{2, 2}, [2]int{1, 3})
+	sort.Stable(NewListSorter[[2]int](pairs, func(a, b [2]int) bool { return a[0] < b[0] }))
+	test.GotWantSlice(t, slices.Collect(pairs.All()), [][2]int{{1, 1}, {1, 3}, {2, 0}, {2, 2}})
+}
+
+// Verifies sorting an empty list through the adapter
+func TestListSorter_Empty(t *testing.T) {
+	l := NewLinkedList[int]()
+	sort.Sort(NewListSorter[int](l, cmp.Less[int]))
+	test.GotWant(t, l.IsEmpty(), true)
+}
+
+// Verifies SortList sorts in ascending order, including empty and
+// single-element lists
+func TestSortList(t *testing.T) {
+	l := NewLinkedList("d", "b", "a", "c", "b")
+	SortList[string](l, cmp.Compare[string])
+	test.GotWantSlice(t, slices.Collect(l.All()), []string{"a", "b", "b", "c", "d"})
+	test.GotWant(t, l.Size(), 5)
+	test.GotWantNoError(t, l.CheckInvariants())
+
+	empty := NewLinkedList[string]()
+	SortList[string](empty, cmp.Compare[string])
+	test.GotWant(t, empty.IsEmpty(), true)
+
+	single := NewLinkedList("a")
+	SortList[string](single, cmp.Compare[string])
+	test.GotWantSlice(t, slices.Collect(single.All()), []string{"a"})
+}
+
+// Verifies SortListStable keeps the order of equal elements
+func TestSortListStable(t *testing.T) {
+	l := NewLinkedList([2]int{2, 0}, [2]int{1, 1}, [2]int{2, 2}, [2]int{1, 3}, [2]int{0, 4})
+	SortListStable[[2]int](l, comparePairKeys)
+	test.GotWantSlice(t, slices.Collect(l.All()), [][2]int{{0, 4}, {1, 1}, {1, 3}, {2, 0}, {2, 2}})
+}
+
+// Verifies lists without All are read by index
+func TestSortList_IndexOnly(t *testing.T) {
+	l := NewLinkedList(3, 1, 2)
+	SortList[int](indexOnlyList[int]{l}, cmp.Compare[int])
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 3})
+}
@@ -0,0 +1,236 @@
+package structures
+
+import "fmt"
+
+// ListTxn records the mutations made to a list inside UpdateList, so they
+// can be undone if the update fails.
+//
+// Mutations are applied to the list right away and reads see them, so a
+// step can depend on the result of the previous ones. Every mutation
+// saves the inverse operation that restores the list; rollback runs them
+// in reverse order. A ListTxn must not be used after its UpdateList call
+// returns, and the list must not be modified other than through it while
+// the update runs.
+type ListTxn[T comparable] struct {
+	list List[T]
+	undo []func()
+}
+
+// UpdateList runs fn with a transaction over the list. If fn returns an
+// error or panics, every mutation made through the transaction is undone
+// in reverse order, leaving the list with the elements and order it had
+// before, and the error is returned or the panic continues.
+//
+// Time complexity: That of the mutations, plus that of their inverses on
+// rollback
+//
+// Example:
+//
+//	l := NewLinkedList(1, 2, 3)
+//	err := UpdateList(l, func(tx *ListTxn[int]) error {
+//	    tx.AddLast(4)
+//	    return tx.RemoveAt(10)  // Fails, so AddLast(4) is undone
+//	})
+//	// err wraps ErrIndexOutOfRange, l is [1, 2, 3]
+func UpdateList[T comparable](list List[T], fn func(tx *ListTxn[T]) error) error {
+	tx := &ListTxn[T]{list: list}
+	committed := false
+	defer func() {
+		if !committed {
+			tx.rollback()
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	committed = true
+	return nil
+}
+
+// AddFirst prepends a value to the list.
+func (tx *ListTxn[T]) AddFirst(value T) {
+	tx.list.AddFirst(value)
+	tx.undo = append(tx.undo, func() { tx.list.RemoveFirst() })
+}
+
+// AddLast appends a value to the list.
+func (tx *ListTxn[T]) AddLast(value T) {
+	tx.list.AddLast(value)
+	tx.undo = append(tx.undo, func() { tx.list.RemoveLast() })
+}
+
+// RemoveFirst removes the first element of the list.
+// Returns ErrEmptyList if the list is empty.
+func (tx *ListTxn[T]) RemoveFirst() error {
+	v, err := tx.list.First()
+	if err != nil {
+		return err
+	}
+
+	tx.list.RemoveFirst()
+	tx.undo = append(tx.undo, func() { tx.list.AddFirst(v) })
+	return nil
+}
+
+// RemoveLast removes the last element of the list.
+// Returns ErrEmptyList if the list is empty.
+func (tx *ListTxn[T]) RemoveLast() error {
+	v, err := tx.list.Last()
+	if err != nil {
+		return err
+	}
+
+	tx.list.RemoveLast()
+	tx.undo = append(tx.undo, func() { tx.list.AddLast(v) })
+	return nil
+}
+
+// InsertAt inserts a value at the specified index.
+// Returns ErrIndexOutOfRange if index is invalid.
+func (tx *ListTxn[T]) InsertAt(index int, value T) error {
+	if err := tx.list.InsertAt(index, value); err != nil {
+		return err
+	}
+
+	tx.undo = append(tx.undo, func() { tx.list.RemoveAt(index) })
+	return nil
+}
+
+// UpdateAt updates a value at the specified index and returns the old
+// value. Returns ErrIndexOutOfRange if index is invalid.
+func (tx *ListTxn[T]) UpdateAt(index int, value T) (T, error) {
+	old, err := tx.list.UpdateAt(index, value)
+	if err != nil {
+		return old, err
+	}
+
+	tx.undo = append(tx.undo, func() { tx.list.UpdateAt(index, old) })
+	return old, nil
+}
+
+// RemoveAt removes the element at the specified index.
+// Returns ErrIndexOutOfRange if index is invalid.
+func (tx *ListTxn[T]) RemoveAt(index int) error {
+	v, err := tx.list.GetAt(index)
+	if err != nil {
+		return err
+	}
+
+	tx.list.RemoveAt(index)
+	tx.undo = append(tx.undo, func() { tx.list.InsertAt(index, v) })
+	return nil
+}
+
+// GetAt returns the element at the specified index, including the
+// changes made by the transaction.
+// Returns ErrIndexOutOfRange if index is invalid.
+func (tx *ListTxn[T]) GetAt(index int) (T, error) {
+	return tx.list.GetAt(index)
+}
+
+// Size returns the number of elements in the list, including the changes
+// made by the transaction.
+func (tx *ListTxn[T]) Size() int {
+	return tx.list.Size()
+}
+
+// Undoes the recorded mutations, newest first.
+func (tx *ListTxn[T]) rollback() {
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		tx.undo[i]()
+	}
+
+	tx.undo = nil
+}
+
+// ListBatch records a sequence of list mutations to apply later, all or
+// none.
+//
+// The zero value is an empty batch ready to use. A batch holds no
+// reference to a list and can be applied to several.
+//
+// Example:
+//
+//	var b ListBatch[string]
+//	b.AddLast("c")
+//	b.RemoveAt(0)
+//	err := b.Apply(l)  // Either both steps or neither
+type ListBatch[T comparable] struct {
+	ops []func(tx *ListTxn[T]) error
+}
+
+// AddFirst records prepending a value.
+func (b *ListBatch[T]) AddFirst(value T) {
+	b.ops = append(b.ops, func(tx *ListTxn[T]) error {
+		tx.AddFirst(value)
+		return nil
+	})
+}
+
+// AddLast records appending a value.
+func (b *ListBatch[T]) AddLast(value T) {
+	b.ops = append(b.ops, func(tx *ListTxn[T]) error {
+		tx.AddLast(value)
+		return nil
+	})
+}
+
+// RemoveFirst records removing the first element.
+func (b *ListBatch[T]) RemoveFirst() {
+	b.ops = append(b.ops, (*ListTxn[T]).RemoveFirst)
+}
+
+// RemoveLast records removing the last element.
+func (b *ListBatch[T]) RemoveLast() {
+	b.ops = append(b.ops, (*ListTxn[T]).RemoveLast)
+}
+
+// InsertAt records inserting a value at the index.
+func (b *ListBatch[T]) InsertAt(index int, value T) {
+	b.ops = append(b.ops, func(tx *ListTxn[T]) error {
+		return tx.InsertAt(index, value)
+	})
+}
+
+// UpdateAt records updating the value at the index.
+func (b *ListBatch[T]) UpdateAt(index int, value T) {
+	b.ops = append(b.ops, func(tx *ListTxn[T]) error {
+		_, err := tx.UpdateAt(index, value)
+		return err
+	})
+}
+
+// RemoveAt records removing the element at the index.
+func (b *ListBatch[T]) RemoveAt(index int) {
+	b.ops = append(b.ops, func(tx *ListTxn[T]) error {
+		return tx.RemoveAt(index)
+	})
+}
+
+// Len returns the number of recorded mutations.
+func (b *ListBatch[T]) Len() int {
+	return len(b.ops)
+}
+
+// Apply applies the recorded mutations to the list in order. Indices
+// refer to the list as left by the mutations before them. If a mutation
+// fails, the ones already applied are undone and the list is left as it
+// was.
+// Returns the error of the failed mutation, wrapped with its position in
+// the batch.
+//
+// Time complexity: That of the mutations, plus that of their inverses on
+// failure
+func (b *ListBatch[T]) Apply(list List[T]) error {
+	return UpdateList(list, func(tx *ListTxn[T]) error {
+		for i, op := range b.ops {
+			if err := op(tx); err != nil {
+				return fmt.Errorf("%w: batch operation %d", err, i)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,143 @@
+package structures
+
+/*
+Test Coverage
+=============
+UpdateList:
+  ✓ Successful update keeps every mutation
+  ✓ Error undoes every mutation, order restored
+  ✓ Panic undoes every mutation and continues
+  ✓ Failed mutations are not recorded
+
+ListBatch:
+  ✓ Zero value applies nothing
+  ✓ Mutations applied in order, batch reusable
+  ✓ Failure rolls back, error names the operation
+*/
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies a successful update keeps every mutation
+func TestUpdateList_Commit(t *testing.T) {
+	l := NewLinkedList(1, 2, 3)
+	err := UpdateList(l, func(tx *ListTxn[int]) error {
+		tx.AddFirst(0)
+		tx.AddLast(4)
+		if _, err := tx.UpdateAt(2, 20); err != nil {
+			return err
+		}
+
+		v, _ := tx.GetAt(2)
+		test.GotWant(t, v, 20)
+		test.GotWant(t, tx.Size(), 5)
+		return tx.RemoveAt(3)
+	})
+
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{0, 1, 20, 4})
+}
+
+// Verifies an error undoes every kind of mutation and restores the order
+func TestUpdateList_Rollback(t *testing.T) {
+	l := NewLinkedList(1, 2, 3, 4)
+	errStop := errors.New("stop")
+	err := UpdateList(l, func(tx *ListTxn[int]) error {
+		tx.AddFirst(0)
+		tx.AddLast(5)
+		tx.InsertAt(3, 25)
+		tx.UpdateAt(1, 10)
+		tx.RemoveAt(4)
+		tx.RemoveFirst()
+		tx.RemoveLast()
+		return errStop
+	})
+
+	test.GotWantErrorIs(t, err, errStop)
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 3, 4})
+	test.GotWantNoError(t, l.CheckInvariants())
+}
+
+// Verifies a panic undoes every mutation and continues
+func TestUpdateList_Panic(t *testing.T) {
+	l := NewLinkedList(1, 2)
+	test.GotWantPanic(t, func() {
+		UpdateList(l, func(tx *ListTxn[int]) error {
+			tx.AddLast(3)
+			tx.RemoveFirst()
+			panic("boom")
+		})
+	}, "boom")
+
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2})
+}
+
+// Verifies failed mutations report their error and are not undone
+func TestUpdateList_FailedMutations(t *testing.T) {
+	l := NewLinkedList[int]()
+	err := UpdateList(l, func(tx *ListTxn[int]) error {
+		test.GotWantErrorIs(t, tx.RemoveFirst(), ErrEmptyList)
+		test.GotWantErrorIs(t, tx.RemoveLast(), ErrEmptyList)
+		test.GotWantErrorIs(t, tx.RemoveAt(0), ErrIndexOutOfRange)
+		test.GotWantErrorIs(t, tx.InsertAt(1, 1), ErrIndexOutOfRange)
+		_, err := tx.UpdateAt(0, 1)
+		test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
+		test.GotWant(t, len(tx.undo), 0)
+
+		tx.AddLast(1)
+		return errors.New("stop")
+	})
+
+	test.GotWantError(t, err, "stop")
+	test.GotWant(t, l.IsEmpty(), true)
+}
+
+// Verifies an empty batch applies nothing
+func TestListBatch_Empty(t *testing.T) {
+	var b ListBatch[int]
+	l := NewLinkedList(1)
+	test.GotWant(t, b.Len(), 0)
+	test.GotWantNoError(t, b.Apply(l))
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1})
+}
+
+// Verifies a batch applies its mutations in order and can be reused
+func TestListBatch_Apply(t *testing.T) {
+	var b ListBatch[string]
+	b.AddLast("d")
+	b.AddFirst("z")
+	b.RemoveFirst()
+	b.InsertAt(1, "x")
+	b.UpdateAt(0, "A")
+	b.RemoveAt(2)
+	b.RemoveLast()
+	test.GotWant(t, b.Len(), 7)
+
+	l := NewLinkedList("a", "b", "c")
+	test.GotWantNoError(t, b.Apply(l))
+	test.GotWantSlice(t, slices.Collect(l.All()), []string{"A", "x", "c"})
+
+	other := NewLinkedList("p", "q", "r", "s")
+	test.GotWantNoError(t, b.Apply(other))
+	test.GotWantSlice(t, slices.Collect(other.All()), []string{"A", "x", "r", "s"})
+}
+
+// Verifies a failing batch leaves the list unchanged and names the
+// failed operation
+func TestListBatch_Rollback(t *testing.T) {
+	var b ListBatch[int]
+	b.AddLast(4)
+	b.RemoveAt(0)
+	b.UpdateAt(5, 0)
+
+	l := NewLinkedList(1, 2, 3)
+	err := b.Apply(l)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
+	test.GotWantError(t, err, "index is out of the range of possible values: batch operation 2")
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 3})
+}
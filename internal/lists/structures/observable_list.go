@@ -0,0 +1,264 @@
+package structures
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ List[int] = &ObservableList[int]{}
+var _ collections.Iterable[int] = &ObservableList[int]{}
+
+// ListHooks holds optional callbacks that ObservableList invokes after
+// each mutation, with the index the element had in the list when it was
+// added, updated or removed. Nil callbacks are skipped.
+//
+// Callbacks run synchronously inside the operation that caused the event,
+// after the list has changed, so they should be cheap and must not modify
+// the list.
+//
+// Example:
+//
+//	l.SetHooks(ListHooks[string]{
+//	    OnAdd:    func(index int, value string) { view.Insert(index, value) },
+//	    OnRemove: func(index int, value string) { view.Delete(index) },
+//	})
+type ListHooks[T any] struct {
+	OnAdd    func(index int, value T)           // A value was added at index
+	OnUpdate func(index int, oldValue, value T) // The value at index was replaced
+	OnRemove func(index int, value T)           // The value at index was removed
+}
+
+// ObservableList wraps any List and reports its mutations to ListHooks.
+//
+// Every mutation goes through the wrapper: changes made to the wrapped
+// list directly are not reported. Reads are passed through unchanged.
+//
+// Design decisions:
+//   - Wrapper over the List interface: Works with every implementation,
+//     adds nothing to lists that are not observed
+//   - Index-based events: Enough to replay the changes on a copy of the
+//     list, such as a bound view
+//   - Lookup before removals: Reports removed values, at the cost of one
+//     extra First, Last or GetAt per removal; Remove and Update find the
+//     index first and then mutate by index
+//
+// Space complexity: O(1) on top of the wrapped list.
+type ObservableList[T comparable] struct {
+	list  List[T]
+	hooks ListHooks[T]
+}
+
+// NewObservableList wraps the list with no hooks installed; see SetHooks.
+//
+// Example:
+//
+//	l := NewObservableList[int](NewLinkedList[int]())
+//	l.SetHooks(ListHooks[int]{
+//	    OnAdd: func(index, value int) { log.Printf("added %d at %d", value, index) },
+//	})
+//	l.AddLast(5)  // Logs "added 5 at 0"
+func NewObservableList[T comparable](list List[T]) *ObservableList[T] {
+	return &ObservableList[T]{list: list}
+}
+
+// SetHooks installs the callbacks for the list's mutations, replacing any
+// installed before.
+func (o *ObservableList[T]) SetHooks(hooks ListHooks[T]) {
+	o.hooks = hooks
+}
+
+// Unwrap returns the wrapped list.
+func (o *ObservableList[T]) Unwrap() List[T] {
+	return o.list
+}
+
+// AddFirst prepends a value to the list and reports it at index 0.
+func (o *ObservableList[T]) AddFirst(value T) {
+	o.list.AddFirst(value)
+	o.added(0, value)
+}
+
+// AddLast appends a value to the list and reports it at the last index.
+func (o *ObservableList[T]) AddLast(value T) {
+	o.list.AddLast(value)
+	o.added(o.list.Size()-1, value)
+}
+
+// RemoveFirst removes the first element of the list and reports it at
+// index 0.
+// Returns false if the list is empty.
+func (o *ObservableList[T]) RemoveFirst() bool {
+	value, err := o.list.First()
+	if err != nil || !o.list.RemoveFirst() {
+		return false
+	}
+
+	o.removed(0, value)
+	return true
+}
+
+// RemoveLast removes the last element of the list and reports it at the
+// index it had.
+// Returns false if the list is empty.
+func (o *ObservableList[T]) RemoveLast() bool {
+	value, err := o.list.Last()
+	if err != nil {
+		return false
+	}
+
+	index := o.list.Size() - 1
+	if !o.list.RemoveLast() {
+		return false
+	}
+
+	o.removed(index, value)
+	return true
+}
+
+// First returns the first element in the list.
+// Returns ErrEmptyList if the list is empty.
+func (o *ObservableList[T]) First() (T, error) {
+	return o.list.First()
+}
+
+// Last returns the last element in the list.
+// Returns ErrEmptyList if the list is empty.
+func (o *ObservableList[T]) Last() (T, error) {
+	return o.list.Last()
+}
+
+// IsEmpty returns true if the list contains no elements.
+func (o *ObservableList[T]) IsEmpty() bool {
+	return o.list.IsEmpty()
+}
+
+// Size returns the number of elements in the list.
+func (o *ObservableList[T]) Size() int {
+	return o.list.Size()
+}
+
+// InsertAt inserts a value at the specified index and reports it.
+// Returns ErrIndexOutOfRange if index is invalid.
+func (o *ObservableList[T]) InsertAt(index int, value T) error {
+	if err := o.list.InsertAt(index, value); err != nil {
+		return err
+	}
+
+	o.added(index, value)
+	return nil
+}
+
+// UpdateAt updates a value at the specified index, reports it and
+// returns the old value.
+// Returns ErrIndexOutOfRange if index is invalid.
+func (o *ObservableList[T]) UpdateAt(index int, value T) (T, error) {
+	old, err := o.list.UpdateAt(index, value)
+	if err != nil {
+		return old, err
+	}
+
+	o.updated(index, old, value)
+	return old, nil
+}
+
+// RemoveAt removes the element at the specified index and reports it.
+// Returns ErrIndexOutOfRange if index is invalid.
+func (o *ObservableList[T]) RemoveAt(index int) error {
+	value, err := o.list.GetAt(index)
+	if err != nil {
+		return err
+	}
+
+	if err := o.list.RemoveAt(index); err != nil {
+		return err
+	}
+
+	o.removed(index, value)
+	return nil
+}
+
+// GetAt returns the element at the specified index.
+// Returns ErrIndexOutOfRange if index is invalid.
+func (o *ObservableList[T]) GetAt(index int) (T, error) {
+	return o.list.GetAt(index)
+}
+
+// IndexOf returns the index of the first occurrence of the value.
+// Returns -1 if the value is not found.
+func (o *ObservableList[T]) IndexOf(value T) int {
+	return o.list.IndexOf(value)
+}
+
+// Contains returns true if the list contains the value.
+func (o *ObservableList[T]) Contains(value T) bool {
+	return o.list.Contains(value)
+}
+
+// Remove removes the first occurrence of the value and reports it.
+// Returns true if the value was found and removed, false otherwise.
+//
+// Time complexity: That of IndexOf and RemoveAt on the wrapped list
+func (o *ObservableList[T]) Remove(value T) bool {
+	index := o.list.IndexOf(value)
+	if index < 0 {
+		return false
+	}
+
+	return o.RemoveAt(index) == nil
+}
+
+// Update replaces the first occurrence of oldValue with newValue and
+// reports it.
+// Returns true if the value was found and updated, false otherwise.
+//
+// Time complexity: That of IndexOf and UpdateAt on the wrapped list
+func (o *ObservableList[T]) Update(oldValue T, newValue T) bool {
+	index := o.list.IndexOf(oldValue)
+	if index < 0 {
+		return false
+	}
+
+	_, err := o.UpdateAt(index, newValue)
+	return err == nil
+}
+
+// All returns an iterator over the elements from first to last, through
+// the iterator of the wrapped list if it has one, otherwise through GetAt.
+// The list must not be modified during iteration.
+func (o *ObservableList[T]) All() iter.Seq[T] {
+	if it, ok := o.list.(collections.Iterable[T]); ok {
+		return it.All()
+	}
+
+	return func(yield func(T) bool) {
+		for i := range o.list.Size() {
+			v, _ := o.list.GetAt(i)
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Reports an added value to OnAdd.
+func (o *ObservableList[T]) added(index int, value T) {
+	if o.hooks.OnAdd != nil {
+		o.hooks.OnAdd(index, value)
+	}
+}
+
+// Reports a replaced value to OnUpdate.
+func (o *ObservableList[T]) updated(index int, old, value T) {
+	if o.hooks.OnUpdate != nil {
+		o.hooks.OnUpdate(index, old, value)
+	}
+}
+
+// Reports a removed value to OnRemove.
+func (o *ObservableList[T]) removed(index int, value T) {
+	if o.hooks.OnRemove != nil {
+		o.hooks.OnRemove(index, value)
+	}
+}
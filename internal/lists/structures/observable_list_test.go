@@ -0,0 +1,229 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewObservableList):
+  ✓ No hooks installed, mutations still applied
+
+AddFirst/AddLast/InsertAt:
+  ✓ OnAdd with the index of the new element
+  ✓ Invalid index not reported
+
+RemoveFirst/RemoveLast/RemoveAt/Remove:
+  ✓ OnRemove with the index and value the element had
+  ✓ Empty list, invalid index and absent value not reported
+
+UpdateAt/Update:
+  ✓ OnUpdate with the old and new value
+  ✓ Invalid index and absent value not reported
+
+Hooks:
+  ✓ Nil callbacks skipped
+  ✓ Replaying the events on a slice reproduces the list
+
+Reads:
+  ✓ First, Last, GetAt, IndexOf, Contains, Size, IsEmpty, Unwrap
+  ✓ All through the wrapped iterator or GetAt, early termination
+*/
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Hides every method of a list but those of List.
+type plainList[T comparable] struct {
+	List[T]
+}
+
+// Records the events reported by the hooks of an ObservableList.
+func recordListEvents[T comparable](l *ObservableList[T]) *[]string {
+	var events []string
+	l.SetHooks(ListHooks[T]{
+		OnAdd: func(index int, value T) {
+			events = append(events, fmt.Sprintf("add %d=%v", index, value))
+		},
+		OnUpdate: func(index int, oldValue, value T) {
+			events = append(events, fmt.Sprintf("update %d=%v->%v", index, oldValue, value))
+		},
+		OnRemove: func(index int, value T) {
+			events = append(events, fmt.Sprintf("remove %d=%v", index, value))
+		},
+	})
+
+	return &events
+}
+
+// Verifies a new observable list has no hooks and applies mutations
+func TestObservableList_NewObservableList(t *testing.T) {
+	inner := NewLinkedList[int]()
+	l := NewObservableList[int](inner)
+	l.AddLast(2)
+	l.AddFirst(1)
+	test.GotWant(t, l.Update(2, 3), true)
+	test.GotWant(t, l.RemoveFirst(), true)
+	test.GotWantSlice(t, slices.Collect(inner.All()), []int{3})
+}
+
+// Verifies additions report the index of the new element
+func TestObservableList_Add(t *testing.T) {
+	l := NewObservableList[string](NewLinkedList[string]())
+	events := recordListEvents(l)
+
+	l.AddLast("b")
+	l.AddFirst("a")
+	l.AddLast("d")
+	test.GotWantNoError(t, l.InsertAt(2, "c"))
+	test.GotWantErrorIs(t, l.InsertAt(9, "x"), ErrIndexOutOfRange)
+
+	test.GotWantSlice(t, slices.Collect(l.All()), []string{"a", "b", "c", "d"})
+	test.GotWantSlice(t, *events, []string{"add 0=b", "add 0=a", "add 2=d", "add 2=c"})
+}
+
+// Verifies removals report the index and value the element had
+func TestObservableList_Remove(t *testing.T) {
+	l := NewObservableList[string](NewLinkedList("a", "b", "c", "d", "e"))
+	events := recordListEvents(l)
+
+	test.GotWant(t, l.RemoveLast(), true)
+	test.GotWant(t, l.RemoveFirst(), true)
+	test.GotWantNoError(t, l.RemoveAt(1))
+	test.GotWant(t, l.Remove("d"), true)
+
+	test.GotWantSlice(t, slices.Collect(l.All()), []string{"b"})
+	test.GotWantSlice(t, *events, []string{"remove 4=e", "remove 0=a", "remove 1=c", "remove 1=d"})
+}
+
+// Verifies failed removals are not reported
+func TestObservableList_Remove_Failed(t *testing.T) {
+	l := NewObservableList[string](NewLinkedList[string]())
+	events := recordListEvents(l)
+
+	test.GotWant(t, l.RemoveFirst(), false)
+	test.GotWant(t, l.RemoveLast(), false)
+	l.AddLast("a")
+	test.GotWantErrorIs(t, l.RemoveAt(1), ErrIndexOutOfRange)
+	test.GotWant(t, l.Remove("b"), false)
+
+	test.GotWantSlice(t, *events, []string{"add 0=a"})
+}
+
+// Verifies updates report the old and new value
+func TestObservableList_Update(t *testing.T) {
+	l := NewObservableList[string](NewLinkedList("a", "b", "b"))
+	events := recordListEvents(l)
+
+	old, err := l.UpdateAt(0, "x")
+	test.GotWantNoError(t, err)
+	test.GotWant(t, old, "a")
+	test.GotWant(t, l.Update("b", "y"), true)
+	test.GotWant(t, l.Update("z", "y"), false)
+	_, err = l.UpdateAt(3, "x")
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
+
+	test.GotWantSlice(t, slices.Collect(l.All()), []string{"x", "y", "b"})
+	test.GotWantSlice(t, *events, []string{"update 0=a->x", "update 1=b->y"})
+}
+
+// Verifies nil callbacks are skipped
+func TestObservableList_NilHooks(t *testing.T) {
+	l := NewObservableList[int](NewLinkedList[int]())
+	var updated []int
+	l.SetHooks(ListHooks[int]{
+		OnUpdate: func(index, oldValue, value int) { updated = append(updated, value) },
+	})
+
+	l.AddLast(1)
+	l.UpdateAt(0, 2)
+	l.RemoveLast()
+	test.GotWantSlice(t, updated, []int{2})
+}
+
+// Verifies replaying the reported events on a slice reproduces the list
+// after random mutations
+func TestObservableList_Replay(t *testing.T) {
+	l := NewObservableList[int](NewLinkedList[int]())
+	var replica []int
+	l.SetHooks(ListHooks[int]{
+		OnAdd:    func(index, value int) { replica = slices.Insert(replica, index, value) },
+		OnUpdate: func(index, oldValue, value int) { replica[index] = value },
+		OnRemove: func(index, value int) { replica = slices.Delete(replica, index, index+1) },
+	})
+
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 1000 {
+		size := l.Size()
+		switch r.IntN(8) {
+		case 0:
+			l.AddFirst(r.IntN(10))
+		case 1:
+			l.AddLast(r.IntN(10))
+		case 2:
+			l.InsertAt(r.IntN(size+1), r.IntN(10))
+		case 3:
+			l.RemoveFirst()
+		case 4:
+			l.RemoveLast()
+		case 5:
+			l.Remove(r.IntN(10))
+		case 6:
+			l.Update(r.IntN(10), r.IntN(10))
+		case 7:
+			if size > 0 {
+				l.RemoveAt(r.IntN(size))
+			}
+		}
+
+		test.GotWantSlice(t, replica, slices.Collect(l.All()))
+	}
+}
+
+// Verifies reads pass through to the wrapped list without reporting events
+func TestObservableList_Reads(t *testing.T) {
+	inner := NewLinkedList[int]()
+	l := NewObservableList[int](inner)
+	test.GotWant(t, l.IsEmpty(), true)
+	_, err := l.First()
+	test.GotWantErrorIs(t, err, ErrEmptyList)
+
+	l.AddLast(1)
+	l.AddLast(2)
+	l.AddLast(3)
+	events := recordListEvents(l)
+
+	v, _ := l.First()
+	test.GotWant(t, v, 1)
+	v, _ = l.Last()
+	test.GotWant(t, v, 3)
+	v, err = l.GetAt(1)
+	test.GotWantNoError(t, err)
+	test.GotWant(t, v, 2)
+	test.GotWant(t, l.IndexOf(3), 2)
+	test.GotWant(t, l.Contains(4), false)
+	test.GotWant(t, l.Size(), 3)
+	test.GotWant(t, l.IsEmpty(), false)
+	test.GotWant(t, l.Unwrap(), List[int](inner))
+	test.GotWant(t, len(*events), 0)
+}
+
+// Verifies All iterates through GetAt when the wrapped list has no
+// iterator, and stops early
+func TestObservableList_All_GetAt(t *testing.T) {
+	l := NewObservableList[int](plainList[int]{NewLinkedList(1, 2, 3)})
+	test.GotWantSlice(t, slices.Collect(l.All()), []int{1, 2, 3})
+
+	var got []int
+	for v := range l.All() {
+		got = append(got, v)
+		if v == 2 {
+			break
+		}
+	}
+
+	test.GotWantSlice(t, got, []int{1, 2})
+}
@@ -0,0 +1,177 @@
+package structures
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Compile-time interface verifications
+var _ Map[int, int] = &BoundedMap[int, int]{}
+
+// BoundedMap wraps any Map and keeps it within a capacity, asking an
+// EvictionPolicy which key to evict when a new key does not fit.
+//
+// The wrapper reports every Put, Get and Delete to the policy, so the
+// same map can serve as an LRU, LFU, FIFO or random-replacement cache by
+// changing only the policy. Peek, Contains, All, Size and IsEmpty are not
+// reported as uses.
+//
+// Design decisions:
+//   - Wrapper over the Map interface: The storage and the replacement
+//     strategy are chosen independently
+//   - Evict before insert: The map never holds more than capacity pairs,
+//     not even for the duration of a Put
+//   - Policy over keys only: Values stay in the wrapped map, the policy
+//     tracks nothing but keys and their use
+//
+// Every mutation must go through the wrapper. Keys the wrapped map drops
+// on its own, such as expired ExpiringCache entries, stay tracked until
+// the policy chooses them, and are then discarded without counting as an
+// eviction. BoundedMap is not safe for concurrent use.
+//
+// Space complexity: O(n) on top of the wrapped map, for the policy.
+type BoundedMap[K comparable, V any] struct {
+	m        Map[K, V]
+	capacity int
+	policy   collections.EvictionPolicy[K]
+	onEvict  func(key K, value V)
+}
+
+// NewBoundedMap wraps the map with the capacity and eviction policy. The
+// keys already in the map are added to the policy in iteration order, and
+// evicted down to the capacity if there are more.
+//
+// Panics if capacity is not positive.
+//
+// Example:
+//
+//	// LRU cache of 100 entries
+//	c := NewBoundedMap(NewHashMap[string, []byte](), 100, collections.NewLRUPolicy[string]())
+func NewBoundedMap[K comparable, V any](m Map[K, V], capacity int, policy collections.EvictionPolicy[K]) *BoundedMap[K, V] {
+	panics.RequireGreaterThan(capacity, 0, "capacity")
+
+	b := &BoundedMap[K, V]{m: m, capacity: capacity, policy: policy}
+	for key := range m.All() {
+		policy.Add(key)
+	}
+
+	b.makeRoom(0)
+	return b
+}
+
+// SetOnEvict installs a callback invoked for every pair evicted to make
+// room, replacing any installed before. Pairs removed by Delete or
+// replaced by Put are not reported. A nil callback removes it.
+//
+// The callback runs synchronously inside Put, after the pair has left the
+// map, so it should be cheap and must not modify the map.
+//
+// Example:
+//
+//	c.SetOnEvict(func(key string, value []byte) { disk.Write(key, value) })
+func (b *BoundedMap[K, V]) SetOnEvict(onEvict func(key K, value V)) {
+	b.onEvict = onEvict
+}
+
+// Unwrap returns the wrapped map.
+func (b *BoundedMap[K, V]) Unwrap() Map[K, V] {
+	return b.m
+}
+
+// Capacity returns the maximum number of pairs the map holds.
+func (b *BoundedMap[K, V]) Capacity() int {
+	return b.capacity
+}
+
+// Put associates the value with the key and reports the use to the
+// policy. If the key is new and the map is full, the key chosen by the
+// policy is evicted first.
+// Returns true if the key was added, false if an existing value was
+// replaced.
+//
+// Time complexity: That of Put on the wrapped map and the policy, plus
+// a Get and Delete per eviction
+func (b *BoundedMap[K, V]) Put(key K, value V) bool {
+	if b.m.Contains(key) {
+		b.m.Put(key, value)
+		b.policy.Access(key)
+		return false
+	}
+
+	b.makeRoom(1)
+	b.m.Put(key, value)
+	b.policy.Add(key)
+	return true
+}
+
+// Get returns the value associated with the key and reports the use to
+// the policy.
+// Returns false if the key is not present.
+func (b *BoundedMap[K, V]) Get(key K) (V, bool) {
+	value, ok := b.m.Get(key)
+	if ok {
+		b.policy.Access(key)
+	}
+
+	return value, ok
+}
+
+// Peek returns the value associated with the key without counting as a
+// use, and without side effects on a wrapped map that has a Peek method
+// itself, such as an access-order LinkedHashMap.
+// Returns false if the key is not present.
+func (b *BoundedMap[K, V]) Peek(key K) (V, bool) {
+	return peek(b.m, key)
+}
+
+// Contains returns true if the key is present, without counting as a use.
+func (b *BoundedMap[K, V]) Contains(key K) bool {
+	return b.m.Contains(key)
+}
+
+// Delete removes the key and its value, and stops the policy from
+// tracking it.
+// Returns true if the key was found and removed, false otherwise.
+func (b *BoundedMap[K, V]) Delete(key K) bool {
+	b.policy.Remove(key)
+	return b.m.Delete(key)
+}
+
+// All returns an iterator over all key-value pairs in the order of the
+// wrapped map, without counting as uses.
+func (b *BoundedMap[K, V]) All() iter.Seq2[K, V] {
+	return b.m.All()
+}
+
+// IsEmpty returns true if the map contains no pairs.
+func (b *BoundedMap[K, V]) IsEmpty() bool {
+	return b.m.IsEmpty()
+}
+
+// Size returns the number of pairs currently in the map.
+func (b *BoundedMap[K, V]) Size() int {
+	return b.m.Size()
+}
+
+// Evicts the keys chosen by the policy until the map has room for extra
+// more pairs.
+func (b *BoundedMap[K, V]) makeRoom(extra int) {
+	for b.m.Size()+extra > b.capacity {
+		key, ok := b.policy.Evict()
+		if !ok {
+			return
+		}
+
+		value, found := b.m.Get(key)
+		if !found {
+			continue // Dropped by the wrapped map
+		}
+
+		b.m.Delete(key)
+		if b.onEvict != nil {
+			b.onEvict(key, value)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewBoundedMap):
+  ✓ Existing keys tracked, evicted down to capacity
+  ✓ Non-positive capacity (panic)
+
+Put/Get/Delete:
+  ✓ LRU: Get and replacing Put protect keys, Contains does not
+  ✓ Peek is not a use and keeps an access-order map in place
+  ✓ LFU and FIFO policies change the victim
+  ✓ Deleted keys no longer tracked
+  ✓ Capacity never exceeded, eviction callback with the evicted pair
+
+Caches:
+  ✓ Keys expired in an ExpiringCache are discarded, not reported
+*/
+
+import (
+	"slices"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the keys of the map, sorted.
+func boundedKeys[V any](m *BoundedMap[string, V]) []string {
+	keys := make([]string, 0, m.Size())
+	for key := range m.All() {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+	return keys
+}
+
+// Verifies the keys of the wrapped map are tracked and evicted down to
+// the capacity in iteration order
+func TestBoundedMap_NewBoundedMap(t *testing.T) {
+	inner := NewLinkedHashMap[string, int]()
+	inner.Put("a", 1)
+	inner.Put("b", 2)
+	inner.Put("c", 3)
+
+	m := NewBoundedMap[string, int](inner, 2, collections.NewFIFOPolicy[string]())
+	test.GotWant(t, m.Capacity(), 2)
+	test.GotWant(t, m.Unwrap(), Map[string, int](inner))
+	test.GotWantSlice(t, boundedKeys(m), []string{"b", "c"})
+
+	m.Put("d", 4)
+	test.GotWantSlice(t, boundedKeys(m), []string{"c", "d"})
+}
+
+// Verifies a non-positive capacity panics
+func TestBoundedMap_NewBoundedMap_Invalid(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewBoundedMap[string, int](NewHashMap[string, int](), 0, collections.NewLRUPolicy[string]())
+	}, `"capacity" must be > 0, got 0`)
+}
+
+// Verifies an LRU map keeps the keys used by Get or a replacing Put, and
+// that Contains is not a use
+func TestBoundedMap_LRU(t *testing.T) {
+	m := NewBoundedMap[string, int](NewHashMap[string, int](), 3, collections.NewLRUPolicy[string]())
+	test.GotWant(t, m.Put("a", 1), true)
+	test.GotWant(t, m.Put("b", 2), true)
+	test.GotWant(t, m.Put("c", 3), true)
+
+	v, ok := m.Get("a")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 1)
+	test.GotWant(t, m.Put("b", 20), false)
+	test.GotWant(t, m.Contains("c"), true)
+
+	test.GotWant(t, m.Put("d", 4), true)
+	test.GotWantSlice(t, boundedKeys(m), []string{"a", "b", "d"})
+	test.GotWant(t, m.Size(), 3)
+}
+
+// Verifies Peek is not reported as a use and does not reorder a wrapped
+// access-order map
+func TestBoundedMap_Peek(t *testing.T) {
+	inner := NewLinkedHashMapWith[string, int](WithAccessOrder(true))
+	m := NewBoundedMap[string, int](inner, 2, collections.NewLRUPolicy[string]())
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	v, ok := m.Peek("a")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 1)
+	_, ok = m.Peek("c")
+	test.GotWant(t, ok, false)
+	test.GotWantSlice(t, slices.Collect(linkedHashKeys(inner)), []string{"a", "b"})
+
+	m.Put("c", 3)
+	test.GotWant(t, m.Contains("a"), false)
+}
+
+// Verifies the policy decides the victim
+func TestBoundedMap_Policies(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy collections.EvictionPolicy[string]
+		want   []string
+	}{
+		{"FIFO", collections.NewFIFOPolicy[string](), []string{"b", "c", "d"}},
+		{"LRU", collections.NewLRUPolicy[string](), []string{"a", "c", "d"}},
+		{"LFU", collections.NewLFUPolicy[string](), []string{"a", "b", "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewBoundedMap[string, int](NewHashMap[string, int](), 3, tt.policy)
+			m.Put("a", 1)
+			m.Put("b", 2)
+			m.Put("c", 3)
+			m.Get("b")
+			m.Get("b")
+			m.Get("c")
+			m.Get("a")
+			m.Put("d", 4)
+
+			test.GotWantSlice(t, boundedKeys(m), tt.want)
+		})
+	}
+}
+
+// Verifies deleted keys leave room and are never chosen as victims
+func TestBoundedMap_Delete(t *testing.T) {
+	m := NewBoundedMap[string, int](NewHashMap[string, int](), 2, collections.NewFIFOPolicy[string]())
+	var evicted []string
+	m.SetOnEvict(func(key string, value int) { evicted = append(evicted, key) })
+
+	m.Put("a", 1)
+	m.Put("b", 2)
+	test.GotWant(t, m.Delete("a"), true)
+	test.GotWant(t, m.Delete("a"), false)
+	m.Put("c", 3)
+	test.GotWant(t, len(evicted), 0)
+
+	m.Put("d", 4)
+	test.GotWantSlice(t, evicted, []string{"b"})
+	test.GotWantSlice(t, boundedKeys(m), []string{"c", "d"})
+}
+
+// Verifies the capacity is never exceeded and every eviction is reported
+// with its pair
+func TestBoundedMap_Capacity(t *testing.T) {
+	m := NewBoundedMap[int, int](NewHashMap[int, int](), 10, collections.NewRandomPolicy[int]())
+	evicted := map[int]int{}
+	m.SetOnEvict(func(key int, value int) {
+		test.GotWant(t, value, key*key)
+		evicted[key]++
+		test.GotWant(t, m.Contains(key), false)
+	})
+
+	for i := range 100 {
+		m.Put(i, i*i)
+		test.GotWant(t, m.Size(), min(i+1, 10))
+	}
+
+	test.GotWant(t, len(evicted), 90)
+	for key, n := range evicted {
+		test.GotWant(t, n, 1)
+		test.GotWant(t, m.Contains(key), false)
+	}
+}
+
+// Verifies keys that expired in a wrapped cache are discarded when chosen,
+// without being reported as evictions
+func TestBoundedMap_ExpiringCache(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		cache := NewExpiringCacheWith[string, int](WithDefaultTTL(time.Second))
+		m := NewBoundedMap[string, int](cache, 2, collections.NewFIFOPolicy[string]())
+		var evicted []string
+		m.SetOnEvict(func(key string, value int) { evicted = append(evicted, key) })
+
+		m.Put("a", 1)
+		time.Sleep(time.Second / 2)
+		m.Put("b", 2)
+		time.Sleep(time.Second / 2)
+		m.Put("c", 3)
+		m.Put("d", 4)
+
+		test.GotWantSlice(t, evicted, []string{"b"})
+		test.GotWantSlice(t, boundedKeys(m), []string{"c", "d"})
+	})
+}
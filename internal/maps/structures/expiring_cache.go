@@ -0,0 +1,405 @@
+package structures
+
+import (
+	"fmt"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Compile-time interface verifications
+var _ Map[int, int] = &ExpiringCache[int, int]{}
+var _ collections.Collection[int] = &ExpiringCache[int, int]{}
+
+// Represents a cached value and the moment it stops being live.
+type expiringEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+// ExpiringCache implements a key-value cache whose entries expire after a
+// time-to-live (TTL).
+//
+// Every entry records its expiry time when it is put. An expired entry
+// behaves exactly like an absent key: lookups evict it on sight, and Put
+// treats its key as new. Optionally, a janitor goroutine sweeps all
+// expired entries at a fixed interval (see ExpiringCacheConfig).
+//
+// Design decisions:
+//   - Lazy eviction: Expiry costs nothing until an entry is touched
+//   - Optional janitor: Reclaims memory held by entries nobody reads again
+//   - Single mutex: The janitor and callers never observe partial updates
+//   - Per-entry TTL: PutWithTTL overrides the default lifetime
+//
+// All methods are safe for concurrent use by multiple goroutines.
+// A cache created with a SweepInterval must be stopped with Stop to
+// release its janitor goroutine.
+//
+// Space complexity: O(n) where n is the number of entries not yet evicted.
+type ExpiringCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	entries map[K]expiringEntry[V]
+	config  ExpiringCacheConfig
+	stop    chan struct{} // Closed to stop the janitor (nil without one)
+	stopped sync.Once
+	onEvict func(key K, value V)
+}
+
+// NewExpiringCache creates an empty cache whose entries live one minute,
+// without a janitor.
+//
+// For specific workloads, use NewExpiringCacheWith:
+//   - Different lifetimes: WithDefaultTTL
+//   - Write-heavy, rarely read caches: WithSweepInterval
+func NewExpiringCache[K comparable, V any]() *ExpiringCache[K, V] {
+	return newExpiringCache[K, V](defaultExpiringCacheConfig())
+}
+
+// NewExpiringCacheWith creates an empty cache from the default
+// configuration adjusted by the given options. Options are applied in
+// order. Starts a janitor goroutine if the sweep interval ends up greater
+// than 0.
+//
+// Panics if the default TTL ends up not greater than 0 or the sweep
+// interval ends up negative.
+//
+// Example:
+//
+//	c := NewExpiringCacheWith[string, int](
+//	    WithDefaultTTL(time.Hour),
+//	    WithSweepInterval(time.Minute),
+//	)
+//	defer c.Stop()
+func NewExpiringCacheWith[K comparable, V any](opts ...ExpiringCacheOption) *ExpiringCache[K, V] {
+	c := defaultExpiringCacheConfig()
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return newExpiringCache[K, V](c)
+}
+
+// NewExpiringCacheWithConfig creates an empty cache with custom settings.
+// See ExpiringCacheConfig for configuration options and tuning guidance.
+// Starts a janitor goroutine if SweepInterval is greater than 0.
+//
+// Panics if DefaultTTL is not greater than 0 or SweepInterval is negative.
+//
+// Deprecated: Use NewExpiringCacheWith with WithDefaultTTL and
+// WithSweepInterval.
+func NewExpiringCacheWithConfig[K comparable, V any](config ExpiringCacheConfig) *ExpiringCache[K, V] {
+	return newExpiringCache[K, V](config)
+}
+
+// Creates an empty cache with the given settings, starting a janitor if
+// SweepInterval is greater than 0.
+// Panics if DefaultTTL is not greater than 0 or SweepInterval is negative.
+func newExpiringCache[K comparable, V any](config ExpiringCacheConfig) *ExpiringCache[K, V] {
+	panics.RequireGreaterThan(config.DefaultTTL, 0, "default ttl")
+	panics.RequireNonNegative(config.SweepInterval, "sweep interval")
+
+	c := &ExpiringCache[K, V]{
+		entries: make(map[K]expiringEntry[V]),
+		config:  config,
+	}
+
+	if config.SweepInterval > 0 {
+		c.stop = make(chan struct{})
+		go c.janitor()
+	}
+
+	return c
+}
+
+// Put associates the value with the key for the default TTL.
+// Returns true if the key was added, false if a live value was replaced.
+//
+// Time complexity: O(1) expected
+func (c *ExpiringCache[K, V]) Put(key K, value V) bool {
+	return c.PutWithTTL(key, value, c.config.DefaultTTL)
+}
+
+// PutWithTTL associates the value with the key for the given TTL,
+// overriding the default. Replacing a value restarts its lifetime.
+// Returns true if the key was added, false if a live value was replaced.
+//
+// Panics if ttl is not greater than 0.
+//
+// Time complexity: O(1) expected
+//
+// Example:
+//
+//	c.PutWithTTL("token", "abc", 5*time.Second)
+func (c *ExpiringCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) bool {
+	panics.RequireGreaterThan(ttl, 0, "ttl")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	e, exists := c.entries[key]
+	c.entries[key] = expiringEntry[V]{value: value, expires: now.Add(ttl)}
+	return !exists || !now.Before(e.expires)
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not present or has expired, evicting it
+// in the latter case.
+//
+// Time complexity: O(1) expected
+func (c *ExpiringCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.live(key, time.Now())
+	return e.value, ok
+}
+
+// TTL returns the time the entry for the key has left to live.
+// Returns false if the key is not present or has expired, evicting it
+// in the latter case.
+//
+// Time complexity: O(1) expected
+func (c *ExpiringCache[K, V]) TTL(key K) (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	e, ok := c.live(key, now)
+	if !ok {
+		return 0, false
+	}
+
+	return e.expires.Sub(now), true
+}
+
+// Clear removes all entries. Like Delete, it does not report them to the
+// eviction callback.
+//
+// Time complexity: O(n)
+func (c *ExpiringCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+}
+
+// Contains returns true if the key is present and has not expired.
+//
+// Time complexity: O(1) expected
+func (c *ExpiringCache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.live(key, time.Now())
+	return ok
+}
+
+// Delete removes the key and its value.
+// Returns true if a live entry was found and removed, false otherwise.
+//
+// Time complexity: O(1) expected
+func (c *ExpiringCache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.live(key, time.Now())
+	delete(c.entries, key)
+	return ok
+}
+
+// Sweep evicts every expired entry.
+// Returns the number of entries evicted.
+//
+// Time complexity: O(n)
+func (c *ExpiringCache[K, V]) Sweep() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.sweep(time.Now())
+}
+
+// All returns an iterator over the live key-value pairs in no particular
+// order. The pairs are a snapshot taken when iteration starts, so the
+// cache may be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (c *ExpiringCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		c.mu.Lock()
+		now := time.Now()
+		keys := make([]K, 0, len(c.entries))
+		values := make([]V, 0, len(c.entries))
+		for k, e := range c.entries {
+			if now.Before(e.expires) {
+				keys = append(keys, k)
+				values = append(values, e.value)
+			}
+		}
+		c.mu.Unlock()
+
+		for i, k := range keys {
+			if !yield(k, values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns an immutable view of the live pairs of the cache as
+// they are now. The cache is locked only while the pairs are copied, and
+// the snapshot keeps the pairs even after they expire in the cache.
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(n) - the live pairs are copied
+//
+// Example:
+//
+//	snap := c.Snapshot()
+//	for k, v := range snap.All() {
+//	    export(k, v)  // Writers to c are not blocked meanwhile
+//	}
+func (c *ExpiringCache[K, V]) Snapshot() *MapSnapshot[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	pairs := make(map[K]V, len(c.entries))
+	for k, e := range c.entries {
+		if now.Before(e.expires) {
+			pairs[k] = e.value
+		}
+	}
+
+	return &MapSnapshot[K, V]{pairs: pairs}
+}
+
+// IsEmpty returns true if the cache contains no live entries.
+//
+// Time complexity: O(n)
+func (c *ExpiringCache[K, V]) IsEmpty() bool {
+	return c.Size() == 0
+}
+
+// Size returns the number of live entries, evicting expired ones first.
+//
+// Time complexity: O(n)
+func (c *ExpiringCache[K, V]) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sweep(time.Now())
+	return len(c.entries)
+}
+
+// CheckInvariants verifies the internal consistency of the cache: the
+// default TTL is positive and the sweep interval not negative, a janitor
+// exists exactly when the sweep interval is positive, and every entry has
+// an expiry time. Expired entries not yet evicted are consistent. Takes
+// the lock and evicts nothing. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the cache is consistent.
+//
+// Time complexity: O(n)
+func (c *ExpiringCache[K, V]) CheckInvariants() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.config.DefaultTTL <= 0 {
+		return fmt.Errorf("%w: default ttl is %v, want > 0", ErrInvariantViolation, c.config.DefaultTTL)
+	}
+	if c.config.SweepInterval < 0 {
+		return fmt.Errorf("%w: sweep interval is %v, want >= 0", ErrInvariantViolation, c.config.SweepInterval)
+	}
+	if hasJanitor := c.stop != nil; hasJanitor != (c.config.SweepInterval > 0) {
+		return fmt.Errorf("%w: janitor is %v with a sweep interval of %v", ErrInvariantViolation, hasJanitor, c.config.SweepInterval)
+	}
+	for k, e := range c.entries {
+		if e.expires.IsZero() {
+			return fmt.Errorf("%w: entry %v has no expiry time", ErrInvariantViolation, k)
+		}
+	}
+
+	return nil
+}
+
+// Stop terminates the janitor goroutine. Safe to call more than once and
+// on a cache without a janitor. The cache remains usable afterwards with
+// lazy eviction only.
+func (c *ExpiringCache[K, V]) Stop() {
+	if c.stop != nil {
+		c.stopped.Do(func() { close(c.stop) })
+	}
+}
+
+// SetOnEvict installs a callback invoked for every entry evicted because
+// it expired, whether by a lookup, Sweep, Size or the janitor, replacing
+// any installed before. Entries removed by Delete or replaced by Put are
+// not reported. A nil callback removes it.
+//
+// The callback runs with the cache locked, so it must not call methods of
+// the cache, and should be cheap.
+//
+// Example:
+//
+//	c.SetOnEvict(func(key string, value int) { evictions.Inc() })
+func (c *ExpiringCache[K, V]) SetOnEvict(onEvict func(key K, value V)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onEvict = onEvict
+}
+
+// Returns the entry for the key if it is live, evicting it if it has
+// expired. Must be called with the lock held.
+func (c *ExpiringCache[K, V]) live(key K, now time.Time) (expiringEntry[V], bool) {
+	e, ok := c.entries[key]
+	if ok && !now.Before(e.expires) {
+		c.evict(key, e)
+		return expiringEntry[V]{}, false
+	}
+
+	return e, ok
+}
+
+// Evicts all entries expired at the given time. Must be called with the
+// lock held.
+func (c *ExpiringCache[K, V]) sweep(now time.Time) int {
+	evicted := 0
+	for k, e := range c.entries {
+		if !now.Before(e.expires) {
+			c.evict(k, e)
+			evicted++
+		}
+	}
+
+	return evicted
+}
+
+// Removes an expired entry and reports it to the eviction callback. Must
+// be called with the lock held.
+func (c *ExpiringCache[K, V]) evict(key K, e expiringEntry[V]) {
+	delete(c.entries, key)
+	if c.onEvict != nil {
+		c.onEvict(key, e.value)
+	}
+}
+
+// Sweeps the cache every SweepInterval until Stop is called.
+func (c *ExpiringCache[K, V]) janitor() {
+	ticker := time.NewTicker(c.config.SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.Sweep()
+		case <-c.stop:
+			return
+		}
+	}
+}
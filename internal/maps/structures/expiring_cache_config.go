@@ -0,0 +1,66 @@
+package structures
+
+import "time"
+
+// ExpiringCacheConfig controls entry lifetimes and background sweeping in
+// an ExpiringCache.
+//
+// Expired entries are always evicted lazily when a lookup finds them. A
+// cache that is written once and rarely read can still hold on to expired
+// values indefinitely, so a background janitor can sweep the whole cache
+// periodically. A cache with a janitor must be stopped with Stop.
+//
+// Default configuration (NewExpiringCache):
+//
+//	DefaultTTL:    time.Minute  // entries live one minute
+//	SweepInterval: 0            // no janitor, lazy eviction only
+//
+// Example configurations:
+//
+//	// Session store: long lifetimes, sweep every few minutes
+//	config := ExpiringCacheConfig{DefaultTTL: 30 * time.Minute, SweepInterval: 5 * time.Minute}
+//
+//	// Short-lived lookups: evict lazily only
+//	config := ExpiringCacheConfig{DefaultTTL: time.Second}
+type ExpiringCacheConfig struct {
+	// DefaultTTL is how long an entry added with Put stays live.
+	// Must be greater than 0. PutWithTTL overrides it per entry.
+	DefaultTTL time.Duration
+
+	// SweepInterval is how often the janitor goroutine evicts expired
+	// entries. Must be >= 0.
+	//
+	// 0: No janitor, expired entries are evicted only when accessed or
+	// when Sweep is called
+	// Shorter intervals: Memory is reclaimed sooner, more time holding the lock
+	// Longer intervals: Less overhead, expired values linger longer
+	SweepInterval time.Duration
+}
+
+// ExpiringCacheOption configures an ExpiringCache created with
+// NewExpiringCacheWith. Options are applied in order on top of the default
+// configuration.
+type ExpiringCacheOption func(*ExpiringCacheConfig)
+
+// WithDefaultTTL sets how long an entry added with Put stays live.
+// See ExpiringCacheConfig.DefaultTTL.
+func WithDefaultTTL(ttl time.Duration) ExpiringCacheOption {
+	return func(c *ExpiringCacheConfig) {
+		c.DefaultTTL = ttl
+	}
+}
+
+// WithSweepInterval sets how often the janitor evicts expired entries.
+// See ExpiringCacheConfig.SweepInterval.
+func WithSweepInterval(interval time.Duration) ExpiringCacheOption {
+	return func(c *ExpiringCacheConfig) {
+		c.SweepInterval = interval
+	}
+}
+
+// Returns the configuration used by NewExpiringCache.
+func defaultExpiringCacheConfig() ExpiringCacheConfig {
+	return ExpiringCacheConfig{
+		DefaultTTL: time.Minute,
+	}
+}
@@ -0,0 +1,480 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewExpiringCache/NewExpiringCacheWithConfig):
+  ✓ Empty cache
+  ✓ Invalid TTL and sweep interval panic
+
+Put/PutWithTTL/Get/Contains/Delete/TTL:
+  ✓ Get from empty cache
+  ✓ Put new keys, replace live values
+  ✓ Per-entry TTL overrides the default, invalid TTL panics
+  ✓ Entries expire exactly at their TTL
+  ✓ Expired keys are evicted on access and treated as new by Put
+  ✓ Replacing a value restarts its lifetime
+  ✓ Delete live, expired and absent keys
+
+Sweep/Size/IsEmpty/All:
+  ✓ Sweep evicts only expired entries
+  ✓ Size and All ignore expired entries, early termination
+
+SetOnEvict:
+  ✓ Expired entries reported once, by lookups and sweeps
+  ✓ Deleted and replaced entries not reported
+
+Janitor/Stop:
+  ✓ Janitor evicts expired entries without access
+  ✓ Stop is idempotent, safe without a janitor
+  ✓ Concurrent access alongside the janitor
+  ✓ Random operations from many goroutines alongside the janitor
+  ✓ Histories of short rounds are linearizable
+
+Options (NewExpiringCacheWith):
+  ✓ No options yields default configuration
+  ✓ Options applied in order, janitor started
+  ✓ Invalid options (panic)
+
+Snapshot:
+  ✓ Live pairs only, unaffected by later changes and expiry
+  ✓ Empty cache
+
+Clear:
+  ✓ Removes entries without reporting them
+
+CheckInvariants:
+  ✓ Valid caches with and without a janitor, expired entries kept
+  ✓ Invalid TTL and sweep interval, janitor out of step, entry without
+    an expiry time
+*/
+
+import (
+	"maps"
+	"math/rand/v2"
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/conctest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty cache
+func TestExpiringCache_NewExpiringCache_Empty(t *testing.T) {
+	c := NewExpiringCache[string, int]()
+	test.GotWant(t, c.Size(), 0)
+	test.GotWant(t, c.IsEmpty(), true)
+	test.GotWant(t, c.config.DefaultTTL, time.Minute)
+}
+
+// Verifies non-positive TTLs and negative sweep intervals panic
+func TestExpiringCache_NewExpiringCacheWithConfig_Invalid(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewExpiringCacheWithConfig[int, int](ExpiringCacheConfig{})
+	}, `"default ttl" must be > 0s, got 0s`)
+	test.GotWantPanic(t, func() {
+		NewExpiringCacheWithConfig[int, int](ExpiringCacheConfig{DefaultTTL: time.Second, SweepInterval: -1})
+	}, `"sweep interval" must be >= 0, got -1ns`)
+}
+
+// Verifies getting from an empty cache
+func TestExpiringCache_Get_EmptyCache(t *testing.T) {
+	c := NewExpiringCache[string, int]()
+	v, ok := c.Get("a")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, 0)
+	_, ok = c.TTL("a")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, c.Contains("a"), false)
+}
+
+// Verifies putting new keys and replacing live values
+func TestExpiringCache_Put(t *testing.T) {
+	c := NewExpiringCache[string, int]()
+	test.GotWant(t, c.Put("a", 1), true)
+	test.GotWant(t, c.Put("b", 2), true)
+	test.GotWant(t, c.Put("a", 3), false)
+	test.GotWant(t, c.Size(), 2)
+
+	v, ok := c.Get("a")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 3)
+}
+
+// Verifies entries expire exactly at their TTL and per-entry TTLs override
+// the default
+func TestExpiringCache_Expiry(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[string, int](ExpiringCacheConfig{DefaultTTL: 10 * time.Second})
+		c.Put("default", 1)
+		c.PutWithTTL("short", 2, 2*time.Second)
+
+		time.Sleep(2*time.Second - time.Nanosecond)
+		test.GotWant(t, c.Contains("short"), true)
+		ttl, _ := c.TTL("short")
+		test.GotWant(t, ttl, time.Nanosecond)
+
+		time.Sleep(time.Nanosecond)
+		test.GotWant(t, c.Contains("short"), false)
+		ttl, ok := c.TTL("default")
+		test.GotWant(t, ok, true)
+		test.GotWant(t, ttl, 8*time.Second)
+
+		time.Sleep(8 * time.Second)
+		_, ok = c.Get("default")
+		test.GotWant(t, ok, false)
+		test.GotWant(t, c.IsEmpty(), true)
+	})
+}
+
+// Verifies a non-positive per-entry TTL panics
+func TestExpiringCache_PutWithTTL_Invalid(t *testing.T) {
+	c := NewExpiringCache[string, int]()
+	test.GotWantPanic(t, func() {
+		c.PutWithTTL("a", 1, 0)
+	}, `"ttl" must be > 0s, got 0s`)
+}
+
+// Verifies expired keys are evicted on access and treated as new by Put
+func TestExpiringCache_LazyEviction(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[string, int](ExpiringCacheConfig{DefaultTTL: time.Second})
+		c.Put("a", 1)
+		c.Put("b", 2)
+		time.Sleep(time.Second)
+
+		test.GotWant(t, len(c.entries), 2)
+		_, ok := c.Get("a")
+		test.GotWant(t, ok, false)
+		test.GotWant(t, len(c.entries), 1)
+
+		test.GotWant(t, c.Put("b", 3), true)
+		v, _ := c.Get("b")
+		test.GotWant(t, v, 3)
+	})
+}
+
+// Verifies replacing a value restarts its lifetime
+func TestExpiringCache_Put_RestartsLifetime(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[string, int](ExpiringCacheConfig{DefaultTTL: time.Second})
+		c.Put("a", 1)
+		time.Sleep(time.Second / 2)
+		c.Put("a", 2)
+		time.Sleep(time.Second / 2)
+
+		v, ok := c.Get("a")
+		test.GotWant(t, ok, true)
+		test.GotWant(t, v, 2)
+	})
+}
+
+// Verifies deleting live, expired and absent keys
+func TestExpiringCache_Delete(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[string, int](ExpiringCacheConfig{DefaultTTL: time.Second})
+		c.Put("live", 1)
+		c.PutWithTTL("expired", 2, time.Millisecond)
+		time.Sleep(time.Millisecond)
+
+		test.GotWant(t, c.Delete("absent"), false)
+		test.GotWant(t, c.Delete("expired"), false)
+		test.GotWant(t, c.Delete("live"), true)
+		test.GotWant(t, c.Delete("live"), false)
+		test.GotWant(t, len(c.entries), 0)
+	})
+}
+
+// Verifies Sweep evicts only expired entries
+func TestExpiringCache_Sweep(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[int, int](ExpiringCacheConfig{DefaultTTL: time.Hour})
+		for i := range 10 {
+			c.PutWithTTL(i, i, time.Duration(i+1)*time.Second)
+		}
+		time.Sleep(4 * time.Second)
+
+		test.GotWant(t, c.Sweep(), 4)
+		test.GotWant(t, c.Sweep(), 0)
+		test.GotWant(t, len(c.entries), 6)
+	})
+}
+
+// Verifies Size and All skip expired entries and All stops when the
+// consumer breaks
+func TestExpiringCache_All(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[int, int](ExpiringCacheConfig{DefaultTTL: time.Hour})
+		want := map[int]int{}
+		for i := range 10 {
+			c.Put(i, -i)
+			want[i] = -i
+		}
+		c.PutWithTTL(10, 10, time.Second)
+		time.Sleep(time.Second)
+
+		test.GotWant(t, maps.Equal(maps.Collect(c.All()), want), true)
+		test.GotWant(t, c.Size(), 10)
+
+		count := 0
+		for range c.All() {
+			count++
+			if count == 3 {
+				break
+			}
+		}
+		test.GotWant(t, count, 3)
+	})
+}
+
+// Verifies OnEvict reports every expired entry exactly once, and neither
+// deleted nor replaced entries
+func TestExpiringCache_SetOnEvict(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[int, int](ExpiringCacheConfig{DefaultTTL: time.Second})
+		evicted := map[int]int{}
+		c.SetOnEvict(func(key int, value int) {
+			_, seen := evicted[key]
+			test.GotWant(t, seen, false)
+			evicted[key] = value
+		})
+		for i := range 5 {
+			c.Put(i, -i)
+		}
+		c.Delete(3)
+		c.Put(4, 40)
+		time.Sleep(time.Second)
+
+		c.Get(0)
+		c.Contains(1)
+		test.GotWant(t, c.Sweep(), 2)
+		test.GotWant(t, c.Sweep(), 0)
+		test.GotWant(t, maps.Equal(evicted, map[int]int{0: 0, 1: -1, 2: -2, 4: 40}), true)
+	})
+}
+
+// Verifies the janitor evicts expired entries nobody accesses
+func TestExpiringCache_Janitor(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[int, int](ExpiringCacheConfig{
+			DefaultTTL:    time.Second,
+			SweepInterval: time.Minute,
+		})
+		defer c.Stop()
+		for i := range 100 {
+			c.Put(i, i)
+		}
+
+		time.Sleep(time.Minute - time.Nanosecond)
+		synctest.Wait()
+		c.mu.Lock()
+		test.GotWant(t, len(c.entries), 100)
+		c.mu.Unlock()
+
+		time.Sleep(time.Nanosecond)
+		synctest.Wait()
+		c.mu.Lock()
+		test.GotWant(t, len(c.entries), 0)
+		c.mu.Unlock()
+	})
+}
+
+// Verifies Stop ends the janitor, is idempotent and is safe on a cache
+// without a janitor
+func TestExpiringCache_Stop(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[int, int](ExpiringCacheConfig{
+			DefaultTTL:    time.Second,
+			SweepInterval: time.Second,
+		})
+		c.Stop()
+		c.Stop()
+
+		// synctest.Test fails if the janitor goroutine is still running
+		// when the bubble's root goroutine returns
+		synctest.Wait()
+		c.Put(1, 1)
+		test.GotWant(t, c.Contains(1), true)
+	})
+
+	NewExpiringCache[int, int]().Stop()
+}
+
+// Verifies concurrent readers and writers alongside the janitor keep the
+// cache consistent
+func TestExpiringCache_Concurrent(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[int, int](ExpiringCacheConfig{
+			DefaultTTL:    time.Second,
+			SweepInterval: time.Second / 4,
+		})
+		defer c.Stop()
+
+		var wg sync.WaitGroup
+		for g := range 8 {
+			wg.Go(func() {
+				for i := range 200 {
+					k := g*1000 + i
+					c.Put(k, i)
+					if v, ok := c.Get(k); !ok || v != i {
+						t.Errorf("Get(%d) = %d, %v", k, v, ok)
+					}
+					if i%50 == 0 {
+						time.Sleep(time.Second / 2)
+					}
+				}
+			})
+		}
+		wg.Wait()
+
+		time.Sleep(time.Second)
+		synctest.Wait()
+		test.GotWant(t, c.Size(), 0)
+	})
+}
+
+// Verifies random operations from many goroutines, including sweeps,
+// keep the cache consistent while the janitor runs
+func TestExpiringCache_Hammer(t *testing.T) {
+	c := NewExpiringCacheWithConfig[int, int](ExpiringCacheConfig{
+		DefaultTTL:    time.Millisecond,
+		SweepInterval: time.Millisecond,
+	})
+	defer c.Stop()
+
+	ops := append(mapHammerOps[*ExpiringCache[int, int]](),
+		conctest.Op[*ExpiringCache[int, int]]{Name: "Sweep", Apply: func(t *testing.T, r *rand.Rand, c *ExpiringCache[int, int]) {
+			c.Sweep()
+		}},
+	)
+	conctest.Hammer(t, conctest.Config{Seed: 1, Goroutines: 8, Ops: 2000}, c, ops...)
+	test.GotWant(t, c.Size() <= 256, true)
+}
+
+// Verifies every history of short concurrent rounds can be explained by
+// some sequential order of the calls
+func TestExpiringCache_Linearizable(t *testing.T) {
+	conctest.CheckLinearizable(t, conctest.Config{Seed: 1, Runs: 300, Goroutines: 4, Ops: 3},
+		func() (*ExpiringCache[int, int], map[int]int) {
+			return NewExpiringCacheWithConfig[int, int](ExpiringCacheConfig{DefaultTTL: time.Hour}), map[int]int{}
+		},
+		mapLinOps[*ExpiringCache[int, int]]()...,
+	)
+}
+
+// Verifies no options yields the default configuration
+func TestExpiringCache_NewExpiringCacheWith_Defaults(t *testing.T) {
+	c := NewExpiringCacheWith[int, int]()
+	test.GotWant(t, c.config, NewExpiringCache[int, int]().config)
+	test.GotWant(t, c.stop == nil, true)
+}
+
+// Verifies options are applied in order and a sweep interval starts the
+// janitor
+func TestExpiringCache_NewExpiringCacheWith_Options(t *testing.T) {
+	c := NewExpiringCacheWith[int, int](
+		WithDefaultTTL(time.Second),
+		WithSweepInterval(time.Hour),
+		WithDefaultTTL(time.Hour),
+	)
+	defer c.Stop()
+
+	test.GotWant(t, c.config, ExpiringCacheConfig{DefaultTTL: time.Hour, SweepInterval: time.Hour})
+	test.GotWant(t, c.stop != nil, true)
+}
+
+// Verifies invalid options panic at construction
+func TestExpiringCache_NewExpiringCacheWith_InvalidOptions(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewExpiringCacheWith[int, int](WithDefaultTTL(0))
+	}, `"default ttl" must be > 0s, got 0s`)
+}
+
+// Verifies a snapshot holds the live pairs and keeps them while the cache
+// changes and its entries expire
+func TestExpiringCache_Snapshot(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWithConfig[string, int](ExpiringCacheConfig{DefaultTTL: time.Second})
+		c.Put("a", 1)
+		c.PutWithTTL("b", 2, time.Millisecond)
+		time.Sleep(time.Millisecond)
+
+		snap := c.Snapshot()
+		c.Put("c", 3)
+		c.Delete("a")
+		time.Sleep(time.Second)
+
+		test.GotWant(t, snap.Size(), 1)
+		test.GotWant(t, snap.IsEmpty(), false)
+		v, ok := snap.Get("a")
+		test.GotWant(t, ok, true)
+		test.GotWant(t, v, 1)
+		test.GotWant(t, snap.Contains("b"), false)
+		test.GotWant(t, snap.Contains("c"), false)
+		test.GotWantDeep(t, maps.Collect(snap.All()), map[string]int{"a": 1})
+		test.GotWant(t, c.IsEmpty(), true)
+	})
+}
+
+// Verifies a snapshot of an empty cache
+func TestExpiringCache_Snapshot_Empty(t *testing.T) {
+	snap := NewExpiringCache[string, int]().Snapshot()
+	test.GotWant(t, snap.Size(), 0)
+	test.GotWant(t, snap.IsEmpty(), true)
+	_, ok := snap.Get("a")
+	test.GotWant(t, ok, false)
+}
+
+// Verifies Clear empties the cache without calling the eviction callback
+func TestExpiringCache_Clear(t *testing.T) {
+	c := NewExpiringCache[string, int]()
+	evicted := 0
+	c.SetOnEvict(func(string, int) { evicted++ })
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	c.Clear()
+	test.GotWant(t, c.Size(), 0)
+	test.GotWant(t, c.Contains("a"), false)
+	test.GotWant(t, evicted, 0)
+}
+
+// Verifies CheckInvariants accepts valid caches, including ones holding
+// expired entries that were not evicted yet, and evicts nothing
+func TestExpiringCache_CheckInvariants(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := NewExpiringCacheWith[string, int](WithDefaultTTL(time.Second))
+		test.GotWantNoError(t, c.CheckInvariants())
+		c.Put("a", 1)
+		time.Sleep(time.Second)
+		test.GotWantNoError(t, c.CheckInvariants())
+		test.GotWant(t, len(c.entries), 1)
+
+		janitor := NewExpiringCacheWith[string, int](WithSweepInterval(time.Minute))
+		defer janitor.Stop()
+		test.GotWantNoError(t, janitor.CheckInvariants())
+	})
+}
+
+// Verifies CheckInvariants reports each kind of corruption
+func TestExpiringCache_CheckInvariants_Corrupted(t *testing.T) {
+	c := NewExpiringCache[string, int]()
+	c.Put("a", 1)
+
+	c.config.DefaultTTL = 0
+	test.GotWantError(t, c.CheckInvariants(), "invariant violation: default ttl is 0s, want > 0")
+	c.config.DefaultTTL = time.Minute
+
+	c.config.SweepInterval = -time.Second
+	test.GotWantError(t, c.CheckInvariants(), "invariant violation: sweep interval is -1s, want >= 0")
+	c.config.SweepInterval = time.Second
+	test.GotWantError(t, c.CheckInvariants(), "invariant violation: janitor is false with a sweep interval of 1s")
+	c.config.SweepInterval = 0
+
+	c.entries["b"] = expiringEntry[int]{value: 2}
+	test.GotWantError(t, c.CheckInvariants(), "invariant violation: entry b has no expiry time")
+	delete(c.entries, "b")
+	test.GotWantNoError(t, c.CheckInvariants())
+}
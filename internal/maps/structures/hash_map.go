@@ -0,0 +1,383 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/hash"
+)
+
+// Compile-time interface verifications
+var _ Map[int, int] = &HashMap[int, int]{}
+var _ collections.Collection[int] = &HashMap[int, int]{}
+
+// Smallest number of slots allocated by a HashMap.
+const hashMapMinCapacity = 8
+
+// Describes the state of a slot in an open-addressing table.
+type slotState uint8
+
+const (
+	slotEmpty slotState = iota
+	slotOccupied
+	slotDeleted // Tombstone: keeps probe chains intact after a delete
+)
+
+// Represents a key-value pair stored in a HashMap slot.
+type hashMapPair[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// HashMap implements an unordered map using open addressing with linear
+// probing.
+//
+// Pairs live inline in a slot array whose length is a power of two, with
+// the slot states kept in a parallel byte array. A key is stored in the
+// first free slot at or after its hash position, so lookups scan a short
+// contiguous run of memory. Deleted slots become tombstones that lookups
+// step over and inserts reuse; the table is rebuilt once live pairs and
+// tombstones together pass 75% of the slots, doubling only when live
+// pairs alone justify it.
+//
+// HashMap is chiefly a transparent reference for open addressing. The
+// built-in map is a Swiss table tuned by the runtime, and measured faster
+// for Put, Get and iteration and more compact at 100,000 int pairs; see
+// hash_map_bench_test.go before choosing HashMap for speed or memory.
+//
+// Design decisions:
+//   - Linear probing: Cache-friendly probe sequence over adjacent slots
+//   - Separate state bytes: Probing reads one byte per slot and pairs
+//     carry no padding for a state field
+//   - Tombstones: Delete is O(1) without shifting the probe chain; a
+//     tombstone directly before an empty slot is cleared immediately
+//   - Per-map hash seed: Probe positions differ between maps, which
+//     hinders crafted collisions
+//   - Pluggable hasher: WithHasher replaces the default maphash-based
+//     hash function
+//
+// Space complexity: O(n) where n is the number of pairs.
+type HashMap[K comparable, V any] struct {
+	states     []slotState
+	pairs      []hashMapPair[K, V]
+	size       int
+	tombstones int
+	hasher     hash.Hasher[K]
+}
+
+// NewHashMap creates an empty hash map.
+//
+// Example:
+//
+//	m := NewHashMap[string, int]()
+//	m.Put("a", 1)
+//	m.Get("a")  // Returns 1, true
+func NewHashMap[K comparable, V any]() *HashMap[K, V] {
+	return NewHashMapWith[K, V]()
+}
+
+// NewHashMapWith creates an empty hash map adjusted by the given options.
+// Options are applied in order.
+//
+// Example:
+//
+//	m := NewHashMapWith[int, string](WithHasher(hash.NewInteger[int]()))
+func NewHashMapWith[K comparable, V any](opts ...HashMapOption[K]) *HashMap[K, V] {
+	var s hashedMapSettings[K]
+	for _, opt := range opts {
+		opt.applyHashMap(&s)
+	}
+
+	return newHashMap[K, V](s.hasherOrDefault())
+}
+
+// NewHashMapWithHasher creates an empty hash map that hashes keys with the
+// given hasher. See hash.Hasher for the requirements.
+//
+// Deprecated: Use NewHashMapWith with WithHasher.
+func NewHashMapWithHasher[K comparable, V any](hasher hash.Hasher[K]) *HashMap[K, V] {
+	return NewHashMapWith[K, V](WithHasher(hasher))
+}
+
+// Creates an empty map of the minimum capacity that hashes with hasher.
+func newHashMap[K comparable, V any](hasher hash.Hasher[K]) *HashMap[K, V] {
+	return &HashMap[K, V]{
+		states: make([]slotState, hashMapMinCapacity),
+		pairs:  make([]hashMapPair[K, V], hashMapMinCapacity),
+		hasher: hasher,
+	}
+}
+
+// Put associates the value with the key.
+// Returns true if the key was added, false if an existing value was replaced.
+//
+// Time complexity: O(1) expected, amortized for table rebuilds
+func (m *HashMap[K, V]) Put(key K, value V) bool {
+	if i, found := m.find(key); found {
+		m.pairs[i].value = value
+		return false
+	}
+
+	if (m.size+m.tombstones+1)*4 > len(m.states)*3 {
+		m.rebuild()
+	}
+
+	// Reuse the first tombstone on the probe path, if any
+	mask := len(m.states) - 1
+	i := m.position(key)
+	for m.states[i] == slotOccupied {
+		i = (i + 1) & mask
+	}
+
+	if m.states[i] == slotDeleted {
+		m.tombstones--
+	}
+	m.states[i] = slotOccupied
+	m.pairs[i] = hashMapPair[K, V]{key: key, value: value}
+	m.size++
+	return true
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not present.
+//
+// Time complexity: O(1) expected
+func (m *HashMap[K, V]) Get(key K) (V, bool) {
+	if i, found := m.find(key); found {
+		return m.pairs[i].value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Clear removes all pairs. The map keeps its allocated slots, so
+// refilling it to a similar size does not rehash.
+//
+// Time complexity: O(c) where c is the capacity
+func (m *HashMap[K, V]) Clear() {
+	clear(m.states)
+	clear(m.pairs)
+	m.size = 0
+	m.tombstones = 0
+}
+
+// Contains returns true if the key is present.
+//
+// Time complexity: O(1) expected
+func (m *HashMap[K, V]) Contains(key K) bool {
+	_, found := m.find(key)
+	return found
+}
+
+// Delete removes the key and its value.
+// Returns true if the key was found and removed, false otherwise.
+//
+// Time complexity: O(1) expected
+func (m *HashMap[K, V]) Delete(key K) bool {
+	i, found := m.find(key)
+	if !found {
+		return false
+	}
+
+	mask := len(m.states) - 1
+	m.states[i] = slotDeleted
+	m.pairs[i] = hashMapPair[K, V]{} // Help GC
+	m.tombstones++
+	m.size--
+
+	// Special case: a tombstone followed by an empty slot ends no probe
+	// chain, so it and any tombstones before it can become empty again
+	for m.states[(i+1)&mask] == slotEmpty && m.states[i] == slotDeleted {
+		m.states[i] = slotEmpty
+		m.tombstones--
+		i = (i - 1) & mask
+	}
+
+	return true
+}
+
+// All returns an iterator over all key-value pairs in unspecified order.
+// The map must not be modified during iteration.
+//
+// Time complexity: O(c) for a full iteration where c is the slot count
+func (m *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i, state := range m.states {
+			if state == slotOccupied && !yield(m.pairs[i].key, m.pairs[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// pairs in iteration order in the format of package codec; the table
+// layout is not stored, since it depends on the hasher.
+// Returns an error wrapping codec.ErrUnsupportedType if K or V cannot be
+// encoded.
+//
+// Time complexity: O(c) where c is the slot count
+func (m *HashMap[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq2("HashMap", m.size, m.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// pairs of the map with those encoded by MarshalBinary, rehashing them
+// with the map's hasher.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded map, in which case the map is left unchanged.
+//
+// Time complexity: O(n) expected
+func (m *HashMap[K, V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := codec.DecodeSlice2[K, V](data, "HashMap")
+	if err != nil {
+		return err
+	}
+
+	m.restore(keys, values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (m *HashMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq2(w, "HashMap", m.size, m.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the pairs of the map
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The map is left
+// unchanged on error.
+//
+// Time complexity: O(n) expected
+func (m *HashMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	keys, values, n, err := codec.ReadSlice2[K, V](r, "HashMap")
+	if err != nil {
+		return n, err
+	}
+
+	m.restore(keys, values)
+	return n, nil
+}
+
+// IsEmpty returns true if the map contains no pairs.
+//
+// Time complexity: O(1)
+func (m *HashMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Size returns the number of pairs currently in the map.
+//
+// Time complexity: O(1)
+func (m *HashMap[K, V]) Size() int {
+	return m.size
+}
+
+// CheckInvariants verifies the internal consistency of the table: the
+// slot count is a power of two, the load stays within 75%, the numbers of
+// occupied and deleted slots match Size and the tombstone counter, and
+// every pair is reachable from its home slot without crossing an empty
+// slot. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the map is consistent.
+//
+// Time complexity: O(c) expected where c is the slot count
+func (m *HashMap[K, V]) CheckInvariants() error {
+	n := len(m.states)
+	if n == 0 || n&(n-1) != 0 || len(m.pairs) != n {
+		return fmt.Errorf("%w: %d states and %d pairs, want a power of two of each", ErrInvariantViolation, n, len(m.pairs))
+	}
+
+	occupied, deleted := 0, 0
+	for i, state := range m.states {
+		switch state {
+		case slotOccupied:
+			occupied++
+			if j, found := m.find(m.pairs[i].key); !found || j != i {
+				return fmt.Errorf("%w: key in slot %d is not reachable from its home slot", ErrInvariantViolation, i)
+			}
+		case slotDeleted:
+			deleted++
+		}
+	}
+	if occupied != m.size {
+		return fmt.Errorf("%w: size is %d, table holds %d pairs", ErrInvariantViolation, m.size, occupied)
+	}
+	if deleted != m.tombstones {
+		return fmt.Errorf("%w: tombstone count is %d, table holds %d", ErrInvariantViolation, m.tombstones, deleted)
+	}
+	if (occupied+deleted)*4 > n*3 {
+		return fmt.Errorf("%w: %d of %d slots in use, over 75%%", ErrInvariantViolation, occupied+deleted, n)
+	}
+
+	return nil
+}
+
+// Returns the home slot of the key.
+func (m *HashMap[K, V]) position(key K) int {
+	return int(m.hasher.Hash(key) & uint64(len(m.states)-1))
+}
+
+// Returns the slot holding the key, probing from its home slot until an
+// empty slot ends the chain.
+func (m *HashMap[K, V]) find(key K) (int, bool) {
+	mask := len(m.states) - 1
+	for i := m.position(key); ; i = (i + 1) & mask {
+		switch m.states[i] {
+		case slotEmpty:
+			return i, false
+		case slotOccupied:
+			if m.pairs[i].key == key {
+				return i, true
+			}
+		}
+	}
+}
+
+// Reinserts every live pair into a fresh table, dropping all tombstones.
+// The table doubles if live pairs fill more than half of it, otherwise it
+// keeps its size and the rebuild only purges tombstones.
+func (m *HashMap[K, V]) rebuild() {
+	capacity := len(m.states)
+	if (m.size+1)*2 > capacity {
+		capacity *= 2
+	}
+
+	states, pairs := m.states, m.pairs
+	m.states = make([]slotState, capacity)
+	m.pairs = make([]hashMapPair[K, V], capacity)
+	m.tombstones = 0
+
+	mask := capacity - 1
+	for j, state := range states {
+		if state != slotOccupied {
+			continue
+		}
+
+		i := m.position(pairs[j].key)
+		for m.states[i] == slotOccupied {
+			i = (i + 1) & mask
+		}
+		m.states[i] = slotOccupied
+		m.pairs[i] = pairs[j]
+	}
+}
+
+// Replaces the pairs with the decoded ones, rehashed into a fresh table.
+func (m *HashMap[K, V]) restore(keys []K, values []V) {
+	fresh := newHashMap[K, V](m.hasher)
+	for i, k := range keys {
+		fresh.Put(k, values[i])
+	}
+
+	*m = *fresh
+}
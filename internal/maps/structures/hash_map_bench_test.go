@@ -0,0 +1,148 @@
+package structures
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/bench"
+)
+
+// Number of pairs preloaded into every hash map benchmark.
+const benchHashMapSize = 100_000
+
+// BenchmarkHashMap_Put measures building a map from empty, including
+// every table growth.
+//
+// Pattern: [Put(i, i)] × 100,000
+// Expected winner: BuiltinMap (~1.6x faster; HashMap rehashes every pair
+// through a generic hash call on each doubling)
+func BenchmarkHashMap_Put(b *testing.B) {
+	b.Run("HashMap", func(b *testing.B) {
+		for b.Loop() {
+			m := NewHashMap[int, int]()
+			for i := range benchHashMapSize {
+				m.Put(i, i)
+			}
+		}
+	})
+
+	b.Run("BuiltinMap", func(b *testing.B) {
+		for b.Loop() {
+			m := map[int]int{}
+			for i := range benchHashMapSize {
+				m[i] = i
+			}
+		}
+	})
+}
+
+// BenchmarkHashMap_Get measures successful lookups in a full map.
+//
+// Pattern: [Get(i)] × 100,000
+// Expected winner: BuiltinMap (~1.3x faster; its specialized int hashing
+// and group probing beat a generic hash call plus a linear scan)
+func BenchmarkHashMap_Get(b *testing.B) {
+	b.Run("HashMap", func(b *testing.B) {
+		m := NewHashMap[int, int]()
+		for i := range benchHashMapSize {
+			m.Put(i, i)
+		}
+		b.ResetTimer()
+		for b.Loop() {
+			for i := range benchHashMapSize {
+				m.Get(i)
+			}
+		}
+	})
+
+	b.Run("BuiltinMap", func(b *testing.B) {
+		m := map[int]int{}
+		for i := range benchHashMapSize {
+			m[i] = i
+		}
+		b.ResetTimer()
+		for b.Loop() {
+			for i := range benchHashMapSize {
+				_ = m[i]
+			}
+		}
+	})
+}
+
+// BenchmarkHashMap_Iterate measures a full iteration over all pairs.
+//
+// Pattern: range over 100,000 pairs
+// Expected winner: BuiltinMap (~1.8x faster, despite HashMap scanning two
+// flat arrays; the yield callback costs more than the runtime iterator)
+func BenchmarkHashMap_Iterate(b *testing.B) {
+	b.Run("HashMap", func(b *testing.B) {
+		m := NewHashMap[int, int]()
+		for i := range benchHashMapSize {
+			m.Put(i, i)
+		}
+		b.ResetTimer()
+		for b.Loop() {
+			sum := 0
+			for _, v := range m.All() {
+				sum += v
+			}
+			_ = sum
+		}
+	})
+
+	b.Run("BuiltinMap", func(b *testing.B) {
+		m := map[int]int{}
+		for i := range benchHashMapSize {
+			m[i] = i
+		}
+		b.ResetTimer()
+		for b.Loop() {
+			sum := 0
+			for _, v := range m {
+				sum += v
+			}
+			_ = sum
+		}
+	})
+}
+
+// BenchmarkHashMap_TotalMemory measures the heap retained by each map
+// holding the same pairs.
+// Reports the custom metric "retained-KB".
+//
+// Pattern: Put 100,000 int pairs
+// Expected winner: BuiltinMap (~2x smaller; 100,000 pairs just passed
+// HashMap's 75% threshold, leaving its doubled table 38% full)
+func BenchmarkHashMap_TotalMemory(b *testing.B) {
+	builders := map[string]func() any{
+		"HashMap": func() any {
+			m := NewHashMap[int, int]()
+			for i := range benchHashMapSize {
+				m.Put(i, i)
+			}
+			return m
+		},
+		"BuiltinMap": func() any {
+			m := map[int]int{}
+			for i := range benchHashMapSize {
+				m[i] = i
+			}
+			return m
+		},
+	}
+
+	for name, build := range builders {
+		b.Run(name, func(b *testing.B) {
+			var m any
+			var retained int
+			for b.Loop() {
+				memory := bench.TrackMemory()
+				m = build()
+				retained = memory.Retained()
+			}
+
+			runtime.KeepAlive(m)
+			bench.ReportRetained(b, retained)
+		})
+	}
+}
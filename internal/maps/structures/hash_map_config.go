@@ -0,0 +1,56 @@
+package structures
+
+import "github.com/apotourlyan/godatastructures/internal/hash"
+
+// Compile-time interface verifications
+var _ HashMapOption[int] = HasherOption[int]{}
+var _ RobinHoodMapOption[int] = HasherOption[int]{}
+
+// HashMapOption configures a HashMap created with NewHashMapWith. Options
+// are applied in order.
+//
+// Like the options of RobinHoodMap, HashMapOption is generic in the key
+// type, so that a hasher for another key type fails to compile.
+type HashMapOption[K comparable] interface {
+	applyHashMap(s *hashedMapSettings[K])
+}
+
+// HasherOption selects the hash function of a hashed map. It is both a
+// HashMapOption and a RobinHoodMapOption; see WithHasher.
+type HasherOption[K comparable] struct {
+	hasher hash.Hasher[K]
+}
+
+// WithHasher hashes keys with the given hasher instead of the default
+// maphash-based one, such as a faster one for the key type or one that
+// matches an external partitioning. See hash.Hasher for the requirements.
+//
+// Example:
+//
+//	m := NewHashMapWith[int, string](WithHasher(hash.NewInteger[int]()))
+func WithHasher[K comparable](hasher hash.Hasher[K]) HasherOption[K] {
+	return HasherOption[K]{hasher: hasher}
+}
+
+func (o HasherOption[K]) applyHashMap(s *hashedMapSettings[K]) {
+	s.hasher = o.hasher
+}
+
+func (o HasherOption[K]) applyRobinHoodMap(s *hashedMapSettings[K]) {
+	s.hasher = o.hasher
+}
+
+// Settings collected from the options of a hashed map.
+type hashedMapSettings[K comparable] struct {
+	config RobinHoodMapConfig // Ignored by HashMap
+	hasher hash.Hasher[K]     // nil selects the default
+}
+
+// Returns the hasher selected by the options, or a new default one.
+func (s hashedMapSettings[K]) hasherOrDefault() hash.Hasher[K] {
+	if s.hasher == nil {
+		return hash.NewComparable[K]()
+	}
+
+	return s.hasher
+}
@@ -0,0 +1,351 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewHashMap/NewHashMapWithHasher):
+  ✓ Empty map
+  ✓ Custom hasher, all keys colliding
+
+Put/Get/Contains/Delete:
+  ✓ Get from empty map
+  ✓ Put new keys, growth past the initial capacity
+  ✓ Put existing key replaces value
+  ✓ Delete present and absent keys
+
+Tombstones:
+  ✓ Deleted keys keep probe chains intact
+  ✓ Tombstones are reused and purged without growing the table
+  ✓ Tombstones before an empty slot are cleared
+
+All:
+  ✓ Yields every pair once, early termination
+
+CheckInvariants:
+  ✓ Valid maps, wrong counters, unreachable key, overload
+
+Randomized:
+  ✓ Mixed operations match the built-in map, counters stay consistent
+
+Binary encoding:
+  ✓ Round trip replaces the pairs, invalid data rejected
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the map unchanged
+
+Clear:
+  ✓ Removes pairs and tombstones, keeps the slots
+
+Options (NewHashMapWith):
+  ✓ No options selects the default hasher
+  ✓ WithHasher, all keys colliding
+*/
+
+import (
+	"maps"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/hash"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty map
+func TestHashMap_NewHashMap_Empty(t *testing.T) {
+	m := NewHashMap[string, int]()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+}
+
+// Verifies a map with a custom hasher, one that sends every key to the
+// same home slot
+func TestHashMap_NewHashMapWithHasher(t *testing.T) {
+	m := NewHashMapWithHasher[int, int](hash.Func[int](func(int) uint64 { return 7 }))
+	for i := range 20 {
+		m.Put(i, i*10)
+	}
+	m.Delete(5)
+	test.GotWantNoError(t, m.CheckInvariants())
+
+	test.GotWant(t, m.Size(), 19)
+	for i := range 20 {
+		v, ok := m.Get(i)
+		test.GotWant(t, ok, i != 5)
+		if ok {
+			test.GotWant(t, v, i*10)
+		}
+	}
+}
+
+// Verifies getting from an empty map
+func TestHashMap_Get_EmptyMap(t *testing.T) {
+	m := NewHashMap[string, int]()
+	v, ok := m.Get("a")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, 0)
+	test.GotWant(t, m.Contains("a"), false)
+}
+
+// Verifies putting new keys well past the initial capacity
+func TestHashMap_Put_NewKeys(t *testing.T) {
+	m := NewHashMap[int, int]()
+	for i := range 1000 {
+		test.GotWant(t, m.Put(i, i*2), true)
+	}
+	test.GotWantNoError(t, m.CheckInvariants())
+	test.GotWant(t, m.Size(), 1000)
+
+	for i := range 1000 {
+		v, ok := m.Get(i)
+		test.GotWant(t, ok, true)
+		test.GotWant(t, v, i*2)
+	}
+	test.GotWant(t, m.Contains(1000), false)
+}
+
+// Verifies putting an existing key replaces its value
+func TestHashMap_Put_ExistingKey(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	test.GotWant(t, m.Put("a", 2), false)
+	test.GotWant(t, m.Size(), 1)
+
+	v, _ := m.Get("a")
+	test.GotWant(t, v, 2)
+}
+
+// Verifies deleting present and absent keys
+func TestHashMap_Delete(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	test.GotWant(t, m.Delete("c"), false)
+	test.GotWant(t, m.Delete("a"), true)
+	test.GotWant(t, m.Delete("a"), false)
+	test.GotWant(t, m.Contains("a"), false)
+	test.GotWant(t, m.Contains("b"), true)
+	test.GotWant(t, m.Size(), 1)
+	test.GotWantNoError(t, m.CheckInvariants())
+}
+
+// Verifies keys behind a deleted key in the same probe chain stay
+// reachable
+func TestHashMap_Tombstones_ProbeChain(t *testing.T) {
+	m := NewHashMap[int, int]()
+
+	// Collect keys sharing one home slot to build a probe chain
+	chain := []int{}
+	home := m.position(0)
+	for k := 0; len(chain) < 4; k++ {
+		if m.position(k) == home {
+			chain = append(chain, k)
+		}
+	}
+	for _, k := range chain {
+		m.Put(k, k)
+	}
+
+	m.Delete(chain[1])
+	test.GotWantNoError(t, m.CheckInvariants())
+	for _, k := range []int{chain[0], chain[2], chain[3]} {
+		test.GotWant(t, m.Contains(k), true)
+	}
+	test.GotWant(t, m.tombstones, 1)
+
+	// Reinserting reuses the tombstone
+	m.Put(chain[1], -1)
+	test.GotWant(t, m.tombstones, 0)
+	test.GotWantNoError(t, m.CheckInvariants())
+}
+
+// Verifies churn with a steady number of keys purges tombstones without
+// growing the table further
+func TestHashMap_Tombstones_Churn(t *testing.T) {
+	m := NewHashMap[int, int]()
+	for i := range 10 {
+		m.Put(i, i)
+	}
+	for i := 10; i < 1000; i++ {
+		m.Delete(i - 10)
+		m.Put(i, i)
+	}
+	capacity := len(m.states)
+
+	for i := 1000; i < 100_000; i++ {
+		m.Delete(i - 10)
+		m.Put(i, i)
+	}
+	test.GotWantNoError(t, m.CheckInvariants())
+	test.GotWant(t, m.Size(), 10)
+	test.GotWant(t, len(m.states), capacity)
+}
+
+// Verifies deleting the last key of a chain clears its tombstones
+func TestHashMap_Tombstones_ClearedBeforeEmpty(t *testing.T) {
+	m := NewHashMap[int, int]()
+	for i := range 5 {
+		m.Put(i, i)
+	}
+	for i := range 5 {
+		m.Delete(i)
+	}
+	test.GotWant(t, m.tombstones, 0)
+	test.GotWantNoError(t, m.CheckInvariants())
+}
+
+// Verifies All yields every pair once and stops when the consumer breaks
+func TestHashMap_All(t *testing.T) {
+	m := NewHashMap[int, string]()
+	want := map[int]string{}
+	for i := range 100 {
+		m.Put(i, string(rune('a'+i%26)))
+		want[i] = string(rune('a' + i%26))
+	}
+	test.GotWant(t, maps.Equal(maps.Collect(m.All()), want), true)
+
+	count := 0
+	for range m.All() {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	test.GotWant(t, count, 3)
+}
+
+// Verifies valid maps pass and corrupted counters, slots and loads are
+// reported
+func TestHashMap_CheckInvariants(t *testing.T) {
+	identity := hash.Func[int](func(k int) uint64 { return uint64(k) })
+	m := NewHashMapWith[int, int](WithHasher(identity))
+	test.GotWantNoError(t, m.CheckInvariants())
+	for i := range 5 {
+		m.Put(i, i) // Key i lands in slot i
+	}
+	m.Delete(4)
+	test.GotWantNoError(t, m.CheckInvariants())
+
+	m.size = 5
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: size is 5, table holds 4 pairs")
+	m.size = 4
+
+	m.tombstones = 1
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: tombstone count is 1, table holds 0")
+	m.tombstones = 0
+
+	m.states[1], m.states[5] = slotEmpty, slotOccupied
+	m.pairs[5] = m.pairs[1]
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: key in slot 5 is not reachable from its home slot")
+	m.states[1], m.states[5] = slotOccupied, slotEmpty
+
+	for _, i := range []int{4, 5, 6} {
+		m.states[i] = slotDeleted
+		m.tombstones++
+	}
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: 7 of 8 slots in use, over 75%")
+}
+
+// Verifies random puts and deletes match the built-in map
+func TestHashMap_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(9, 10))
+	m := NewHashMap[int, int]()
+	model := map[int]int{}
+
+	for i := range 20_000 {
+		k := r.IntN(500)
+		switch r.IntN(3) {
+		case 0:
+			_, exists := model[k]
+			test.GotWant(t, m.Delete(k), exists)
+			delete(model, k)
+		case 1:
+			_, exists := model[k]
+			test.GotWant(t, m.Put(k, i), !exists)
+			model[k] = i
+		case 2:
+			got, ok := m.Get(k)
+			want, exists := model[k]
+			test.GotWant(t, ok, exists)
+			test.GotWant(t, got, want)
+		}
+		if i%1000 == 0 {
+			test.GotWantNoError(t, m.CheckInvariants())
+		}
+	}
+
+	test.GotWantNoError(t, m.CheckInvariants())
+	test.GotWant(t, maps.Equal(maps.Collect(m.All()), model), true)
+}
+
+// Verifies a round trip replaces the pairs of the receiving map and
+// invalid data is rejected
+func TestHashMap_MarshalBinary(t *testing.T) {
+	src := NewHashMap[string, int]()
+	for i, k := range []string{"a", "b", "c"} {
+		src.Put(k, i)
+	}
+	other, _ := NewSkipListMap[string, int]().MarshalBinary()
+	dst := NewHashMap[string, int]()
+	dst.Put("z", 26)
+	codectest.RoundTrip(t, src, dst,
+		func(m *HashMap[string, int]) map[string]int { return maps.Collect(m.All()) }, other)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the pairs
+func TestHashMap_WriteTo_ReadFrom(t *testing.T) {
+	src := NewHashMap[int, string]()
+	src.Put(1, "a")
+	src.Put(2, "b")
+	dst := NewHashMap[int, string]()
+	dst.Put(9, "z")
+	codectest.StreamRoundTrip(t, src, dst,
+		func(m *HashMap[int, string]) map[int]string { return maps.Collect(m.All()) })
+}
+
+// Verifies Clear empties the map, drops tombstones and keeps the table
+func TestHashMap_Clear(t *testing.T) {
+	m := NewHashMap[int, int]()
+	for i := range 100 {
+		m.Put(i, i)
+	}
+	for i := range 50 {
+		m.Delete(i)
+	}
+	capacity := len(m.states)
+
+	m.Clear()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+	test.GotWant(t, m.tombstones, 0)
+	test.GotWant(t, m.Contains(75), false)
+	test.GotWant(t, len(maps.Collect(m.All())), 0)
+	test.GotWant(t, len(m.states), capacity)
+
+	test.GotWant(t, m.Put(75, 1), true)
+	v, ok := m.Get(75)
+	test.GotWant(t, v, 1)
+	test.GotWant(t, ok, true)
+}
+
+// Verifies no options selects the default hasher
+func TestHashMap_NewHashMapWith_Defaults(t *testing.T) {
+	m := NewHashMapWith[string, int]()
+	_, ok := m.hasher.(hash.Comparable[string])
+	test.GotWant(t, ok, true)
+	test.GotWant(t, m.IsEmpty(), true)
+}
+
+// Verifies WithHasher replaces the hash function, here with one that
+// sends every key to the same home slot
+func TestHashMap_NewHashMapWith_Hasher(t *testing.T) {
+	m := NewHashMapWith[int, int](WithHasher(hash.Func[int](func(int) uint64 { return 3 })))
+	for i := range 20 {
+		m.Put(i, i)
+	}
+	test.GotWantNoError(t, m.CheckInvariants())
+	test.GotWant(t, m.position(0), 3)
+	test.GotWant(t, m.position(19), 3)
+}
@@ -0,0 +1,347 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	lists "github.com/apotourlyan/godatastructures/internal/lists/structures"
+)
+
+// Compile-time interface verifications
+var _ Map[int, int] = &LinkedHashMap[int, int]{}
+var _ collections.Collection[int] = &LinkedHashMap[int, int]{}
+
+// Represents a single pair in a LinkedHashMap.
+type linkedHashEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LinkedHashMap implements a hash map with a predictable iteration order.
+//
+// Every pair lives in a DoublyLinkedList that records the iteration order:
+// insertion order by default, or least to most recently used in
+// access-order mode (see LinkedHashMapConfig). A built-in map from keys to
+// list nodes gives O(1) lookups. Both orders expose the front of the list
+// through Oldest and RemoveOldest, so an LRU cache is a LinkedHashMap in
+// access order that calls RemoveOldest when it grows past its capacity.
+//
+// Design decisions:
+//   - Map of list nodes: Lookups find the entry and its list position at once
+//   - Sentinel list: O(1) access, removal and reordering at both ends
+//   - Peek: Reads without disturbing access order
+//
+// Space complexity: O(n) where n is the number of pairs.
+type LinkedHashMap[K comparable, V any] struct {
+	entries map[K]*lists.DoublyLinkedListNode[linkedHashEntry[K, V]]
+	order   lists.DoublyLinkedList[linkedHashEntry[K, V]]
+	config  LinkedHashMapConfig
+}
+
+// NewLinkedHashMap creates an empty linked hash map in insertion order.
+//
+// Example:
+//
+//	m := NewLinkedHashMap[string, int]()
+//	m.Put("b", 2)
+//	m.Put("a", 1)
+//	for k, v := range m.All() {
+//	    fmt.Println(k, v)  // "b" 2, then "a" 1
+//	}
+func NewLinkedHashMap[K comparable, V any]() *LinkedHashMap[K, V] {
+	return newLinkedHashMap[K, V](LinkedHashMapConfig{})
+}
+
+// NewLinkedHashMapWith creates an empty linked hash map from the default
+// configuration adjusted by the given options. Options are applied in
+// order.
+//
+// Example:
+//
+//	m := NewLinkedHashMapWith[string, int](WithAccessOrder(true))
+//	m.Put("a", 1)
+//	m.Put("b", 2)
+//	m.Get("a")
+//	m.Oldest()  // Returns "b", 2, true
+func NewLinkedHashMapWith[K comparable, V any](opts ...LinkedHashMapOption) *LinkedHashMap[K, V] {
+	var c LinkedHashMapConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return newLinkedHashMap[K, V](c)
+}
+
+// NewLinkedHashMapWithConfig creates an empty linked hash map with the
+// given iteration order. See LinkedHashMapConfig for the options.
+//
+// Deprecated: Use NewLinkedHashMapWith with WithAccessOrder.
+func NewLinkedHashMapWithConfig[K comparable, V any](config LinkedHashMapConfig) *LinkedHashMap[K, V] {
+	return newLinkedHashMap[K, V](config)
+}
+
+// Creates an empty linked hash map with the given iteration order.
+func newLinkedHashMap[K comparable, V any](config LinkedHashMapConfig) *LinkedHashMap[K, V] {
+	return &LinkedHashMap[K, V]{
+		entries: make(map[K]*lists.DoublyLinkedListNode[linkedHashEntry[K, V]]),
+		config:  config,
+	}
+}
+
+// Put associates the value with the key. A new key is added at the back;
+// an existing key keeps its position unless the map is in access order.
+// Returns true if the key was added, false if an existing value was replaced.
+//
+// Time complexity: O(1) expected
+func (m *LinkedHashMap[K, V]) Put(key K, value V) bool {
+	if n, ok := m.entries[key]; ok {
+		n.Value.value = value
+		m.touch(n)
+		return false
+	}
+
+	m.entries[key] = m.order.PushBack(linkedHashEntry[K, V]{key: key, value: value})
+	return true
+}
+
+// Get returns the value associated with the key, moving the pair to the
+// back if the map is in access order.
+// Returns false if the key is not present.
+//
+// Time complexity: O(1) expected
+func (m *LinkedHashMap[K, V]) Get(key K) (V, bool) {
+	n, ok := m.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	m.touch(n)
+	return n.Value.value, true
+}
+
+// Peek returns the value associated with the key without changing the
+// iteration order.
+// Returns false if the key is not present.
+//
+// Time complexity: O(1) expected
+func (m *LinkedHashMap[K, V]) Peek(key K) (V, bool) {
+	if n, ok := m.entries[key]; ok {
+		return n.Value.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Clear removes all pairs. The iteration order setting is kept.
+//
+// Time complexity: O(n)
+func (m *LinkedHashMap[K, V]) Clear() {
+	clear(m.entries)
+	m.order.Clear()
+}
+
+// Contains returns true if the key is present.
+// Never changes the iteration order.
+//
+// Time complexity: O(1) expected
+func (m *LinkedHashMap[K, V]) Contains(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// Delete removes the key and its value.
+// Returns true if the key was found and removed, false otherwise.
+//
+// Time complexity: O(1) expected
+func (m *LinkedHashMap[K, V]) Delete(key K) bool {
+	n, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	delete(m.entries, key)
+	m.order.Remove(n)
+	return true
+}
+
+// Oldest returns the pair at the front of the iteration order: the
+// earliest inserted, or the least recently used in access order.
+// Returns false if the map is empty.
+//
+// Time complexity: O(1)
+func (m *LinkedHashMap[K, V]) Oldest() (K, V, bool) {
+	front := m.order.Front()
+	if front == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+
+	return front.Value.key, front.Value.value, true
+}
+
+// RemoveOldest removes and returns the pair at the front of the iteration
+// order. Returns false if the map is empty.
+//
+// Time complexity: O(1) expected
+//
+// Example:
+//
+//	// Evict the least recently used pair once over capacity
+//	if m.Size() > capacity {
+//	    m.RemoveOldest()
+//	}
+func (m *LinkedHashMap[K, V]) RemoveOldest() (K, V, bool) {
+	key, value, ok := m.Oldest()
+	if ok {
+		m.Delete(key)
+	}
+
+	return key, value, ok
+}
+
+// All returns an iterator over all key-value pairs from the front to the
+// back of the iteration order. The map must not be modified during
+// iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (m *LinkedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range m.order.All() {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all key-value pairs from the back to
+// the front of the iteration order. The map must not be modified during
+// iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (m *LinkedHashMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range m.order.Backward() {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// pairs from front to back in the format of package codec, so the
+// iteration order survives a round trip.
+// Returns an error wrapping codec.ErrUnsupportedType if K or V cannot be
+// encoded.
+//
+// Time complexity: O(n)
+func (m *LinkedHashMap[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq2("LinkedHashMap", len(m.entries), m.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// pairs of the map with those encoded by MarshalBinary, in their encoded
+// order, keeping the configuration.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded map, in which case the map is left unchanged.
+//
+// Time complexity: O(n) expected
+func (m *LinkedHashMap[K, V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := codec.DecodeSlice2[K, V](data, "LinkedHashMap")
+	if err != nil {
+		return err
+	}
+
+	m.restore(keys, values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (m *LinkedHashMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq2(w, "LinkedHashMap", len(m.entries), m.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the pairs of the map
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The map is left
+// unchanged on error.
+//
+// Time complexity: O(n) expected
+func (m *LinkedHashMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	keys, values, n, err := codec.ReadSlice2[K, V](r, "LinkedHashMap")
+	if err != nil {
+		return n, err
+	}
+
+	m.restore(keys, values)
+	return n, nil
+}
+
+// IsEmpty returns true if the map contains no pairs.
+//
+// Time complexity: O(1)
+func (m *LinkedHashMap[K, V]) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+// Size returns the number of pairs currently in the map.
+//
+// Time complexity: O(1)
+func (m *LinkedHashMap[K, V]) Size() int {
+	return len(m.entries)
+}
+
+// CheckInvariants verifies the internal consistency of the map: the order
+// list is itself consistent, holds as many nodes as the key index, and
+// every node is the one indexed under its key. Intended for tests and
+// debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the map is consistent.
+//
+// Time complexity: O(n)
+func (m *LinkedHashMap[K, V]) CheckInvariants() error {
+	if err := m.order.CheckInvariants(); err != nil {
+		return fmt.Errorf("%w: order list: %w", ErrInvariantViolation, err)
+	}
+	if m.order.Size() != len(m.entries) {
+		return fmt.Errorf("%w: order list holds %d nodes, index holds %d keys", ErrInvariantViolation, m.order.Size(), len(m.entries))
+	}
+
+	i := 0
+	for n := m.order.Front(); n != nil; n = n.Next() {
+		if m.entries[n.Value.key] != n {
+			return fmt.Errorf("%w: node %d of the order list is not indexed under its key", ErrInvariantViolation, i)
+		}
+		i++
+	}
+
+	return nil
+}
+
+// Moves an accessed node to the back when the map is in access order.
+func (m *LinkedHashMap[K, V]) touch(n *lists.DoublyLinkedListNode[linkedHashEntry[K, V]]) {
+	if m.config.AccessOrder {
+		m.order.MoveToBack(n)
+	}
+}
+
+// Replaces the pairs with the decoded ones, in their decoded order.
+func (m *LinkedHashMap[K, V]) restore(keys []K, values []V) {
+	m.entries = make(map[K]*lists.DoublyLinkedListNode[linkedHashEntry[K, V]], len(keys))
+	m.order.Clear()
+	for i, k := range keys {
+		m.Put(k, values[i])
+	}
+}
@@ -0,0 +1,37 @@
+package structures
+
+// LinkedHashMapConfig controls the iteration order of a LinkedHashMap.
+//
+// The map supports two orders:
+//
+// Insertion order (default):
+//
+// Pairs iterate in the order their keys were first added. Replacing the
+// value of an existing key keeps its position; deleting and re-adding a
+// key moves it to the back.
+//
+// Access order:
+//
+// Every successful Get or Put moves the pair to the back, so iteration
+// runs from the least to the most recently used pair. Combined with
+// Oldest and RemoveOldest this is the bookkeeping an LRU cache needs.
+// Contains and Peek never change the order.
+type LinkedHashMapConfig struct {
+	// AccessOrder moves pairs to the back on Get and Put.
+	//
+	// When disabled, iteration follows insertion order.
+	AccessOrder bool
+}
+
+// LinkedHashMapOption configures a LinkedHashMap created with
+// NewLinkedHashMapWith. Options are applied in order on top of the default
+// configuration.
+type LinkedHashMapOption func(*LinkedHashMapConfig)
+
+// WithAccessOrder selects access order instead of insertion order.
+// See LinkedHashMapConfig.AccessOrder.
+func WithAccessOrder(enabled bool) LinkedHashMapOption {
+	return func(c *LinkedHashMapConfig) {
+		c.AccessOrder = enabled
+	}
+}
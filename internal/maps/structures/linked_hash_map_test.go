@@ -0,0 +1,384 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewLinkedHashMap/NewLinkedHashMapWithConfig):
+  ✓ Empty map
+
+Put/Get/Peek/Contains/Delete:
+  ✓ Get from empty map
+  ✓ Put new keys, replace existing values
+  ✓ Delete present and absent keys, head, middle and tail
+
+Insertion order:
+  ✓ Replacing a value keeps its position
+  ✓ Deleting and re-adding moves to the back
+  ✓ Get does not reorder
+
+Access order:
+  ✓ Get and Put move to the back
+  ✓ Peek and Contains do not reorder
+
+Oldest/RemoveOldest:
+  ✓ Empty map
+  ✓ LRU eviction
+
+All/Backward:
+  ✓ Both directions, early termination
+
+CheckInvariants:
+  ✓ Valid maps, index out of step with the order list
+
+Randomized:
+  ✓ Order and contents match a slice model in both modes
+
+Options (NewLinkedHashMapWith):
+  ✓ No options keeps insertion order
+  ✓ WithAccessOrder moves accessed keys to the back
+
+Binary encoding:
+  ✓ Round trip preserves the iteration order, invalid data rejected
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the map unchanged
+
+Forged streams:
+  ✓ Count beyond the int range rejected by ReadFrom
+
+Clear:
+  ✓ Removes pairs, later pairs start a new order
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/pairs"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the list links agree in both directions and with the entry map.
+func checkLinkedHashMap[K comparable, V any](t *testing.T, m *LinkedHashMap[K, V]) {
+	t.Helper()
+	count := 0
+	for n := m.order.Front(); n != nil; n = n.Next() {
+		count++
+		if next := n.Next(); next != nil && next.Prev() != n {
+			t.Errorf("entry %v has a broken next link", n.Value.key)
+		}
+		if m.entries[n.Value.key] != n {
+			t.Errorf("entry %v is not the mapped entry", n.Value.key)
+		}
+	}
+	test.GotWant(t, count, len(m.entries))
+	test.GotWant(t, m.order.Size(), len(m.entries))
+}
+
+// Returns the keys in iteration order.
+func linkedKeys[K comparable, V any](m *LinkedHashMap[K, V]) []K {
+	keys := []K{}
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Verifies the creation of an empty map
+func TestLinkedHashMap_NewLinkedHashMap_Empty(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+	checkLinkedHashMap(t, m)
+}
+
+// Verifies getting from an empty map
+func TestLinkedHashMap_Get_EmptyMap(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	v, ok := m.Get("a")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, 0)
+	v, ok = m.Peek("a")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, 0)
+	test.GotWant(t, m.Contains("a"), false)
+}
+
+// Verifies putting new keys and replacing values
+func TestLinkedHashMap_Put(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	test.GotWant(t, m.Put("a", 1), true)
+	test.GotWant(t, m.Put("b", 2), true)
+	test.GotWant(t, m.Put("a", 3), false)
+	test.GotWant(t, m.Size(), 2)
+
+	v, ok := m.Get("a")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 3)
+	checkLinkedHashMap(t, m)
+}
+
+// Verifies deleting present and absent keys at every list position
+func TestLinkedHashMap_Delete(t *testing.T) {
+	m := NewLinkedHashMap[int, int]()
+	for i := range 5 {
+		m.Put(i, i)
+	}
+
+	test.GotWant(t, m.Delete(9), false)
+	test.GotWant(t, m.Delete(2), true)
+	test.GotWant(t, m.Delete(0), true)
+	test.GotWant(t, m.Delete(4), true)
+	test.GotWant(t, m.Delete(4), false)
+	checkLinkedHashMap(t, m)
+	test.GotWantSlice(t, linkedKeys(m), []int{1, 3})
+}
+
+// Verifies insertion order is kept on replace and reset on re-add
+func TestLinkedHashMap_InsertionOrder(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("c", 1)
+	m.Put("a", 2)
+	m.Put("b", 3)
+
+	m.Put("c", 4)
+	m.Get("c")
+	test.GotWantSlice(t, linkedKeys(m), []string{"c", "a", "b"})
+
+	m.Delete("c")
+	m.Put("c", 5)
+	test.GotWantSlice(t, linkedKeys(m), []string{"a", "b", "c"})
+	checkLinkedHashMap(t, m)
+}
+
+// Verifies Get and Put move pairs to the back in access order, while Peek
+// and Contains do not
+func TestLinkedHashMap_AccessOrder(t *testing.T) {
+	m := NewLinkedHashMapWithConfig[string, int](LinkedHashMapConfig{AccessOrder: true})
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	m.Get("a")
+	test.GotWantSlice(t, linkedKeys(m), []string{"b", "c", "a"})
+
+	m.Put("b", 4)
+	test.GotWantSlice(t, linkedKeys(m), []string{"c", "a", "b"})
+
+	m.Peek("c")
+	m.Contains("c")
+	m.Get("missing")
+	test.GotWantSlice(t, linkedKeys(m), []string{"c", "a", "b"})
+	checkLinkedHashMap(t, m)
+}
+
+// Verifies Oldest and RemoveOldest on an empty map
+func TestLinkedHashMap_Oldest_EmptyMap(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	_, _, ok := m.Oldest()
+	test.GotWant(t, ok, false)
+	_, _, ok = m.RemoveOldest()
+	test.GotWant(t, ok, false)
+}
+
+// Verifies an access-ordered map with RemoveOldest evicts the least
+// recently used pair
+func TestLinkedHashMap_RemoveOldest_LRU(t *testing.T) {
+	const capacity = 3
+	m := NewLinkedHashMapWithConfig[string, int](LinkedHashMapConfig{AccessOrder: true})
+	put := func(k string, v int) {
+		m.Put(k, v)
+		if m.Size() > capacity {
+			m.RemoveOldest()
+		}
+	}
+
+	put("a", 1)
+	put("b", 2)
+	put("c", 3)
+	m.Get("a")
+	put("d", 4) // Evicts "b"
+
+	test.GotWant(t, m.Contains("b"), false)
+	k, v, ok := m.Oldest()
+	test.GotWant(t, ok, true)
+	test.GotWant(t, k, "c")
+	test.GotWant(t, v, 3)
+
+	k, _, _ = m.RemoveOldest()
+	test.GotWant(t, k, "c")
+	test.GotWantSlice(t, linkedKeys(m), []string{"a", "d"})
+	checkLinkedHashMap(t, m)
+}
+
+// Verifies All and Backward order and early termination
+func TestLinkedHashMap_All(t *testing.T) {
+	m := NewLinkedHashMap[int, int]()
+	for _, k := range []int{3, 1, 2} {
+		m.Put(k, k*10)
+	}
+
+	backward := []int{}
+	for k, v := range m.Backward() {
+		test.GotWant(t, v, k*10)
+		backward = append(backward, k)
+	}
+	test.GotWantSlice(t, linkedKeys(m), []int{3, 1, 2})
+	test.GotWantSlice(t, backward, []int{2, 1, 3})
+
+	count := 0
+	for range m.All() {
+		count++
+		break
+	}
+	for range m.Backward() {
+		count++
+		break
+	}
+	test.GotWant(t, count, 2)
+}
+
+// Verifies valid maps pass and an index that disagrees with the order
+// list is reported
+func TestLinkedHashMap_CheckInvariants(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	test.GotWantNoError(t, m.CheckInvariants())
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	test.GotWantNoError(t, m.CheckInvariants())
+
+	a := m.entries["a"]
+	delete(m.entries, "a")
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: order list holds 3 nodes, index holds 2 keys")
+
+	m.entries["a"] = m.entries["b"]
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: node 0 of the order list is not indexed under its key")
+
+	m.entries["a"] = a
+	test.GotWantNoError(t, m.CheckInvariants())
+}
+
+// Verifies random operations keep order and contents in line with a slice
+// model, in both orders
+func TestLinkedHashMap_Randomized(t *testing.T) {
+	for _, accessOrder := range []bool{false, true} {
+		m := NewLinkedHashMapWithConfig[int, int](LinkedHashMapConfig{AccessOrder: accessOrder})
+		order := []int{}
+		values := map[int]int{}
+		moveToBack := func(k int) {
+			i := slices.Index(order, k)
+			order = append(slices.Delete(order, i, i+1), k)
+		}
+
+		for i := range 3000 {
+			k := (i * 7919) % 61
+			switch i % 4 {
+			case 0, 1:
+				if _, exists := values[k]; !exists {
+					order = append(order, k)
+				} else if accessOrder {
+					moveToBack(k)
+				}
+				values[k] = i
+				m.Put(k, i)
+			case 2:
+				if _, exists := values[k]; exists {
+					i := slices.Index(order, k)
+					order = slices.Delete(order, i, i+1)
+					delete(values, k)
+				}
+				m.Delete(k)
+			case 3:
+				got, ok := m.Get(k)
+				want, exists := values[k]
+				test.GotWant(t, ok, exists)
+				test.GotWant(t, got, want)
+				if exists && accessOrder {
+					moveToBack(k)
+				}
+			}
+		}
+
+		checkLinkedHashMap(t, m)
+		test.GotWantSlice(t, linkedKeys(m), order)
+	}
+}
+
+// Verifies no options keeps insertion order
+func TestLinkedHashMap_NewLinkedHashMapWith_Defaults(t *testing.T) {
+	m := NewLinkedHashMapWith[string, int]()
+	test.GotWant(t, m.config, LinkedHashMapConfig{})
+}
+
+// Verifies WithAccessOrder moves accessed keys to the back
+func TestLinkedHashMap_NewLinkedHashMapWith_AccessOrder(t *testing.T) {
+	m := NewLinkedHashMapWith[string, int](WithAccessOrder(true))
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Get("a")
+
+	k, _, _ := m.Oldest()
+	test.GotWant(t, k, "b")
+}
+
+// Verifies a round trip preserves the pairs in iteration order and
+// invalid data is rejected
+func TestLinkedHashMap_MarshalBinary(t *testing.T) {
+	src := NewLinkedHashMap[string, int]()
+	src.Put("b", 2)
+	src.Put("a", 1)
+	src.Put("c", 3)
+	other, _ := NewHashMap[string, int]().MarshalBinary()
+	dst := NewLinkedHashMap[string, int]()
+	dst.Put("z", 26)
+	codectest.RoundTrip(t, src, dst,
+		func(m *LinkedHashMap[string, int]) []pairs.Pair[string, int] { return pairs.Collect(m.All()) }, other)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the pairs in iteration order
+func TestLinkedHashMap_WriteTo_ReadFrom(t *testing.T) {
+	src := NewLinkedHashMap[int, string]()
+	src.Put(1, "a")
+	src.Put(2, "b")
+	dst := NewLinkedHashMap[int, string]()
+	dst.Put(9, "z")
+	codectest.StreamRoundTrip(t, src, dst,
+		func(m *LinkedHashMap[int, string]) []pairs.Pair[int, string] { return pairs.Collect(m.All()) })
+}
+
+// Verifies ReadFrom rejects a header whose count does not fit an int,
+// leaving the map unchanged
+func TestLinkedHashMap_ReadFrom_CountOverflow(t *testing.T) {
+	data := append([]byte("GDS"), codec.Version)
+	data = binary.AppendUvarint(data, uint64(len("LinkedHashMap")))
+	data = append(data, "LinkedHashMap"...)
+	data = binary.AppendUvarint(data, 1<<63)
+
+	m := NewLinkedHashMap[string, int]()
+	m.Put("a", 1)
+	_, err := m.ReadFrom(bytes.NewReader(data))
+	test.GotWantErrorIs(t, err, codec.ErrInvalidData)
+	test.GotWant(t, m.Size(), 1)
+}
+
+// Verifies Clear empties the map and later pairs are ordered afresh
+func TestLinkedHashMap_Clear(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	m.Clear()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+	test.GotWant(t, m.Contains("a"), false)
+
+	m.Put("c", 3)
+	m.Put("a", 4)
+	test.GotWantSlice(t, linkedKeys(m), []string{"c", "a"})
+}
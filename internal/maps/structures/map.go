@@ -0,0 +1,89 @@
+// Package structures provides generic map data structures and their implementations.
+//
+// Configurable maps are created with NewXWith and options named WithY,
+// applied in order on top of the defaults; the older NewXWithConfig
+// constructors, which take the settings as a struct, are deprecated. The
+// hashed maps, HashMap and RobinHoodMap, share WithHasher, and their
+// options are generic in the key type, so a hasher for other keys fails
+// to compile. There are deliberately no WithComparator, WithThreadSafety
+// or WithOptimization options: the ordered maps order keys by
+// cmp.Ordered, unlike the heaps, which take WithComparator, safety for concurrent use is a property of the
+// implementation (SkipListMap and ExpiringCache have it, the others do
+// not), and every tuning knob is an option of the map it tunes, such as
+// WithMaxLoadPercent or WithAccessOrder.
+package structures
+
+import (
+	"errors"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+const ErrorInvariantViolation = "invariant violation"
+const ErrorKeyNotFound = "key is not in the map"
+
+// ErrKeyNotFound is wrapped when a MapBatch deletes a key the map does not
+// contain.
+// ErrInvariantViolation is wrapped by CheckInvariants.
+var (
+	ErrInvariantViolation = errors.New(ErrorInvariantViolation)
+	ErrKeyNotFound        = errors.New(ErrorKeyNotFound)
+)
+
+// Map defines the interface for a key-value association where every key
+// maps to at most one value.
+//
+// All implementations guarantee:
+//   - Put operations add a new pair or replace the value of an existing key
+//   - Get and Contains operations observe pairs without removal
+//   - Delete operations remove a pair by key
+//   - Size and IsEmpty operations reflect current state
+//
+// Iteration order is implementation-dependent. Thread safety is
+// implementation-dependent. Check specific implementation documentation
+// for ordering and concurrency guarantees.
+type Map[K comparable, V any] interface {
+	collections.Sized
+	collections.Iterable2[K, V]
+
+	// Put associates the value with the key.
+	// Returns true if the key was added, false if an existing value was replaced.
+	Put(key K, value V) bool
+
+	// Get returns the value associated with the key.
+	// Returns false if the key is not present.
+	Get(key K) (V, bool)
+
+	// Contains returns true if the key is present.
+	Contains(key K) bool
+
+	// Delete removes the key and its value.
+	// Returns true if the key was found and removed, false otherwise.
+	Delete(key K) bool
+
+	// All returns an iterator over all key-value pairs.
+	All() iter.Seq2[K, V]
+
+	// IsEmpty returns true if the map contains no pairs.
+	IsEmpty() bool
+
+	// Size returns the number of pairs currently in the map.
+	Size() int
+}
+
+// Implemented by maps whose Get has side effects, such as reordering or
+// counting a use, and that can also look a key up without them.
+type peeker[K comparable, V any] interface {
+	Peek(key K) (V, bool)
+}
+
+// Returns the value associated with the key through Peek if the map has
+// one, otherwise through Get.
+func peek[K comparable, V any](m Map[K, V], key K) (V, bool) {
+	if p, ok := m.(peeker[K, V]); ok {
+		return p.Peek(key)
+	}
+
+	return m.Get(key)
+}
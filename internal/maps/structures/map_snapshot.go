@@ -0,0 +1,67 @@
+package structures
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &MapSnapshot[int, int]{}
+var _ collections.Iterable2[int, int] = &MapSnapshot[int, int]{}
+
+// MapSnapshot is an immutable point-in-time view of the pairs of a map,
+// returned by ExpiringCache.Snapshot and SkipListMap.Snapshot.
+//
+// The pairs are copied when the snapshot is taken, so lookups and
+// iteration take no lock of the map and see the same pairs however it
+// changes afterwards. All methods are safe for concurrent use by multiple
+// goroutines.
+type MapSnapshot[K comparable, V any] struct {
+	pairs map[K]V
+}
+
+// Get returns the value the key was associated with.
+// Returns false if the key was not present.
+//
+// Time complexity: O(1) expected
+func (s *MapSnapshot[K, V]) Get(key K) (V, bool) {
+	v, ok := s.pairs[key]
+	return v, ok
+}
+
+// Contains returns true if the key was present.
+//
+// Time complexity: O(1) expected
+func (s *MapSnapshot[K, V]) Contains(key K) bool {
+	_, ok := s.pairs[key]
+	return ok
+}
+
+// All returns an iterator over the key-value pairs in no particular
+// order.
+//
+// Time complexity: O(n) for a full iteration
+func (s *MapSnapshot[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range s.pairs {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// IsEmpty returns true if the map held no pairs.
+//
+// Time complexity: O(1)
+func (s *MapSnapshot[K, V]) IsEmpty() bool {
+	return len(s.pairs) == 0
+}
+
+// Size returns the number of pairs the map held.
+//
+// Time complexity: O(1)
+func (s *MapSnapshot[K, V]) Size() int {
+	return len(s.pairs)
+}
@@ -0,0 +1,225 @@
+package structures
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// MapTxn stages the mutations made to a map inside UpdateMap, so they can
+// be applied together or not at all.
+//
+// Mutations are kept in the transaction and reads see them, so a step
+// can depend on the result of the previous ones. The map itself is only
+// read, and only with operations free of side effects (see UpdateMap),
+// until the update commits. A MapTxn must not be used after its UpdateMap
+// call returns, and the map must not be modified while the update runs.
+type MapTxn[K comparable, V any] struct {
+	m      Map[K, V]
+	writes map[K]mapWrite[V] // Staged state of every written key
+	seq    int               // Number of writes that staged a new state
+	delta  int               // Size change of the staged writes
+}
+
+// Represents the staged state of a key and the write that added, deleted
+// or first updated it.
+type mapWrite[V any] struct {
+	value   V
+	deleted bool
+	seq     int
+}
+
+// UpdateMap runs fn with a transaction over the map. If fn returns nil,
+// the staged mutations are applied to the map; if it returns an error or
+// panics, the map is left untouched, and the error is returned or the
+// panic continues.
+//
+// Only the net change of every written key is applied: a key put and
+// deleted again is not touched, a key put twice is put once with its last
+// value, and a present key deleted and put again is updated in place,
+// keeping its position in a LinkedHashMap. Keys are applied in the order
+// in which the transaction last added or deleted them, or first updated
+// them, so keys are inserted in the order a sequence of direct calls
+// would have inserted them.
+// Wrappers such as BoundedMap
+// and ObservableMap therefore see committed changes only, and evict for
+// or report them as if the changes had been made directly. Before the
+// commit, reads of keys the transaction has not written use Contains and
+// Peek, or Get on maps without a Peek method, so an access-order
+// LinkedHashMap or a BoundedMap does not record them as uses.
+//
+// Time complexity: That of the reads, plus O(w log w) and the mutations
+// of the w written keys on commit
+//
+// Example:
+//
+//	accounts := NewHashMap[string, int]()
+//	accounts.Put("alice", 100)
+//	err := UpdateMap(accounts, func(tx *MapTxn[string, int]) error {
+//	    balance, _ := tx.Get("alice")
+//	    tx.Put("alice", balance-150)
+//	    tx.Put("bob", 150)
+//	    if balance < 150 {
+//	        return errors.New("insufficient funds")  // Neither put is applied
+//	    }
+//	    return nil
+//	})
+func UpdateMap[K comparable, V any](m Map[K, V], fn func(tx *MapTxn[K, V]) error) error {
+	tx := &MapTxn[K, V]{m: m, writes: map[K]mapWrite[V]{}}
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	tx.commit()
+	return nil
+}
+
+// Put associates the value with the key.
+// Returns true if the key was added, false if an existing value was
+// replaced.
+func (tx *MapTxn[K, V]) Put(key K, value V) bool {
+	added := !tx.Contains(key)
+	if added {
+		tx.delta++
+	}
+
+	tx.stage(key, mapWrite[V]{value: value}, added)
+	return added
+}
+
+// Delete removes the key and its value.
+// Returns true if the key was found and removed, false otherwise.
+func (tx *MapTxn[K, V]) Delete(key K) bool {
+	if !tx.Contains(key) {
+		return false
+	}
+
+	tx.delta--
+	tx.stage(key, mapWrite[V]{deleted: true}, true)
+	return true
+}
+
+// Get returns the value associated with the key, including the changes
+// made by the transaction.
+// Returns false if the key is not present.
+func (tx *MapTxn[K, V]) Get(key K) (V, bool) {
+	if w, ok := tx.writes[key]; ok {
+		return w.value, !w.deleted
+	}
+
+	return peek(tx.m, key)
+}
+
+// Contains returns true if the key is present, including the changes
+// made by the transaction.
+func (tx *MapTxn[K, V]) Contains(key K) bool {
+	if w, ok := tx.writes[key]; ok {
+		return !w.deleted
+	}
+
+	return tx.m.Contains(key)
+}
+
+// Size returns the number of pairs in the map, including the changes
+// made by the transaction. Evictions the commit may cause in a
+// BoundedMap are not anticipated.
+func (tx *MapTxn[K, V]) Size() int {
+	return tx.m.Size() + tx.delta
+}
+
+// Records the state of the key. A write that changes whether the key is
+// present, or the first write of the key, moves it to the end of the
+// commit order.
+func (tx *MapTxn[K, V]) stage(key K, w mapWrite[V], changed bool) {
+	if old, ok := tx.writes[key]; ok && !changed {
+		w.seq = old.seq
+	} else {
+		tx.seq++
+		w.seq = tx.seq
+	}
+
+	tx.writes[key] = w
+}
+
+// Applies the net change of every written key in commit order.
+func (tx *MapTxn[K, V]) commit() {
+	keys := make([]K, 0, len(tx.writes))
+	for key := range tx.writes {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b K) int {
+		return cmp.Compare(tx.writes[a].seq, tx.writes[b].seq)
+	})
+
+	for _, key := range keys {
+		w := tx.writes[key]
+		switch {
+		case !w.deleted:
+			tx.m.Put(key, w.value)
+		case tx.m.Contains(key): // Not for keys the transaction added
+			tx.m.Delete(key)
+		}
+	}
+
+	tx.writes = nil
+}
+
+// MapBatch records a sequence of map mutations to apply later, all or
+// none.
+//
+// The zero value is an empty batch ready to use. A batch holds no
+// reference to a map and can be applied to several.
+//
+// Example:
+//
+//	var b MapBatch[string, int]
+//	b.Put("alice", 50)
+//	b.Delete("bob")
+//	err := b.Apply(accounts)  // Fails and changes nothing if bob is absent
+type MapBatch[K comparable, V any] struct {
+	ops []func(tx *MapTxn[K, V]) error
+}
+
+// Put records associating the value with the key.
+func (b *MapBatch[K, V]) Put(key K, value V) {
+	b.ops = append(b.ops, func(tx *MapTxn[K, V]) error {
+		tx.Put(key, value)
+		return nil
+	})
+}
+
+// Delete records removing the key. Applying it fails with ErrKeyNotFound
+// if the key is absent at that point.
+func (b *MapBatch[K, V]) Delete(key K) {
+	b.ops = append(b.ops, func(tx *MapTxn[K, V]) error {
+		if !tx.Delete(key) {
+			return fmt.Errorf("%w: %v", ErrKeyNotFound, key)
+		}
+
+		return nil
+	})
+}
+
+// Len returns the number of recorded mutations.
+func (b *MapBatch[K, V]) Len() int {
+	return len(b.ops)
+}
+
+// Apply applies the recorded mutations to the map in order, through
+// UpdateMap. If a mutation fails, none is applied and the map is left
+// with the pairs it had.
+// Returns the error of the failed mutation, wrapped with its position in
+// the batch.
+//
+// Time complexity: That of UpdateMap
+func (b *MapBatch[K, V]) Apply(m Map[K, V]) error {
+	return UpdateMap(m, func(tx *MapTxn[K, V]) error {
+		for i, op := range b.ops {
+			if err := op(tx); err != nil {
+				return fmt.Errorf("%w: batch operation %d", err, i)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,297 @@
+package structures
+
+/*
+Test Coverage
+=============
+UpdateMap:
+  ✓ Successful update keeps every mutation
+  ✓ Error discards added, replaced and deleted keys
+  ✓ Panic discards the mutations and continues
+  ✓ Deleting an absent key records nothing
+  ✓ Works on every Map implementation
+  ✓ Commit applies net changes in insertion order
+  ✓ BoundedMap keeps its pairs on failure, evicts for committed puts
+  ✓ Reads and failures leave an access-order LinkedHashMap as it was
+  ✓ ObservableMap reports committed changes only
+
+MapBatch:
+  ✓ Zero value applies nothing
+  ✓ Mutations applied in order, batch reusable
+  ✓ Failure rolls back, error names the key and the operation
+*/
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies a successful update keeps every mutation
+func TestUpdateMap_Commit(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	err := UpdateMap(m, func(tx *MapTxn[string, int]) error {
+		test.GotWant(t, tx.Put("c", 3), true)
+		test.GotWant(t, tx.Put("a", 10), false)
+		test.GotWant(t, tx.Delete("b"), true)
+		v, _ := tx.Get("a")
+		test.GotWant(t, v, 10)
+		test.GotWant(t, tx.Contains("b"), false)
+		test.GotWant(t, tx.Size(), 2)
+		return nil
+	})
+
+	test.GotWantNoError(t, err)
+	test.GotWantDeep(t, maps.Collect(m.All()), map[string]int{"a": 10, "c": 3})
+}
+
+// Verifies an error discards added, replaced and deleted keys, including
+// keys changed several times
+func TestUpdateMap_Rollback(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	errStop := errors.New("stop")
+
+	err := UpdateMap(m, func(tx *MapTxn[string, int]) error {
+		tx.Put("c", 3)
+		tx.Put("a", 10)
+		tx.Delete("a")
+		tx.Put("a", 100)
+		tx.Delete("b")
+		tx.Delete("c")
+		return errStop
+	})
+
+	test.GotWantErrorIs(t, err, errStop)
+	test.GotWantDeep(t, maps.Collect(m.All()), map[string]int{"a": 1, "b": 2})
+}
+
+// Verifies a panic discards the mutations and continues
+func TestUpdateMap_Panic(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	test.GotWantPanic(t, func() {
+		UpdateMap(m, func(tx *MapTxn[string, int]) error {
+			tx.Put("a", 2)
+			tx.Put("b", 3)
+			panic("boom")
+		})
+	}, "boom")
+
+	test.GotWantDeep(t, maps.Collect(m.All()), map[string]int{"a": 1})
+}
+
+// Verifies deleting an absent key records nothing
+func TestUpdateMap_DeleteAbsent(t *testing.T) {
+	m := NewHashMap[string, int]()
+	UpdateMap(m, func(tx *MapTxn[string, int]) error {
+		test.GotWant(t, tx.Delete("a"), false)
+		test.GotWant(t, len(tx.writes), 0)
+		return nil
+	})
+}
+
+// Verifies a failed update leaves every Map implementation unchanged
+func TestUpdateMap_Implementations(t *testing.T) {
+	for name, m := range map[string]Map[int, string]{
+		"HashMap":       NewHashMap[int, string](),
+		"RobinHoodMap":  NewRobinHoodMap[int, string](),
+		"LinkedHashMap": NewLinkedHashMap[int, string](),
+		"OrderedMap":    NewOrderedMap[int, string](),
+		"SkipListMap":   NewSkipListMap[int, string](),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for i := range 10 {
+				m.Put(i, "v")
+			}
+
+			UpdateMap(m, func(tx *MapTxn[int, string]) error {
+				for i := range 20 {
+					if i%3 == 0 {
+						tx.Delete(i)
+					} else {
+						tx.Put(i, "w")
+					}
+				}
+
+				return errors.New("stop")
+			})
+
+			test.GotWant(t, m.Size(), 10)
+			for k, v := range m.All() {
+				if k >= 10 || v != "v" {
+					t.Fatalf("got pair %d=%q after rollback", k, v)
+				}
+			}
+		})
+	}
+}
+
+// Verifies the commit applies only the net change of every key, inserting
+// new keys in the order direct calls would, and updating a deleted and
+// put key in place
+func TestUpdateMap_CommitOrder(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	err := UpdateMap(m, func(tx *MapTxn[string, int]) error {
+		tx.Put("c", 3)
+		tx.Put("e", 5)
+		tx.Delete("a")
+		tx.Put("a", 10)
+		tx.Put("d", 4)
+		tx.Delete("d")
+		tx.Delete("e")
+		tx.Put("e", 50)
+		tx.Put("c", 30)
+		test.GotWant(t, tx.Size(), 4)
+		return nil
+	})
+
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, slices.Collect(linkedHashKeys(m)), []string{"a", "b", "c", "e"})
+	test.GotWantDeep(t, maps.Collect(m.All()), map[string]int{"a": 10, "b": 2, "c": 30, "e": 50})
+}
+
+// Verifies a failed update leaves a full BoundedMap with all its pairs,
+// while a committed one evicts to make room as a direct Put would
+func TestUpdateMap_BoundedMap(t *testing.T) {
+	b := NewBoundedMap(NewHashMap[string, int](), 2, collections.NewLRUPolicy[string]())
+	b.Put("a", 1)
+	b.Put("b", 2)
+
+	err := UpdateMap[string, int](b, func(tx *MapTxn[string, int]) error {
+		tx.Put("c", 3)
+		v, _ := tx.Get("a")
+		test.GotWant(t, v, 1)
+		return errors.New("stop")
+	})
+
+	test.GotWantError(t, err, "stop")
+	test.GotWantDeep(t, maps.Collect(b.All()), map[string]int{"a": 1, "b": 2})
+
+	// The failed read of a was not a use, so a is still the one evicted
+	test.GotWantNoError(t, UpdateMap[string, int](b, func(tx *MapTxn[string, int]) error {
+		tx.Put("c", 3)
+		return nil
+	}))
+	test.GotWantDeep(t, maps.Collect(b.All()), map[string]int{"b": 2, "c": 3})
+}
+
+// Verifies neither reads through the transaction nor a failed update
+// reorder an access-order LinkedHashMap
+func TestUpdateMap_LinkedHashMap_AccessOrder(t *testing.T) {
+	m := NewLinkedHashMapWith[string, int](WithAccessOrder(true))
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	UpdateMap[string, int](m, func(tx *MapTxn[string, int]) error {
+		tx.Get("a")
+		tx.Put("b", 20)
+		tx.Delete("c")
+		return errors.New("stop")
+	})
+
+	test.GotWantSlice(t, slices.Collect(linkedHashKeys(m)), []string{"a", "b", "c"})
+}
+
+// Verifies an ObservableMap reports the committed changes and nothing of
+// a failed update
+func TestUpdateMap_ObservableMap(t *testing.T) {
+	o := NewObservableMap[string, int](NewHashMap[string, int]())
+	o.Put("a", 1)
+	o.Put("b", 2)
+	var events []string
+	o.SetHooks(MapHooks[string, int]{
+		OnAdd:    func(key string, value int) { events = append(events, "add "+key) },
+		OnUpdate: func(key string, old, value int) { events = append(events, "update "+key) },
+		OnRemove: func(key string, value int) { events = append(events, "remove "+key) },
+	})
+
+	UpdateMap[string, int](o, func(tx *MapTxn[string, int]) error {
+		tx.Put("c", 3)
+		tx.Delete("a")
+		return errors.New("stop")
+	})
+	test.GotWant(t, len(events), 0)
+
+	UpdateMap[string, int](o, func(tx *MapTxn[string, int]) error {
+		tx.Put("c", 3)
+		tx.Put("d", 4)
+		tx.Delete("d")
+		tx.Put("b", 20)
+		tx.Delete("a")
+		return nil
+	})
+	test.GotWantSlice(t, events, []string{"add c", "update b", "remove a"})
+}
+
+// Returns an iterator over the keys of the map in iteration order.
+func linkedHashKeys[K comparable, V any](m *LinkedHashMap[K, V]) func(func(K) bool) {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Verifies an empty batch applies nothing
+func TestMapBatch_Empty(t *testing.T) {
+	var b MapBatch[string, int]
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	test.GotWant(t, b.Len(), 0)
+	test.GotWantNoError(t, b.Apply(m))
+	test.GotWantDeep(t, maps.Collect(m.All()), map[string]int{"a": 1})
+}
+
+// Verifies a batch applies its mutations in order and can be reused
+func TestMapBatch_Apply(t *testing.T) {
+	var b MapBatch[string, int]
+	b.Put("c", 3)
+	b.Put("a", 10)
+	b.Delete("b")
+	b.Put("b", 20)
+	test.GotWant(t, b.Len(), 4)
+
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	test.GotWantNoError(t, b.Apply(m))
+	test.GotWantDeep(t, maps.Collect(m.All()), map[string]int{"a": 10, "b": 20, "c": 3})
+
+	other := NewOrderedMap[string, int]()
+	other.Put("b", 0)
+	other.Put("d", 4)
+	test.GotWantNoError(t, b.Apply(other))
+	test.GotWantDeep(t, maps.Collect(other.All()), map[string]int{"a": 10, "b": 20, "c": 3, "d": 4})
+}
+
+// Verifies a failing batch leaves the map unchanged and names the absent
+// key and the failed operation
+func TestMapBatch_Rollback(t *testing.T) {
+	var b MapBatch[string, int]
+	b.Put("a", 10)
+	b.Delete("b")
+	b.Put("c", 3)
+	b.Delete("d")
+
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	err := b.Apply(m)
+	test.GotWantErrorIs(t, err, ErrKeyNotFound)
+	test.GotWantError(t, err, "key is not in the map: d: batch operation 3")
+	test.GotWantDeep(t, maps.Collect(m.All()), map[string]int{"a": 1, "b": 2})
+}
@@ -0,0 +1,146 @@
+package structures
+
+import "iter"
+
+// Compile-time interface verifications
+var _ Map[int, int] = &ObservableMap[int, int]{}
+
+// MapHooks holds optional callbacks that ObservableMap invokes after each
+// mutation, for cache invalidation, auditing or keeping a view in sync
+// without polling. Nil callbacks are skipped.
+//
+// Callbacks run synchronously inside the operation that caused the event,
+// after the map has changed, so they should be cheap and must not modify
+// the map.
+//
+// Example:
+//
+//	m.SetHooks(MapHooks[string, int]{
+//	    OnUpdate: func(key string, old, new int) { invalidate(key) },
+//	    OnRemove: func(key string, value int) { invalidate(key) },
+//	})
+type MapHooks[K comparable, V any] struct {
+	OnAdd    func(key K, value V)           // A new key was put
+	OnUpdate func(key K, oldValue, value V) // The value of a key was replaced
+	OnRemove func(key K, value V)           // A key was deleted
+}
+
+// ObservableMap wraps any Map and reports its mutations to MapHooks.
+//
+// Every mutation goes through the wrapper: changes made to the wrapped map
+// directly are not reported, and neither are removals the map makes on
+// its own, such as the expiry of ExpiringCache entries; use SetOnEvict
+// for those. Reads are passed through unchanged.
+//
+// Design decisions:
+//   - Wrapper over the Map interface: Works with every implementation,
+//     adds nothing to maps that are not observed
+//   - Lookup before each mutation: Reports old values and tells adds from
+//     updates, at the cost of one extra Get per Put and Delete
+//
+// The wrapper adds no locking. Around a map that is safe for concurrent
+// use it stays safe, but a hook may see an old value that another
+// goroutine replaced between the lookup and the mutation.
+//
+// Space complexity: O(1) on top of the wrapped map.
+type ObservableMap[K comparable, V any] struct {
+	m     Map[K, V]
+	hooks MapHooks[K, V]
+}
+
+// NewObservableMap wraps the map with no hooks installed; see SetHooks.
+//
+// Example:
+//
+//	m := NewObservableMap[string, int](NewHashMap[string, int]())
+//	m.SetHooks(MapHooks[string, int]{
+//	    OnAdd: func(key string, value int) { log.Printf("added %s", key) },
+//	})
+//	m.Put("a", 1)  // Logs "added a"
+func NewObservableMap[K comparable, V any](m Map[K, V]) *ObservableMap[K, V] {
+	return &ObservableMap[K, V]{m: m}
+}
+
+// SetHooks installs the callbacks for the map's mutations, replacing any
+// installed before.
+func (o *ObservableMap[K, V]) SetHooks(hooks MapHooks[K, V]) {
+	o.hooks = hooks
+}
+
+// Unwrap returns the wrapped map.
+func (o *ObservableMap[K, V]) Unwrap() Map[K, V] {
+	return o.m
+}
+
+// Put associates the value with the key and reports it to OnAdd or
+// OnUpdate.
+// Returns true if the key was added, false if an existing value was
+// replaced.
+//
+// The old value is read through Peek, so observing a bounded or
+// access-order map leaves its eviction order as it was.
+//
+// Time complexity: That of Peek and Put on the wrapped map
+func (o *ObservableMap[K, V]) Put(key K, value V) bool {
+	old, existed := peek(o.m, key)
+	added := o.m.Put(key, value)
+	switch {
+	case !existed && o.hooks.OnAdd != nil:
+		o.hooks.OnAdd(key, value)
+	case existed && o.hooks.OnUpdate != nil:
+		o.hooks.OnUpdate(key, old, value)
+	}
+
+	return added
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not present.
+func (o *ObservableMap[K, V]) Get(key K) (V, bool) {
+	return o.m.Get(key)
+}
+
+// Peek returns the value associated with the key through the Peek method
+// of the wrapped map, or its Get if it has none.
+// Returns false if the key is not present.
+func (o *ObservableMap[K, V]) Peek(key K) (V, bool) {
+	return peek(o.m, key)
+}
+
+// Contains returns true if the key is present.
+func (o *ObservableMap[K, V]) Contains(key K) bool {
+	return o.m.Contains(key)
+}
+
+// Delete removes the key and its value and reports them to OnRemove.
+// Returns true if the key was found and removed, false otherwise.
+//
+// Time complexity: That of Peek and Delete on the wrapped map
+func (o *ObservableMap[K, V]) Delete(key K) bool {
+	old, ok := peek(o.m, key)
+	if !ok || !o.m.Delete(key) {
+		return false
+	}
+
+	if o.hooks.OnRemove != nil {
+		o.hooks.OnRemove(key, old)
+	}
+
+	return true
+}
+
+// All returns an iterator over all key-value pairs in the order of the
+// wrapped map.
+func (o *ObservableMap[K, V]) All() iter.Seq2[K, V] {
+	return o.m.All()
+}
+
+// IsEmpty returns true if the map contains no pairs.
+func (o *ObservableMap[K, V]) IsEmpty() bool {
+	return o.m.IsEmpty()
+}
+
+// Size returns the number of pairs currently in the map.
+func (o *ObservableMap[K, V]) Size() int {
+	return o.m.Size()
+}
@@ -0,0 +1,193 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewObservableMap):
+  ✓ No hooks installed, mutations still applied
+
+Put/Delete:
+  ✓ OnAdd for new keys, OnUpdate with the old value for existing ones
+  ✓ OnRemove with the removed value, absent keys not reported
+  ✓ Nil callbacks skipped
+  ✓ SetHooks replaces earlier hooks
+  ✓ Old values read without counting a use, eviction order unchanged
+
+Reads:
+  ✓ Get, Contains, All, Size and IsEmpty pass through, Unwrap
+  ✓ Peek keeps an access-order map in place
+
+Caches:
+  ✓ ExpiringCache expiry not reported, replaced values reported
+*/
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Records the events reported by the hooks of an ObservableMap.
+func recordMapEvents[K comparable, V any](m *ObservableMap[K, V]) *[]string {
+	var events []string
+	m.SetHooks(MapHooks[K, V]{
+		OnAdd: func(key K, value V) {
+			events = append(events, fmt.Sprintf("add %v=%v", key, value))
+		},
+		OnUpdate: func(key K, oldValue, value V) {
+			events = append(events, fmt.Sprintf("update %v=%v->%v", key, oldValue, value))
+		},
+		OnRemove: func(key K, value V) {
+			events = append(events, fmt.Sprintf("remove %v=%v", key, value))
+		},
+	})
+
+	return &events
+}
+
+// Verifies a new observable map has no hooks and applies mutations
+func TestObservableMap_NewObservableMap(t *testing.T) {
+	inner := NewHashMap[string, int]()
+	m := NewObservableMap[string, int](inner)
+	test.GotWant(t, m.Put("a", 1), true)
+	test.GotWant(t, m.Put("a", 2), false)
+	test.GotWant(t, m.Delete("a"), true)
+	test.GotWant(t, m.Put("b", 3), true)
+
+	v, ok := inner.Get("b")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 3)
+	test.GotWant(t, inner.Contains("a"), false)
+}
+
+// Verifies Put reports new keys to OnAdd and replaced values to OnUpdate
+func TestObservableMap_Put(t *testing.T) {
+	m := NewObservableMap[string, int](NewHashMap[string, int]())
+	events := recordMapEvents(m)
+
+	test.GotWant(t, m.Put("a", 1), true)
+	test.GotWant(t, m.Put("b", 2), true)
+	test.GotWant(t, m.Put("a", 3), false)
+	test.GotWantSlice(t, *events, []string{"add a=1", "add b=2", "update a=1->3"})
+}
+
+// Verifies Delete reports the removed value and ignores absent keys
+func TestObservableMap_Delete(t *testing.T) {
+	m := NewObservableMap[string, int](NewHashMap[string, int]())
+	m.Put("a", 1)
+	events := recordMapEvents(m)
+
+	test.GotWant(t, m.Delete("b"), false)
+	test.GotWant(t, m.Delete("a"), true)
+	test.GotWant(t, m.Delete("a"), false)
+	test.GotWantSlice(t, *events, []string{"remove a=1"})
+}
+
+// Verifies nil callbacks are skipped
+func TestObservableMap_NilHooks(t *testing.T) {
+	m := NewObservableMap[string, int](NewHashMap[string, int]())
+	var removed []string
+	m.SetHooks(MapHooks[string, int]{
+		OnRemove: func(key string, value int) { removed = append(removed, key) },
+	})
+
+	m.Put("a", 1)
+	m.Put("a", 2)
+	m.Delete("a")
+	test.GotWantSlice(t, removed, []string{"a"})
+}
+
+// Verifies SetHooks replaces the hooks installed before
+func TestObservableMap_SetHooks(t *testing.T) {
+	m := NewObservableMap[string, int](NewHashMap[string, int]())
+	first := recordMapEvents(m)
+	m.Put("a", 1)
+	second := recordMapEvents(m)
+	m.Put("b", 2)
+
+	test.GotWantSlice(t, *first, []string{"add a=1"})
+	test.GotWantSlice(t, *second, []string{"add b=2"})
+}
+
+// Verifies reading the old value does not count as a use, so an observed
+// LFU-bounded map evicts the same key as the plain one
+func TestObservableMap_BoundedMap_EvictionOrder(t *testing.T) {
+	run := func(m Map[string, int]) {
+		m.Put("a", 1)
+		m.Put("b", 2)
+		m.Get("b")
+		m.Get("b")
+		m.Put("a", 3)
+		m.Put("c", 4)
+	}
+
+	plain := NewBoundedMap[string, int](NewHashMap[string, int](), 2, collections.NewLFUPolicy[string]())
+	run(plain)
+	observed := NewBoundedMap[string, int](NewHashMap[string, int](), 2, collections.NewLFUPolicy[string]())
+	run(NewObservableMap[string, int](observed))
+
+	test.GotWantSlice(t, boundedKeys(plain), []string{"b", "c"})
+	test.GotWantSlice(t, boundedKeys(observed), boundedKeys(plain))
+}
+
+// Verifies reads pass through to the wrapped map without reporting events
+func TestObservableMap_Reads(t *testing.T) {
+	inner := NewLinkedHashMap[string, int]()
+	m := NewObservableMap[string, int](inner)
+	test.GotWant(t, m.IsEmpty(), true)
+	m.Put("b", 2)
+	m.Put("a", 1)
+	events := recordMapEvents(m)
+
+	v, ok := m.Get("a")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 1)
+	_, ok = m.Get("c")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, m.Contains("b"), true)
+	test.GotWant(t, m.Size(), 2)
+	test.GotWant(t, m.IsEmpty(), false)
+	test.GotWantDeep(t, maps.Collect(m.All()), map[string]int{"a": 1, "b": 2})
+	test.GotWant(t, m.Unwrap(), Map[string, int](inner))
+	test.GotWant(t, len(*events), 0)
+}
+
+// Verifies Peek reads through the Peek of the wrapped map, so an
+// access-order map keeps its order
+func TestObservableMap_Peek(t *testing.T) {
+	inner := NewLinkedHashMapWith[string, int](WithAccessOrder(true))
+	m := NewObservableMap[string, int](inner)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	v, ok := m.Peek("a")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 1)
+	test.GotWantSlice(t, slices.Collect(linkedHashKeys(inner)), []string{"a", "b"})
+}
+
+// Verifies an observed cache reports replaced values but not expiry, which
+// goes to SetOnEvict
+func TestObservableMap_ExpiringCache(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		cache := NewExpiringCacheWith[string, int](WithDefaultTTL(time.Second))
+		m := NewObservableMap[string, int](cache)
+		events := recordMapEvents(m)
+		var evicted []string
+		cache.SetOnEvict(func(key string, value int) { evicted = append(evicted, key) })
+
+		m.Put("a", 1)
+		m.Put("a", 2)
+		time.Sleep(time.Second)
+		m.Put("a", 3)
+
+		test.GotWantSlice(t, *events, []string{"add a=1", "update a=1->2", "add a=3"})
+		test.GotWantSlice(t, evicted, []string{"a"})
+	})
+}
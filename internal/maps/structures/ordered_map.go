@@ -0,0 +1,285 @@
+package structures
+
+import (
+	"cmp"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/pairs"
+	trees "github.com/apotourlyan/godatastructures/internal/trees/structures"
+)
+
+// Compile-time interface verifications
+var _ Map[int, int] = &OrderedMap[int, int]{}
+var _ collections.Collection[int] = &OrderedMap[int, int]{}
+
+// OrderedMap implements a map whose pairs are kept sorted by key.
+//
+// Pairs are stored in an AVL tree, so lookups and updates have a
+// worst-case O(log n) bound, iteration is always in key order, and
+// neighbor queries (Floor, Ceiling) answer "closest key" questions that a
+// hash map cannot.
+//
+// Design decisions:
+//   - AVL tree backing: Balanced worst case, reads never restructure
+//   - Sorted iteration: All, Backward and Range walk the tree in order
+//   - Half-open ranges: Range(from, to) yields from <= key < to
+//
+// Space complexity: O(n) where n is the number of pairs.
+type OrderedMap[K cmp.Ordered, V any] struct {
+	tree *trees.AVLTree[K, V]
+}
+
+// NewOrderedMap creates an empty ordered map.
+//
+// Example:
+//
+//	m := NewOrderedMap[string, int]()
+//	m.Put("b", 2)
+//	m.Put("a", 1)
+//	for k, v := range m.All() {
+//	    fmt.Println(k, v)  // "a" 1, then "b" 2
+//	}
+func NewOrderedMap[K cmp.Ordered, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{tree: trees.NewAVLTree[K, V]()}
+}
+
+// Put associates the value with the key.
+// Returns true if the key was added, false if an existing value was replaced.
+//
+// Time complexity: O(log n)
+func (m *OrderedMap[K, V]) Put(key K, value V) bool {
+	return m.tree.Insert(key, value)
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not present.
+//
+// Time complexity: O(log n)
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	return m.tree.Get(key)
+}
+
+// Clear removes all pairs.
+//
+// Time complexity: O(1)
+func (m *OrderedMap[K, V]) Clear() {
+	m.tree.Clear()
+}
+
+// Contains returns true if the key is present.
+//
+// Time complexity: O(log n)
+func (m *OrderedMap[K, V]) Contains(key K) bool {
+	return m.tree.Contains(key)
+}
+
+// Delete removes the key and its value.
+// Returns true if the key was found and removed, false otherwise.
+//
+// Time complexity: O(log n)
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	return m.tree.Delete(key)
+}
+
+// Min returns the smallest key and its value.
+// Returns false if the map is empty.
+//
+// Time complexity: O(log n)
+func (m *OrderedMap[K, V]) Min() (K, V, bool) {
+	return m.tree.Min()
+}
+
+// Max returns the largest key and its value.
+// Returns false if the map is empty.
+//
+// Time complexity: O(log n)
+func (m *OrderedMap[K, V]) Max() (K, V, bool) {
+	return m.tree.Max()
+}
+
+// Floor returns the largest key less than or equal to the given key,
+// and its value. Returns false if there is no such key.
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	// Map with keys 10, 20, 30
+//	m.Floor(25)  // Returns 20
+//	m.Floor(5)   // Returns false
+func (m *OrderedMap[K, V]) Floor(key K) (K, V, bool) {
+	return m.tree.Floor(key)
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key,
+// and its value. Returns false if there is no such key.
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	// Map with keys 10, 20, 30
+//	m.Ceiling(25)  // Returns 30
+//	m.Ceiling(35)  // Returns false
+func (m *OrderedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return m.tree.Ceiling(key)
+}
+
+// All returns an iterator over all key-value pairs in ascending key order.
+// The map must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return m.tree.All()
+}
+
+// Backward returns an iterator over all key-value pairs in descending
+// key order. The map must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return m.tree.Backward()
+}
+
+// Range returns an iterator over the key-value pairs with from <= key < to
+// in ascending key order. The map must not be modified during iteration.
+//
+// Time complexity: O(log n + k) where k is the number of pairs yielded
+func (m *OrderedMap[K, V]) Range(from K, to K) iter.Seq2[K, V] {
+	return m.tree.Range(from, to)
+}
+
+// Keys returns an iterator over all keys in ascending order.
+// The map must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.tree.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over all values in ascending key order.
+// The map must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.tree.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Page returns page index of the pairs in ascending key order, split into
+// pages of size pairs, along with the total count. Each pair is found by
+// its rank in the tree, so earlier pages are not walked.
+//
+// Panics if index is negative or size is not positive.
+//
+// Time complexity: O(size * log n)
+//
+// Example:
+//
+//	m := NewOrderedMap[string, int]()
+//	m.Put("c", 3)
+//	m.Put("a", 1)
+//	m.Put("b", 2)
+//	p := m.Page(1, 2)  // p.Items is [{c 3}]
+func (m *OrderedMap[K, V]) Page(index int, size int) collections.Page[pairs.Pair[K, V]] {
+	total := m.tree.Size()
+	start, end := collections.PageBounds(index, size, total)
+	items := make([]pairs.Pair[K, V], 0, end-start)
+	for i := start; i < end; i++ {
+		k, v, _ := m.tree.Select(i)
+		items = append(items, pairs.Of(k, v))
+	}
+
+	return collections.Page[pairs.Pair[K, V]]{Items: items, Index: index, Size: size, Total: total}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the pairs
+// in ascending key order in the format of package codec, under the kind
+// "OrderedMap", so the data of a plain AVL tree is not taken for a map or
+// the other way around.
+// Returns an error wrapping codec.ErrUnsupportedType if K or V cannot be
+// encoded.
+//
+// Time complexity: O(n)
+func (m *OrderedMap[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq2("OrderedMap", m.tree.Size(), m.tree.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// pairs of the map with those encoded by MarshalBinary.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded map, in which case the map is left unchanged.
+//
+// Time complexity: O(n log n)
+func (m *OrderedMap[K, V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := codec.DecodeSlice2[K, V](data, "OrderedMap")
+	if err != nil {
+		return err
+	}
+
+	m.restore(keys, values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (m *OrderedMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq2(w, "OrderedMap", m.tree.Size(), m.tree.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the pairs of the map
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The map is
+// left unchanged on error.
+//
+// Time complexity: O(n log n)
+func (m *OrderedMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	keys, values, n, err := codec.ReadSlice2[K, V](r, "OrderedMap")
+	if err != nil {
+		return n, err
+	}
+
+	m.restore(keys, values)
+	return n, nil
+}
+
+// IsEmpty returns true if the map contains no pairs.
+//
+// Time complexity: O(1)
+func (m *OrderedMap[K, V]) IsEmpty() bool {
+	return m.tree.IsEmpty()
+}
+
+// Size returns the number of pairs in the map.
+//
+// Time complexity: O(1)
+func (m *OrderedMap[K, V]) Size() int {
+	return m.tree.Size()
+}
+
+// Replaces the pairs with the decoded ones; a repeated key keeps its
+// last value.
+func (m *OrderedMap[K, V]) restore(keys []K, values []V) {
+	m.tree.Clear()
+	for i, k := range keys {
+		m.tree.Insert(k, values[i])
+	}
+}
@@ -0,0 +1,266 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewOrderedMap):
+  ✓ Empty map
+
+Put/Get/Contains/Delete:
+  ✓ Get from empty map
+  ✓ Put new keys
+  ✓ Put existing key replaces value
+  ✓ Delete present and absent keys
+
+Min/Max/Floor/Ceiling:
+  ✓ Empty map
+  ✓ Non-empty map
+
+All/Backward/Range/Keys/Values:
+  ✓ Sorted order regardless of insertion order
+  ✓ Half-open range bounds
+  ✓ Early termination
+
+Binary encoding:
+  ✓ Round trip preserves the pairs in order, invalid data rejected
+  ✓ Encoding not interchangeable with a plain AVL tree
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the map unchanged
+
+Page:
+  ✓ Pairs in key order, total count, past-the-end page
+
+Clear:
+  ✓ Removes pairs
+*/
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/pairs"
+	trees "github.com/apotourlyan/godatastructures/internal/trees/structures"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty map
+func TestOrderedMap_NewOrderedMap_Empty(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+}
+
+// Verifies getting from an empty map
+func TestOrderedMap_Get_EmptyMap(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	v, ok := m.Get("a")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, 0)
+	test.GotWant(t, m.Contains("a"), false)
+}
+
+// Verifies putting new keys
+func TestOrderedMap_Put_NewKeys(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	test.GotWant(t, m.Put("b", 2), true)
+	test.GotWant(t, m.Put("a", 1), true)
+	test.GotWant(t, m.Size(), 2)
+	test.GotWant(t, m.IsEmpty(), false)
+
+	v, ok := m.Get("a")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 1)
+	test.GotWant(t, m.Contains("b"), true)
+}
+
+// Verifies putting an existing key replaces its value
+func TestOrderedMap_Put_ExistingKey(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Put("a", 1)
+	test.GotWant(t, m.Put("a", 2), false)
+	test.GotWant(t, m.Size(), 1)
+
+	v, _ := m.Get("a")
+	test.GotWant(t, v, 2)
+}
+
+// Verifies deleting present and absent keys
+func TestOrderedMap_Delete(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	test.GotWant(t, m.Delete("c"), false)
+	test.GotWant(t, m.Delete("a"), true)
+	test.GotWant(t, m.Delete("a"), false)
+	test.GotWant(t, m.Contains("a"), false)
+	test.GotWant(t, m.Size(), 1)
+}
+
+// Verifies Min, Max, Floor and Ceiling on an empty map
+func TestOrderedMap_MinMaxFloorCeiling_EmptyMap(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	_, _, minOk := m.Min()
+	_, _, maxOk := m.Max()
+	_, _, floorOk := m.Floor(1)
+	_, _, ceilingOk := m.Ceiling(1)
+	test.GotWant(t, minOk, false)
+	test.GotWant(t, maxOk, false)
+	test.GotWant(t, floorOk, false)
+	test.GotWant(t, ceilingOk, false)
+}
+
+// Verifies Min, Max, Floor and Ceiling on a non-empty map
+func TestOrderedMap_MinMaxFloorCeiling_NonEmptyMap(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	m.Put(20, "twenty")
+	m.Put(10, "ten")
+	m.Put(30, "thirty")
+
+	k, v, _ := m.Min()
+	test.GotWant(t, k, 10)
+	test.GotWant(t, v, "ten")
+	k, v, _ = m.Max()
+	test.GotWant(t, k, 30)
+	test.GotWant(t, v, "thirty")
+
+	k, v, ok := m.Floor(25)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, k, 20)
+	test.GotWant(t, v, "twenty")
+	_, _, ok = m.Floor(5)
+	test.GotWant(t, ok, false)
+
+	k, v, ok = m.Ceiling(25)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, k, 30)
+	test.GotWant(t, v, "thirty")
+	_, _, ok = m.Ceiling(35)
+	test.GotWant(t, ok, false)
+}
+
+// Verifies iteration is sorted by key regardless of insertion order
+func TestOrderedMap_All_SortedOrder(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	for _, k := range []int{5, 1, 4, 2, 3} {
+		m.Put(k, string(rune('a'+k-1)))
+	}
+
+	keys := []int{}
+	values := []string{}
+	for k, v := range m.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	test.GotWantSlice(t, keys, []int{1, 2, 3, 4, 5})
+	test.GotWantSlice(t, values, []string{"a", "b", "c", "d", "e"})
+
+	test.GotWantSlice(t, slices.Collect(m.Keys()), []int{1, 2, 3, 4, 5})
+	test.GotWantSlice(t, slices.Collect(m.Values()), []string{"a", "b", "c", "d", "e"})
+
+	backward := []int{}
+	for k := range m.Backward() {
+		backward = append(backward, k)
+	}
+	test.GotWantSlice(t, backward, []int{5, 4, 3, 2, 1})
+}
+
+// Verifies Range respects half-open bounds
+func TestOrderedMap_Range_Bounds(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	for i := range 10 {
+		m.Put(i, i)
+	}
+
+	got := []int{}
+	for k := range m.Range(3, 6) {
+		got = append(got, k)
+	}
+	test.GotWantSlice(t, got, []int{3, 4, 5})
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestOrderedMap_Keys_EarlyTermination(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	for i := range 10 {
+		m.Put(i, i)
+	}
+
+	got := []int{}
+	for k := range m.Keys() {
+		got = append(got, k)
+		if len(got) == 2 {
+			break
+		}
+	}
+	for v := range m.Values() {
+		got = append(got, v)
+		if len(got) == 3 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []int{0, 1, 0})
+}
+
+// Verifies a round trip preserves the pairs in ascending key order and
+// invalid data, including that of a plain AVL tree, is rejected
+func TestOrderedMap_MarshalBinary(t *testing.T) {
+	src := NewOrderedMap[int, string]()
+	for _, k := range []int{3, 1, 2} {
+		src.Put(k, strconv.Itoa(k))
+	}
+	tree, _ := trees.NewAVLTree[int, string]().MarshalBinary()
+	dst := NewOrderedMap[int, string]()
+	dst.Put(9, "z")
+	codectest.RoundTrip(t, src, dst,
+		func(m *OrderedMap[int, string]) []pairs.Pair[int, string] { return pairs.Collect(m.All()) }, tree)
+
+	data, _ := src.MarshalBinary()
+	test.GotWantErrorIs(t, trees.NewAVLTree[int, string]().UnmarshalBinary(data), codec.ErrInvalidData)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the pairs in key order
+func TestOrderedMap_WriteTo_ReadFrom(t *testing.T) {
+	src := NewOrderedMap[int, string]()
+	src.Put(1, "a")
+	src.Put(2, "b")
+	dst := NewOrderedMap[int, string]()
+	dst.Put(9, "z")
+	codectest.StreamRoundTrip(t, src, dst,
+		func(m *OrderedMap[int, string]) []pairs.Pair[int, string] { return pairs.Collect(m.All()) })
+}
+
+// Verifies pages hold the pairs in key order with the total count
+func TestOrderedMap_Page(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	for _, k := range []int{5, 3, 1, 4, 2} {
+		m.Put(k, strconv.Itoa(k))
+	}
+
+	p := m.Page(1, 2)
+	test.GotWantSlice(t, p.Items, []pairs.Pair[int, string]{pairs.Of(3, "3"), pairs.Of(4, "4")})
+	test.GotWant(t, p.Total, 5)
+	test.GotWantSlice(t, m.Page(2, 2).Items, []pairs.Pair[int, string]{pairs.Of(5, "5")})
+	test.GotWant(t, len(m.Page(3, 2).Items), 0)
+	test.GotWant(t, len(NewOrderedMap[int, string]().Page(0, 2).Items), 0)
+}
+
+// Verifies Clear empties the map and it stays usable
+func TestOrderedMap_Clear(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	m.Put(2, "b")
+	m.Put(1, "a")
+
+	m.Clear()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+	test.GotWant(t, m.Contains(1), false)
+
+	m.Put(3, "c")
+	test.GotWantSlice(t, slices.Collect(m.Keys()), []int{3})
+}
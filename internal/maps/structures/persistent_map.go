@@ -0,0 +1,150 @@
+package structures
+
+import (
+	"fmt"
+	"iter"
+
+	trees "github.com/apotourlyan/godatastructures/internal/trees/structures"
+)
+
+// PersistentMap implements an immutable map from comparable keys to
+// values.
+//
+// Put and Delete never modify the receiver; they return a new map that
+// shares all unchanged structure with it. Pairs are stored in a HAMT, so
+// each update copies only O(log32 n) small nodes. Every version remains
+// valid, which makes snapshots free and allows concurrent readers without
+// locks, as no version is ever written after it is returned.
+//
+// Design decisions:
+//   - HAMT backing: Structural sharing with near-constant depth
+//   - Deleting an absent key returns the receiver: Allocates nothing
+//   - Put always returns a new version: Values need not be comparable,
+//     so replacing a value with an equal one is not detected
+//
+// Space complexity: O(n) where n is the number of pairs, shared between
+// versions.
+type PersistentMap[K comparable, V any] struct {
+	trie *trees.HAMT[K, V]
+}
+
+// NewPersistentMap creates an empty persistent map.
+//
+// Example:
+//
+//	a := NewPersistentMap[string, int]()
+//	b := a.Put("x", 1)
+//	a.Contains("x")  // Returns false
+//	b.Get("x")       // Returns 1
+func NewPersistentMap[K comparable, V any]() *PersistentMap[K, V] {
+	return &PersistentMap[K, V]{trie: trees.NewHAMT[K, V]()}
+}
+
+// Put returns a map in which the key maps to the value.
+//
+// Time complexity: O(log32 n)
+func (m *PersistentMap[K, V]) Put(key K, value V) *PersistentMap[K, V] {
+	return &PersistentMap[K, V]{trie: m.trie.Put(key, value)}
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not present.
+//
+// Time complexity: O(log32 n)
+func (m *PersistentMap[K, V]) Get(key K) (V, bool) {
+	return m.trie.Get(key)
+}
+
+// Contains returns true if the key is present.
+//
+// Time complexity: O(log32 n)
+func (m *PersistentMap[K, V]) Contains(key K) bool {
+	return m.trie.Contains(key)
+}
+
+// Delete returns a map without the key.
+// Returns the receiver if the key is absent.
+//
+// Time complexity: O(log32 n)
+func (m *PersistentMap[K, V]) Delete(key K) *PersistentMap[K, V] {
+	trie := m.trie.Delete(key)
+	if trie == m.trie {
+		return m
+	}
+
+	return &PersistentMap[K, V]{trie: trie}
+}
+
+// All returns an iterator over all key-value pairs in unspecified order.
+// Since the map never changes, iteration is always safe.
+//
+// Time complexity: O(n) for a full iteration
+func (m *PersistentMap[K, V]) All() iter.Seq2[K, V] {
+	return m.trie.All()
+}
+
+// Keys returns an iterator over all keys in unspecified order.
+//
+// Time complexity: O(n) for a full iteration
+func (m *PersistentMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.trie.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over all values in unspecified order.
+//
+// Time complexity: O(n) for a full iteration
+func (m *PersistentMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.trie.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns the map itself: a persistent map never changes, so
+// every version is already an immutable point-in-time view.
+//
+// Time complexity: O(1)
+func (m *PersistentMap[K, V]) Snapshot() *PersistentMap[K, V] {
+	return m
+}
+
+// IsEmpty returns true if the map contains no pairs.
+//
+// Time complexity: O(1)
+func (m *PersistentMap[K, V]) IsEmpty() bool {
+	return m.trie.IsEmpty()
+}
+
+// Size returns the number of pairs in the map.
+//
+// Time complexity: O(1)
+func (m *PersistentMap[K, V]) Size() int {
+	return m.trie.Size()
+}
+
+// CheckInvariants verifies the internal consistency of the map, which is
+// that of its backing HAMT: bitmaps, hash paths, compaction and size.
+// Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the map is consistent.
+//
+// Time complexity: O(n)
+func (m *PersistentMap[K, V]) CheckInvariants() error {
+	if m.trie == nil {
+		return fmt.Errorf("%w: trie is nil", ErrInvariantViolation)
+	}
+	if err := m.trie.CheckInvariants(); err != nil {
+		return fmt.Errorf("%w: trie: %w", ErrInvariantViolation, err)
+	}
+
+	return nil
+}
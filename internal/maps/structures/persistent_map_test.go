@@ -0,0 +1,197 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewPersistentMap):
+  ✓ Empty map
+
+Put/Get/Contains/Delete:
+  ✓ New versions contain the change, old versions are unchanged
+  ✓ Replacing a value
+  ✓ Deleting an absent key returns the receiver
+
+All/Keys/Values:
+  ✓ Every pair once, early termination
+
+CheckInvariants:
+  ✓ Valid maps, missing trie
+
+Randomized:
+  ✓ Every retained version matches its built-in map snapshot and stays
+    consistent
+
+Concurrency:
+  ✓ Readers of one version race with writers deriving new versions
+
+Snapshot:
+  ✓ Returns the map itself
+*/
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty map
+func TestPersistentMap_NewPersistentMap_Empty(t *testing.T) {
+	m := NewPersistentMap[string, int]()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+	_, ok := m.Get("a")
+	test.GotWant(t, ok, false)
+}
+
+// Verifies updates produce new versions and leave old versions unchanged
+func TestPersistentMap_PutDelete_Versions(t *testing.T) {
+	v1 := NewPersistentMap[string, int]().Put("a", 1).Put("b", 2)
+	v2 := v1.Put("c", 3)
+	v3 := v2.Delete("a")
+
+	test.GotWant(t, maps.Equal(maps.Collect(v1.All()), map[string]int{"a": 1, "b": 2}), true)
+	test.GotWant(t, maps.Equal(maps.Collect(v2.All()), map[string]int{"a": 1, "b": 2, "c": 3}), true)
+	test.GotWant(t, maps.Equal(maps.Collect(v3.All()), map[string]int{"b": 2, "c": 3}), true)
+	test.GotWant(t, v1.Contains("c"), false)
+	test.GotWant(t, v3.Contains("a"), false)
+}
+
+// Verifies replacing a value keeps the size and leaves the old version
+// unchanged
+func TestPersistentMap_Put_Replace(t *testing.T) {
+	v1 := NewPersistentMap[string, int]().Put("a", 1)
+	v2 := v1.Put("a", 10)
+
+	got, _ := v1.Get("a")
+	test.GotWant(t, got, 1)
+	got, _ = v2.Get("a")
+	test.GotWant(t, got, 10)
+	test.GotWant(t, v2.Size(), 1)
+}
+
+// Verifies deleting an absent key returns the receiver
+func TestPersistentMap_Delete_NoOp(t *testing.T) {
+	m := NewPersistentMap[string, int]().Put("a", 1)
+	test.GotWant(t, m.Delete("z"), m)
+}
+
+// Verifies the iterators yield every pair once and stop early
+func TestPersistentMap_All(t *testing.T) {
+	m := NewPersistentMap[int, int]()
+	for i := range 100 {
+		m = m.Put(i, i*i)
+	}
+
+	keys := slices.Sorted(m.Keys())
+	values := slices.Sorted(m.Values())
+	for i := range 100 {
+		test.GotWant(t, keys[i], i)
+	}
+	test.GotWant(t, len(values), 100)
+	test.GotWant(t, values[99], 99*99)
+
+	count := 0
+	for range m.All() {
+		count++
+		break
+	}
+	for range m.Keys() {
+		count++
+		break
+	}
+	for range m.Values() {
+		count++
+		break
+	}
+	test.GotWant(t, count, 3)
+}
+
+// Verifies CheckInvariants accepts valid maps and reports a missing trie.
+// The map keeps no state besides the trie, whose corruption cases the
+// HAMT tests cover.
+func TestPersistentMap_CheckInvariants(t *testing.T) {
+	m := NewPersistentMap[string, int]()
+	test.GotWantNoError(t, m.CheckInvariants())
+	m = m.Put("a", 1).Put("b", 2).Delete("a")
+	test.GotWantNoError(t, m.CheckInvariants())
+
+	trie := m.trie
+	m.trie = nil
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: trie is nil")
+	m.trie = trie
+}
+
+// Verifies every version keeps matching the snapshot taken when it was
+// created
+func TestPersistentMap_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(44, 45))
+	m := NewPersistentMap[int, int]()
+	model := map[int]int{}
+	versions := []*PersistentMap[int, int]{}
+	snapshots := []map[int]int{}
+
+	for i := range 3000 {
+		k := r.IntN(200)
+		if r.IntN(3) == 0 {
+			m = m.Delete(k)
+			delete(model, k)
+		} else {
+			m = m.Put(k, i)
+			model[k] = i
+		}
+		test.GotWant(t, m.Size(), len(model))
+
+		if i%100 == 0 {
+			versions = append(versions, m)
+			snapshots = append(snapshots, maps.Clone(model))
+		}
+	}
+
+	for i, v := range versions {
+		test.GotWant(t, maps.Equal(maps.Collect(v.All()), snapshots[i]), true)
+		test.GotWantNoError(t, v.CheckInvariants())
+	}
+}
+
+// Verifies concurrent readers see a consistent version while writers
+// derive new versions from it (run with -race)
+func TestPersistentMap_Concurrency_Snapshots(t *testing.T) {
+	base := NewPersistentMap[int, int]()
+	for i := range 1000 {
+		base = base.Put(i, i)
+	}
+
+	var wg sync.WaitGroup
+	for w := range 4 {
+		wg.Go(func() {
+			m := base
+			for i := range 250 {
+				m = m.Delete(i*4+w).Put(1000+i*4+w, w)
+			}
+			test.GotWant(t, m.Size(), 1000)
+		})
+		wg.Go(func() {
+			for i := range 1000 {
+				v, ok := base.Get(i)
+				test.GotWant(t, ok, true)
+				test.GotWant(t, v, i)
+			}
+		})
+	}
+	wg.Wait()
+	test.GotWant(t, base.Size(), 1000)
+}
+
+// Verifies the snapshot of a persistent map is the map itself
+func TestPersistentMap_Snapshot(t *testing.T) {
+	m := NewPersistentMap[string, int]().Put("a", 1)
+	snap := m.Snapshot()
+	test.GotWant(t, snap, m)
+
+	m.Put("b", 2)
+	test.GotWant(t, snap.Size(), 1)
+}
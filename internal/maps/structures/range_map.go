@@ -0,0 +1,231 @@
+package structures
+
+import (
+	"cmp"
+	"iter"
+
+	algorithms "github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+	trees "github.com/apotourlyan/godatastructures/internal/trees/structures"
+)
+
+// KeyRange is a half-open range of keys [From, To) and the value
+// associated with it.
+type KeyRange[K cmp.Ordered, V any] struct {
+	From  K
+	To    K
+	Value V
+}
+
+// Represents the end and value of a range stored under its start key.
+type rangeSpan[K cmp.Ordered, V any] struct {
+	to    K
+	value V
+}
+
+// RangeMap implements a map from half-open key ranges to values.
+//
+// Ranges are stored in an AVL tree keyed by their start and never overlap,
+// so a point lookup is a single Floor query: the only range that can
+// contain a key is the one starting at or just before it. Putting a range
+// overwrites whatever it covers, trimming or splitting the ranges it
+// overlaps, and adjacent ranges holding equal values are merged, so the
+// map always stores the fewest ranges describing its contents.
+//
+// Design decisions:
+//   - Half-open ranges: [from, to) ranges tile without gaps or overlaps
+//   - Last write wins: Put replaces the covered part of older ranges
+//   - Coalescing: Touching ranges with equal values become one range,
+//     which is why V must be comparable
+//   - Empty ranges: Put and Delete ignore ranges with from >= to
+//
+// Space complexity: O(n) where n is the number of stored ranges.
+type RangeMap[K cmp.Ordered, V comparable] struct {
+	tree *trees.AVLTree[K, rangeSpan[K, V]]
+}
+
+// NewRangeMap creates an empty range map.
+//
+// Example:
+//
+//	m := NewRangeMap[int, string]()
+//	m.Put(0, 10, "low")
+//	m.Put(5, 15, "high")  // [0, 5) low, [5, 15) high
+//	m.Get(7)              // Returns "high"
+func NewRangeMap[K cmp.Ordered, V comparable]() *RangeMap[K, V] {
+	return &RangeMap[K, V]{tree: trees.NewAVLTree[K, rangeSpan[K, V]]()}
+}
+
+// Put associates the value with every key in [from, to), overwriting any
+// overlapping ranges and merging with neighbors holding the same value.
+// Does nothing if from >= to.
+//
+// Time complexity: O((k + 1) log n) where k is the number of ranges
+// overlapped
+//
+// Example:
+//
+//	m.Put(0, 10, "a")
+//	m.Put(3, 5, "b")   // [0, 3) a, [3, 5) b, [5, 10) a
+//	m.Put(3, 5, "a")   // [0, 10) a
+func (m *RangeMap[K, V]) Put(from K, to K, value V) {
+	if from >= to {
+		return
+	}
+
+	m.clear(from, to)
+	if start, left, ok := m.tree.Lower(from); ok && left.to == from && left.value == value {
+		m.tree.Delete(start)
+		from = start
+	}
+	if right, ok := m.tree.Get(to); ok && right.value == value {
+		m.tree.Delete(to)
+		to = right.to
+	}
+
+	m.tree.Insert(from, rangeSpan[K, V]{to: to, value: value})
+}
+
+// Get returns the value of the range containing the key.
+// Returns false if no range contains the key.
+//
+// Time complexity: O(log n)
+func (m *RangeMap[K, V]) Get(key K) (V, bool) {
+	if _, span, ok := m.tree.Floor(key); ok && key < span.to {
+		return span.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// GetRange returns the range containing the key.
+// Returns false if no range contains the key.
+//
+// Time complexity: O(log n)
+func (m *RangeMap[K, V]) GetRange(key K) (KeyRange[K, V], bool) {
+	if start, span, ok := m.tree.Floor(key); ok && key < span.to {
+		return KeyRange[K, V]{From: start, To: span.to, Value: span.value}, true
+	}
+
+	return KeyRange[K, V]{}, false
+}
+
+// Contains returns true if some range contains the key.
+//
+// Time complexity: O(log n)
+func (m *RangeMap[K, V]) Contains(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Delete removes every key in [from, to) from the map, trimming or
+// splitting the ranges it overlaps.
+// Returns true if any key was removed. Does nothing if from >= to.
+//
+// Time complexity: O((k + 1) log n) where k is the number of ranges
+// overlapped
+//
+// Example:
+//
+//	m.Put(0, 10, "a")
+//	m.Delete(3, 5)  // [0, 3) a, [5, 10) a
+func (m *RangeMap[K, V]) Delete(from K, to K) bool {
+	if from >= to {
+		return false
+	}
+
+	return m.clear(from, to) > 0
+}
+
+// Overlapping returns an iterator over the ranges that share at least one
+// key with [from, to), in ascending order. The map must not be modified
+// during iteration.
+//
+// Time complexity: O(log n + k) where k is the number of ranges yielded
+func (m *RangeMap[K, V]) Overlapping(from K, to K) iter.Seq[KeyRange[K, V]] {
+	return func(yield func(KeyRange[K, V]) bool) {
+		if from >= to {
+			return
+		}
+		if start, span, ok := m.tree.Lower(from); ok && from < span.to {
+			if !yield(KeyRange[K, V]{From: start, To: span.to, Value: span.value}) {
+				return
+			}
+		}
+		for start, span := range m.tree.Range(from, to) {
+			if !yield(KeyRange[K, V]{From: start, To: span.to, Value: span.value}) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over all ranges in ascending order.
+// The map must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (m *RangeMap[K, V]) All() iter.Seq[KeyRange[K, V]] {
+	return func(yield func(KeyRange[K, V]) bool) {
+		for start, span := range m.tree.All() {
+			if !yield(KeyRange[K, V]{From: start, To: span.to, Value: span.value}) {
+				return
+			}
+		}
+	}
+}
+
+// Coverage returns the keys that have a value as the fewest ascending
+// intervals, ignoring the values: touching ranges with different values,
+// which the map stores separately, are merged into one interval.
+//
+// Time complexity: O(n log n)
+//
+// Example:
+//
+//	m.Put(0, 5, "a")
+//	m.Put(5, 8, "b")
+//	m.Put(10, 12, "a")
+//	m.Coverage()  // Returns [{0, 8}, {10, 12}]
+func (m *RangeMap[K, V]) Coverage() []algorithms.Interval[K] {
+	intervals := make([]algorithms.Interval[K], 0, m.Size())
+	for start, span := range m.tree.All() {
+		intervals = append(intervals, algorithms.Interval[K]{Start: start, End: span.to})
+	}
+
+	return algorithms.MergeIntervals(intervals)
+}
+
+// IsEmpty returns true if the map contains no ranges.
+//
+// Time complexity: O(1)
+func (m *RangeMap[K, V]) IsEmpty() bool {
+	return m.tree.IsEmpty()
+}
+
+// Size returns the number of stored ranges. Merged ranges count once.
+//
+// Time complexity: O(1)
+func (m *RangeMap[K, V]) Size() int {
+	return m.tree.Size()
+}
+
+// Removes [from, to) from every stored range, keeping the parts outside
+// it. Returns the number of ranges that overlapped [from, to).
+func (m *RangeMap[K, V]) clear(from K, to K) int {
+	overlapping := []KeyRange[K, V]{}
+	for r := range m.Overlapping(from, to) {
+		overlapping = append(overlapping, r)
+	}
+
+	for _, r := range overlapping {
+		m.tree.Delete(r.From)
+		if r.From < from {
+			m.tree.Insert(r.From, rangeSpan[K, V]{to: from, value: r.Value})
+		}
+		if to < r.To {
+			m.tree.Insert(to, rangeSpan[K, V]{to: r.To, value: r.Value})
+		}
+	}
+
+	return len(overlapping)
+}
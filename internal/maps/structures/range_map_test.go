@@ -0,0 +1,263 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewRangeMap):
+  ✓ Empty map
+
+Put:
+  ✓ Disjoint ranges, empty range ignored
+  ✓ Overwrite splits, trims and swallows older ranges
+  ✓ Equal-valued neighbors merge, touching but different do not
+
+Get/GetRange/Contains:
+  ✓ Half-open bounds, gaps
+
+Delete:
+  ✓ Splits and trims, reports whether anything was removed
+
+Overlapping/All:
+  ✓ Range starting before the query, ranges touching the bounds
+  ✓ Early termination
+
+Coverage:
+  ✓ Empty map
+  ✓ Touching ranges with different values merged, gaps kept
+
+Randomized:
+  ✓ Operations match a per-key model, ranges disjoint and coalesced
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	algorithms "github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the ranges are non-empty, ascending, disjoint, and that no two
+// touching ranges hold the same value.
+func checkRangeMap[V comparable](t *testing.T, m *RangeMap[int, V]) {
+	t.Helper()
+	ranges := slices.Collect(m.All())
+	test.GotWant(t, len(ranges), m.Size())
+	for i, r := range ranges {
+		if r.From >= r.To {
+			t.Errorf("empty range [%v, %v)", r.From, r.To)
+		}
+		if i == 0 {
+			continue
+		}
+		prev := ranges[i-1]
+		if prev.To > r.From {
+			t.Errorf("ranges [%v, %v) and [%v, %v) overlap", prev.From, prev.To, r.From, r.To)
+		}
+		if prev.To == r.From && prev.Value == r.Value {
+			t.Errorf("ranges [%v, %v) and [%v, %v) were not merged", prev.From, prev.To, r.From, r.To)
+		}
+	}
+}
+
+// Verifies the creation of an empty map
+func TestRangeMap_NewRangeMap_Empty(t *testing.T) {
+	m := NewRangeMap[int, string]()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+	_, ok := m.Get(0)
+	test.GotWant(t, ok, false)
+}
+
+// Verifies disjoint ranges are stored as given and empty ranges ignored
+func TestRangeMap_Put_Disjoint(t *testing.T) {
+	m := NewRangeMap[int, string]()
+	m.Put(10, 20, "b")
+	m.Put(0, 5, "a")
+	m.Put(7, 7, "x")
+	m.Put(9, 8, "x")
+
+	test.GotWantSlice(t, slices.Collect(m.All()), []KeyRange[int, string]{
+		{0, 5, "a"}, {10, 20, "b"},
+	})
+	checkRangeMap(t, m)
+}
+
+// Verifies an overwrite splits a containing range, trims partial overlaps
+// and swallows contained ranges
+func TestRangeMap_Put_Overwrite(t *testing.T) {
+	m := NewRangeMap[int, string]()
+	m.Put(0, 10, "a")
+	m.Put(3, 5, "b")
+	test.GotWantSlice(t, slices.Collect(m.All()), []KeyRange[int, string]{
+		{0, 3, "a"}, {3, 5, "b"}, {5, 10, "a"},
+	})
+
+	m.Put(20, 30, "c")
+	m.Put(8, 25, "d")
+	test.GotWantSlice(t, slices.Collect(m.All()), []KeyRange[int, string]{
+		{0, 3, "a"}, {3, 5, "b"}, {5, 8, "a"}, {8, 25, "d"}, {25, 30, "c"},
+	})
+
+	m.Put(-5, 40, "e")
+	test.GotWantSlice(t, slices.Collect(m.All()), []KeyRange[int, string]{{-5, 40, "e"}})
+	checkRangeMap(t, m)
+}
+
+// Verifies equal-valued ranges merge when they touch or overlap and
+// different values stay separate
+func TestRangeMap_Put_Merge(t *testing.T) {
+	m := NewRangeMap[int, string]()
+	m.Put(0, 10, "a")
+	m.Put(3, 5, "b")
+	m.Put(3, 5, "a")
+	test.GotWantSlice(t, slices.Collect(m.All()), []KeyRange[int, string]{{0, 10, "a"}})
+
+	m.Put(10, 15, "a")
+	m.Put(-3, 0, "a")
+	m.Put(15, 20, "b")
+	test.GotWantSlice(t, slices.Collect(m.All()), []KeyRange[int, string]{
+		{-3, 15, "a"}, {15, 20, "b"},
+	})
+
+	m.Put(12, 17, "b")
+	test.GotWantSlice(t, slices.Collect(m.All()), []KeyRange[int, string]{
+		{-3, 12, "a"}, {12, 20, "b"},
+	})
+	checkRangeMap(t, m)
+}
+
+// Verifies lookups honor the half-open bounds and report gaps
+func TestRangeMap_Get(t *testing.T) {
+	m := NewRangeMap[int, string]()
+	m.Put(0, 5, "a")
+	m.Put(10, 20, "b")
+
+	cases := []struct {
+		key  int
+		want string
+		ok   bool
+	}{
+		{-1, "", false}, {0, "a", true}, {4, "a", true}, {5, "", false},
+		{9, "", false}, {10, "b", true}, {19, "b", true}, {20, "", false},
+	}
+	for _, c := range cases {
+		v, ok := m.Get(c.key)
+		test.GotWant(t, ok, c.ok)
+		test.GotWant(t, v, c.want)
+		test.GotWant(t, m.Contains(c.key), c.ok)
+	}
+
+	r, ok := m.GetRange(12)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, r, KeyRange[int, string]{10, 20, "b"})
+	_, ok = m.GetRange(7)
+	test.GotWant(t, ok, false)
+}
+
+// Verifies deletion splits and trims ranges and reports whether any key
+// was removed
+func TestRangeMap_Delete(t *testing.T) {
+	m := NewRangeMap[int, string]()
+	m.Put(0, 10, "a")
+	m.Put(20, 30, "b")
+
+	test.GotWant(t, m.Delete(3, 5), true)
+	test.GotWant(t, m.Delete(12, 18), false)
+	test.GotWant(t, m.Delete(10, 20), false)
+	test.GotWant(t, m.Delete(5, 5), false)
+	test.GotWantSlice(t, slices.Collect(m.All()), []KeyRange[int, string]{
+		{0, 3, "a"}, {5, 10, "a"}, {20, 30, "b"},
+	})
+
+	test.GotWant(t, m.Delete(8, 25), true)
+	test.GotWantSlice(t, slices.Collect(m.All()), []KeyRange[int, string]{
+		{0, 3, "a"}, {5, 8, "a"}, {25, 30, "b"},
+	})
+	checkRangeMap(t, m)
+}
+
+// Verifies the overlapping ranges include one starting before the query
+// and exclude ones that only touch its bounds
+func TestRangeMap_Overlapping(t *testing.T) {
+	m := NewRangeMap[int, string]()
+	m.Put(0, 5, "a")
+	m.Put(5, 10, "b")
+	m.Put(10, 15, "c")
+	m.Put(20, 25, "d")
+
+	test.GotWantSlice(t, slices.Collect(m.Overlapping(7, 21)), []KeyRange[int, string]{
+		{5, 10, "b"}, {10, 15, "c"}, {20, 25, "d"},
+	})
+	test.GotWantSlice(t, slices.Collect(m.Overlapping(15, 20)), []KeyRange[int, string]{})
+	test.GotWantSlice(t, slices.Collect(m.Overlapping(3, 3)), []KeyRange[int, string]{})
+}
+
+// Verifies early termination of the iterators
+func TestRangeMap_All_EarlyTermination(t *testing.T) {
+	m := NewRangeMap[int, int]()
+	for i := range 5 {
+		m.Put(2*i, 2*i+1, i)
+	}
+
+	count := 0
+	for range m.All() {
+		count++
+		break
+	}
+	for range m.Overlapping(0, 10) {
+		count++
+		break
+	}
+	test.GotWant(t, count, 2)
+}
+
+// Verifies Coverage merges touching ranges regardless of their values
+func TestRangeMap_Coverage(t *testing.T) {
+	m := NewRangeMap[int, string]()
+	test.GotWantSlice(t, m.Coverage(), []algorithms.Interval[int]{})
+
+	m.Put(0, 5, "a")
+	m.Put(5, 8, "b")
+	m.Put(8, 9, "c")
+	m.Put(10, 12, "a")
+	test.GotWant(t, m.Size(), 4)
+	test.GotWantSlice(t, m.Coverage(), []algorithms.Interval[int]{{Start: 0, End: 9}, {Start: 10, End: 12}})
+}
+
+// Verifies random puts and deletes match a per-key model
+func TestRangeMap_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(43, 44))
+	m := NewRangeMap[int, int]()
+	const universe = 100
+	model := map[int]int{}
+
+	for range 3000 {
+		from := r.IntN(universe)
+		to := from + r.IntN(20)
+		if r.IntN(3) == 0 {
+			removed := false
+			for k := from; k < to; k++ {
+				_, ok := model[k]
+				removed = removed || ok
+				delete(model, k)
+			}
+			test.GotWant(t, m.Delete(from, to), removed)
+		} else {
+			v := r.IntN(3)
+			m.Put(from, to, v)
+			for k := from; k < to; k++ {
+				model[k] = v
+			}
+		}
+
+		for k := -1; k < universe+20; k++ {
+			want, wantOk := model[k]
+			got, ok := m.Get(k)
+			test.GotWant(t, ok, wantOk)
+			test.GotWant(t, got, want)
+		}
+		checkRangeMap(t, m)
+	}
+}
@@ -0,0 +1,400 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/hash"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Compile-time interface verifications
+var _ Map[int, int] = &RobinHoodMap[int, int]{}
+var _ collections.Collection[int] = &RobinHoodMap[int, int]{}
+
+// RobinHoodMapStats describes the table of a RobinHoodMap at the moment
+// Stats was called.
+type RobinHoodMapStats struct {
+	// Capacity is the number of slots in the table.
+	Capacity int
+
+	// Rehashes is the number of times the table has grown and reinserted
+	// every pair since the map was created.
+	Rehashes int
+
+	// MaxProbeLength is the largest number of slots inspected by a
+	// successful lookup of any stored key.
+	MaxProbeLength int
+
+	// MeanProbeLength is the average number of slots inspected by a
+	// successful lookup over all stored keys, 0 for an empty map.
+	MeanProbeLength float64
+}
+
+// RobinHoodMap implements an unordered map using open addressing with
+// Robin Hood linear probing.
+//
+// Every slot records how far its pair sits from its home slot. When an
+// insertion probes past a pair that is closer to home than the pair being
+// inserted, the two swap places and the displaced pair continues probing:
+// slots are taken from the rich (short probes) and given to the poor (long
+// probes). This keeps probe lengths short and nearly uniform even at high
+// load, and lets a lookup for a missing key stop as soon as it meets a
+// pair closer to home than the current probe.
+//
+// Deletion shifts the following pairs of the cluster back by one slot
+// instead of leaving tombstones, so lookups never slow down with churn.
+//
+// Design decisions:
+//   - Stored probe lengths: One word per slot, 0 marks an empty slot
+//   - Backward-shift deletion: No tombstones and no purging rebuilds
+//   - Configurable load: See RobinHoodMapConfig, default 90%
+//   - Statistics: Stats reports rehashes and probe lengths for tuning
+//
+// Space complexity: O(n) where n is the number of pairs.
+type RobinHoodMap[K comparable, V any] struct {
+	probes   []int
+	pairs    []hashMapPair[K, V]
+	size     int
+	rehashes int
+	config   RobinHoodMapConfig
+	hasher   hash.Hasher[K]
+}
+
+// NewRobinHoodMap creates an empty Robin Hood map that grows once 90% of
+// its slots are occupied.
+//
+// Example:
+//
+//	m := NewRobinHoodMap[string, int]()
+//	m.Put("a", 1)
+//	m.Get("a")  // Returns 1, true
+func NewRobinHoodMap[K comparable, V any]() *RobinHoodMap[K, V] {
+	return NewRobinHoodMapWith[K, V]()
+}
+
+// NewRobinHoodMapWith creates an empty Robin Hood map from the default
+// configuration adjusted by the given options. Options are applied in
+// order.
+//
+// Panics if MaxLoadPercent ends up outside [1, 99].
+//
+// Example:
+//
+//	m := NewRobinHoodMapWith[string, int](
+//	    WithMaxLoadPercent[string](95),
+//	    WithHasher(hash.NewString()),
+//	)
+func NewRobinHoodMapWith[K comparable, V any](opts ...RobinHoodMapOption[K]) *RobinHoodMap[K, V] {
+	s := hashedMapSettings[K]{config: defaultRobinHoodMapConfig()}
+	for _, opt := range opts {
+		opt.applyRobinHoodMap(&s)
+	}
+
+	return newRobinHoodMap[K, V](s.config, s.hasherOrDefault())
+}
+
+// NewRobinHoodMapWithConfig creates an empty Robin Hood map with custom
+// settings. Panics if MaxLoadPercent is outside [1, 99].
+// See RobinHoodMapConfig for configuration options and tuning guidance.
+//
+// Deprecated: Use NewRobinHoodMapWith with WithMaxLoadPercent.
+func NewRobinHoodMapWithConfig[K comparable, V any](config RobinHoodMapConfig) *RobinHoodMap[K, V] {
+	return NewRobinHoodMapWith[K, V](withRobinHoodMapConfig[K](config))
+}
+
+// NewRobinHoodMapWithHasher creates an empty Robin Hood map with custom
+// settings that hashes keys with the given hasher. See hash.Hasher for the
+// requirements. Panics if MaxLoadPercent is outside [1, 99].
+//
+// Deprecated: Use NewRobinHoodMapWith with WithMaxLoadPercent and
+// WithHasher.
+func NewRobinHoodMapWithHasher[K comparable, V any](config RobinHoodMapConfig, hasher hash.Hasher[K]) *RobinHoodMap[K, V] {
+	return NewRobinHoodMapWith[K, V](withRobinHoodMapConfig[K](config), WithHasher(hasher))
+}
+
+// Creates an empty map of the minimum capacity with the given settings.
+func newRobinHoodMap[K comparable, V any](config RobinHoodMapConfig, hasher hash.Hasher[K]) *RobinHoodMap[K, V] {
+	panics.RequireGreaterThan(config.MaxLoadPercent, 0, "max load percent")
+	panics.RequireLessThan(config.MaxLoadPercent, 100, "max load percent")
+
+	return &RobinHoodMap[K, V]{
+		probes: make([]int, hashMapMinCapacity),
+		pairs:  make([]hashMapPair[K, V], hashMapMinCapacity),
+		config: config,
+		hasher: hasher,
+	}
+}
+
+// Put associates the value with the key.
+// Returns true if the key was added, false if an existing value was replaced.
+//
+// Time complexity: O(1) expected, amortized for table growth
+func (m *RobinHoodMap[K, V]) Put(key K, value V) bool {
+	if i, found := m.find(key); found {
+		m.pairs[i].value = value
+		return false
+	}
+
+	if (m.size+1)*100 > len(m.probes)*m.config.MaxLoadPercent {
+		m.grow()
+	}
+
+	m.insert(hashMapPair[K, V]{key: key, value: value})
+	m.size++
+	return true
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not present.
+//
+// Time complexity: O(1) expected
+func (m *RobinHoodMap[K, V]) Get(key K) (V, bool) {
+	if i, found := m.find(key); found {
+		return m.pairs[i].value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Clear removes all pairs. The map keeps its allocated slots, so
+// refilling it to a similar size does not rehash.
+//
+// Time complexity: O(c) where c is the capacity
+func (m *RobinHoodMap[K, V]) Clear() {
+	clear(m.probes)
+	clear(m.pairs)
+	m.size = 0
+}
+
+// Contains returns true if the key is present.
+//
+// Time complexity: O(1) expected
+func (m *RobinHoodMap[K, V]) Contains(key K) bool {
+	_, found := m.find(key)
+	return found
+}
+
+// Delete removes the key and its value, shifting the rest of its cluster
+// back by one slot.
+// Returns true if the key was found and removed, false otherwise.
+//
+// Time complexity: O(1) expected
+func (m *RobinHoodMap[K, V]) Delete(key K) bool {
+	i, found := m.find(key)
+	if !found {
+		return false
+	}
+
+	mask := len(m.probes) - 1
+	for next := (i + 1) & mask; m.probes[next] > 1; next = (next + 1) & mask {
+		m.probes[i] = m.probes[next] - 1
+		m.pairs[i] = m.pairs[next]
+		i = next
+	}
+
+	m.probes[i] = 0
+	m.pairs[i] = hashMapPair[K, V]{} // Help GC
+	m.size--
+	return true
+}
+
+// All returns an iterator over all key-value pairs in unspecified order.
+// The map must not be modified during iteration.
+//
+// Time complexity: O(c) for a full iteration where c is the slot count
+func (m *RobinHoodMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i, probe := range m.probes {
+			if probe != 0 && !yield(m.pairs[i].key, m.pairs[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// Stats returns the table's capacity, rehash count and probe lengths.
+//
+// Time complexity: O(c) where c is the slot count
+func (m *RobinHoodMap[K, V]) Stats() RobinHoodMapStats {
+	stats := RobinHoodMapStats{Capacity: len(m.probes), Rehashes: m.rehashes}
+	total := 0
+	for _, probe := range m.probes {
+		total += probe
+		stats.MaxProbeLength = max(stats.MaxProbeLength, probe)
+	}
+
+	if m.size > 0 {
+		stats.MeanProbeLength = float64(total) / float64(m.size)
+	}
+
+	return stats
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// pairs in iteration order in the format of package codec; the table
+// layout is not stored, since it depends on the hasher.
+// Returns an error wrapping codec.ErrUnsupportedType if K or V cannot be
+// encoded.
+//
+// Time complexity: O(c) where c is the slot count
+func (m *RobinHoodMap[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq2("RobinHoodMap", m.size, m.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// pairs of the map with those encoded by MarshalBinary, rehashing them
+// with the map's hasher and configuration.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded map, in which case the map is left unchanged.
+//
+// Time complexity: O(n) expected
+func (m *RobinHoodMap[K, V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := codec.DecodeSlice2[K, V](data, "RobinHoodMap")
+	if err != nil {
+		return err
+	}
+
+	m.restore(keys, values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (m *RobinHoodMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq2(w, "RobinHoodMap", m.size, m.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the pairs of the map
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The map is left
+// unchanged on error.
+//
+// Time complexity: O(n) expected
+func (m *RobinHoodMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	keys, values, n, err := codec.ReadSlice2[K, V](r, "RobinHoodMap")
+	if err != nil {
+		return n, err
+	}
+
+	m.restore(keys, values)
+	return n, nil
+}
+
+// IsEmpty returns true if the map contains no pairs.
+//
+// Time complexity: O(1)
+func (m *RobinHoodMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Size returns the number of pairs currently in the map.
+//
+// Time complexity: O(1)
+func (m *RobinHoodMap[K, V]) Size() int {
+	return m.size
+}
+
+// CheckInvariants verifies the internal consistency of the table: every
+// stored probe length leads back to the key's home slot, no pair follows
+// one that is more than one slot closer to home, which Robin Hood
+// insertion and backward-shift deletion rule out, and the number of
+// occupied slots matches Size. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the map is consistent.
+//
+// Time complexity: O(capacity)
+func (m *RobinHoodMap[K, V]) CheckInvariants() error {
+	mask := len(m.probes) - 1
+	count := 0
+	for i, probe := range m.probes {
+		if probe == 0 {
+			continue
+		}
+
+		count++
+		if home := m.position(m.pairs[i].key); (home+probe-1)&mask != i {
+			return fmt.Errorf("%w: slot %d has probe length %d, key is at distance %d from home",
+				ErrInvariantViolation, i, probe, (i-home)&mask+1)
+		}
+		if prev := m.probes[(i-1)&mask]; probe > prev+1 {
+			return fmt.Errorf("%w: slot %d has probe length %d after %d", ErrInvariantViolation, i, probe, prev)
+		}
+	}
+	if count != m.size {
+		return fmt.Errorf("%w: size is %d, table holds %d pairs", ErrInvariantViolation, m.size, count)
+	}
+
+	return nil
+}
+
+// Returns the home slot of the key.
+func (m *RobinHoodMap[K, V]) position(key K) int {
+	return int(m.hasher.Hash(key) & uint64(len(m.probes)-1))
+}
+
+// Returns the slot holding the key. The search stops early at a slot whose
+// pair is closer to home than the current probe, as the key would have
+// displaced it.
+func (m *RobinHoodMap[K, V]) find(key K) (int, bool) {
+	mask := len(m.probes) - 1
+	for i, probe := m.position(key), 1; ; i, probe = (i+1)&mask, probe+1 {
+		if m.probes[i] < probe {
+			return i, false
+		}
+
+		if m.probes[i] == probe && m.pairs[i].key == key {
+			return i, true
+		}
+	}
+}
+
+// Places a pair whose key is absent, swapping it with every pair closer
+// to home along the way.
+func (m *RobinHoodMap[K, V]) insert(pair hashMapPair[K, V]) {
+	mask := len(m.probes) - 1
+	for i, probe := m.position(pair.key), 1; ; i, probe = (i+1)&mask, probe+1 {
+		if m.probes[i] == 0 {
+			m.probes[i] = probe
+			m.pairs[i] = pair
+			return
+		}
+
+		if m.probes[i] < probe {
+			m.probes[i], probe = probe, m.probes[i]
+			m.pairs[i], pair = pair, m.pairs[i]
+		}
+	}
+}
+
+// Doubles the table and reinserts every pair.
+func (m *RobinHoodMap[K, V]) grow() {
+	probes, pairs := m.probes, m.pairs
+	m.probes = make([]int, 2*len(probes))
+	m.pairs = make([]hashMapPair[K, V], 2*len(pairs))
+	m.rehashes++
+
+	for i, probe := range probes {
+		if probe != 0 {
+			m.insert(pairs[i])
+		}
+	}
+}
+
+// Replaces the pairs with the decoded ones, rehashed into a fresh table.
+func (m *RobinHoodMap[K, V]) restore(keys []K, values []V) {
+	fresh := newRobinHoodMap[K, V](m.config, m.hasher)
+	for i, k := range keys {
+		fresh.Put(k, values[i])
+	}
+
+	*m = *fresh
+}
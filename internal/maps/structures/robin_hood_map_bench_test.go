@@ -0,0 +1,68 @@
+package structures
+
+import "testing"
+
+// Number of pairs in a table of 2^17 slots at ~85% load, where plain
+// linear probing has grown long clusters.
+const benchRobinHoodSize = 111_000
+
+// Maps compared by the Robin Hood benchmarks. HashMap and RobinHood75
+// allow at most 75% occupancy, so their tables double at this size and
+// run at ~42% load instead.
+var benchRobinHoodMaps = map[string]func() Map[int, int]{
+	"RobinHood": func() Map[int, int] { return NewRobinHoodMap[int, int]() },
+	"RobinHood75": func() Map[int, int] {
+		return NewRobinHoodMapWithConfig[int, int](RobinHoodMapConfig{MaxLoadPercent: 75})
+	},
+	"HashMap": func() Map[int, int] { return NewHashMap[int, int]() },
+}
+
+// BenchmarkRobinHoodMap_GetMissing measures unsuccessful lookups, the worst
+// case for open addressing, which must probe to the end of a cluster.
+//
+// Pattern: [Get(missing key)] × 111,000
+// Expected winner: HashMap (~1.4x faster than RobinHood75 at the same load
+// and ~1.5x faster than RobinHood at 85%; scanning one-byte slot states
+// beats comparing word-sized probe lengths despite Robin Hood's early exit)
+func BenchmarkRobinHoodMap_GetMissing(b *testing.B) {
+	for name, newMap := range benchRobinHoodMaps {
+		b.Run(name, func(b *testing.B) {
+			m := newMap()
+			for i := range benchRobinHoodSize {
+				m.Put(i, i)
+			}
+			b.ResetTimer()
+			for b.Loop() {
+				for i := range benchRobinHoodSize {
+					m.Get(-i - 1)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkRobinHoodMap_Churn measures deleting and reinserting keys at a
+// steady size, where tombstones accumulate in HashMap.
+//
+// Pattern: Fill 111,000 → [Delete(oldest), Put(new)] × 10,000
+// Expected winner: RobinHood75 and HashMap (comparable; RobinHood at 85%
+// load is ~2.5x slower as each backward shift walks a longer cluster)
+func BenchmarkRobinHoodMap_Churn(b *testing.B) {
+	for name, newMap := range benchRobinHoodMaps {
+		b.Run(name, func(b *testing.B) {
+			m := newMap()
+			for i := range benchRobinHoodSize {
+				m.Put(i, i)
+			}
+			next := benchRobinHoodSize
+			b.ResetTimer()
+			for b.Loop() {
+				for range 10_000 {
+					m.Delete(next - benchRobinHoodSize)
+					m.Put(next, next)
+					next++
+				}
+			}
+		})
+	}
+}
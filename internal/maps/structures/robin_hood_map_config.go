@@ -0,0 +1,75 @@
+package structures
+
+// RobinHoodMapConfig controls when a RobinHoodMap grows its table.
+//
+// Robin Hood hashing keeps probe sequences short and even at load factors
+// where plain linear probing degrades, so the table can run fuller and use
+// less memory. Every insertion that would push the load past the limit
+// first doubles the table and reinserts all pairs.
+//
+// Default configuration (NewRobinHoodMap):
+//
+//	MaxLoadPercent: 90  // grow once the table is 90% full
+//
+// Example configurations:
+//
+//	// Memory-constrained: fuller table, slightly longer probes
+//	config := RobinHoodMapConfig{MaxLoadPercent: 95}
+//
+//	// Lookup-heavy: emptier table, shortest probes
+//	config := RobinHoodMapConfig{MaxLoadPercent: 70}
+type RobinHoodMapConfig struct {
+	// MaxLoadPercent is the highest percentage of occupied slots allowed
+	// before the table doubles. Must be in the range [1, 99].
+	//
+	// Lower values: Shorter probes, more memory, more frequent rehashing
+	// Higher values: Longer probes, less memory, fewer rehashes
+	//
+	// Recommended values:
+	//   85-90: Balanced (default: 90)
+	//   90-95: Memory-constrained
+	//   60-75: Latency-sensitive lookups
+	MaxLoadPercent int
+}
+
+// RobinHoodMapOption configures a RobinHoodMap created with
+// NewRobinHoodMapWith. Options are applied in order on top of the default
+// configuration.
+//
+// RobinHoodMapOption is generic in the key type, so that a hasher for
+// another key type fails to compile. Options that carry no key, such as
+// WithMaxLoadPercent, therefore take the key type as a type argument.
+type RobinHoodMapOption[K comparable] interface {
+	applyRobinHoodMap(s *hashedMapSettings[K])
+}
+
+// Adapts a change of the configuration to a RobinHoodMapOption.
+type robinHoodMapConfigOption[K comparable] func(*RobinHoodMapConfig)
+
+func (f robinHoodMapConfigOption[K]) applyRobinHoodMap(s *hashedMapSettings[K]) {
+	f(&s.config)
+}
+
+// WithMaxLoadPercent sets the load at which the table doubles.
+// See RobinHoodMapConfig.MaxLoadPercent.
+//
+// Example:
+//
+//	m := NewRobinHoodMapWith[string, int](WithMaxLoadPercent[string](95))
+func WithMaxLoadPercent[K comparable](percent int) RobinHoodMapOption[K] {
+	return robinHoodMapConfigOption[K](func(c *RobinHoodMapConfig) {
+		c.MaxLoadPercent = percent
+	})
+}
+
+// Replaces the whole configuration, for NewRobinHoodMapWithConfig.
+func withRobinHoodMapConfig[K comparable](config RobinHoodMapConfig) RobinHoodMapOption[K] {
+	return robinHoodMapConfigOption[K](func(c *RobinHoodMapConfig) {
+		*c = config
+	})
+}
+
+// Returns the configuration used by NewRobinHoodMap.
+func defaultRobinHoodMapConfig() RobinHoodMapConfig {
+	return RobinHoodMapConfig{MaxLoadPercent: 90}
+}
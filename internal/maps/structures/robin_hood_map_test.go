@@ -0,0 +1,412 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewRobinHoodMap/NewRobinHoodMapWithConfig/NewRobinHoodMapWithHasher):
+  ✓ Empty map
+  ✓ Invalid load percent panics
+  ✓ Custom hashers, with and without collisions
+
+Put/Get/Contains/Delete:
+  ✓ Get from empty map
+  ✓ Put new keys, growth past the initial capacity
+  ✓ Put existing key replaces value
+  ✓ Delete present and absent keys
+  ✓ Delete shifts the cluster back, leaving no gaps in probe chains
+
+Load factor:
+  ✓ Table grows exactly when the configured load is exceeded
+
+Stats:
+  ✓ Empty map
+  ✓ Rehash count and probe lengths
+  ✓ Probe lengths stay short at high load
+
+All:
+  ✓ Yields every pair once, early termination
+
+CheckInvariants:
+  ✓ Valid map, wrong size, corrupted probe length
+
+Randomized:
+  ✓ Mixed operations match the built-in map, Robin Hood invariant holds
+
+Options (NewRobinHoodMapWith):
+  ✓ No options yields default configuration
+  ✓ WithMaxLoadPercent applied
+  ✓ Invalid options (panic)
+
+Binary encoding:
+  ✓ Round trip replaces the pairs, invalid data rejected
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the map unchanged
+
+Clear:
+  ✓ Removes pairs, keeps the slots
+
+Hasher option (NewRobinHoodMapWith):
+  ✓ WithHasher combined with WithMaxLoadPercent
+*/
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/hash"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the size, that every probe length matches the pair's distance
+// from its home slot, and the Robin Hood invariant: within a cluster a
+// slot's probe length exceeds its predecessor's by at most one.
+func checkRobinHoodMap[K comparable, V any](t *testing.T, m *RobinHoodMap[K, V]) {
+	t.Helper()
+	test.GotWantNoError(t, m.CheckInvariants())
+	mask := len(m.probes) - 1
+	size := 0
+	for i, probe := range m.probes {
+		if probe == 0 {
+			continue
+		}
+
+		size++
+		distance := (i - m.position(m.pairs[i].key)) & mask
+		test.GotWant(t, probe, distance+1)
+		if prev := m.probes[(i-1)&mask]; probe > prev+1 {
+			t.Errorf("slot %d has probe length %d after %d", i, probe, prev)
+		}
+	}
+	test.GotWant(t, m.size, size)
+}
+
+// Verifies the creation of an empty map
+func TestRobinHoodMap_NewRobinHoodMap_Empty(t *testing.T) {
+	m := NewRobinHoodMap[string, int]()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+}
+
+// Verifies load percents outside [1, 99] panic
+func TestRobinHoodMap_NewRobinHoodMapWithConfig_InvalidLoad(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewRobinHoodMapWithConfig[int, int](RobinHoodMapConfig{MaxLoadPercent: 0})
+	}, `"max load percent" must be > 0, got 0`)
+	test.GotWantPanic(t, func() {
+		NewRobinHoodMapWithConfig[int, int](RobinHoodMapConfig{MaxLoadPercent: 100})
+	}, `"max load percent" must be < 100, got 100`)
+}
+
+// Verifies a map with a custom hasher, with and without collisions
+func TestRobinHoodMap_NewRobinHoodMapWithHasher(t *testing.T) {
+	config := RobinHoodMapConfig{MaxLoadPercent: 90}
+	hashers := map[string]hash.Hasher[int]{
+		"Integer":   hash.NewInteger[int](),
+		"Colliding": hash.Func[int](func(k int) uint64 { return uint64(k % 3) }),
+	}
+
+	for name, hasher := range hashers {
+		t.Run(name, func(t *testing.T) {
+			m := NewRobinHoodMapWithHasher[int, int](config, hasher)
+			for i := range 50 {
+				m.Put(i, -i)
+			}
+			for i := 0; i < 50; i += 2 {
+				m.Delete(i)
+			}
+			checkRobinHoodMap(t, m)
+
+			test.GotWant(t, m.Size(), 25)
+			for i := range 50 {
+				_, ok := m.Get(i)
+				test.GotWant(t, ok, i%2 == 1)
+			}
+		})
+	}
+}
+
+// Verifies getting from an empty map
+func TestRobinHoodMap_Get_EmptyMap(t *testing.T) {
+	m := NewRobinHoodMap[string, int]()
+	v, ok := m.Get("a")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, 0)
+	test.GotWant(t, m.Contains("a"), false)
+}
+
+// Verifies putting new keys well past the initial capacity
+func TestRobinHoodMap_Put_NewKeys(t *testing.T) {
+	m := NewRobinHoodMap[int, int]()
+	for i := range 1000 {
+		test.GotWant(t, m.Put(i, i*2), true)
+	}
+	checkRobinHoodMap(t, m)
+	test.GotWant(t, m.Size(), 1000)
+
+	for i := range 1000 {
+		v, ok := m.Get(i)
+		test.GotWant(t, ok, true)
+		test.GotWant(t, v, i*2)
+	}
+	test.GotWant(t, m.Contains(1000), false)
+}
+
+// Verifies putting an existing key replaces its value
+func TestRobinHoodMap_Put_ExistingKey(t *testing.T) {
+	m := NewRobinHoodMap[string, int]()
+	m.Put("a", 1)
+	test.GotWant(t, m.Put("a", 2), false)
+	test.GotWant(t, m.Size(), 1)
+
+	v, _ := m.Get("a")
+	test.GotWant(t, v, 2)
+}
+
+// Verifies deleting present and absent keys
+func TestRobinHoodMap_Delete(t *testing.T) {
+	m := NewRobinHoodMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	test.GotWant(t, m.Delete("c"), false)
+	test.GotWant(t, m.Delete("a"), true)
+	test.GotWant(t, m.Delete("a"), false)
+	test.GotWant(t, m.Contains("a"), false)
+	test.GotWant(t, m.Contains("b"), true)
+	test.GotWant(t, m.Size(), 1)
+	checkRobinHoodMap(t, m)
+}
+
+// Verifies deleting from the middle of a cluster shifts the rest back so
+// every remaining key stays reachable
+func TestRobinHoodMap_Delete_BackwardShift(t *testing.T) {
+	m := NewRobinHoodMapWithConfig[int, int](RobinHoodMapConfig{MaxLoadPercent: 99})
+
+	// Collect keys sharing one home slot to build a cluster
+	chain := []int{}
+	home := m.position(0)
+	for k := 0; len(chain) < 5; k++ {
+		if m.position(k) == home {
+			chain = append(chain, k)
+		}
+	}
+	for _, k := range chain {
+		m.Put(k, k)
+	}
+	test.GotWant(t, m.Stats().MaxProbeLength, 5)
+
+	m.Delete(chain[1])
+	checkRobinHoodMap(t, m)
+	test.GotWant(t, m.Stats().MaxProbeLength, 4)
+	for _, k := range []int{chain[0], chain[2], chain[3], chain[4]} {
+		test.GotWant(t, m.Contains(k), true)
+	}
+}
+
+// Verifies the table doubles on the insertion that would exceed the
+// configured load
+func TestRobinHoodMap_LoadFactor(t *testing.T) {
+	m := NewRobinHoodMapWithConfig[int, int](RobinHoodMapConfig{MaxLoadPercent: 75})
+	for i := range 6 {
+		m.Put(i, i)
+	}
+	test.GotWant(t, m.Stats().Capacity, 8)
+
+	m.Put(6, 6) // 7/8 > 75%
+	test.GotWant(t, m.Stats().Capacity, 16)
+	test.GotWant(t, m.Stats().Rehashes, 1)
+}
+
+// Verifies the statistics of an empty map
+func TestRobinHoodMap_Stats_Empty(t *testing.T) {
+	stats := NewRobinHoodMap[int, int]().Stats()
+	test.GotWant(t, stats, RobinHoodMapStats{Capacity: 8})
+}
+
+// Verifies the rehash count and that probe lengths stay short at 90% load
+func TestRobinHoodMap_Stats_HighLoad(t *testing.T) {
+	m := NewRobinHoodMap[int, int]()
+	for i := range 58_000 { // 88% of 65,536 slots
+		m.Put(i, i)
+	}
+
+	stats := m.Stats()
+	test.GotWant(t, stats.Capacity, 65_536)
+	test.GotWant(t, stats.Rehashes, 13)
+	if stats.MeanProbeLength > 6 {
+		t.Errorf("mean probe length %.2f is too long", stats.MeanProbeLength)
+	}
+	if stats.MaxProbeLength > 64 {
+		t.Errorf("max probe length %d is too long", stats.MaxProbeLength)
+	}
+}
+
+// Verifies All yields every pair once and stops when the consumer breaks
+func TestRobinHoodMap_All(t *testing.T) {
+	m := NewRobinHoodMap[int, int]()
+	want := map[int]int{}
+	for i := range 100 {
+		m.Put(i, -i)
+		want[i] = -i
+	}
+	test.GotWant(t, maps.Equal(maps.Collect(m.All()), want), true)
+
+	count := 0
+	for range m.All() {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	test.GotWant(t, count, 3)
+}
+
+// Verifies CheckInvariants accepts a valid map and reports a wrong size and
+// a probe length that does not lead back to the home slot
+func TestRobinHoodMap_CheckInvariants(t *testing.T) {
+	m := NewRobinHoodMap[int, int]()
+	test.GotWantNoError(t, m.CheckInvariants())
+	for i := range 10 {
+		m.Put(i, i)
+	}
+	test.GotWantNoError(t, m.CheckInvariants())
+
+	m.size = 11
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: size is 11, table holds 10 pairs")
+	m.size = 10
+
+	slot := slices.IndexFunc(m.probes, func(p int) bool { return p > 0 })
+	m.probes[slot]++
+	err := m.CheckInvariants()
+	test.GotWant(t, err != nil, true)
+	test.GotWant(t, strings.HasPrefix(err.Error(), ErrorInvariantViolation+": slot"), true)
+	test.GotWantErrorIs(t, err, ErrInvariantViolation)
+}
+
+// Verifies random puts and deletes match the built-in map
+func TestRobinHoodMap_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(11, 12))
+	m := NewRobinHoodMapWithConfig[int, int](RobinHoodMapConfig{MaxLoadPercent: 95})
+	model := map[int]int{}
+
+	for i := range 20_000 {
+		k := r.IntN(500)
+		switch r.IntN(3) {
+		case 0:
+			_, exists := model[k]
+			test.GotWant(t, m.Delete(k), exists)
+			delete(model, k)
+		case 1:
+			_, exists := model[k]
+			test.GotWant(t, m.Put(k, i), !exists)
+			model[k] = i
+		case 2:
+			got, ok := m.Get(k)
+			want, exists := model[k]
+			test.GotWant(t, ok, exists)
+			test.GotWant(t, got, want)
+		}
+		if i%1000 == 0 {
+			checkRobinHoodMap(t, m)
+		}
+	}
+
+	checkRobinHoodMap(t, m)
+	test.GotWant(t, maps.Equal(maps.Collect(m.All()), model), true)
+}
+
+// Verifies no options yields the default configuration
+func TestRobinHoodMap_NewRobinHoodMapWith_Defaults(t *testing.T) {
+	m := NewRobinHoodMapWith[int, int]()
+	test.GotWant(t, m.config, NewRobinHoodMap[int, int]().config)
+}
+
+// Verifies WithMaxLoadPercent sets the growth threshold
+func TestRobinHoodMap_NewRobinHoodMapWith_MaxLoadPercent(t *testing.T) {
+	m := NewRobinHoodMapWith[int, int](WithMaxLoadPercent[int](70))
+	test.GotWant(t, m.config.MaxLoadPercent, 70)
+
+	m.Put(1, 10)
+	v, _ := m.Get(1)
+	test.GotWant(t, v, 10)
+}
+
+// Verifies invalid options panic at construction
+func TestRobinHoodMap_NewRobinHoodMapWith_InvalidOptions(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewRobinHoodMapWith[int, int](WithMaxLoadPercent[int](100))
+	}, `"max load percent" must be < 100, got 100`)
+}
+
+// Verifies a round trip replaces the pairs of the receiving map and
+// invalid data is rejected
+func TestRobinHoodMap_MarshalBinary(t *testing.T) {
+	src := NewRobinHoodMap[string, int]()
+	for i, k := range []string{"a", "b", "c"} {
+		src.Put(k, i)
+	}
+	other, _ := NewSkipListMap[string, int]().MarshalBinary()
+	dst := NewRobinHoodMap[string, int]()
+	dst.Put("z", 26)
+	codectest.RoundTrip(t, src, dst,
+		func(m *RobinHoodMap[string, int]) map[string]int { return maps.Collect(m.All()) }, other)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the pairs
+func TestRobinHoodMap_WriteTo_ReadFrom(t *testing.T) {
+	src := NewRobinHoodMap[int, string]()
+	src.Put(1, "a")
+	src.Put(2, "b")
+	dst := NewRobinHoodMap[int, string]()
+	dst.Put(9, "z")
+	codectest.StreamRoundTrip(t, src, dst,
+		func(m *RobinHoodMap[int, string]) map[int]string { return maps.Collect(m.All()) })
+}
+
+// Verifies Clear empties the map and refilling it does not rehash
+func TestRobinHoodMap_Clear(t *testing.T) {
+	m := NewRobinHoodMap[int, int]()
+	for i := range 100 {
+		m.Put(i, i)
+	}
+	before := m.Stats()
+
+	m.Clear()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+	test.GotWant(t, m.Contains(50), false)
+	test.GotWantNoError(t, m.CheckInvariants())
+
+	for i := range 100 {
+		m.Put(i, -i)
+	}
+	after := m.Stats()
+	test.GotWant(t, after.Capacity, before.Capacity)
+	test.GotWant(t, after.Rehashes, before.Rehashes)
+	test.GotWantNoError(t, m.CheckInvariants())
+}
+
+// Verifies WithHasher replaces the hash function and combines with
+// WithMaxLoadPercent
+func TestRobinHoodMap_NewRobinHoodMapWith_Hasher(t *testing.T) {
+	m := NewRobinHoodMapWith[int, int](
+		WithMaxLoadPercent[int](75),
+		WithHasher(hash.Func[int](func(k int) uint64 { return uint64(k % 3) })),
+	)
+	test.GotWant(t, m.config.MaxLoadPercent, 75)
+	for i := range 30 {
+		m.Put(i, -i)
+	}
+	checkRobinHoodMap(t, m)
+	test.GotWant(t, m.position(4), 1)
+
+	v, ok := m.Get(29)
+	test.GotWant(t, v, -29)
+	test.GotWant(t, ok, true)
+}
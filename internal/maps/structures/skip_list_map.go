@@ -0,0 +1,644 @@
+package structures
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"iter"
+	"math/bits"
+	"math/rand/v2"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ Map[int, int] = &SkipListMap[int, int]{}
+var _ collections.Collection[int] = &SkipListMap[int, int]{}
+
+// Maximum number of levels in a skip list. With a promotion probability
+// of 1/4 this comfortably covers any number of pairs that fits in memory.
+const skipListMaxLevel = 32
+
+// Represents a single pair in a SkipListMap, linked into levels 0 through
+// len(next)-1.
+//
+// A node is logically present once fullyLinked is set and until marked is
+// set. The mutex serializes structural changes to the node's successor
+// pointers and value replacement.
+type skipListNode[K cmp.Ordered, V any] struct {
+	key         K
+	value       atomic.Pointer[V]
+	next        []atomic.Pointer[skipListNode[K, V]]
+	mu          sync.Mutex
+	marked      atomic.Bool // Logically deleted
+	fullyLinked atomic.Bool // Linked into every level
+}
+
+// Returns the highest level the node is linked into.
+func (n *skipListNode[K, V]) topLevel() int {
+	return len(n.next) - 1
+}
+
+// Returns true if the node is present in the map.
+func (n *skipListNode[K, V]) live() bool {
+	return n.fullyLinked.Load() && !n.marked.Load()
+}
+
+// SkipListMap implements a concurrent map whose pairs are kept sorted by
+// key, as an alternative to OrderedMap for shared access.
+//
+// Pairs are stored in a lazy skip list: a sorted linked list with express
+// lanes of randomly chosen height, so searches skip most of the list in
+// expected O(log n) steps. Writers lock only the handful of nodes
+// adjacent to the key they change, and readers take no locks at all, so
+// operations on different parts of the key space proceed in parallel.
+//
+// Design decisions:
+//   - Lock-free reads: Get, Contains and iteration never block
+//   - Fine-grained locking: Put and Delete lock only neighbors of the key
+//   - Logical deletion: Nodes are marked before being unlinked, so a
+//     concurrent reader never follows a pointer into freed structure
+//   - Forward links only: No Backward iteration, Floor and Max retry if
+//     the node they land on is being removed
+//
+// All methods are safe for concurrent use by multiple goroutines.
+// Iterators are weakly consistent: they reflect some of the updates made
+// during iteration and never yield a key twice. Size is exact when the map
+// is quiescent and approximate while updates are in progress.
+//
+// Space complexity: O(n) expected where n is the number of pairs.
+type SkipListMap[K cmp.Ordered, V any] struct {
+	head   *skipListNode[K, V] // Sentinel linked into every level
+	height atomic.Int32        // Number of levels in use
+	size   atomic.Int64
+}
+
+// NewSkipListMap creates an empty concurrent ordered map.
+//
+// Example:
+//
+//	m := NewSkipListMap[string, int]()
+//	var wg sync.WaitGroup
+//	wg.Go(func() { m.Put("b", 2) })
+//	wg.Go(func() { m.Put("a", 1) })
+//	wg.Wait()
+//	for k, v := range m.All() {
+//	    fmt.Println(k, v)  // "a" 1, then "b" 2
+//	}
+func NewSkipListMap[K cmp.Ordered, V any]() *SkipListMap[K, V] {
+	m := &SkipListMap[K, V]{
+		head: &skipListNode[K, V]{
+			next: make([]atomic.Pointer[skipListNode[K, V]], skipListMaxLevel),
+		},
+	}
+	m.head.fullyLinked.Store(true)
+	m.height.Store(1)
+	return m
+}
+
+// Put associates the value with the key.
+// Returns true if the key was added, false if an existing value was replaced.
+//
+// Time complexity: O(log n) expected
+func (m *SkipListMap[K, V]) Put(key K, value V) bool {
+	top := randomSkipListLevel()
+	m.raiseHeight(top + 1)
+
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+	for {
+		if found := m.find(key, &preds, &succs); found != -1 {
+			if m.replace(succs[found], &value) {
+				return false
+			}
+			continue // Node is being removed, insert afresh
+		}
+
+		locked, valid := m.lockPreds(&preds, top, func(level int, pred *skipListNode[K, V]) bool {
+			succ := succs[level]
+			return !pred.marked.Load() &&
+				(succ == nil || !succ.marked.Load()) &&
+				pred.next[level].Load() == succ
+		})
+		if !valid {
+			m.unlockPreds(&preds, locked)
+			continue
+		}
+
+		n := &skipListNode[K, V]{
+			key:  key,
+			next: make([]atomic.Pointer[skipListNode[K, V]], top+1),
+		}
+		n.value.Store(&value)
+		for level := 0; level <= top; level++ {
+			n.next[level].Store(succs[level])
+		}
+		// Linearization point: the node becomes reachable at level 0
+		for level := 0; level <= top; level++ {
+			preds[level].next[level].Store(n)
+		}
+		n.fullyLinked.Store(true)
+
+		m.unlockPreds(&preds, locked)
+		m.size.Add(1)
+		return true
+	}
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not present.
+//
+// Time complexity: O(log n) expected
+func (m *SkipListMap[K, V]) Get(key K) (V, bool) {
+	if n := m.lookup(key); n != nil {
+		return *n.value.Load(), true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Clear removes all pairs by deleting them one by one, so it is not
+// atomic: a concurrent Put may survive it, and readers may observe some
+// of the pairs removed and others not yet.
+//
+// Time complexity: O(n log n) expected
+func (m *SkipListMap[K, V]) Clear() {
+	for k := range m.All() {
+		m.Delete(k)
+	}
+}
+
+// Contains returns true if the key is present.
+//
+// Time complexity: O(log n) expected
+func (m *SkipListMap[K, V]) Contains(key K) bool {
+	return m.lookup(key) != nil
+}
+
+// Delete removes the key and its value.
+// Returns true if the key was found and removed, false otherwise.
+//
+// Time complexity: O(log n) expected
+func (m *SkipListMap[K, V]) Delete(key K) bool {
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+	var victim *skipListNode[K, V]
+	for {
+		found := m.find(key, &preds, &succs)
+		if victim == nil {
+			if found == -1 {
+				return false
+			}
+
+			// Only a fully linked node found at its own top level is
+			// ready to be removed; otherwise it is absent or in flux
+			n := succs[found]
+			if !n.fullyLinked.Load() || n.topLevel() != found || n.marked.Load() {
+				return false
+			}
+
+			n.mu.Lock()
+			if n.marked.Load() {
+				n.mu.Unlock()
+				return false
+			}
+			// Linearization point: the key is logically deleted
+			n.marked.Store(true)
+			victim = n
+		}
+
+		top := victim.topLevel()
+		locked, valid := m.lockPreds(&preds, top, func(level int, pred *skipListNode[K, V]) bool {
+			return !pred.marked.Load() && pred.next[level].Load() == victim
+		})
+		if !valid {
+			m.unlockPreds(&preds, locked)
+			continue
+		}
+
+		for level := top; level >= 0; level-- {
+			preds[level].next[level].Store(victim.next[level].Load())
+		}
+
+		victim.mu.Unlock()
+		m.unlockPreds(&preds, locked)
+		m.size.Add(-1)
+		return true
+	}
+}
+
+// Min returns the smallest key and its value.
+// Returns false if the map is empty.
+//
+// Time complexity: O(1) expected
+func (m *SkipListMap[K, V]) Min() (K, V, bool) {
+	return m.first(m.head)
+}
+
+// Max returns the largest key and its value.
+// Returns false if the map is empty.
+//
+// Time complexity: O(log n) expected
+func (m *SkipListMap[K, V]) Max() (K, V, bool) {
+	return m.last(func(K) bool { return true })
+}
+
+// Floor returns the largest key less than or equal to the given key,
+// and its value. Returns false if there is no such key.
+//
+// Time complexity: O(log n) expected
+//
+// Example:
+//
+//	// Map with keys 10, 20, 30
+//	m.Floor(25)  // Returns 20
+//	m.Floor(5)   // Returns false
+func (m *SkipListMap[K, V]) Floor(key K) (K, V, bool) {
+	return m.last(func(k K) bool { return k <= key })
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key,
+// and its value. Returns false if there is no such key.
+//
+// Time complexity: O(log n) expected
+//
+// Example:
+//
+//	// Map with keys 10, 20, 30
+//	m.Ceiling(25)  // Returns 30
+//	m.Ceiling(35)  // Returns false
+func (m *SkipListMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return m.first(m.seek(key))
+}
+
+// All returns an iterator over all key-value pairs in ascending key order.
+// The map may be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (m *SkipListMap[K, V]) All() iter.Seq2[K, V] {
+	return m.walk(m.head, func(K) bool { return true })
+}
+
+// Range returns an iterator over the key-value pairs with from <= key < to
+// in ascending key order. The map may be modified during iteration.
+//
+// Time complexity: O(log n + k) expected where k is the number of pairs yielded
+func (m *SkipListMap[K, V]) Range(from K, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.walk(m.seek(from), func(k K) bool { return k < to })(yield)
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// pairs in ascending key order in the format of package codec. The pairs
+// are first copied by a single iteration, so under concurrent updates the
+// encoding is as weakly consistent as All but always well formed.
+// Returns an error wrapping codec.ErrUnsupportedType if K or V cannot be
+// encoded.
+//
+// Time complexity: O(n)
+func (m *SkipListMap[K, V]) MarshalBinary() ([]byte, error) {
+	count, pairs := m.snapshot()
+	return codec.EncodeSeq2("SkipListMap", count, pairs)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// pairs of the map with those encoded by MarshalBinary. The data is
+// decoded in full before the map is touched, then the map is cleared and
+// refilled, so like Clear the replacement is not atomic with respect to
+// concurrent updates.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded map, in which case the map is left unchanged.
+//
+// Time complexity: O(n log n) expected
+func (m *SkipListMap[K, V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := codec.DecodeSlice2[K, V](data, "SkipListMap")
+	if err != nil {
+		return err
+	}
+
+	m.restore(keys, values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks. The pairs are copied first, as
+// in MarshalBinary.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (m *SkipListMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	count, pairs := m.snapshot()
+	return codec.WriteSeq2(w, "SkipListMap", count, pairs)
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the pairs of the map
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it, as UnmarshalBinary does.
+// Returns the number of bytes read and the first error. The map is left
+// unchanged on error.
+//
+// Time complexity: O(n log n) expected
+func (m *SkipListMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	keys, values, n, err := codec.ReadSlice2[K, V](r, "SkipListMap")
+	if err != nil {
+		return n, err
+	}
+
+	m.restore(keys, values)
+	return n, nil
+}
+
+// Snapshot returns an immutable view of the pairs of the map as it is
+// now. The pairs are copied by a lock-free iteration, so writers are never
+// blocked; like any iteration of the map it is weakly consistent, and a
+// pair put or deleted while the snapshot is taken may or may not be
+// included. Pairs not changed meanwhile are always included, and the
+// snapshot is exact when the map is quiescent.
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(n) - the pairs are copied
+//
+// Example:
+//
+//	snap := m.Snapshot()
+//	for k, v := range snap.All() {
+//	    export(k, v)  // Writers to m are not blocked meanwhile
+//	}
+func (m *SkipListMap[K, V]) Snapshot() *MapSnapshot[K, V] {
+	pairs := make(map[K]V, m.Size())
+	for k, v := range m.All() {
+		pairs[k] = v
+	}
+
+	return &MapSnapshot[K, V]{pairs: pairs}
+}
+
+// IsEmpty returns true if the map contains no pairs.
+//
+// Time complexity: O(1)
+func (m *SkipListMap[K, V]) IsEmpty() bool {
+	return m.Size() == 0
+}
+
+// Size returns the number of pairs in the map.
+//
+// Time complexity: O(1)
+func (m *SkipListMap[K, V]) Size() int {
+	return int(m.size.Load())
+}
+
+// CheckInvariants verifies the internal consistency of the skip list:
+// the height is within bounds with no node above it, every level is
+// sorted by strictly increasing key, every node on a level is also on the
+// level below, every node is live and the number of nodes matches Size.
+// Intended for tests and debugging; the map must be quiescent, since
+// updates in progress pass through states that violate these rules.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the map is consistent.
+//
+// Time complexity: O(n) expected
+func (m *SkipListMap[K, V]) CheckInvariants() error {
+	height := int(m.height.Load())
+	if height < 1 || height > skipListMaxLevel {
+		return fmt.Errorf("%w: height is %d, want 1 to %d", ErrInvariantViolation, height, skipListMaxLevel)
+	}
+	for level := height; level < skipListMaxLevel; level++ {
+		if m.head.next[level].Load() != nil {
+			return fmt.Errorf("%w: level %d is above the height %d but not empty", ErrInvariantViolation, level, height)
+		}
+	}
+
+	var below map[*skipListNode[K, V]]bool
+	for level := 0; level < height; level++ {
+		nodes := make(map[*skipListNode[K, V]]bool)
+		var prev *skipListNode[K, V]
+		for n := m.head.next[level].Load(); n != nil; n = n.next[level].Load() {
+			if prev != nil && n.key <= prev.key {
+				return fmt.Errorf("%w: key %v follows key %v on level %d", ErrInvariantViolation, n.key, prev.key, level)
+			}
+			if level > 0 && !below[n] {
+				return fmt.Errorf("%w: key %v is on level %d but not on the level below", ErrInvariantViolation, n.key, level)
+			}
+			if level == 0 && !n.live() {
+				return fmt.Errorf("%w: key %v is linked but not live", ErrInvariantViolation, n.key)
+			}
+			nodes[n] = true
+			prev = n
+		}
+		if level == 0 && len(nodes) != m.Size() {
+			return fmt.Errorf("%w: size is %d, bottom level holds %d nodes", ErrInvariantViolation, m.Size(), len(nodes))
+		}
+		below = nodes
+	}
+
+	return nil
+}
+
+// Copies the pairs in one iteration, so the encoders write a count that
+// matches the pairs however the map changes meanwhile.
+// Returns the number of pairs and an iterator over the copies.
+func (m *SkipListMap[K, V]) snapshot() (int, iter.Seq2[K, V]) {
+	var keys []K
+	var values []V
+	for k, v := range m.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	return len(keys), func(yield func(K, V) bool) {
+		for i, k := range keys {
+			if !yield(k, values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Replaces the pairs with the decoded ones; a repeated key keeps its
+// last value.
+func (m *SkipListMap[K, V]) restore(keys []K, values []V) {
+	m.Clear()
+	for i, k := range keys {
+		m.Put(k, values[i])
+	}
+}
+
+// Draws a node's top level with promotion probability 1/4.
+func randomSkipListLevel() int {
+	return min(bits.TrailingZeros64(rand.Uint64())/2, skipListMaxLevel-1)
+}
+
+// Raises the number of levels in use to at least the given height.
+func (m *SkipListMap[K, V]) raiseHeight(height int) {
+	for {
+		current := m.height.Load()
+		if int(current) >= height || m.height.CompareAndSwap(current, int32(height)) {
+			return
+		}
+	}
+}
+
+// Fills preds and succs with the last node before the key and the first
+// node at or after it on every level in use.
+// Returns the highest level the key was found on, or -1 if absent.
+func (m *SkipListMap[K, V]) find(key K, preds, succs *[skipListMaxLevel]*skipListNode[K, V]) int {
+	found := -1
+	pred := m.head
+	for level := int(m.height.Load()) - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && curr.key < key {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+
+		if found == -1 && curr != nil && curr.key == key {
+			found = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+
+	return found
+}
+
+// Returns the live node holding the key, or nil if absent.
+func (m *SkipListMap[K, V]) lookup(key K) *skipListNode[K, V] {
+	pred := m.head
+	for level := int(m.height.Load()) - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && curr.key < key {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+
+		if curr != nil && curr.key == key {
+			if curr.live() {
+				return curr
+			}
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// Returns the last node with a key less than the given key on level 0,
+// which may be the head sentinel.
+func (m *SkipListMap[K, V]) seek(key K) *skipListNode[K, V] {
+	pred := m.head
+	for level := int(m.height.Load()) - 1; level >= 0; level-- {
+		for curr := pred.next[level].Load(); curr != nil && curr.key < key; curr = pred.next[level].Load() {
+			pred = curr
+		}
+	}
+
+	return pred
+}
+
+// Replaces the value of a node unless it has been removed.
+// Returns false if the node is marked for removal.
+func (m *SkipListMap[K, V]) replace(n *skipListNode[K, V], value *V) bool {
+	for !n.fullyLinked.Load() {
+		runtime.Gosched() // Wait for the concurrent insertion to complete
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.marked.Load() {
+		return false
+	}
+
+	n.value.Store(value)
+	return true
+}
+
+// Locks the distinct predecessors on levels 0 through top, stopping at the
+// first level where valid reports a stale view.
+// Returns the highest level locked and whether every level was valid.
+func (m *SkipListMap[K, V]) lockPreds(
+	preds *[skipListMaxLevel]*skipListNode[K, V],
+	top int,
+	valid func(level int, pred *skipListNode[K, V]) bool,
+) (int, bool) {
+	locked := -1
+	var prev *skipListNode[K, V]
+	for level := 0; level <= top; level++ {
+		pred := preds[level]
+		if pred != prev {
+			pred.mu.Lock()
+			locked = level
+			prev = pred
+		}
+
+		if !valid(level, pred) {
+			return locked, false
+		}
+	}
+
+	return locked, true
+}
+
+// Unlocks the distinct predecessors on levels 0 through locked.
+func (m *SkipListMap[K, V]) unlockPreds(preds *[skipListMaxLevel]*skipListNode[K, V], locked int) {
+	var prev *skipListNode[K, V]
+	for level := 0; level <= locked; level++ {
+		if pred := preds[level]; pred != prev {
+			pred.mu.Unlock()
+			prev = pred
+		}
+	}
+}
+
+// Returns the first live pair after the given node on level 0.
+func (m *SkipListMap[K, V]) first(after *skipListNode[K, V]) (K, V, bool) {
+	for n := after.next[0].Load(); n != nil; n = n.next[0].Load() {
+		if n.live() {
+			return n.key, *n.value.Load(), true
+		}
+	}
+
+	var zeroK K
+	var zeroV V
+	return zeroK, zeroV, false
+}
+
+// Returns the live pair with the largest key satisfying the predicate,
+// which must hold for a prefix of the keys. Retries while the node found
+// is being inserted or removed, since the list cannot be walked backward.
+func (m *SkipListMap[K, V]) last(accept func(K) bool) (K, V, bool) {
+	for {
+		pred := m.head
+		for level := int(m.height.Load()) - 1; level >= 0; level-- {
+			for curr := pred.next[level].Load(); curr != nil && accept(curr.key); curr = pred.next[level].Load() {
+				pred = curr
+			}
+		}
+
+		if pred == m.head {
+			var zeroK K
+			var zeroV V
+			return zeroK, zeroV, false
+		}
+		if pred.live() {
+			return pred.key, *pred.value.Load(), true
+		}
+	}
+}
+
+// Returns an iterator over the live pairs after the given node on level 0
+// while the predicate holds.
+func (m *SkipListMap[K, V]) walk(after *skipListNode[K, V], accept func(K) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := after.next[0].Load(); n != nil && accept(n.key); n = n.next[0].Load() {
+			if n.live() && !yield(n.key, *n.value.Load()) {
+				return
+			}
+		}
+	}
+}
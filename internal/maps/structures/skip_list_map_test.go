@@ -0,0 +1,595 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewSkipListMap):
+  ✓ Empty map
+
+Put/Get/Contains/Delete:
+  ✓ Get from empty map
+  ✓ Put new keys in any order, replace existing values
+  ✓ Delete present and absent keys
+
+Min/Max/Floor/Ceiling:
+  ✓ Empty map
+  ✓ Exact, between, below and above stored keys
+
+All/Range:
+  ✓ Ascending order, early termination
+  ✓ Half-open bounds, empty ranges
+
+Randomized:
+  ✓ Mixed operations match OrderedMap
+
+Concurrency:
+  ✓ Disjoint concurrent puts and deletes
+  ✓ Contended puts and deletes on the same keys
+  ✓ Readers and iterators alongside writers
+  ✓ Random operations from many goroutines keep the map consistent
+  ✓ Histories of short rounds are linearizable
+
+Snapshot:
+  ✓ Pairs kept while the map changes, empty map
+  ✓ Snapshots taken alongside writers hold every unchanged pair
+
+Clear:
+  ✓ Removes pairs
+
+CheckInvariants:
+  ✓ Valid maps, wrong size and height, unsorted, unlinked and dead nodes
+
+Binary encoding:
+  ✓ Round trip replaces the pairs, invalid data rejected
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+  ✓ Encoding during concurrent updates stays well formed
+*/
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/pairs"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/conctest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the keys in iteration order.
+func skipListKeys[K cmp.Ordered, V any](seq iter.Seq2[K, V]) []K {
+	keys := []K{}
+	for k := range seq {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Verifies the creation of an empty map
+func TestSkipListMap_NewSkipListMap_Empty(t *testing.T) {
+	m := NewSkipListMap[string, int]()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+	test.GotWantNoError(t, m.CheckInvariants())
+}
+
+// Verifies getting from an empty map
+func TestSkipListMap_Get_EmptyMap(t *testing.T) {
+	m := NewSkipListMap[string, int]()
+	v, ok := m.Get("a")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, 0)
+	test.GotWant(t, m.Contains("a"), false)
+	test.GotWant(t, m.Delete("a"), false)
+}
+
+// Verifies putting new keys in any order and replacing values
+func TestSkipListMap_Put(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	for _, k := range []int{5, 1, 9, 3, 7} {
+		test.GotWant(t, m.Put(k, k*10), true)
+	}
+	test.GotWant(t, m.Put(3, 33), false)
+	test.GotWant(t, m.Size(), 5)
+	test.GotWantNoError(t, m.CheckInvariants())
+
+	v, ok := m.Get(3)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 33)
+	test.GotWantSlice(t, skipListKeys(m.All()), []int{1, 3, 5, 7, 9})
+}
+
+// Verifies deleting present and absent keys
+func TestSkipListMap_Delete(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	for i := range 100 {
+		m.Put(i, i)
+	}
+
+	test.GotWant(t, m.Delete(100), false)
+	for i := 0; i < 100; i += 2 {
+		test.GotWant(t, m.Delete(i), true)
+	}
+	test.GotWant(t, m.Delete(0), false)
+	test.GotWant(t, m.Contains(0), false)
+	test.GotWant(t, m.Contains(1), true)
+	test.GotWant(t, m.Size(), 50)
+	test.GotWantNoError(t, m.CheckInvariants())
+}
+
+// Verifies neighbor queries on an empty map
+func TestSkipListMap_MinMax_EmptyMap(t *testing.T) {
+	m := NewSkipListMap[int, string]()
+	_, _, ok := m.Min()
+	test.GotWant(t, ok, false)
+	_, _, ok = m.Max()
+	test.GotWant(t, ok, false)
+	_, _, ok = m.Floor(1)
+	test.GotWant(t, ok, false)
+	_, _, ok = m.Ceiling(1)
+	test.GotWant(t, ok, false)
+}
+
+// Verifies neighbor queries for exact, between, below and above keys
+func TestSkipListMap_FloorCeiling(t *testing.T) {
+	m := NewSkipListMap[int, string]()
+	m.Put(20, "b")
+	m.Put(10, "a")
+	m.Put(30, "c")
+
+	k, v, _ := m.Min()
+	test.GotWant(t, k, 10)
+	test.GotWant(t, v, "a")
+	k, v, _ = m.Max()
+	test.GotWant(t, k, 30)
+	test.GotWant(t, v, "c")
+
+	k, _, _ = m.Floor(20)
+	test.GotWant(t, k, 20)
+	k, _, _ = m.Floor(25)
+	test.GotWant(t, k, 20)
+	k, _, _ = m.Floor(35)
+	test.GotWant(t, k, 30)
+	_, _, ok := m.Floor(5)
+	test.GotWant(t, ok, false)
+
+	k, _, _ = m.Ceiling(20)
+	test.GotWant(t, k, 20)
+	k, _, _ = m.Ceiling(25)
+	test.GotWant(t, k, 30)
+	k, _, _ = m.Ceiling(5)
+	test.GotWant(t, k, 10)
+	_, _, ok = m.Ceiling(35)
+	test.GotWant(t, ok, false)
+}
+
+// Verifies All yields pairs in ascending order and stops when the consumer
+// breaks
+func TestSkipListMap_All(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	for i := 99; i >= 0; i-- {
+		m.Put(i, -i)
+	}
+	for k, v := range m.All() {
+		test.GotWant(t, v, -k)
+	}
+	test.GotWant(t, slices.IsSorted(skipListKeys(m.All())), true)
+
+	count := 0
+	for range m.All() {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	test.GotWant(t, count, 3)
+}
+
+// Verifies Range honors half-open bounds and yields nothing for empty ranges
+func TestSkipListMap_Range(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	for i := 0; i < 100; i += 10 {
+		m.Put(i, i)
+	}
+
+	test.GotWantSlice(t, skipListKeys(m.Range(20, 50)), []int{20, 30, 40})
+	test.GotWantSlice(t, skipListKeys(m.Range(15, 45)), []int{20, 30, 40})
+	test.GotWantSlice(t, skipListKeys(m.Range(-5, 15)), []int{0, 10})
+	test.GotWantSlice(t, skipListKeys(m.Range(30, 30)), []int{})
+	test.GotWantSlice(t, skipListKeys(m.Range(50, 20)), []int{})
+	test.GotWantSlice(t, skipListKeys(m.Range(95, 200)), []int{})
+}
+
+// Verifies random operations match OrderedMap
+func TestSkipListMap_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(21, 22))
+	m := NewSkipListMap[int, int]()
+	model := NewOrderedMap[int, int]()
+
+	for i := range 20_000 {
+		k := r.IntN(500)
+		switch r.IntN(4) {
+		case 0:
+			test.GotWant(t, m.Delete(k), model.Delete(k))
+		case 1:
+			test.GotWant(t, m.Put(k, i), model.Put(k, i))
+		case 2:
+			got, ok := m.Get(k)
+			want, exists := model.Get(k)
+			test.GotWant(t, ok, exists)
+			test.GotWant(t, got, want)
+		case 3:
+			got, _, ok := m.Floor(k)
+			want, _, exists := model.Floor(k)
+			test.GotWant(t, ok, exists)
+			test.GotWant(t, got, want)
+		}
+		if i%1000 == 0 {
+			test.GotWantNoError(t, m.CheckInvariants())
+		}
+	}
+
+	test.GotWantNoError(t, m.CheckInvariants())
+	test.GotWantSlice(t, skipListKeys(m.All()), skipListKeys(model.All()))
+	test.GotWantSlice(t, skipListKeys(m.Range(100, 300)), skipListKeys(model.Range(100, 300)))
+}
+
+// Verifies concurrent puts and deletes on disjoint keys all take effect
+func TestSkipListMap_PutDelete_Concurrent(t *testing.T) {
+	const goroutines, perGoroutine = 8, 1000
+	m := NewSkipListMap[int, int]()
+
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Go(func() {
+			for i := range perGoroutine {
+				k := i*goroutines + g
+				m.Put(k, k)
+				if i%2 == 1 && !m.Delete(k) {
+					t.Errorf("Delete(%d) = false", k)
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	test.GotWantNoError(t, m.CheckInvariants())
+	test.GotWant(t, m.Size(), goroutines*perGoroutine/2)
+	for k := range m.All() {
+		test.GotWant(t, (k/goroutines)%2, 0)
+	}
+}
+
+// Verifies contended puts and deletes on the same keys leave the map
+// consistent, with each key's presence matching the net effect of the
+// operations that reported success
+func TestSkipListMap_Contended_Concurrent(t *testing.T) {
+	const goroutines, keys = 8, 16
+	m := NewSkipListMap[int, int]()
+	var net [keys]int64
+	var mu sync.Mutex
+
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Go(func() {
+			r := rand.New(rand.NewPCG(uint64(g), 0))
+			for range 5000 {
+				k := r.IntN(keys)
+				delta := int64(0)
+				if r.IntN(2) == 0 {
+					if m.Put(k, g) {
+						delta = 1
+					}
+				} else if m.Delete(k) {
+					delta = -1
+				}
+				mu.Lock()
+				net[k] += delta
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	test.GotWantNoError(t, m.CheckInvariants())
+	for k := range keys {
+		test.GotWant(t, m.Contains(k), net[k] == 1)
+	}
+}
+
+// Verifies readers and iterators running alongside writers only observe
+// keys that were put, in ascending order
+func TestSkipListMap_Readers_Concurrent(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	for i := 0; i < 1000; i += 2 {
+		m.Put(i, i)
+	}
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for i := 1; i < 1000; i += 2 {
+				m.Put(i, i)
+				m.Delete(i - 1)
+			}
+		})
+	}
+	for range 4 {
+		wg.Go(func() {
+			for range 20 {
+				keys := skipListKeys(m.All())
+				for i := 1; i < len(keys); i++ {
+					if keys[i-1] >= keys[i] {
+						t.Errorf("iteration yielded %d before %d", keys[i-1], keys[i])
+					}
+				}
+				for k, v := range m.Range(200, 400) {
+					if k != v || k < 200 || k >= 400 {
+						t.Errorf("Range yielded %d, %d", k, v)
+					}
+				}
+				if k, v, ok := m.Floor(500); ok && (k != v || k > 500) {
+					t.Errorf("Floor(500) = %d, %d", k, v)
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	test.GotWantNoError(t, m.CheckInvariants())
+	test.GotWant(t, m.Contains(999), true)
+	test.GotWant(t, m.Contains(998), false)
+}
+
+// Verifies random operations from many goroutines keep the map consistent
+func TestSkipListMap_Hammer(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	conctest.Hammer(t, conctest.Config{Seed: 1, Goroutines: 8, Ops: 2000}, m, mapHammerOps[*SkipListMap[int, int]]()...)
+	test.GotWantNoError(t, m.CheckInvariants())
+	test.GotWant(t, m.Size(), len(skipListKeys(m.All())))
+}
+
+// Verifies every history of short concurrent rounds can be explained by
+// some sequential order of the calls
+func TestSkipListMap_Linearizable(t *testing.T) {
+	conctest.CheckLinearizable(t, conctest.Config{Seed: 1, Runs: 300, Goroutines: 4, Ops: 3},
+		func() (*SkipListMap[int, int], map[int]int) { return NewSkipListMap[int, int](), map[int]int{} },
+		mapLinOps[*SkipListMap[int, int]]()...,
+	)
+}
+
+// Verifies a snapshot holds the pairs of the map and keeps them while the
+// map changes
+func TestSkipListMap_Snapshot(t *testing.T) {
+	test.GotWant(t, NewSkipListMap[int, int]().Snapshot().IsEmpty(), true)
+
+	m := NewSkipListMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	snap := m.Snapshot()
+	m.Put("a", 9)
+	m.Delete("b")
+	m.Put("c", 3)
+
+	test.GotWant(t, snap.Size(), 2)
+	v, ok := snap.Get("a")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 1)
+	test.GotWant(t, snap.Contains("b"), true)
+	test.GotWant(t, snap.Contains("c"), false)
+	test.GotWantDeep(t, maps.Collect(snap.All()), map[string]int{"a": 1, "b": 2})
+}
+
+// Verifies snapshots taken while other goroutines update the map hold
+// only values written to their keys, every pair no writer touches, and
+// the same pairs however the map changes afterwards
+func TestSkipListMap_Snapshot_Concurrent(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	for k := 256; k < 300; k++ {
+		m.Put(k, 10*k) // Outside the keys mapHammerOps changes
+	}
+
+	ops := append(mapHammerOps[*SkipListMap[int, int]](), conctest.Op[*SkipListMap[int, int]]{
+		Name: "Snapshot", Apply: func(t *testing.T, r *rand.Rand, m *SkipListMap[int, int]) {
+			snap := m.Snapshot()
+			size := snap.Size()
+			for k := 256; k < 300; k++ {
+				if !snap.Contains(k) {
+					t.Errorf("Snapshot lost unchanged key %d", k)
+				}
+			}
+
+			count := 0
+			for k, v := range snap.All() {
+				count++
+				if v != 10*k {
+					t.Errorf("Snapshot holds %d, %d", k, v)
+				}
+			}
+			if count != size {
+				t.Errorf("Snapshot yielded %d pairs, Size() = %d", count, size)
+			}
+		},
+	})
+	conctest.Hammer(t, conctest.Config{Seed: 1, Goroutines: 8, Ops: 500}, m, ops...)
+
+	test.GotWantDeep(t, maps.Collect(m.Snapshot().All()), maps.Collect(m.All()))
+}
+
+// Returns random Put, Delete, Get and All operations for hammering a
+// concurrent Map[int, int]. Every key maps to ten times itself, so
+// readers can check the values they observe.
+func mapHammerOps[S Map[int, int]]() []conctest.Op[S] {
+	return []conctest.Op[S]{
+		{Name: "Put", Weight: 3, Apply: func(t *testing.T, r *rand.Rand, m S) {
+			k := r.IntN(256)
+			m.Put(k, 10*k)
+		}},
+		{Name: "Delete", Weight: 2, Apply: func(t *testing.T, r *rand.Rand, m S) {
+			m.Delete(r.IntN(256))
+		}},
+		{Name: "Get", Weight: 3, Apply: func(t *testing.T, r *rand.Rand, m S) {
+			k := r.IntN(256)
+			if v, ok := m.Get(k); ok && v != 10*k {
+				t.Errorf("Get(%d) = %d", k, v)
+			}
+		}},
+		{Name: "All", Apply: func(t *testing.T, r *rand.Rand, m S) {
+			for k, v := range m.All() {
+				if v != 10*k {
+					t.Errorf("All yielded %d, %d", k, v)
+				}
+			}
+		}},
+	}
+}
+
+// Returns Put, Get and Delete calls on a few keys of a concurrent
+// Map[int, int] for linearizability checks against a map model.
+func mapLinOps[S Map[int, int]]() []conctest.LinOp[S, map[int]int] {
+	return []conctest.LinOp[S, map[int]int]{
+		{Name: "Put", Call: func(r *rand.Rand, m S) (string, func(map[int]int) (map[int]int, bool)) {
+			k, v := r.IntN(3), r.IntN(10)
+			added := m.Put(k, v)
+			return fmt.Sprintf("Put(%d, %d) = %t", k, v, added), func(model map[int]int) (map[int]int, bool) {
+				_, present := model[k]
+				next := maps.Clone(model)
+				next[k] = v
+				return next, added == !present
+			}
+		}},
+		{Name: "Get", Call: func(r *rand.Rand, m S) (string, func(map[int]int) (map[int]int, bool)) {
+			k := r.IntN(3)
+			v, ok := m.Get(k)
+			return fmt.Sprintf("Get(%d) = %d, %t", k, v, ok), func(model map[int]int) (map[int]int, bool) {
+				want, present := model[k]
+				return model, ok == present && v == want
+			}
+		}},
+		{Name: "Delete", Call: func(r *rand.Rand, m S) (string, func(map[int]int) (map[int]int, bool)) {
+			k := r.IntN(3)
+			deleted := m.Delete(k)
+			return fmt.Sprintf("Delete(%d) = %t", k, deleted), func(model map[int]int) (map[int]int, bool) {
+				_, present := model[k]
+				next := maps.Clone(model)
+				delete(next, k)
+				return next, deleted == present
+			}
+		}},
+	}
+}
+
+// Verifies Clear empties the map and it stays usable
+func TestSkipListMap_Clear(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	for i := range 100 {
+		m.Put(i, i)
+	}
+
+	m.Clear()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+	test.GotWant(t, m.Contains(50), false)
+
+	m.Put(7, 7)
+	test.GotWantSlice(t, skipListKeys(m.All()), []int{7})
+}
+
+// Verifies CheckInvariants accepts consistent maps and reports a wrong
+// size or height, an unsorted level, a node missing from the level below
+// and a linked node that is not live
+func TestSkipListMap_CheckInvariants(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	test.GotWantNoError(t, m.CheckInvariants())
+	for k := 1; k <= 5; k++ {
+		m.Put(k, k)
+	}
+	test.GotWantNoError(t, m.CheckInvariants())
+
+	m.size.Add(1)
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: size is 6, bottom level holds 5 nodes")
+	m.size.Add(-1)
+
+	height := m.height.Load()
+	m.height.Store(0)
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: height is 0, want 1 to 32")
+
+	stray := &skipListNode[int, int]{key: 9, next: make([]atomic.Pointer[skipListNode[int, int]], 2)}
+	stray.fullyLinked.Store(true)
+	above := m.head.next[1].Load()
+	m.head.next[1].Store(stray)
+	m.height.Store(1)
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: level 1 is above the height 1 but not empty")
+
+	m.height.Store(max(height, 2))
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: key 9 is on level 1 but not on the level below")
+	m.head.next[1].Store(above)
+	m.height.Store(height)
+
+	first := m.head.next[0].Load()
+	first.key = 10
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: key 2 follows key 10 on level 0")
+	first.key = 1
+
+	first.marked.Store(true)
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: key 1 is linked but not live")
+	first.marked.Store(false)
+	test.GotWantNoError(t, m.CheckInvariants())
+}
+
+// Verifies a round trip restores the pairs in key order and invalid data
+// is rejected
+func TestSkipListMap_MarshalBinary(t *testing.T) {
+	src := NewSkipListMap[string, int]()
+	src.Put("b", 2)
+	src.Put("a", 1)
+	other, _ := NewHashMap[string, int]().MarshalBinary()
+	dst := NewSkipListMap[string, int]()
+	dst.Put("z", 26)
+	codectest.RoundTrip(t, src, dst,
+		func(m *SkipListMap[string, int]) []pairs.Pair[string, int] { return pairs.Collect(m.All()) }, other)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the pairs in key order
+func TestSkipListMap_WriteTo_ReadFrom(t *testing.T) {
+	src := NewSkipListMap[int, int]()
+	for i := range 50 {
+		src.Put(i, -i)
+	}
+	dst := NewSkipListMap[int, int]()
+	codectest.StreamRoundTrip(t, src, dst,
+		func(m *SkipListMap[int, int]) []pairs.Pair[int, int] { return pairs.Collect(m.All()) })
+}
+
+// Verifies encodings taken while other goroutines update the map always
+// decode
+func TestSkipListMap_MarshalBinary_Concurrent(t *testing.T) {
+	m := NewSkipListMap[int, int]()
+	var wg sync.WaitGroup
+	for g := range 4 {
+		wg.Go(func() {
+			for i := range 1000 {
+				k := (g*1000 + i) % 300
+				if i%2 == 0 {
+					m.Put(k, i)
+				} else {
+					m.Delete(k)
+				}
+			}
+		})
+	}
+	wg.Go(func() {
+		for range 50 {
+			data, err := m.MarshalBinary()
+			test.GotWantNoError(t, err)
+			test.GotWantNoError(t, NewSkipListMap[int, int]().UnmarshalBinary(data))
+		}
+	})
+	wg.Wait()
+}
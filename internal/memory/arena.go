@@ -0,0 +1,91 @@
+package memory
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
+
+// Arena hands out objects of type T carved from large slabs.
+//
+// Allocating a node per insertion costs one heap allocation each, and the
+// garbage collector later has to find and free every one of them. An
+// arena instead allocates a slab of many objects at once and returns
+// pointers into it, so building a structure of n nodes takes n/slabSize
+// allocations. Objects are never freed one by one: Release forgets every
+// slab in O(1), and the memory is reclaimed together once the structure
+// built from it is dropped. That suits short-lived bulk structures that
+// are built, queried and thrown away as a whole.
+//
+// Release is memory-safe: it does not invalidate pointers already handed
+// out, as the garbage collector keeps a slab alive while any of its
+// objects is referenced. A single live object therefore pins its whole
+// slab, which is the price of the batching.
+//
+// Design decisions:
+//   - Fixed slab size: Every slab holds the same number of objects
+//   - No individual frees: Removed objects stay in their slab until it
+//     is released; combine with a FreeList to recycle them
+//   - Lazy slabs: The first slab is allocated by the first Alloc
+//
+// Space complexity: O(n) where n is the number of objects allocated since
+// the last Release, rounded up to whole slabs.
+type Arena[T any] struct {
+	slab     []T // Current slab, len is the number of objects handed out
+	slabSize int
+	slabs    int // Slabs allocated since the last Release
+	size     int // Objects handed out since the last Release
+}
+
+// NewArena creates an arena that allocates slabs of the given number of
+// objects.
+//
+// Panics if slabSize is not greater than 0.
+//
+// Example:
+//
+//	a := NewArena[node](1024)
+//	n := a.Alloc()  // First slab of 1024 nodes is allocated
+//	m := a.Alloc()  // Served from the same slab
+//	a.Release()     // Forgets the slab; n and m stay valid
+func NewArena[T any](slabSize int) *Arena[T] {
+	panics.RequireGreaterThan(slabSize, 0, "slab size")
+	return &Arena[T]{slabSize: slabSize}
+}
+
+// Alloc returns a pointer to a zero object, allocating a new slab when the
+// current one is full.
+//
+// Time complexity: O(1) amortized
+func (a *Arena[T]) Alloc() *T {
+	n := len(a.slab)
+	if n == cap(a.slab) {
+		a.slab = make([]T, 0, a.slabSize)
+		a.slabs++
+		n = 0
+	}
+
+	a.slab = a.slab[:n+1]
+	a.size++
+	return &a.slab[n]
+}
+
+// Release forgets every slab. Later allocations start a new slab; objects
+// already handed out stay valid for as long as they are referenced.
+//
+// Time complexity: O(1)
+func (a *Arena[T]) Release() {
+	a.slab = nil
+	a.slabs = 0
+	a.size = 0
+}
+
+// Slabs returns the number of slabs allocated since the last Release.
+//
+// Time complexity: O(1)
+func (a *Arena[T]) Slabs() int {
+	return a.slabs
+}
+
+// Size returns the number of objects handed out since the last Release.
+//
+// Time complexity: O(1)
+func (a *Arena[T]) Size() int {
+	return a.size
+}
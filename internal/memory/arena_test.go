@@ -0,0 +1,76 @@
+package memory
+
+/*
+Test Coverage
+=============
+Constructor (NewArena):
+  ✓ Empty arena
+  ✓ Non-positive slab size panics
+
+Alloc:
+  ✓ Zero objects, distinct addresses
+  ✓ New slab when the current one is full
+
+Release:
+  ✓ Counters reset, handed-out objects stay valid
+*/
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty arena
+func TestArena_NewArena_Empty(t *testing.T) {
+	a := NewArena[testNode](4)
+	test.GotWant(t, a.Size(), 0)
+	test.GotWant(t, a.Slabs(), 0)
+}
+
+// Verifies a non-positive slab size panics
+func TestArena_NewArena_InvalidSlabSize(t *testing.T) {
+	test.GotWantPanic(t, func() { NewArena[testNode](0) }, `"slab size" must be > 0, got 0`)
+}
+
+// Verifies allocations return distinct zero objects and fill a slab
+// before starting the next one
+func TestArena_Alloc(t *testing.T) {
+	a := NewArena[testNode](3)
+	seen := map[*testNode]bool{}
+	for i := range 7 {
+		n := a.Alloc()
+		test.GotWant(t, *n, testNode{})
+		test.GotWant(t, seen[n], false)
+		seen[n] = true
+		n.value = i
+
+		test.GotWant(t, a.Size(), i+1)
+		test.GotWant(t, a.Slabs(), i/3+1)
+	}
+
+	// Earlier objects are unaffected by later allocations
+	i := 0
+	for n := range seen {
+		i += n.value
+	}
+	test.GotWant(t, i, 0+1+2+3+4+5+6)
+}
+
+// Verifies Release resets the counters and leaves handed-out objects valid
+func TestArena_Release(t *testing.T) {
+	a := NewArena[testNode](2)
+	first := a.Alloc()
+	first.value = 42
+	a.Alloc()
+	a.Alloc()
+
+	a.Release()
+	test.GotWant(t, a.Size(), 0)
+	test.GotWant(t, a.Slabs(), 0)
+	test.GotWant(t, first.value, 42)
+
+	n := a.Alloc()
+	test.GotWant(t, *n, testNode{})
+	test.GotWant(t, a.Slabs(), 1)
+}
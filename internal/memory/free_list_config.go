@@ -0,0 +1,64 @@
+package memory
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
+
+// FreeListConfig controls how many objects a FreeList retains and how
+// they are cleaned up before reuse.
+type FreeListConfig[T any] struct {
+	// Capacity represents the maximum number of released objects kept for
+	// reuse. Objects released while the list is full are dropped and left
+	// to the garbage collector.
+	//
+	// Bounding the list caps the memory held by idle objects after a
+	// burst, at the price of allocating again when the next burst comes.
+	//
+	// Recommended values:
+	//   0:       Unbounded, keep everything (default)
+	//   64-1024: Structures whose size fluctuates around a steady state
+	//
+	// Valid range: [0, ...]
+	Capacity int
+
+	// Reset prepares a released object for reuse.
+	//
+	// When nil, released objects are set to the zero value, which drops
+	// every reference they hold so the garbage collector can reclaim what
+	// they pointed to. Buffers should instead keep their backing storage,
+	// which is the point of reusing them:
+	//
+	//	Reset: func(b *[]byte) { *b = (*b)[:0] }
+	Reset func(value *T)
+}
+
+// Validates the configuration values.
+//
+// Panics if values are invalid:
+//   - Capacity < 0
+func (c *FreeListConfig[T]) validate() {
+	panics.RequireNonNegative(c.Capacity, "capacity")
+}
+
+// FreeListOption configures a FreeList created with NewFreeListWith.
+// Options are applied in order on top of the default configuration.
+//
+// Options are generic in the object type, which Go cannot infer from
+// their arguments alone; WithCapacity takes it as a type argument:
+//
+//	nodes := NewFreeListWith(WithCapacity[node](256))
+type FreeListOption[T any] func(*FreeListConfig[T])
+
+// WithCapacity bounds the number of released objects kept for reuse.
+// See FreeListConfig.Capacity.
+func WithCapacity[T any](capacity int) FreeListOption[T] {
+	return func(c *FreeListConfig[T]) {
+		c.Capacity = capacity
+	}
+}
+
+// WithReset sets the function that prepares released objects for reuse.
+// See FreeListConfig.Reset.
+func WithReset[T any](reset func(value *T)) FreeListOption[T] {
+	return func(c *FreeListConfig[T]) {
+		c.Reset = reset
+	}
+}
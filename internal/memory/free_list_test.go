@@ -0,0 +1,152 @@
+package memory
+
+/*
+Test Coverage
+=============
+Constructor (NewFreeList/NewFreeListWith):
+  ✓ Empty free list
+  ✓ Options applied in order
+  ✓ Negative capacity panics
+
+Get/Put:
+  ✓ Allocates when empty, reuses released objects in LIFO order
+  ✓ Released objects are zeroed by default
+  ✓ Custom reset keeps buffer storage
+  ✓ Capacity bound drops surplus objects
+
+Reserve/Clear:
+  ✓ Reserve allocates up to n, respects capacity
+  ✓ Clear drops retained objects
+
+Stats:
+  ✓ Counters across a churn workload
+*/
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+type testNode struct {
+	value int
+	next  *testNode
+}
+
+// Verifies the creation of an empty free list
+func TestFreeList_NewFreeList_Empty(t *testing.T) {
+	f := NewFreeList[testNode]()
+	test.GotWant(t, f.Size(), 0)
+	test.GotWant(t, f.Stats(), FreeListStats{})
+}
+
+// Verifies options are applied in order, later ones overriding earlier ones
+func TestFreeList_NewFreeListWith_Options(t *testing.T) {
+	f := NewFreeListWith(WithCapacity[testNode](4), WithCapacity[testNode](1))
+	f.Put(&testNode{})
+	f.Put(&testNode{})
+	test.GotWant(t, f.Size(), 1)
+}
+
+// Verifies a negative capacity panics
+func TestFreeList_NewFreeListWith_InvalidCapacity(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewFreeListWith(WithCapacity[testNode](-1))
+	}, `"capacity" must be >= 0, got -1`)
+}
+
+// Verifies Get allocates when empty and reuses released objects, most
+// recently released first
+func TestFreeList_GetPut_Reuse(t *testing.T) {
+	f := NewFreeList[testNode]()
+	a, b := f.Get(), f.Get()
+	if a == b {
+		t.Fatal("two Gets on an empty free list returned the same object")
+	}
+
+	test.GotWant(t, f.Put(a), true)
+	test.GotWant(t, f.Put(b), true)
+	test.GotWant(t, f.Size(), 2)
+	test.GotWant(t, f.Get(), b)
+	test.GotWant(t, f.Get(), a)
+	test.GotWant(t, f.Size(), 0)
+}
+
+// Verifies released objects are zeroed, dropping their references
+func TestFreeList_Put_Zeroes(t *testing.T) {
+	f := NewFreeList[testNode]()
+	n := f.Get()
+	n.value, n.next = 7, &testNode{}
+	f.Put(n)
+
+	test.GotWant(t, *f.Get(), testNode{})
+}
+
+// Verifies a custom reset keeps the storage of released buffers
+func TestFreeList_Put_CustomReset(t *testing.T) {
+	f := NewFreeListWith(WithReset(func(b *[]byte) { *b = (*b)[:0] }))
+	b := f.Get()
+	*b = append(*b, make([]byte, 100)...)
+	f.Put(b)
+
+	reused := f.Get()
+	test.GotWant(t, len(*reused), 0)
+	test.GotWant(t, cap(*reused) >= 100, true)
+}
+
+// Verifies objects released beyond the capacity are dropped
+func TestFreeList_Put_Capacity(t *testing.T) {
+	f := NewFreeListWith(WithCapacity[testNode](2))
+	nodes := []*testNode{f.Get(), f.Get(), f.Get()}
+	test.GotWant(t, f.Put(nodes[0]), true)
+	test.GotWant(t, f.Put(nodes[1]), true)
+	test.GotWant(t, f.Put(nodes[2]), false)
+	test.GotWant(t, f.Size(), 2)
+	test.GotWant(t, f.Stats().Dropped, 1)
+}
+
+// Verifies Reserve fills the list up to n without exceeding the capacity
+func TestFreeList_Reserve(t *testing.T) {
+	f := NewFreeList[testNode]()
+	f.Reserve(5)
+	test.GotWant(t, f.Size(), 5)
+	f.Reserve(3)
+	test.GotWant(t, f.Size(), 5)
+	test.GotWant(t, f.Stats().Allocated, 5)
+
+	bounded := NewFreeListWith(WithCapacity[testNode](4))
+	bounded.Reserve(10)
+	test.GotWant(t, bounded.Size(), 4)
+}
+
+// Verifies Clear drops every retained object
+func TestFreeList_Clear(t *testing.T) {
+	f := NewFreeList[testNode]()
+	f.Reserve(3)
+	f.Clear()
+	test.GotWant(t, f.Size(), 0)
+	f.Get()
+	test.GotWant(t, f.Stats().Allocated, 4)
+}
+
+// Verifies the counters over a workload that repeatedly grows and shrinks
+// a linked list of recycled nodes
+func TestFreeList_Stats_Churn(t *testing.T) {
+	f := NewFreeList[testNode]()
+	var head *testNode
+	for range 10 {
+		for i := range 100 {
+			n := f.Get()
+			n.value, n.next = i, head
+			head = n
+		}
+		for head != nil {
+			next := head.next
+			f.Put(head)
+			head = next
+		}
+	}
+
+	test.GotWant(t, f.Stats(), FreeListStats{Allocated: 100, Reused: 900})
+	test.GotWant(t, f.Size(), 100)
+}
@@ -0,0 +1,122 @@
+// Package pairs provides a generic two-element tuple and the helpers that
+// move data between pairs and the structures: zipping two iterable
+// structures together, unzipping pairs into two containers, and turning
+// the key-value iteration of maps and search trees into pairs and back.
+package pairs
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Pair holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Of creates a pair of the two values.
+//
+// Example:
+//
+//	p := Of("a", 1)  // p.First is "a", p.Second is 1
+func Of[A any, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Values returns both values of the pair.
+func (p Pair[A, B]) Values() (A, B) {
+	return p.First, p.Second
+}
+
+// Swap returns the pair with its values exchanged.
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+// Zip pairs the elements of two iterable structures by position, in the
+// order their All methods yield them. The result is as long as the
+// shorter of the two; the rest of the longer one is not read.
+//
+// Time complexity: O(min(n, m))
+//
+// Example:
+//
+//	names := structures.NewDoublyLinkedList("a", "b", "c")
+//	ids := structures.NewDoublyLinkedList(1, 2)
+//	Zip[string, int](names, ids)  // Returns [{a 1} {b 2}]
+func Zip[A any, B any](a collections.Iterable[A], b collections.Iterable[B]) []Pair[A, B] {
+	next, stop := iter.Pull(b.All())
+	defer stop()
+
+	var pairs []Pair[A, B]
+	for x := range a.All() {
+		y, ok := next()
+		if !ok {
+			break
+		}
+
+		pairs = append(pairs, Pair[A, B]{First: x, Second: y})
+	}
+
+	return pairs
+}
+
+// Unzip adds the first values of the pairs to a and the second values to
+// b, in order. Returns the number of values each container accepted.
+//
+// Time complexity: O(n) Add operations on each container
+//
+// Example:
+//
+//	a := structures.NewDoublyLinkedList[string]()
+//	b := structures.NewHashSet[int]()
+//	Unzip(pairs, a, b)  // a holds the first values, b the distinct seconds
+func Unzip[A any, B any](pairs []Pair[A, B], a collections.Addable[A], b collections.Addable[B]) (int, int) {
+	addedA, addedB := 0, 0
+	for _, p := range pairs {
+		if a.Add(p.First) {
+			addedA++
+		}
+		if b.Add(p.Second) {
+			addedB++
+		}
+	}
+
+	return addedA, addedB
+}
+
+// Collect gathers the pairs of a two-value sequence, such as the All
+// method of a map or search tree, in iteration order.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	m := structures.NewOrderedMap[string, int]()
+//	m.Put("b", 2)
+//	m.Put("a", 1)
+//	Collect(m.All())  // Returns [{a 1} {b 2}]
+func Collect[A any, B any](seq iter.Seq2[A, B]) []Pair[A, B] {
+	var pairs []Pair[A, B]
+	for x, y := range seq {
+		pairs = append(pairs, Pair[A, B]{First: x, Second: y})
+	}
+
+	return pairs
+}
+
+// All returns a two-value iterator over the pairs, the inverse of
+// Collect.
+//
+// Time complexity: O(n) for a full iteration
+func All[A any, B any](pairs []Pair[A, B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for _, p := range pairs {
+			if !yield(p.First, p.Second) {
+				return
+			}
+		}
+	}
+}
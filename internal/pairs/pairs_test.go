@@ -0,0 +1,98 @@
+package pairs_test
+
+/*
+Test Coverage
+=============
+Pair/Of:
+  ✓ Values and Swap
+
+Zip:
+  ✓ Equal lengths, pairs by position
+  ✓ Shorter side ends the result, either side
+  ✓ Empty structures
+
+Unzip:
+  ✓ Values added in order, accepted values counted
+
+Collect/All:
+  ✓ Round trip of a map iteration, early termination
+*/
+
+import (
+	"slices"
+	"testing"
+
+	lists "github.com/apotourlyan/godatastructures/internal/lists/structures"
+	maps "github.com/apotourlyan/godatastructures/internal/maps/structures"
+	"github.com/apotourlyan/godatastructures/internal/pairs"
+	sets "github.com/apotourlyan/godatastructures/internal/sets/structures"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the values of a pair and swapping them
+func TestPair(t *testing.T) {
+	p := pairs.Of("a", 1)
+	test.GotWant(t, p, pairs.Pair[string, int]{First: "a", Second: 1})
+
+	first, second := p.Values()
+	test.GotWant(t, first, "a")
+	test.GotWant(t, second, 1)
+	test.GotWant(t, p.Swap(), pairs.Of(1, "a"))
+}
+
+// Verifies Zip pairs elements by position
+func TestZip(t *testing.T) {
+	a := lists.NewDoublyLinkedList("a", "b", "c")
+	b := lists.NewDoublyLinkedList(1, 2, 3)
+	test.GotWantSlice(t, pairs.Zip[string, int](a, b), []pairs.Pair[string, int]{pairs.Of("a", 1), pairs.Of("b", 2), pairs.Of("c", 3)})
+}
+
+// Verifies the shorter structure ends the result
+func TestZip_Uneven(t *testing.T) {
+	long := lists.NewDoublyLinkedList(1, 2, 3)
+	short := lists.NewDoublyLinkedList("x")
+	test.GotWantSlice(t, pairs.Zip[int, string](long, short), []pairs.Pair[int, string]{pairs.Of(1, "x")})
+	test.GotWantSlice(t, pairs.Zip[string, int](short, long), []pairs.Pair[string, int]{pairs.Of("x", 1)})
+}
+
+// Verifies zipping empty structures yields no pairs
+func TestZip_Empty(t *testing.T) {
+	empty := lists.NewDoublyLinkedList[int]()
+	full := lists.NewDoublyLinkedList(1)
+	test.GotWant(t, len(pairs.Zip[int, int](empty, full)), 0)
+	test.GotWant(t, len(pairs.Zip[int, int](full, empty)), 0)
+}
+
+// Verifies Unzip adds the values in order and counts accepted values
+func TestUnzip(t *testing.T) {
+	ps := []pairs.Pair[string, int]{pairs.Of("a", 1), pairs.Of("b", 2), pairs.Of("c", 1)}
+	a := lists.NewDoublyLinkedList[string]()
+	b := sets.NewHashSet[int]()
+
+	addedA, addedB := pairs.Unzip(ps, a, b)
+	test.GotWant(t, addedA, 3)
+	test.GotWant(t, addedB, 2)
+	test.GotWantSlice(t, slices.Collect(a.All()), []string{"a", "b", "c"})
+	test.GotWant(t, b.Contains(1) && b.Contains(2), true)
+}
+
+// Verifies Collect and All round trip the iteration of a map
+func TestCollect_All(t *testing.T) {
+	m := maps.NewOrderedMap[string, int]()
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+
+	ps := pairs.Collect(m.All())
+	test.GotWantSlice(t, ps, []pairs.Pair[string, int]{pairs.Of("a", 1), pairs.Of("b", 2), pairs.Of("c", 3)})
+
+	var keys []string
+	for k, v := range pairs.All(ps) {
+		if v > 2 {
+			break
+		}
+		keys = append(keys, k)
+	}
+
+	test.GotWantSlice(t, keys, []string{"a", "b"})
+}
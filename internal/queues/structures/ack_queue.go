@@ -0,0 +1,300 @@
+package structures
+
+import (
+	"sync"
+	"time"
+
+	lists "github.com/apotourlyan/godatastructures/internal/lists/structures"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Delivery is an element handed out by AckQueue.Dequeue. It stays in
+// flight until its ID is passed to Ack or Nack, or its timeout expires.
+type Delivery[T any] struct {
+	ID      uint64 // Identifies the delivery for Ack and Nack, never reused
+	Value   T
+	Attempt int // 1 on the first delivery of the element, +1 on every redelivery
+}
+
+// Represents an element waiting for delivery and how often it was
+// delivered before.
+type ackMessage[T any] struct {
+	value    T
+	attempts int
+}
+
+// Represents a delivery in flight and when it times out.
+type ackDelivery[T any] struct {
+	id       uint64
+	message  ackMessage[T]
+	deadline time.Time // Zero if deliveries never time out
+}
+
+// AckQueue implements a FIFO task queue with acknowledgments.
+//
+// Dequeue does not remove an element for good: it hands out a Delivery
+// that stays in flight until the caller reports the outcome. Ack removes
+// the element, Nack puts it back at the end of the queue, and a delivery
+// that is not settled within the AckTimeout is requeued as if rejected
+// (see AckQueueConfig). Every element is thus processed at least once,
+// and possibly more often if a slow worker acknowledges too late; the
+// Attempt of a delivery tells how often its element was handed out.
+//
+// Design decisions:
+//   - Ring deque for waiting elements: O(1) enqueue, dequeue and requeue
+//   - In-flight list in deadline order: All deliveries share one timeout,
+//     so dequeue order is deadline order and expiry checks only look at
+//     the front
+//   - Map from ID to list node: O(1) Ack and Nack anywhere in the list
+//   - Lazy timeouts: Expired deliveries are requeued by the next operation,
+//     no goroutine is needed
+//
+// All methods are safe for concurrent use by multiple goroutines.
+//
+// Space complexity: O(n + f) where n is the number of waiting elements
+// and f the number of deliveries in flight.
+type AckQueue[T any] struct {
+	mu       sync.Mutex
+	ready    *RingDeque[ackMessage[T]]
+	inFlight map[uint64]*lists.DoublyLinkedListNode[ackDelivery[T]]
+	order    lists.DoublyLinkedList[ackDelivery[T]] // In flight, earliest deadline first
+	nextID   uint64
+	config   AckQueueConfig
+}
+
+// NewAckQueue creates a queue containing the given values, with
+// deliveries that time out after 30 seconds.
+//
+// Example:
+//
+//	q := NewAckQueue("resize img-1", "resize img-2")
+//	d, _ := q.Dequeue()
+//	if err := process(d.Value); err != nil {
+//	    q.Nack(d.ID)  // Retry later
+//	} else {
+//	    q.Ack(d.ID)   // Done for good
+//	}
+func NewAckQueue[T any](values ...T) *AckQueue[T] {
+	q := newAckQueue[T](defaultAckQueueConfig())
+	for _, v := range values {
+		q.Enqueue(v)
+	}
+
+	return q
+}
+
+// NewAckQueueWith creates an empty queue from the default configuration
+// adjusted by the given options. Options are applied in order.
+//
+// Panics if AckTimeout ends up negative.
+//
+// Example:
+//
+//	q := NewAckQueueWith[string](WithAckTimeout(5 * time.Minute))
+func NewAckQueueWith[T any](opts ...AckQueueOption) *AckQueue[T] {
+	c := defaultAckQueueConfig()
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return newAckQueue[T](c)
+}
+
+// NewAckQueueWithConfig creates an empty queue with custom settings.
+// See AckQueueConfig for the options.
+//
+// Panics if AckTimeout is negative.
+//
+// Deprecated: Use NewAckQueueWith with WithAckTimeout.
+func NewAckQueueWithConfig[T any](config AckQueueConfig) *AckQueue[T] {
+	return newAckQueue[T](config)
+}
+
+// Creates an empty queue with the given settings.
+// Panics if AckTimeout is negative.
+func newAckQueue[T any](config AckQueueConfig) *AckQueue[T] {
+	panics.RequireNonNegative(config.AckTimeout, "ack timeout")
+
+	return &AckQueue[T]{
+		ready:    NewRingDeque[ackMessage[T]](),
+		inFlight: make(map[uint64]*lists.DoublyLinkedListNode[ackDelivery[T]]),
+		config:   config,
+	}
+}
+
+// Enqueue adds an element to the back of the queue.
+//
+// Time complexity: O(1) amortized
+func (q *AckQueue[T]) Enqueue(value T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.ready.PushBack(ackMessage[T]{value: value})
+}
+
+// Dequeue hands out the element at the front of the queue as a delivery
+// in flight. The caller must settle it with Ack or Nack before the
+// AckTimeout, or the element is requeued.
+// Returns ErrEmptyQueue if no element is waiting; elements in flight do
+// not count.
+//
+// Time complexity: O(1) amortized, plus O(e) to requeue e expired
+// deliveries
+//
+// Example:
+//
+//	q := NewAckQueue("a")
+//	d, _ := q.Dequeue()  // d.Value is "a", d.Attempt is 1
+//	q.Dequeue()          // Returns ErrEmptyQueue, "a" is in flight
+func (q *AckQueue[T]) Dequeue() (Delivery[T], error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	q.requeueExpired(now)
+	m, err := q.ready.PopFront()
+	if err != nil {
+		return Delivery[T]{}, ErrEmptyQueue
+	}
+
+	m.attempts++
+	q.nextID++
+	d := ackDelivery[T]{id: q.nextID, message: m}
+	if q.config.AckTimeout > 0 {
+		d.deadline = now.Add(q.config.AckTimeout)
+	}
+
+	q.inFlight[d.id] = q.order.PushBack(d)
+	return Delivery[T]{ID: d.id, Value: m.value, Attempt: m.attempts}, nil
+}
+
+// Ack acknowledges a delivery, removing its element for good.
+// Returns ErrUnknownDelivery if the delivery is not in flight, for
+// example because it timed out and its element was requeued.
+//
+// Time complexity: O(1) expected, plus O(e) to requeue e expired
+// deliveries
+func (q *AckQueue[T]) Ack(id uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.requeueExpired(time.Now())
+	_, err := q.settle(id)
+	return err
+}
+
+// Nack rejects a delivery, putting its element back at the end of the
+// queue for another delivery.
+// Returns ErrUnknownDelivery if the delivery is not in flight.
+//
+// Time complexity: O(1) expected, plus O(e) to requeue e expired
+// deliveries
+func (q *AckQueue[T]) Nack(id uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.requeueExpired(time.Now())
+	d, err := q.settle(id)
+	if err == nil {
+		q.ready.PushBack(d.message)
+	}
+
+	return err
+}
+
+// RequeueExpired puts the elements of every timed-out delivery back at
+// the end of the queue, oldest first. The other methods do this on their
+// own; call it to bring the counts up to date without other activity.
+// Returns the number of deliveries requeued.
+//
+// Time complexity: O(e) where e is the number of expired deliveries
+func (q *AckQueue[T]) RequeueExpired() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.requeueExpired(time.Now())
+}
+
+// Snapshot returns an immutable view of the elements waiting for
+// delivery, front to back, after requeueing expired deliveries. Elements
+// in flight are not included. The queue is locked only while the
+// elements are copied.
+//
+// Time complexity: O(n), plus O(e) to requeue e expired deliveries
+//
+// Space complexity: O(n) - the waiting elements are copied
+func (q *AckQueue[T]) Snapshot() *QueueSnapshot[T] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.requeueExpired(time.Now())
+	values := make([]T, 0, q.ready.Size())
+	for m := range q.ready.All() {
+		values = append(values, m.value)
+	}
+
+	return &QueueSnapshot[T]{values: values}
+}
+
+// IsEmpty returns true if no element is waiting for delivery. Elements
+// in flight may still return.
+//
+// Time complexity: O(1), plus O(e) to requeue e expired deliveries
+func (q *AckQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Size returns the number of elements waiting for delivery, after
+// requeueing expired deliveries.
+//
+// Time complexity: O(1), plus O(e) to requeue e expired deliveries
+func (q *AckQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.requeueExpired(time.Now())
+	return q.ready.Size()
+}
+
+// InFlight returns the number of deliveries awaiting Ack or Nack, after
+// requeueing expired ones.
+//
+// Time complexity: O(1), plus O(e) to requeue e expired deliveries
+func (q *AckQueue[T]) InFlight() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.requeueExpired(time.Now())
+	return len(q.inFlight)
+}
+
+// Removes a delivery from flight.
+// Returns the delivery, or ErrUnknownDelivery if it is not in flight.
+// Must be called with the lock held.
+func (q *AckQueue[T]) settle(id uint64) (ackDelivery[T], error) {
+	n, ok := q.inFlight[id]
+	if !ok {
+		return ackDelivery[T]{}, ErrUnknownDelivery
+	}
+
+	delete(q.inFlight, id)
+	d, _ := q.order.Remove(n)
+	return d, nil
+}
+
+// Requeues the deliveries whose deadline is not after now.
+// Returns the number requeued. Must be called with the lock held.
+func (q *AckQueue[T]) requeueExpired(now time.Time) int {
+	if q.config.AckTimeout == 0 {
+		return 0
+	}
+
+	count := 0
+	for n := q.order.Front(); n != nil && !now.Before(n.Value.deadline); n = q.order.Front() {
+		d, _ := q.settle(n.Value.id)
+		q.ready.PushBack(d.message)
+		count++
+	}
+
+	return count
+}
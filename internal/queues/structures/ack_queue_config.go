@@ -0,0 +1,52 @@
+package structures
+
+import "time"
+
+// AckQueueConfig controls how long deliveries of an AckQueue may stay in
+// flight.
+//
+// A delivery that is neither acknowledged nor rejected within AckTimeout
+// is assumed lost, for example because its worker crashed, and its
+// element is requeued for another delivery. Timeouts are detected lazily
+// by the operations of the queue; see AckQueue.RequeueExpired.
+//
+// Default configuration (NewAckQueue):
+//
+//	AckTimeout: 30 * time.Second  // lost deliveries return after 30s
+//
+// Example configurations:
+//
+//	// Long-running jobs: give workers ten minutes
+//	config := AckQueueConfig{AckTimeout: 10 * time.Minute}
+//
+//	// Workers that never crash silently: wait for Ack or Nack forever
+//	config := AckQueueConfig{AckTimeout: 0}
+type AckQueueConfig struct {
+	// AckTimeout is how long a delivery stays in flight before its element
+	// is requeued. Must be >= 0.
+	//
+	// 0: Deliveries never time out, only Nack requeues
+	// Shorter timeouts: Lost work is retried sooner, slow workers risk
+	// duplicate deliveries
+	// Longer timeouts: Fewer duplicates, lost work waits longer
+	AckTimeout time.Duration
+}
+
+// AckQueueOption configures an AckQueue created with NewAckQueueWith.
+// Options are applied in order on top of the default configuration.
+type AckQueueOption func(*AckQueueConfig)
+
+// WithAckTimeout sets how long a delivery stays in flight before its
+// element is requeued. See AckQueueConfig.AckTimeout.
+func WithAckTimeout(timeout time.Duration) AckQueueOption {
+	return func(c *AckQueueConfig) {
+		c.AckTimeout = timeout
+	}
+}
+
+// Returns the configuration used by NewAckQueue.
+func defaultAckQueueConfig() AckQueueConfig {
+	return AckQueueConfig{
+		AckTimeout: 30 * time.Second,
+	}
+}
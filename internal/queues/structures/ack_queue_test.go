@@ -0,0 +1,388 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewAckQueue/NewAckQueueWithConfig):
+  ✓ Values waiting in order, default timeout
+  ✓ Negative timeout (panic)
+
+Options (NewAckQueueWith):
+  ✓ No options yields default configuration
+  ✓ Options applied in order
+
+Dequeue/Ack/Nack:
+  ✓ Dequeue from empty queue, elements in flight do not count
+  ✓ Ack removes the element for good
+  ✓ Nack requeues to the back with the next attempt
+  ✓ Settling unknown or settled deliveries fails, IDs never reused
+
+Timeouts:
+  ✓ Deliveries time out exactly at the deadline, requeued oldest first
+  ✓ Late Ack of a timed-out delivery fails
+  ✓ Zero timeout keeps deliveries in flight forever
+
+Concurrency:
+  ✓ Workers with random Ack and Nack process every element exactly once
+  ✓ Linearizable: Enqueue, Dequeue, Ack, Nack
+
+Snapshot:
+  ✓ Waiting elements in order, in-flight ones excluded
+  ✓ Expired deliveries requeued first
+  ✓ Empty queue
+*/
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/conctest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of a queue with waiting values and the default
+// timeout
+func TestAckQueue_NewAckQueue(t *testing.T) {
+	q := NewAckQueue(1, 2, 3)
+	test.GotWant(t, q.Size(), 3)
+	test.GotWant(t, q.InFlight(), 0)
+	test.GotWant(t, q.IsEmpty(), false)
+	test.GotWant(t, q.config.AckTimeout, 30*time.Second)
+
+	for want := 1; want <= 3; want++ {
+		d, err := q.Dequeue()
+		test.GotWantNoError(t, err)
+		test.GotWant(t, d.Value, want)
+	}
+}
+
+// Verifies a negative timeout panics
+func TestAckQueue_NewAckQueueWithConfig_Invalid(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewAckQueueWithConfig[int](AckQueueConfig{AckTimeout: -1})
+	}, `"ack timeout" must be >= 0, got -1ns`)
+}
+
+// Verifies no options yield the default configuration
+func TestAckQueue_NewAckQueueWith_Default(t *testing.T) {
+	q := NewAckQueueWith[int]()
+	test.GotWant(t, q.config, defaultAckQueueConfig())
+	test.GotWant(t, q.IsEmpty(), true)
+}
+
+// Verifies options are applied in order
+func TestAckQueue_NewAckQueueWith_Options(t *testing.T) {
+	q := NewAckQueueWith[int](WithAckTimeout(time.Second), WithAckTimeout(time.Minute))
+	test.GotWant(t, q.config.AckTimeout, time.Minute)
+}
+
+// Verifies dequeueing from an empty queue, and that elements in flight do
+// not count as waiting
+func TestAckQueue_Dequeue_Empty(t *testing.T) {
+	q := NewAckQueue[string]()
+	_, err := q.Dequeue()
+	test.GotWantErrorIs(t, err, ErrEmptyQueue)
+
+	q.Enqueue("a")
+	d, err := q.Dequeue()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, d, Delivery[string]{ID: 1, Value: "a", Attempt: 1})
+	test.GotWant(t, q.IsEmpty(), true)
+	test.GotWant(t, q.InFlight(), 1)
+
+	_, err = q.Dequeue()
+	test.GotWantErrorIs(t, err, ErrEmptyQueue)
+}
+
+// Verifies Ack removes the element for good
+func TestAckQueue_Ack(t *testing.T) {
+	q := NewAckQueue("a", "b")
+	d, _ := q.Dequeue()
+	test.GotWantNoError(t, q.Ack(d.ID))
+	test.GotWant(t, q.InFlight(), 0)
+	test.GotWant(t, q.Size(), 1)
+
+	d, _ = q.Dequeue()
+	test.GotWant(t, d.Value, "b")
+	test.GotWantNoError(t, q.Ack(d.ID))
+	test.GotWant(t, q.IsEmpty(), true)
+	test.GotWant(t, q.InFlight(), 0)
+}
+
+// Verifies Nack requeues the element to the back with the next attempt
+func TestAckQueue_Nack(t *testing.T) {
+	q := NewAckQueue("a", "b")
+	d, _ := q.Dequeue()
+	test.GotWantNoError(t, q.Nack(d.ID))
+	test.GotWant(t, q.InFlight(), 0)
+	test.GotWant(t, q.Size(), 2)
+
+	d, _ = q.Dequeue()
+	test.GotWant(t, d, Delivery[string]{ID: 2, Value: "b", Attempt: 1})
+	d, _ = q.Dequeue()
+	test.GotWant(t, d, Delivery[string]{ID: 3, Value: "a", Attempt: 2})
+}
+
+// Verifies settling unknown or already settled deliveries fails and that
+// IDs are never reused
+func TestAckQueue_Settle_Unknown(t *testing.T) {
+	q := NewAckQueue("a")
+	test.GotWantErrorIs(t, q.Ack(1), ErrUnknownDelivery)
+	test.GotWantErrorIs(t, q.Nack(1), ErrUnknownDelivery)
+
+	d, _ := q.Dequeue()
+	test.GotWantNoError(t, q.Nack(d.ID))
+	test.GotWantErrorIs(t, q.Ack(d.ID), ErrUnknownDelivery)
+	test.GotWantErrorIs(t, q.Nack(d.ID), ErrUnknownDelivery)
+
+	next, _ := q.Dequeue()
+	test.GotWant(t, next.ID, d.ID+1)
+	test.GotWantErrorIs(t, q.Ack(d.ID), ErrUnknownDelivery)
+	test.GotWantNoError(t, q.Ack(next.ID))
+	test.GotWantErrorIs(t, q.Ack(next.ID), ErrUnknownDelivery)
+}
+
+// Verifies deliveries time out exactly at their deadline and are requeued
+// oldest first, behind the elements already waiting
+func TestAckQueue_Timeout(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		q := NewAckQueueWith[string](WithAckTimeout(10 * time.Second))
+		q.Enqueue("a")
+		q.Enqueue("b")
+		q.Dequeue()
+		time.Sleep(time.Second)
+		q.Dequeue()
+		q.Enqueue("c")
+
+		time.Sleep(9*time.Second - time.Nanosecond)
+		test.GotWant(t, q.RequeueExpired(), 0)
+		test.GotWant(t, q.InFlight(), 2)
+
+		time.Sleep(time.Nanosecond)
+		test.GotWant(t, q.InFlight(), 1)
+		test.GotWant(t, q.Size(), 2)
+
+		time.Sleep(time.Second)
+		test.GotWant(t, q.RequeueExpired(), 1)
+		test.GotWant(t, q.InFlight(), 0)
+
+		var got []Delivery[string]
+		for !q.IsEmpty() {
+			d, _ := q.Dequeue()
+			got = append(got, d)
+		}
+
+		test.GotWantSlice(t, got, []Delivery[string]{
+			{ID: 3, Value: "c", Attempt: 1},
+			{ID: 4, Value: "a", Attempt: 2},
+			{ID: 5, Value: "b", Attempt: 2},
+		})
+	})
+}
+
+// Verifies a late Ack of a timed-out delivery fails while the element
+// waits for redelivery
+func TestAckQueue_Timeout_LateAck(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		q := NewAckQueueWith[string](WithAckTimeout(time.Second))
+		q.Enqueue("a")
+		d, _ := q.Dequeue()
+		time.Sleep(time.Second)
+
+		test.GotWantErrorIs(t, q.Ack(d.ID), ErrUnknownDelivery)
+		test.GotWantErrorIs(t, q.Nack(d.ID), ErrUnknownDelivery)
+		test.GotWant(t, q.Size(), 1)
+
+		d, _ = q.Dequeue()
+		test.GotWant(t, d.Attempt, 2)
+		test.GotWantNoError(t, q.Ack(d.ID))
+	})
+}
+
+// Verifies deliveries never time out with a zero timeout
+func TestAckQueue_Timeout_Zero(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		q := NewAckQueueWithConfig[string](AckQueueConfig{AckTimeout: 0})
+		q.Enqueue("a")
+		d, _ := q.Dequeue()
+		time.Sleep(1000 * time.Hour)
+
+		test.GotWant(t, q.RequeueExpired(), 0)
+		test.GotWant(t, q.InFlight(), 1)
+		test.GotWantNoError(t, q.Ack(d.ID))
+	})
+}
+
+// Verifies workers that enqueue, and dequeue to acknowledge or reject at
+// random, process every element exactly once
+func TestAckQueue_Concurrent(t *testing.T) {
+	q := NewAckQueueWith[int](WithAckTimeout(0))
+	var next atomic.Int64
+	var mu sync.Mutex
+	acked := map[int]int{}
+
+	conctest.Hammer(t, conctest.Config{Seed: 1, Goroutines: 8, Ops: 2000}, q,
+		conctest.Op[*AckQueue[int]]{Name: "Enqueue", Weight: 2, Apply: func(t *testing.T, r *rand.Rand, q *AckQueue[int]) {
+			q.Enqueue(int(next.Add(1) - 1))
+		}},
+		conctest.Op[*AckQueue[int]]{Name: "Ack", Weight: 2, Apply: func(t *testing.T, r *rand.Rand, q *AckQueue[int]) {
+			d, err := q.Dequeue()
+			if err != nil {
+				return
+			}
+
+			test.GotWantNoError(t, q.Ack(d.ID))
+			mu.Lock()
+			acked[d.Value]++
+			mu.Unlock()
+		}},
+		conctest.Op[*AckQueue[int]]{Name: "Nack", Apply: func(t *testing.T, r *rand.Rand, q *AckQueue[int]) {
+			if d, err := q.Dequeue(); err == nil {
+				test.GotWantNoError(t, q.Nack(d.ID))
+			}
+		}},
+		conctest.Op[*AckQueue[int]]{Name: "Size", Apply: func(t *testing.T, r *rand.Rand, q *AckQueue[int]) {
+			if n := q.Size(); n < 0 {
+				t.Errorf("Size() = %d", n)
+			}
+		}},
+	)
+
+	test.GotWant(t, q.InFlight(), 0)
+	for d, err := q.Dequeue(); err == nil; d, err = q.Dequeue() {
+		test.GotWantNoError(t, q.Ack(d.ID))
+		acked[d.Value]++
+	}
+	for i := range int(next.Load()) {
+		if acked[i] != 1 {
+			t.Fatalf("element %d acknowledged %d times, want 1", i, acked[i])
+		}
+	}
+}
+
+// Represents the sequential model of an AckQueue: the waiting messages in
+// order, the messages in flight by delivery ID, and the last ID handed out.
+type ackQueueModel struct {
+	ready    []ackMessage[int]
+	inFlight map[uint64]ackMessage[int]
+	lastID   uint64
+}
+
+// Returns a copy of the model that the replay of a call may modify.
+func (m ackQueueModel) clone() ackQueueModel {
+	m.ready = slices.Clone(m.ready)
+	m.inFlight = maps.Clone(m.inFlight)
+	return m
+}
+
+// Verifies every history of short concurrent rounds can be explained by
+// some sequential order of the calls. Ack and Nack name random IDs, so
+// settling deliveries of other workers and unknown ones are covered too.
+func TestAckQueue_Linearizable(t *testing.T) {
+	type linOp = conctest.LinOp[*AckQueue[int], ackQueueModel]
+
+	conctest.CheckLinearizable(t, conctest.Config{Seed: 1, Runs: 300, Goroutines: 4, Ops: 3},
+		func() (*AckQueue[int], ackQueueModel) {
+			return NewAckQueueWith[int](WithAckTimeout(0)), ackQueueModel{inFlight: map[uint64]ackMessage[int]{}}
+		},
+		linOp{Name: "Enqueue", Call: func(r *rand.Rand, q *AckQueue[int]) (string, func(ackQueueModel) (ackQueueModel, bool)) {
+			v := r.IntN(10)
+			q.Enqueue(v)
+			return fmt.Sprintf("Enqueue(%d)", v), func(m ackQueueModel) (ackQueueModel, bool) {
+				m = m.clone()
+				m.ready = append(m.ready, ackMessage[int]{value: v})
+				return m, true
+			}
+		}},
+		linOp{Name: "Dequeue", Weight: 2, Call: func(r *rand.Rand, q *AckQueue[int]) (string, func(ackQueueModel) (ackQueueModel, bool)) {
+			d, err := q.Dequeue()
+			return fmt.Sprintf("Dequeue() = %+v, %v", d, err), func(m ackQueueModel) (ackQueueModel, bool) {
+				if len(m.ready) == 0 {
+					return m, err != nil
+				}
+
+				m = m.clone()
+				msg := m.ready[0]
+				msg.attempts++
+				m.ready = m.ready[1:]
+				m.lastID++
+				m.inFlight[m.lastID] = msg
+				return m, err == nil && d == Delivery[int]{ID: m.lastID, Value: msg.value, Attempt: msg.attempts}
+			}
+		}},
+		linOp{Name: "Ack", Call: func(r *rand.Rand, q *AckQueue[int]) (string, func(ackQueueModel) (ackQueueModel, bool)) {
+			id := uint64(r.IntN(4) + 1)
+			err := q.Ack(id)
+			return fmt.Sprintf("Ack(%d) = %v", id, err), func(m ackQueueModel) (ackQueueModel, bool) {
+				if _, ok := m.inFlight[id]; !ok {
+					return m, errors.Is(err, ErrUnknownDelivery)
+				}
+
+				m = m.clone()
+				delete(m.inFlight, id)
+				return m, err == nil
+			}
+		}},
+		linOp{Name: "Nack", Call: func(r *rand.Rand, q *AckQueue[int]) (string, func(ackQueueModel) (ackQueueModel, bool)) {
+			id := uint64(r.IntN(4) + 1)
+			err := q.Nack(id)
+			return fmt.Sprintf("Nack(%d) = %v", id, err), func(m ackQueueModel) (ackQueueModel, bool) {
+				msg, ok := m.inFlight[id]
+				if !ok {
+					return m, errors.Is(err, ErrUnknownDelivery)
+				}
+
+				m = m.clone()
+				delete(m.inFlight, id)
+				m.ready = append(m.ready, msg)
+				return m, err == nil
+			}
+		}},
+	)
+}
+
+// Verifies a snapshot holds the waiting elements front to back, without
+// those in flight, and keeps them while the queue changes
+func TestAckQueue_Snapshot(t *testing.T) {
+	q := NewAckQueue("a", "b", "c")
+	q.Dequeue()
+	snap := q.Snapshot()
+	q.Enqueue("d")
+	q.Dequeue()
+
+	test.GotWant(t, snap.Size(), 2)
+	test.GotWant(t, snap.IsEmpty(), false)
+	v, err := snap.Peek()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, v, "b")
+	test.GotWantSlice(t, slices.Collect(snap.All()), []string{"b", "c"})
+}
+
+// Verifies a snapshot includes the elements of expired deliveries
+func TestAckQueue_Snapshot_Expired(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		q := NewAckQueueWith[string](WithAckTimeout(time.Second))
+		q.Enqueue("a")
+		q.Enqueue("b")
+		q.Dequeue()
+		time.Sleep(time.Second)
+
+		test.GotWantSlice(t, slices.Collect(q.Snapshot().All()), []string{"b", "a"})
+	})
+}
+
+// Verifies a snapshot of an empty queue
+func TestAckQueue_Snapshot_Empty(t *testing.T) {
+	snap := NewAckQueue[int]().Snapshot()
+	test.GotWant(t, snap.IsEmpty(), true)
+	_, err := snap.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyQueue)
+}
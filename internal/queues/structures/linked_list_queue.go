@@ -1,21 +1,28 @@
 package structures
 
 import (
-	"errors"
+	"fmt"
+	"io"
+	"iter"
 
+	"github.com/apotourlyan/godatastructures/internal/collections"
 	lists "github.com/apotourlyan/godatastructures/internal/lists/structures"
 )
 
 // Compile-time interface verifications
 var _ Queue[int] = &LinkedListQueue[int]{}
+var _ collections.Iterable[int] = &LinkedListQueue[int]{}
+var _ collections.Addable[int] = &LinkedListQueue[int]{}
 
 // LinkedListQueue is a FIFO queue backed by a singly-linked list.
 //
 // This implementation uses a BasicLinkedList as its underlying storage,
 // providing true O(1) enqueue and dequeue operations without memory
-// reallocation or compaction overhead.
+// reallocation or compaction overhead. With node recycling enabled (see
+// NewLinkedListQueueWith), dequeued nodes are reused by later enqueues,
+// so a queue in a steady state does not allocate.
 type LinkedListQueue[T any] struct {
-	data lists.BasicList[T] // Underlying basic list storage
+	data *lists.BasicLinkedList[T] // Underlying basic list storage
 }
 
 // Creates a new LinkedListQueue with optional initial values.
@@ -34,6 +41,19 @@ func NewLinkedListQueue[T any](values ...T) *LinkedListQueue[T] {
 	return &LinkedListQueue[T]{data}
 }
 
+// Creates a new empty LinkedListQueue whose underlying list is configured
+// by the given options, such as lists.WithFreeListCapacity to recycle the
+// nodes of dequeued values. Options are applied in order.
+//
+// Panics if the resulting configuration is invalid.
+//
+// Example:
+//
+//	q := NewLinkedListQueueWith[int](lists.WithFreeListCapacity(256))
+func NewLinkedListQueueWith[T any](opts ...lists.LinkedListOption) *LinkedListQueue[T] {
+	return &LinkedListQueue[T]{lists.NewBasicLinkedListWith[T](opts...)}
+}
+
 // Adds a value to the back of the queue.
 //
 // Time complexity: O(1)
@@ -50,9 +70,25 @@ func (q *LinkedListQueue[T]) Enqueue(value T) {
 	q.data.AddLast(value)
 }
 
+// Adds a value to the back of the queue, as Enqueue does.
+// Always returns true.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	q := NewLinkedListQueue(1)
+//	q.Add(2)  // Returns true, queue is now [1, 2]
+func (q *LinkedListQueue[T]) Add(value T) bool {
+	q.data.AddLast(value)
+	return true
+}
+
 // Removes and returns the value from the front of the queue.
 //
-// Returns ErrorEmptyQueue if the queue is empty.
+// Returns ErrEmptyQueue if the queue is empty.
 //
 // Time complexity: O(1)
 //
@@ -67,7 +103,7 @@ func (q *LinkedListQueue[T]) Dequeue() (T, error) {
 	f, err := q.data.First()
 	if err != nil {
 		var zero T
-		return zero, errors.New(ErrorEmptyQueue)
+		return zero, ErrEmptyQueue
 	}
 
 	q.data.RemoveFirst()
@@ -76,7 +112,7 @@ func (q *LinkedListQueue[T]) Dequeue() (T, error) {
 
 // Returns the value at the front of the queue without removing it.
 //
-// Returns ErrorEmptyQueue if the queue is empty.
+// Returns ErrEmptyQueue if the queue is empty.
 //
 // Time complexity: O(1)
 //
@@ -91,12 +127,81 @@ func (q *LinkedListQueue[T]) Peek() (T, error) {
 	f, err := q.data.First()
 	if err != nil {
 		var zero T
-		return zero, errors.New(ErrorEmptyQueue)
+		return zero, ErrEmptyQueue
 	}
 
 	return f, nil
 }
 
+// Returns an iterator over the values from front to back,
+// in the order they would be dequeued.
+// The queue must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+//
+// Example:
+//
+//	q := NewLinkedListQueue(1, 2, 3)
+//	for v := range q.All() {
+//	    fmt.Println(v)  // Prints 1, 2, 3
+//	}
+func (q *LinkedListQueue[T]) All() iter.Seq[T] {
+	return q.data.All()
+}
+
+// Removes all values from the queue.
+//
+// Time complexity: O(1)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	q := NewLinkedListQueue(1, 2, 3)
+//	q.Clear()  // Queue is now empty
+func (q *LinkedListQueue[T]) Clear() {
+	q.data.Clear()
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements from front to back in the format of the underlying
+// lists.BasicLinkedList, so either can decode what the other encodes.
+//
+// Time complexity: O(n)
+func (q *LinkedListQueue[T]) MarshalBinary() ([]byte, error) {
+	return q.data.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the queue with those encoded by MarshalBinary.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded list, in which case the queue is left unchanged.
+//
+// Time complexity: O(n)
+func (q *LinkedListQueue[T]) UnmarshalBinary(data []byte) error {
+	return q.data.UnmarshalBinary(data)
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (q *LinkedListQueue[T]) WriteTo(w io.Writer) (int64, error) {
+	return q.data.WriteTo(w)
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the queue
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The queue is
+// left unchanged on error.
+//
+// Time complexity: O(n)
+func (q *LinkedListQueue[T]) ReadFrom(r io.Reader) (int64, error) {
+	return q.data.ReadFrom(r)
+}
+
 // Returns true if the queue contains no elements.
 //
 // Time complexity: O(1)
@@ -126,3 +231,19 @@ func (q *LinkedListQueue[T]) IsEmpty() bool {
 func (q *LinkedListQueue[T]) Size() int {
 	return q.data.Size()
 }
+
+// CheckInvariants verifies the internal consistency of the queue, which
+// is that of its underlying list: the nodes reachable from the front end
+// at the back and their number matches Size. Intended for tests and
+// debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the queue is consistent.
+//
+// Time complexity: O(n)
+func (q *LinkedListQueue[T]) CheckInvariants() error {
+	if err := q.data.CheckInvariants(); err != nil {
+		return fmt.Errorf("%w: underlying list: %w", ErrInvariantViolation, err)
+	}
+
+	return nil
+}
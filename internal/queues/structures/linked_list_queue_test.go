@@ -8,6 +8,10 @@ Constructor (NewBasicLinkedList):
   ✓ Single value
   ✓ Multiple values
 
+Options (NewLinkedListQueueWith):
+  ✓ Node recycling stops steady-state allocation
+  ✓ Invalid options (panic)
+
 Enqueue:
   ✓ Single value to empty queue
   ✓ Single value to non-empty queue
@@ -31,11 +35,30 @@ Peek:
 IsEmpty/Size:
   ✓ Empty queue
   ✓ Non-empty queue
+
+Add/All/Clear:
+  ✓ Add enqueues at the back and always returns true
+  ✓ All yields front to back
+  ✓ Clear empties the queue
+
+CheckInvariants:
+  ✓ Underlying list stays consistent through enqueues and dequeues
+
+Binary encoding:
+  ✓ Round trip, invalid data rejected
+  ✓ Encoding shared with BasicLinkedList
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the queue unchanged
 */
 
 import (
+	"slices"
 	"testing"
 
+	lists "github.com/apotourlyan/godatastructures/internal/lists/structures"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
 	"github.com/apotourlyan/godatastructures/internal/utilities/test"
 )
 
@@ -60,6 +83,28 @@ func TestLinkedListQueue_NewLinkedListQueue_ManyValues(t *testing.T) {
 	test.GotWant(t, q.IsEmpty(), false)
 }
 
+// Verifies a queue with node recycling reuses dequeued nodes, so
+// alternating enqueues and dequeues do not allocate
+func TestLinkedListQueue_NewLinkedListQueueWith_FreeList(t *testing.T) {
+	q := NewLinkedListQueueWith[int](lists.WithFreeListCapacity(4))
+	q.Enqueue(0)
+	q.Dequeue()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		q.Enqueue(1)
+		q.Dequeue()
+	})
+	test.GotWant(t, allocs, 0.0)
+	test.GotWant(t, q.IsEmpty(), true)
+}
+
+// Verifies invalid options panic at construction
+func TestLinkedListQueue_NewLinkedListQueueWith_InvalidOptions(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewLinkedListQueueWith[int](lists.WithFreeListCapacity(-1))
+	}, `"free list capacity" must be >= 0, got -1`)
+}
+
 // Verifies the enqueuing of an element in an empty queue
 func TestLinkedListQueue_Enqueue_OneElement_EmptyQueue(t *testing.T) {
 	q := NewLinkedListQueue[int]()
@@ -107,7 +152,7 @@ func TestLinkedListQueue_Enqueue_ManyElements_NonEmptyQueue(t *testing.T) {
 func TestLinkedListQueue_Dequeue_OneElement_EmptyQueue(t *testing.T) {
 	q := NewLinkedListQueue[int]()
 	d, err := q.Dequeue()
-	test.GotWantError(t, err, ErrorEmptyQueue)
+	test.GotWantErrorIs(t, err, ErrEmptyQueue)
 	test.GotWant(t, d, 0)
 	test.GotWant(t, q.Size(), 0)
 	test.GotWant(t, q.IsEmpty(), true)
@@ -117,7 +162,7 @@ func TestLinkedListQueue_Dequeue_OneElement_EmptyQueue(t *testing.T) {
 func TestLinkedListQueue_Dequeue_OneElement_NonEmptyQueue(t *testing.T) {
 	q := NewLinkedListQueue(1, 2, 3)
 	d, err := q.Dequeue()
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, d, 1)
 	p, _ := q.Peek()
 	test.GotWant(t, p, 2)
@@ -131,7 +176,7 @@ func TestLinkedListQueue_Dequeue_ManyElements_NonEmptyQueue(t *testing.T) {
 	q.Dequeue()
 	q.Dequeue()
 	d, err := q.Dequeue()
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, d, 3)
 	test.GotWant(t, q.Size(), 0)
 	test.GotWant(t, q.IsEmpty(), true)
@@ -170,7 +215,7 @@ func TestLinkedListQueue_EnqueueDequeue_Reusability(t *testing.T) {
 func TestLinkedListQueue_Peek_EmptyQueue(t *testing.T) {
 	q := NewLinkedListQueue[int]()
 	p, err := q.Peek()
-	test.GotWantError(t, err, ErrorEmptyQueue)
+	test.GotWantErrorIs(t, err, ErrEmptyQueue)
 	test.GotWant(t, p, 0)
 	test.GotWant(t, q.Size(), 0)
 	test.GotWant(t, q.IsEmpty(), true)
@@ -180,7 +225,7 @@ func TestLinkedListQueue_Peek_EmptyQueue(t *testing.T) {
 func TestLinkedListQueue_Peek_NonEmptyQueue(t *testing.T) {
 	q := NewLinkedListQueue(1, 2, 3)
 	p, err := q.Peek()
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, p, 1)
 	test.GotWant(t, q.Size(), 3)
 	test.GotWant(t, q.IsEmpty(), false)
@@ -192,7 +237,7 @@ func TestLinkedListQueue_Peek_Many(t *testing.T) {
 
 	for range 3 {
 		p, err := q.Peek()
-		test.GotWant(t, err, nil)
+		test.GotWantNoError(t, err)
 		test.GotWant(t, p, 1)
 	}
 }
@@ -220,3 +265,73 @@ func TestLinkedListQueue_Size_NonEmptyQueue(t *testing.T) {
 	q := NewLinkedListQueue(1, 2, 3)
 	test.GotWant(t, q.Size(), 3)
 }
+
+// Verifies Add enqueues at the back of the queue
+func TestLinkedListQueue_Add(t *testing.T) {
+	q := NewLinkedListQueue(1)
+	test.GotWant(t, q.Add(2), true)
+	test.GotWant(t, q.Size(), 2)
+
+	v, _ := q.Dequeue()
+	test.GotWant(t, v, 1)
+}
+
+// Verifies All yields the values in dequeue order
+func TestLinkedListQueue_All(t *testing.T) {
+	test.GotWantSlice(t, slices.Collect(NewLinkedListQueue[int]().All()), []int{})
+	test.GotWantSlice(t, slices.Collect(NewLinkedListQueue(1, 2, 3).All()), []int{1, 2, 3})
+}
+
+// Verifies Clear empties the queue, which stays usable
+func TestLinkedListQueue_Clear(t *testing.T) {
+	q := NewLinkedListQueue(1, 2, 3)
+	q.Clear()
+	test.GotWant(t, q.IsEmpty(), true)
+
+	_, err := q.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyQueue)
+
+	q.Enqueue(4)
+	test.GotWantSlice(t, slices.Collect(q.All()), []int{4})
+}
+
+// Verifies the underlying list stays consistent through enqueues and
+// dequeues, with and without node recycling. The queue keeps no state
+// besides the list, whose corruption cases the list tests cover.
+func TestLinkedListQueue_CheckInvariants(t *testing.T) {
+	for _, q := range []*LinkedListQueue[int]{
+		NewLinkedListQueue[int](),
+		NewLinkedListQueueWith[int](lists.WithFreeListCapacity(4)),
+	} {
+		test.GotWantNoError(t, q.CheckInvariants())
+		for i := range 100 {
+			if i%3 == 2 {
+				q.Dequeue()
+			} else {
+				q.Enqueue(i)
+			}
+			test.GotWantNoError(t, q.CheckInvariants())
+		}
+	}
+}
+
+// Verifies a round trip preserves the order, invalid data is rejected and
+// the encoding is that of a basic linked list
+func TestLinkedListQueue_MarshalBinary(t *testing.T) {
+	other, _ := NewSliceQueue("x").MarshalBinary()
+	codectest.RoundTrip(t, NewLinkedListQueue("a", "b"), NewLinkedListQueue("z"),
+		func(q *LinkedListQueue[string]) []string { return slices.Collect(q.All()) }, other)
+
+	data, _ := NewLinkedListQueue("a", "b").MarshalBinary()
+	l := lists.NewBasicLinkedList[string]()
+	test.GotWantNoError(t, l.UnmarshalBinary(data))
+	test.GotWantSlice(t, slices.Collect(l.All()), []string{"a", "b"})
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements in queue order
+func TestLinkedListQueue_WriteTo_ReadFrom(t *testing.T) {
+	src := NewLinkedListQueue(1, 2, 3)
+	dst := NewLinkedListQueue(9)
+	codectest.StreamRoundTrip(t, src, dst,
+		func(q *LinkedListQueue[int]) []int { return slices.Collect(q.All()) })
+}
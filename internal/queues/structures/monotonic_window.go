@@ -0,0 +1,149 @@
+package structures
+
+import (
+	"cmp"
+	"errors"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+const ErrorEmptyWindow = "window is empty"
+
+// ErrEmptyWindow is returned when querying a window with no values in it.
+var ErrEmptyWindow = errors.New(ErrorEmptyWindow)
+
+// Represents a pushed value and its position in the stream.
+type windowEntry[T any] struct {
+	value T
+	seq   int
+}
+
+// MonotonicWindow tracks the minimum (or maximum) of the last N values
+// pushed onto a stream.
+//
+// Candidates are kept in a RingDeque ordered from best to worst. A new
+// value first evicts every candidate at the back that it beats or ties,
+// since those can never be the best again while the newer value is in the
+// window, and then joins at the back. The front is the current best and
+// is dropped once it slides out of the window. Every value enters and
+// leaves the deque at most once, so pushes are O(1) amortized.
+//
+// The order is given by a less function: the window reports the value for
+// which less is true against every other value, so cmp.Less yields a
+// sliding minimum and a > b a sliding maximum.
+//
+// Design decisions:
+//   - Monotonic deque: O(1) amortized Push, O(1) Peek
+//   - Sequence numbers: Expiry needs no copy of the whole window
+//   - Ties evict older values: The newest equal value stays longest
+//
+// Space complexity: O(N) where N is the window size.
+type MonotonicWindow[T any] struct {
+	candidates *RingDeque[windowEntry[T]]
+	less       func(a T, b T) bool
+	size       int // Window size N
+	pushed     int // Number of values pushed so far
+}
+
+// NewMonotonicWindow creates a window over the last size values that
+// reports the best value by less.
+//
+// Panics if size is not greater than 0.
+//
+// Example:
+//
+//	// Longest of the last 3 strings
+//	w := NewMonotonicWindow(3, func(a, b string) bool { return len(a) > len(b) })
+func NewMonotonicWindow[T any](size int, less func(a T, b T) bool) *MonotonicWindow[T] {
+	panics.RequireGreaterThan(size, 0, "window size")
+
+	return &MonotonicWindow[T]{
+		candidates: NewRingDeque[windowEntry[T]](),
+		less:       less,
+		size:       size,
+	}
+}
+
+// NewMinWindow creates a window reporting the minimum of the last size
+// values.
+//
+// Panics if size is not greater than 0.
+//
+// Example:
+//
+//	w := NewMinWindow[int](3)
+//	for _, v := range []int{4, 2, 5, 6} {
+//	    w.Push(v)
+//	}
+//	w.Peek()  // Returns 2 (window is 2, 5, 6)
+//	w.Push(7)
+//	w.Peek()  // Returns 5 (window is 5, 6, 7)
+func NewMinWindow[T cmp.Ordered](size int) *MonotonicWindow[T] {
+	return NewMonotonicWindow(size, cmp.Less[T])
+}
+
+// NewMaxWindow creates a window reporting the maximum of the last size
+// values.
+//
+// Panics if size is not greater than 0.
+func NewMaxWindow[T cmp.Ordered](size int) *MonotonicWindow[T] {
+	return NewMonotonicWindow(size, func(a, b T) bool { return cmp.Less(b, a) })
+}
+
+// Push adds a value to the stream, sliding the oldest value out of the
+// window once it is full.
+//
+// Time complexity: O(1) amortized
+func (w *MonotonicWindow[T]) Push(value T) {
+	for !w.candidates.IsEmpty() {
+		back, _ := w.candidates.PeekBack()
+		if w.less(back.value, value) {
+			break
+		}
+		w.candidates.PopBack()
+	}
+
+	w.candidates.PushBack(windowEntry[T]{value: value, seq: w.pushed})
+	w.pushed++
+
+	// Only one value leaves the window per push, and only the front can be it
+	if front, _ := w.candidates.PeekFront(); front.seq <= w.pushed-1-w.size {
+		w.candidates.PopFront()
+	}
+}
+
+// Peek returns the best value in the window: the minimum for a min
+// window, the maximum for a max window.
+// Returns an error if no value has been pushed.
+//
+// Time complexity: O(1)
+func (w *MonotonicWindow[T]) Peek() (T, error) {
+	front, err := w.candidates.PeekFront()
+	if err != nil {
+		return front.value, ErrEmptyWindow
+	}
+
+	return front.value, nil
+}
+
+// Capacity returns the window size N.
+//
+// Time complexity: O(1)
+func (w *MonotonicWindow[T]) Capacity() int {
+	return w.size
+}
+
+// IsEmpty returns true if no value has been pushed.
+//
+// Time complexity: O(1)
+func (w *MonotonicWindow[T]) IsEmpty() bool {
+	return w.pushed == 0
+}
+
+// Size returns the number of values in the window, which is the window
+// size once that many values have been pushed.
+//
+// Time complexity: O(1)
+func (w *MonotonicWindow[T]) Size() int {
+	return min(w.pushed, w.size)
+}
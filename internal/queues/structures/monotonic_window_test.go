@@ -0,0 +1,152 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewMonotonicWindow/NewMinWindow/NewMaxWindow):
+  ✓ Empty window
+  ✓ Invalid size panics
+
+Push/Peek:
+  ✓ Peek on empty window
+  ✓ Sliding minimum and maximum
+  ✓ Window of one
+  ✓ Ties keep the newest value
+  ✓ Custom order
+  ✓ Candidates never exceed the window size
+
+Size/Capacity/IsEmpty:
+  ✓ Size grows to the window size and stays there
+
+Randomized:
+  ✓ Matches a brute-force scan of the last N values
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty window
+func TestMonotonicWindow_NewMinWindow_Empty(t *testing.T) {
+	w := NewMinWindow[int](3)
+	test.GotWant(t, w.Size(), 0)
+	test.GotWant(t, w.IsEmpty(), true)
+	test.GotWant(t, w.Capacity(), 3)
+}
+
+// Verifies non-positive window sizes panic
+func TestMonotonicWindow_NewMonotonicWindow_InvalidSize(t *testing.T) {
+	test.GotWantPanic(t, func() { NewMinWindow[int](0) }, `"window size" must be > 0, got 0`)
+	test.GotWantPanic(t, func() { NewMaxWindow[int](-1) }, `"window size" must be > 0, got -1`)
+}
+
+// Verifies peeking an empty window
+func TestMonotonicWindow_Peek_EmptyWindow(t *testing.T) {
+	_, err := NewMaxWindow[int](2).Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyWindow)
+}
+
+// Verifies the sliding minimum and maximum over a stream
+func TestMonotonicWindow_Push_MinMax(t *testing.T) {
+	stream := []int{4, 2, 5, 6, 7, 1, 3, 3, 8}
+	wantMin := []int{4, 2, 2, 2, 5, 1, 1, 1, 3}
+	wantMax := []int{4, 4, 5, 6, 7, 7, 7, 3, 8}
+
+	minW, maxW := NewMinWindow[int](3), NewMaxWindow[int](3)
+	for i, v := range stream {
+		minW.Push(v)
+		maxW.Push(v)
+		got, _ := minW.Peek()
+		test.GotWant(t, got, wantMin[i])
+		got, _ = maxW.Peek()
+		test.GotWant(t, got, wantMax[i])
+	}
+}
+
+// Verifies a window of one always reports the latest value
+func TestMonotonicWindow_Push_SizeOne(t *testing.T) {
+	w := NewMinWindow[int](1)
+	for _, v := range []int{5, 9, 1, 7} {
+		w.Push(v)
+		got, _ := w.Peek()
+		test.GotWant(t, got, v)
+	}
+}
+
+// Verifies equal values keep only the newest, which stays in the window
+// longest
+func TestMonotonicWindow_Push_Ties(t *testing.T) {
+	w := NewMinWindow[int](2)
+	w.Push(1)
+	w.Push(1)
+	test.GotWant(t, w.candidates.Size(), 1)
+
+	w.Push(5)
+	got, _ := w.Peek()
+	test.GotWant(t, got, 1)
+	w.Push(6)
+	got, _ = w.Peek()
+	test.GotWant(t, got, 5)
+}
+
+// Verifies a custom order over a non-ordered type
+func TestMonotonicWindow_Push_CustomOrder(t *testing.T) {
+	w := NewMonotonicWindow(2, func(a, b string) bool { return len(a) > len(b) })
+	w.Push("go")
+	w.Push("window")
+	w.Push("ab")
+	got, _ := w.Peek()
+	test.GotWant(t, got, "window")
+	w.Push("x")
+	got, _ = w.Peek()
+	test.GotWant(t, got, "ab")
+}
+
+// Verifies a rising stream, the worst case for a min window, never holds
+// more candidates than the window size
+func TestMonotonicWindow_Push_BoundedCandidates(t *testing.T) {
+	w := NewMinWindow[int](4)
+	for i := range 100 {
+		w.Push(i)
+		if w.candidates.Size() > 4 {
+			t.Fatalf("%d candidates in a window of 4", w.candidates.Size())
+		}
+	}
+	got, _ := w.Peek()
+	test.GotWant(t, got, 96)
+}
+
+// Verifies the size grows to the window size and stays there
+func TestMonotonicWindow_Size(t *testing.T) {
+	w := NewMaxWindow[int](3)
+	for i := range 5 {
+		w.Push(i)
+		test.GotWant(t, w.Size(), min(i+1, 3))
+		test.GotWant(t, w.IsEmpty(), false)
+	}
+}
+
+// Verifies random streams match a brute-force scan of the window
+func TestMonotonicWindow_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(91, 92))
+	for _, size := range []int{1, 2, 5, 17} {
+		minW, maxW := NewMinWindow[int](size), NewMaxWindow[int](size)
+		stream := []int{}
+		for range 2000 {
+			v := r.IntN(50)
+			stream = append(stream, v)
+			minW.Push(v)
+			maxW.Push(v)
+
+			window := stream[max(0, len(stream)-size):]
+			got, _ := minW.Peek()
+			test.GotWant(t, got, slices.Min(window))
+			got, _ = maxW.Peek()
+			test.GotWant(t, got, slices.Max(window))
+		}
+	}
+}
@@ -1,6 +1,19 @@
 package structures
 
+import "errors"
+
 const ErrorEmptyQueue = "queue is empty"
+const ErrorInvariantViolation = "invariant violation"
+const ErrorUnknownDelivery = "delivery is not in flight"
+
+// Errors returned when dequeuing or peeking an empty queue and when
+// acknowledging a delivery that is not in flight. ErrInvariantViolation is
+// wrapped by CheckInvariants.
+var (
+	ErrEmptyQueue         = errors.New(ErrorEmptyQueue)
+	ErrInvariantViolation = errors.New(ErrorInvariantViolation)
+	ErrUnknownDelivery    = errors.New(ErrorUnknownDelivery)
+)
 
 // Queue defines the interface for a FIFO (First-In-First-Out) data structure.
 // Elements are added to the back and removed from the front, maintaining insertion order.
@@ -0,0 +1,29 @@
+package structures
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/bench"
+)
+
+// BenchmarkQueue_Profiles compares the Queue implementations with their
+// default configurations under every workload profile.
+//
+// Expected: RingDeque and SliceQueue allocate only while growing,
+// LinkedListQueue allocates once per enqueue
+func BenchmarkQueue_Profiles(b *testing.B) {
+	bench.Run(b, map[string]func() bench.Target{
+		"SliceQueue": func() bench.Target {
+			return sliceQueueTarget(NewSliceQueue[int]())
+		},
+		"RingDeque": func() bench.Target {
+			d := NewRingDeque[int]()
+			t := bench.Queue(d)
+			t.Capacity = func() int { return len(d.data) }
+			return t
+		},
+		"LinkedListQueue": func() bench.Target {
+			return bench.Queue(NewLinkedListQueue[int]())
+		},
+	}, bench.Profiles()...)
+}
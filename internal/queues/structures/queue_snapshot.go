@@ -0,0 +1,57 @@
+package structures
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &QueueSnapshot[int]{}
+var _ collections.Iterable[int] = &QueueSnapshot[int]{}
+
+// QueueSnapshot is an immutable point-in-time view of the elements of a
+// queue, returned by AckQueue.Snapshot.
+//
+// The elements are copied when the snapshot is taken, so iteration takes
+// no lock of the queue and sees the same elements however it changes
+// afterwards. All methods are safe for concurrent use by multiple
+// goroutines.
+type QueueSnapshot[T any] struct {
+	values []T
+}
+
+// Peek returns the element that was at the front of the queue.
+// Returns ErrEmptyQueue if the queue was empty.
+//
+// Time complexity: O(1)
+func (s *QueueSnapshot[T]) Peek() (T, error) {
+	if len(s.values) == 0 {
+		var zero T
+		return zero, ErrEmptyQueue
+	}
+
+	return s.values[0], nil
+}
+
+// All returns an iterator over the elements from front to back.
+//
+// Time complexity: O(n) for a full iteration
+func (s *QueueSnapshot[T]) All() iter.Seq[T] {
+	return slices.Values(s.values)
+}
+
+// IsEmpty returns true if the queue was empty.
+//
+// Time complexity: O(1)
+func (s *QueueSnapshot[T]) IsEmpty() bool {
+	return len(s.values) == 0
+}
+
+// Size returns the number of elements the queue held.
+//
+// Time complexity: O(1)
+func (s *QueueSnapshot[T]) Size() int {
+	return len(s.values)
+}
@@ -0,0 +1,458 @@
+package structures
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"math/bits"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Compile-time interface verifications
+var _ Queue[int] = &RingDeque[int]{}
+var _ collections.Iterable[int] = &RingDeque[int]{}
+var _ collections.Addable[int] = &RingDeque[int]{}
+
+const ErrorEmptyDeque = "deque is empty"
+const ErrorIndexOutOfRange = "index is out of the range of possible values"
+
+// Errors returned by the deque operations on an empty deque or a position
+// outside it.
+var (
+	ErrEmptyDeque      = errors.New(ErrorEmptyDeque)
+	ErrIndexOutOfRange = errors.New(ErrorIndexOutOfRange)
+)
+
+// Initial capacity of a RingDeque's buffer once the first element arrives.
+const ringDequeMinCapacity = 8
+
+// RingDeque implements a double-ended queue using a circular buffer.
+//
+// Elements are stored in a slice that wraps around: the front may sit
+// anywhere in the buffer and the back continues from it modulo the
+// capacity, so both ends grow and shrink in O(1) without shifting
+// elements. When the buffer is full it doubles, and the shorter of the
+// two wrapped segments is moved so the elements are contiguous again.
+//
+// RingDeque also satisfies Queue: Enqueue, Dequeue and Peek operate on the
+// back and front like PushBack, PopFront and PeekFront.
+//
+// Design decisions:
+//   - Power-of-two capacity: Wrapping is a bit mask instead of a division
+//   - Doubling growth: O(1) amortized pushes at either end
+//   - No automatic shrinking: Capacity is kept for reuse after pops until
+//     ShrinkToFit releases it
+//
+// Space complexity: O(n) where n is the largest number of elements held.
+type RingDeque[T any] struct {
+	data  []T // Circular buffer, len(data) is 0 or a power of two
+	head  int // Index of the front element
+	size  int
+	hooks algorithms.SliceHooks
+}
+
+// NewRingDeque creates a deque containing the given values, the first
+// value at the front.
+//
+// Example:
+//
+//	d := NewRingDeque(1, 2, 3)
+//	d.PushFront(0)
+//	d.PopBack()  // Returns 3
+func NewRingDeque[T any](values ...T) *RingDeque[T] {
+	d := &RingDeque[T]{}
+	for _, v := range values {
+		d.PushBack(v)
+	}
+
+	return d
+}
+
+// NewRingDequeWith creates an empty deque from the default configuration
+// adjusted by the given options. Options are applied in order.
+//
+// Panics if the capacity is negative.
+//
+// Example:
+//
+//	d := NewRingDequeWith[int](WithDequeCapacity(100))  // Buffer of 128
+//	for i := range 128 {
+//	    d.PushFront(i)  // Never reallocates
+//	}
+func NewRingDequeWith[T any](opts ...RingDequeOption) *RingDeque[T] {
+	c := defaultRingDequeConfig()
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	panics.RequireNonNegative(c.InitialCapacity, "capacity")
+	d := &RingDeque[T]{}
+	if c.InitialCapacity > 0 {
+		d.data = make([]T, 1<<bits.Len(uint(c.InitialCapacity-1)))
+	}
+
+	return d
+}
+
+// NewRingDequeWithCapacity creates an empty deque whose buffer holds at
+// least capacity elements, so pushing up to that many elements at either
+// end does not reallocate. The buffer length is rounded up to a power of
+// two.
+//
+// Panics if capacity is negative.
+//
+// Deprecated: Use NewRingDequeWith with WithDequeCapacity.
+func NewRingDequeWithCapacity[T any](capacity int) *RingDeque[T] {
+	return NewRingDequeWith[T](WithDequeCapacity(capacity))
+}
+
+// PushFront adds an element to the front of the deque.
+//
+// Time complexity: O(1) amortized
+func (d *RingDeque[T]) PushFront(value T) {
+	d.reserve()
+	d.head = (d.head - 1) & (len(d.data) - 1)
+	d.data[d.head] = value
+	d.size++
+}
+
+// PushBack adds an element to the back of the deque.
+//
+// Time complexity: O(1) amortized
+func (d *RingDeque[T]) PushBack(value T) {
+	d.reserve()
+	d.data[d.index(d.size)] = value
+	d.size++
+}
+
+// PopFront removes and returns the element at the front of the deque.
+// Returns an error if the deque is empty.
+//
+// Time complexity: O(1)
+func (d *RingDeque[T]) PopFront() (T, error) {
+	var zero T
+	if d.size == 0 {
+		return zero, ErrEmptyDeque
+	}
+
+	v := d.data[d.head]
+	d.data[d.head] = zero // Help GC
+	d.head = d.index(1)
+	d.size--
+	return v, nil
+}
+
+// PopBack removes and returns the element at the back of the deque.
+// Returns an error if the deque is empty.
+//
+// Time complexity: O(1)
+func (d *RingDeque[T]) PopBack() (T, error) {
+	var zero T
+	if d.size == 0 {
+		return zero, ErrEmptyDeque
+	}
+
+	i := d.index(d.size - 1)
+	v := d.data[i]
+	d.data[i] = zero // Help GC
+	d.size--
+	return v, nil
+}
+
+// PeekFront returns the element at the front of the deque without
+// removing it. Returns an error if the deque is empty.
+//
+// Time complexity: O(1)
+func (d *RingDeque[T]) PeekFront() (T, error) {
+	if d.size == 0 {
+		var zero T
+		return zero, ErrEmptyDeque
+	}
+
+	return d.data[d.head], nil
+}
+
+// PeekBack returns the element at the back of the deque without removing
+// it. Returns an error if the deque is empty.
+//
+// Time complexity: O(1)
+func (d *RingDeque[T]) PeekBack() (T, error) {
+	if d.size == 0 {
+		var zero T
+		return zero, ErrEmptyDeque
+	}
+
+	return d.data[d.index(d.size-1)], nil
+}
+
+// Get returns the element at the given position counted from the front.
+// Returns an error if the index is out of range.
+//
+// Time complexity: O(1)
+func (d *RingDeque[T]) Get(index int) (T, error) {
+	if index < 0 || index >= d.size {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+
+	return d.data[d.index(index)], nil
+}
+
+// Enqueue adds an element to the back of the deque.
+//
+// Time complexity: O(1) amortized
+func (d *RingDeque[T]) Enqueue(value T) {
+	d.PushBack(value)
+}
+
+// Add inserts the element at the back of the deque, as PushBack does.
+// Always returns true.
+//
+// Time complexity: O(1) amortized
+func (d *RingDeque[T]) Add(value T) bool {
+	d.PushBack(value)
+	return true
+}
+
+// Dequeue removes and returns the element at the front of the deque.
+// Returns an error if the deque is empty.
+//
+// Time complexity: O(1)
+func (d *RingDeque[T]) Dequeue() (T, error) {
+	return d.PopFront()
+}
+
+// Peek returns the element at the front of the deque without removing it.
+// Returns an error if the deque is empty.
+//
+// Time complexity: O(1)
+func (d *RingDeque[T]) Peek() (T, error) {
+	return d.PeekFront()
+}
+
+// All returns an iterator over the elements from front to back.
+// The deque must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (d *RingDeque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range d.size {
+			if !yield(d.data[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements from back to front.
+// The deque must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (d *RingDeque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(d.data[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all elements. The buffer is kept for reuse, with its
+// slots zeroed so the removed elements can be garbage collected.
+//
+// Time complexity: O(capacity)
+func (d *RingDeque[T]) Clear() {
+	clear(d.data)
+	d.head = 0
+	d.size = 0
+}
+
+// ShrinkToFit moves the elements to the front of the smallest buffer
+// that holds them, regardless of how much capacity earlier pushes left
+// behind. The buffer length stays a power of two, so up to Size()-1
+// slots may remain free; an empty deque releases its buffer entirely.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	d := NewRingDeque(1, 2, 3, 4, 5, 6, 7, 8, 9)  // Capacity 16
+//	d.PopFront()
+//	d.PopFront()
+//	d.ShrinkToFit()  // Capacity 8
+func (d *RingDeque[T]) ShrinkToFit() {
+	n := 0
+	if d.size > 0 {
+		n = 1 << bits.Len(uint(d.size-1))
+	}
+	if n >= len(d.data) {
+		return
+	}
+
+	var data []T
+	if n > 0 {
+		data = make([]T, n)
+		for i := range d.size {
+			data[i] = d.data[d.index(i)]
+		}
+	}
+
+	capBefore := len(d.data)
+	d.data = data
+	d.head = 0
+	d.hooks.Resized(capBefore, n)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements from front to back in the format of package codec; the free
+// slots of the buffer are not stored.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (d *RingDeque[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("RingDeque", d.size, d.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the deque with those encoded by MarshalBinary, in a new
+// buffer of the smallest power of two that holds them.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded deque, in which case the deque is left unchanged.
+//
+// Time complexity: O(n)
+func (d *RingDeque[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "RingDeque")
+	if err != nil {
+		return err
+	}
+
+	d.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (d *RingDeque[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "RingDeque", d.size, d.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// deque with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The deque is
+// left unchanged on error.
+//
+// Time complexity: O(n)
+func (d *RingDeque[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "RingDeque")
+	if err != nil {
+		return n, err
+	}
+
+	d.restore(values)
+	return n, nil
+}
+
+// IsEmpty returns true if the deque contains no elements.
+//
+// Time complexity: O(1)
+func (d *RingDeque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Size returns the number of elements currently in the deque.
+//
+// Time complexity: O(1)
+func (d *RingDeque[T]) Size() int {
+	return d.size
+}
+
+// SetHooks installs callbacks for the deque's storage events, replacing
+// any installed before: OnGrow when a push doubles the buffer and
+// OnShrink when ShrinkToFit reduces it. The deque never compacts, so
+// OnCompact is not called.
+func (d *RingDeque[T]) SetHooks(hooks algorithms.SliceHooks) {
+	d.hooks = hooks
+}
+
+// CheckInvariants verifies the internal consistency of the deque: the
+// buffer length is 0 or a power of two, the front index lies within the
+// buffer and the size does not exceed it. Intended for tests and
+// debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the deque is consistent.
+//
+// Time complexity: O(1)
+func (d *RingDeque[T]) CheckInvariants() error {
+	n := len(d.data)
+	if n&(n-1) != 0 {
+		return fmt.Errorf("%w: buffer length %d is not a power of two", ErrInvariantViolation, n)
+	}
+	if d.size < 0 || d.size > n {
+		return fmt.Errorf("%w: size %d outside [0, %d]", ErrInvariantViolation, d.size, n)
+	}
+	if n > 0 && (d.head < 0 || d.head >= n) || n == 0 && d.head != 0 {
+		return fmt.Errorf("%w: front index %d outside the buffer of length %d", ErrInvariantViolation, d.head, n)
+	}
+
+	return nil
+}
+
+// Returns the buffer index of the element at the given offset from the front.
+func (d *RingDeque[T]) index(offset int) int {
+	return (d.head + offset) & (len(d.data) - 1)
+}
+
+// Doubles the buffer if it is full. The old buffer is copied to the front
+// of the new one, where the elements wrap around at the old length; the
+// shorter segment is then moved into the new half, which relocates at most
+// half of the elements.
+func (d *RingDeque[T]) reserve() {
+	if d.size < len(d.data) {
+		return
+	}
+
+	n := len(d.data)
+	data := make([]T, max(2*n, ringDequeMinCapacity))
+	copy(data, d.data)
+
+	if front := n - d.head; front < d.head {
+		// Move the front segment [head, n) to the end of the new buffer
+		to := len(data) - front
+		algorithms.MoveRange(data, d.head, to, front)
+		clear(data[d.head:n]) // Help GC
+		d.head = to
+	} else {
+		// Move the wrapped segment [0, head) after the old end
+		algorithms.MoveRange(data, 0, n, d.head)
+		clear(data[:d.head]) // Help GC
+	}
+
+	d.data = data
+	d.hooks.Resized(n, len(data))
+}
+
+// Replaces the elements with the decoded values, front to back, in a
+// new buffer of the smallest power of two that holds them.
+func (d *RingDeque[T]) restore(values []T) {
+	var buf []T
+	if len(values) > 0 {
+		buf = make([]T, 1<<bits.Len(uint(len(values)-1)))
+		copy(buf, values)
+	}
+
+	capBefore := len(d.data)
+	d.data = buf
+	d.head = 0
+	d.size = len(values)
+	d.hooks.Resized(capBefore, len(buf))
+}
@@ -0,0 +1,35 @@
+package structures
+
+// RingDequeConfig controls the buffer a RingDeque starts with.
+//
+// Default configuration (NewRingDeque):
+//
+//	InitialCapacity: 0  // the buffer is allocated by the first push
+//
+// Example configurations:
+//
+//	// Known size: pushes up to 1000 elements never reallocate
+//	config := RingDequeConfig{InitialCapacity: 1000}
+type RingDequeConfig struct {
+	// InitialCapacity is the number of elements the buffer holds before
+	// its first reallocation. It is rounded up to a power of two. Must be
+	// >= 0.
+	InitialCapacity int
+}
+
+// RingDequeOption configures a RingDeque created with NewRingDequeWith.
+// Options are applied in order on top of the default configuration.
+type RingDequeOption func(*RingDequeConfig)
+
+// WithDequeCapacity sets the capacity allocated by the constructor.
+// See RingDequeConfig.InitialCapacity.
+func WithDequeCapacity(capacity int) RingDequeOption {
+	return func(c *RingDequeConfig) {
+		c.InitialCapacity = capacity
+	}
+}
+
+// Returns the configuration used by NewRingDeque.
+func defaultRingDequeConfig() RingDequeConfig {
+	return RingDequeConfig{}
+}
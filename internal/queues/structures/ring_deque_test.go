@@ -0,0 +1,461 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewRingDeque):
+  ✓ Empty deque
+  ✓ Multiple values, first at the front
+
+Constructor (NewRingDequeWith):
+  ✓ Negative capacity (panic)
+  ✓ No options and zero capacity allocate nothing
+  ✓ Buffer rounded up to a power of two, pushes within it do not grow
+
+Constructor (NewRingDequeWithCapacity):
+  ✓ Same buffer as NewRingDequeWith with WithDequeCapacity
+
+PushFront/PushBack/PopFront/PopBack:
+  ✓ Pop from empty deque
+  ✓ Both ends, LIFO at one end and FIFO across ends
+  ✓ Growth while wrapped around the buffer
+  ✓ Growth moves the shorter wrapped segment, vacated slots cleared
+  ✓ Popped slots are cleared
+
+PeekFront/PeekBack/Get:
+  ✓ Empty deque
+  ✓ Both ends, index out of range
+
+Enqueue/Dequeue/Peek:
+  ✓ FIFO order through the Queue interface
+
+All/Backward:
+  ✓ Both directions across the wrap point, early termination
+
+ShrinkToFit:
+  ✓ Smallest power-of-two buffer, wrapped order preserved, OnShrink
+    reported
+  ✓ Empty deque releases the buffer
+
+SetHooks:
+  ✓ OnGrow reports every doubling of the buffer
+
+CheckInvariants:
+  ✓ Valid deques, buffer length, size and front index out of range
+
+Randomized:
+  ✓ Mixed operations match a slice model
+
+Add/Clear:
+  ✓ Add pushes to the back and always returns true
+  ✓ Clear empties the deque and keeps the buffer
+
+Binary encoding:
+  ✓ Round trip of a wrapped buffer into the smallest power of two
+  ✓ Invalid data leaves the deque unchanged
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the deque unchanged
+*/
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty deque
+func TestRingDeque_NewRingDeque_Empty(t *testing.T) {
+	d := NewRingDeque[int]()
+	test.GotWant(t, d.Size(), 0)
+	test.GotWant(t, d.IsEmpty(), true)
+}
+
+// Verifies the creation of a multi-element deque with the first value at
+// the front
+func TestRingDeque_NewRingDeque_ManyValues(t *testing.T) {
+	d := NewRingDeque(1, 2, 3)
+	test.GotWant(t, d.Size(), 3)
+	test.GotWantSlice(t, slices.Collect(d.All()), []int{1, 2, 3})
+}
+
+// Verifies NewRingDequeWith panics on a negative capacity
+func TestRingDeque_NewRingDequeWith_Negative(t *testing.T) {
+	test.GotWantPanic(t, func() { NewRingDequeWith[int](WithDequeCapacity(-1)) }, `"capacity" must be >= 0, got -1`)
+}
+
+// Verifies no options and a zero capacity allocate no buffer
+func TestRingDeque_NewRingDequeWith_Zero(t *testing.T) {
+	for _, d := range []*RingDeque[int]{
+		NewRingDequeWith[int](),
+		NewRingDequeWith[int](WithDequeCapacity(0)),
+	} {
+		test.GotWant(t, len(d.data), 0)
+		test.GotWantNoError(t, d.CheckInvariants())
+	}
+}
+
+// Verifies the buffer is rounded up to a power of two and pushes at both
+// ends within it do not grow the buffer
+func TestRingDeque_NewRingDequeWith(t *testing.T) {
+	d := NewRingDequeWith[int](WithDequeCapacity(100))
+	test.GotWant(t, len(d.data), 128)
+	test.GotWantNoError(t, d.CheckInvariants())
+
+	grows := 0
+	d.SetHooks(algorithms.SliceHooks{OnGrow: func(int, int) { grows++ }})
+	for i := range 64 {
+		d.PushFront(i)
+		d.PushBack(i)
+	}
+	test.GotWant(t, grows, 0)
+	test.GotWant(t, d.Size(), 128)
+}
+
+// Verifies the deprecated constructor allocates the same buffer
+func TestRingDeque_NewRingDequeWithCapacity(t *testing.T) {
+	d := NewRingDequeWithCapacity[int](100)
+	test.GotWant(t, len(d.data), 128)
+	test.GotWantNoError(t, d.CheckInvariants())
+}
+
+// Verifies popping and peeking an empty deque
+func TestRingDeque_Pop_EmptyDeque(t *testing.T) {
+	d := NewRingDeque[int]()
+	_, err := d.PopFront()
+	test.GotWantErrorIs(t, err, ErrEmptyDeque)
+	_, err = d.PopBack()
+	test.GotWantErrorIs(t, err, ErrEmptyDeque)
+	_, err = d.PeekFront()
+	test.GotWantErrorIs(t, err, ErrEmptyDeque)
+	_, err = d.PeekBack()
+	test.GotWantErrorIs(t, err, ErrEmptyDeque)
+}
+
+// Verifies pushing and popping at both ends
+func TestRingDeque_PushPop_BothEnds(t *testing.T) {
+	d := NewRingDeque[int]()
+	d.PushBack(2)
+	d.PushFront(1)
+	d.PushBack(3)
+	d.PushFront(0)
+
+	front, _ := d.PeekFront()
+	back, _ := d.PeekBack()
+	test.GotWant(t, front, 0)
+	test.GotWant(t, back, 3)
+
+	v, err := d.PopFront()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, v, 0)
+	v, _ = d.PopBack()
+	test.GotWant(t, v, 3)
+	v, _ = d.PopBack()
+	test.GotWant(t, v, 2)
+	v, _ = d.PopFront()
+	test.GotWant(t, v, 1)
+	test.GotWant(t, d.IsEmpty(), true)
+}
+
+// Verifies the buffer grows correctly while its contents wrap around
+func TestRingDeque_Grow_Wrapped(t *testing.T) {
+	d := NewRingDeque[int]()
+	for i := range 6 {
+		d.PushBack(i)
+	}
+	for range 4 {
+		d.PopFront()
+	}
+	for i := 6; i < 20; i++ {
+		d.PushBack(i)
+	}
+	d.PushFront(3)
+
+	want := []int{3}
+	for i := 4; i < 20; i++ {
+		want = append(want, i)
+	}
+	test.GotWantSlice(t, slices.Collect(d.All()), want)
+	test.GotWant(t, len(d.data), 32)
+}
+
+// Verifies growth relocates only the shorter of the two wrapped segments
+func TestRingDeque_Grow_MovesShorterSegment(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		head     int
+		wantHead int
+	}{
+		{"short_front_segment", 6, 14},  // [6, 8) moves to the end
+		{"short_wrapped_segment", 2, 2}, // [0, 2) moves after the old end
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := NewRingDeque[*int]()
+			for range c.head {
+				d.PushBack(nil)
+			}
+			for range c.head {
+				d.PopFront()
+			}
+
+			want := []*int{}
+			for range 9 {
+				p := new(int)
+				want = append(want, p)
+				d.PushBack(p)
+			}
+
+			test.GotWant(t, len(d.data), 16)
+			test.GotWant(t, d.head, c.wantHead)
+			test.GotWantSlice(t, slices.Collect(d.All()), want)
+			nils := 0
+			for _, p := range d.data {
+				if p == nil {
+					nils++
+				}
+			}
+			test.GotWant(t, nils, 16-9)
+		})
+	}
+}
+
+// Verifies popped slots no longer reference their elements
+func TestRingDeque_Pop_ClearsSlots(t *testing.T) {
+	d := NewRingDeque(new(int), new(int), new(int))
+	d.PopFront()
+	d.PopBack()
+	nils := 0
+	for _, p := range d.data {
+		if p == nil {
+			nils++
+		}
+	}
+	test.GotWant(t, nils, len(d.data)-1)
+}
+
+// Verifies indexed access from the front and out-of-range indices
+func TestRingDeque_Get(t *testing.T) {
+	d := NewRingDeque(1, 2, 3)
+	d.PushFront(0)
+	for i := range 4 {
+		v, err := d.Get(i)
+		test.GotWantNoError(t, err)
+		test.GotWant(t, v, i)
+	}
+
+	_, err := d.Get(-1)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
+	_, err = d.Get(4)
+	test.GotWantErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+// Verifies FIFO order through the Queue interface
+func TestRingDeque_Queue_FIFO(t *testing.T) {
+	var q Queue[int] = NewRingDeque[int]()
+	for i := range 20 {
+		q.Enqueue(i)
+	}
+	for i := range 20 {
+		front, _ := q.Peek()
+		test.GotWant(t, front, i)
+		v, _ := q.Dequeue()
+		test.GotWant(t, v, i)
+	}
+	_, err := q.Dequeue()
+	test.GotWantErrorIs(t, err, ErrEmptyDeque)
+}
+
+// Verifies ShrinkToFit moves a wrapped deque into the smallest buffer
+// holding it and reports the shrink
+func TestRingDeque_ShrinkToFit(t *testing.T) {
+	d := NewRingDeque[int]()
+	for i := range 20 {
+		d.PushBack(i)
+	}
+	for range 15 {
+		d.PopFront()
+	}
+	d.PushFront(-1) // Wrap around the front
+
+	got := [][2]int{}
+	d.SetHooks(algorithms.SliceHooks{
+		OnShrink: func(oldCap, newCap int) { got = append(got, [2]int{oldCap, newCap}) },
+	})
+	d.ShrinkToFit()
+	test.GotWant(t, len(d.data), 8)
+	test.GotWantDeep(t, got, [][2]int{{32, 8}})
+	test.GotWantSlice(t, slices.Collect(d.All()), []int{-1, 15, 16, 17, 18, 19})
+	test.GotWantNoError(t, d.CheckInvariants())
+
+	d.ShrinkToFit()
+	test.GotWant(t, len(got), 1)
+}
+
+// Verifies ShrinkToFit on an emptied deque releases the buffer
+func TestRingDeque_ShrinkToFit_Empty(t *testing.T) {
+	d := NewRingDeque(1, 2, 3)
+	d.Clear()
+	d.ShrinkToFit()
+	test.GotWant(t, len(d.data), 0)
+	test.GotWantNoError(t, d.CheckInvariants())
+
+	d.PushBack(4)
+	test.GotWantSlice(t, slices.Collect(d.All()), []int{4})
+}
+
+// Verifies OnGrow reports every doubling of the buffer from either end
+func TestRingDeque_Hooks_OnGrow(t *testing.T) {
+	d := NewRingDeque[int]()
+	got := [][2]int{}
+	d.SetHooks(algorithms.SliceHooks{
+		OnGrow: func(oldCap, newCap int) { got = append(got, [2]int{oldCap, newCap}) },
+	})
+	for i := range 20 {
+		if i%2 == 0 {
+			d.PushFront(i)
+		} else {
+			d.PushBack(i)
+		}
+	}
+	for range 20 {
+		d.PopFront()
+	}
+
+	test.GotWantSlice(t, got, [][2]int{{0, 8}, {8, 16}, {16, 32}})
+}
+
+// Verifies iteration in both directions across the wrap point and early
+// termination
+func TestRingDeque_All(t *testing.T) {
+	d := NewRingDeque(2, 3, 4)
+	d.PushFront(1)
+	d.PushFront(0)
+
+	test.GotWantSlice(t, slices.Collect(d.All()), []int{0, 1, 2, 3, 4})
+	test.GotWantSlice(t, slices.Collect(d.Backward()), []int{4, 3, 2, 1, 0})
+
+	count := 0
+	for range d.All() {
+		count++
+		break
+	}
+	for range d.Backward() {
+		count++
+		break
+	}
+	test.GotWant(t, count, 2)
+}
+
+// Verifies CheckInvariants accepts valid deques and reports a buffer length
+// that is not a power of two, a size beyond the buffer and a front index
+// outside it
+func TestRingDeque_CheckInvariants(t *testing.T) {
+	d := NewRingDeque[int]()
+	test.GotWantNoError(t, d.CheckInvariants())
+
+	d = NewRingDeque(1, 2, 3)
+	test.GotWantNoError(t, d.CheckInvariants())
+
+	n := len(d.data)
+	d.size = n + 1
+	test.GotWantError(t, d.CheckInvariants(), fmt.Sprintf("invariant violation: size %d outside [0, %d]", n+1, n))
+	d.size = 3
+
+	d.head = n
+	test.GotWantError(t, d.CheckInvariants(), fmt.Sprintf("invariant violation: front index %d outside the buffer of length %d", n, n))
+	d.head = 0
+
+	d.data = d.data[:n-1]
+	test.GotWantError(t, d.CheckInvariants(), fmt.Sprintf("invariant violation: buffer length %d is not a power of two", n-1))
+}
+
+// Verifies random operations at both ends match a slice model
+func TestRingDeque_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(81, 82))
+	d := NewRingDeque[int]()
+	model := []int{}
+
+	for i := range 20_000 {
+		switch r.IntN(4) {
+		case 0:
+			d.PushFront(i)
+			model = slices.Insert(model, 0, i)
+		case 1:
+			d.PushBack(i)
+			model = append(model, i)
+		case 2:
+			v, err := d.PopFront()
+			if len(model) == 0 {
+				test.GotWantErrorIs(t, err, ErrEmptyDeque)
+			} else {
+				test.GotWant(t, v, model[0])
+				model = model[1:]
+			}
+		case 3:
+			v, err := d.PopBack()
+			if len(model) == 0 {
+				test.GotWantErrorIs(t, err, ErrEmptyDeque)
+			} else {
+				test.GotWant(t, v, model[len(model)-1])
+				model = model[:len(model)-1]
+			}
+		}
+		test.GotWant(t, d.Size(), len(model))
+		test.GotWantNoError(t, d.CheckInvariants())
+	}
+
+	test.GotWantSlice(t, slices.Collect(d.All()), model)
+}
+
+// Verifies Add pushes to the back of the deque
+func TestRingDeque_Add(t *testing.T) {
+	d := NewRingDeque(1)
+	test.GotWant(t, d.Add(2), true)
+	test.GotWantSlice(t, slices.Collect(d.All()), []int{1, 2})
+}
+
+// Verifies Clear empties a wrapped deque, keeps its buffer and zeroes the
+// removed slots
+func TestRingDeque_Clear(t *testing.T) {
+	d := NewRingDeque(1, 2, 3)
+	d.PushFront(0)
+	capBefore := len(d.data)
+
+	d.Clear()
+	test.GotWant(t, d.IsEmpty(), true)
+	test.GotWant(t, len(d.data), capBefore)
+	test.GotWantSlice(t, d.data, make([]int, capBefore))
+	test.GotWantNoError(t, d.CheckInvariants())
+
+	d.PushBack(5)
+	test.GotWantSlice(t, slices.Collect(d.All()), []int{5})
+}
+
+// Verifies a round trip of a wrapped buffer restores the order in the
+// smallest power of two and invalid data is rejected
+func TestRingDeque_MarshalBinary(t *testing.T) {
+	src := NewRingDequeWith[int](WithDequeCapacity(16))
+	src.PushBack(2)
+	src.PushBack(3)
+	src.PushFront(1)
+	other, _ := NewSliceQueue(1).MarshalBinary()
+	dst := NewRingDeque(7)
+	codectest.RoundTrip(t, src, dst,
+		func(d *RingDeque[int]) []int { return slices.Collect(d.All()) }, other)
+	test.GotWant(t, len(dst.data), 4)
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements from front to back
+func TestRingDeque_WriteTo_ReadFrom(t *testing.T) {
+	src := NewRingDeque(1, 2, 3)
+	dst := NewRingDeque(9)
+	codectest.StreamRoundTrip(t, src, dst,
+		func(d *RingDeque[int]) []int { return slices.Collect(d.All()) })
+}
@@ -1,6 +1,20 @@
 package structures
 
-import "errors"
+import (
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Compile-time interface verifications
+var _ Queue[int] = &SliceQueue[int]{}
+var _ collections.Iterable[int] = &SliceQueue[int]{}
+var _ collections.Addable[int] = &SliceQueue[int]{}
 
 // SliceQueue implements a FIFO queue using a dynamic slice with configurable
 // memory optimizations. It supports two optimization strategies:
@@ -14,6 +28,7 @@ import "errors"
 //   - Best for: permanent shrinkage, memory-constrained environments
 //   - Benefit: ~97-99% memory freed after shrinkage
 //   - Tradeoff: Reallocation overhead
+//   - Variant: ReallocateByHalving halves capacity below 25% usage instead
 //
 // Default configuration enables both optimizations for balanced performance.
 // See benchmarks in slice_queue_bench_test.go for detailed comparisons.
@@ -21,44 +36,74 @@ type SliceQueue[T any] struct {
 	curr   int              // Index of front element
 	data   []T              // Underlying slice storage
 	config SliceQueueConfig // Optimization configuration
+	hooks  algorithms.SliceHooks
 }
 
 // NewSliceQueue creates a queue with default optimizations enabled.
 // Suitable for most workloads including balanced operations, oscillating
 // sizes, and mixed growth/shrinkage patterns.
 //
-// For specific workloads, use NewSliceQueueWithConfig:
+// For specific workloads, use NewSliceQueueWith:
 //   - Pure growth: disable both optimizations
-//   - Balanced/oscillating: enable CompactOnEnqueue only
-//   - Permanent shrinkage: enable ReallocateOnDequeue
+//   - Balanced/oscillating: WithReallocateOnDequeue(false)
+//   - Permanent shrinkage: WithCompactOnEnqueue(false)
 //   - Unknown/mixed: use default (both enabled)
+//   - Known size: WithCapacity
 func NewSliceQueue[T any](values ...T) *SliceQueue[T] {
-	config := SliceQueueConfig{
-		CompactOnEnqueue:       true,
-		ReallocateOnDequeue:    true,
-		MinOptimizationLength:  100,
-		CompactWastePercent:    50,
-		ReallocateWastePercent: 75,
+	return newSliceQueue(defaultSliceQueueConfig(), values...)
+}
+
+// NewSliceQueueWith creates an empty queue from the default configuration
+// adjusted by the given options. Options are applied in order.
+//
+// Example:
+//
+//	q := NewSliceQueueWith[int](
+//	    WithReallocateOnDequeue(false),
+//	    WithCapacity(1000),
+//	)
+//	for i := range 1000 {
+//	    q.Enqueue(i)  // Never reallocates
+//	}
+func NewSliceQueueWith[T any](opts ...SliceQueueOption) *SliceQueue[T] {
+	c := defaultSliceQueueConfig()
+	for _, opt := range opts {
+		opt(&c)
 	}
 
-	return NewSliceQueueWithConfig(config, values...)
+	return newSliceQueue[T](c)
+}
+
+// NewSliceQueueWithCapacity creates an empty queue with default
+// optimizations and room for capacity elements, so enqueuing up to that
+// many elements does not reallocate, like make([]T, 0, capacity).
+// ReallocateOnDequeue may still release the capacity once the queue has
+// grown and shrunk again.
+//
+// Panics if capacity is negative.
+//
+// Deprecated: Use NewSliceQueueWith with WithCapacity.
+func NewSliceQueueWithCapacity[T any](capacity int) *SliceQueue[T] {
+	panics.RequireNonNegative(capacity, "capacity")
+	q := NewSliceQueue[T]()
+	q.Reserve(capacity)
+	return q
 }
 
 // NewSliceQueueWithConfig creates a queue with custom optimization settings.
 // See SliceQueueConfig for configuration options and tuning guidance.
 //
-// Example:
-//
-//	config := SliceQueueConfig{
-//	    CompactOnEnqueue:      true,
-//	    ReallocateOnDequeue:   false,
-//	    MinOptimizationLength: 1000,
-//	    CompactWastePercent:   60,
-//	}
-//	q := NewSliceQueueWithConfig(config, 1, 2, 3)
+// Deprecated: Use NewSliceQueueWith with the options named after the
+// fields of SliceQueueConfig, then Enqueue.
 func NewSliceQueueWithConfig[T any](config SliceQueueConfig, values ...T) *SliceQueue[T] {
+	return newSliceQueue(config, values...)
+}
+
+// Creates a queue with the given settings holding the values, front to
+// back.
+func newSliceQueue[T any](config SliceQueueConfig, values ...T) *SliceQueue[T] {
 	q := &SliceQueue[T]{
-		data: make([]T, 0, len(values)),
+		data: make([]T, 0, max(len(values), config.InitialCapacity)),
 	}
 
 	q.data = append(q.data, values...)
@@ -78,12 +123,63 @@ func (q *SliceQueue[T]) Enqueue(value T) {
 		100.0*q.Size() < q.config.CompactWastePercent*len(q.data)
 
 	if optimize {
-		copy(q.data, q.data[q.curr:])
+		moved := copy(q.data, q.data[q.curr:])
 		q.data = q.data[:len(q.data)-q.curr]
 		q.curr = 0
+		q.hooks.Compacted(moved)
 	}
 
+	capBefore := cap(q.data)
 	q.data = append(q.data, value)
+	q.hooks.Resized(capBefore, cap(q.data))
+}
+
+// Add enqueues the element at the back of the queue, as Enqueue does.
+// Always returns true.
+//
+// Time complexity: O(1) amortized, O(n) when compaction triggers
+func (q *SliceQueue[T]) Add(value T) bool {
+	q.Enqueue(value)
+	return true
+}
+
+// Reserve ensures that the next n enqueues do not reallocate. If the
+// capacity behind the back element is insufficient, the elements are moved
+// to the front of a slice sized for exactly Size()+n elements, instead of
+// the repeated doubling that enqueuing them one by one would cause.
+//
+// Time complexity: O(n) when the capacity grows, O(1) otherwise
+//
+// Panics if n is negative.
+//
+// Example:
+//
+//	q := NewSliceQueue[int]()
+//	q.Reserve(1000)
+//	for i := range 1000 {
+//	    q.Enqueue(i)  // Never reallocates
+//	}
+func (q *SliceQueue[T]) Reserve(n int) {
+	panics.RequireNonNegative(n, "reserved size")
+	if len(q.data)+n <= cap(q.data) {
+		return
+	}
+
+	if q.IsEmpty() {
+		q.data = q.data[:0]
+		q.curr = 0
+	}
+
+	capBefore := cap(q.data)
+	var end int
+	q.data, q.curr, end = algorithms.Reallocate(
+		q.data, algorithms.SliceReallocationParams{
+			UsedStart: q.curr,
+			UsedEnd:   len(q.data),
+			Headroom:  n,
+		})
+	q.data = q.data[:end]
+	q.hooks.Resized(capBefore, cap(q.data))
 }
 
 // Dequeue removes and returns the element at the front of the queue.
@@ -95,22 +191,29 @@ func (q *SliceQueue[T]) Enqueue(value T) {
 func (q *SliceQueue[T]) Dequeue() (T, error) {
 	if q.IsEmpty() {
 		var zero T
-		return zero, errors.New(ErrorEmptyQueue)
+		return zero, ErrEmptyQueue
 	}
 
 	v := q.data[q.curr]
 	q.curr++
 
+	if q.config.ReallocateOnDequeue && q.config.ReallocateByHalving {
+		q.halve()
+		return v, nil
+	}
+
 	// Reallocate after dequeue when waste is significant (> 'ReallocateWastePercent')
 	optimize := q.config.ReallocateOnDequeue &&
 		q.curr >= q.config.MinOptimizationLength &&
 		100.0*q.Size() < (100-q.config.ReallocateWastePercent)*cap(q.data)
 
 	if optimize {
+		capBefore := cap(q.data)
 		data := q.data[q.curr:]
 		q.data = make([]T, 0, max(len(data)*2, 10))
 		q.data = append(q.data, data...)
 		q.curr = 0
+		q.hooks.Resized(capBefore, cap(q.data))
 	}
 
 	return v, nil
@@ -123,12 +226,131 @@ func (q *SliceQueue[T]) Dequeue() (T, error) {
 func (q *SliceQueue[T]) Peek() (T, error) {
 	if q.IsEmpty() {
 		var zero T
-		return zero, errors.New(ErrorEmptyQueue)
+		return zero, ErrEmptyQueue
 	}
 
 	return q.data[q.curr], nil
 }
 
+// All returns an iterator over the elements from front to back,
+// in the order they would be dequeued.
+// The queue must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+//
+// Example:
+//
+//	q := NewSliceQueue(1, 2, 3)
+//	for v := range q.All() {
+//	    fmt.Println(v)  // Prints 1, 2, 3
+//	}
+func (q *SliceQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range q.data[q.curr:] {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all elements and releases the underlying slice.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	q := NewSliceQueue(1, 2, 3)
+//	q.Clear()  // Queue is empty, capacity is 0
+func (q *SliceQueue[T]) Clear() {
+	capBefore := cap(q.data)
+	q.data = nil
+	q.curr = 0
+	q.hooks.Resized(capBefore, 0)
+}
+
+// ShrinkToFit moves the elements into a new slice of exactly Size()
+// elements, dropping the dequeued slots at the front and the spare
+// capacity at the back regardless of the optimization settings. Use it
+// when a burst is known to be over and the memory is wanted back now.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	q := NewSliceQueue(1, 2, 3, 4)
+//	q.Dequeue()
+//	q.ShrinkToFit()  // Capacity is 3
+func (q *SliceQueue[T]) ShrinkToFit() {
+	capBefore := cap(q.data)
+	if q.curr == 0 && capBefore == len(q.data) {
+		return
+	}
+
+	if q.IsEmpty() {
+		q.data = nil
+	} else {
+		data := make([]T, q.Size())
+		copy(data, q.data[q.curr:])
+		q.data = data
+	}
+	q.curr = 0
+	q.hooks.Resized(capBefore, cap(q.data))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements from front to back in the format of package codec; the slack
+// before the front is not stored.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (q *SliceQueue[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("SliceQueue", q.Size(), q.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the queue with those encoded by MarshalBinary, keeping the
+// configuration. The new slice holds exactly the decoded elements.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded queue, in which case the queue is left unchanged.
+//
+// Time complexity: O(n)
+func (q *SliceQueue[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "SliceQueue")
+	if err != nil {
+		return err
+	}
+
+	q.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (q *SliceQueue[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "SliceQueue", q.Size(), q.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// queue with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The queue is
+// left unchanged on error.
+//
+// Time complexity: O(n)
+func (q *SliceQueue[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "SliceQueue")
+	if err != nil {
+		return n, err
+	}
+
+	q.restore(values)
+	return n, nil
+}
+
 // IsEmpty returns true if the queue contains no elements.
 //
 // Time complexity: O(1)
@@ -142,3 +364,77 @@ func (q *SliceQueue[T]) IsEmpty() bool {
 func (q *SliceQueue[T]) Size() int {
 	return len(q.data) - q.curr
 }
+
+// SetHooks installs callbacks for the queue's storage events, replacing
+// any installed before: OnGrow when Enqueue or Reserve grows the capacity,
+// OnShrink when reallocation or halving after Dequeue, Clear or
+// ShrinkToFit reduces it, and
+// OnCompact when CompactOnEnqueue shifts the elements to the front.
+// The zero SliceHooks removes all callbacks.
+//
+// Example:
+//
+//	q.SetHooks(algorithms.SliceHooks{
+//	    OnShrink: func(oldCap, newCap int) { log.Printf("freed %d slots", oldCap-newCap) },
+//	})
+func (q *SliceQueue[T]) SetHooks(hooks algorithms.SliceHooks) {
+	q.hooks = hooks
+}
+
+// CheckInvariants verifies the internal consistency of the queue: the
+// front index lies within the used part of the slice. Intended for tests
+// and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the queue is consistent.
+//
+// Time complexity: O(1)
+func (q *SliceQueue[T]) CheckInvariants() error {
+	if q.curr < 0 || q.curr > len(q.data) {
+		return fmt.Errorf("%w: front index %d outside [0, %d]", ErrInvariantViolation, q.curr, len(q.data))
+	}
+
+	return nil
+}
+
+// Halves the capacity once the queue size drops below 25% of it.
+// Resets the slice when the queue becomes empty so capacity is reused.
+func (q *SliceQueue[T]) halve() {
+	if q.IsEmpty() {
+		q.data = q.data[:0]
+		q.curr = 0
+		return
+	}
+
+	if q.curr < q.config.MinOptimizationLength {
+		return
+	}
+
+	capBefore := cap(q.data)
+	var end int
+	q.data, q.curr, end = algorithms.Halve(
+		q.data, algorithms.SliceHalvingParams{
+			UsedStart: q.curr,
+			UsedEnd:   len(q.data),
+		})
+	q.data = q.data[:end]
+	q.hooks.Resized(capBefore, cap(q.data))
+}
+
+// Returns the configuration used by NewSliceQueue.
+func defaultSliceQueueConfig() SliceQueueConfig {
+	return SliceQueueConfig{
+		CompactOnEnqueue:       true,
+		ReallocateOnDequeue:    true,
+		MinOptimizationLength:  100,
+		CompactWastePercent:    50,
+		ReallocateWastePercent: 75,
+	}
+}
+
+// Replaces the elements with the decoded values, front to back.
+func (q *SliceQueue[T]) restore(values []T) {
+	capBefore := cap(q.data)
+	q.data = values
+	q.curr = 0
+	q.hooks.Resized(capBefore, cap(values))
+}
@@ -45,167 +45,28 @@ var configs = map[string]SliceQueueConfig{
 	},
 }
 
-// BenchmarkSliceQueue_Balanced measures performance with equal enqueue/dequeue operations.
-// Queue size remains constant. Tests steady-state performance without growth or shrinkage.
+// BenchmarkSliceQueue_Profiles runs every workload profile of the bench
+// harness against each configuration, reporting allocations and the memory
+// the queue retains.
 //
-// Pattern: [Enqueue, Dequeue] × 500
-// Expected winner: CompactOnly (~3x faster, 0 allocations)
-func BenchmarkSliceQueue_Balanced(b *testing.B) {
+// Expected winners:
+//   - Balanced, Bursty: CompactOnly (~3x faster, 0 allocations)
+//   - MostlyGrowing: NoOptimizations (compaction overhead hurts growth)
+//   - GrowOnly, ShrinkOnly: All configs similar (no optimization triggers)
+//   - MostlyShrinking: CompactOnly or ReallocateOnly
+func BenchmarkSliceQueue_Profiles(b *testing.B) {
+	targets := map[string]func() bench.Target{}
 	for name, config := range configs {
-		b.Run(name, func(b *testing.B) {
-			q := NewSliceQueueWithConfig[int](config)
-
-			for i := range 10000 {
-				q.Enqueue(i)
-			}
-
-			b.ResetTimer()
-
-			for b.Loop() {
-				for j := range 500 {
-					q.Enqueue(j)
-					q.Dequeue()
-				}
-			}
-		})
-	}
-}
-
-// BenchmarkSliceQueue_Oscilating measures performance with alternating growth/shrinkage.
-// Creates significant waste (70%) then refills. Tests compaction effectiveness.
-//
-// Pattern: Create 70% waste → [Dequeue × 500, Enqueue × 500]
-// Expected winner: CompactOnly (triggers compaction, reuses capacity)
-func BenchmarkSliceQueue_Oscilating(b *testing.B) {
-	for name, config := range configs {
-		b.Run(name, func(b *testing.B) {
-			q := NewSliceQueueWithConfig[int](config)
-
-			for i := range 10000 {
-				q.Enqueue(i)
-			}
-
-			for range 7000 {
-				q.Dequeue() // Dequeue 70%, creates 70% waste!
-			}
-
-			b.ResetTimer()
-
-			for b.Loop() {
-				for range 500 {
-					q.Dequeue()
-				}
-
-				for j := range 500 {
-					q.Enqueue(j)
-				}
-			}
-		})
-	}
-}
-
-// BenchmarkSliceQueue_MostlyGrowing measures performance with net positive growth.
-// 67% enqueue, 33% dequeue. Tests optimization overhead on growing queues.
-//
-// Pattern: [Enqueue, Enqueue, Dequeue] × 333
-// Expected winner: NoOptimizations (compaction overhead hurts growth)
-func BenchmarkSliceQueue_MostlyGrowing(b *testing.B) {
-	for name, config := range configs {
-		b.Run(name, func(b *testing.B) {
-			q := NewSliceQueueWithConfig[int](config)
-
-			b.ResetTimer()
-
-			for b.Loop() {
-				for j := range 1000 {
-					if j%3 == 0 {
-						q.Dequeue()
-					} else {
-						q.Enqueue(j)
-					}
-				}
-			}
-		})
-	}
-}
-
-// BenchmarkSliceQueue_OnlyGrowing measures performance with pure insertion.
-// Tests baseline enqueue performance without any dequeue operations.
-//
-// Pattern: [Enqueue] × 1000
-// Expected: All configs similar (no optimization triggers)
-func BenchmarkSliceQueue_OnlyGrowing(b *testing.B) {
-	for name, config := range configs {
-		b.Run(name, func(b *testing.B) {
-			q := NewSliceQueueWithConfig[int](config)
-
-			b.ResetTimer()
-
-			for b.Loop() {
-				for j := range 1000 {
-					q.Enqueue(j)
-				}
-			}
-		})
+		targets[name] = func() bench.Target {
+			return sliceQueueTarget(NewSliceQueueWithConfig[int](config))
+		}
 	}
-}
-
-// BenchmarkSliceQueue_MostlyShrinking measures performance with net negative growth.
-// 67% dequeue, 33% enqueue. Tests reallocation effectiveness.
-//
-// Pattern: Start with 1M elements → [Dequeue, Dequeue, Enqueue] × 333
-// Expected winner: CompactOnly or ReallocateOnly (depending on refill pattern)
-func BenchmarkSliceQueue_MostlyShrinking(b *testing.B) {
-	for name, config := range configs {
-		b.Run(name, func(b *testing.B) {
-			q := NewSliceQueueWithConfig[int](config)
 
-			for i := range 1_000_000 {
-				q.Enqueue(i)
-			}
-
-			b.ResetTimer()
-
-			for b.Loop() {
-				for j := range 1000 {
-					if j%3 == 0 {
-						q.Enqueue(j)
-					} else {
-						q.Dequeue()
-					}
-				}
-			}
-		})
-	}
-}
-
-// BenchmarkSliceQueue_OnlyShrinking measures performance with pure removal.
-// Tests baseline dequeue performance after large queue.
-//
-// Pattern: Start with 1M elements → [Dequeue] × 1000
-// Expected: All configs similar (optimization in dequeue path rarely helps)
-func BenchmarkSliceQueue_OnlyShrinking(b *testing.B) {
-	for name, config := range configs {
-		b.Run(name, func(b *testing.B) {
-			q := NewSliceQueueWithConfig[int](config)
-
-			for i := range 1_000_000 {
-				q.Enqueue(i)
-			}
-
-			b.ResetTimer()
-
-			for b.Loop() {
-				for range 1000 {
-					q.Dequeue()
-				}
-			}
-		})
-	}
+	bench.Run(b, targets, bench.Profiles()...)
 }
 
 // BenchmarkSliceQueue_TotalMemory measures total memory footprint (capacity)
-// across different workload patterns. Reports custom metric "total-KB" showing
+// across different workload patterns. Reports custom metric "retained-KB" showing
 // actual memory held by the queue after operations.
 //
 // This benchmark demonstrates when reallocation provides value:
@@ -214,10 +75,6 @@ func BenchmarkSliceQueue_OnlyShrinking(b *testing.B) {
 //   - OnlyEnqueue: All configs same
 //   - MostlyEnqueue: CompactOnly prevents unbounded growth
 func BenchmarkSliceQueue_TotalMemory(b *testing.B) {
-	total := func(q *SliceQueue[int]) float64 {
-		return bench.ToKiloBytes(cap(q.data), 8)
-	}
-
 	for name, config := range configs {
 		q := NewSliceQueueWithConfig[int](config)
 
@@ -226,7 +83,7 @@ func BenchmarkSliceQueue_TotalMemory(b *testing.B) {
 				q.Enqueue(i)
 			}
 
-			b.ReportMetric(total(q), "total-KB")
+			bench.ReportRetained(b, cap(q.data)*8)
 		})
 
 		b.Run(name+"/OnlyDequeue", func(b *testing.B) {
@@ -234,7 +91,7 @@ func BenchmarkSliceQueue_TotalMemory(b *testing.B) {
 				q.Dequeue()
 			}
 
-			b.ReportMetric(total(q), "total-KB")
+			bench.ReportRetained(b, cap(q.data)*8)
 		})
 
 		b.Run(name+"/MostlyEnqueue", func(b *testing.B) {
@@ -246,7 +103,7 @@ func BenchmarkSliceQueue_TotalMemory(b *testing.B) {
 				}
 			}
 
-			b.ReportMetric(total(q), "total-KB")
+			bench.ReportRetained(b, cap(q.data)*8)
 		})
 
 		b.Run(name+"/MostlyDequeue", func(b *testing.B) {
@@ -258,7 +115,52 @@ func BenchmarkSliceQueue_TotalMemory(b *testing.B) {
 				}
 			}
 
-			b.ReportMetric(total(q), "total-KB")
+			bench.ReportRetained(b, cap(q.data)*8)
+		})
+	}
+}
+
+// BenchmarkSliceQueue_ShrinkStrategies compares the waste-percent
+// reallocation with capacity halving on a permanently shrinking queue.
+// Reports custom metric "retained-KB" showing memory held after shrinking.
+//
+// Pattern: Enqueue 1M → Dequeue 999,000
+// Expected: Both reclaim most memory at similar cost
+func BenchmarkSliceQueue_ShrinkStrategies(b *testing.B) {
+	strategies := map[string]SliceQueueConfig{
+		"WastePercent": {
+			ReallocateOnDequeue:    true,
+			MinOptimizationLength:  100,
+			ReallocateWastePercent: 75,
+		},
+		"Halving": {
+			ReallocateOnDequeue:   true,
+			ReallocateByHalving:   true,
+			MinOptimizationLength: 100,
+		},
+	}
+
+	for name, config := range strategies {
+		b.Run(name, func(b *testing.B) {
+			var q *SliceQueue[int]
+			for b.Loop() {
+				q = NewSliceQueueWithConfig[int](config)
+				for i := range 1_000_000 {
+					q.Enqueue(i)
+				}
+				for range 999_000 {
+					q.Dequeue()
+				}
+			}
+
+			bench.ReportRetained(b, cap(q.data)*8)
 		})
 	}
 }
+
+// Adapts a queue to the bench harness, reporting its capacity.
+func sliceQueueTarget(q *SliceQueue[int]) bench.Target {
+	t := bench.Queue(q)
+	t.Capacity = func() int { return cap(q.data) }
+	return t
+}
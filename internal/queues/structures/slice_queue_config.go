@@ -109,4 +109,82 @@ type SliceQueueConfig struct {
 	//
 	// Note: Should be higher than CompactWastePercent to avoid conflicts
 	ReallocateWastePercent int
+
+	// ReallocateByHalving switches reallocation to simple capacity halving.
+	//
+	// When enabled together with ReallocateOnDequeue, the capacity is halved
+	// whenever the queue size drops below 25% of the capacity.
+	// ReallocateWastePercent is ignored.
+	//
+	// Halving is cheaper to evaluate and more predictable than the
+	// waste-percent computation, but is not tunable: the trigger (25% usage) and the
+	// shrink factor (2x) are fixed.
+	ReallocateByHalving bool
+
+	// InitialCapacity is the capacity allocated by the constructor, so
+	// enqueues up to that many elements do not reallocate. The capacity is
+	// never smaller than the number of initial values; 0 sizes the slice
+	// to exactly the initial values. Negative values count as 0.
+	InitialCapacity int
+}
+
+// SliceQueueOption configures a SliceQueue created with NewSliceQueueWith.
+// Options are applied in order on top of the default configuration.
+type SliceQueueOption func(*SliceQueueConfig)
+
+// WithCompactOnEnqueue enables or disables compaction during Enqueue.
+// See SliceQueueConfig.CompactOnEnqueue.
+func WithCompactOnEnqueue(enabled bool) SliceQueueOption {
+	return func(c *SliceQueueConfig) {
+		c.CompactOnEnqueue = enabled
+	}
+}
+
+// WithReallocateOnDequeue enables or disables reallocation during Dequeue.
+// See SliceQueueConfig.ReallocateOnDequeue.
+func WithReallocateOnDequeue(enabled bool) SliceQueueOption {
+	return func(c *SliceQueueConfig) {
+		c.ReallocateOnDequeue = enabled
+	}
+}
+
+// WithMinOptimizationLength sets the minimum queue capacity before
+// optimizations are considered.
+// See SliceQueueConfig.MinOptimizationLength.
+func WithMinOptimizationLength(length int) SliceQueueOption {
+	return func(c *SliceQueueConfig) {
+		c.MinOptimizationLength = length
+	}
+}
+
+// WithCompactWastePercent sets the waste threshold to trigger compaction.
+// See SliceQueueConfig.CompactWastePercent.
+func WithCompactWastePercent(percent int) SliceQueueOption {
+	return func(c *SliceQueueConfig) {
+		c.CompactWastePercent = percent
+	}
+}
+
+// WithReallocateWastePercent sets the waste threshold to trigger
+// reallocation. See SliceQueueConfig.ReallocateWastePercent.
+func WithReallocateWastePercent(percent int) SliceQueueOption {
+	return func(c *SliceQueueConfig) {
+		c.ReallocateWastePercent = percent
+	}
+}
+
+// WithReallocateByHalving switches reallocation to capacity halving.
+// See SliceQueueConfig.ReallocateByHalving.
+func WithReallocateByHalving(enabled bool) SliceQueueOption {
+	return func(c *SliceQueueConfig) {
+		c.ReallocateByHalving = enabled
+	}
+}
+
+// WithCapacity sets the capacity allocated by the constructor.
+// See SliceQueueConfig.InitialCapacity.
+func WithCapacity(capacity int) SliceQueueOption {
+	return func(c *SliceQueueConfig) {
+		c.InitialCapacity = capacity
+	}
 }
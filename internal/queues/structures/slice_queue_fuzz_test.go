@@ -0,0 +1,30 @@
+package structures
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/proptest"
+)
+
+// Fuzzes SliceQueue with operation sequences decoded from the input. The
+// first byte selects the configuration, the rest drives the operations of
+// the property tests, which compare every step against a slice model.
+//
+// Run: go test ./internal/queues/structures -run '^$' -fuzz FuzzSliceQueue
+func FuzzSliceQueue(f *testing.F) {
+	f.Add([]byte{0, 10, 20, 30, 200, 250})
+	f.Add([]byte{1, 40, 40, 40, 40, 40, 200, 200, 200, 200, 40, 40, 250, 0})
+	f.Add([]byte{2, 60, 60, 60, 60, 60, 60, 180, 180, 180, 180, 180, 180})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) == 0 {
+			return
+		}
+
+		config := sliceQueueModelConfigs[int(data[0])%len(sliceQueueModelConfigs)].config
+		setup := func() (*SliceQueue[int], []int) {
+			return NewSliceQueueWithConfig[int](config), []int{}
+		}
+		proptest.Replay(t, data[1:], 1000, setup, checkSliceQueueModel, sliceQueueOps()...)
+	})
+}
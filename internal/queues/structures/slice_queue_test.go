@@ -72,6 +72,10 @@ Basic Queue Operations:
   ✓ Reusable after becoming empty
   ✓ Large-scale operations (10,000 elements)
 
+NewSliceQueueWithCapacity:
+  ✓ Negative capacity (panic)
+  ✓ Enqueues up to the capacity do not reallocate
+
 Optimization Verification:
   ✓ Compaction triggers at threshold
   ✓ Compaction resets curr pointer
@@ -79,11 +83,65 @@ Optimization Verification:
   ✓ Reallocation triggers at threshold
   ✓ Reallocation shrinks capacity
   ✓ Reallocation preserves elements
+  ✓ Reallocation by halving shrinks capacity in halves
+
+Reserve:
+  ✓ Negative count (panic)
+  ✓ Capacity sized exactly, front waste dropped, order preserved
+  ✓ Enqueues within reserve keep capacity
+  ✓ Emptied queue reserves from the start
+
+ShrinkToFit:
+  ✓ Front waste and spare capacity dropped, order preserved, OnShrink
+    reported
+  ✓ Empty queue releases the slice
+  ✓ Exact capacity left unchanged
+
+Hooks:
+  ✓ OnGrow reports every capacity increase of Enqueue and Reserve
+  ✓ OnCompact reports the elements moved by compaction
+  ✓ OnShrink reports reallocation and halving after Dequeue
+
+Memory:
+  ✓ Reallocation frees at least 97% of the peak after a permanent shrink
+  ✓ Balanced operations on a compacting queue do not allocate
+
+Add/All/Clear:
+  ✓ Add enqueues at the back and always returns true
+  ✓ All yields front to back, skipping dequeued slots
+  ✓ Clear releases the storage and reports the shrink
+
+Options (NewSliceQueueWith):
+  ✓ No options yields default configuration
+  ✓ Options applied in order
+  ✓ WithCapacity allocates the capacity, never below the initial values
+
+Binary encoding:
+  ✓ Round trip drops the slack before the front, invalid data rejected
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the queue unchanged
+
+CheckInvariants:
+  ✓ Valid queue, front index out of range
+
+Properties:
+  ✓ Random operation sequences match a slice model under every
+    optimization
 */
 
 import (
+	"math/rand/v2"
+	"runtime"
+	"slices"
 	"testing"
 
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+	"github.com/apotourlyan/godatastructures/internal/utilities/bench"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/proptest"
 	"github.com/apotourlyan/godatastructures/internal/utilities/test"
 )
 
@@ -101,13 +159,13 @@ func TestSliceQueue_Empty(t *testing.T) {
 
 	p, pErr := q.Peek()
 	test.GotWant(t, p, 0)
-	test.GotWantError(t, pErr, ErrorEmptyQueue)
+	test.GotWantErrorIs(t, pErr, ErrEmptyQueue)
 	test.GotWant(t, q.Size(), 0)
 	test.GotWant(t, q.IsEmpty(), true)
 
 	d, dErr := q.Dequeue()
 	test.GotWant(t, d, 0)
-	test.GotWantError(t, dErr, ErrorEmptyQueue)
+	test.GotWantErrorIs(t, dErr, ErrEmptyQueue)
 }
 
 // Purpose: Verify constructor with values
@@ -133,6 +191,30 @@ func TestSliceQueue_InitialValues(t *testing.T) {
 	test.GotWantError(t, dErr, "")
 }
 
+// Purpose: Verify NewSliceQueueWithCapacity panics on a negative capacity
+//
+// Config: Default
+func TestSliceQueue_NewSliceQueueWithCapacity_Negative(t *testing.T) {
+	test.GotWantPanic(t, func() { NewSliceQueueWithCapacity[int](-1) }, `"capacity" must be >= 0, got -1`)
+}
+
+// Purpose: Verify enqueues up to the requested capacity do not reallocate
+//
+// Config: Default
+func TestSliceQueue_NewSliceQueueWithCapacity(t *testing.T) {
+	q := NewSliceQueueWithCapacity[int](100)
+	test.GotWant(t, q.IsEmpty(), true)
+	test.GotWant(t, cap(q.data), 100)
+
+	grows := 0
+	q.SetHooks(algorithms.SliceHooks{OnGrow: func(int, int) { grows++ }})
+	for i := range 100 {
+		q.Enqueue(i)
+	}
+	test.GotWant(t, grows, 0)
+	test.GotWant(t, cap(q.data), 100)
+}
+
 // Purpose: Verify generic type support
 //
 // Verifies: Works with string type (not just int)
@@ -191,7 +273,7 @@ func TestSliceQueue_FirstInFirstOutOrder(t *testing.T) {
 
 	p, pErr := q.Peek()
 	test.GotWant(t, p, 0)
-	test.GotWantError(t, pErr, ErrorEmptyQueue)
+	test.GotWantErrorIs(t, pErr, ErrEmptyQueue)
 	test.GotWant(t, q.Size(), 0)
 	test.GotWant(t, q.IsEmpty(), true)
 }
@@ -335,3 +417,501 @@ func TestSliceQueue_Reallocation(t *testing.T) {
 	test.GotWant(t, capAfter < capBefore, true)
 	test.GotWant(t, q.Size(), 150)
 }
+
+// Purpose: Verify halving reallocation triggers correctly
+//
+// Setup: Enqueue 1000, Dequeue 800 (20% usage)
+//
+// Config: ReallocateOnDequeue with ReallocateByHalving
+//
+// Verifies:
+//   - Capacity halves (not sized by waste buffer)
+//   - Size correct after reallocation
+//   - Elements preserved
+func TestSliceQueue_ReallocationByHalving(t *testing.T) {
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{
+		CompactOnEnqueue:      false,
+		ReallocateOnDequeue:   true,
+		ReallocateByHalving:   true,
+		MinOptimizationLength: 10,
+	})
+
+	for i := range 1000 {
+		q.Enqueue(i)
+	}
+
+	capBefore := cap(q.data)
+	for range 800 {
+		q.Dequeue()
+	}
+
+	test.GotWant(t, cap(q.data) <= capBefore/2, true)
+	test.GotWant(t, cap(q.data) >= capBefore/4, true)
+	test.GotWant(t, q.Size(), 200)
+	for i := range 200 {
+		d, _ := q.Dequeue()
+		test.GotWant(t, d, 800+i)
+	}
+	test.GotWant(t, q.IsEmpty(), true)
+}
+
+// Purpose: Verify Reserve panics on a negative count
+//
+// Config: NoOptimizations
+func TestSliceQueue_Reserve_NegativeCount(t *testing.T) {
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{})
+	test.GotWantPanic(t, func() { q.Reserve(-1) }, `"reserved size" must be >= 0, got -1`)
+}
+
+// Purpose: Verify Reserve sizes the capacity precisely
+//
+// Setup: Enqueue 10, Dequeue 4, Reserve 20
+//
+// Config: NoOptimizations
+//
+// Verifies:
+//   - Capacity is exactly Size()+20
+//   - Dequeued slots at the front are dropped
+//   - FIFO order preserved
+func TestSliceQueue_Reserve_Grows(t *testing.T) {
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{})
+	for i := range 10 {
+		q.Enqueue(i)
+	}
+	for range 4 {
+		q.Dequeue()
+	}
+
+	q.Reserve(20)
+	test.GotWant(t, cap(q.data), 26)
+	test.GotWant(t, q.curr, 0)
+	test.GotWant(t, q.Size(), 6)
+	for i := range 6 {
+		d, _ := q.Dequeue()
+		test.GotWant(t, d, 4+i)
+	}
+}
+
+// Purpose: Verify enqueues within the reserved count do not reallocate
+//
+// Config: NoOptimizations
+func TestSliceQueue_Reserve_EnqueuesKeepCapacity(t *testing.T) {
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{}, 1, 2)
+	q.Reserve(100)
+	capBefore := cap(q.data)
+	for i := range 100 {
+		q.Enqueue(i)
+	}
+	test.GotWant(t, cap(q.data), capBefore)
+	test.GotWant(t, q.Size(), 102)
+}
+
+// Purpose: Verify Reserve on a queue emptied by dequeues
+//
+// Config: NoOptimizations
+func TestSliceQueue_Reserve_EmptiedQueue(t *testing.T) {
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{}, 1, 2, 3)
+	for range 3 {
+		q.Dequeue()
+	}
+
+	q.Reserve(30)
+	test.GotWant(t, cap(q.data), 30)
+	test.GotWant(t, q.IsEmpty(), true)
+	q.Enqueue(7)
+	d, _ := q.Dequeue()
+	test.GotWant(t, d, 7)
+}
+
+// Purpose: Verify ShrinkToFit moves the elements into a slice of exactly
+// the live size and reports the shrink
+//
+// Config: NoOptimizations
+func TestSliceQueue_ShrinkToFit(t *testing.T) {
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{})
+	for i := range 10 {
+		q.Enqueue(i)
+	}
+	for range 7 {
+		q.Dequeue()
+	}
+
+	var shrunk [2]int
+	q.SetHooks(algorithms.SliceHooks{
+		OnShrink: func(oldCap, newCap int) { shrunk = [2]int{oldCap, newCap} },
+	})
+	capBefore := cap(q.data)
+	q.ShrinkToFit()
+	test.GotWant(t, cap(q.data), 3)
+	test.GotWant(t, q.curr, 0)
+	test.GotWant(t, shrunk, [2]int{capBefore, 3})
+	test.GotWantSlice(t, slices.Collect(q.All()), []int{7, 8, 9})
+}
+
+// Purpose: Verify ShrinkToFit on an emptied queue releases the slice
+//
+// Config: NoOptimizations
+func TestSliceQueue_ShrinkToFit_Empty(t *testing.T) {
+	q := NewSliceQueueWithConfig(SliceQueueConfig{}, 1, 2)
+	q.Dequeue()
+	q.Dequeue()
+	q.ShrinkToFit()
+	test.GotWant(t, cap(q.data), 0)
+	test.GotWant(t, q.curr, 0)
+}
+
+// Purpose: Verify ShrinkToFit keeps a slice whose capacity already fits
+//
+// Config: NoOptimizations
+func TestSliceQueue_ShrinkToFit_AlreadyFits(t *testing.T) {
+	q := NewSliceQueueWithConfig(SliceQueueConfig{}, 1, 2, 3)
+	data := q.data
+	q.ShrinkToFit()
+	test.GotWant(t, &q.data[0], &data[0])
+}
+
+// Purpose: Verify OnGrow reports every capacity increase
+//
+// Setup: Enqueue 100, Reserve 500
+//
+// Config: NoOptimizations
+//
+// Verifies:
+//   - Each report continues from the previous new capacity
+//   - The last report ends at the current capacity
+func TestSliceQueue_Hooks_OnGrow(t *testing.T) {
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{})
+	grows, last := 0, 0
+	q.SetHooks(algorithms.SliceHooks{
+		OnGrow: func(oldCap, newCap int) {
+			test.GotWant(t, oldCap, last)
+			test.GotWant(t, newCap > oldCap, true)
+			grows++
+			last = newCap
+		},
+	})
+
+	for i := range 100 {
+		q.Enqueue(i)
+	}
+	test.GotWant(t, grows > 0, true)
+	test.GotWant(t, last, cap(q.data))
+
+	q.Reserve(500)
+	test.GotWant(t, last, 600)
+}
+
+// Purpose: Verify OnCompact reports the elements moved by compaction
+//
+// Setup: Enqueue 100, Dequeue 60 (60% waste), Enqueue
+//
+// Config: CompactOnEnqueue, 50% threshold
+func TestSliceQueue_Hooks_OnCompact(t *testing.T) {
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{
+		CompactOnEnqueue:      true,
+		MinOptimizationLength: 10,
+		CompactWastePercent:   50,
+	})
+	for i := range 100 {
+		q.Enqueue(i)
+	}
+	for range 60 {
+		q.Dequeue()
+	}
+
+	moved := []int{}
+	q.SetHooks(algorithms.SliceHooks{
+		OnCompact: func(n int) { moved = append(moved, n) },
+		OnGrow:    func(oldCap, newCap int) { t.Errorf("unexpected growth %d -> %d", oldCap, newCap) },
+	})
+	q.Enqueue(999)
+	test.GotWantSlice(t, moved, []int{40})
+}
+
+// Purpose: Verify OnShrink reports both reallocation strategies
+//
+// Setup: Enqueue 1000, Dequeue 900
+//
+// Config: ReallocateOnDequeue with a 75% threshold, then with halving
+//
+// Verifies:
+//   - At least one shrink is reported
+//   - The last report ends at the current capacity
+func TestSliceQueue_Hooks_OnShrink(t *testing.T) {
+	for _, config := range []SliceQueueConfig{
+		{ReallocateOnDequeue: true, MinOptimizationLength: 10, ReallocateWastePercent: 75},
+		{ReallocateOnDequeue: true, ReallocateByHalving: true, MinOptimizationLength: 10},
+	} {
+		q := NewSliceQueueWithConfig[int](config)
+		for i := range 1000 {
+			q.Enqueue(i)
+		}
+
+		shrinks, last := 0, 0
+		q.SetHooks(algorithms.SliceHooks{
+			OnShrink: func(oldCap, newCap int) {
+				test.GotWant(t, newCap < oldCap, true)
+				shrinks++
+				last = newCap
+			},
+		})
+		for range 900 {
+			q.Dequeue()
+		}
+		test.GotWant(t, shrinks > 0, true)
+		test.GotWant(t, last, cap(q.data))
+	}
+}
+
+// Purpose: Verify Add enqueues at the back of the queue
+//
+// Config: Default
+func TestSliceQueue_Add(t *testing.T) {
+	q := NewSliceQueue(1)
+	test.GotWant(t, q.Add(2), true)
+	test.GotWantSlice(t, slices.Collect(q.All()), []int{1, 2})
+}
+
+// Purpose: Verify All yields the elements in dequeue order, skipping the
+// slots already dequeued
+//
+// Config: NoOptimizations
+func TestSliceQueue_All(t *testing.T) {
+	q := NewSliceQueueWithConfig(SliceQueueConfig{}, 1, 2, 3, 4)
+	q.Dequeue()
+	test.GotWantSlice(t, slices.Collect(q.All()), []int{2, 3, 4})
+
+	got := []int{}
+	for v := range q.All() {
+		got = append(got, v)
+		break
+	}
+	test.GotWantSlice(t, got, []int{2})
+}
+
+// Purpose: Verify Clear releases the storage and reports the shrink
+//
+// Config: NoOptimizations
+func TestSliceQueue_Clear(t *testing.T) {
+	q := NewSliceQueueWithConfig(SliceQueueConfig{}, 1, 2, 3)
+	var shrunk [2]int
+	q.SetHooks(algorithms.SliceHooks{
+		OnShrink: func(oldCap, newCap int) { shrunk = [2]int{oldCap, newCap} },
+	})
+
+	q.Clear()
+	test.GotWant(t, q.IsEmpty(), true)
+	test.GotWant(t, cap(q.data), 0)
+	test.GotWant(t, shrunk, [2]int{3, 0})
+
+	q.Enqueue(4)
+	test.GotWantSlice(t, slices.Collect(q.All()), []int{4})
+}
+
+// Purpose: Verify no options yields the default configuration
+//
+// Config: Default
+func TestSliceQueue_NewSliceQueueWith_Defaults(t *testing.T) {
+	q := NewSliceQueueWith[int]()
+	test.GotWant(t, q.config, NewSliceQueue[int]().config)
+	test.GotWant(t, q.IsEmpty(), true)
+}
+
+// Purpose: Verify options are applied in order on top of the defaults
+//
+// Config: Default adjusted by options
+func TestSliceQueue_NewSliceQueueWith_Options(t *testing.T) {
+	q := NewSliceQueueWith[int](
+		WithCompactOnEnqueue(false),
+		WithReallocateOnDequeue(true),
+		WithReallocateByHalving(true),
+		WithMinOptimizationLength(500),
+		WithCompactWastePercent(60),
+		WithReallocateWastePercent(80),
+		WithMinOptimizationLength(1000),
+	)
+	test.GotWant(t, q.config, SliceQueueConfig{
+		CompactOnEnqueue:       false,
+		ReallocateOnDequeue:    true,
+		ReallocateByHalving:    true,
+		MinOptimizationLength:  1000,
+		CompactWastePercent:    60,
+		ReallocateWastePercent: 80,
+	})
+}
+
+// Purpose: Verify WithCapacity allocates the requested capacity and the
+// configured capacity never drops below the initial values
+//
+// Config: Default adjusted by options
+func TestSliceQueue_NewSliceQueueWith_Capacity(t *testing.T) {
+	q := NewSliceQueueWith[int](WithCapacity(64))
+	test.GotWant(t, cap(q.data), 64)
+
+	q = NewSliceQueueWithConfig(SliceQueueConfig{InitialCapacity: 2}, 1, 2, 3)
+	test.GotWant(t, cap(q.data), 3)
+	test.GotWantSlice(t, slices.Collect(q.All()), []int{1, 2, 3})
+}
+
+// Verifies a round trip drops the slack before the front and invalid data
+// is rejected
+func TestSliceQueue_MarshalBinary(t *testing.T) {
+	src := NewSliceQueue(1, 2, 3, 4)
+	src.Dequeue()
+	other, _ := codec.EncodeSeq("SliceStack", 1, slices.Values([]int{1}))
+	dst := NewSliceQueue(7)
+	codectest.RoundTrip(t, src, dst,
+		func(q *SliceQueue[int]) []int { return slices.Collect(q.All()) }, other)
+	test.GotWant(t, dst.curr, 0)
+	test.GotWant(t, cap(dst.data), 3)
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements in queue order
+func TestSliceQueue_WriteTo_ReadFrom(t *testing.T) {
+	src := NewSliceQueue(1, 2, 3)
+	dst := NewSliceQueue(9)
+	codectest.StreamRoundTrip(t, src, dst,
+		func(q *SliceQueue[int]) []int { return slices.Collect(q.All()) })
+}
+
+// Purpose: Verify CheckInvariants accepts a valid queue and reports a front
+// index outside the storage
+//
+// Config: NoOptimizations
+func TestSliceQueue_CheckInvariants(t *testing.T) {
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{}, 1, 2, 3)
+	test.GotWantNoError(t, q.CheckInvariants())
+
+	q.curr = 4
+	test.GotWantError(t, q.CheckInvariants(), "invariant violation: front index 4 outside [0, 3]")
+	q.curr = -1
+	test.GotWantError(t, q.CheckInvariants(), "invariant violation: front index -1 outside [0, 3]")
+}
+
+// Purpose: Verify reallocation frees at least 97% of the peak memory after
+// a permanent shrink, as documented on SliceQueue
+//
+// Config: ReallocateOnDequeue with a 75% waste threshold
+func TestSliceQueue_Reallocation_FreesMemory(t *testing.T) {
+	memory := bench.TrackMemory()
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{
+		ReallocateOnDequeue:    true,
+		MinOptimizationLength:  100,
+		ReallocateWastePercent: 75,
+	})
+	for i := range 1_000_000 {
+		q.Enqueue(i)
+	}
+	peak := memory.Retained()
+
+	for range 999_000 {
+		q.Dequeue()
+	}
+	retained := memory.Retained()
+	runtime.KeepAlive(q)
+
+	if freed := bench.FreedPercent(peak, retained); freed < 97 {
+		t.Errorf("freed %.2f%% of %d bytes, want at least 97%%", freed, peak)
+	}
+}
+
+// Purpose: Verify balanced operations on a compacting queue do not allocate
+//
+// Config: CompactOnEnqueue with a 50% waste threshold
+func TestSliceQueue_Compaction_NoAllocations(t *testing.T) {
+	q := NewSliceQueueWithConfig[int](SliceQueueConfig{
+		CompactOnEnqueue:      true,
+		MinOptimizationLength: 100,
+		CompactWastePercent:   50,
+	})
+	for i := range 10_000 {
+		q.Enqueue(i)
+	}
+
+	allocs := bench.AllocsPerOp(100, 1000, func() {
+		for i := range 500 {
+			q.Enqueue(i)
+			q.Dequeue()
+		}
+	})
+	test.GotWant(t, allocs, 0)
+}
+
+// Purpose: Verify random operation sequences keep the queue equivalent to
+// a slice model
+//
+// Config: NoOptimizations, and aggressive compaction, reallocation and
+// halving thresholds so the optimizations trigger often
+//
+// Verifies:
+//   - Dequeue and Peek return the model's front
+//   - Size matches the model after every step
+func TestSliceQueue_Properties(t *testing.T) {
+	for _, c := range sliceQueueModelConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			setup := func() (*SliceQueue[int], []int) {
+				return NewSliceQueueWithConfig[int](c.config), []int{}
+			}
+			proptest.Run(t, proptest.Config{Seed: 62, Runs: 30, Steps: 400}, setup, checkSliceQueueModel, sliceQueueOps()...)
+		})
+	}
+}
+
+// Returns the operations of the SliceQueue property and fuzz tests
+func sliceQueueOps() []proptest.Op[*SliceQueue[int], []int] {
+	type op = proptest.Op[*SliceQueue[int], []int]
+	return []op{
+		{Name: "Enqueue", Weight: 4, Apply: func(t *testing.T, r *rand.Rand, q *SliceQueue[int], m *[]int) {
+			v := r.IntN(1000)
+			q.Enqueue(v)
+			*m = append(*m, v)
+		}},
+		{Name: "Dequeue", Weight: 3, Apply: func(t *testing.T, r *rand.Rand, q *SliceQueue[int], m *[]int) {
+			v, err := q.Dequeue()
+			if len(*m) == 0 {
+				test.GotWantErrorIs(t, err, ErrEmptyQueue)
+				return
+			}
+			test.GotWant(t, v, (*m)[0])
+			*m = (*m)[1:]
+		}},
+		{Name: "Reserve", Apply: func(t *testing.T, r *rand.Rand, q *SliceQueue[int], m *[]int) {
+			q.Reserve(r.IntN(50))
+		}},
+	}
+}
+
+// Verifies the queue matches its slice model
+func checkSliceQueueModel(t *testing.T, q *SliceQueue[int], m []int) {
+	t.Helper()
+	test.GotWantNoError(t, q.CheckInvariants())
+	test.GotWant(t, q.Size(), len(m))
+	v, err := q.Peek()
+	if len(m) == 0 {
+		test.GotWantErrorIs(t, err, ErrEmptyQueue)
+	} else {
+		test.GotWant(t, v, m[0])
+	}
+}
+
+// Configurations the SliceQueue property and fuzz tests run under, with
+// aggressive thresholds so the optimizations trigger often
+var sliceQueueModelConfigs = []struct {
+	name   string
+	config SliceQueueConfig
+}{
+	{"no_optimizations", SliceQueueConfig{}},
+	{"compaction_and_reallocation", SliceQueueConfig{
+		CompactOnEnqueue:       true,
+		ReallocateOnDequeue:    true,
+		MinOptimizationLength:  4,
+		CompactWastePercent:    50,
+		ReallocateWastePercent: 50,
+	}},
+	{"halving", SliceQueueConfig{
+		ReallocateOnDequeue:   true,
+		ReallocateByHalving:   true,
+		MinOptimizationLength: 4,
+	}},
+}
@@ -0,0 +1,236 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewBitSet):
+  ✓ Empty set
+  ✓ With values (duplicates collapsed)
+
+Add/Remove/Contains:
+  ✓ Word boundaries
+  ✓ Grow on demand, reads beyond capacity
+  ✓ Remove present and absent elements
+
+NextSetBit:
+  ✓ Empty set
+  ✓ From inside, between and beyond stored elements
+
+And/Or/Xor/AndNot:
+  ✓ Sets of equal and different lengths
+  ✓ Operand is unchanged
+
+Clone/All/Cardinality/IsEmpty:
+  ✓ Clone is independent
+  ✓ Ascending order, early termination
+  ✓ Cleared words count as empty
+
+Randomized:
+  ✓ Operations match a map-based model
+
+Binary encoding:
+  ✓ Round trip replaces the elements, trailing zero words dropped
+  ✓ Invalid data leaves the set unchanged
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty set
+func TestBitSet_NewBitSet_Empty(t *testing.T) {
+	s := NewBitSet()
+	test.GotWant(t, s.Cardinality(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, s.Contains(0), false)
+}
+
+// Verifies the creation of a set with values, storing duplicates once
+func TestBitSet_NewBitSet_WithValues(t *testing.T) {
+	s := NewBitSet(64, 1, 5, 1)
+	test.GotWant(t, s.Cardinality(), 3)
+	test.GotWantSlice(t, slices.Collect(s.All()), []uint{1, 5, 64})
+}
+
+// Verifies elements on both sides of word boundaries
+func TestBitSet_Add_WordBoundaries(t *testing.T) {
+	s := NewBitSet()
+	for _, v := range []uint{0, 63, 64, 127, 128} {
+		test.GotWant(t, s.Add(v), true)
+		test.GotWant(t, s.Add(v), false)
+	}
+	for _, v := range []uint{1, 62, 65, 126, 129} {
+		test.GotWant(t, s.Contains(v), false)
+	}
+	test.GotWantSlice(t, slices.Collect(s.All()), []uint{0, 63, 64, 127, 128})
+}
+
+// Verifies the set grows when needed and reads beyond it report absence
+func TestBitSet_Add_Grow(t *testing.T) {
+	s := NewBitSet(3)
+	test.GotWant(t, s.Contains(10_000), false)
+	test.GotWant(t, s.Remove(10_000), false)
+	test.GotWant(t, len(s.words), 1)
+
+	s.Add(10_000)
+	test.GotWant(t, s.Contains(10_000), true)
+	test.GotWant(t, len(s.words), 10_000/64+1)
+}
+
+// Verifies removing present and absent elements
+func TestBitSet_Remove(t *testing.T) {
+	s := NewBitSet(1, 2, 3)
+	test.GotWant(t, s.Remove(4), false)
+	test.GotWant(t, s.Remove(2), true)
+	test.GotWant(t, s.Remove(2), false)
+	test.GotWant(t, s.Contains(2), false)
+	test.GotWantSlice(t, slices.Collect(s.All()), []uint{1, 3})
+}
+
+// Verifies NextSetBit on an empty set
+func TestBitSet_NextSetBit_EmptySet(t *testing.T) {
+	_, ok := NewBitSet().NextSetBit(0)
+	test.GotWant(t, ok, false)
+}
+
+// Verifies NextSetBit from various starting points
+func TestBitSet_NextSetBit(t *testing.T) {
+	s := NewBitSet(3, 64, 200)
+	s.Remove(200) // Leaves trailing zero words
+
+	cases := []struct {
+		from  uint
+		want  uint
+		found bool
+	}{
+		{0, 3, true},
+		{3, 3, true},
+		{4, 64, true},
+		{64, 64, true},
+		{65, 0, false},
+		{1000, 0, false},
+	}
+
+	for _, tc := range cases {
+		got, ok := s.NextSetBit(tc.from)
+		test.GotWant(t, ok, tc.found)
+		test.GotWant(t, got, tc.want)
+	}
+}
+
+// Verifies the set algebra on sets of different lengths, leaving the
+// operand unchanged
+func TestBitSet_Algebra(t *testing.T) {
+	short := []uint{1, 2, 3}
+	long := []uint{2, 3, 4, 100}
+
+	cases := []struct {
+		name string
+		op   func(s, other *BitSet)
+		a, b []uint
+		want []uint
+	}{
+		{"And short long", (*BitSet).And, short, long, []uint{2, 3}},
+		{"And long short", (*BitSet).And, long, short, []uint{2, 3}},
+		{"Or short long", (*BitSet).Or, short, long, []uint{1, 2, 3, 4, 100}},
+		{"Or long short", (*BitSet).Or, long, short, []uint{1, 2, 3, 4, 100}},
+		{"Xor short long", (*BitSet).Xor, short, long, []uint{1, 4, 100}},
+		{"Xor long short", (*BitSet).Xor, long, short, []uint{1, 4, 100}},
+		{"AndNot short long", (*BitSet).AndNot, short, long, []uint{1}},
+		{"AndNot long short", (*BitSet).AndNot, long, short, []uint{4, 100}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, other := NewBitSet(tc.a...), NewBitSet(tc.b...)
+			tc.op(s, other)
+			test.GotWantSlice(t, slices.Collect(s.All()), tc.want)
+			test.GotWantSlice(t, slices.Collect(other.All()), tc.b)
+		})
+	}
+}
+
+// Verifies a clone is unaffected by changes to the original and vice versa
+func TestBitSet_Clone(t *testing.T) {
+	a := NewBitSet(1, 2)
+	b := a.Clone()
+	a.Add(3)
+	b.Remove(1)
+	test.GotWantSlice(t, slices.Collect(a.All()), []uint{1, 2, 3})
+	test.GotWantSlice(t, slices.Collect(b.All()), []uint{2})
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestBitSet_All_EarlyTermination(t *testing.T) {
+	s := NewBitSet(1, 2, 3, 70, 80)
+	got := []uint{}
+	for v := range s.All() {
+		got = append(got, v)
+		if len(got) == 4 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []uint{1, 2, 3, 70})
+}
+
+// Verifies a set whose words were all cleared reports empty
+func TestBitSet_IsEmpty_ClearedWords(t *testing.T) {
+	s := NewBitSet(5, 500)
+	s.AndNot(NewBitSet(5, 500))
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, s.Cardinality(), 0)
+}
+
+// Verifies random operations match a map-based model
+func TestBitSet_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	s := NewBitSet()
+	model := map[uint]bool{}
+
+	for range 5000 {
+		v := uint(r.IntN(1000))
+		switch r.IntN(3) {
+		case 0:
+			test.GotWant(t, s.Add(v), !model[v])
+			model[v] = true
+		case 1:
+			test.GotWant(t, s.Remove(v), model[v])
+			delete(model, v)
+		case 2:
+			test.GotWant(t, s.Contains(v), model[v])
+		}
+	}
+
+	test.GotWant(t, s.Cardinality(), len(model))
+	for v := range s.All() {
+		test.GotWant(t, model[v], true)
+	}
+}
+
+// Verifies a round trip restores the elements, only the words up to the
+// highest element are encoded and invalid data is rejected
+func TestBitSet_MarshalBinary(t *testing.T) {
+	src := NewBitSet(1, 64, 200, 1000)
+	src.Remove(1000)
+	other, _ := NewSparseSet(10, 1).MarshalBinary()
+	codectest.RoundTrip(t, src, NewBitSet(5),
+		func(s *BitSet) []uint { return slices.Collect(s.All()) }, other)
+
+	data, _ := src.MarshalBinary()
+	words, _ := codec.DecodeSlice[uint64](data, "BitSet")
+	test.GotWant(t, len(words), 4)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements
+func TestBitSet_WriteTo_ReadFrom(t *testing.T) {
+	src := NewBitSet(3, 130)
+	dst := NewBitSet()
+	codectest.StreamRoundTrip(t, src, dst, func(s *BitSet) []uint { return slices.Collect(s.All()) })
+}
@@ -0,0 +1,203 @@
+package structures
+
+import (
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+)
+
+// Compile-time interface verifications
+var _ Set[int] = &HashSet[int]{}
+
+// HashSet implements an unordered set of distinct comparable elements.
+//
+// Elements are stored as keys of a built-in map with empty struct values,
+// so membership tests and updates take expected O(1) time. Iteration
+// order is unspecified and may differ between iterations; use OrderedSet
+// when a sorted order is needed.
+//
+// Design decisions:
+//   - Built-in map backing: Hashing and growth are delegated to the runtime
+//   - Empty struct values: Elements cost no extra space beyond the map key
+//   - Bulk operations report counts: AddAll and RemoveAll return how many
+//     elements actually changed membership
+//
+// Space complexity: O(n) where n is the number of elements.
+type HashSet[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewHashSet creates a hash set with the given elements.
+// Duplicate values are stored once.
+//
+// Example:
+//
+//	s := NewHashSet("a", "b", "a")
+//	s.Size()          // Returns 2
+//	s.Contains("b")  // Returns true
+func NewHashSet[T comparable](values ...T) *HashSet[T] {
+	s := &HashSet[T]{items: make(map[T]struct{}, len(values))}
+	s.AddAll(values...)
+	return s
+}
+
+// Add inserts the element into the set.
+// Returns true if the element was added, false if it was already present.
+//
+// Time complexity: O(1) expected
+func (s *HashSet[T]) Add(value T) bool {
+	if _, ok := s.items[value]; ok {
+		return false
+	}
+
+	s.items[value] = struct{}{}
+	return true
+}
+
+// AddAll inserts every given element into the set.
+// Returns the number of elements that were not already present.
+//
+// Time complexity: O(k) expected where k is the number of given elements
+func (s *HashSet[T]) AddAll(values ...T) int {
+	added := 0
+	for _, v := range values {
+		if s.Add(v) {
+			added++
+		}
+	}
+
+	return added
+}
+
+// Remove deletes the element from the set.
+// Returns true if the element was found and removed, false otherwise.
+//
+// Time complexity: O(1) expected
+func (s *HashSet[T]) Remove(value T) bool {
+	if _, ok := s.items[value]; !ok {
+		return false
+	}
+
+	delete(s.items, value)
+	return true
+}
+
+// RemoveAll deletes every given element from the set.
+// Returns the number of elements that were found and removed.
+//
+// Time complexity: O(k) expected where k is the number of given elements
+func (s *HashSet[T]) RemoveAll(values ...T) int {
+	removed := 0
+	for _, v := range values {
+		if s.Remove(v) {
+			removed++
+		}
+	}
+
+	return removed
+}
+
+// Clear removes all elements. The set keeps its allocated buckets, so
+// refilling it to a similar size does not rehash.
+//
+// Time complexity: O(n)
+func (s *HashSet[T]) Clear() {
+	clear(s.items)
+}
+
+// Contains returns true if the element is in the set.
+//
+// Time complexity: O(1) expected
+func (s *HashSet[T]) Contains(value T) bool {
+	_, ok := s.items[value]
+	return ok
+}
+
+// All returns an iterator over the elements in unspecified order.
+// Elements may be removed during iteration; elements added during
+// iteration may or may not be yielded.
+//
+// Time complexity: O(n) for a full iteration
+func (s *HashSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s.items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements in iteration order in the format of package codec.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (s *HashSet[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("HashSet", len(s.items), s.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the set with those encoded by MarshalBinary.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded set, in which case the set is left unchanged.
+//
+// Time complexity: O(n)
+func (s *HashSet[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "HashSet")
+	if err != nil {
+		return err
+	}
+
+	s.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (s *HashSet[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "HashSet", len(s.items), s.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// set with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The set is
+// left unchanged on error.
+//
+// Time complexity: O(n)
+func (s *HashSet[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "HashSet")
+	if err != nil {
+		return n, err
+	}
+
+	s.restore(values)
+	return n, nil
+}
+
+// IsEmpty returns true if the set contains no elements.
+//
+// Time complexity: O(1)
+func (s *HashSet[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Size returns the number of elements in the set.
+//
+// Time complexity: O(1)
+func (s *HashSet[T]) Size() int {
+	return len(s.items)
+}
+
+// Replaces the elements with the decoded values.
+func (s *HashSet[T]) restore(values []T) {
+	s.items = make(map[T]struct{}, len(values))
+	for _, v := range values {
+		s.items[v] = struct{}{}
+	}
+}
@@ -0,0 +1,149 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewHashSet):
+  ✓ Empty set
+  ✓ With values (duplicates collapsed)
+
+Add/Remove/Contains:
+  ✓ Add new and existing elements
+  ✓ Remove present and absent elements
+
+AddAll/RemoveAll:
+  ✓ Counts only elements that changed membership
+  ✓ No arguments
+
+All:
+  ✓ Yields every element once
+  ✓ Early termination
+  ✓ Removal during iteration
+
+Binary encoding:
+  ✓ Round trip replaces the elements, invalid data rejected
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+  ✓ Invalid stream leaves the set unchanged
+*/
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the set's elements in ascending order for comparison.
+func sortedHashSet(s *HashSet[int]) []int {
+	values := slices.Collect(s.All())
+	slices.Sort(values)
+	return values
+}
+
+// Verifies the creation of an empty set
+func TestHashSet_NewHashSet_Empty(t *testing.T) {
+	s := NewHashSet[int]()
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies the creation of a set with values, storing duplicates once
+func TestHashSet_NewHashSet_WithValues(t *testing.T) {
+	s := NewHashSet(3, 1, 2, 1, 3)
+	test.GotWant(t, s.Size(), 3)
+	test.GotWantSlice(t, sortedHashSet(s), []int{1, 2, 3})
+}
+
+// Verifies adding new and existing elements
+func TestHashSet_Add(t *testing.T) {
+	s := NewHashSet[string]()
+	test.GotWant(t, s.Add("b"), true)
+	test.GotWant(t, s.Add("a"), true)
+	test.GotWant(t, s.Add("b"), false)
+	test.GotWant(t, s.Size(), 2)
+	test.GotWant(t, s.Contains("a"), true)
+	test.GotWant(t, s.Contains("c"), false)
+}
+
+// Verifies removing present and absent elements
+func TestHashSet_Remove(t *testing.T) {
+	s := NewHashSet(1, 2, 3)
+	test.GotWant(t, s.Remove(4), false)
+	test.GotWant(t, s.Remove(2), true)
+	test.GotWant(t, s.Remove(2), false)
+	test.GotWant(t, s.Contains(2), false)
+	test.GotWantSlice(t, sortedHashSet(s), []int{1, 3})
+}
+
+// Verifies AddAll counts only newly added elements
+func TestHashSet_AddAll(t *testing.T) {
+	s := NewHashSet(1, 2)
+	test.GotWant(t, s.AddAll(2, 3, 4, 3), 2)
+	test.GotWant(t, s.AddAll(), 0)
+	test.GotWantSlice(t, sortedHashSet(s), []int{1, 2, 3, 4})
+}
+
+// Verifies RemoveAll counts only elements that were present
+func TestHashSet_RemoveAll(t *testing.T) {
+	s := NewHashSet(1, 2, 3, 4)
+	test.GotWant(t, s.RemoveAll(2, 5, 4, 2), 2)
+	test.GotWant(t, s.RemoveAll(), 0)
+	test.GotWantSlice(t, sortedHashSet(s), []int{1, 3})
+}
+
+// Verifies iteration yields every element exactly once
+func TestHashSet_All(t *testing.T) {
+	s := NewHashSet[int]()
+	for i := range 100 {
+		s.Add(i)
+	}
+
+	values := sortedHashSet(s)
+	test.GotWant(t, len(values), 100)
+	for i, v := range values {
+		test.GotWant(t, v, i)
+	}
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestHashSet_All_EarlyTermination(t *testing.T) {
+	s := NewHashSet(1, 2, 3, 4, 5)
+	count := 0
+	for range s.All() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	test.GotWant(t, count, 2)
+}
+
+// Verifies elements can be removed while iterating
+func TestHashSet_All_RemoveDuringIteration(t *testing.T) {
+	s := NewHashSet(1, 2, 3, 4, 5, 6)
+	for v := range s.All() {
+		if v%2 == 0 {
+			s.Remove(v)
+		}
+	}
+	test.GotWantSlice(t, sortedHashSet(s), []int{1, 3, 5})
+}
+
+// Verifies a round trip replaces the elements of the receiving set and
+// invalid data is rejected
+func TestHashSet_MarshalBinary(t *testing.T) {
+	other, _ := NewOrderedSet("a").MarshalBinary()
+	codectest.RoundTrip(t, NewHashSet("a", "b"), NewHashSet("z"),
+		func(s *HashSet[string]) []string { return slices.Sorted(s.All()) }, other)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements
+func TestHashSet_WriteTo_ReadFrom(t *testing.T) {
+	src := NewHashSet(3) // One element, so the encoding is unique
+	dst := NewHashSet(9)
+	codectest.StreamRoundTrip(t, src, dst,
+		func(s *HashSet[int]) []int { return slices.Sorted(s.All()) })
+}
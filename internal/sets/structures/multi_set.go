@@ -0,0 +1,346 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"math"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Compile-time interface verifications
+var _ collections.Collection[int] = &MultiSet[int]{}
+var _ collections.Iterable2[int, int] = &MultiSet[int]{}
+
+// MultiSet implements a bag: an unordered collection of comparable
+// elements in which each element may occur more than once.
+//
+// Each distinct element is stored once as a map key together with its
+// number of occurrences, so the structure grows with the number of
+// distinct elements rather than with the total count. Union, Intersection
+// and Sum follow the usual multiset semantics: the maximum, minimum and
+// sum of the two counts respectively.
+//
+// Design decisions:
+//   - Count map backing: Elements with a zero count are never stored
+//   - Size counts occurrences: DistinctSize counts distinct elements
+//   - Non-destructive algebra: Union, Intersection and Sum return new
+//     multisets and leave both operands unchanged
+//
+// Space complexity: O(d) where d is the number of distinct elements.
+type MultiSet[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+// NewMultiSet creates a multiset containing one occurrence of each given
+// value, so repeated values are counted.
+//
+// Example:
+//
+//	m := NewMultiSet("a", "b", "a")
+//	m.Count("a")      // Returns 2
+//	m.Size()          // Returns 3
+//	m.DistinctSize()  // Returns 2
+func NewMultiSet[T comparable](values ...T) *MultiSet[T] {
+	m := &MultiSet[T]{counts: make(map[T]int)}
+	for _, v := range values {
+		m.Add(v)
+	}
+
+	return m
+}
+
+// Add inserts one occurrence of the element.
+// Returns the element's count after the insertion.
+//
+// Time complexity: O(1) expected
+func (m *MultiSet[T]) Add(value T) int {
+	return m.AddN(value, 1)
+}
+
+// AddN inserts n occurrences of the element. Panics if n is negative.
+// Returns the element's count after the insertion.
+//
+// Time complexity: O(1) expected
+func (m *MultiSet[T]) AddN(value T, n int) int {
+	panics.RequireNonNegative(n, "n")
+	if n == 0 {
+		return m.counts[value]
+	}
+
+	m.counts[value] += n
+	m.size += n
+	return m.counts[value]
+}
+
+// Remove deletes one occurrence of the element.
+// Returns true if an occurrence was found and removed, false otherwise.
+//
+// Time complexity: O(1) expected
+func (m *MultiSet[T]) Remove(value T) bool {
+	return m.RemoveN(value, 1) == 1
+}
+
+// RemoveN deletes up to n occurrences of the element. Panics if n is
+// negative. Returns the number of occurrences actually removed.
+//
+// Time complexity: O(1) expected
+func (m *MultiSet[T]) RemoveN(value T, n int) int {
+	panics.RequireNonNegative(n, "n")
+	count := m.counts[value]
+	removed := min(count, n)
+	if removed == count {
+		delete(m.counts, value)
+	} else {
+		m.counts[value] = count - removed
+	}
+
+	m.size -= removed
+	return removed
+}
+
+// Count returns the number of occurrences of the element, 0 if absent.
+//
+// Time complexity: O(1) expected
+func (m *MultiSet[T]) Count(value T) int {
+	return m.counts[value]
+}
+
+// Clear removes all occurrences of all elements.
+//
+// Time complexity: O(d) where d is the number of distinct elements
+func (m *MultiSet[T]) Clear() {
+	clear(m.counts)
+	m.size = 0
+}
+
+// Contains returns true if the element occurs at least once.
+//
+// Time complexity: O(1) expected
+func (m *MultiSet[T]) Contains(value T) bool {
+	return m.counts[value] > 0
+}
+
+// Distinct returns an iterator over the distinct elements in unspecified
+// order. The multiset must not be modified during iteration.
+//
+// Time complexity: O(d) for a full iteration
+func (m *MultiSet[T]) Distinct() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range m.counts {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the distinct elements and their counts in
+// unspecified order. The multiset must not be modified during iteration.
+//
+// Time complexity: O(d) for a full iteration
+func (m *MultiSet[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for v, c := range m.counts {
+			if !yield(v, c) {
+				return
+			}
+		}
+	}
+}
+
+// Union returns a new multiset in which each element's count is the
+// larger of its counts in the two multisets.
+//
+// Time complexity: O(d + e) where e is the distinct size of other
+//
+// Example:
+//
+//	a := NewMultiSet(1, 1, 2)
+//	b := NewMultiSet(1, 3)
+//	a.Union(b)  // {1: 2, 2: 1, 3: 1}
+func (m *MultiSet[T]) Union(other *MultiSet[T]) *MultiSet[T] {
+	result := m.Clone()
+	for v, c := range other.counts {
+		if extra := c - result.counts[v]; extra > 0 {
+			result.AddN(v, extra)
+		}
+	}
+
+	return result
+}
+
+// Intersection returns a new multiset in which each element's count is
+// the smaller of its counts in the two multisets.
+//
+// Time complexity: O(min(d, e)) where e is the distinct size of other
+//
+// Example:
+//
+//	a := NewMultiSet(1, 1, 2)
+//	b := NewMultiSet(1, 1, 1, 3)
+//	a.Intersection(b)  // {1: 2}
+func (m *MultiSet[T]) Intersection(other *MultiSet[T]) *MultiSet[T] {
+	small, large := m, other
+	if len(large.counts) < len(small.counts) {
+		small, large = large, small
+	}
+
+	result := NewMultiSet[T]()
+	for v, c := range small.counts {
+		result.AddN(v, min(c, large.counts[v]))
+	}
+
+	return result
+}
+
+// Sum returns a new multiset in which each element's count is the sum of
+// its counts in the two multisets.
+//
+// Time complexity: O(d + e) where e is the distinct size of other
+//
+// Example:
+//
+//	a := NewMultiSet(1, 1, 2)
+//	b := NewMultiSet(1, 3)
+//	a.Sum(b)  // {1: 3, 2: 1, 3: 1}
+func (m *MultiSet[T]) Sum(other *MultiSet[T]) *MultiSet[T] {
+	result := m.Clone()
+	for v, c := range other.counts {
+		result.AddN(v, c)
+	}
+
+	return result
+}
+
+// Clone returns an independent copy of the multiset.
+//
+// Time complexity: O(d)
+func (m *MultiSet[T]) Clone() *MultiSet[T] {
+	counts := make(map[T]int, len(m.counts))
+	for v, c := range m.counts {
+		counts[v] = c
+	}
+
+	return &MultiSet[T]{counts: counts, size: m.size}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes each
+// distinct element with its count in the format of package codec.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(d) where d is the number of distinct elements
+func (m *MultiSet[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq2("MultiSet", len(m.counts), m.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the multiset with those encoded by MarshalBinary.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded multiset or holds a count that is not positive, in which case
+// the multiset is left unchanged.
+//
+// Time complexity: O(d) where d is the number of distinct elements
+func (m *MultiSet[T]) UnmarshalBinary(data []byte) error {
+	values, counts, err := codec.DecodeSlice2[T, int](data, "MultiSet")
+	if err != nil {
+		return err
+	}
+
+	return m.restore(values, counts)
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(d) where d is the number of distinct elements
+func (m *MultiSet[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq2(w, "MultiSet", len(m.counts), m.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// multiset with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The multiset is
+// left unchanged on error.
+//
+// Time complexity: O(d) where d is the number of distinct elements
+func (m *MultiSet[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, counts, n, err := codec.ReadSlice2[T, int](r, "MultiSet")
+	if err != nil {
+		return n, err
+	}
+
+	return n, m.restore(values, counts)
+}
+
+// IsEmpty returns true if the multiset contains no elements.
+//
+// Time complexity: O(1)
+func (m *MultiSet[T]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Size returns the total number of occurrences of all elements.
+//
+// Time complexity: O(1)
+func (m *MultiSet[T]) Size() int {
+	return m.size
+}
+
+// DistinctSize returns the number of distinct elements.
+//
+// Time complexity: O(1)
+func (m *MultiSet[T]) DistinctSize() int {
+	return len(m.counts)
+}
+
+// CheckInvariants verifies that every stored element has a positive count
+// and that Size is the sum of the counts. Intended for tests and
+// debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the multiset is consistent.
+//
+// Time complexity: O(d) expected where d is the number of distinct elements
+func (m *MultiSet[T]) CheckInvariants() error {
+	total := 0
+	for v, n := range m.counts {
+		if n <= 0 {
+			return fmt.Errorf("%w: element %v is stored with count %d", ErrInvariantViolation, v, n)
+		}
+		total += n
+	}
+	if total != m.size {
+		return fmt.Errorf("%w: size is %d, counts add up to %d", ErrInvariantViolation, m.size, total)
+	}
+
+	return nil
+}
+
+// Replaces the elements with the decoded ones; a repeated element adds up
+// its counts. Leaves the multiset unchanged if a count is not positive or
+// the total overflows.
+func (m *MultiSet[T]) restore(values []T, counts []int) error {
+	size := 0
+	for i, c := range counts {
+		if c <= 0 {
+			return fmt.Errorf("%w: count %d of %v is not positive", codec.ErrInvalidData, c, values[i])
+		}
+		if c > math.MaxInt-size {
+			return fmt.Errorf("%w: total count exceeds the maximum of %d", codec.ErrInvalidData, math.MaxInt)
+		}
+		size += c
+	}
+
+	m.counts = make(map[T]int, len(values))
+	for i, v := range values {
+		m.counts[v] += counts[i]
+	}
+	m.size = size
+	return nil
+}
@@ -0,0 +1,212 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewMultiSet):
+  ✓ Empty multiset
+  ✓ With values (duplicates counted)
+
+Add/AddN/Remove/RemoveN/Count/Contains:
+  ✓ Counts grow and shrink
+  ✓ Removing more occurrences than present
+  ✓ Removing absent elements
+  ✓ Zero and negative n
+
+Distinct/All:
+  ✓ Yields each distinct element once with its count
+
+Union/Intersection/Sum:
+  ✓ Multiplicities follow max, min and sum
+  ✓ Operands are unchanged
+  ✓ Empty operands
+
+Clone:
+  ✓ Copy is independent
+
+Clear:
+  ✓ Removes all occurrences
+
+CheckInvariants:
+  ✓ Valid multisets, stored zero count, size out of step with the counts
+
+Binary encoding:
+  ✓ Round trip replaces the elements and counts, invalid data and
+    non-positive or overflowing counts rejected
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+*/
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the multiset's counts and sizes against an expected count map.
+func checkMultiSet(t *testing.T, m *MultiSet[int], want map[int]int) {
+	t.Helper()
+	got := maps.Collect(m.All())
+	test.GotWant(t, maps.Equal(got, want), true)
+
+	size := 0
+	for _, c := range want {
+		size += c
+	}
+	test.GotWant(t, m.Size(), size)
+	test.GotWant(t, m.DistinctSize(), len(want))
+	test.GotWant(t, m.IsEmpty(), size == 0)
+}
+
+// Verifies the creation of an empty multiset
+func TestMultiSet_NewMultiSet_Empty(t *testing.T) {
+	m := NewMultiSet[int]()
+	checkMultiSet(t, m, map[int]int{})
+}
+
+// Verifies the creation of a multiset with values, counting duplicates
+func TestMultiSet_NewMultiSet_WithValues(t *testing.T) {
+	m := NewMultiSet(3, 1, 3, 3, 2)
+	checkMultiSet(t, m, map[int]int{1: 1, 2: 1, 3: 3})
+}
+
+// Verifies counts grow with Add and AddN and shrink with Remove and RemoveN
+func TestMultiSet_AddRemove(t *testing.T) {
+	m := NewMultiSet[int]()
+	test.GotWant(t, m.Add(7), 1)
+	test.GotWant(t, m.Add(7), 2)
+	test.GotWant(t, m.AddN(7, 3), 5)
+	test.GotWant(t, m.AddN(8, 0), 0)
+	test.GotWant(t, m.Contains(8), false)
+	checkMultiSet(t, m, map[int]int{7: 5})
+
+	test.GotWant(t, m.Remove(7), true)
+	test.GotWant(t, m.RemoveN(7, 2), 2)
+	test.GotWant(t, m.Count(7), 2)
+	test.GotWant(t, m.Contains(7), true)
+	checkMultiSet(t, m, map[int]int{7: 2})
+}
+
+// Verifies RemoveN removes at most the occurrences present
+func TestMultiSet_RemoveN_MoreThanPresent(t *testing.T) {
+	m := NewMultiSet(1, 1, 2)
+	test.GotWant(t, m.RemoveN(1, 5), 2)
+	test.GotWant(t, m.Count(1), 0)
+	test.GotWant(t, m.Contains(1), false)
+	checkMultiSet(t, m, map[int]int{2: 1})
+}
+
+// Verifies removing an absent element changes nothing
+func TestMultiSet_Remove_Absent(t *testing.T) {
+	m := NewMultiSet(1)
+	test.GotWant(t, m.Remove(2), false)
+	test.GotWant(t, m.RemoveN(2, 3), 0)
+	test.GotWant(t, m.RemoveN(1, 0), 0)
+	checkMultiSet(t, m, map[int]int{1: 1})
+}
+
+// Verifies negative counts panic
+func TestMultiSet_AddNRemoveN_NegativeN(t *testing.T) {
+	m := NewMultiSet[int]()
+	test.GotWantPanic(t, func() { m.AddN(1, -1) }, `"n" must be >= 0, got -1`)
+	test.GotWantPanic(t, func() { m.RemoveN(1, -1) }, `"n" must be >= 0, got -1`)
+}
+
+// Verifies Distinct yields each distinct element once
+func TestMultiSet_Distinct(t *testing.T) {
+	m := NewMultiSet(3, 1, 3, 2, 1)
+	values := slices.Collect(m.Distinct())
+	slices.Sort(values)
+	test.GotWantSlice(t, values, []int{1, 2, 3})
+}
+
+// Verifies Union, Intersection and Sum multiplicities, leaving operands
+// unchanged
+func TestMultiSet_Algebra(t *testing.T) {
+	a := NewMultiSet(1, 1, 2, 4)
+	b := NewMultiSet(1, 1, 1, 3, 4)
+
+	checkMultiSet(t, a.Union(b), map[int]int{1: 3, 2: 1, 3: 1, 4: 1})
+	checkMultiSet(t, a.Intersection(b), map[int]int{1: 2, 4: 1})
+	checkMultiSet(t, b.Intersection(a), map[int]int{1: 2, 4: 1})
+	checkMultiSet(t, a.Sum(b), map[int]int{1: 5, 2: 1, 3: 1, 4: 2})
+
+	checkMultiSet(t, a, map[int]int{1: 2, 2: 1, 4: 1})
+	checkMultiSet(t, b, map[int]int{1: 3, 3: 1, 4: 1})
+}
+
+// Verifies the algebra with an empty operand
+func TestMultiSet_Algebra_Empty(t *testing.T) {
+	a := NewMultiSet(1, 1, 2)
+	empty := NewMultiSet[int]()
+
+	checkMultiSet(t, a.Union(empty), map[int]int{1: 2, 2: 1})
+	checkMultiSet(t, empty.Union(a), map[int]int{1: 2, 2: 1})
+	checkMultiSet(t, a.Intersection(empty), map[int]int{})
+	checkMultiSet(t, a.Sum(empty), map[int]int{1: 2, 2: 1})
+}
+
+// Verifies a clone is unaffected by changes to the original and vice versa
+func TestMultiSet_Clone(t *testing.T) {
+	a := NewMultiSet(1, 1, 2)
+	b := a.Clone()
+	a.Add(3)
+	b.Remove(1)
+	checkMultiSet(t, a, map[int]int{1: 2, 2: 1, 3: 1})
+	checkMultiSet(t, b, map[int]int{1: 1, 2: 1})
+}
+
+// Verifies Clear removes every occurrence of every element
+func TestMultiSet_Clear(t *testing.T) {
+	m := NewMultiSet(1, 1, 2, 3, 3, 3)
+	m.Clear()
+	test.GotWant(t, m.Size(), 0)
+	test.GotWant(t, m.IsEmpty(), true)
+	test.GotWant(t, m.Count(3), 0)
+
+	m.Add(3)
+	test.GotWant(t, m.Count(3), 1)
+}
+
+// Verifies CheckInvariants accepts valid multisets and reports a stored
+// zero count and a size that does not match the counts
+func TestMultiSet_CheckInvariants(t *testing.T) {
+	m := NewMultiSet[string]()
+	test.GotWantNoError(t, m.CheckInvariants())
+	m = NewMultiSet("a", "b", "a", "c")
+	m.RemoveN("c", 5)
+	test.GotWantNoError(t, m.CheckInvariants())
+
+	m.size = 4
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: size is 4, counts add up to 3")
+	m.size = 3
+
+	m.counts["c"] = 0
+	test.GotWantError(t, m.CheckInvariants(), "invariant violation: element c is stored with count 0")
+	delete(m.counts, "c")
+	test.GotWantNoError(t, m.CheckInvariants())
+}
+
+// Verifies a round trip restores the counts and invalid data, including
+// non-positive counts and counts overflowing the size, is rejected
+func TestMultiSet_MarshalBinary(t *testing.T) {
+	other, _ := NewHashSet(1).MarshalBinary()
+	zero, _ := codec.EncodeSeq2("MultiSet", 2, maps.All(map[int]int{1: 2, 2: 0}))
+	overflow, _ := codec.EncodeSeq2("MultiSet", 2, maps.All(map[int]int{1: math.MaxInt, 2: 1}))
+	dst := NewMultiSet(9)
+	codectest.RoundTrip(t, NewMultiSet(1, 1, 2, 3, 3, 3), dst,
+		func(m *MultiSet[int]) map[int]int { return maps.Collect(m.All()) }, other, zero, overflow)
+	checkMultiSet(t, dst, map[int]int{1: 2, 2: 1, 3: 3})
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the counts
+func TestMultiSet_WriteTo_ReadFrom(t *testing.T) {
+	src := NewMultiSet(4, 4) // One distinct element, so the encoding is unique
+	dst := NewMultiSet[int]()
+	codectest.StreamRoundTrip(t, src, dst,
+		func(m *MultiSet[int]) map[int]int { return maps.Collect(m.All()) })
+}
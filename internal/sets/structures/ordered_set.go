@@ -0,0 +1,356 @@
+package structures
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	trees "github.com/apotourlyan/godatastructures/internal/trees/structures"
+)
+
+const ErrorEmptySet = "set is empty"
+const ErrorInvariantViolation = "invariant violation"
+
+// ErrEmptySet is returned by First and Last on an empty ordered set.
+// ErrInvariantViolation is wrapped by CheckInvariants.
+var (
+	ErrEmptySet           = errors.New(ErrorEmptySet)
+	ErrInvariantViolation = errors.New(ErrorInvariantViolation)
+)
+
+// Compile-time interface verifications
+var _ Set[int] = &OrderedSet[int]{}
+
+// OrderedSet implements a set of distinct elements kept in ascending order.
+//
+// Elements are stored as keys of an AVL tree, so membership tests and
+// updates have a worst-case O(log n) bound and iteration is always sorted.
+// Neighbor queries (Floor, Ceiling, Lower, Higher) and range iteration
+// mirror the navigation operations of java.util.TreeSet.
+//
+// Design decisions:
+//   - AVL tree backing: Balanced worst case, reads never restructure
+//   - Empty struct values: Elements cost no extra space beyond the tree node
+//   - Half-open ranges: Range(from, to) yields from <= element < to
+//
+// Space complexity: O(n) where n is the number of elements.
+type OrderedSet[T cmp.Ordered] struct {
+	tree *trees.AVLTree[T, struct{}]
+}
+
+// TreeSet is the java.util.TreeSet name for OrderedSet.
+// Create one with NewOrderedSet.
+type TreeSet[T cmp.Ordered] = OrderedSet[T]
+
+// NewOrderedSet creates an ordered set with the given elements.
+// Duplicate values are stored once.
+//
+// Example:
+//
+//	s := NewOrderedSet(3, 1, 2, 1)
+//	for v := range s.All() {
+//	    fmt.Println(v)  // 1, 2, 3
+//	}
+func NewOrderedSet[T cmp.Ordered](values ...T) *OrderedSet[T] {
+	s := &OrderedSet[T]{tree: trees.NewAVLTree[T, struct{}]()}
+	for _, v := range values {
+		s.Add(v)
+	}
+
+	return s
+}
+
+// Add inserts the element into the set.
+// Returns true if the element was added, false if it was already present.
+//
+// Time complexity: O(log n)
+func (s *OrderedSet[T]) Add(value T) bool {
+	return s.tree.Insert(value, struct{}{})
+}
+
+// Remove deletes the element from the set.
+// Returns true if the element was found and removed, false otherwise.
+//
+// Time complexity: O(log n)
+func (s *OrderedSet[T]) Remove(value T) bool {
+	return s.tree.Delete(value)
+}
+
+// Clear removes all elements.
+//
+// Time complexity: O(1)
+func (s *OrderedSet[T]) Clear() {
+	s.tree.Release()
+}
+
+// Contains returns true if the element is in the set.
+//
+// Time complexity: O(log n)
+func (s *OrderedSet[T]) Contains(value T) bool {
+	return s.tree.Contains(value)
+}
+
+// First returns the smallest element.
+// Returns an error if the set is empty.
+//
+// Time complexity: O(log n)
+func (s *OrderedSet[T]) First() (T, error) {
+	v, _, ok := s.tree.Min()
+	if !ok {
+		return v, ErrEmptySet
+	}
+
+	return v, nil
+}
+
+// Last returns the largest element.
+// Returns an error if the set is empty.
+//
+// Time complexity: O(log n)
+func (s *OrderedSet[T]) Last() (T, error) {
+	v, _, ok := s.tree.Max()
+	if !ok {
+		return v, ErrEmptySet
+	}
+
+	return v, nil
+}
+
+// PollFirst removes and returns the smallest element.
+// Returns an error if the set is empty.
+//
+// Time complexity: O(log n)
+func (s *OrderedSet[T]) PollFirst() (T, error) {
+	v, err := s.First()
+	if err == nil {
+		s.tree.Delete(v)
+	}
+
+	return v, err
+}
+
+// PollLast removes and returns the largest element.
+// Returns an error if the set is empty.
+//
+// Time complexity: O(log n)
+func (s *OrderedSet[T]) PollLast() (T, error) {
+	v, err := s.Last()
+	if err == nil {
+		s.tree.Delete(v)
+	}
+
+	return v, err
+}
+
+// Floor returns the largest element less than or equal to the given value.
+// Returns false if there is no such element.
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	s := NewOrderedSet(10, 20, 30)
+//	s.Floor(25)  // Returns 20
+//	s.Floor(20)  // Returns 20
+//	s.Floor(5)   // Returns false
+func (s *OrderedSet[T]) Floor(value T) (T, bool) {
+	v, _, ok := s.tree.Floor(value)
+	return v, ok
+}
+
+// Lower returns the largest element strictly less than the given value.
+// Returns false if there is no such element.
+//
+// Time complexity: O(log n)
+func (s *OrderedSet[T]) Lower(value T) (T, bool) {
+	v, _, ok := s.tree.Lower(value)
+	return v, ok
+}
+
+// Ceiling returns the smallest element greater than or equal to the given
+// value. Returns false if there is no such element.
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	s := NewOrderedSet(10, 20, 30)
+//	s.Ceiling(25)  // Returns 30
+//	s.Ceiling(20)  // Returns 20
+//	s.Ceiling(35)  // Returns false
+func (s *OrderedSet[T]) Ceiling(value T) (T, bool) {
+	v, _, ok := s.tree.Ceiling(value)
+	return v, ok
+}
+
+// Higher returns the smallest element strictly greater than the given value.
+// Returns false if there is no such element.
+//
+// Time complexity: O(log n)
+func (s *OrderedSet[T]) Higher(value T) (T, bool) {
+	v, _, ok := s.tree.Higher(value)
+	return v, ok
+}
+
+// All returns an iterator over the elements in ascending order.
+// The set must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (s *OrderedSet[T]) All() iter.Seq[T] {
+	return keysOf(s.tree.All())
+}
+
+// Backward returns an iterator over the elements in descending order.
+// The set must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (s *OrderedSet[T]) Backward() iter.Seq[T] {
+	return keysOf(s.tree.Backward())
+}
+
+// Range returns an iterator over the elements with from <= element < to
+// in ascending order. The set must not be modified during iteration.
+//
+// Time complexity: O(log n + k) where k is the number of elements yielded
+//
+// Example:
+//
+//	s := NewOrderedSet(1, 3, 5, 7, 9)
+//	for v := range s.Range(3, 9) {
+//	    fmt.Println(v)  // 3, 5, 7
+//	}
+func (s *OrderedSet[T]) Range(from T, to T) iter.Seq[T] {
+	return keysOf(s.tree.Range(from, to))
+}
+
+// RangeBackward returns an iterator over the elements with
+// from <= element < to in descending order. The set must not be modified
+// during iteration.
+//
+// Time complexity: O(log n + k) where k is the number of elements yielded
+func (s *OrderedSet[T]) RangeBackward(from T, to T) iter.Seq[T] {
+	return keysOf(s.tree.RangeBackward(from, to))
+}
+
+// Subset returns a live view of the elements with from <= element < to.
+// Changes to the set are visible through the view and changes made
+// through the view are applied to the set.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	s := NewOrderedSet(1, 3, 5, 7, 9)
+//	v := s.Subset(3, 8)
+//	v.Size()        // Returns 3
+//	s.Add(4)
+//	v.Contains(4)  // Returns true
+func (s *OrderedSet[T]) Subset(from T, to T) *OrderedSubset[T] {
+	return &OrderedSubset[T]{set: s, from: from, to: to}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements in ascending order in the format of package codec, under the
+// kind "OrderedSet", so the data of a plain AVL tree is not taken for a
+// set or the other way around.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be
+// encoded.
+//
+// Time complexity: O(n)
+func (s *OrderedSet[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("OrderedSet", s.tree.Size(), s.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the set with those encoded by MarshalBinary.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded set, in which case the set is left unchanged.
+//
+// Time complexity: O(n log n)
+func (s *OrderedSet[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "OrderedSet")
+	if err != nil {
+		return err
+	}
+
+	s.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (s *OrderedSet[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "OrderedSet", s.tree.Size(), s.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the set
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The set is
+// left unchanged on error.
+//
+// Time complexity: O(n log n)
+func (s *OrderedSet[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "OrderedSet")
+	if err != nil {
+		return n, err
+	}
+
+	s.restore(values)
+	return n, nil
+}
+
+// IsEmpty returns true if the set contains no elements.
+//
+// Time complexity: O(1)
+func (s *OrderedSet[T]) IsEmpty() bool {
+	return s.tree.IsEmpty()
+}
+
+// Size returns the number of elements in the set.
+//
+// Time complexity: O(1)
+func (s *OrderedSet[T]) Size() int {
+	return s.tree.Size()
+}
+
+// CheckInvariants verifies the internal consistency of the set, which is
+// that of its backing AVL tree: search order, stored heights and subtree
+// counts, balance and size. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the set is consistent.
+//
+// Time complexity: O(n)
+func (s *OrderedSet[T]) CheckInvariants() error {
+	if err := s.tree.CheckInvariants(); err != nil {
+		return fmt.Errorf("%w: tree: %w", ErrInvariantViolation, err)
+	}
+
+	return nil
+}
+
+// Adapts a key-value iterator to an iterator over its keys.
+func keysOf[T any, V any](seq iter.Seq2[T, V]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Replaces the elements with the decoded ones; repeated values are
+// stored once.
+func (s *OrderedSet[T]) restore(values []T) {
+	s.tree.Clear()
+	for _, v := range values {
+		s.Add(v)
+	}
+}
@@ -0,0 +1,259 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewOrderedSet):
+  ✓ Empty set
+  ✓ With values (duplicates collapsed)
+
+Add/Remove/Contains:
+  ✓ Add new and existing elements
+  ✓ Remove present and absent elements
+
+First/Last:
+  ✓ Empty set (error)
+  ✓ Non-empty set
+
+PollFirst/PollLast:
+  ✓ Empty set (error)
+  ✓ Removes elements from both ends
+
+Floor/Lower/Ceiling/Higher:
+  ✓ Empty set
+  ✓ Exact, between, below and above the stored elements
+
+All/Backward/Range/RangeBackward:
+  ✓ Ascending and descending order
+  ✓ Half-open range bounds
+  ✓ Empty range
+  ✓ Early termination
+
+CheckInvariants:
+  ✓ Backing tree stays consistent through adds, removals and polls
+
+Binary encoding:
+  ✓ Round trip preserves the elements in order, invalid data rejected
+  ✓ Encoding not interchangeable with a plain AVL tree
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the set unchanged
+*/
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	trees "github.com/apotourlyan/godatastructures/internal/trees/structures"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty set
+func TestOrderedSet_NewOrderedSet_Empty(t *testing.T) {
+	s := NewOrderedSet[int]()
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies the creation of a set with values, storing duplicates once
+func TestOrderedSet_NewOrderedSet_WithValues(t *testing.T) {
+	s := NewOrderedSet(3, 1, 2, 1, 3)
+	test.GotWant(t, s.Size(), 3)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{1, 2, 3})
+}
+
+// Verifies adding new and existing elements
+func TestOrderedSet_Add(t *testing.T) {
+	s := NewOrderedSet[string]()
+	test.GotWant(t, s.Add("b"), true)
+	test.GotWant(t, s.Add("a"), true)
+	test.GotWant(t, s.Add("b"), false)
+	test.GotWant(t, s.Size(), 2)
+	test.GotWant(t, s.Contains("a"), true)
+	test.GotWant(t, s.Contains("c"), false)
+}
+
+// Verifies removing present and absent elements
+func TestOrderedSet_Remove(t *testing.T) {
+	s := NewOrderedSet(1, 2, 3)
+	test.GotWant(t, s.Remove(4), false)
+	test.GotWant(t, s.Remove(2), true)
+	test.GotWant(t, s.Remove(2), false)
+	test.GotWant(t, s.Contains(2), false)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{1, 3})
+}
+
+// Verifies First and Last on an empty set
+func TestOrderedSet_FirstLast_EmptySet(t *testing.T) {
+	s := NewOrderedSet[int]()
+	_, err := s.First()
+	test.GotWantErrorIs(t, err, ErrEmptySet)
+	_, err = s.Last()
+	test.GotWantErrorIs(t, err, ErrEmptySet)
+}
+
+// Verifies First and Last on a non-empty set
+func TestOrderedSet_FirstLast_NonEmptySet(t *testing.T) {
+	s := NewOrderedSet(5, 3, 9, 1, 7)
+	first, err := s.First()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, first, 1)
+	last, err := s.Last()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, last, 9)
+}
+
+// Verifies PollFirst and PollLast on an empty set
+func TestOrderedSet_PollFirstPollLast_EmptySet(t *testing.T) {
+	s := NewOrderedSet[int]()
+	_, err := s.PollFirst()
+	test.GotWantErrorIs(t, err, ErrEmptySet)
+	_, err = s.PollLast()
+	test.GotWantErrorIs(t, err, ErrEmptySet)
+}
+
+// Verifies PollFirst and PollLast remove the elements they return
+func TestOrderedSet_PollFirstPollLast(t *testing.T) {
+	var s *TreeSet[int] = NewOrderedSet(5, 3, 9, 1, 7)
+	first, err := s.PollFirst()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, first, 1)
+	last, err := s.PollLast()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, last, 9)
+	test.GotWant(t, s.Size(), 3)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{3, 5, 7})
+}
+
+// Verifies neighbor queries on an empty set
+func TestOrderedSet_Neighbors_EmptySet(t *testing.T) {
+	s := NewOrderedSet[int]()
+	queries := map[string]func(int) (int, bool){
+		"Floor":   s.Floor,
+		"Lower":   s.Lower,
+		"Ceiling": s.Ceiling,
+		"Higher":  s.Higher,
+	}
+
+	for name, query := range queries {
+		t.Run(name, func(t *testing.T) {
+			_, ok := query(1)
+			test.GotWant(t, ok, false)
+		})
+	}
+}
+
+// Verifies Floor, Lower, Ceiling and Higher around the stored elements
+func TestOrderedSet_Neighbors(t *testing.T) {
+	s := NewOrderedSet(10, 20, 30)
+
+	cases := []struct {
+		name  string
+		query func(int) (int, bool)
+		value int
+		want  int
+		found bool
+	}{
+		{"Floor exact", s.Floor, 20, 20, true},
+		{"Floor between", s.Floor, 25, 20, true},
+		{"Floor below all", s.Floor, 5, 0, false},
+		{"Lower exact", s.Lower, 20, 10, true},
+		{"Lower smallest", s.Lower, 10, 0, false},
+		{"Ceiling exact", s.Ceiling, 20, 20, true},
+		{"Ceiling between", s.Ceiling, 25, 30, true},
+		{"Ceiling above all", s.Ceiling, 35, 0, false},
+		{"Higher exact", s.Higher, 20, 30, true},
+		{"Higher largest", s.Higher, 30, 0, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tc.query(tc.value)
+			test.GotWant(t, ok, tc.found)
+			test.GotWant(t, got, tc.want)
+		})
+	}
+}
+
+// Verifies All yields ascending order and Backward descending order
+func TestOrderedSet_All_Order(t *testing.T) {
+	s := NewOrderedSet(4, 2, 5, 1, 3)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{1, 2, 3, 4, 5})
+	test.GotWantSlice(t, slices.Collect(s.Backward()), []int{5, 4, 3, 2, 1})
+}
+
+// Verifies Range and RangeBackward respect half-open bounds
+func TestOrderedSet_Range_Bounds(t *testing.T) {
+	s := NewOrderedSet(1, 3, 5, 7, 9)
+	test.GotWantSlice(t, slices.Collect(s.Range(3, 9)), []int{3, 5, 7})
+	test.GotWantSlice(t, slices.Collect(s.Range(2, 8)), []int{3, 5, 7})
+	test.GotWantSlice(t, slices.Collect(s.RangeBackward(3, 9)), []int{7, 5, 3})
+}
+
+// Verifies empty ranges yield nothing
+func TestOrderedSet_Range_Empty(t *testing.T) {
+	s := NewOrderedSet(1, 3, 5)
+	test.GotWant(t, len(slices.Collect(s.Range(3, 3))), 0)
+	test.GotWant(t, len(slices.Collect(s.Range(5, 1))), 0)
+	test.GotWant(t, len(slices.Collect(s.RangeBackward(6, 10))), 0)
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestOrderedSet_All_EarlyTermination(t *testing.T) {
+	s := NewOrderedSet(1, 2, 3, 4, 5)
+	got := []int{}
+	for v := range s.All() {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	for v := range s.Backward() {
+		got = append(got, v)
+		if len(got) == 3 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []int{1, 2, 5})
+}
+
+// Verifies the backing tree stays consistent through adds, removals and
+// polls. The set keeps no state besides the tree, whose corruption cases
+// the AVLTree tests cover.
+func TestOrderedSet_CheckInvariants(t *testing.T) {
+	s := NewOrderedSet[int]()
+	test.GotWantNoError(t, s.CheckInvariants())
+	for i := range 300 {
+		switch i % 4 {
+		case 0, 1:
+			s.Add(i * 7 % 61)
+		case 2:
+			s.Remove(i * 11 % 61)
+		case 3:
+			s.PollFirst()
+		}
+		test.GotWantNoError(t, s.CheckInvariants())
+	}
+}
+
+// Verifies a round trip preserves the elements in ascending order and
+// invalid data, including that of a plain AVL tree, is rejected
+func TestOrderedSet_MarshalBinary(t *testing.T) {
+	tree, _ := trees.NewAVLTree[int, struct{}]().MarshalBinary()
+	codectest.RoundTrip(t, NewOrderedSet(5, 1, 3, 2, 4), NewOrderedSet(9),
+		func(s *OrderedSet[int]) []int { return slices.Collect(s.All()) }, tree)
+
+	data, _ := NewOrderedSet(1).MarshalBinary()
+	test.GotWantErrorIs(t, trees.NewAVLTree[int, struct{}]().UnmarshalBinary(data), codec.ErrInvalidData)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements in ascending order
+func TestOrderedSet_WriteTo_ReadFrom(t *testing.T) {
+	src := NewOrderedSet(3, 1, 2)
+	dst := NewOrderedSet(9)
+	codectest.StreamRoundTrip(t, src, dst,
+		func(s *OrderedSet[int]) []int { return slices.Collect(s.All()) })
+}
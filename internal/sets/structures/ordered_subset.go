@@ -0,0 +1,169 @@
+package structures
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Compile-time interface verifications
+var _ Set[int] = &OrderedSubset[int]{}
+
+// OrderedSubset is a live view of the elements of an OrderedSet within the
+// half-open range from <= element < to.
+//
+// The view stores only its bounds and delegates every operation to the
+// underlying set, so it always reflects the set's current contents.
+// Elements outside the range are invisible to the view and cannot be
+// added through it.
+//
+// Design decisions:
+//   - No copying: Creating a view is O(1) regardless of its size
+//   - Linear Size: The tree keeps no subtree counts, so Size counts the
+//     elements in range
+//   - Nested views: Subset of a view intersects the two ranges
+//
+// Space complexity: O(1) beyond the underlying set.
+type OrderedSubset[T cmp.Ordered] struct {
+	set  *OrderedSet[T]
+	from T
+	to   T
+}
+
+// Add inserts the element into the underlying set if it lies in range.
+// Returns true if the element was added, false if it was already present
+// or lies outside the range.
+//
+// Time complexity: O(log n)
+func (v *OrderedSubset[T]) Add(value T) bool {
+	return v.inRange(value) && v.set.Add(value)
+}
+
+// Remove deletes the element from the underlying set if it lies in range.
+// Returns true if the element was found and removed, false otherwise.
+//
+// Time complexity: O(log n)
+func (v *OrderedSubset[T]) Remove(value T) bool {
+	return v.inRange(value) && v.set.Remove(value)
+}
+
+// Clear removes all elements in range from the underlying set. Elements
+// outside the range are kept.
+//
+// Time complexity: O(k log n) where k is the number of elements in range
+func (v *OrderedSubset[T]) Clear() {
+	for {
+		if _, err := v.PollFirst(); err != nil {
+			return
+		}
+	}
+}
+
+// Contains returns true if the element lies in range and is in the set.
+//
+// Time complexity: O(log n)
+func (v *OrderedSubset[T]) Contains(value T) bool {
+	return v.inRange(value) && v.set.Contains(value)
+}
+
+// First returns the smallest element in range.
+// Returns an error if the view is empty.
+//
+// Time complexity: O(log n)
+func (v *OrderedSubset[T]) First() (T, error) {
+	value, ok := v.set.Ceiling(v.from)
+	if !ok || !v.inRange(value) {
+		var zero T
+		return zero, ErrEmptySet
+	}
+
+	return value, nil
+}
+
+// Last returns the largest element in range.
+// Returns an error if the view is empty.
+//
+// Time complexity: O(log n)
+func (v *OrderedSubset[T]) Last() (T, error) {
+	value, ok := v.set.Lower(v.to)
+	if !ok || !v.inRange(value) {
+		var zero T
+		return zero, ErrEmptySet
+	}
+
+	return value, nil
+}
+
+// PollFirst removes and returns the smallest element in range.
+// Returns an error if the view is empty.
+//
+// Time complexity: O(log n)
+func (v *OrderedSubset[T]) PollFirst() (T, error) {
+	value, err := v.First()
+	if err == nil {
+		v.set.Remove(value)
+	}
+
+	return value, err
+}
+
+// PollLast removes and returns the largest element in range.
+// Returns an error if the view is empty.
+//
+// Time complexity: O(log n)
+func (v *OrderedSubset[T]) PollLast() (T, error) {
+	value, err := v.Last()
+	if err == nil {
+		v.set.Remove(value)
+	}
+
+	return value, err
+}
+
+// Subset returns a view of the elements with from <= element < to that
+// also lie within this view's range.
+//
+// Time complexity: O(1)
+func (v *OrderedSubset[T]) Subset(from T, to T) *OrderedSubset[T] {
+	return &OrderedSubset[T]{set: v.set, from: max(v.from, from), to: min(v.to, to)}
+}
+
+// All returns an iterator over the elements in range in ascending order.
+// The set must not be modified during iteration.
+//
+// Time complexity: O(log n + k) where k is the number of elements yielded
+func (v *OrderedSubset[T]) All() iter.Seq[T] {
+	return v.set.Range(v.from, v.to)
+}
+
+// Backward returns an iterator over the elements in range in descending
+// order. The set must not be modified during iteration.
+//
+// Time complexity: O(log n + k) where k is the number of elements yielded
+func (v *OrderedSubset[T]) Backward() iter.Seq[T] {
+	return v.set.RangeBackward(v.from, v.to)
+}
+
+// IsEmpty returns true if no element of the set lies in range.
+//
+// Time complexity: O(log n)
+func (v *OrderedSubset[T]) IsEmpty() bool {
+	_, err := v.First()
+	return err != nil
+}
+
+// Size returns the number of elements in range.
+//
+// Time complexity: O(log n + k) where k is the number of elements in range
+func (v *OrderedSubset[T]) Size() int {
+	count := 0
+	for range v.All() {
+		count++
+	}
+
+	return count
+}
+
+// Returns true if from <= value < to.
+func (v *OrderedSubset[T]) inRange(value T) bool {
+	return !cmp.Less(value, v.from) && cmp.Less(value, v.to)
+}
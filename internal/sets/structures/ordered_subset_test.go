@@ -0,0 +1,151 @@
+package structures
+
+/*
+Test Coverage
+=============
+Subset:
+  ✓ Half-open bounds
+  ✓ Empty and inverted ranges
+  ✓ View reflects later changes to the set
+  ✓ Nested views intersect ranges
+
+Add/Remove/Contains:
+  ✓ In-range changes apply to the set
+  ✓ Out-of-range elements are ignored
+
+First/Last/PollFirst/PollLast:
+  ✓ Empty view (error)
+  ✓ Ends of the range, polling removes from the set
+
+All/Backward:
+  ✓ Ascending and descending order within range
+
+Clear:
+  ✓ Removes in-range elements only
+*/
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies a view contains exactly the elements in the half-open range
+func TestOrderedSubset_Subset_Bounds(t *testing.T) {
+	s := NewOrderedSet(1, 3, 5, 7, 9)
+	v := s.Subset(3, 9)
+	test.GotWant(t, v.Size(), 3)
+	test.GotWant(t, v.IsEmpty(), false)
+	test.GotWant(t, v.Contains(3), true)
+	test.GotWant(t, v.Contains(9), false)
+	test.GotWant(t, v.Contains(1), false)
+	test.GotWantSlice(t, slices.Collect(v.All()), []int{3, 5, 7})
+}
+
+// Verifies empty and inverted ranges produce empty views
+func TestOrderedSubset_Subset_Empty(t *testing.T) {
+	s := NewOrderedSet(1, 3, 5)
+	for _, v := range []*OrderedSubset[int]{s.Subset(3, 3), s.Subset(5, 1), s.Subset(6, 10)} {
+		test.GotWant(t, v.Size(), 0)
+		test.GotWant(t, v.IsEmpty(), true)
+		test.GotWant(t, v.Add(4), false)
+	}
+	test.GotWant(t, s.Size(), 3)
+}
+
+// Verifies a view reflects changes made to the set after its creation
+func TestOrderedSubset_Subset_Live(t *testing.T) {
+	s := NewOrderedSet(1, 5, 9)
+	v := s.Subset(2, 8)
+	test.GotWantSlice(t, slices.Collect(v.All()), []int{5})
+
+	s.Add(4)
+	s.Add(8)
+	s.Remove(5)
+	test.GotWantSlice(t, slices.Collect(v.All()), []int{4})
+}
+
+// Verifies a view of a view is limited to the intersection of both ranges
+func TestOrderedSubset_Subset_Nested(t *testing.T) {
+	s := NewOrderedSet(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	v := s.Subset(2, 8).Subset(5, 20)
+	test.GotWantSlice(t, slices.Collect(v.All()), []int{5, 6, 7})
+	test.GotWant(t, v.Add(8), false)
+	test.GotWant(t, v.Contains(8), false)
+}
+
+// Verifies in-range Add and Remove change the underlying set
+func TestOrderedSubset_AddRemove_InRange(t *testing.T) {
+	s := NewOrderedSet(1, 9)
+	v := s.Subset(2, 8)
+	test.GotWant(t, v.Add(4), true)
+	test.GotWant(t, v.Add(4), false)
+	test.GotWant(t, s.Contains(4), true)
+	test.GotWant(t, v.Remove(4), true)
+	test.GotWant(t, v.Remove(4), false)
+	test.GotWant(t, s.Contains(4), false)
+}
+
+// Verifies out-of-range Add and Remove leave the set unchanged
+func TestOrderedSubset_AddRemove_OutOfRange(t *testing.T) {
+	s := NewOrderedSet(1, 9)
+	v := s.Subset(2, 8)
+	test.GotWant(t, v.Add(0), false)
+	test.GotWant(t, v.Add(8), false)
+	test.GotWant(t, v.Remove(1), false)
+	test.GotWant(t, v.Remove(9), false)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{1, 9})
+}
+
+// Verifies First, Last and polling on an empty view
+func TestOrderedSubset_FirstLast_EmptyView(t *testing.T) {
+	s := NewOrderedSet(1, 9)
+	v := s.Subset(2, 8)
+	_, err := v.First()
+	test.GotWantErrorIs(t, err, ErrEmptySet)
+	_, err = v.Last()
+	test.GotWantErrorIs(t, err, ErrEmptySet)
+	_, err = v.PollFirst()
+	test.GotWantErrorIs(t, err, ErrEmptySet)
+	_, err = v.PollLast()
+	test.GotWantErrorIs(t, err, ErrEmptySet)
+	test.GotWant(t, s.Size(), 2)
+}
+
+// Verifies First and Last return the range ends and polling removes them
+// from the underlying set
+func TestOrderedSubset_PollFirstPollLast(t *testing.T) {
+	s := NewOrderedSet(1, 3, 5, 7, 9)
+	v := s.Subset(2, 9)
+
+	first, err := v.First()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, first, 3)
+	last, err := v.Last()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, last, 7)
+
+	first, _ = v.PollFirst()
+	last, _ = v.PollLast()
+	test.GotWant(t, first, 3)
+	test.GotWant(t, last, 7)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{1, 5, 9})
+}
+
+// Verifies All yields ascending order and Backward descending order
+func TestOrderedSubset_All_Order(t *testing.T) {
+	s := NewOrderedSet(4, 2, 5, 1, 3, 6)
+	v := s.Subset(2, 6)
+	test.GotWantSlice(t, slices.Collect(v.All()), []int{2, 3, 4, 5})
+	test.GotWantSlice(t, slices.Collect(v.Backward()), []int{5, 4, 3, 2})
+}
+
+// Verifies Clear removes the elements in range and keeps the others
+func TestOrderedSubset_Clear(t *testing.T) {
+	s := NewOrderedSet(1, 3, 5, 7, 9)
+	v := s.Subset(3, 9)
+	v.Clear()
+	test.GotWant(t, v.IsEmpty(), true)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{1, 9})
+}
@@ -0,0 +1,113 @@
+package structures
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	trees "github.com/apotourlyan/godatastructures/internal/trees/structures"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &PersistentSet[int]{}
+var _ collections.Iterable[int] = &PersistentSet[int]{}
+
+// PersistentSet implements an immutable set of distinct comparable
+// elements.
+//
+// Add and Remove never modify the receiver; they return a new set that
+// shares all unchanged structure with it. Elements are stored as keys of
+// a HAMT, so each update copies only O(log32 n) small nodes. Every version
+// remains valid, which makes snapshots free and allows concurrent readers
+// without locks, as no version is ever written after it is returned.
+//
+// Design decisions:
+//   - HAMT backing: Structural sharing with near-constant depth
+//   - Empty struct values: Elements cost no extra space beyond the leaf
+//   - No-op updates return the receiver: Adding a present element or
+//     removing an absent one allocates nothing
+//
+// Space complexity: O(n) where n is the number of elements, shared
+// between versions.
+type PersistentSet[T comparable] struct {
+	trie *trees.HAMT[T, struct{}]
+}
+
+// NewPersistentSet creates a persistent set with the given elements.
+// Duplicate values are stored once.
+//
+// Example:
+//
+//	a := NewPersistentSet(1, 2)
+//	b := a.Add(3)
+//	a.Contains(3)  // Returns false
+//	b.Contains(3)  // Returns true
+func NewPersistentSet[T comparable](values ...T) *PersistentSet[T] {
+	s := &PersistentSet[T]{trie: trees.NewHAMT[T, struct{}]()}
+	for _, v := range values {
+		s = s.Add(v)
+	}
+
+	return s
+}
+
+// Add returns a set that also contains the element.
+// Returns the receiver if the element is already present.
+//
+// Time complexity: O(log32 n)
+func (s *PersistentSet[T]) Add(value T) *PersistentSet[T] {
+	if s.trie.Contains(value) {
+		return s
+	}
+
+	return &PersistentSet[T]{trie: s.trie.Put(value, struct{}{})}
+}
+
+// Remove returns a set without the element.
+// Returns the receiver if the element is absent.
+//
+// Time complexity: O(log32 n)
+func (s *PersistentSet[T]) Remove(value T) *PersistentSet[T] {
+	trie := s.trie.Delete(value)
+	if trie == s.trie {
+		return s
+	}
+
+	return &PersistentSet[T]{trie: trie}
+}
+
+// Contains returns true if the element is in the set.
+//
+// Time complexity: O(log32 n)
+func (s *PersistentSet[T]) Contains(value T) bool {
+	return s.trie.Contains(value)
+}
+
+// All returns an iterator over the elements in unspecified order.
+// Since the set never changes, iteration is always safe.
+//
+// Time complexity: O(n) for a full iteration
+func (s *PersistentSet[T]) All() iter.Seq[T] {
+	return keysOf(s.trie.All())
+}
+
+// Snapshot returns the set itself: a persistent set never changes, so
+// every version is already an immutable point-in-time view.
+//
+// Time complexity: O(1)
+func (s *PersistentSet[T]) Snapshot() *PersistentSet[T] {
+	return s
+}
+
+// IsEmpty returns true if the set contains no elements.
+//
+// Time complexity: O(1)
+func (s *PersistentSet[T]) IsEmpty() bool {
+	return s.trie.IsEmpty()
+}
+
+// Size returns the number of elements in the set.
+//
+// Time complexity: O(1)
+func (s *PersistentSet[T]) Size() int {
+	return s.trie.Size()
+}
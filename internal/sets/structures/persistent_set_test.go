@@ -0,0 +1,107 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewPersistentSet):
+  ✓ Empty set
+  ✓ With values (duplicates collapsed)
+
+Add/Remove/Contains:
+  ✓ New versions contain the change, old versions are unchanged
+  ✓ No-op updates return the receiver
+
+Concurrency:
+  ✓ Readers of one version race with writers deriving new versions
+
+Snapshot:
+  ✓ Returns the receiver
+*/
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the set's elements in ascending order for comparison.
+func sortedPersistentSet(s *PersistentSet[int]) []int {
+	values := slices.Collect(s.All())
+	slices.Sort(values)
+	return values
+}
+
+// Verifies the creation of an empty set
+func TestPersistentSet_NewPersistentSet_Empty(t *testing.T) {
+	s := NewPersistentSet[int]()
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies the creation of a set with values, storing duplicates once
+func TestPersistentSet_NewPersistentSet_WithValues(t *testing.T) {
+	s := NewPersistentSet(3, 1, 2, 1, 3)
+	test.GotWant(t, s.Size(), 3)
+	test.GotWantSlice(t, sortedPersistentSet(s), []int{1, 2, 3})
+}
+
+// Verifies updates produce new versions and leave old versions unchanged
+func TestPersistentSet_AddRemove_Versions(t *testing.T) {
+	v1 := NewPersistentSet(1, 2)
+	v2 := v1.Add(3)
+	v3 := v2.Remove(1)
+
+	test.GotWantSlice(t, sortedPersistentSet(v1), []int{1, 2})
+	test.GotWantSlice(t, sortedPersistentSet(v2), []int{1, 2, 3})
+	test.GotWantSlice(t, sortedPersistentSet(v3), []int{2, 3})
+	test.GotWant(t, v1.Contains(3), false)
+	test.GotWant(t, v3.Contains(1), false)
+}
+
+// Verifies adding a present element or removing an absent one returns
+// the receiver
+func TestPersistentSet_AddRemove_NoOp(t *testing.T) {
+	s := NewPersistentSet(1, 2)
+	test.GotWant(t, s.Add(1), s)
+	test.GotWant(t, s.Remove(5), s)
+}
+
+// Verifies concurrent readers see a consistent version while writers
+// derive new versions from it (run with -race)
+func TestPersistentSet_Concurrency_Snapshots(t *testing.T) {
+	base := NewPersistentSet[int]()
+	for i := range 1000 {
+		base = base.Add(i)
+	}
+
+	var wg sync.WaitGroup
+	for w := range 4 {
+		wg.Go(func() {
+			s := base
+			for i := range 250 {
+				s = s.Remove(i*4 + w).Add(1000 + i*4 + w)
+			}
+			test.GotWant(t, s.Size(), 1000)
+		})
+		wg.Go(func() {
+			for i := range 1000 {
+				test.GotWant(t, base.Contains(i), true)
+			}
+			test.GotWant(t, len(slices.Collect(base.All())), 1000)
+		})
+	}
+	wg.Wait()
+	test.GotWant(t, base.Size(), 1000)
+}
+
+// Verifies a snapshot is the set itself
+func TestPersistentSet_Snapshot(t *testing.T) {
+	s := NewPersistentSet(1, 2)
+	snap := s.Snapshot()
+	test.GotWant(t, snap, s)
+
+	s.Add(3)
+	test.GotWant(t, snap.Size(), 2)
+}
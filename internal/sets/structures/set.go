@@ -0,0 +1,153 @@
+// Package structures provides generic set data structures and their implementations.
+package structures
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Set defines the interface for a mutable collection of distinct
+// elements.
+//
+// All implementations guarantee:
+//   - Add operations insert an element only if it is not already present
+//   - Remove operations delete an element if present
+//   - Contains and All operations observe elements without removal
+//   - Clear operations remove every element
+//   - Size and IsEmpty operations reflect current state
+//
+// Iteration order is implementation-dependent. Thread safety is
+// implementation-dependent. Check specific implementation documentation
+// for ordering, complexity and concurrency guarantees.
+type Set[T comparable] interface {
+	collections.Collection[T]
+	collections.Iterable[T]
+	collections.Addable[T]
+
+	// Add inserts the element.
+	// Returns true if the element was added, false if it was already present.
+	Add(value T) bool
+
+	// Remove deletes the element.
+	// Returns true if the element was found and removed, false otherwise.
+	Remove(value T) bool
+
+	// Contains returns true if the element is present.
+	Contains(value T) bool
+
+	// Clear removes all elements.
+	Clear()
+
+	// All returns an iterator over all elements.
+	All() iter.Seq[T]
+
+	// IsEmpty returns true if the set contains no elements.
+	IsEmpty() bool
+
+	// Size returns the number of elements currently in the set.
+	Size() int
+}
+
+// Union adds to dst every element that is in a or b, and returns dst.
+// The operands may be of different set types; dst must be distinct from
+// both of them.
+//
+// Time complexity: O(|a| + |b|) Add operations on dst
+//
+// Example:
+//
+//	a := NewHashSet(1, 2)
+//	b := NewOrderedSet(2, 3)
+//	u := Union(NewOrderedSet[int](), a, b)  // {1, 2, 3}
+func Union[T comparable, S Set[T]](dst S, a Set[T], b Set[T]) S {
+	for v := range a.All() {
+		dst.Add(v)
+	}
+	for v := range b.All() {
+		dst.Add(v)
+	}
+
+	return dst
+}
+
+// Intersection adds to dst every element that is in both a and b, and
+// returns dst. The smaller operand is iterated and the larger one probed.
+// The operands may be of different set types; dst must be distinct from
+// both of them.
+//
+// Time complexity: O(min(|a|, |b|)) Contains operations
+//
+// Example:
+//
+//	a := NewHashSet(1, 2, 3)
+//	b := NewOrderedSet(2, 3, 4)
+//	i := Intersection(NewHashSet[int](), a, b)  // {2, 3}
+func Intersection[T comparable, S Set[T]](dst S, a Set[T], b Set[T]) S {
+	if b.Size() < a.Size() {
+		a, b = b, a
+	}
+
+	for v := range a.All() {
+		if b.Contains(v) {
+			dst.Add(v)
+		}
+	}
+
+	return dst
+}
+
+// Difference adds to dst every element of a that is not in b, and returns
+// dst. The operands may be of different set types; dst must be distinct
+// from both of them.
+//
+// Time complexity: O(|a|) Contains operations
+//
+// Example:
+//
+//	a := NewHashSet(1, 2, 3)
+//	b := NewHashSet(2)
+//	d := Difference(NewHashSet[int](), a, b)  // {1, 3}
+func Difference[T comparable, S Set[T]](dst S, a Set[T], b Set[T]) S {
+	for v := range a.All() {
+		if !b.Contains(v) {
+			dst.Add(v)
+		}
+	}
+
+	return dst
+}
+
+// SymmetricDifference adds to dst every element that is in exactly one
+// of a and b, and returns dst. The operands may be of different set
+// types; dst must be distinct from both of them.
+//
+// Time complexity: O(|a| + |b|) Contains operations
+//
+// Example:
+//
+//	a := NewHashSet(1, 2, 3)
+//	b := NewHashSet(3, 4)
+//	s := SymmetricDifference(NewHashSet[int](), a, b)  // {1, 2, 4}
+func SymmetricDifference[T comparable, S Set[T]](dst S, a Set[T], b Set[T]) S {
+	Difference(dst, a, b)
+	return Difference(dst, b, a)
+}
+
+// IsSubset returns true if every element of a is also in b.
+// The empty set is a subset of every set.
+//
+// Time complexity: O(|a|) Contains operations
+func IsSubset[T comparable](a Set[T], b Set[T]) bool {
+	if a.Size() > b.Size() {
+		return false
+	}
+
+	for v := range a.All() {
+		if !b.Contains(v) {
+			return false
+		}
+	}
+
+	return true
+}
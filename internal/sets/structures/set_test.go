@@ -0,0 +1,154 @@
+package structures
+
+/*
+Test Coverage
+=============
+Union/Intersection/Difference/SymmetricDifference:
+  ✓ Every pairing of HashSet, OrderedSet and BitSet operands
+  ✓ Results written into any destination set type
+  ✓ Empty operands
+  ✓ Operands are unchanged
+
+IsSubset:
+  ✓ Proper subsets, equal sets, non-subsets and the empty set
+  ✓ Mixed set types, including subset views
+
+Clear:
+  ✓ Every implementation is empty afterwards and accepts new elements
+*/
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the set's elements in ascending order for comparison.
+func sortedSet(s Set[uint]) []uint {
+	values := slices.Collect(s.All())
+	slices.Sort(values)
+	return values
+}
+
+// Returns one set of every implementation holding the given values.
+func eachSet(values ...uint) map[string]func() Set[uint] {
+	return map[string]func() Set[uint]{
+		"HashSet":    func() Set[uint] { return NewHashSet(values...) },
+		"OrderedSet": func() Set[uint] { return NewOrderedSet(values...) },
+		"BitSet":     func() Set[uint] { return NewBitSet(values...) },
+	}
+}
+
+// Verifies the algebra for every combination of operand and destination
+// types, leaving the operands unchanged
+func TestSet_Algebra_MixedTypes(t *testing.T) {
+	a := []uint{1, 2, 3, 100}
+	b := []uint{2, 3, 4, 200}
+
+	operations := map[string]struct {
+		op   func(dst, a, b Set[uint]) Set[uint]
+		want []uint
+	}{
+		"Union":               {Union[uint, Set[uint]], []uint{1, 2, 3, 4, 100, 200}},
+		"Intersection":        {Intersection[uint, Set[uint]], []uint{2, 3}},
+		"Difference":          {Difference[uint, Set[uint]], []uint{1, 100}},
+		"SymmetricDifference": {SymmetricDifference[uint, Set[uint]], []uint{1, 4, 100, 200}},
+	}
+
+	for opName, operation := range operations {
+		for aName, newA := range eachSet(a...) {
+			for bName, newB := range eachSet(b...) {
+				for dstName, newDst := range eachSet() {
+					name := opName + "/" + aName + "_" + bName + "_into_" + dstName
+					t.Run(name, func(t *testing.T) {
+						x, y := newA(), newB()
+						got := operation.op(newDst(), x, y)
+						test.GotWantSlice(t, sortedSet(got), operation.want)
+						test.GotWantSlice(t, sortedSet(x), a)
+						test.GotWantSlice(t, sortedSet(y), b)
+					})
+				}
+			}
+		}
+	}
+}
+
+// Verifies the typed destination is returned for further use
+func TestSet_Union_ReturnsDestination(t *testing.T) {
+	dst := NewOrderedSet[int]()
+	got := Union(dst, NewHashSet(3, 1), NewOrderedSet(2))
+	test.GotWant(t, got, dst)
+
+	first, _ := got.First()
+	test.GotWant(t, first, 1)
+}
+
+// Verifies the algebra with empty operands
+func TestSet_Algebra_Empty(t *testing.T) {
+	empty := NewHashSet[int]()
+	s := NewHashSet(1, 2)
+
+	test.GotWant(t, Union(NewHashSet[int](), empty, s).Size(), 2)
+	test.GotWant(t, Intersection(NewHashSet[int](), s, empty).Size(), 0)
+	test.GotWant(t, Difference(NewHashSet[int](), s, empty).Size(), 2)
+	test.GotWant(t, Difference(NewHashSet[int](), empty, s).Size(), 0)
+	test.GotWant(t, SymmetricDifference(NewHashSet[int](), empty, s).Size(), 2)
+	test.GotWant(t, Union(NewHashSet[int](), empty, empty).IsEmpty(), true)
+}
+
+// Verifies IsSubset for subsets, equal sets, non-subsets and the empty set
+func TestSet_IsSubset(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b []uint
+		want bool
+	}{
+		{"proper subset", []uint{1, 3}, []uint{1, 2, 3}, true},
+		{"equal", []uint{1, 2, 3}, []uint{1, 2, 3}, true},
+		{"superset", []uint{1, 2, 3}, []uint{1, 3}, false},
+		{"disjoint", []uint{4}, []uint{1, 2, 3}, false},
+		{"same size", []uint{1, 4}, []uint{1, 2}, false},
+		{"empty in non-empty", []uint{}, []uint{1}, true},
+		{"empty in empty", []uint{}, []uint{}, true},
+	}
+
+	for _, tc := range cases {
+		for aName, newA := range eachSet(tc.a...) {
+			for bName, newB := range eachSet(tc.b...) {
+				t.Run(tc.name+"/"+aName+"_"+bName, func(t *testing.T) {
+					test.GotWant(t, IsSubset(newA(), newB()), tc.want)
+				})
+			}
+		}
+	}
+}
+
+// Verifies a subset view takes part in the algebra like any other set
+func TestSet_IsSubset_View(t *testing.T) {
+	s := NewOrderedSet(1, 2, 3, 4, 5)
+	view := s.Subset(2, 4)
+	test.GotWant(t, IsSubset[int](view, s), true)
+	test.GotWant(t, IsSubset[int](s, view), false)
+	test.GotWant(t, IsSubset[int](view, NewHashSet(2, 3)), true)
+}
+
+// Verifies Clear empties every implementation and leaves it usable
+func TestSet_Clear(t *testing.T) {
+	sets := eachSet(1, 2, 64, 100)
+	sets["SparseSet"] = func() Set[uint] { return NewSparseSet(128, 1, 2, 64, 100) }
+
+	for name, newSet := range sets {
+		t.Run(name, func(t *testing.T) {
+			s := newSet()
+			s.Clear()
+			test.GotWant(t, s.Size(), 0)
+			test.GotWant(t, s.IsEmpty(), true)
+			test.GotWant(t, s.Contains(64), false)
+			test.GotWantSlice(t, sortedSet(s), []uint{})
+
+			test.GotWant(t, s.Add(2), true)
+			test.GotWantSlice(t, sortedSet(s), []uint{2})
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Compile-time interface verifications
+var _ Set[uint] = &SparseSet{}
+
+// SparseSet implements a set of integers drawn from a fixed universe
+// [0, u) using the sparse/dense array pair of Briggs and Torczon.
+//
+// The dense array holds the elements packed at the front, and the sparse
+// array maps every element to its position in dense. An element v is
+// present when sparse[v] points inside the packed prefix and the dense
+// entry there is v again, so the sparse array never needs clearing:
+// stale entries fail the cross-check. This gives O(1) Add, Remove,
+// Contains and Clear, and iteration touches only the n elements present
+// rather than the whole universe, which is what makes the structure
+// popular for entity-component systems and register allocation.
+//
+// Design decisions:
+//   - Fixed universe: Both arrays are allocated once; adding an element
+//     outside the universe panics, querying one reports absence
+//   - Swap removal: The last dense element fills the hole, so iteration
+//     follows insertion order only until the first removal
+//   - O(1) Clear: Truncating dense invalidates every sparse entry at once
+//
+// Compared to BitSet, SparseSet uses more memory per universe element
+// but iterates and clears in time proportional to its size.
+//
+// Space complexity: O(u) where u is the universe size.
+type SparseSet struct {
+	dense  []uint // Elements, packed at the front
+	sparse []uint // Element -> index in dense, valid only if cross-checked
+}
+
+// NewSparseSet creates a sparse set over the universe [0, universe) with
+// the given elements.
+//
+// Panics if any value is not less than universe.
+//
+// Example:
+//
+//	s := NewSparseSet(100, 42, 7)
+//	s.Contains(7)   // Returns true
+//	s.Contains(99)  // Returns false
+//	s.Add(100)      // Panics, outside the universe
+func NewSparseSet(universe uint, values ...uint) *SparseSet {
+	s := &SparseSet{
+		dense:  make([]uint, 0, universe),
+		sparse: make([]uint, universe),
+	}
+	for _, v := range values {
+		s.Add(v)
+	}
+
+	return s
+}
+
+// Add inserts the element.
+// Returns true if the element was added, false if it was already present.
+//
+// Panics if the value is not less than the universe size.
+//
+// Time complexity: O(1)
+func (s *SparseSet) Add(value uint) bool {
+	panics.RequireLessThan(value, s.Universe(), "value")
+	if s.Contains(value) {
+		return false
+	}
+
+	s.sparse[value] = uint(len(s.dense))
+	s.dense = append(s.dense, value)
+	return true
+}
+
+// Remove deletes the element from the set, moving the last element into
+// its place.
+// Returns true if the element was found and removed, false otherwise.
+//
+// Time complexity: O(1)
+func (s *SparseSet) Remove(value uint) bool {
+	if !s.Contains(value) {
+		return false
+	}
+
+	last := s.dense[len(s.dense)-1]
+	i := s.sparse[value]
+	s.dense[i] = last
+	s.sparse[last] = i
+	s.dense = s.dense[:len(s.dense)-1]
+	return true
+}
+
+// Contains returns true if the element is in the set. Values outside the
+// universe are never contained.
+//
+// Time complexity: O(1)
+func (s *SparseSet) Contains(value uint) bool {
+	if value >= s.Universe() {
+		return false
+	}
+
+	i := s.sparse[value]
+	return i < uint(len(s.dense)) && s.dense[i] == value
+}
+
+// Clear removes all elements while keeping the universe.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	s := NewSparseSet(10, 1, 2, 3)
+//	s.Clear()  // Set is empty, elements 0-9 can still be added
+func (s *SparseSet) Clear() {
+	s.dense = s.dense[:0]
+}
+
+// All returns an iterator over the elements in dense order: insertion
+// order, except that each removal moves the last element into the hole.
+// The set must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (s *SparseSet) All() iter.Seq[uint] {
+	return func(yield func(uint) bool) {
+		for _, v := range s.dense {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Universe returns the universe size u; elements lie in [0, u).
+//
+// Time complexity: O(1)
+func (s *SparseSet) Universe() uint {
+	return uint(len(s.sparse))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements in dense order in the format of package codec, so the
+// iteration order survives a round trip. The universe is not encoded.
+//
+// Time complexity: O(n)
+func (s *SparseSet) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("SparseSet", len(s.dense), s.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the set with those encoded by MarshalBinary, in their
+// encoded order, keeping the universe.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded set or holds an element outside the universe, in which case
+// the set is left unchanged.
+//
+// Time complexity: O(n)
+func (s *SparseSet) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[uint](data, "SparseSet")
+	if err != nil {
+		return err
+	}
+
+	return s.restore(values)
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (s *SparseSet) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "SparseSet", len(s.dense), s.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the set
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The set is left
+// unchanged on error.
+//
+// Time complexity: O(n)
+func (s *SparseSet) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[uint](r, "SparseSet")
+	if err != nil {
+		return n, err
+	}
+
+	return n, s.restore(values)
+}
+
+// IsEmpty returns true if the set contains no elements.
+//
+// Time complexity: O(1)
+func (s *SparseSet) IsEmpty() bool {
+	return len(s.dense) == 0
+}
+
+// Size returns the number of elements in the set.
+//
+// Time complexity: O(1)
+func (s *SparseSet) Size() int {
+	return len(s.dense)
+}
+
+// CheckInvariants verifies the cross-index between the two arrays: the
+// packed elements fit in the universe, every one of them lies inside it,
+// and the sparse entry of each points back to its position in dense,
+// which also rules out duplicates. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the set is consistent.
+//
+// Time complexity: O(n)
+func (s *SparseSet) CheckInvariants() error {
+	if len(s.dense) > len(s.sparse) {
+		return fmt.Errorf("%w: %d elements in a universe of %d", ErrInvariantViolation, len(s.dense), len(s.sparse))
+	}
+
+	for i, v := range s.dense {
+		if v >= s.Universe() {
+			return fmt.Errorf("%w: element %d at index %d is outside the universe [0, %d)", ErrInvariantViolation, v, i, s.Universe())
+		}
+		if s.sparse[v] != uint(i) {
+			return fmt.Errorf("%w: element %d at index %d maps back to index %d", ErrInvariantViolation, v, i, s.sparse[v])
+		}
+	}
+
+	return nil
+}
+
+// Replaces the elements with the decoded ones, in their decoded order.
+// Leaves the set unchanged if an element lies outside the universe.
+func (s *SparseSet) restore(values []uint) error {
+	for _, v := range values {
+		if v >= s.Universe() {
+			return fmt.Errorf("%w: element %d is outside the universe [0, %d)", codec.ErrInvalidData, v, s.Universe())
+		}
+	}
+
+	s.Clear()
+	for _, v := range values {
+		s.Add(v)
+	}
+	return nil
+}
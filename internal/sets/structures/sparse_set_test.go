@@ -0,0 +1,210 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewSparseSet):
+  ✓ Empty set, empty universe
+  ✓ With values (duplicates collapsed)
+  ✓ Value outside the universe panics
+
+Add/Remove/Contains:
+  ✓ Universe bounds
+  ✓ Remove moves the last element into the hole
+  ✓ Stale sparse entries are not reported
+
+Clear/All:
+  ✓ Clear keeps the universe, elements can be re-added
+  ✓ Insertion order, early termination
+
+CheckInvariants:
+  ✓ Valid sets, element outside the universe, broken cross-index,
+    duplicate element
+
+Randomized:
+  ✓ Operations match a map-based model, cross-index stays consistent
+
+Binary encoding:
+  ✓ Round trip keeps the dense order and the receiver's universe
+  ✓ Invalid data and elements outside the universe leave the set unchanged
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty set and of an empty universe
+func TestSparseSet_NewSparseSet_Empty(t *testing.T) {
+	s := NewSparseSet(10)
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, s.Universe(), uint(10))
+
+	empty := NewSparseSet(0)
+	test.GotWant(t, empty.Contains(0), false)
+	test.GotWant(t, empty.Remove(0), false)
+}
+
+// Verifies the creation of a set with values, duplicates collapsed
+func TestSparseSet_NewSparseSet_WithValues(t *testing.T) {
+	s := NewSparseSet(10, 3, 1, 3, 7)
+	test.GotWant(t, s.Size(), 3)
+	test.GotWantSlice(t, slices.Collect(s.All()), []uint{3, 1, 7})
+}
+
+// Verifies adding a value outside the universe panics
+func TestSparseSet_Add_OutsideUniverse(t *testing.T) {
+	test.GotWantPanic(t, func() { NewSparseSet(5, 5) }, `"value" must be < 5, got 5`)
+	s := NewSparseSet(5)
+	test.GotWantPanic(t, func() { s.Add(9) }, `"value" must be < 5, got 9`)
+}
+
+// Verifies the first and last element of the universe and queries beyond it
+func TestSparseSet_Add_UniverseBounds(t *testing.T) {
+	s := NewSparseSet(8)
+	test.GotWant(t, s.Add(0), true)
+	test.GotWant(t, s.Add(7), true)
+	test.GotWant(t, s.Add(7), false)
+	test.GotWant(t, s.Contains(0), true)
+	test.GotWant(t, s.Contains(7), true)
+	test.GotWant(t, s.Contains(8), false)
+	test.GotWant(t, s.Remove(8), false)
+}
+
+// Verifies removal moves the last element into the hole
+func TestSparseSet_Remove(t *testing.T) {
+	s := NewSparseSet(10, 1, 2, 3, 4)
+	test.GotWant(t, s.Remove(2), true)
+	test.GotWant(t, s.Remove(2), false)
+	test.GotWant(t, s.Contains(2), false)
+	test.GotWantSlice(t, slices.Collect(s.All()), []uint{1, 4, 3})
+
+	test.GotWant(t, s.Remove(3), true)
+	test.GotWantSlice(t, slices.Collect(s.All()), []uint{1, 4})
+}
+
+// Verifies sparse entries left behind by removals and clears are not
+// mistaken for membership
+func TestSparseSet_Contains_StaleEntries(t *testing.T) {
+	s := NewSparseSet(10, 5, 6)
+	s.Remove(5) // sparse[5] still points at index 0
+	s.Add(9)
+	test.GotWant(t, s.Contains(5), false)
+
+	s.Clear()
+	s.Add(1)
+	test.GotWant(t, s.Contains(6), false)
+	test.GotWant(t, s.Contains(1), true)
+}
+
+// Verifies Clear empties the set and keeps the universe
+func TestSparseSet_Clear(t *testing.T) {
+	s := NewSparseSet(4, 0, 1, 2, 3)
+	s.Clear()
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, s.Universe(), uint(4))
+	for v := range uint(4) {
+		test.GotWant(t, s.Contains(v), false)
+	}
+
+	test.GotWant(t, s.Add(2), true)
+	test.GotWantSlice(t, slices.Collect(s.All()), []uint{2})
+}
+
+// Verifies early termination of the iterator
+func TestSparseSet_All_EarlyTermination(t *testing.T) {
+	s := NewSparseSet(10, 4, 5, 6)
+	got := []uint{}
+	for v := range s.All() {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []uint{4, 5})
+}
+
+// Verifies CheckInvariants accepts valid sets and reports each kind of
+// corruption of the cross-index
+func TestSparseSet_CheckInvariants(t *testing.T) {
+	s := NewSparseSet(10)
+	test.GotWantNoError(t, s.CheckInvariants())
+	s = NewSparseSet(10, 3, 5, 7)
+	s.Remove(3)
+	test.GotWantNoError(t, s.CheckInvariants())
+
+	s.dense[1] = 12
+	test.GotWantError(t, s.CheckInvariants(), "invariant violation: element 12 at index 1 is outside the universe [0, 10)")
+	s.dense[1] = 5
+
+	s.sparse[5] = 0
+	test.GotWantError(t, s.CheckInvariants(), "invariant violation: element 5 at index 1 maps back to index 0")
+	s.sparse[5] = 1
+
+	s.dense[1] = 7
+	test.GotWantError(t, s.CheckInvariants(), "invariant violation: element 7 at index 1 maps back to index 0")
+	s.dense[1] = 5
+	test.GotWantNoError(t, s.CheckInvariants())
+}
+
+// Verifies random operations match a map-based model
+func TestSparseSet_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	s := NewSparseSet(200)
+	model := map[uint]bool{}
+
+	for range 5000 {
+		v := uint(r.IntN(250))
+		switch r.IntN(10) {
+		case 0, 1, 2:
+			if v < s.Universe() {
+				test.GotWant(t, s.Add(v), !model[v])
+				model[v] = true
+			}
+		case 3, 4:
+			test.GotWant(t, s.Remove(v), model[v])
+			delete(model, v)
+		case 5:
+			if r.IntN(20) == 0 {
+				s.Clear()
+				clear(model)
+			}
+		default:
+			test.GotWant(t, s.Contains(v), model[v])
+		}
+		test.GotWant(t, s.Size(), len(model))
+	}
+	test.GotWantNoError(t, s.CheckInvariants())
+
+	for v := range s.All() {
+		test.GotWant(t, model[v], true)
+	}
+}
+
+// Verifies a round trip restores the elements in dense order and keeps
+// the receiver's universe, and invalid data or elements outside the
+// universe are rejected
+func TestSparseSet_MarshalBinary(t *testing.T) {
+	other, _ := NewBitSet(1).MarshalBinary()
+	outside, _ := NewSparseSet(100, 3, 50).MarshalBinary()
+	dst := NewSparseSet(20, 1)
+	codectest.RoundTrip(t, NewSparseSet(10, 7, 2, 5), dst,
+		func(s *SparseSet) []uint { return slices.Collect(s.All()) }, other, outside)
+	test.GotWant(t, dst.Universe(), uint(20))
+
+	dst = NewSparseSet(10)
+	test.GotWantError(t, dst.UnmarshalBinary(outside), "invalid binary data: element 50 is outside the universe [0, 10)")
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements in dense order
+func TestSparseSet_WriteTo_ReadFrom(t *testing.T) {
+	src := NewSparseSet(10, 4, 0)
+	dst := NewSparseSet(10)
+	codectest.StreamRoundTrip(t, src, dst, func(s *SparseSet) []uint { return slices.Collect(s.All()) })
+}
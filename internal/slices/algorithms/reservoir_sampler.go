@@ -0,0 +1,148 @@
+package algorithms
+
+import (
+	"math/rand/v2"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// ReservoirSampler maintains a uniform random sample of k values from a
+// stream of unknown length.
+//
+// The first k values fill the reservoir. After that, the n-th value
+// replaces a random slot with probability k/n, which keeps every value
+// seen so far in the sample with the same probability k/n (Algorithm R).
+// Only the reservoir is stored, so arbitrarily long streams are sampled in
+// O(k) memory without knowing their length in advance.
+//
+// Samplers fed by parallel streams can be combined with Merge into a
+// sample of the concatenated stream, so a stream can be split across
+// goroutines with one sampler each.
+//
+// Design decisions:
+//   - Caller-provided source: A seeded source makes the sample reproducible
+//   - Copying Sample: The reservoir cannot be modified from outside
+//   - Weighted merge: Merging draws from each reservoir in proportion to
+//     the length of its stream, preserving uniformity
+//
+// Not safe for concurrent use; give each goroutine its own sampler and
+// merge them afterwards.
+//
+// Space complexity: O(k)
+type ReservoirSampler[T any] struct {
+	sample []T
+	k      int
+	seen   int // Number of values added to the stream
+	rand   *rand.Rand
+}
+
+// NewReservoirSampler creates a sampler keeping k values, drawing its
+// randomness from src.
+//
+// Panics if k is not greater than 0.
+//
+// Example:
+//
+//	s := NewReservoirSampler[string](10, rand.NewPCG(1, 2))
+//	for line := range lines {
+//	    s.Add(line)
+//	}
+//	s.Sample()  // 10 lines, each equally likely
+func NewReservoirSampler[T any](k int, src rand.Source) *ReservoirSampler[T] {
+	panics.RequireGreaterThan(k, 0, "sample size")
+	return &ReservoirSampler[T]{
+		sample: make([]T, 0, k),
+		k:      k,
+		rand:   rand.New(src),
+	}
+}
+
+// Add offers the next value of the stream to the sample.
+//
+// Time complexity: O(1)
+func (s *ReservoirSampler[T]) Add(value T) {
+	s.seen++
+	if len(s.sample) < s.k {
+		s.sample = append(s.sample, value)
+		return
+	}
+
+	if i := s.rand.IntN(s.seen); i < s.k {
+		s.sample[i] = value
+	}
+}
+
+// Merge combines the other sampler into the receiver, which afterwards
+// holds a uniform sample of both streams as if they had been added to one
+// sampler. The other sampler is not modified.
+//
+// Each slot of the merged sample is drawn from the reservoir of one stream
+// with probability proportional to the number of that stream's values not
+// yet drawn, which is how sampling the combined stream directly would
+// pick it.
+//
+// Time complexity: O(k)
+//
+// Panics if the samplers keep a different number of values.
+//
+// Example:
+//
+//	a := NewReservoirSampler[int](5, rand.NewPCG(1, 1))
+//	b := NewReservoirSampler[int](5, rand.NewPCG(2, 2))
+//	// Feed a and b from two goroutines, then:
+//	a.Merge(b)
+//	a.Sample()  // Uniform over both streams
+func (s *ReservoirSampler[T]) Merge(other *ReservoirSampler[T]) {
+	panics.RequireEqualTo(other.k, s.k, "merged sample size")
+
+	mine, theirs := s.sample, append([]T(nil), other.sample...)
+	restMine, restTheirs := s.seen, other.seen
+	merged := make([]T, 0, s.k)
+
+	// Takes a random value out of the reservoir
+	take := func(pool []T) (T, []T) {
+		i := s.rand.IntN(len(pool))
+		value := pool[i]
+		last := len(pool) - 1
+		pool[i] = pool[last]
+		return value, pool[:last]
+	}
+
+	var value T
+	for len(merged) < s.k && restMine+restTheirs > 0 {
+		if s.rand.IntN(restMine+restTheirs) < restMine {
+			value, mine = take(mine)
+			restMine--
+		} else {
+			value, theirs = take(theirs)
+			restTheirs--
+		}
+		merged = append(merged, value)
+	}
+
+	s.sample = merged
+	s.seen += other.seen
+}
+
+// Sample returns a copy of the current sample: all values if fewer than k
+// were added, otherwise k of them in no particular order.
+//
+// Time complexity: O(k)
+func (s *ReservoirSampler[T]) Sample() []T {
+	return append([]T(nil), s.sample...)
+}
+
+// Seen returns the number of values added to the stream, including those
+// merged from other samplers.
+//
+// Time complexity: O(1)
+func (s *ReservoirSampler[T]) Seen() int {
+	return s.seen
+}
+
+// Size returns the number of values currently in the sample.
+//
+// Time complexity: O(1)
+func (s *ReservoirSampler[T]) Size() int {
+	return len(s.sample)
+}
@@ -0,0 +1,153 @@
+package algorithms
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// NewReservoirSampler:
+//  ✓ Non-positive sample size
+//
+// Add/Sample/Seen/Size:
+//  ✓ Fewer values than the sample size are all kept
+//  ✓ Sample size is capped, values come from the stream
+//  ✓ Sample is a copy
+//  ✓ Every value equally likely to be sampled
+//
+// Merge:
+//  ✓ Different sample sizes
+//  ✓ Merging short streams keeps every value
+//  ✓ Other sampler is not modified
+//  ✓ Every value of both streams equally likely to be sampled
+
+// Verifies a non-positive sample size panics
+func TestReservoirSampler_NewReservoirSampler_InvalidSize(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewReservoirSampler[int](0, rand.NewPCG(1, 1))
+	}, `"sample size" must be > 0, got 0`)
+}
+
+// Verifies a stream shorter than the sample size is kept whole
+func TestReservoirSampler_Add_ShortStream(t *testing.T) {
+	s := NewReservoirSampler[int](5, rand.NewPCG(1, 1))
+	test.GotWant(t, s.Size(), 0)
+	for i := range 3 {
+		s.Add(i)
+	}
+
+	test.GotWantSlice(t, s.Sample(), []int{0, 1, 2})
+	test.GotWant(t, s.Seen(), 3)
+	test.GotWant(t, s.Size(), 3)
+}
+
+// Verifies the sample size is capped and holds distinct stream values
+func TestReservoirSampler_Add_LongStream(t *testing.T) {
+	s := NewReservoirSampler[int](5, rand.NewPCG(2, 2))
+	for i := range 1000 {
+		s.Add(i)
+	}
+	test.GotWant(t, s.Seen(), 1000)
+	test.GotWant(t, s.Size(), 5)
+
+	sample := s.Sample()
+	slices.Sort(sample)
+	test.GotWant(t, len(slices.Compact(sample)), 5)
+	for _, v := range sample {
+		test.GotWant(t, v >= 0 && v < 1000, true)
+	}
+}
+
+// Verifies modifying the returned sample does not affect the sampler
+func TestReservoirSampler_Sample_Copy(t *testing.T) {
+	s := NewReservoirSampler[int](2, rand.NewPCG(3, 3))
+	s.Add(1)
+	s.Add(2)
+	s.Sample()[0] = 99
+	test.GotWantSlice(t, s.Sample(), []int{1, 2})
+}
+
+// Verifies every value of the stream is sampled about equally often
+func TestReservoirSampler_Add_Uniform(t *testing.T) {
+	src := rand.NewPCG(4, 4)
+	counts := make([]int, 10)
+	const trials = 20_000
+	for range trials {
+		s := NewReservoirSampler[int](3, src)
+		for i := range 10 {
+			s.Add(i)
+		}
+		for _, v := range s.Sample() {
+			counts[v]++
+		}
+	}
+
+	for v, count := range counts {
+		// Expected 6000 each; 5 standard deviations is about 320
+		if count < 5600 || count > 6400 {
+			t.Errorf("value %d sampled %d times, want about %d", v, count, trials*3/10)
+		}
+	}
+}
+
+// Verifies merging samplers of different sizes panics
+func TestReservoirSampler_Merge_DifferentSizes(t *testing.T) {
+	a := NewReservoirSampler[int](3, rand.NewPCG(1, 1))
+	b := NewReservoirSampler[int](2, rand.NewPCG(1, 1))
+	test.GotWantPanic(t, func() { a.Merge(b) }, `"merged sample size" must be == 3, got 2`)
+}
+
+// Verifies merging short streams keeps every value and leaves the other
+// sampler unchanged
+func TestReservoirSampler_Merge_ShortStreams(t *testing.T) {
+	a := NewReservoirSampler[int](5, rand.NewPCG(5, 5))
+	b := NewReservoirSampler[int](5, rand.NewPCG(6, 6))
+	a.Add(1)
+	a.Add(2)
+	b.Add(3)
+	b.Add(4)
+
+	a.Merge(b)
+	test.GotWant(t, a.Seen(), 4)
+	sample := a.Sample()
+	slices.Sort(sample)
+	test.GotWantSlice(t, sample, []int{1, 2, 3, 4})
+
+	test.GotWant(t, b.Seen(), 2)
+	test.GotWantSlice(t, b.Sample(), []int{3, 4})
+}
+
+// Verifies every value of two streams of different lengths is sampled
+// about equally often after merging
+func TestReservoirSampler_Merge_Uniform(t *testing.T) {
+	src := rand.NewPCG(7, 7)
+	counts := make([]int, 12)
+	const trials = 20_000
+	for range trials {
+		a := NewReservoirSampler[int](3, src)
+		b := NewReservoirSampler[int](3, src)
+		for i := range 4 {
+			a.Add(i)
+		}
+		for i := 4; i < 12; i++ {
+			b.Add(i)
+		}
+
+		a.Merge(b)
+		test.GotWant(t, a.Size(), 3)
+		for _, v := range a.Sample() {
+			counts[v]++
+		}
+	}
+
+	for v, count := range counts {
+		// Expected 5000 each; 5 standard deviations is about 300
+		if count < 4650 || count > 5350 {
+			t.Errorf("value %d sampled %d times, want about %d", v, count, trials*3/12)
+		}
+	}
+}
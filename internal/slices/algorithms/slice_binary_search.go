@@ -0,0 +1,111 @@
+package algorithms
+
+// LowerBound returns the index of the first element of a sorted slice that
+// is not less than the target, or len(data) if there is none.
+//
+// The slice must be sorted in ascending order according to less, which
+// must be a strict weak ordering such as cmp.Less. The result is the
+// position at which the target would be inserted before any equal
+// elements, so data[:i] holds exactly the elements less than the target.
+//
+// Parameters:
+//   - data: The sorted slice to search
+//   - target: The value to search for
+//   - less: Reports whether a sorts before b
+//
+// Time complexity: O(log n)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	data := []int{1, 3, 3, 5}
+//	LowerBound(data, 3, cmp.Less[int])  // Returns 1
+//	LowerBound(data, 4, cmp.Less[int])  // Returns 3
+//	LowerBound(data, 9, cmp.Less[int])  // Returns 4
+func LowerBound[T any](data []T, target T, less func(a, b T) bool) int {
+	lo, hi := 0, len(data)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1) // Avoids overflow
+		if less(data[mid], target) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return lo
+}
+
+// UpperBound returns the index of the first element of a sorted slice that
+// is greater than the target, or len(data) if there is none.
+//
+// The result is the position at which the target would be inserted after
+// any equal elements, so data[:i] holds exactly the elements not greater
+// than the target. See LowerBound for the requirements on data and less.
+//
+// Time complexity: O(log n)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	data := []int{1, 3, 3, 5}
+//	UpperBound(data, 3, cmp.Less[int])  // Returns 3
+//	UpperBound(data, 0, cmp.Less[int])  // Returns 0
+func UpperBound[T any](data []T, target T, less func(a, b T) bool) int {
+	lo, hi := 0, len(data)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1) // Avoids overflow
+		if less(target, data[mid]) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return lo
+}
+
+// EqualRange returns the half-open range [start, end) of the elements of a
+// sorted slice that are equal to the target, that is neither less nor
+// greater. The range is empty, with start == end at the insertion point,
+// if there are none. See LowerBound for the requirements on data and less.
+//
+// Time complexity: O(log n)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	data := []int{1, 3, 3, 5}
+//	EqualRange(data, 3, cmp.Less[int])  // Returns 1, 3
+//	EqualRange(data, 4, cmp.Less[int])  // Returns 3, 3
+func EqualRange[T any](data []T, target T, less func(a, b T) bool) (start int, end int) {
+	start = LowerBound(data, target, less)
+	end = start + UpperBound(data[start:], target, less)
+	return start, end
+}
+
+// BinarySearch searches a sorted slice for the target and returns the
+// index of the first equal element and true, or the index at which the
+// target would be inserted and false. See LowerBound for the requirements
+// on data and less.
+//
+// Unlike slices.BinarySearch it accepts any element type, and unlike
+// slices.BinarySearchFunc it takes the same less function used to sort
+// the slice.
+//
+// Time complexity: O(log n)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	data := []int{1, 3, 3, 5}
+//	BinarySearch(data, 3, cmp.Less[int])  // Returns 1, true
+//	BinarySearch(data, 4, cmp.Less[int])  // Returns 3, false
+func BinarySearch[T any](data []T, target T, less func(a, b T) bool) (int, bool) {
+	i := LowerBound(data, target, less)
+	return i, i < len(data) && !less(target, data[i])
+}
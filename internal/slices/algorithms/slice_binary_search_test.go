@@ -0,0 +1,104 @@
+package algorithms
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// LowerBound/UpperBound/EqualRange/BinarySearch:
+//  ✓ Empty slice
+//  ✓ Target below, between, at and above the elements
+//  ✓ Runs of equal elements
+//  ✓ Custom order (descending, by key)
+//  ✓ Randomized slices match a linear scan
+
+// Verifies the bounds on fixed slices and targets
+func TestBinarySearch_Bounds(t *testing.T) {
+	cases := []struct {
+		name   string
+		data   []int
+		target int
+		lower  int
+		upper  int
+	}{
+		{name: "empty_slice", data: []int{}, target: 1, lower: 0, upper: 0},
+		{name: "below", data: []int{2, 4, 6}, target: 1, lower: 0, upper: 0},
+		{name: "between", data: []int{2, 4, 6}, target: 5, lower: 2, upper: 2},
+		{name: "at_single", data: []int{2, 4, 6}, target: 4, lower: 1, upper: 2},
+		{name: "above", data: []int{2, 4, 6}, target: 7, lower: 3, upper: 3},
+		{name: "run_of_equal", data: []int{1, 3, 3, 3, 5}, target: 3, lower: 1, upper: 4},
+		{name: "all_equal", data: []int{3, 3, 3}, target: 3, lower: 0, upper: 3},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			test.GotWant(t, LowerBound(c.data, c.target, cmp.Less[int]), c.lower)
+			test.GotWant(t, UpperBound(c.data, c.target, cmp.Less[int]), c.upper)
+
+			start, end := EqualRange(c.data, c.target, cmp.Less[int])
+			test.GotWant(t, start, c.lower)
+			test.GotWant(t, end, c.upper)
+
+			i, found := BinarySearch(c.data, c.target, cmp.Less[int])
+			test.GotWant(t, i, c.lower)
+			test.GotWant(t, found, c.upper > c.lower)
+		})
+	}
+}
+
+// Verifies the search follows the order of the less function
+func TestBinarySearch_CustomOrder(t *testing.T) {
+	desc := []int{9, 7, 7, 3}
+	greater := func(a, b int) bool { return a > b }
+	start, end := EqualRange(desc, 7, greater)
+	test.GotWant(t, start, 1)
+	test.GotWant(t, end, 3)
+	i, found := BinarySearch(desc, 5, greater)
+	test.GotWant(t, i, 3)
+	test.GotWant(t, found, false)
+
+	type entry struct {
+		key   int
+		value string
+	}
+	entries := []entry{{1, "a"}, {2, "b"}, {2, "c"}, {4, "d"}}
+	byKey := func(a, b entry) bool { return a.key < b.key }
+	i, found = BinarySearch(entries, entry{key: 2}, byKey)
+	test.GotWant(t, found, true)
+	test.GotWant(t, entries[i].value, "b")
+	test.GotWant(t, UpperBound(entries, entry{key: 2}, byKey), 3)
+}
+
+// Verifies random slices and targets against a linear scan
+func TestBinarySearch_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(51, 51))
+	for range 500 {
+		data := make([]int, r.IntN(20))
+		for i := range data {
+			data[i] = r.IntN(10)
+		}
+		slices.Sort(data)
+
+		target := r.IntN(12) - 1
+		lower := 0
+		for lower < len(data) && data[lower] < target {
+			lower++
+		}
+		upper := lower
+		for upper < len(data) && data[upper] == target {
+			upper++
+		}
+
+		test.GotWant(t, LowerBound(data, target, cmp.Less[int]), lower)
+		test.GotWant(t, UpperBound(data, target, cmp.Less[int]), upper)
+		i, found := BinarySearch(data, target, cmp.Less[int])
+		test.GotWant(t, i, lower)
+		test.GotWant(t, found, upper > lower)
+	}
+}
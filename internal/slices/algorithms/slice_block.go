@@ -0,0 +1,77 @@
+package algorithms
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
+
+// MoveRange moves the n elements starting at index from so they start at
+// index to, like memmove: when the two ranges overlap, the destination
+// still receives the original contents of the source. Source elements
+// outside the destination keep their values; callers that abandon those
+// slots should clear them to help GC.
+//
+// Parameters:
+//   - data: The slice containing both ranges
+//   - from: Index of the first element to move
+//   - to: Index the first element is moved to
+//   - n: Number of elements to move
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(1)
+//
+// Panics if a range is invalid:
+//   - n < 0
+//   - from < 0 or from+n > len(data)
+//   - to < 0 or to+n > len(data)
+//
+// Example:
+//
+//	data := []int{0, 0, 1, 2, 3, 0}
+//	MoveRange(data, 2, 0, 3)  // data is [1, 2, 3, 2, 3, 0]
+//	MoveRange(data, 0, 1, 3)  // data is [1, 1, 2, 3, 3, 0]
+func MoveRange[T any](data []T, from int, to int, n int) {
+	panics.RequireNonNegative(n, "count")
+	panics.RequireNonNegative(from, "source index")
+	panics.RequireLessThanOrEqualTo(from+n, len(data), "source end index")
+	panics.RequireNonNegative(to, "destination index")
+	panics.RequireLessThanOrEqualTo(to+n, len(data), "destination end index")
+
+	// The built-in copy handles overlapping ranges like memmove
+	copy(data[to:to+n], data[from:from+n])
+}
+
+// BlockSwap exchanges the n elements starting at index a with the n
+// elements starting at index b, element by element, without a buffer.
+// The blocks must not overlap.
+//
+// Parameters:
+//   - data: The slice containing both blocks
+//   - a: Index of the first element of one block
+//   - b: Index of the first element of the other block
+//   - n: Number of elements in each block
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(1)
+//
+// Panics if a block is invalid or the blocks overlap:
+//   - n < 0
+//   - a < 0 or a+n > len(data)
+//   - b < 0 or b+n > len(data)
+//   - n > |a-b|
+//
+// Example:
+//
+//	data := []int{1, 2, 3, 4, 5, 6}
+//	BlockSwap(data, 0, 4, 2)  // data is [5, 6, 3, 4, 1, 2]
+func BlockSwap[T any](data []T, a int, b int, n int) {
+	panics.RequireNonNegative(n, "block size")
+	panics.RequireNonNegative(a, "first block index")
+	panics.RequireLessThanOrEqualTo(a+n, len(data), "first block end index")
+	panics.RequireNonNegative(b, "second block index")
+	panics.RequireLessThanOrEqualTo(b+n, len(data), "second block end index")
+	panics.RequireLessThanOrEqualTo(n, max(a-b, b-a), "block size")
+
+	for i := range n {
+		data[a+i], data[b+i] = data[b+i], data[a+i]
+	}
+}
@@ -0,0 +1,122 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// MoveRange:
+//  ✓ Invalid ranges (panic)
+//  ✓ Zero count
+//  ✓ Disjoint ranges
+//  ✓ Overlapping move to the left
+//  ✓ Overlapping move to the right
+//  ✓ Same position
+//
+// BlockSwap:
+//  ✓ Invalid blocks (panic)
+//  ✓ Overlapping blocks (panic)
+//  ✓ Zero size
+//  ✓ Adjacent blocks
+//  ✓ Distant blocks in either order
+
+// Verifies that MoveRange panics with appropriate error messages for invalid ranges
+func TestMoveRange_InvalidArgs(t *testing.T) {
+	cases := []struct {
+		name string
+		from int
+		to   int
+		n    int
+		want string
+	}{
+		{"negative_count", 0, 1, -1, `"count" must be >= 0, got -1`},
+		{"negative_source", -1, 0, 2, `"source index" must be >= 0, got -1`},
+		{"source_past_end", 3, 0, 3, `"source end index" must be <= 5, got 6`},
+		{"negative_destination", 0, -2, 2, `"destination index" must be >= 0, got -2`},
+		{"destination_past_end", 0, 4, 2, `"destination end index" must be <= 5, got 6`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data := []int{1, 2, 3, 4, 5}
+			test.GotWantPanic(t, func() { MoveRange(data, c.from, c.to, c.n) }, c.want)
+		})
+	}
+}
+
+// Verifies that MoveRange moves elements like memmove
+func TestMoveRange(t *testing.T) {
+	cases := []struct {
+		name string
+		from int
+		to   int
+		n    int
+		want []int
+	}{
+		{"zero_count", 0, 3, 0, []int{1, 2, 3, 4, 5, 6}},
+		{"disjoint", 0, 4, 2, []int{1, 2, 3, 4, 1, 2}},
+		{"overlap_left", 2, 0, 4, []int{3, 4, 5, 6, 5, 6}},
+		{"overlap_right", 0, 2, 4, []int{1, 2, 1, 2, 3, 4}},
+		{"same_position", 1, 1, 4, []int{1, 2, 3, 4, 5, 6}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data := []int{1, 2, 3, 4, 5, 6}
+			MoveRange(data, c.from, c.to, c.n)
+			test.GotWantSlice(t, data, c.want)
+		})
+	}
+}
+
+// Verifies that BlockSwap panics with appropriate error messages for invalid blocks
+func TestBlockSwap_InvalidArgs(t *testing.T) {
+	cases := []struct {
+		name string
+		a    int
+		b    int
+		n    int
+		want string
+	}{
+		{"negative_size", 0, 2, -1, `"block size" must be >= 0, got -1`},
+		{"negative_first", -1, 2, 1, `"first block index" must be >= 0, got -1`},
+		{"first_past_end", 4, 0, 2, `"first block end index" must be <= 5, got 6`},
+		{"negative_second", 0, -3, 1, `"second block index" must be >= 0, got -3`},
+		{"second_past_end", 0, 3, 3, `"second block end index" must be <= 5, got 6`},
+		{"overlapping", 0, 1, 2, `"block size" must be <= 1, got 2`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data := []int{1, 2, 3, 4, 5}
+			test.GotWantPanic(t, func() { BlockSwap(data, c.a, c.b, c.n) }, c.want)
+		})
+	}
+}
+
+// Verifies that BlockSwap exchanges the blocks
+func TestBlockSwap(t *testing.T) {
+	cases := []struct {
+		name string
+		a    int
+		b    int
+		n    int
+		want []int
+	}{
+		{"zero_size", 0, 0, 0, []int{1, 2, 3, 4, 5, 6}},
+		{"adjacent", 0, 3, 3, []int{4, 5, 6, 1, 2, 3}},
+		{"distant", 0, 4, 2, []int{5, 6, 3, 4, 1, 2}},
+		{"reversed_order", 4, 1, 2, []int{1, 5, 6, 4, 2, 3}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data := []int{1, 2, 3, 4, 5, 6}
+			BlockSwap(data, c.a, c.b, c.n)
+			test.GotWantSlice(t, data, c.want)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package algorithms
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Chunk returns an iterator over consecutive batches of size elements of
+// the slice. Every batch is full except possibly the last, which holds the
+// remaining elements. An empty slice yields no batches.
+//
+// Each batch is a fresh copy, so it can be kept, modified or handed to
+// another goroutine, for example through a queue, without affecting data
+// or the other batches. Use ChunkView to avoid the copies when batches are
+// consumed immediately.
+//
+// Parameters:
+//   - data: The slice to split
+//   - size: The number of elements per batch, greater than 0
+//
+// Time complexity: O(n) for a full iteration
+//
+// Space complexity: O(size) per batch
+//
+// Panics if size is not greater than 0.
+//
+// Example:
+//
+//	q := queues.NewSliceQueue[[]int]()
+//	for batch := range Chunk([]int{1, 2, 3, 4, 5}, 2) {
+//	    q.Enqueue(batch)  // [1 2], [3 4], [5]
+//	}
+func Chunk[T any](data []T, size int) iter.Seq[[]T] {
+	panics.RequireGreaterThan(size, 0, "chunk size")
+	return func(yield func([]T) bool) {
+		for batch := range ChunkView(data, size) {
+			if !yield(append([]T(nil), batch...)) {
+				return
+			}
+		}
+	}
+}
+
+// ChunkView returns an iterator over consecutive batches of size elements
+// of the slice, like Chunk, but yields subslices of data instead of copies.
+//
+// The batches share memory with data: writes through a batch change data
+// and the other way around. Their capacity is clipped to their length, so
+// appending to a batch reallocates it instead of overwriting the next one.
+//
+// Time complexity: O(n/size) for a full iteration
+//
+// Space complexity: O(1)
+//
+// Panics if size is not greater than 0.
+//
+// Example:
+//
+//	sum := 0
+//	for batch := range ChunkView(values, 1024) {
+//	    sum += process(batch)  // No copying
+//	}
+func ChunkView[T any](data []T, size int) iter.Seq[[]T] {
+	panics.RequireGreaterThan(size, 0, "chunk size")
+	return func(yield func([]T) bool) {
+		for start := 0; start < len(data); start += size {
+			end := min(start+size, len(data))
+			if !yield(data[start:end:end]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package algorithms
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// Chunk/ChunkView:
+//  ✓ Non-positive chunk size
+//  ✓ Empty slice
+//  ✓ Exact multiple and remainder
+//  ✓ Chunk size larger than the slice
+//  ✓ Early termination
+//
+// Chunk:
+//  ✓ Batches are independent copies
+//
+// ChunkView:
+//  ✓ Batches share memory, appends do not overwrite
+
+// Chunking algorithms under test.
+var chunkFuncs = map[string]func([]int, int) iter.Seq[[]int]{
+	"Chunk":     Chunk[int],
+	"ChunkView": ChunkView[int],
+}
+
+// Collects the batches yielded by the iterator.
+func collectChunks(seq iter.Seq[[]int]) [][]int {
+	batches := [][]int{}
+	for batch := range seq {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// Verifies both variants panic for a non-positive chunk size
+func TestChunk_InvalidSize(t *testing.T) {
+	for name, chunk := range chunkFuncs {
+		t.Run(name, func(t *testing.T) {
+			test.GotWantPanic(t, func() {
+				chunk([]int{1}, 0)
+			}, `"chunk size" must be > 0, got 0`)
+		})
+	}
+}
+
+// Verifies the batches on fixed inputs
+func TestChunk_Inputs(t *testing.T) {
+	cases := []struct {
+		name string
+		data []int
+		size int
+		want [][]int
+	}{
+		{name: "empty", data: []int{}, size: 2, want: [][]int{}},
+		{name: "exact_multiple", data: []int{1, 2, 3, 4}, size: 2, want: [][]int{{1, 2}, {3, 4}}},
+		{name: "remainder", data: []int{1, 2, 3, 4, 5}, size: 2, want: [][]int{{1, 2}, {3, 4}, {5}}},
+		{name: "size_one", data: []int{1, 2}, size: 1, want: [][]int{{1}, {2}}},
+		{name: "larger_than_slice", data: []int{1, 2}, size: 5, want: [][]int{{1, 2}}},
+	}
+
+	for name, chunk := range chunkFuncs {
+		for _, c := range cases {
+			t.Run(name+"/"+c.name, func(t *testing.T) {
+				test.GotWantDeep(t, collectChunks(chunk(c.data, c.size)), c.want)
+			})
+		}
+	}
+}
+
+// Verifies both variants stop when the consumer breaks
+func TestChunk_EarlyTermination(t *testing.T) {
+	for name, chunk := range chunkFuncs {
+		t.Run(name, func(t *testing.T) {
+			count := 0
+			for range chunk([]int{1, 2, 3, 4, 5}, 2) {
+				count++
+				break
+			}
+			test.GotWant(t, count, 1)
+		})
+	}
+}
+
+// Verifies Chunk batches do not share memory with the slice
+func TestChunk_Copies(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+	batches := collectChunks(Chunk(data, 2))
+	batches[0][0] = 10
+	data[2] = 30
+
+	test.GotWantSlice(t, data, []int{1, 2, 30, 4})
+	test.GotWantDeep(t, batches, [][]int{{10, 2}, {3, 4}})
+}
+
+// Verifies ChunkView batches share memory and appends do not overwrite the
+// next batch
+func TestChunkView_Shared(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+	batches := collectChunks(ChunkView(data, 2))
+	batches[0][0] = 10
+	test.GotWant(t, data[0], 10)
+
+	test.GotWant(t, cap(batches[0]), 2)
+	_ = append(batches[0], 99)
+	test.GotWantSlice(t, data, []int{10, 2, 3, 4, 5})
+}
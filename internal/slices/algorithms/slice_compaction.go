@@ -5,6 +5,7 @@ import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
 // Controls when and how to compact a slice-based data structure.
 type SliceCompactionParams struct {
 	UsedStart    int // Index of first used element
+	UsedEnd      int // Exclusive index of last used element (0 means the end of the slice)
 	MinSize      int // Minimum used size to trigger compaction (0 means always compact if waste threshold is met)
 	WastePercent int // Compact if waste >= this percent (0-100)
 }
@@ -13,16 +14,23 @@ type SliceCompactionParams struct {
 //
 // Panics if parameters are invalid:
 //   - UsedStart outside [0, length)
+//   - UsedEnd outside (UsedStart, length], unless 0
 //   - MinSize < 0
 //   - WastePercent outside [0, 100]
 //
-// Special case: For empty slices (length=0), requires UsedStart=0.
+// Special case: For empty slices (length=0), requires UsedStart=0 & UsedEnd=0.
 func (p *SliceCompactionParams) validate(length int) {
 	panics.RequireNonNegative(p.UsedStart, "start index")
+	panics.RequireNonNegative(p.UsedEnd, "end index")
 	if length > 0 {
 		panics.RequireLessThan(p.UsedStart, length, "start index")
+		if p.UsedEnd > 0 {
+			panics.RequireLessThan(p.UsedStart, p.UsedEnd, "start index")
+			panics.RequireLessThanOrEqualTo(p.UsedEnd, length, "end index")
+		}
 	} else {
 		panics.RequireEqualTo(p.UsedStart, length, "start index")
+		panics.RequireEqualTo(p.UsedEnd, length, "end index")
 	}
 	panics.RequireNonNegative(p.MinSize, "min compaction trigger size")
 	panics.RequireNonNegative(p.WastePercent, "waste percent")
@@ -31,12 +39,18 @@ func (p *SliceCompactionParams) validate(length int) {
 
 // Compact shifts elements to the beginning of the slice to reclaim wasted capacity.
 //
+// The used elements are data[UsedStart:UsedEnd]. Structures that only
+// remove from the front, like queues, leave UsedEnd at 0, which stands for
+// the end of the slice. Structures that remove from both ends, like
+// deques, set UsedEnd as well, and the waste at the front and back is
+// reclaimed in the same pass.
+//
 // Compaction occurs when ALL conditions are met:
 //   - Used size >= MinSize (avoid expensive compaction on small ranges)
 //   - Waste percent >= WastePercent (enough waste to justify cost)
-//   - UsedStart > 0 (not already at beginning)
+//   - UsedStart > 0 or UsedEnd < length (there is waste to reclaim)
 //
-// If compaction occurs, elements at [UsedStart:length] are moved to [0:used],
+// If compaction occurs, elements at [UsedStart:UsedEnd] are moved to [0:used],
 // the resliced data[:used] and the new start index are returned.
 // Otherwise, the original data and start index are returned.
 //
@@ -73,27 +87,93 @@ func (p *SliceCompactionParams) validate(length int) {
 //	// Result: data = [1, 2, 3]  // Re-sliced to used size
 //	//         start = 0
 //
+//	// Deque after removals at both ends
+//	// wasted: 3 + 2, used: 3, length: 8
+//	data := [_, _, _, 1, 2, 3, _, _]
+//	params := SliceCompactionParams{
+//	  UsedStart:    3,
+//	  UsedEnd:      6,
+//	  WastePercent: 50,
+//	}
+//
+//	// Waste: 5/8 = 63% >= 50% => compaction triggered
+//	data, start := Compact(data, params)
+//	// Result: data = [1, 2, 3], start = 0
+//
 // Use cases:
 //   - Slice-based queues (elements removed from front)
-//   - Slice-based deques (elements removed from front & back)
+//   - Slice-based deques (elements removed from front & back, set UsedEnd)
 //   - Any structure with sliding window over slice
 func Compact[T any](data []T, p SliceCompactionParams) (cData []T, start int) {
+	cData, start, _ = CompactWithStats(data, p)
+	return cData, start
+}
+
+// TryCompact is Compact that returns an error instead of panicking when
+// the parameters are invalid, for callers such as servers that must not
+// crash on a bad request. The error wraps ErrInvalidParams and
+// names the offending parameter. On error, the original data and
+// UsedStart are returned.
+//
+// Time complexity: Same as Compact
+//
+// Example:
+//
+//	data, start, err := TryCompact(data, SliceCompactionParams{UsedStart: -1})
+//	// err: invalid parameters: "start index" must be >= 0, got -1
+func TryCompact[T any](data []T, p SliceCompactionParams) (cData []T, start int, err error) {
+	if err := checkParams(func() { p.validate(len(data)) }); err != nil {
+		return data, p.UsedStart, err
+	}
+
+	cData, start = Compact(data, p)
+	return cData, start, nil
+}
+
+// CompactWithStats is Compact that also reports what happened. Waste is
+// measured relative to the length of the slice, which is what compaction
+// reclaims; the capacity never changes.
+//
+// Time complexity: Same as Compact
+//
+// Panics if parameters are invalid.
+//
+// Example:
+//
+//	data := [_, _, _, _, _, 1, 2, 3]
+//	data, start, stats := CompactWithStats(data, SliceCompactionParams{
+//	  UsedStart:    5,
+//	  WastePercent: 50,
+//	})
+//	// stats: Triggered=true, Moved=3, WasteBefore=63, WasteAfter=0
+func CompactWithStats[T any](data []T, p SliceCompactionParams) (cData []T, start int, stats SliceStats) {
 	length := len(data)
 	p.validate(length)
 
+	stats.CapBefore = cap(data)
+	stats.CapAfter = cap(data)
 	if length == 0 {
-		return data, 0
+		return data, 0, stats
+	}
+
+	end := p.UsedEnd
+	if end == 0 {
+		end = length
 	}
 
-	used := length - p.UsedStart
-	wastePercent := 100 - 100*used/length
+	used := end - p.UsedStart
+	stats.WasteBefore = wastePercent(used, length)
+	stats.WasteAfter = stats.WasteBefore
 	shouldCompact := used >= p.MinSize &&
-		wastePercent >= p.WastePercent &&
-		p.UsedStart > 0
+		stats.WasteBefore >= p.WastePercent &&
+		(p.UsedStart > 0 || end < length)
 	if shouldCompact {
-		copy(data, data[p.UsedStart:])
-		return data[:used], 0
+		MoveRange(data, p.UsedStart, 0, used)
+		stats.Triggered = true
+		stats.Moved = used
+		stats.WasteAfter = 0
+		return data[:used], 0, stats
 	}
 
-	return data, p.UsedStart
+	return data, p.UsedStart, stats
 }
@@ -0,0 +1,53 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Fuzzes Compact with arbitrary parameters through TryCompact. Invalid
+// parameters must be reported as errors, never as panics, and valid ones
+// must keep the used elements in order, either in place or moved to the
+// front.
+//
+// Run: go test ./internal/slices/algorithms -run '^$' -fuzz FuzzCompact
+func FuzzCompact(f *testing.F) {
+	f.Add(uint8(8), uint8(5), uint8(0), uint8(1), uint8(50))
+	f.Add(uint8(8), uint8(3), uint8(6), uint8(0), uint8(50))
+	f.Add(uint8(0), uint8(0), uint8(0), uint8(0), uint8(0))
+	f.Add(uint8(4), uint8(4), uint8(2), uint8(1), uint8(101))
+
+	f.Fuzz(func(t *testing.T, length uint8, start uint8, end uint8, minSize uint8, waste uint8) {
+		data := make([]int, length)
+		for i := range data {
+			data[i] = i
+		}
+		p := SliceCompactionParams{
+			UsedStart:    int(start),
+			UsedEnd:      int(end),
+			MinSize:      int(minSize),
+			WastePercent: int(waste),
+		}
+
+		cData, cStart, err := TryCompact(data, p)
+		if err != nil {
+			return
+		}
+
+		used := []int{}
+		if length > 0 {
+			if end == 0 {
+				end = length
+			}
+			for i := start; i < end; i++ {
+				used = append(used, int(i))
+			}
+		}
+		cEnd := cStart + len(used)
+		if cStart != 0 && cStart != int(start) {
+			t.Fatalf("start index %d, want 0 or %d", cStart, start)
+		}
+		test.GotWantSlice(t, cData[cStart:cEnd], used)
+	})
+}
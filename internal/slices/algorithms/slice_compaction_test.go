@@ -13,6 +13,10 @@ import (
 //  ✓ Start index equals length
 //  ✓ Start index greater than length
 //  ✓ Empty slice with nonzero start
+//  ✓ Negative end index
+//  ✓ End index not greater than start index
+//  ✓ End index greater than length
+//  ✓ Empty slice with nonzero end
 //  ✓ Negative min size
 //  ✓ Negative waste percent
 //  ✓ Waste percent greater than 100
@@ -27,6 +31,18 @@ import (
 //  ✓ Waste percent boundary
 //  ✓ Min size zero with waste above threshold
 //  ✓ Waste percent zero with any waste
+//  ✓ Back waste only, end at length
+//  ✓ Waste at both ends
+//  ✓ Waste at the back only
+//
+// CompactWithStats:
+//  ✓ Triggered reports moved elements and waste
+//  ✓ Not triggered reports unchanged waste
+//  ✓ Empty slice
+//
+// TryCompact:
+//  ✓ Invalid parameters return an error and the original data
+//  ✓ Valid parameters compact like Compact
 
 // Verifies that Compact panics with appropriate error messages for invalid parameters
 func TestCompact_InvalidArgs(t *testing.T) {
@@ -106,6 +122,30 @@ func TestCompact_InvalidArgs(t *testing.T) {
 			},
 			want: `"waste percent" must be <= 100, got 150`,
 		},
+		{
+			name: "negative_end_index",
+			data: []int{1, 2, 3},
+			p:    SliceCompactionParams{UsedStart: 0, UsedEnd: -1},
+			want: `"end index" must be >= 0, got -1`,
+		},
+		{
+			name: "end_index_not_greater_than_start_index",
+			data: []int{1, 2, 3},
+			p:    SliceCompactionParams{UsedStart: 2, UsedEnd: 2},
+			want: `"start index" must be < 2, got 2`,
+		},
+		{
+			name: "end_index_greater_than_length",
+			data: []int{1, 2, 3},
+			p:    SliceCompactionParams{UsedStart: 0, UsedEnd: 4},
+			want: `"end index" must be <= 3, got 4`,
+		},
+		{
+			name: "empty_slice_with_nonzero_end",
+			data: []int{},
+			p:    SliceCompactionParams{UsedStart: 0, UsedEnd: 1},
+			want: `"end index" must be == 0, got 1`,
+		},
 	}
 
 	for _, c := range cases {
@@ -247,6 +287,28 @@ func TestCompact_Triggered(t *testing.T) {
 			},
 			wantData: []int{1, 2, 3, 4},
 		},
+		{
+			name: "waste_at_both_ends",
+			data: []int{0, 0, 0, 1, 2, 3, 0, 0}, // length=8, used=3, waste=63%
+			p: SliceCompactionParams{
+				UsedStart:    3, // ✓ > 0
+				UsedEnd:      6, // ← Testing: back waste reclaimed too
+				MinSize:      1, // ✓ 3 >= 1
+				WastePercent: 50,
+			},
+			wantData: []int{1, 2, 3},
+		},
+		{
+			name: "waste_at_back_only",
+			data: []int{1, 2, 0, 0, 0}, // length=5, used=2, waste=60%
+			p: SliceCompactionParams{
+				UsedStart:    0, // ← Testing: no front waste
+				UsedEnd:      2, // ✓ < length
+				MinSize:      1,
+				WastePercent: 50,
+			},
+			wantData: []int{1, 2},
+		},
 	}
 
 	for _, c := range cases {
@@ -257,3 +319,68 @@ func TestCompact_Triggered(t *testing.T) {
 		})
 	}
 }
+
+// Verifies the stats reported by CompactWithStats
+func TestCompactWithStats(t *testing.T) {
+	cases := []struct {
+		name string
+		data []int
+		p    SliceCompactionParams
+		want SliceStats
+	}{
+		{
+			name: "triggered",
+			// len=8, used=3 (indices 5-8), waste=63%
+			data: []int{0, 0, 0, 0, 0, 1, 2, 3},
+			p:    SliceCompactionParams{UsedStart: 5, WastePercent: 50},
+			want: SliceStats{
+				Triggered:   true,
+				Moved:       3,
+				CapBefore:   8,
+				CapAfter:    8,
+				WasteBefore: 63,
+				WasteAfter:  0,
+			},
+		},
+		{
+			name: "not_triggered",
+			// len=8, used=6 (indices 2-8), waste=25%
+			data: []int{0, 0, 1, 2, 3, 4, 5, 6},
+			p:    SliceCompactionParams{UsedStart: 2, WastePercent: 50},
+			want: SliceStats{
+				CapBefore:   8,
+				CapAfter:    8,
+				WasteBefore: 25,
+				WasteAfter:  25,
+			},
+		},
+		{
+			name: "empty_slice",
+			data: []int{},
+			p:    SliceCompactionParams{},
+			want: SliceStats{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, _, stats := CompactWithStats(c.data, c.p)
+			test.GotWant(t, stats, c.want)
+		})
+	}
+}
+
+// Verifies that TryCompact returns errors instead of panicking
+func TestTryCompact(t *testing.T) {
+	data := []int{0, 0, 1, 2}
+	got, start, err := TryCompact(data, SliceCompactionParams{UsedStart: 4})
+	test.GotWantError(t, err, `invalid parameters: "start index" must be < 4, got 4`)
+	test.GotWantErrorIs(t, err, ErrInvalidParams)
+	test.GotWantSlice(t, got, data)
+	test.GotWant(t, start, 4)
+
+	got, start, err = TryCompact(data, SliceCompactionParams{UsedStart: 2, WastePercent: 50})
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, got, []int{1, 2})
+	test.GotWant(t, start, 0)
+}
@@ -0,0 +1,101 @@
+package algorithms
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
+
+// Controls when to halve the capacity of a slice-based data structure.
+type SliceHalvingParams struct {
+	UsedStart int // Index of first used element
+	UsedEnd   int // Exclusive index of last used element
+	MinSize   int // Minimum used size to trigger halving (0 means always halve if usage is low)
+}
+
+// Validates halving parameters against slice length.
+//
+// Panics if parameters are invalid:
+//   - UsedStart outside [0, UsedEnd)
+//   - UsedEnd outside [0, length)
+//   - MinSize < 0
+//
+// Special case: For empty slices (length=0), requires UsedStart=0 & UsedEnd=0.
+func (p *SliceHalvingParams) validate(length int) {
+	panics.RequireNonNegative(p.UsedStart, "start index")
+	panics.RequireNonNegative(p.UsedEnd, "end index")
+	if length > 0 {
+		panics.RequireLessThan(p.UsedStart, p.UsedEnd, "start index")
+		panics.RequireLessThanOrEqualTo(p.UsedEnd, length, "end index")
+	} else {
+		panics.RequireEqualTo(p.UsedStart, 0, "start index")
+		panics.RequireEqualTo(p.UsedEnd, 0, "end index")
+	}
+	panics.RequireNonNegative(p.MinSize, "min halving trigger size")
+}
+
+// Halve creates a new slice with half the capacity once usage drops below
+// a quarter of the capacity.
+//
+// This is the classic dynamic-array shrink rule: shrinking at 25% usage
+// to 50% capacity leaves the structure half full, so at least cap/4
+// further operations are needed before the next grow or shrink, which
+// keeps the amortized cost O(1). It is cheaper and more predictable than
+// the waste-buffer computation of Reallocate, at the cost of being
+// less tunable.
+//
+// Halving occurs when ALL conditions are met:
+//   - Used size >= MinSize (avoid expensive reallocation on small slices)
+//   - Used size < capacity / 4
+//   - Half the capacity is larger than the minimum practical capacity (10)
+//
+// If halving occurs, used elements are copied to the start of a new slice
+// with capacity cap/2. Otherwise, original slice and indices are returned
+// unchanged.
+//
+// Parameters:
+//   - data: The underlying slice to halve
+//   - p: Halving parameters controlling when to halve
+//
+// Returns:
+//   - hData: Halved slice (or original if no halving)
+//   - start: New start index (0 if halved, UsedStart otherwise)
+//   - end: New end index (len if halved, UsedEnd otherwise)
+//
+// Time complexity:
+//   - Best case: O(1) when no halving needed
+//   - Worst case: O(n) when halving occurs (n = used size)
+//
+// Space complexity:
+//   - O(1) when no halving
+//   - O(c) when halving occurs (new slice of half capacity c allocated)
+//
+// Panics if parameters are invalid.
+//
+// Example:
+//
+//	// Stack after many pops
+//	data := [1, 2, 3, _, ..., _]  // cap=40, used=3 (7.5% usage)
+//	hData, start, end := Halve(data, SliceHalvingParams{
+//	    UsedStart: 0,
+//	    UsedEnd:   3,
+//	    MinSize:   1,
+//	})
+//	// Result: hData [1, 2, 3, _, ..., _] with cap=20, start=0, end=3
+func Halve[T any](data []T, p SliceHalvingParams) (hData []T, start int, end int) {
+	length := len(data)
+	p.validate(length)
+
+	if length == 0 {
+		return data, 0, 0
+	}
+
+	used := p.UsedEnd - p.UsedStart
+	halfCapacity := cap(data) / 2
+	shouldHalve := used >= p.MinSize &&
+		4*used < cap(data) &&
+		halfCapacity > 10 // min practical capacity 10
+	if shouldHalve {
+		hData = make([]T, 0, halfCapacity)
+		hData = append(hData, data[p.UsedStart:p.UsedEnd]...)
+		return hData, 0, len(hData)
+	}
+
+	return data, p.UsedStart, p.UsedEnd
+}
@@ -0,0 +1,187 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// Halve:
+//  ✓ Negative start index
+//  ✓ Negative end index
+//  ✓ Start index greater than or equal to end index
+//  ✓ End index greater than length
+//  ✓ Empty slice with nonzero start
+//  ✓ Negative min size
+//  ✓ Empty slice
+//  ✓ Used size below min size
+//  ✓ Usage at a quarter of capacity
+//  ✓ Half capacity at minimum practical capacity
+//  ✓ Standard halving
+//  ✓ Halving with offset start
+
+// Verifies that Halve panics with appropriate error messages for invalid parameters
+func TestHalve_InvalidArgs(t *testing.T) {
+	cases := []struct {
+		name string
+		data []int
+		p    SliceHalvingParams
+		want string
+	}{
+		{
+			name: "negative_start_index",
+			data: []int{1, 2, 3},
+			p:    SliceHalvingParams{UsedStart: -1, UsedEnd: 3},
+			want: `"start index" must be >= 0, got -1`,
+		},
+		{
+			name: "negative_end_index",
+			data: []int{1, 2, 3},
+			p:    SliceHalvingParams{UsedStart: 0, UsedEnd: -1},
+			want: `"end index" must be >= 0, got -1`,
+		},
+		{
+			name: "start_index_greater_than_or_equal_to_end_index",
+			data: []int{1, 2, 3},
+			p:    SliceHalvingParams{UsedStart: 2, UsedEnd: 2},
+			want: `"start index" must be < 2, got 2`,
+		},
+		{
+			name: "end_index_greater_than_length",
+			data: []int{1, 2, 3},
+			p:    SliceHalvingParams{UsedStart: 0, UsedEnd: 5},
+			want: `"end index" must be <= 3, got 5`,
+		},
+		{
+			name: "empty_slice_with_nonzero_start",
+			data: []int{},
+			p:    SliceHalvingParams{UsedStart: 1, UsedEnd: 0},
+			want: `"start index" must be == 0, got 1`,
+		},
+		{
+			name: "negative_min_size",
+			data: []int{1, 2, 3},
+			p:    SliceHalvingParams{UsedStart: 0, UsedEnd: 3, MinSize: -5},
+			want: `"min halving trigger size" must be >= 0, got -5`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			test.GotWantPanic(t, func() {
+				Halve(c.data, c.p)
+			}, c.want)
+		})
+	}
+}
+
+// Verifies that Halve returns unchanged data when halving conditions are not met
+func TestHalve_NotTriggered(t *testing.T) {
+	cases := []struct {
+		name string
+		data []int
+		p    SliceHalvingParams
+	}{
+		{
+			name: "empty_slice",
+			data: []int{},
+			p:    SliceHalvingParams{},
+		},
+		{
+			name: "used_size_below_min_size",
+			// cap=100, used=2, usage=2%
+			data: make([]int, 10, 100),
+			p: SliceHalvingParams{
+				UsedStart: 0,
+				UsedEnd:   2,
+				MinSize:   5, // ← Testing: 2 < 5
+			},
+		},
+		{
+			name: "usage_at_quarter_capacity",
+			// cap=100, used=25, usage=25%
+			data: make([]int, 50, 100),
+			p: SliceHalvingParams{
+				UsedStart: 0,
+				UsedEnd:   25, // ← Testing: 25 is not < 100/4 (boundary)
+				MinSize:   1,
+			},
+		},
+		{
+			name: "half_capacity_at_minimum",
+			// cap=20, used=1, usage=5%
+			data: make([]int, 5, 20),
+			p: SliceHalvingParams{
+				UsedStart: 0,
+				UsedEnd:   1,
+				MinSize:   1, // ← Testing: 20/2 = 10 is not > 10
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data, start, end := Halve(c.data, c.p)
+			test.GotWant(t, cap(data), cap(c.data))
+			test.GotWantSlice(t, data, c.data)
+			test.GotWant(t, start, c.p.UsedStart)
+			test.GotWant(t, end, c.p.UsedEnd)
+		})
+	}
+}
+
+// Verifies that Halve shifts elements to the start of a slice with half the capacity
+func TestHalve_Triggered(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     []int
+		p        SliceHalvingParams
+		wantData []int
+		wantCap  int
+	}{
+		{
+			name: "standard_halving",
+			// cap=100, used=3 (indices 0-3), usage=3%
+			data: func() []int {
+				data := make([]int, 10, 100)
+				data[0], data[1], data[2] = 1, 2, 3
+				return data
+			}(),
+			p: SliceHalvingParams{
+				UsedStart: 0,
+				UsedEnd:   3,
+				MinSize:   1,
+			},
+			wantData: []int{1, 2, 3},
+			wantCap:  50,
+		},
+		{
+			name: "offset_start",
+			// cap=40, used=2 (indices 5-7), usage=5%
+			data: func() []int {
+				data := make([]int, 10, 40)
+				data[5], data[6] = 1, 2
+				return data
+			}(),
+			p: SliceHalvingParams{
+				UsedStart: 5,
+				UsedEnd:   7,
+				MinSize:   0,
+			},
+			wantData: []int{1, 2},
+			wantCap:  20,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data, start, end := Halve(c.data, c.p)
+			test.GotWantSlice(t, data, c.wantData)
+			test.GotWant(t, start, 0)
+			test.GotWant(t, end, len(c.wantData))
+			test.GotWant(t, cap(data), c.wantCap)
+		})
+	}
+}
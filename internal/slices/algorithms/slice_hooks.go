@@ -0,0 +1,40 @@
+package algorithms
+
+// SliceHooks holds optional callbacks that slice-backed structures invoke
+// when their storage changes, so applications can export capacity and
+// compaction metrics (to Prometheus or similar) without forking the
+// structure. Nil callbacks are skipped.
+//
+// Callbacks run synchronously inside the operation that caused the event,
+// so they should be cheap and must not modify the structure.
+//
+// Example:
+//
+//	q.SetHooks(algorithms.SliceHooks{
+//	    OnGrow:    func(oldCap, newCap int) { capacityGauge.Set(float64(newCap)) },
+//	    OnCompact: func(moved int) { compactions.Inc() },
+//	})
+type SliceHooks struct {
+	OnGrow    func(oldCap, newCap int) // Capacity increased
+	OnShrink  func(oldCap, newCap int) // Capacity decreased
+	OnCompact func(moved int)          // Elements shifted within the same storage
+}
+
+// Resized reports a capacity change from oldCap to newCap to OnGrow or
+// OnShrink. Reports nothing if the capacity is unchanged.
+func (h *SliceHooks) Resized(oldCap int, newCap int) {
+	switch {
+	case newCap > oldCap && h.OnGrow != nil:
+		h.OnGrow(oldCap, newCap)
+	case newCap < oldCap && h.OnShrink != nil:
+		h.OnShrink(oldCap, newCap)
+	}
+}
+
+// Compacted reports a compaction that moved the given number of elements
+// to OnCompact.
+func (h *SliceHooks) Compacted(moved int) {
+	if h.OnCompact != nil {
+		h.OnCompact(moved)
+	}
+}
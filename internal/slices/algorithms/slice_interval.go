@@ -0,0 +1,47 @@
+package algorithms
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/constraints"
+
+// Interval is the half-open range of values [Start, End).
+type Interval[T constraints.Ordered] struct {
+	Start T
+	End   T
+}
+
+// MergeIntervals coalesces intervals that overlap or touch, so that the
+// result covers exactly the same values with the fewest intervals, in
+// ascending order. Empty intervals, where Start >= End, cover nothing and
+// are dropped.
+//
+// The slice is sorted by start in place, then swept once: each interval
+// either extends the last merged interval, if it starts at or before its
+// end, or begins a new one. The merged intervals are written over the
+// front of the slice and returned as data[:k].
+//
+// Time complexity: O(n log n)
+//
+// Space complexity: O(log n)
+//
+// Example:
+//
+//	MergeIntervals([]Interval[int]{{8, 10}, {1, 3}, {2, 6}, {6, 7}})
+//	// Returns [{1, 7}, {8, 10}]
+func MergeIntervals[T constraints.Ordered](data []Interval[T]) []Interval[T] {
+	Sort(data, func(a, b Interval[T]) bool { return a.Start < b.Start })
+
+	k := 0
+	for _, interval := range data {
+		if interval.Start >= interval.End {
+			continue
+		}
+
+		if k > 0 && interval.Start <= data[k-1].End {
+			data[k-1].End = max(data[k-1].End, interval.End)
+		} else {
+			data[k] = interval
+			k++
+		}
+	}
+
+	return data[:k]
+}
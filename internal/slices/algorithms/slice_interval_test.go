@@ -0,0 +1,97 @@
+package algorithms
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// MergeIntervals:
+//  ✓ Empty slice
+//  ✓ Disjoint intervals sorted by start
+//  ✓ Overlapping intervals merged
+//  ✓ Touching intervals merged
+//  ✓ Contained intervals absorbed
+//  ✓ Empty intervals dropped
+//  ✓ Randomized: covers the same values as the input
+
+// Verifies that MergeIntervals coalesces intervals into the fewest sorted ones
+func TestMergeIntervals(t *testing.T) {
+	cases := []struct {
+		name string
+		data []Interval[int]
+		want []Interval[int]
+	}{
+		{
+			name: "empty_slice",
+			data: []Interval[int]{},
+			want: []Interval[int]{},
+		},
+		{
+			name: "disjoint",
+			data: []Interval[int]{{7, 9}, {0, 2}, {4, 5}},
+			want: []Interval[int]{{0, 2}, {4, 5}, {7, 9}},
+		},
+		{
+			name: "overlapping",
+			data: []Interval[int]{{8, 10}, {1, 3}, {2, 6}},
+			want: []Interval[int]{{1, 6}, {8, 10}},
+		},
+		{
+			name: "touching",
+			data: []Interval[int]{{5, 10}, {0, 5}, {10, 12}},
+			want: []Interval[int]{{0, 12}},
+		},
+		{
+			name: "contained",
+			data: []Interval[int]{{0, 10}, {2, 3}, {4, 8}},
+			want: []Interval[int]{{0, 10}},
+		},
+		{
+			name: "empty_intervals",
+			data: []Interval[int]{{3, 3}, {5, 1}, {0, 2}, {2, 2}},
+			want: []Interval[int]{{0, 2}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			test.GotWantSlice(t, MergeIntervals(c.data), c.want)
+		})
+	}
+}
+
+// Verifies on random inputs that the merged intervals are sorted, separated
+// by gaps, and cover exactly the values of the input
+func TestMergeIntervals_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 7))
+	for range 200 {
+		data := make([]Interval[int], r.IntN(20))
+		covered := make([]bool, 100)
+		for i := range data {
+			start := r.IntN(90)
+			data[i] = Interval[int]{start, start + r.IntN(11) - 2}
+			for v := start; v < data[i].End; v++ {
+				covered[v] = true
+			}
+		}
+
+		merged := MergeIntervals(data)
+		got := make([]bool, 100)
+		for i, interval := range merged {
+			if interval.Start >= interval.End {
+				t.Fatalf("empty interval %v", interval)
+			}
+			if i > 0 && merged[i-1].End >= interval.Start {
+				t.Fatalf("intervals %v and %v not merged", merged[i-1], interval)
+			}
+			for v := interval.Start; v < interval.End; v++ {
+				got[v] = true
+			}
+		}
+		test.GotWantSlice(t, got, covered)
+	}
+}
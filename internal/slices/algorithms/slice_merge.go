@@ -0,0 +1,124 @@
+package algorithms
+
+import (
+	"iter"
+
+	heaps "github.com/apotourlyan/godatastructures/internal/heaps/structures"
+)
+
+// Represents the next unmerged element of one input in a k-way merge.
+type mergeCursor[T any] struct {
+	value  T
+	source int // Index of the input the value came from
+	next   int // Index of the following element, for slice inputs
+}
+
+// MergeSorted merges slices that are each sorted according to less into
+// one sorted slice. The merge is stable: equal elements keep the order of
+// their slices in the argument list, and their order within a slice.
+//
+// The first element of every slice is kept in a Heap ordered by less. The
+// root is the least remaining element overall; it is appended to the
+// result and replaced in the heap by the next element of its slice. Each
+// element costs O(log k) instead of the O(k) of scanning all slices.
+//
+// Parameters:
+//   - less: Reports whether a sorts before b
+//   - sorted: The slices to merge, each sorted by less
+//
+// Time complexity: O(n log k) where n is the total number of elements and
+// k the number of slices
+//
+// Space complexity: O(n) for the result, O(k) for the heap
+//
+// Example:
+//
+//	MergeSorted(cmp.Less[int], []int{1, 4, 7}, []int{2, 5}, []int{3, 6})
+//	// Returns [1, 2, 3, 4, 5, 6, 7]
+func MergeSorted[T any](less func(a, b T) bool, sorted ...[]T) []T {
+	total := 0
+	cursors := make([]mergeCursor[T], 0, len(sorted))
+	for i, s := range sorted {
+		total += len(s)
+		if len(s) > 0 {
+			cursors = append(cursors, mergeCursor[T]{value: s[0], source: i, next: 1})
+		}
+	}
+
+	h := heaps.NewHeap(cursorLess(less), cursors...)
+	result := make([]T, 0, total)
+	for !h.IsEmpty() {
+		c, _ := h.Peek()
+		result = append(result, c.value)
+
+		s := sorted[c.source]
+		if c.next < len(s) {
+			h.Set(0, mergeCursor[T]{value: s[c.next], source: c.source, next: c.next + 1})
+		} else {
+			h.Pop()
+		}
+	}
+
+	return result
+}
+
+// MergeSortedSeq returns an iterator over the merge of sequences that are
+// each sorted according to less, like MergeSorted, without materializing
+// the inputs or the result.
+//
+// Every input is read lazily, one element ahead, so sorted shards that are
+// too large for memory, such as files or network streams, can be combined
+// in O(k) space. Stopping the iteration early stops all inputs.
+//
+// Time complexity: O(n log k) for a full iteration
+//
+// Space complexity: O(k)
+//
+// Example:
+//
+//	for v := range MergeSortedSeq(cmp.Less[int], slices.Values(a), slices.Values(b)) {
+//	    fmt.Println(v)  // Ascending across both inputs
+//	}
+func MergeSortedSeq[T any](less func(a, b T) bool, sorted ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		nexts := make([]func() (T, bool), len(sorted))
+		cursors := make([]mergeCursor[T], 0, len(sorted))
+		for i, seq := range sorted {
+			next, stop := iter.Pull(seq)
+			defer stop()
+
+			nexts[i] = next
+			if v, ok := next(); ok {
+				cursors = append(cursors, mergeCursor[T]{value: v, source: i})
+			}
+		}
+
+		h := heaps.NewHeap(cursorLess(less), cursors...)
+		for !h.IsEmpty() {
+			c, _ := h.Peek()
+			if !yield(c.value) {
+				return
+			}
+
+			if v, ok := nexts[c.source](); ok {
+				h.Set(0, mergeCursor[T]{value: v, source: c.source})
+			} else {
+				h.Pop()
+			}
+		}
+	}
+}
+
+// Returns the heap order of merge cursors: by value, then by input, which
+// makes the merge stable.
+func cursorLess[T any](less func(a, b T) bool) func(a, b mergeCursor[T]) bool {
+	return func(a, b mergeCursor[T]) bool {
+		if less(a.value, b.value) {
+			return true
+		}
+		if less(b.value, a.value) {
+			return false
+		}
+		return a.source < b.source
+	}
+}
@@ -0,0 +1,123 @@
+package algorithms
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// MergeSorted/MergeSortedSeq:
+//  ✓ No inputs, empty inputs
+//  ✓ Single input
+//  ✓ Interleaved inputs
+//  ✓ Stability across and within inputs
+//  ✓ Randomized inputs match sorting the concatenation
+//
+// MergeSortedSeq:
+//  ✓ Early termination stops every input
+
+// Merges the slices through MergeSortedSeq.
+func mergeSortedSeq(less func(a, b int) bool, sorted ...[]int) []int {
+	seqs := make([]iter.Seq[int], len(sorted))
+	for i, s := range sorted {
+		seqs[i] = slices.Values(s)
+	}
+	return slices.Collect(MergeSortedSeq(less, seqs...))
+}
+
+// Merge algorithms under test.
+var mergeFuncs = map[string]func(func(a, b int) bool, ...[]int) []int{
+	"MergeSorted":    MergeSorted[int],
+	"MergeSortedSeq": mergeSortedSeq,
+}
+
+// Verifies both variants on fixed inputs
+func TestMergeSorted_Inputs(t *testing.T) {
+	cases := []struct {
+		name   string
+		sorted [][]int
+		want   []int
+	}{
+		{name: "no_inputs", sorted: [][]int{}, want: []int{}},
+		{name: "empty_inputs", sorted: [][]int{{}, {}}, want: []int{}},
+		{name: "single", sorted: [][]int{{1, 2, 3}}, want: []int{1, 2, 3}},
+		{name: "one_empty", sorted: [][]int{{}, {1, 3}, {2}}, want: []int{1, 2, 3}},
+		{name: "interleaved", sorted: [][]int{{1, 4, 7}, {2, 5}, {3, 6}}, want: []int{1, 2, 3, 4, 5, 6, 7}},
+		{name: "duplicates", sorted: [][]int{{1, 1, 2}, {1, 2, 2}}, want: []int{1, 1, 1, 2, 2, 2}},
+	}
+
+	for name, merge := range mergeFuncs {
+		for _, c := range cases {
+			t.Run(name+"/"+c.name, func(t *testing.T) {
+				test.GotWantSlice(t, merge(cmp.Less[int], c.sorted...), c.want)
+			})
+		}
+	}
+}
+
+// Verifies equal elements keep the order of their inputs and their order
+// within an input
+func TestMergeSorted_Stability(t *testing.T) {
+	type entry struct{ key, order int }
+	byKey := func(a, b entry) bool { return a.key < b.key }
+	a := []entry{{1, 0}, {2, 1}, {2, 2}}
+	b := []entry{{1, 3}, {2, 4}}
+	want := []entry{{1, 0}, {1, 3}, {2, 1}, {2, 2}, {2, 4}}
+
+	test.GotWantSlice(t, MergeSorted(byKey, a, b), want)
+	test.GotWantSlice(t, slices.Collect(MergeSortedSeq(byKey, slices.Values(a), slices.Values(b))), want)
+}
+
+// Verifies breaking out of the iteration stops every input
+func TestMergeSortedSeq_EarlyTermination(t *testing.T) {
+	stopped := 0
+	counting := func(values ...int) iter.Seq[int] {
+		return func(yield func(int) bool) {
+			defer func() { stopped++ }()
+			for _, v := range values {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+
+	got := []int{}
+	for v := range MergeSortedSeq(cmp.Less[int], counting(1, 3, 5), counting(2, 4)) {
+		got = append(got, v)
+		if v == 3 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []int{1, 2, 3})
+	test.GotWant(t, stopped, 2)
+}
+
+// Verifies random inputs against sorting their concatenation
+func TestMergeSorted_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(61, 61))
+	for name, merge := range mergeFuncs {
+		t.Run(name, func(t *testing.T) {
+			for range 200 {
+				sorted := make([][]int, r.IntN(8))
+				for i := range sorted {
+					sorted[i] = make([]int, r.IntN(20))
+					for j := range sorted[i] {
+						sorted[i][j] = r.IntN(50)
+					}
+					slices.Sort(sorted[i])
+				}
+
+				want := slices.Concat(sorted...)
+				slices.Sort(want)
+				test.GotWantSlice(t, merge(cmp.Less[int], sorted...), want)
+			}
+		})
+	}
+}
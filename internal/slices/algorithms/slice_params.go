@@ -0,0 +1,26 @@
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+const ErrorInvalidParams = "invalid parameters"
+
+// ErrInvalidParams is wrapped by the error-returning variants of the
+// algorithms in place of the panic their parameter checks would raise.
+var ErrInvalidParams = errors.New(ErrorInvalidParams)
+
+// Runs a panicking parameter validation and returns its failure as an
+// error wrapping ErrInvalidParams, or nil if the parameters are
+// valid. The validations stay the single source of the rules and
+// messages for both the panicking and the error-returning functions.
+func checkParams(validate func()) error {
+	if panicked, message := panics.CatchPanic(validate); panicked {
+		return fmt.Errorf("%w: %s", ErrInvalidParams, message)
+	}
+
+	return nil
+}
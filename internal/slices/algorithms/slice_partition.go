@@ -0,0 +1,73 @@
+package algorithms
+
+// Partition reorders the slice so that every element satisfying pred comes
+// before every element that does not, and returns the split point: data[:i]
+// satisfies pred and data[i:] does not. The relative order of the elements
+// is not preserved; use StablePartition when it matters.
+//
+// Two indices move towards each other from both ends and swap misplaced
+// pairs, so each element is tested once and moved at most once.
+//
+// Parameters:
+//   - data: The slice to reorder in place
+//   - pred: Reports whether an element belongs to the front part
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	data := []int{1, 2, 3, 4, 5, 6}
+//	i := Partition(data, func(v int) bool { return v%2 == 0 })
+//	// i is 3, data[:3] holds 2, 4 and 6 in some order
+func Partition[T any](data []T, pred func(value T) bool) int {
+	i, j := 0, len(data)
+	for {
+		for i < j && pred(data[i]) {
+			i++
+		}
+		for i < j && !pred(data[j-1]) {
+			j--
+		}
+		if i >= j {
+			return i
+		}
+
+		data[i], data[j-1] = data[j-1], data[i]
+		i++
+		j--
+	}
+}
+
+// StablePartition reorders the slice like Partition, but keeps the relative
+// order of the elements within both parts, and returns the split point.
+//
+// Elements satisfying pred are compacted to the front in one pass while
+// the others are set aside in a buffer, which is then copied back behind
+// them.
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(m) where m is the number of elements not satisfying pred
+//
+// Example:
+//
+//	data := []int{1, 2, 3, 4, 5, 6}
+//	i := StablePartition(data, func(v int) bool { return v%2 == 0 })
+//	// i is 3, data is [2, 4, 6, 1, 3, 5]
+func StablePartition[T any](data []T, pred func(value T) bool) int {
+	var rest []T
+	i := 0
+	for _, v := range data {
+		if pred(v) {
+			data[i] = v
+			i++
+		} else {
+			rest = append(rest, v)
+		}
+	}
+
+	copy(data[i:], rest)
+	return i
+}
@@ -0,0 +1,100 @@
+package algorithms
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// Partition/StablePartition:
+//  ✓ Empty slice
+//  ✓ All or no elements satisfy the predicate
+//  ✓ Mixed elements, split point
+//  ✓ Randomized slices keep their elements and split correctly
+//
+// StablePartition:
+//  ✓ Relative order is kept in both parts
+
+// Partition algorithms under test.
+var partitionFuncs = map[string]func([]int, func(int) bool) int{
+	"Partition":       Partition[int],
+	"StablePartition": StablePartition[int],
+}
+
+// Reports whether a value is even.
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+// Verifies data[:i] satisfies the predicate, data[i:] does not, and the
+// elements are a permutation of the original ones.
+func checkPartition(t *testing.T, original []int, data []int, i int, pred func(int) bool) {
+	t.Helper()
+	for j, v := range data {
+		if pred(v) != (j < i) {
+			t.Fatalf("element %d (%d) is on the wrong side of %d: %v", j, v, i, data)
+		}
+	}
+
+	got, want := slices.Clone(data), slices.Clone(original)
+	slices.Sort(got)
+	slices.Sort(want)
+	test.GotWantSlice(t, got, want)
+}
+
+// Verifies the split point on fixed inputs
+func TestPartition_Inputs(t *testing.T) {
+	cases := []struct {
+		name string
+		data []int
+		want int
+	}{
+		{name: "empty", data: []int{}, want: 0},
+		{name: "all_satisfy", data: []int{2, 4, 6}, want: 3},
+		{name: "none_satisfy", data: []int{1, 3, 5}, want: 0},
+		{name: "alternating", data: []int{1, 2, 3, 4, 5, 6}, want: 3},
+		{name: "reversed_sides", data: []int{1, 1, 1, 2, 2}, want: 2},
+	}
+
+	for name, partition := range partitionFuncs {
+		for _, c := range cases {
+			t.Run(name+"/"+c.name, func(t *testing.T) {
+				data := slices.Clone(c.data)
+				i := partition(data, isEven)
+				test.GotWant(t, i, c.want)
+				checkPartition(t, c.data, data, i, isEven)
+			})
+		}
+	}
+}
+
+// Verifies StablePartition keeps the relative order in both parts
+func TestStablePartition_Order(t *testing.T) {
+	data := []int{7, 2, 9, 4, 1, 8, 3, 6}
+	i := StablePartition(data, isEven)
+	test.GotWant(t, i, 4)
+	test.GotWantSlice(t, data, []int{2, 4, 8, 6, 7, 9, 1, 3})
+}
+
+// Verifies random slices against the partition invariants
+func TestPartition_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(55, 55))
+	for name, partition := range partitionFuncs {
+		t.Run(name, func(t *testing.T) {
+			for range 300 {
+				original := make([]int, r.IntN(50))
+				for i := range original {
+					original[i] = r.IntN(100)
+				}
+
+				data := slices.Clone(original)
+				i := partition(data, isEven)
+				checkPartition(t, original, data, i, isEven)
+			}
+		})
+	}
+}
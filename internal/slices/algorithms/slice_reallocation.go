@@ -9,6 +9,7 @@ type SliceReallocationParams struct {
 	MinSize      int // Minimum used size to trigger reallocation (0 means always reallocate if waste threshold is met)
 	WastePercent int // Reallocate if waste >= this percent (0-100)
 	WasteBuffer  int // Target waste as percent of threshold (0-99, e.g. 80 means target 80% of threshold)
+	Headroom     int // Expected number of elements to be added after UsedEnd (0 disables growth)
 }
 
 // Validates reallocation parameters against slice length.
@@ -19,6 +20,7 @@ type SliceReallocationParams struct {
 //   - MinSize < 0
 //   - WastePercent outside [0, 100]
 //   - WasteBuffer outside [0, 100]
+//   - Headroom < 0
 //
 // Special case: For empty slices (length=0), requires UsedStart=0 & UsedEnd=0.
 func (p *SliceReallocationParams) validate(length int) {
@@ -36,18 +38,28 @@ func (p *SliceReallocationParams) validate(length int) {
 	panics.RequireLessThanOrEqualTo(p.WastePercent, 100, "waste percent")
 	panics.RequireNonNegative(p.WasteBuffer, "waste buffer")
 	panics.RequireLessThanOrEqualTo(p.WasteBuffer, 99, "waste buffer")
+	panics.RequireNonNegative(p.Headroom, "headroom")
 }
 
-// Reallocate creates a new slice with reduced capacity to reclaim wasted space.
+// Reallocate creates a new slice with reduced capacity to reclaim wasted
+// space, or with increased capacity to make room for expected growth.
 //
-// Reallocation occurs when ALL conditions are met:
+// Growth occurs when Headroom > 0 and UsedEnd + Headroom exceeds the
+// capacity. The new slice has capacity for exactly the used elements plus
+// Headroom (at least 10), instead of the doubling append would apply, so a
+// structure that knows how much it will grow allocates once and wastes
+// nothing. Growth takes precedence over shrinking and ignores MinSize and
+// WastePercent.
+//
+// Shrinking occurs when ALL conditions are met:
 //   - Used size >= MinSize (avoid expensive reallocation on small slices)
 //   - Waste percent >= WastePercent (enough waste to justify cost)
 //
 // If reallocation occurs, a new slice with capacity sized to keep waste at
 // WasteBuffer% of WastePercent is created, and used elements are copied to
-// the new slice starting at index 0. Otherwise, original slice and indices
-// are returned unchanged.
+// the new slice starting at index 0. The new capacity never drops below
+// the used size plus Headroom. Otherwise, original slice and indices are
+// returned unchanged.
 //
 // Parameters:
 //   - data: The underlying slice to reallocate
@@ -82,31 +94,95 @@ func (p *SliceReallocationParams) validate(length int) {
 //	// Result: rData [1, 2, 3, 4, 5, 6, _, _, _, _], start=0, end=6
 //	//         New waste: 40% (4 unused slots out of 10)
 //
+//	// Stack about to receive 500 elements
+//	data := [1, 2, 3, _, ..., _]  // cap=16, used=3
+//	rData, start, end := Reallocate(data, SliceReallocationParams{
+//	    UsedStart: 0,
+//	    UsedEnd:   3,
+//	    Headroom:  500,
+//	})
+//	// Result: rData [1, 2, 3], cap=503, start=0, end=3
+//
 // Use cases:
 //   - Slice-based queues (elements removed from front)
 //   - Slice-based stacks (elements removed from back)
 //   - Slice-based deques (elements removed from front & back)
 //   - Any structure with sliding window over slice
 func Reallocate[T any](data []T, p SliceReallocationParams) (rData []T, start int, end int) {
-	length := len(data)
-	p.validate(length)
+	rData, start, end, _ = ReallocateWithStats(data, p)
+	return rData, start, end
+}
 
-	if length == 0 {
-		return data, 0, 0
+// TryReallocate is Reallocate that returns an error instead of panicking
+// when the parameters are invalid, for callers such as servers that must
+// not crash on a bad request. The error wraps ErrInvalidParams
+// and names the offending parameter. On error, the
+// original data, UsedStart and UsedEnd are returned.
+//
+// Time complexity: Same as Reallocate
+//
+// Example:
+//
+//	rData, start, end, err := TryReallocate(data, SliceReallocationParams{
+//	    UsedEnd:      3,
+//	    WastePercent: 150,
+//	})
+//	// err: invalid parameters: "waste percent" must be <= 100, got 150
+func TryReallocate[T any](data []T, p SliceReallocationParams) (rData []T, start int, end int, err error) {
+	if err := checkParams(func() { p.validate(len(data)) }); err != nil {
+		return data, p.UsedStart, p.UsedEnd, err
 	}
 
+	rData, start, end = Reallocate(data, p)
+	return rData, start, end, nil
+}
+
+// ReallocateWithStats is Reallocate that also reports what happened. Waste
+// is measured relative to the capacity, which is what reallocation
+// reclaims. Growth is reported as triggered, with CapAfter above CapBefore.
+//
+// Time complexity: Same as Reallocate
+//
+// Panics if parameters are invalid.
+//
+// Example:
+//
+//	data := [1, 2, 3, _, ..., _]  // cap=20, used=3
+//	rData, start, end, stats := ReallocateWithStats(data, SliceReallocationParams{
+//	    UsedEnd:      3,
+//	    WastePercent: 50,
+//	    WasteBuffer:  80,
+//	})
+//	// stats: Triggered=true, Moved=3, CapBefore=20, CapAfter=10,
+//	//        WasteBefore=85, WasteAfter=70
+func ReallocateWithStats[T any](data []T, p SliceReallocationParams) (rData []T, start int, end int, stats SliceStats) {
+	length := len(data)
+	p.validate(length)
+
 	used := p.UsedEnd - p.UsedStart
-	wastePercent := 100 - 100*used/cap(data)
-	shouldReallocate := used >= p.MinSize && wastePercent >= p.WastePercent
-	if shouldReallocate {
+	stats.CapBefore = cap(data)
+	stats.WasteBefore = wastePercent(used, cap(data))
+
+	targetCapacity := 0
+	if p.Headroom > 0 && p.UsedEnd+p.Headroom > cap(data) {
+		targetCapacity = max(used+p.Headroom, 10) // min practical capacity 10
+	} else if length > 0 && used >= p.MinSize && stats.WasteBefore >= p.WastePercent {
 		// Calculate new capacity to keep waste at a fraction of the threshold
 		targetPercent := p.WastePercent * p.WasteBuffer / 100
-		targetCapacity := max(used*100/(100-targetPercent), 10) // min practical capacity 10
-		usedData := data[p.UsedStart:p.UsedEnd]
-		rData = make([]T, 0, targetCapacity)
-		rData = append(rData, usedData...)
-		return rData, 0, len(rData)
+		targetCapacity = max(used*100/(100-targetPercent), used+p.Headroom, 10) // min practical capacity 10
+	}
+
+	if targetCapacity == 0 {
+		stats.CapAfter = stats.CapBefore
+		stats.WasteAfter = stats.WasteBefore
+		return data, p.UsedStart, p.UsedEnd, stats
 	}
 
-	return data, p.UsedStart, p.UsedEnd
+	rData = make([]T, 0, targetCapacity)
+	rData = append(rData, data[p.UsedStart:p.UsedEnd]...)
+	stats.Triggered = true
+	stats.Moved = used
+	stats.CapAfter = targetCapacity
+	stats.WasteAfter = wastePercent(used, targetCapacity)
+	return rData, 0, len(rData), stats
 }
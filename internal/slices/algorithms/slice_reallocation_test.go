@@ -20,16 +20,32 @@ import (
 //  ✓ Waste percent greater than 100
 //  ✓ Negative waste buffer
 //  ✓ Waste buffer equals 100
+//  ✓ Negative headroom
 //  ✓ Empty slice
 //  ✓ Used size below min size
 //  ✓ Waste below threshold
 //  ✓ Waste just below threshold
+//  ✓ Headroom fits in capacity
 //  ✓ Standard reallocation
 //  ✓ Min size boundary
 //  ✓ Waste percent boundary
 //  ✓ Min size zero with waste above threshold
 //  ✓ Waste percent zero with any waste
 //  ✓ High waste buffer value
+//  ✓ Growth for headroom
+//  ✓ Growth of empty slice
+//  ✓ Growth with minimum capacity
+//  ✓ Growth takes precedence over shrinking
+//  ✓ Shrinking keeps headroom
+//
+// ReallocateWithStats:
+//  ✓ Shrinking reports capacities and waste
+//  ✓ Growth reports increased capacity
+//  ✓ Not triggered reports unchanged capacity
+//
+// TryReallocate:
+//  ✓ Invalid parameters return an error and the original data
+//  ✓ Valid parameters reallocate like Reallocate
 
 // Verifies that Reallocate panics with appropriate error messages for invalid parameters
 func TestReallocate_InvalidArgs(t *testing.T) {
@@ -171,6 +187,19 @@ func TestReallocate_InvalidArgs(t *testing.T) {
 			},
 			want: `"waste buffer" must be <= 99, got 100`,
 		},
+		{
+			name: "negative_headroom",
+			data: []int{1, 2, 3},
+			p: SliceReallocationParams{
+				UsedStart:    0,
+				UsedEnd:      3,
+				MinSize:      1,
+				WastePercent: 50,
+				WasteBuffer:  80,
+				Headroom:     -1,
+			},
+			want: `"headroom" must be >= 0, got -1`,
+		},
 	}
 
 	for _, c := range cases {
@@ -253,6 +282,25 @@ func TestReallocate_NotTriggered(t *testing.T) {
 				WasteBuffer:  80,
 			},
 		},
+		{
+			name: "headroom_fits_in_capacity",
+			// cap=10, len=5, used=5 (indices 0-5), waste=50%
+			data: func() []int {
+				data := make([]int, 5, 10)
+				for i := range 5 {
+					data[i] = i + 1
+				}
+				return data
+			}(),
+			p: SliceReallocationParams{
+				UsedStart:    0,
+				UsedEnd:      5,
+				MinSize:      1,
+				WastePercent: 60, // ✓ 50% < 60%
+				WasteBuffer:  80,
+				Headroom:     5, // ← Testing: 5+5 <= 10 (boundary)
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -400,6 +448,103 @@ func TestReallocate_Triggered(t *testing.T) {
 			wantLen:  10,
 			wantCap:  19, // max(10*100/51, 10) = 19
 		},
+		{
+			name: "growth_for_headroom",
+			// cap=10, len=10, used=3 (indices 7-10), no room at the end
+			data: func() []int {
+				data := make([]int, 10)
+				data[7] = 1
+				data[8] = 2
+				data[9] = 3
+				return data
+			}(),
+			p: SliceReallocationParams{
+				UsedStart:    7,
+				UsedEnd:      10,
+				MinSize:      100, // ← Ignored by growth
+				WastePercent: 100, // ← Ignored by growth
+				WasteBuffer:  80,
+				Headroom:     50, // ← Testing: 10+50 > 10
+			},
+			wantData: []int{1, 2, 3},
+			wantLen:  3,
+			wantCap:  53, // 3+50
+		},
+		{
+			name: "growth_of_empty_slice",
+			data: []int{},
+			p: SliceReallocationParams{
+				UsedStart:    0,
+				UsedEnd:      0,
+				MinSize:      1,
+				WastePercent: 50,
+				WasteBuffer:  80,
+				Headroom:     25,
+			},
+			wantData: []int{},
+			wantLen:  0,
+			wantCap:  25,
+		},
+		{
+			name: "growth_with_minimum_capacity",
+			// cap=2, len=2, used=2
+			data: []int{1, 2},
+			p: SliceReallocationParams{
+				UsedStart:    0,
+				UsedEnd:      2,
+				MinSize:      1,
+				WastePercent: 50,
+				WasteBuffer:  80,
+				Headroom:     1,
+			},
+			wantData: []int{1, 2},
+			wantLen:  2,
+			wantCap:  10, // max(2+1, 10)
+		},
+		{
+			name: "growth_takes_precedence_over_shrinking",
+			// cap=100, len=100, used=5 (indices 95-100), waste=95%
+			data: func() []int {
+				data := make([]int, 100)
+				for i := range 5 {
+					data[95+i] = i + 1
+				}
+				return data
+			}(),
+			p: SliceReallocationParams{
+				UsedStart:    95,
+				UsedEnd:      100,
+				MinSize:      1,  // ✓ 5 >= 1
+				WastePercent: 50, // ✓ 95% >= 50%
+				WasteBuffer:  80,
+				Headroom:     20, // ← Testing: 100+20 > 100
+			},
+			wantData: []int{1, 2, 3, 4, 5},
+			wantLen:  5,
+			wantCap:  25, // 5+20
+		},
+		{
+			name: "shrinking_keeps_headroom",
+			// cap=100, len=50, used=10 (indices 0-10), waste=90%
+			data: func() []int {
+				data := make([]int, 50, 100)
+				for i := range 10 {
+					data[i] = i + 1
+				}
+				return data
+			}(),
+			p: SliceReallocationParams{
+				UsedStart:    0,
+				UsedEnd:      10,
+				MinSize:      1,
+				WastePercent: 50,
+				WasteBuffer:  80,
+				Headroom:     30, // ← Testing: 10+30 <= 100, no growth
+			},
+			wantData: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			wantLen:  10,
+			wantCap:  40, // max(10*100/60, 10+30, 10) = 40
+		},
 	}
 
 	for _, c := range cases {
@@ -412,3 +557,99 @@ func TestReallocate_Triggered(t *testing.T) {
 		})
 	}
 }
+
+// Verifies the stats reported by ReallocateWithStats
+func TestReallocateWithStats(t *testing.T) {
+	cases := []struct {
+		name string
+		data []int
+		p    SliceReallocationParams
+		want SliceStats
+	}{
+		{
+			name: "shrinking",
+			// cap=20, len=3, used=3, waste=85%
+			data: make([]int, 3, 20),
+			p: SliceReallocationParams{
+				UsedEnd:      3,
+				WastePercent: 50,
+				WasteBuffer:  80,
+			},
+			want: SliceStats{
+				Triggered:   true,
+				Moved:       3,
+				CapBefore:   20,
+				CapAfter:    10,
+				WasteBefore: 85,
+				WasteAfter:  70,
+			},
+		},
+		{
+			name: "growth",
+			// cap=4, len=4, used=2 (indices 2-4), waste=50%
+			data: make([]int, 4),
+			p: SliceReallocationParams{
+				UsedStart:    2,
+				UsedEnd:      4,
+				WastePercent: 100,
+				Headroom:     18,
+			},
+			want: SliceStats{
+				Triggered:   true,
+				Moved:       2,
+				CapBefore:   4,
+				CapAfter:    20,
+				WasteBefore: 50,
+				WasteAfter:  90,
+			},
+		},
+		{
+			name: "not_triggered",
+			// cap=10, len=10, used=8, waste=20%
+			data: make([]int, 10),
+			p: SliceReallocationParams{
+				UsedEnd:      8,
+				WastePercent: 50,
+				WasteBuffer:  80,
+			},
+			want: SliceStats{
+				CapBefore:   10,
+				CapAfter:    10,
+				WasteBefore: 20,
+				WasteAfter:  20,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, _, _, stats := ReallocateWithStats(c.data, c.p)
+			test.GotWant(t, stats, c.want)
+		})
+	}
+}
+
+// Verifies that TryReallocate returns errors instead of panicking
+func TestTryReallocate(t *testing.T) {
+	data := make([]int, 3, 20)
+	data[0], data[1], data[2] = 1, 2, 3
+	got, start, end, err := TryReallocate(data, SliceReallocationParams{
+		UsedEnd:      3,
+		WastePercent: 150,
+	})
+	test.GotWantError(t, err, `invalid parameters: "waste percent" must be <= 100, got 150`)
+	test.GotWant(t, &got[0], &data[0])
+	test.GotWant(t, start, 0)
+	test.GotWant(t, end, 3)
+
+	got, start, end, err = TryReallocate(data, SliceReallocationParams{
+		UsedEnd:      3,
+		WastePercent: 50,
+		WasteBuffer:  80,
+	})
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, got, []int{1, 2, 3})
+	test.GotWant(t, cap(got), 10)
+	test.GotWant(t, start, 0)
+	test.GotWant(t, end, 3)
+}
@@ -0,0 +1,80 @@
+package algorithms
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
+
+// Reverse reverses the order of the elements of the slice in place.
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	data := []int{1, 2, 3}
+//	Reverse(data)  // data is [3, 2, 1]
+func Reverse[T any](data []T) {
+	for i, j := 0, len(data)-1; i < j; i, j = i+1, j-1 {
+		data[i], data[j] = data[j], data[i]
+	}
+}
+
+// ReverseRange reverses the order of the elements of data[start:end] in
+// place, leaving the rest of the slice untouched.
+//
+// Parameters:
+//   - data: The slice containing the range
+//   - start: Index of the first element of the range
+//   - end: Exclusive index of the last element of the range
+//
+// Time complexity: O(end - start)
+//
+// Space complexity: O(1)
+//
+// Panics if the range is invalid:
+//   - start < 0
+//   - start > end
+//   - end > len(data)
+//
+// Example:
+//
+//	data := []int{1, 2, 3, 4, 5}
+//	ReverseRange(data, 1, 4)  // data is [1, 4, 3, 2, 5]
+func ReverseRange[T any](data []T, start int, end int) {
+	panics.RequireNonNegative(start, "start index")
+	panics.RequireLessThanOrEqualTo(start, end, "start index")
+	panics.RequireLessThanOrEqualTo(end, len(data), "end index")
+	Reverse(data[start:end])
+}
+
+// Rotate rotates the slice left by k positions in place, so data[k] becomes
+// the first element and the first k elements move to the back. k is taken
+// modulo the length of the slice; a negative k rotates right.
+//
+// The rotation reverses both parts and then the whole slice: reversing
+// [a b] part by part gives [a' b'], and reversing that gives [b a]. Every
+// element is swapped twice at most and no buffer is needed.
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	data := []int{1, 2, 3, 4, 5}
+//	Rotate(data, 2)   // data is [3, 4, 5, 1, 2]
+//	Rotate(data, -2)  // data is [1, 2, 3, 4, 5]
+func Rotate[T any](data []T, k int) {
+	n := len(data)
+	if n == 0 {
+		return
+	}
+
+	k %= n
+	if k < 0 {
+		k += n
+	}
+
+	Reverse(data[:k])
+	Reverse(data[k:])
+	Reverse(data)
+}
@@ -0,0 +1,131 @@
+package algorithms
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// Reverse:
+//  ✓ Empty, single, even and odd lengths
+//
+// ReverseRange:
+//  ✓ Invalid ranges
+//  ✓ Empty, partial and full ranges
+//
+// Rotate:
+//  ✓ Empty slice
+//  ✓ Left, right, zero and full rotations, k beyond the length
+//  ✓ Randomized rotations match concatenation
+
+// Verifies Reverse on slices of different lengths
+func TestReverse(t *testing.T) {
+	cases := []struct {
+		name string
+		data []int
+		want []int
+	}{
+		{name: "empty", data: []int{}, want: []int{}},
+		{name: "single", data: []int{1}, want: []int{1}},
+		{name: "even", data: []int{1, 2, 3, 4}, want: []int{4, 3, 2, 1}},
+		{name: "odd", data: []int{1, 2, 3}, want: []int{3, 2, 1}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			Reverse(c.data)
+			test.GotWantSlice(t, c.data, c.want)
+		})
+	}
+}
+
+// Verifies ReverseRange panics for invalid ranges
+func TestReverseRange_InvalidArgs(t *testing.T) {
+	cases := []struct {
+		name       string
+		start, end int
+		want       string
+	}{
+		{name: "negative_start", start: -1, end: 2, want: `"start index" must be >= 0, got -1`},
+		{name: "start_after_end", start: 2, end: 1, want: `"start index" must be <= 1, got 2`},
+		{name: "end_beyond_length", start: 0, end: 4, want: `"end index" must be <= 3, got 4`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			test.GotWantPanic(t, func() {
+				ReverseRange([]int{1, 2, 3}, c.start, c.end)
+			}, c.want)
+		})
+	}
+}
+
+// Verifies ReverseRange only touches the range
+func TestReverseRange(t *testing.T) {
+	cases := []struct {
+		name       string
+		start, end int
+		want       []int
+	}{
+		{name: "empty_range", start: 2, end: 2, want: []int{1, 2, 3, 4, 5}},
+		{name: "partial", start: 1, end: 4, want: []int{1, 4, 3, 2, 5}},
+		{name: "suffix", start: 3, end: 5, want: []int{1, 2, 3, 5, 4}},
+		{name: "full", start: 0, end: 5, want: []int{5, 4, 3, 2, 1}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data := []int{1, 2, 3, 4, 5}
+			ReverseRange(data, c.start, c.end)
+			test.GotWantSlice(t, data, c.want)
+		})
+	}
+}
+
+// Verifies Rotate for different amounts and directions
+func TestRotate(t *testing.T) {
+	cases := []struct {
+		name string
+		k    int
+		want []int
+	}{
+		{name: "zero", k: 0, want: []int{1, 2, 3, 4, 5}},
+		{name: "left", k: 2, want: []int{3, 4, 5, 1, 2}},
+		{name: "right", k: -2, want: []int{4, 5, 1, 2, 3}},
+		{name: "full", k: 5, want: []int{1, 2, 3, 4, 5}},
+		{name: "beyond_length", k: 7, want: []int{3, 4, 5, 1, 2}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data := []int{1, 2, 3, 4, 5}
+			Rotate(data, c.k)
+			test.GotWantSlice(t, data, c.want)
+		})
+	}
+
+	empty := []int{}
+	Rotate(empty, 3)
+	test.GotWant(t, len(empty), 0)
+}
+
+// Verifies random rotations against concatenating the two parts
+func TestRotate_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(56, 56))
+	for range 300 {
+		n := 1 + r.IntN(30)
+		data := make([]int, n)
+		for i := range data {
+			data[i] = i
+		}
+
+		k := r.IntN(n)
+		want := slices.Concat(data[k:], data[:k])
+		Rotate(data, k)
+		test.GotWantSlice(t, data, want)
+	}
+}
@@ -0,0 +1,151 @@
+package algorithms
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
+
+// Number of median-of-three partitions that may each keep more than 3/4
+// of the range before introselect falls back to median-of-medians pivots.
+const introselectPoorRounds = 3
+
+// SelectKth returns the k-th smallest element (counting from 0) of the
+// slice according to less, and rearranges the slice around it: afterwards
+// data[k] holds that element, no element of data[:k] is greater and no
+// element of data[k+1:] is less. The order within both parts is
+// unspecified.
+//
+// SelectKth is an introselect. It runs quickselect, which partitions the
+// slice around a median-of-three pivot like Quicksort but only continues
+// into the side holding k, for O(n) on average. Once a few rounds have
+// each kept more than 3/4 of the range, it switches to median-of-medians
+// pivots, which split the slice in at least a 3:7 ratio. Every round then
+// either shrinks the range by a constant fraction or is one of a constant
+// number of poor rounds, which guarantees O(n) in the worst case.
+//
+// Parameters:
+//   - data: The slice to search, rearranged in place
+//   - k: The rank of the element to select, in [0, len(data))
+//   - less: Reports whether a sorts before b
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(log n)
+//
+// Panics if k is outside [0, len(data)).
+//
+// Example:
+//
+//	data := []int{7, 1, 5, 3, 9}
+//	SelectKth(data, 2, cmp.Less[int])  // Returns 5, the median
+func SelectKth[T any](data []T, k int, less func(a, b T) bool) T {
+	panics.RequireNonNegative(k, "k")
+	panics.RequireLessThan(k, len(data), "k")
+
+	introselect(data, k, less, introselectPoorRounds)
+	return data[k]
+}
+
+// TopK moves the k least elements of the slice according to less to its
+// front and returns them as data[:k], in unspecified order. Passing a
+// greater-than function selects the k greatest elements instead.
+//
+// Only the boundary between the first k elements and the rest is
+// established, which makes TopK O(n) where sorting would be O(n log n).
+// Use TopKSorted when the selected elements are needed in order.
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(log n)
+//
+// Panics if k is outside [0, len(data)].
+//
+// Example:
+//
+//	scores := []int{40, 95, 70, 85, 60}
+//	TopK(scores, 2, func(a, b int) bool { return a > b })  // 95 and 85
+func TopK[T any](data []T, k int, less func(a, b T) bool) []T {
+	panics.RequireNonNegative(k, "k")
+	panics.RequireLessThanOrEqualTo(k, len(data), "k")
+
+	if k > 0 && k < len(data) {
+		introselect(data, k-1, less, introselectPoorRounds)
+	}
+
+	return data[:k]
+}
+
+// TopKSorted moves the k least elements of the slice according to less to
+// its front in sorted order and returns them as data[:k].
+//
+// Time complexity: O(n + k log k)
+//
+// Space complexity: O(log n)
+//
+// Panics if k is outside [0, len(data)].
+//
+// Example:
+//
+//	scores := []int{40, 95, 70, 85, 60}
+//	TopKSorted(scores, 3, func(a, b int) bool { return a > b })  // [95, 85, 70]
+func TopKSorted[T any](data []T, k int, less func(a, b T) bool) []T {
+	top := TopK(data, k, less)
+	Sort(top, less)
+	return top
+}
+
+// Rearranges the slice so that data[k] is its k-th smallest element, using
+// median-of-three pivots until poor rounds of them have each kept more
+// than 3/4 of the range, and median-of-medians pivots after that.
+func introselect[T any](data []T, k int, less func(a, b T) bool, poor int) {
+	for len(data) > insertionSortThreshold {
+		n := len(data)
+		var p int
+		if poor > 0 {
+			p = partition(data, less)
+		} else {
+			p = partitionAround(data, medianOfMedians(data, less), less)
+		}
+
+		switch {
+		case k == p:
+			return
+		case k < p:
+			data = data[:p]
+		default:
+			data = data[p+1:]
+			k -= p + 1
+		}
+
+		if poor > 0 && 4*len(data) > 3*n {
+			poor--
+		}
+	}
+
+	Insertionsort(data, less)
+}
+
+// Returns the index of an approximate median of the slice: the median of
+// the medians of its groups of five. At least 30% of the elements are not
+// less and 30% are not greater than it. The medians are gathered at the
+// front of the slice, which is otherwise left in unspecified order.
+func medianOfMedians[T any](data []T, less func(a, b T) bool) int {
+	n := len(data)
+	if n <= 5 {
+		Insertionsort(data, less)
+		return n / 2
+	}
+
+	medians := 0
+	for start := 0; start < n; start += 5 {
+		end := min(start+5, n)
+		Insertionsort(data[start:end], less)
+
+		// Earlier groups are done, so their slots can hold the medians
+		m := start + (end-start)/2
+		data[medians], data[m] = data[m], data[medians]
+		medians++
+	}
+
+	// Select the median of the medians with median-of-medians pivots as
+	// well, which keeps the worst case linear
+	introselect(data[:medians], medians/2, less, 0)
+	return medians / 2
+}
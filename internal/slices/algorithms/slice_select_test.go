@@ -0,0 +1,174 @@
+package algorithms
+
+import (
+	"cmp"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// SelectKth:
+//  ✓ Invalid rank
+//  ✓ Single element, minimum, maximum and median
+//  ✓ Slice is partitioned around the selected element
+//  ✓ Median-of-medians fallback
+//  ✓ Linear comparisons on adversarial input
+//  ✓ Randomized slices match sorting
+//
+// TopK/TopKSorted:
+//  ✓ Invalid count
+//  ✓ Zero and all elements
+//  ✓ Least and greatest elements, sorted variant
+
+// Verifies data[k] is the k-th smallest element and the slice is
+// partitioned around it
+func checkSelected(t *testing.T, original []int, data []int, k int) {
+	t.Helper()
+	sorted := slices.Clone(original)
+	slices.Sort(sorted)
+	test.GotWant(t, data[k], sorted[k])
+	for i, v := range data {
+		if i < k && v > data[k] || i > k && v < data[k] {
+			t.Fatalf("element %d (%d) is on the wrong side of rank %d (%d)", i, v, k, data[k])
+		}
+	}
+}
+
+// Verifies SelectKth panics for ranks outside [0, len(data))
+func TestSelectKth_InvalidRank(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		SelectKth([]int{1, 2}, -1, cmp.Less[int])
+	}, `"k" must be >= 0, got -1`)
+	test.GotWantPanic(t, func() {
+		SelectKth([]int{1, 2}, 2, cmp.Less[int])
+	}, `"k" must be < 2, got 2`)
+	test.GotWantPanic(t, func() {
+		SelectKth([]int{}, 0, cmp.Less[int])
+	}, `"k" must be < 0, got 0`)
+}
+
+// Verifies selection of a single element, the minimum, maximum and median
+func TestSelectKth_Ranks(t *testing.T) {
+	test.GotWant(t, SelectKth([]int{7}, 0, cmp.Less[int]), 7)
+
+	original := make([]int, 101)
+	for i := range original {
+		original[i] = (i * 37) % 101
+	}
+	for _, k := range []int{0, 50, 100} {
+		data := slices.Clone(original)
+		test.GotWant(t, SelectKth(data, k, cmp.Less[int]), k)
+		checkSelected(t, original, data, k)
+	}
+}
+
+// Verifies selection with median-of-medians pivots from the start
+func TestSelectKth_MedianOfMedians(t *testing.T) {
+	r := rand.New(rand.NewPCG(59, 59))
+	for range 100 {
+		original := make([]int, 1+r.IntN(500))
+		for i := range original {
+			original[i] = r.IntN(50)
+		}
+
+		k := r.IntN(len(original))
+		data := slices.Clone(original)
+		introselect(data, k, cmp.Less[int], 0)
+		checkSelected(t, original, data, k)
+	}
+}
+
+// Verifies random slices and ranks against sorting
+func TestSelectKth_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(60, 60))
+	for range 300 {
+		original := make([]int, 1+r.IntN(300))
+		limit := 1 + r.IntN(1000)
+		for i := range original {
+			original[i] = r.IntN(limit)
+		}
+
+		k := r.IntN(len(original))
+		data := slices.Clone(original)
+		SelectKth(data, k, cmp.Less[int])
+		checkSelected(t, original, data, k)
+	}
+}
+
+// Verifies TopK panics for counts outside [0, len(data)]
+func TestTopK_InvalidCount(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		TopK([]int{1, 2}, -1, cmp.Less[int])
+	}, `"k" must be >= 0, got -1`)
+	test.GotWantPanic(t, func() {
+		TopK([]int{1, 2}, 3, cmp.Less[int])
+	}, `"k" must be <= 2, got 3`)
+}
+
+// Verifies TopK and TopKSorted select the least or greatest elements
+func TestTopK(t *testing.T) {
+	greater := func(a, b int) bool { return a > b }
+	test.GotWant(t, len(TopK([]int{3, 1, 2}, 0, cmp.Less[int])), 0)
+
+	all := TopK([]int{3, 1, 2}, 3, cmp.Less[int])
+	slices.Sort(all)
+	test.GotWantSlice(t, all, []int{1, 2, 3})
+
+	scores := []int{40, 95, 70, 85, 60, 10, 99, 55, 30, 75, 20, 65, 50, 80, 90}
+	least := TopK(slices.Clone(scores), 4, cmp.Less[int])
+	slices.Sort(least)
+	test.GotWantSlice(t, least, []int{10, 20, 30, 40})
+
+	test.GotWantSlice(t, TopKSorted(slices.Clone(scores), 3, greater), []int{99, 95, 90})
+	test.GotWantSlice(t, TopKSorted(slices.Clone(scores), 3, cmp.Less[int]), []int{10, 20, 30})
+}
+
+// Returns the number of comparisons SelectKth makes for the median of n
+// elements whose order is decided lazily by McIlroy's adversary, which
+// makes every median-of-three pivot as poor as it can be.
+func adversarialComparisons(n int) int {
+	const gas = math.MaxInt
+	values := make([]int, n)
+	data := make([]int, n)
+	for i := range n {
+		values[i], data[i] = gas, i
+	}
+
+	solid, candidate, comparisons := 0, 0, 0
+	less := func(a, b int) bool {
+		comparisons++
+		if values[a] == gas && values[b] == gas {
+			if a == candidate {
+				values[a] = solid
+			} else {
+				values[b] = solid
+			}
+			solid++
+		}
+
+		if values[a] == gas {
+			candidate = a
+		} else if values[b] == gas {
+			candidate = b
+		}
+
+		return values[a] < values[b]
+	}
+
+	SelectKth(data, n/2, less)
+	return comparisons
+}
+
+// Verifies the number of comparisons grows linearly for adversarial input
+func TestSelectKth_Adversarial(t *testing.T) {
+	for _, n := range []int{1 << 10, 1 << 14, 1 << 18} {
+		if got := adversarialComparisons(n); got > 16*n {
+			t.Fatalf("n=%d: %d comparisons, %.1f per element", n, got, float64(got)/float64(n))
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package algorithms
+
+import (
+	"math/rand/v2"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Shuffle reorders the slice into a uniformly random permutation using the
+// Fisher–Yates algorithm.
+//
+// Walking from the back, each position is swapped with a random position
+// at or before it, so every one of the n! permutations is equally likely.
+// Randomness comes from the given source; passing a seeded source such as
+// rand.NewPCG makes the result reproducible, which keeps tests that depend
+// on a shuffle deterministic.
+//
+// Parameters:
+//   - data: The slice to shuffle in place
+//   - src: The source of randomness
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	data := []int{1, 2, 3, 4}
+//	Shuffle(data, rand.NewPCG(1, 2))  // Same order on every run
+func Shuffle[T any](data []T, src rand.Source) {
+	r := rand.New(src)
+	for i := len(data) - 1; i > 0; i-- {
+		j := r.IntN(i + 1)
+		data[i], data[j] = data[j], data[i]
+	}
+}
+
+// SampleN moves a uniformly random sample of n elements to the front of the
+// slice, in random order, and returns it as data[:n]. The remaining
+// elements end up in data[n:] in unspecified order.
+//
+// This is a Fisher–Yates shuffle stopped after n steps: each step swaps a
+// random element of the not yet sampled part into the next position, so
+// sampling costs O(n) regardless of the length of the slice. The returned
+// sample shares memory with data; clone it to keep it past later changes.
+//
+// Parameters:
+//   - data: The slice to sample from, reordered in place
+//   - n: The number of elements to sample, in [0, len(data)]
+//   - src: The source of randomness
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(1)
+//
+// Panics if n is negative or greater than len(data).
+//
+// Example:
+//
+//	data := []string{"a", "b", "c", "d", "e"}
+//	sample := SampleN(data, 2, rand.NewPCG(1, 2))  // Two distinct elements
+func SampleN[T any](data []T, n int, src rand.Source) []T {
+	panics.RequireNonNegative(n, "sample size")
+	panics.RequireLessThanOrEqualTo(n, len(data), "sample size")
+
+	r := rand.New(src)
+	for i := range n {
+		j := i + r.IntN(len(data)-i)
+		data[i], data[j] = data[j], data[i]
+	}
+
+	return data[:n]
+}
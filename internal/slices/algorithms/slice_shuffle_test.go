@@ -0,0 +1,135 @@
+package algorithms
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// Shuffle:
+//  ✓ Empty and single-element slices
+//  ✓ Elements are kept, seeded order is reproducible
+//  ✓ All permutations equally likely
+//
+// SampleN:
+//  ✓ Invalid sample size
+//  ✓ Zero and full samples
+//  ✓ Sample is distinct elements of the slice
+//  ✓ Every element equally likely to be sampled
+
+// Verifies shuffling empty and single-element slices does nothing
+func TestShuffle_Short(t *testing.T) {
+	empty := []int{}
+	Shuffle(empty, rand.NewPCG(1, 1))
+	test.GotWant(t, len(empty), 0)
+
+	single := []int{7}
+	Shuffle(single, rand.NewPCG(1, 1))
+	test.GotWantSlice(t, single, []int{7})
+}
+
+// Verifies Shuffle keeps the elements and a seeded source reproduces the order
+func TestShuffle_Deterministic(t *testing.T) {
+	a, b := make([]int, 50), make([]int, 50)
+	for i := range a {
+		a[i], b[i] = i, i
+	}
+
+	Shuffle(a, rand.NewPCG(3, 4))
+	Shuffle(b, rand.NewPCG(3, 4))
+	test.GotWantSlice(t, a, b)
+
+	sorted := slices.Clone(a)
+	slices.Sort(sorted)
+	for i, v := range sorted {
+		test.GotWant(t, v, i)
+	}
+}
+
+// Verifies all six permutations of three elements occur about equally often
+func TestShuffle_Uniform(t *testing.T) {
+	src := rand.NewPCG(5, 6)
+	counts := map[[3]int]int{}
+	const trials = 60_000
+	for range trials {
+		data := []int{1, 2, 3}
+		Shuffle(data, src)
+		counts[[3]int(data)]++
+	}
+
+	test.GotWant(t, len(counts), 6)
+	for perm, count := range counts {
+		// Expected 10000 each; 5 standard deviations is about 450
+		if count < 9500 || count > 10500 {
+			t.Errorf("permutation %v occurred %d times, want about %d", perm, count, trials/6)
+		}
+	}
+}
+
+// Verifies SampleN panics for sizes outside [0, len(data)]
+func TestSampleN_InvalidSize(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		SampleN([]int{1, 2}, -1, rand.NewPCG(1, 1))
+	}, `"sample size" must be >= 0, got -1`)
+	test.GotWantPanic(t, func() {
+		SampleN([]int{1, 2}, 3, rand.NewPCG(1, 1))
+	}, `"sample size" must be <= 2, got 3`)
+}
+
+// Verifies zero and full samples
+func TestSampleN_Bounds(t *testing.T) {
+	data := []int{1, 2, 3}
+	test.GotWant(t, len(SampleN(data, 0, rand.NewPCG(1, 1))), 0)
+
+	full := slices.Clone(SampleN(data, 3, rand.NewPCG(1, 1)))
+	slices.Sort(full)
+	test.GotWantSlice(t, full, []int{1, 2, 3})
+}
+
+// Verifies the sample holds distinct elements and is the front of the slice
+func TestSampleN_Distinct(t *testing.T) {
+	data := make([]int, 100)
+	for i := range data {
+		data[i] = i
+	}
+
+	sample := SampleN(data, 10, rand.NewPCG(7, 8))
+	test.GotWant(t, len(sample), 10)
+	test.GotWant(t, &sample[0], &data[0])
+
+	seen := map[int]bool{}
+	for _, v := range sample {
+		test.GotWant(t, seen[v], false)
+		seen[v] = true
+	}
+
+	sorted := slices.Clone(data)
+	slices.Sort(sorted)
+	for i, v := range sorted {
+		test.GotWant(t, v, i)
+	}
+}
+
+// Verifies every element is sampled about equally often
+func TestSampleN_Uniform(t *testing.T) {
+	src := rand.NewPCG(9, 10)
+	counts := make([]int, 10)
+	const trials = 20_000
+	for range trials {
+		data := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		for _, v := range SampleN(data, 3, src) {
+			counts[v]++
+		}
+	}
+
+	for v, count := range counts {
+		// Expected 6000 each; 5 standard deviations is about 320
+		if count < 5600 || count > 6400 {
+			t.Errorf("element %d sampled %d times, want about %d", v, count, trials*3/10)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package algorithms
+
+import "math/bits"
+
+// Slices of at most this length are finished by insertion sort in the
+// hybrid sorts, where its low overhead beats further partitioning.
+const insertionSortThreshold = 12
+
+// Sort sorts the slice in ascending order according to less, which must be
+// a strict weak ordering such as cmp.Less. The sort is not stable.
+//
+// Sort is an introsort: it runs Quicksort but tracks the recursion depth,
+// switches to Heapsort for any part that has been partitioned more than
+// 2·log2(n) times, and finishes short parts with Insertionsort. This keeps
+// quicksort's speed on typical inputs while guaranteeing O(n log n) on
+// inputs that defeat the pivot choice.
+//
+// Time complexity: O(n log n)
+//
+// Space complexity: O(log n)
+//
+// Example:
+//
+//	data := []int{3, 1, 2}
+//	Sort(data, cmp.Less[int])  // data is [1, 2, 3]
+func Sort[T any](data []T, less func(a, b T) bool) {
+	introsort(data, less, 2*bits.Len(uint(len(data))))
+}
+
+// Quicksort sorts the slice in ascending order according to less. The sort
+// is not stable.
+//
+// Each step partitions the slice around the median of its first, middle
+// and last elements, then sorts both sides. Elements equal to the pivot
+// are split evenly between the sides, so runs of duplicates do not degrade
+// the running time. The smaller side is sorted recursively and the larger
+// one iteratively, which bounds the stack depth by O(log n).
+//
+// Time complexity:
+//   - Average case: O(n log n)
+//   - Worst case: O(n²) on inputs that defeat the median-of-three pivot
+//
+// Space complexity: O(log n)
+//
+// Example:
+//
+//	data := []string{"b", "c", "a"}
+//	Quicksort(data, cmp.Less[string])  // data is ["a", "b", "c"]
+func Quicksort[T any](data []T, less func(a, b T) bool) {
+	for len(data) > 1 {
+		p := partition(data, less)
+		if p < len(data)-p-1 {
+			Quicksort(data[:p], less)
+			data = data[p+1:]
+		} else {
+			Quicksort(data[p+1:], less)
+			data = data[:p]
+		}
+	}
+}
+
+// Mergesort sorts the slice in ascending order according to less. The sort
+// is stable: equal elements keep their original order.
+//
+// The slice is split in halves that are sorted recursively and merged by
+// moving the left half to a buffer and merging it back with the right
+// half in place. Short halves are sorted with Insertionsort, and the merge
+// is skipped when the halves are already in order, which makes sorted
+// inputs O(n).
+//
+// Time complexity: O(n log n)
+//
+// Space complexity: O(n) for a buffer of n/2 elements
+//
+// Example:
+//
+//	people := []person{{"Ann", 30}, {"Bob", 25}, {"Cid", 30}}
+//	Mergesort(people, func(a, b person) bool { return a.age < b.age })
+//	// people is [Bob 25, Ann 30, Cid 30]; Ann stays before Cid
+func Mergesort[T any](data []T, less func(a, b T) bool) {
+	buffer := make([]T, 0, len(data)/2)
+	mergesort(data, buffer, less)
+	clear(buffer[:cap(buffer)]) // Help GC
+}
+
+// Heapsort sorts the slice in ascending order according to less. The sort
+// is not stable.
+//
+// The slice is rearranged into a binary max-heap in O(n), then the maximum
+// is repeatedly swapped to the end of the shrinking heap and the new root
+// sifted down. Heapsort guarantees O(n log n) with no extra memory, but
+// its scattered accesses make it slower than Quicksort in practice.
+//
+// Time complexity: O(n log n)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	data := []int{5, 2, 4}
+//	Heapsort(data, cmp.Less[int])  // data is [2, 4, 5]
+func Heapsort[T any](data []T, less func(a, b T) bool) {
+	n := len(data)
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(data, i, n, less)
+	}
+
+	for end := n - 1; end > 0; end-- {
+		data[0], data[end] = data[end], data[0]
+		siftDown(data, 0, end, less)
+	}
+}
+
+// Insertionsort sorts the slice in ascending order according to less. The
+// sort is stable: equal elements keep their original order.
+//
+// Each element is shifted left past the larger elements before it. The
+// quadratic running time rules it out for long slices, but on short or
+// nearly sorted ones it beats the O(n log n) algorithms, which is why they
+// use it to finish their smallest parts.
+//
+// Time complexity:
+//   - Best case: O(n) for a sorted slice
+//   - Worst case: O(n²)
+//
+// Space complexity: O(1)
+//
+// Example:
+//
+//	data := []int{1, 2, 4, 3}
+//	Insertionsort(data, cmp.Less[int])  // data is [1, 2, 3, 4]
+func Insertionsort[T any](data []T, less func(a, b T) bool) {
+	for i := 1; i < len(data); i++ {
+		value := data[i]
+		j := i
+		for ; j > 0 && less(value, data[j-1]); j-- {
+			data[j] = data[j-1]
+		}
+		data[j] = value
+	}
+}
+
+// Sorts the slice with quicksort, falling back to heapsort once depth
+// partitioning steps have been spent and to insertion sort for short parts.
+func introsort[T any](data []T, less func(a, b T) bool, depth int) {
+	for len(data) > insertionSortThreshold {
+		if depth == 0 {
+			Heapsort(data, less)
+			return
+		}
+
+		depth--
+		p := partition(data, less)
+		if p < len(data)-p-1 {
+			introsort(data[:p], less, depth)
+			data = data[p+1:]
+		} else {
+			introsort(data[p+1:], less, depth)
+			data = data[:p]
+		}
+	}
+
+	Insertionsort(data, less)
+}
+
+// Partitions a slice of at least two elements around the median of its
+// first, middle and last elements.
+// Returns the final index of the pivot: elements before it are not greater
+// and elements after it are not less than the pivot.
+func partition[T any](data []T, less func(a, b T) bool) int {
+	last, mid := len(data)-1, len(data)/2
+
+	// Order the three samples so the median ends up in the middle
+	if less(data[mid], data[0]) {
+		data[mid], data[0] = data[0], data[mid]
+	}
+	if less(data[last], data[mid]) {
+		data[last], data[mid] = data[mid], data[last]
+		if less(data[mid], data[0]) {
+			data[mid], data[0] = data[0], data[mid]
+		}
+	}
+
+	return partitionAround(data, mid, less)
+}
+
+// Partitions a non-empty slice around the element at the pivot index.
+// Returns the final index of the pivot: elements before it are not greater
+// and elements after it are not less than the pivot.
+func partitionAround[T any](data []T, pivot int, less func(a, b T) bool) int {
+	data[0], data[pivot] = data[pivot], data[0]
+	value := data[0]
+
+	// Both scans stop at elements equal to the pivot, which splits runs
+	// of duplicates evenly between the sides
+	i, j := 1, len(data)-1
+	for {
+		for i <= j && less(data[i], value) {
+			i++
+		}
+		for i <= j && less(value, data[j]) {
+			j--
+		}
+		if i >= j {
+			break
+		}
+
+		data[i], data[j] = data[j], data[i]
+		i++
+		j--
+	}
+
+	data[0], data[j] = data[j], data[0]
+	return j
+}
+
+// Sorts the slice with merge sort, using buffer to hold the left half
+// during merges. The buffer must have a capacity of at least len(data)/2.
+func mergesort[T any](data []T, buffer []T, less func(a, b T) bool) {
+	n := len(data)
+	if n <= insertionSortThreshold {
+		Insertionsort(data, less)
+		return
+	}
+
+	mid := n / 2
+	mergesort(data[:mid], buffer, less)
+	mergesort(data[mid:], buffer, less)
+
+	// Special case: The halves are already in order
+	if !less(data[mid], data[mid-1]) {
+		return
+	}
+
+	// Merge the buffered left half with the right half in place. The
+	// write index never passes the read index of the right half, and ties
+	// take the left element, which keeps the sort stable.
+	left := append(buffer[:0], data[:mid]...)
+	i, j, k := 0, mid, 0
+	for i < len(left) && j < n {
+		if less(data[j], left[i]) {
+			data[k] = data[j]
+			j++
+		} else {
+			data[k] = left[i]
+			i++
+		}
+		k++
+	}
+
+	copy(data[k:], left[i:])
+}
+
+// Restores the max-heap property of data[:n] below the root by moving the
+// root down past its larger children.
+func siftDown[T any](data []T, root int, n int, less func(a, b T) bool) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && less(data[child], data[child+1]) {
+			child++
+		}
+		if !less(data[root], data[child]) {
+			return
+		}
+
+		data[root], data[child] = data[child], data[root]
+		root = child
+	}
+}
@@ -0,0 +1,124 @@
+package algorithms
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"sort"
+	"testing"
+)
+
+// Sorting algorithms compared against each other. sort.Slice serves as the
+// standard library baseline.
+var benchSorts = map[string]func([]int){
+	// Sort: Introsort, quicksort with heapsort and insertion sort fallbacks.
+	// Expected: Fastest of the package on random input.
+	"Sort": func(data []int) {
+		Sort(data, cmp.Less[int])
+	},
+	// Quicksort: Median-of-three pivot, no cutoff.
+	// Expected: Close to Sort, slower on short parts.
+	"Quicksort": func(data []int) {
+		Quicksort(data, cmp.Less[int])
+	},
+	// Mergesort: Stable, n/2 buffer.
+	// Expected: Best on presorted input, slower on random input.
+	"Mergesort": func(data []int) {
+		Mergesort(data, cmp.Less[int])
+	},
+	// Heapsort: In place, guaranteed O(n log n).
+	// Expected: Slowest, scattered memory accesses.
+	"Heapsort": func(data []int) {
+		Heapsort(data, cmp.Less[int])
+	},
+	// sort.Slice: Standard library pdqsort through reflection-based swaps.
+	// Expected: Slower on random input, but detects sorted runs and
+	// duplicate-heavy parts that the package's sorts handle generically.
+	"sort.Slice": func(data []int) {
+		sort.Slice(data, func(i, j int) bool { return data[i] < data[j] })
+	},
+}
+
+// Number of elements sorted by every benchmark.
+const benchSortSize = 10_000
+
+// Runs every sorting algorithm on a fresh copy of the input per iteration.
+func benchmarkSorts(b *testing.B, input []int) {
+	for name, sortFunc := range benchSorts {
+		b.Run(name, func(b *testing.B) {
+			data := make([]int, len(input))
+			for b.Loop() {
+				copy(data, input)
+				sortFunc(data)
+			}
+		})
+	}
+}
+
+// BenchmarkSort_Random measures sorting uniformly random integers.
+//
+// Pattern: Sort(random permutation of 10000)
+// Expected winner: Sort (no reflection, insertion sort for short parts)
+func BenchmarkSort_Random(b *testing.B) {
+	benchmarkSorts(b, rand.New(rand.NewPCG(1, 1)).Perm(benchSortSize))
+}
+
+// BenchmarkSort_Sorted measures sorting input that is already sorted.
+//
+// Pattern: Sort(0, 1, ..., 9999)
+// Expected winner: sort.Slice (detects the sorted run in one pass), with
+// Mergesort close behind (every merge is skipped)
+func BenchmarkSort_Sorted(b *testing.B) {
+	input := make([]int, benchSortSize)
+	for i := range input {
+		input[i] = i
+	}
+	benchmarkSorts(b, input)
+}
+
+// BenchmarkSort_FewDistinct measures sorting input with many duplicates.
+//
+// Pattern: Sort(10000 values drawn from 0-9)
+// Expected winner: sort.Slice (groups elements equal to the pivot); Sort
+// leads the package's sorts (equal elements split evenly between the sides)
+func BenchmarkSort_FewDistinct(b *testing.B) {
+	r := rand.New(rand.NewPCG(2, 2))
+	input := make([]int, benchSortSize)
+	for i := range input {
+		input[i] = r.IntN(10)
+	}
+	benchmarkSorts(b, input)
+}
+
+// BenchmarkSort_Short measures sorting many short slices, the case the
+// hybrid sorts hand to insertion sort.
+//
+// Pattern: [Sort(random permutation of 12)] × 1000
+// Expected winner: Insertionsort (no partitioning or recursion overhead)
+func BenchmarkSort_Short(b *testing.B) {
+	short := map[string]func([]int){
+		"Insertionsort": func(data []int) {
+			Insertionsort(data, cmp.Less[int])
+		},
+		"Quicksort":  benchSorts["Quicksort"],
+		"Heapsort":   benchSorts["Heapsort"],
+		"sort.Slice": benchSorts["sort.Slice"],
+	}
+
+	r := rand.New(rand.NewPCG(3, 3))
+	inputs := make([][]int, 1000)
+	for i := range inputs {
+		inputs[i] = r.Perm(insertionSortThreshold)
+	}
+
+	for name, sortFunc := range short {
+		b.Run(name, func(b *testing.B) {
+			data := make([]int, insertionSortThreshold)
+			for b.Loop() {
+				for _, input := range inputs {
+					copy(data, input)
+					sortFunc(data)
+				}
+			}
+		})
+	}
+}
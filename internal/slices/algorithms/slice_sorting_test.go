@@ -0,0 +1,149 @@
+package algorithms
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// Sort/Quicksort/Mergesort/Heapsort/Insertionsort:
+//  ✓ Empty, single and two-element slices
+//  ✓ Sorted, reversed, all-equal and few-distinct inputs
+//  ✓ Custom order
+//  ✓ Randomized slices match slices.Sort
+//
+// Mergesort/Insertionsort:
+//  ✓ Stability
+//
+// Sort:
+//  ✓ Heapsort fallback once the depth limit is spent
+
+// Sorting algorithms under test.
+var sortFuncs = map[string]func([]int, func(a, b int) bool){
+	"Sort":          Sort[int],
+	"Quicksort":     Quicksort[int],
+	"Mergesort":     Mergesort[int],
+	"Heapsort":      Heapsort[int],
+	"Insertionsort": Insertionsort[int],
+}
+
+// Verifies every algorithm on edge-case and patterned inputs
+func TestSort_Inputs(t *testing.T) {
+	ascending := make([]int, 100)
+	descending := make([]int, 100)
+	equal := make([]int, 100)
+	fewDistinct := make([]int, 100)
+	for i := range 100 {
+		ascending[i] = i
+		descending[i] = 100 - i
+		equal[i] = 7
+		fewDistinct[i] = (i * 37) % 3
+	}
+
+	cases := []struct {
+		name string
+		data []int
+	}{
+		{name: "empty", data: []int{}},
+		{name: "single", data: []int{1}},
+		{name: "two", data: []int{2, 1}},
+		{name: "ascending", data: ascending},
+		{name: "descending", data: descending},
+		{name: "all_equal", data: equal},
+		{name: "few_distinct", data: fewDistinct},
+	}
+
+	for name, sort := range sortFuncs {
+		for _, c := range cases {
+			t.Run(name+"/"+c.name, func(t *testing.T) {
+				got := slices.Clone(c.data)
+				sort(got, cmp.Less[int])
+				want := slices.Clone(c.data)
+				slices.Sort(want)
+				test.GotWantSlice(t, got, want)
+			})
+		}
+	}
+}
+
+// Verifies every algorithm follows the order of the less function
+func TestSort_CustomOrder(t *testing.T) {
+	greater := func(a, b int) bool { return a > b }
+	for name, sort := range sortFuncs {
+		t.Run(name, func(t *testing.T) {
+			data := []int{3, 9, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5, 8, 9, 7, 9, 3, 2, 3, 8}
+			sort(data, greater)
+			test.GotWantSlice(t, data, []int{9, 9, 9, 9, 8, 8, 7, 6, 5, 5, 5, 4, 3, 3, 3, 3, 2, 2, 1, 1})
+		})
+	}
+}
+
+// Verifies the stable algorithms keep equal elements in their original order
+func TestSort_Stability(t *testing.T) {
+	type entry struct{ key, order int }
+	stable := map[string]func([]entry, func(a, b entry) bool){
+		"Mergesort":     Mergesort[entry],
+		"Insertionsort": Insertionsort[entry],
+	}
+
+	r := rand.New(rand.NewPCG(52, 52))
+	for name, sort := range stable {
+		t.Run(name, func(t *testing.T) {
+			data := make([]entry, 500)
+			for i := range data {
+				data[i] = entry{key: r.IntN(10), order: i}
+			}
+
+			sort(data, func(a, b entry) bool { return a.key < b.key })
+			for i := 1; i < len(data); i++ {
+				prev, cur := data[i-1], data[i]
+				if prev.key > cur.key || prev.key == cur.key && prev.order > cur.order {
+					t.Fatalf("entries %d and %d are out of order: %v, %v", i-1, i, prev, cur)
+				}
+			}
+		})
+	}
+}
+
+// Verifies Sort still sorts once the depth limit forces the heapsort fallback
+func TestSort_HeapsortFallback(t *testing.T) {
+	r := rand.New(rand.NewPCG(53, 53))
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = r.IntN(100)
+	}
+	want := slices.Clone(data)
+	slices.Sort(want)
+
+	for _, depth := range []int{0, 1, 3} {
+		got := slices.Clone(data)
+		introsort(got, cmp.Less[int], depth)
+		test.GotWantSlice(t, got, want)
+	}
+}
+
+// Verifies every algorithm against slices.Sort on random slices
+func TestSort_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(54, 54))
+	for name, sort := range sortFuncs {
+		t.Run(name, func(t *testing.T) {
+			for range 200 {
+				data := make([]int, r.IntN(300))
+				limit := 1 + r.IntN(1000)
+				for i := range data {
+					data[i] = r.IntN(limit)
+				}
+
+				want := slices.Clone(data)
+				slices.Sort(want)
+				sort(data, cmp.Less[int])
+				test.GotWantSlice(t, data, want)
+			}
+		})
+	}
+}
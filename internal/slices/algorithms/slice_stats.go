@@ -0,0 +1,26 @@
+package algorithms
+
+// SliceStats describes the outcome of a Compact or Reallocate call, so
+// structures can aggregate optimization telemetry without re-deriving it
+// from the slices before and after the call.
+//
+// Waste is measured the way the reporting function measures it for its
+// trigger: relative to the length for Compact and to the capacity for
+// Reallocate.
+type SliceStats struct {
+	Triggered   bool // Whether the optimization ran
+	Moved       int  // Number of elements copied
+	CapBefore   int  // Capacity of the input slice
+	CapAfter    int  // Capacity of the returned slice
+	WasteBefore int  // Waste percent of the input slice (0-100)
+	WasteAfter  int  // Waste percent of the returned slice (0-100)
+}
+
+// Returns the percent of size not taken by used elements, 0 for size 0.
+func wastePercent(used int, size int) int {
+	if size == 0 {
+		return 0
+	}
+
+	return 100 - 100*used/size
+}
@@ -0,0 +1,158 @@
+package algorithms
+
+import (
+	"cmp"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/constraints"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// WindowSum returns the sum of every window of size consecutive elements:
+// result[i] is the sum of data[i:i+size]. Only full windows are reported,
+// so the result has len(data)-size+1 elements, or none if the slice is
+// shorter than the window.
+//
+// The sum is kept running: each step adds the element entering the window
+// and subtracts the one leaving it, instead of summing the window anew.
+// For floating-point and complex data the rounding errors of the
+// additions and subtractions accumulate along the slice.
+//
+// Parameters:
+//   - data: The values to aggregate
+//   - size: The window size, greater than 0
+//
+// Time complexity: O(n), independent of the window size
+//
+// Space complexity: O(n) for the result
+//
+// Panics if size is not greater than 0.
+//
+// Example:
+//
+//	WindowSum([]int{1, 2, 3, 4, 5}, 3)  // Returns [6, 9, 12]
+func WindowSum[T constraints.Number | constraints.Complex](data []T, size int) []T {
+	panics.RequireGreaterThan(size, 0, "window size")
+	if size > len(data) {
+		return []T{}
+	}
+
+	result := make([]T, 0, len(data)-size+1)
+	var sum T
+	for i, v := range data {
+		sum += v
+		if i >= size {
+			sum -= data[i-size]
+		}
+		if i >= size-1 {
+			result = append(result, sum)
+		}
+	}
+
+	return result
+}
+
+// WindowMean returns the arithmetic mean of every window of size
+// consecutive elements. See WindowSum for the shape of the result.
+//
+// Time complexity: O(n), independent of the window size
+//
+// Space complexity: O(n) for the result
+//
+// Panics if size is not greater than 0.
+//
+// Example:
+//
+//	WindowMean([]int{1, 2, 3, 4}, 2)  // Returns [1.5, 2.5, 3.5]
+func WindowMean[T constraints.Number](data []T, size int) []float64 {
+	sums := WindowSum(data, size)
+	result := make([]float64, len(sums))
+	for i, sum := range sums {
+		result[i] = float64(sum) / float64(size)
+	}
+
+	return result
+}
+
+// WindowMin returns the minimum of every window of size consecutive
+// elements. See WindowSum for the shape of the result and WindowMinFunc
+// for the algorithm.
+//
+// Time complexity: O(n), independent of the window size
+//
+// Space complexity: O(n) for the result, O(size) for the candidates
+//
+// Panics if size is not greater than 0.
+//
+// Example:
+//
+//	WindowMin([]int{4, 2, 5, 6, 7}, 3)  // Returns [2, 2, 5]
+func WindowMin[T constraints.Ordered](data []T, size int) []T {
+	return WindowMinFunc(data, size, cmp.Less[T])
+}
+
+// WindowMax returns the maximum of every window of size consecutive
+// elements. See WindowSum for the shape of the result and WindowMinFunc
+// for the algorithm.
+//
+// Time complexity: O(n), independent of the window size
+//
+// Space complexity: O(n) for the result, O(size) for the candidates
+//
+// Panics if size is not greater than 0.
+//
+// Example:
+//
+//	WindowMax([]int{4, 2, 5, 6, 1}, 3)  // Returns [5, 6, 6]
+func WindowMax[T constraints.Ordered](data []T, size int) []T {
+	return WindowMinFunc(data, size, func(a, b T) bool { return a > b })
+}
+
+// WindowMinFunc returns the least element of every window of size
+// consecutive elements according to less, so a > b selects maxima. See
+// WindowSum for the shape of the result.
+//
+// It uses the monotonic-deque technique of MonotonicWindow in package
+// queues/structures, keeping indices into data as the candidates, which
+// gives O(n) overall instead of the O(n·size) of scanning each window.
+//
+// Time complexity: O(n), independent of the window size
+//
+// Space complexity: O(n) for the result, O(size) for the candidates
+//
+// Panics if size is not greater than 0.
+//
+// Example:
+//
+//	// Shortest word of every pair of neighbors
+//	words := []string{"go", "rust", "c", "java"}
+//	WindowMinFunc(words, 2, func(a, b string) bool { return len(a) < len(b) })
+//	// Returns ["go", "c", "c"]
+func WindowMinFunc[T any](data []T, size int, less func(a, b T) bool) []T {
+	panics.RequireGreaterThan(size, 0, "window size")
+	if size > len(data) {
+		return []T{}
+	}
+
+	// Ring buffer of candidate indices; at most size are live at once
+	candidates := make([]int, size)
+	front, count := 0, 0
+
+	result := make([]T, 0, len(data)-size+1)
+	for i, v := range data {
+		if count > 0 && candidates[front] <= i-size {
+			front = (front + 1) % size
+			count--
+		}
+		for count > 0 && !less(data[candidates[(front+count-1)%size]], v) {
+			count--
+		}
+
+		candidates[(front+count)%size] = i
+		count++
+		if i >= size-1 {
+			result = append(result, data[candidates[front]])
+		}
+	}
+
+	return result
+}
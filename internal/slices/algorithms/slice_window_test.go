@@ -0,0 +1,110 @@
+package algorithms
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Test Coverage
+// =============
+// WindowSum/WindowMean/WindowMin/WindowMax/WindowMinFunc:
+//  ✓ Non-positive window size
+//  ✓ Window longer than the slice
+//  ✓ Window of one and of the whole slice
+//  ✓ Fixed inputs
+//  ✓ Unsigned values
+//  ✓ Complex sums
+//  ✓ Randomized slices match scanning every window
+//
+// WindowMinFunc:
+//  ✓ Custom order
+
+// Verifies the aggregations accept unsigned values
+func TestWindow_Unsigned(t *testing.T) {
+	data := []uint{5, 1, 7, 2}
+	test.GotWantSlice(t, WindowSum(data, 2), []uint{6, 8, 9})
+	test.GotWantSlice(t, WindowMean(data, 2), []float64{3, 4, 4.5})
+	test.GotWantSlice(t, WindowMax(data, 2), []uint{5, 7, 7})
+}
+
+// Verifies WindowSum accepts complex values
+func TestWindow_ComplexSum(t *testing.T) {
+	data := []complex128{1 + 2i, 3, -1i, 2 + 2i}
+	test.GotWantSlice(t, WindowSum(data, 2), []complex128{4 + 2i, 3 - 1i, 2 + 1i})
+}
+
+// Verifies every aggregation panics for a non-positive window size
+func TestWindow_InvalidSize(t *testing.T) {
+	want := `"window size" must be > 0, got 0`
+	test.GotWantPanic(t, func() { WindowSum([]int{1}, 0) }, want)
+	test.GotWantPanic(t, func() { WindowMean([]int{1}, 0) }, want)
+	test.GotWantPanic(t, func() { WindowMin([]int{1}, 0) }, want)
+	test.GotWantPanic(t, func() { WindowMax([]int{1}, 0) }, want)
+}
+
+// Verifies windows longer than the slice produce no results
+func TestWindow_LongerThanSlice(t *testing.T) {
+	data := []int{1, 2}
+	test.GotWant(t, len(WindowSum(data, 3)), 0)
+	test.GotWant(t, len(WindowMean(data, 3)), 0)
+	test.GotWant(t, len(WindowMin(data, 3)), 0)
+	test.GotWant(t, len(WindowMax([]int{}, 1)), 0)
+}
+
+// Verifies windows of one element and of the whole slice
+func TestWindow_Extremes(t *testing.T) {
+	data := []int{3, 1, 4, 1, 5}
+	test.GotWantSlice(t, WindowSum(data, 1), data)
+	test.GotWantSlice(t, WindowMin(data, 1), data)
+	test.GotWantSlice(t, WindowSum(data, 5), []int{14})
+	test.GotWantSlice(t, WindowMin(data, 5), []int{1})
+	test.GotWantSlice(t, WindowMax(data, 5), []int{5})
+}
+
+// Verifies every aggregation on a fixed input
+func TestWindow_Inputs(t *testing.T) {
+	data := []int{4, 2, 5, 6, 1, 3}
+	test.GotWantSlice(t, WindowSum(data, 3), []int{11, 13, 12, 10})
+	test.GotWantSlice(t, WindowMean(data, 2), []float64{3, 3.5, 5.5, 3.5, 2})
+	test.GotWantSlice(t, WindowMin(data, 3), []int{2, 2, 1, 1})
+	test.GotWantSlice(t, WindowMax(data, 3), []int{5, 6, 6, 6})
+	test.GotWantSlice(t, WindowMean([]float64{0.5, 1.5}, 2), []float64{1})
+}
+
+// Verifies WindowMinFunc follows the order of the less function
+func TestWindowMinFunc_CustomOrder(t *testing.T) {
+	words := []string{"go", "rust", "c", "java"}
+	shorter := func(a, b string) bool { return len(a) < len(b) }
+	test.GotWantSlice(t, WindowMinFunc(words, 2, shorter), []string{"go", "c", "c"})
+}
+
+// Verifies random slices and window sizes against scanning every window
+func TestWindow_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(57, 57))
+	for range 300 {
+		data := make([]int, r.IntN(40))
+		for i := range data {
+			data[i] = r.IntN(20) - 10
+		}
+		size := 1 + r.IntN(10)
+
+		wantSum, wantMin, wantMax := []int{}, []int{}, []int{}
+		for i := 0; i+size <= len(data); i++ {
+			window := data[i : i+size]
+			sum := 0
+			for _, v := range window {
+				sum += v
+			}
+			wantSum = append(wantSum, sum)
+			wantMin = append(wantMin, slices.Min(window))
+			wantMax = append(wantMax, slices.Max(window))
+		}
+
+		test.GotWantSlice(t, WindowSum(data, size), wantSum)
+		test.GotWantSlice(t, WindowMin(data, size), wantMin)
+		test.GotWantSlice(t, WindowMax(data, size), wantMax)
+	}
+}
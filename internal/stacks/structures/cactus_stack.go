@@ -0,0 +1,183 @@
+package structures
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ Stack[int] = &CactusStack[int]{}
+var _ collections.Iterable[int] = &CactusStack[int]{}
+
+// Represents a single immutable frame in a cactus stack.
+// Each frame points to its parent, so many tops can share one tail.
+type cactusFrame[T any] struct {
+	value  T
+	parent *cactusFrame[T]
+	depth  int // Number of frames from the bottom up to and including this one
+}
+
+// CactusStack implements a LIFO stack handle over a tree of parent-pointer
+// frames (also known as a spaghetti or parent-pointer stack).
+//
+// Fork creates another handle whose stack starts out identical to the
+// original. After forking, each handle pushes and pops independently and
+// only the common tail is shared, so branching histories cost O(1) per
+// branch instead of a copy of the whole stack. This suits backtracking
+// algorithms (fork before trying a choice, discard the fork on failure)
+// and interpreters (closures capturing the current call chain).
+//
+// Design decisions:
+//   - Immutable frames: Sharing a tail between handles is always safe
+//   - Depth per frame: Enables O(1) Size and O(d) SharedDepth
+//   - Mutable handle: Push/Pop update the handle, unlike PersistentStack
+//
+// A single handle is not safe for concurrent mutation, but different
+// handles may be used from different goroutines concurrently.
+//
+// Space complexity: O(n) across all handles, where n is the number of
+// distinct frames pushed.
+type CactusStack[T any] struct {
+	top *cactusFrame[T] // Top frame of this handle (nil when empty)
+}
+
+// NewCactusStack creates a cactus stack with optional initial values.
+// The last value provided ends up on top of the stack.
+//
+// Time complexity: O(n) where n is the number of initial values
+//
+// Example:
+//
+//	empty := NewCactusStack[int]()
+//	withValues := NewCactusStack(1, 2, 3)  // Top is 3
+func NewCactusStack[T any](values ...T) *CactusStack[T] {
+	s := &CactusStack[T]{}
+	for _, v := range values {
+		s.Push(v)
+	}
+
+	return s
+}
+
+// Push adds an element to the top of this handle's stack.
+// Other handles sharing the same tail are not affected.
+//
+// Time complexity: O(1)
+func (s *CactusStack[T]) Push(value T) {
+	s.top = &cactusFrame[T]{
+		value:  value,
+		parent: s.top,
+		depth:  s.Size() + 1,
+	}
+}
+
+// Pop removes and returns the element at the top of this handle's stack.
+// The frame itself stays alive while other handles still share it.
+// Returns ErrEmptyStack if the stack is empty.
+//
+// Time complexity: O(1)
+func (s *CactusStack[T]) Pop() (T, error) {
+	if s.top == nil {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+
+	v := s.top.value
+	s.top = s.top.parent
+	return v, nil
+}
+
+// Peek returns the element at the top of this handle's stack without
+// removing it. Returns ErrEmptyStack if the stack is empty.
+//
+// Time complexity: O(1)
+func (s *CactusStack[T]) Peek() (T, error) {
+	if s.top == nil {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+
+	return s.top.value, nil
+}
+
+// Fork returns a new handle whose stack is identical to this one.
+// Both handles share all current frames and diverge on the next Push or Pop.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	s := NewCactusStack(1, 2)
+//	f := s.Fork()
+//	s.Push(3)  // s is [1, 2, 3]
+//	f.Push(4)  // f is [1, 2, 4], frames 1 and 2 are shared
+func (s *CactusStack[T]) Fork() *CactusStack[T] {
+	return &CactusStack[T]{top: s.top}
+}
+
+// SharedDepth returns the number of bottom elements this handle shares
+// with other, that is, the depth of their deepest common frame.
+// Values are not compared; only physically shared frames count.
+//
+// Time complexity: O(d) where d is the larger stack size
+//
+// Example:
+//
+//	s := NewCactusStack(1, 2)
+//	f := s.Fork()
+//	s.Push(3)
+//	f.Pop()
+//	s.SharedDepth(f)  // Returns 1
+func (s *CactusStack[T]) SharedDepth(other *CactusStack[T]) int {
+	a, b := s.top, other.top
+	// Align both frames to the same depth, then walk up in lockstep
+	for s.depthOf(a) > s.depthOf(b) {
+		a = a.parent
+	}
+	for s.depthOf(b) > s.depthOf(a) {
+		b = b.parent
+	}
+	for a != b {
+		a, b = a.parent, b.parent
+	}
+
+	return s.depthOf(a)
+}
+
+// Returns the depth of the frame, treating nil as the empty bottom.
+func (s *CactusStack[T]) depthOf(f *cactusFrame[T]) int {
+	if f == nil {
+		return 0
+	}
+
+	return f.depth
+}
+
+// All returns an iterator over the elements from top to bottom,
+// in the order they would be popped from this handle.
+//
+// Time complexity: O(n) for a full iteration
+func (s *CactusStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for f := s.top; f != nil; f = f.parent {
+			if !yield(f.value) {
+				return
+			}
+		}
+	}
+}
+
+// IsEmpty returns true if this handle's stack contains no elements.
+//
+// Time complexity: O(1)
+func (s *CactusStack[T]) IsEmpty() bool {
+	return s.top == nil
+}
+
+// Size returns the number of elements in this handle's stack.
+//
+// Time complexity: O(1)
+func (s *CactusStack[T]) Size() int {
+	return s.depthOf(s.top)
+}
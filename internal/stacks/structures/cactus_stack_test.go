@@ -0,0 +1,173 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewCactusStack):
+  ✓ Empty stack
+  ✓ Multiple values (last on top)
+
+Push/Pop/Peek:
+  ✓ Empty stack (error)
+  ✓ LIFO order
+  ✓ Reusable after emptying the stack
+
+Fork:
+  ✓ Fork starts identical to the original
+  ✓ Pushes on one handle are invisible to the other
+  ✓ Pops on one handle are invisible to the other
+  ✓ Forks share the common tail frames
+
+SharedDepth:
+  ✓ Unrelated stacks
+  ✓ Identical handles
+  ✓ Diverged handles
+  ✓ Values equal but frames not shared
+
+All:
+  ✓ Top-to-bottom order
+*/
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty stack
+func TestCactusStack_NewCactusStack_Empty(t *testing.T) {
+	s := NewCactusStack[int]()
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies the creation of multi-element stack
+func TestCactusStack_NewCactusStack_ManyValues(t *testing.T) {
+	s := NewCactusStack(1, 2, 3)
+	test.GotWant(t, s.Size(), 3)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 3)
+}
+
+// Verifies popping and peeking an empty stack
+func TestCactusStack_PopPeek_EmptyStack(t *testing.T) {
+	s := NewCactusStack[int]()
+	d, dErr := s.Pop()
+	test.GotWantErrorIs(t, dErr, ErrEmptyStack)
+	test.GotWant(t, d, 0)
+	p, pErr := s.Peek()
+	test.GotWantErrorIs(t, pErr, ErrEmptyStack)
+	test.GotWant(t, p, 0)
+}
+
+// Verifies Last-In-First-Out element order
+func TestCactusStack_PushPop_Order(t *testing.T) {
+	s := NewCactusStack[int]()
+	for i := range 5 {
+		s.Push(i + 1)
+		test.GotWant(t, s.Size(), i+1)
+	}
+
+	for i := range 5 {
+		d, _ := s.Pop()
+		test.GotWant(t, d, 5-i)
+	}
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies the stack is reusable
+func TestCactusStack_PushPop_Reusability(t *testing.T) {
+	s := NewCactusStack[int]()
+	s.Push(1)
+	s.Pop()
+	s.Push(2)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 2)
+	test.GotWant(t, s.Size(), 1)
+}
+
+// Verifies a fork starts out identical to the original
+func TestCactusStack_Fork_Identical(t *testing.T) {
+	s := NewCactusStack(1, 2, 3)
+	f := s.Fork()
+	test.GotWant(t, f.Size(), 3)
+	p, _ := f.Peek()
+	test.GotWant(t, p, 3)
+}
+
+// Verifies pushes on one handle are not visible to the other
+func TestCactusStack_Fork_IndependentPush(t *testing.T) {
+	s := NewCactusStack(1, 2)
+	f := s.Fork()
+	s.Push(3)
+	f.Push(4)
+
+	sp, _ := s.Peek()
+	fp, _ := f.Peek()
+	test.GotWant(t, sp, 3)
+	test.GotWant(t, fp, 4)
+	test.GotWant(t, s.Size(), 3)
+	test.GotWant(t, f.Size(), 3)
+}
+
+// Verifies pops on one handle are not visible to the other
+func TestCactusStack_Fork_IndependentPop(t *testing.T) {
+	s := NewCactusStack(1, 2, 3)
+	f := s.Fork()
+	f.Pop()
+	f.Pop()
+
+	test.GotWant(t, s.Size(), 3)
+	test.GotWant(t, f.Size(), 1)
+	sp, _ := s.Peek()
+	test.GotWant(t, sp, 3)
+}
+
+// Verifies forks physically share the common tail
+func TestCactusStack_Fork_SharedTail(t *testing.T) {
+	s := NewCactusStack(1, 2)
+	base := s.top
+	f := s.Fork()
+	s.Push(3)
+	f.Push(4)
+	test.GotWant(t, s.top.parent, base)
+	test.GotWant(t, f.top.parent, base)
+}
+
+// Verifies unrelated stacks share nothing
+func TestCactusStack_SharedDepth_Unrelated(t *testing.T) {
+	a := NewCactusStack(1, 2)
+	b := NewCactusStack(1, 2)
+	test.GotWant(t, a.SharedDepth(b), 0)
+	test.GotWant(t, a.SharedDepth(NewCactusStack[int]()), 0)
+}
+
+// Verifies identical handles share every frame
+func TestCactusStack_SharedDepth_Identical(t *testing.T) {
+	s := NewCactusStack(1, 2, 3)
+	test.GotWant(t, s.SharedDepth(s.Fork()), 3)
+}
+
+// Verifies diverged handles share the frames below the fork point
+func TestCactusStack_SharedDepth_Diverged(t *testing.T) {
+	s := NewCactusStack(1, 2)
+	f := s.Fork()
+	s.Push(3)
+	s.Push(4)
+	f.Pop()
+	f.Push(5)
+	test.GotWant(t, s.SharedDepth(f), 1)
+	test.GotWant(t, f.SharedDepth(s), 1)
+}
+
+// Verifies All yields elements from top to bottom
+func TestCactusStack_All_Order(t *testing.T) {
+	s := NewCactusStack(1, 2)
+	f := s.Fork()
+	f.Push(3)
+	got := []int{}
+	for v := range f.All() {
+		got = append(got, v)
+	}
+	test.GotWantSlice(t, got, []int{3, 2, 1})
+}
@@ -0,0 +1,143 @@
+package structures
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = PersistentStack[int]{}
+var _ collections.Iterable[int] = PersistentStack[int]{}
+
+// Represents a single immutable node in a persistent stack.
+// Nodes are shared between stack versions and never modified.
+type persistentNode[T any] struct {
+	value T
+	next  *persistentNode[T]
+}
+
+// PersistentStack implements an immutable LIFO stack with structural sharing.
+//
+// Push and Pop never modify the receiver; they return a new stack value
+// that shares all unchanged nodes with the original. Old versions remain
+// valid and unchanged, so a stack can be handed to concurrent readers as
+// a snapshot without copying or locking.
+//
+// Design decisions:
+//   - Value type: Stack versions are cheap to copy (one pointer, one int)
+//   - Shared tail: Push and Pop are O(1) and allocate at most one node
+//   - Size counter: Enables O(1) Size and IsEmpty operations
+//
+// The zero value is an empty stack ready to use.
+// All methods are safe for concurrent use by multiple goroutines.
+//
+// Space complexity: O(n) across all versions, where n is the number of
+// distinct pushes.
+type PersistentStack[T any] struct {
+	top  *persistentNode[T] // Top node (nil when empty)
+	size int                // Number of elements
+}
+
+// NewPersistentStack creates a persistent stack with optional initial values.
+// The last value provided ends up on top of the stack.
+//
+// Time complexity: O(n) where n is the number of initial values
+//
+// Example:
+//
+//	empty := NewPersistentStack[int]()
+//	withValues := NewPersistentStack(1, 2, 3)  // Top is 3
+func NewPersistentStack[T any](values ...T) PersistentStack[T] {
+	var s PersistentStack[T]
+	for _, v := range values {
+		s = s.Push(v)
+	}
+
+	return s
+}
+
+// Push returns a new stack with the value added on top.
+// The receiver is not modified.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	s1 := NewPersistentStack(1, 2)
+//	s2 := s1.Push(3)  // s2 is [1, 2, 3], s1 is still [1, 2]
+func (s PersistentStack[T]) Push(value T) PersistentStack[T] {
+	return PersistentStack[T]{
+		top:  &persistentNode[T]{value: value, next: s.top},
+		size: s.size + 1,
+	}
+}
+
+// Pop returns the element at the top of the stack together with a new
+// stack without it. The receiver is not modified.
+// Returns ErrEmptyStack and the receiver if the stack is empty.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	s1 := NewPersistentStack(1, 2, 3)
+//	v, s2, _ := s1.Pop()  // v is 3, s2 is [1, 2], s1 is still [1, 2, 3]
+func (s PersistentStack[T]) Pop() (T, PersistentStack[T], error) {
+	if s.top == nil {
+		var zero T
+		return zero, s, ErrEmptyStack
+	}
+
+	rest := PersistentStack[T]{top: s.top.next, size: s.size - 1}
+	return s.top.value, rest, nil
+}
+
+// Peek returns the element at the top of the stack.
+// Returns ErrEmptyStack if the stack is empty.
+//
+// Time complexity: O(1)
+func (s PersistentStack[T]) Peek() (T, error) {
+	if s.top == nil {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+
+	return s.top.value, nil
+}
+
+// All returns an iterator over the elements from top to bottom,
+// in the order they would be popped.
+//
+// Time complexity: O(n) for a full iteration
+func (s PersistentStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.top; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns the stack itself: a persistent stack never changes, so
+// every version is already an immutable point-in-time view.
+//
+// Time complexity: O(1)
+func (s PersistentStack[T]) Snapshot() PersistentStack[T] {
+	return s
+}
+
+// IsEmpty returns true if the stack contains no elements.
+//
+// Time complexity: O(1)
+func (s PersistentStack[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Size returns the number of elements in the stack.
+//
+// Time complexity: O(1)
+func (s PersistentStack[T]) Size() int {
+	return s.size
+}
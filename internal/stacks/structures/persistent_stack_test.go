@@ -0,0 +1,184 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewPersistentStack):
+  ✓ Empty stack
+  ✓ Multiple values (last on top)
+  ✓ Zero value is an empty stack
+
+Push:
+  ✓ Returns new version, original unchanged
+  ✓ Versions share a common tail
+
+Pop:
+  ✓ Empty stack (error)
+  ✓ Returns new version, original unchanged
+  ✓ LIFO order
+
+Peek:
+  ✓ Empty stack (error)
+  ✓ Non-empty stack
+
+All:
+  ✓ Top-to-bottom order
+  ✓ Concurrent readers of one snapshot
+
+Snapshot:
+  ✓ Same version, unaffected by pushes and pops
+*/
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty stack
+func TestPersistentStack_NewPersistentStack_Empty(t *testing.T) {
+	s := NewPersistentStack[int]()
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies the creation of multi-element stack
+func TestPersistentStack_NewPersistentStack_ManyValues(t *testing.T) {
+	s := NewPersistentStack(1, 2, 3)
+	test.GotWant(t, s.Size(), 3)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 3)
+}
+
+// Verifies the zero value is a usable empty stack
+func TestPersistentStack_ZeroValue(t *testing.T) {
+	var s PersistentStack[int]
+	test.GotWant(t, s.IsEmpty(), true)
+	s = s.Push(1)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 1)
+}
+
+// Verifies Push returns a new version without modifying the original
+func TestPersistentStack_Push_Immutability(t *testing.T) {
+	s1 := NewPersistentStack(1, 2)
+	s2 := s1.Push(3)
+	test.GotWant(t, s1.Size(), 2)
+	test.GotWant(t, s2.Size(), 3)
+	p1, _ := s1.Peek()
+	p2, _ := s2.Peek()
+	test.GotWant(t, p1, 2)
+	test.GotWant(t, p2, 3)
+}
+
+// Verifies versions pushed from the same base share the base nodes
+func TestPersistentStack_Push_StructuralSharing(t *testing.T) {
+	base := NewPersistentStack(1, 2)
+	a := base.Push(3)
+	b := base.Push(4)
+	test.GotWant(t, a.top.next, base.top)
+	test.GotWant(t, b.top.next, base.top)
+}
+
+// Verifies popping from an empty stack
+func TestPersistentStack_Pop_EmptyStack(t *testing.T) {
+	s := NewPersistentStack[int]()
+	v, rest, err := s.Pop()
+	test.GotWantErrorIs(t, err, ErrEmptyStack)
+	test.GotWant(t, v, 0)
+	test.GotWant(t, rest.IsEmpty(), true)
+}
+
+// Verifies Pop returns a new version without modifying the original
+func TestPersistentStack_Pop_Immutability(t *testing.T) {
+	s1 := NewPersistentStack(1, 2, 3)
+	v, s2, err := s1.Pop()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, v, 3)
+	test.GotWant(t, s1.Size(), 3)
+	test.GotWant(t, s2.Size(), 2)
+	p, _ := s1.Peek()
+	test.GotWant(t, p, 3)
+}
+
+// Verifies Last-In-First-Out element order
+func TestPersistentStack_PushPop_Order(t *testing.T) {
+	s := NewPersistentStack[int]()
+	for i := range 5 {
+		s = s.Push(i + 1)
+	}
+
+	for i := range 5 {
+		var v int
+		v, s, _ = s.Pop()
+		test.GotWant(t, v, 5-i)
+	}
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies peeking into an empty stack
+func TestPersistentStack_Peek_EmptyStack(t *testing.T) {
+	s := NewPersistentStack[int]()
+	p, err := s.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyStack)
+	test.GotWant(t, p, 0)
+}
+
+// Verifies peeking into a non-empty stack
+func TestPersistentStack_Peek_NonEmptyStack(t *testing.T) {
+	s := NewPersistentStack(1, 2, 3)
+	p, err := s.Peek()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, p, 3)
+	test.GotWant(t, s.Size(), 3)
+}
+
+// Verifies All yields elements from top to bottom
+func TestPersistentStack_All_Order(t *testing.T) {
+	s := NewPersistentStack(1, 2, 3)
+	got := []int{}
+	for v := range s.All() {
+		got = append(got, v)
+	}
+	test.GotWantSlice(t, got, []int{3, 2, 1})
+}
+
+// Verifies a snapshot can be read concurrently while new versions are built
+func TestPersistentStack_All_ConcurrentReaders(t *testing.T) {
+	snapshot := NewPersistentStack(1, 2, 3)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			sum := 0
+			for v := range snapshot.All() {
+				sum += v
+			}
+			test.GotWant(t, sum, 6)
+		})
+	}
+	wg.Go(func() {
+		s := snapshot
+		for i := range 100 {
+			s = s.Push(i)
+			_, s, _ = s.Pop()
+		}
+	})
+	wg.Wait()
+}
+
+// Verifies a snapshot is the same version and unaffected by versions
+// derived from the stack
+func TestPersistentStack_Snapshot(t *testing.T) {
+	s := NewPersistentStack(1, 2)
+	snap := s.Snapshot()
+	test.GotWant(t, snap, s)
+
+	s = s.Push(3)
+	_, s, _ = s.Pop()
+	_, s, _ = s.Pop()
+	test.GotWant(t, snap.Size(), 2)
+	top, _ := snap.Peek()
+	test.GotWant(t, top, 2)
+}
@@ -0,0 +1,117 @@
+package structures
+
+import (
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ SearchableStack[int] = &SearchableSliceStack[int]{}
+var _ collections.Collection[int] = &SearchableSliceStack[int]{}
+
+// SearchableSliceStack extends SliceStack with value-based lookup for
+// comparable element types.
+//
+// All stack operations and optimizations are inherited from SliceStack;
+// see SliceStack and SliceStackConfig for details.
+type SearchableSliceStack[T comparable] struct {
+	SliceStack[T]
+}
+
+// NewSearchableSliceStack creates a searchable stack with default
+// optimizations enabled. See NewSliceStack for the defaults.
+//
+// Example:
+//
+//	s := NewSearchableSliceStack(1, 2, 3)
+//	s.Search(3)  // Returns 1
+func NewSearchableSliceStack[T comparable](values ...T) *SearchableSliceStack[T] {
+	return &SearchableSliceStack[T]{
+		SliceStack: *NewSliceStack(values...),
+	}
+}
+
+// NewSearchableSliceStackWithCapacity creates an empty searchable stack
+// with room for capacity elements.
+//
+// Panics if capacity is negative.
+//
+// Deprecated: Use NewSearchableSliceStackWith with WithCapacity.
+func NewSearchableSliceStackWithCapacity[T comparable](capacity int) *SearchableSliceStack[T] {
+	return &SearchableSliceStack[T]{
+		SliceStack: *NewSliceStackWith[T](WithCapacity(capacity)),
+	}
+}
+
+// NewSearchableSliceStackWith creates an empty searchable stack from the
+// default configuration adjusted by the given options. See
+// NewSliceStackWith.
+func NewSearchableSliceStackWith[T comparable](opts ...SliceStackOption) *SearchableSliceStack[T] {
+	return &SearchableSliceStack[T]{
+		SliceStack: *NewSliceStackWith[T](opts...),
+	}
+}
+
+// NewSearchableSliceStackWithConfig creates a searchable stack with custom
+// optimization settings. See SliceStackConfig for configuration options.
+//
+// Deprecated: Use NewSearchableSliceStackWith, then Push.
+func NewSearchableSliceStackWithConfig[T comparable](config SliceStackConfig, values ...T) *SearchableSliceStack[T] {
+	return &SearchableSliceStack[T]{
+		SliceStack: *newSliceStack(config, values...),
+	}
+}
+
+// Clone returns an independent copy of the stack, including its
+// optimization configuration. See SliceStack.Clone.
+//
+// Time complexity: O(n)
+func (s *SearchableSliceStack[T]) Clone() *SearchableSliceStack[T] {
+	return &SearchableSliceStack[T]{
+		SliceStack: *s.SliceStack.Clone(),
+	}
+}
+
+// CloneFunc returns a deep copy of the stack, with every element copied
+// by clone. See SliceStack.CloneFunc.
+//
+// Time complexity: O(n) calls of clone
+func (s *SearchableSliceStack[T]) CloneFunc(clone func(T) T) *SearchableSliceStack[T] {
+	return &SearchableSliceStack[T]{
+		SliceStack: *s.SliceStack.CloneFunc(clone),
+	}
+}
+
+// Contains returns true if the stack contains the specified value.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	s := NewSearchableSliceStack(1, 2, 3)
+//	s.Contains(2)  // Returns true
+//	s.Contains(9)  // Returns false
+func (s *SearchableSliceStack[T]) Contains(value T) bool {
+	return s.Search(value) != -1
+}
+
+// Search returns the 1-based distance from the top of the stack to the
+// topmost occurrence of the specified value. The top element has
+// distance 1. Returns -1 if the value is not found.
+//
+// Time complexity: O(d) where d is the returned distance, O(n) if absent
+//
+// Example:
+//
+//	s := NewSearchableSliceStack(1, 2, 3, 2)
+//	s.Search(2)  // Returns 1 (the top element)
+//	s.Search(1)  // Returns 4 (the bottom element)
+//	s.Search(9)  // Returns -1 (not found)
+func (s *SearchableSliceStack[T]) Search(value T) int {
+	for i := s.curr - 1; i >= 0; i-- {
+		if s.data[i] == value {
+			return s.curr - i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,154 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewSearchableSliceStack):
+  ✓ Inherits stack behavior
+
+Constructor (NewSearchableSliceStackWithCapacity):
+  ✓ Empty stack with the requested capacity
+
+Contains:
+  ✓ Empty stack
+  ✓ Present value
+  ✓ Absent value
+  ✓ Popped value no longer found
+
+Search:
+  ✓ Empty stack
+  ✓ Top element (distance 1)
+  ✓ Bottom element (distance size)
+  ✓ Duplicate values (topmost occurrence)
+  ✓ Absent value
+  ✓ Popped value no longer found
+
+Clone:
+  ✓ Copy remains searchable and independent
+  ✓ CloneFunc copy remains searchable
+
+Options (NewSearchableSliceStackWith):
+  ✓ Options applied to the embedded stack
+*/
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the searchable stack behaves as a regular stack
+func TestSearchableSliceStack_NewSearchableSliceStack(t *testing.T) {
+	s := NewSearchableSliceStack(1, 2, 3)
+	test.GotWant(t, s.Size(), 3)
+	d, _ := s.Pop()
+	test.GotWant(t, d, 3)
+	s.Push(4)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 4)
+}
+
+// Verifies the searchable stack is created empty with the requested
+// capacity
+func TestSearchableSliceStack_NewSearchableSliceStackWithCapacity(t *testing.T) {
+	s := NewSearchableSliceStackWithCapacity[int](50)
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, cap(s.data), 50)
+
+	s.Push(7)
+	test.GotWant(t, s.Contains(7), true)
+}
+
+// Verifies Contains on an empty stack
+func TestSearchableSliceStack_Contains_EmptyStack(t *testing.T) {
+	s := NewSearchableSliceStack[int]()
+	test.GotWant(t, s.Contains(1), false)
+}
+
+// Verifies Contains finds a present value
+func TestSearchableSliceStack_Contains_Present(t *testing.T) {
+	s := NewSearchableSliceStack(1, 2, 3)
+	test.GotWant(t, s.Contains(1), true)
+	test.GotWant(t, s.Contains(2), true)
+	test.GotWant(t, s.Contains(3), true)
+}
+
+// Verifies Contains does not find an absent value
+func TestSearchableSliceStack_Contains_Absent(t *testing.T) {
+	s := NewSearchableSliceStack(1, 2, 3)
+	test.GotWant(t, s.Contains(4), false)
+}
+
+// Verifies Contains ignores popped elements still held by the backing slice
+func TestSearchableSliceStack_Contains_AfterPop(t *testing.T) {
+	s := NewSearchableSliceStack(1, 2, 3)
+	s.Pop()
+	test.GotWant(t, s.Contains(3), false)
+}
+
+// Verifies Search on an empty stack
+func TestSearchableSliceStack_Search_EmptyStack(t *testing.T) {
+	s := NewSearchableSliceStack[int]()
+	test.GotWant(t, s.Search(1), -1)
+}
+
+// Verifies Search reports distance 1 for the top element
+func TestSearchableSliceStack_Search_Top(t *testing.T) {
+	s := NewSearchableSliceStack(1, 2, 3)
+	test.GotWant(t, s.Search(3), 1)
+}
+
+// Verifies Search reports distance Size() for the bottom element
+func TestSearchableSliceStack_Search_Bottom(t *testing.T) {
+	s := NewSearchableSliceStack(1, 2, 3)
+	test.GotWant(t, s.Search(1), 3)
+}
+
+// Verifies Search reports the topmost occurrence of duplicates
+func TestSearchableSliceStack_Search_Duplicates(t *testing.T) {
+	s := NewSearchableSliceStack(2, 1, 2, 3)
+	test.GotWant(t, s.Search(2), 2)
+}
+
+// Verifies Search on an absent value
+func TestSearchableSliceStack_Search_Absent(t *testing.T) {
+	s := NewSearchableSliceStack(1, 2, 3)
+	test.GotWant(t, s.Search(4), -1)
+}
+
+// Verifies Search ignores popped elements still held by the backing slice
+func TestSearchableSliceStack_Search_AfterPop(t *testing.T) {
+	s := NewSearchableSliceStack(1, 2, 3)
+	s.Pop()
+	test.GotWant(t, s.Search(3), -1)
+	test.GotWant(t, s.Search(2), 1)
+}
+
+// Verifies the clone is searchable and independent of the original
+func TestSearchableSliceStack_Clone(t *testing.T) {
+	s := NewSearchableSliceStack(1, 2, 3)
+	c := s.Clone()
+	c.Pop()
+	test.GotWant(t, c.Contains(3), false)
+	test.GotWant(t, s.Contains(3), true)
+	test.GotWant(t, c.Search(2), 1)
+}
+
+// Verifies the CloneFunc copy holds the cloned elements and is searchable
+func TestSearchableSliceStack_CloneFunc(t *testing.T) {
+	s := NewSearchableSliceStack(1, 2, 3)
+	c := s.CloneFunc(func(v int) int { return v * 10 })
+	test.GotWant(t, c.Search(30), 1)
+	test.GotWant(t, c.Contains(1), false)
+	test.GotWant(t, s.Contains(1), true)
+}
+
+// Verifies options configure the embedded stack
+func TestSearchableSliceStack_NewSearchableSliceStackWith(t *testing.T) {
+	s := NewSearchableSliceStackWith[int](WithReallocateOnPop(false), WithCapacity(8))
+	test.GotWant(t, s.config.ReallocateOnPop, false)
+	test.GotWant(t, cap(s.data), 8)
+
+	s.Push(1)
+	test.GotWant(t, s.Search(1), 1)
+}
@@ -1,13 +1,22 @@
 package structures
 
 import (
-	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"unsafe"
 
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
 	"github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
 )
 
 // Compile-time interface verifications
 var _ Stack[int] = &SliceStack[int]{}
+var _ collections.Iterable[int] = &SliceStack[int]{}
+var _ collections.Addable[int] = &SliceStack[int]{}
 
 // SliceStack implements a LIFO stack using a dynamic slice with optional
 // memory optimization.
@@ -18,6 +27,7 @@ var _ Stack[int] = &SliceStack[int]{}
 //   - Best for: stacks that grow large then permanently shrink
 //   - Benefit: Reclaims ~97-99% of wasted memory after shrinkage
 //   - Tradeoff: Reallocation overhead (one-time O(n) cost)
+//   - Variant: ReallocateByHalving halves capacity below 25% usage instead
 //
 // Default configuration enables reallocation with conservative thresholds,
 // suitable for most workloads. Disable for pure growth patterns or when
@@ -26,43 +36,86 @@ type SliceStack[T any] struct {
 	curr   int              // Exclusive index of back element
 	data   []T              // Underlying slice storage
 	config SliceStackConfig // Optimization configuration
+	stats  SliceStackStats  // Accumulated reallocation counters
+	hooks  algorithms.SliceHooks
 }
 
 // NewSliceStack creates a stack with default optimizations enabled.
 // Suitable for most workloads including growth-shrink cycles and
 // temporary large allocations.
 //
-// For specific workloads, use NewSliceStackWithConfig:
-//   - Pure growth: disable ReallocateOnPop
-//   - Memory-constrained: enable with aggressive thresholds (90-99% waste)
+// For specific workloads, use NewSliceStackWith:
+//   - Pure growth: WithReallocateOnPop(false)
+//   - Memory-constrained: aggressive thresholds (90-99% waste) with
+//     WithReallocateWastePercent
 //   - CPU-constrained: disable or use conservative thresholds (60-70% waste)
 //   - Unknown/mixed: use default (reallocation enabled, 75% threshold)
+//   - Known size: WithCapacity
 func NewSliceStack[T any](values ...T) *SliceStack[T] {
-	c := SliceStackConfig{
-		ReallocateOnPop:        true,
-		MinOptimizationLength:  100,
-		ReallocateWastePercent: 75,
-		ReallocateWasteBuffer:  80,
+	return newSliceStack(defaultSliceStackConfig(), values...)
+}
+
+// NewSliceStackWithCapacity creates an empty stack with default
+// optimizations and room for capacity elements, so pushing up to that
+// many elements does not reallocate, like make([]T, 0, capacity).
+// ReallocateOnPop may still release the capacity once the stack has
+// grown and shrunk again.
+//
+// Panics if capacity is negative.
+//
+// Deprecated: Use NewSliceStackWith with WithCapacity.
+func NewSliceStackWithCapacity[T any](capacity int) *SliceStack[T] {
+	panics.RequireNonNegative(capacity, "capacity")
+	s := NewSliceStack[T]()
+	s.Reserve(capacity)
+	return s
+}
+
+// NewSliceStackWith creates an empty stack from the default configuration
+// adjusted by the given options. Options are applied in order.
+//
+// Panics if the resulting configuration is invalid (see SliceStackConfig).
+//
+// Example:
+//
+//	s := NewSliceStackWith[int](
+//	    WithMinOptimizationLength(500),
+//	    WithReallocateWastePercent(80),
+//	    WithCapacity(1000),
+//	)
+//	for i := range 1000 {
+//	    s.Push(i)  // Never reallocates
+//	}
+func NewSliceStackWith[T any](opts ...SliceStackOption) *SliceStack[T] {
+	c := defaultSliceStackConfig()
+	for _, opt := range opts {
+		opt(&c)
 	}
 
-	return NewSliceStackWithConfig(c, values...)
+	return newSliceStack[T](c)
 }
 
 // NewSliceStackWithConfig creates a stack with custom optimization settings.
 // See SliceStackConfig for configuration options and tuning guidance.
 //
-// Example:
+// Panics if the configuration is invalid:
+//   - MinOptimizationLength < 0
+//   - ReallocateWastePercent outside [0, 100]
+//   - ReallocateWasteBuffer outside [0, 99]
+//   - InitialCapacity < 0
 //
-//	config := SliceStackConfig{
-//	    ReallocateOnPop:        true,
-//	    MinOptimizationLength:  500,
-//	    ReallocateWastePercent: 80,
-//	    ReallocateWasteBuffer:  70,
-//	}
-//	s := NewSliceStackWithConfig(config, 1, 2, 3)
+// Deprecated: Use NewSliceStackWith with the options named after the
+// fields of SliceStackConfig, then Push.
 func NewSliceStackWithConfig[T any](config SliceStackConfig, values ...T) *SliceStack[T] {
+	return newSliceStack(config, values...)
+}
+
+// Creates a stack with the given settings holding the values, the last
+// on top. Panics if the configuration is invalid.
+func newSliceStack[T any](config SliceStackConfig, values ...T) *SliceStack[T] {
+	config.validate()
 	s := &SliceStack[T]{
-		data: make([]T, 0, len(values)),
+		data: make([]T, 0, max(len(values), config.InitialCapacity)),
 	}
 
 	s.data = append(s.data, values...)
@@ -76,7 +129,9 @@ func NewSliceStackWithConfig[T any](config SliceStackConfig, values ...T) *Slice
 // Time complexity: O(1) amortized
 func (s *SliceStack[T]) Push(value T) {
 	if s.curr == len(s.data) {
+		capBefore := cap(s.data)
 		s.data = append(s.data, value)
+		s.hooks.Resized(capBefore, cap(s.data))
 	} else {
 		s.data[s.curr] = value
 	}
@@ -84,6 +139,15 @@ func (s *SliceStack[T]) Push(value T) {
 	s.curr++
 }
 
+// Add pushes the element onto the top of the stack, as Push does.
+// Always returns true.
+//
+// Time complexity: O(1) amortized
+func (s *SliceStack[T]) Add(value T) bool {
+	s.Push(value)
+	return true
+}
+
 // Pop removes and returns the element at the top of the stack.
 // Returns an error if the stack is empty.
 // If ReallocateOnPop is enabled and waste exceeds the threshold,
@@ -93,27 +157,221 @@ func (s *SliceStack[T]) Push(value T) {
 func (s *SliceStack[T]) Pop() (T, error) {
 	if s.IsEmpty() {
 		var zero T
-		return zero, errors.New(ErrorEmptyStack)
+		return zero, ErrEmptyStack
 	}
 
 	v := s.data[s.curr-1]
 	s.curr--
+	s.shrink()
+	return v, nil
+}
 
-	// Reset when empty
-	if s.curr == 0 {
-		s.data = s.data[:0]
-	} else if s.config.ReallocateOnPop {
-		s.data, _, s.curr = algorithms.Reallocate(
-			s.data, algorithms.SliceReallocationParams{
-				UsedStart:    0,
-				UsedEnd:      s.curr,
-				MinSize:      s.config.MinOptimizationLength,
-				WastePercent: s.config.ReallocateWastePercent,
-				WasteBuffer:  s.config.ReallocateWasteBuffer,
-			})
+// PushAll adds all values to the top of the stack in the order provided,
+// so the last value ends up on top. Equivalent to calling Push for each
+// value, but grows the underlying slice with a single append.
+//
+// Time complexity: O(k) amortized where k is the number of values
+//
+// Example:
+//
+//	s := NewSliceStack(1)
+//	s.PushAll(2, 3)  // Stack is now [1, 2, 3], top is 3
+func (s *SliceStack[T]) PushAll(values ...T) {
+	capBefore := cap(s.data)
+	s.data = append(s.data[:s.curr], values...)
+	s.curr = len(s.data)
+	s.hooks.Resized(capBefore, cap(s.data))
+}
+
+// Reserve ensures that the next n pushes do not reallocate. If the
+// capacity is insufficient, the elements are moved to a slice sized for
+// exactly Size()+n elements, instead of the repeated doubling that pushing
+// them one by one would cause.
+//
+// Time complexity: O(n) when the capacity grows, O(1) otherwise
+//
+// Panics if n is negative.
+//
+// Example:
+//
+//	s := NewSliceStack[int]()
+//	s.Reserve(1000)
+//	for i := range 1000 {
+//	    s.Push(i)  // Never reallocates
+//	}
+func (s *SliceStack[T]) Reserve(n int) {
+	panics.RequireNonNegative(n, "reserved size")
+	if s.curr+n <= cap(s.data) {
+		return
 	}
 
-	return v, nil
+	capBefore := cap(s.data)
+	s.data, _, s.curr = algorithms.Reallocate(
+		s.data, algorithms.SliceReallocationParams{
+			UsedStart: 0,
+			UsedEnd:   s.curr,
+			Headroom:  n,
+		})
+	s.hooks.Resized(capBefore, cap(s.data))
+}
+
+// PopN removes and returns the top n elements of the stack in pop order,
+// so the former top element is first. Equivalent to calling Pop n times,
+// but copies the elements once and evaluates reallocation only once at
+// the end.
+// Returns ErrCountOutOfRange if n is negative or greater than Size().
+//
+// Time complexity: O(n) amortized, O(size) when reallocation triggers
+//
+// Example:
+//
+//	s := NewSliceStack(1, 2, 3, 4)
+//	top, _ := s.PopN(2)  // Returns [4, 3], stack is now [1, 2]
+func (s *SliceStack[T]) PopN(n int) ([]T, error) {
+	if n < 0 || n > s.curr {
+		return nil, ErrCountOutOfRange
+	}
+
+	values := make([]T, n)
+	for i := range n {
+		values[i] = s.data[s.curr-1-i]
+	}
+
+	s.curr -= n
+	s.shrink()
+	return values, nil
+}
+
+// Drain removes and returns all elements of the stack in pop order,
+// so the former top element is first. The stack is left empty but keeps
+// its capacity for reuse. The result slice is allocated exactly once.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	s := NewSliceStack(1, 2, 3)
+//	values := s.Drain()  // Returns [3, 2, 1], stack is now empty
+func (s *SliceStack[T]) Drain() []T {
+	values, _ := s.PopN(s.curr)
+	return values
+}
+
+// Clone returns an independent copy of the stack, including its
+// optimization configuration. Changes to the copy do not affect the
+// original and vice versa, so speculative work can be done on the copy
+// and discarded.
+//
+// The copy is shallow: elements are copied by assignment, so pointers
+// and reference types inside elements are shared. Use CloneFunc for a
+// deep copy.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	s := NewSliceStack(1, 2, 3)
+//	c := s.Clone()
+//	c.Pop()  // s still holds [1, 2, 3]
+func (s *SliceStack[T]) Clone() *SliceStack[T] {
+	return newSliceStack(s.config, s.data[:s.curr]...)
+}
+
+// CloneFunc returns a deep copy of the stack: like Clone, but every
+// element of the copy is the result of calling clone on the original
+// element, bottom to top.
+//
+// Time complexity: O(n) calls of clone
+//
+// Example:
+//
+//	s := NewSliceStack([]int{1, 2}, []int{3})
+//	c := s.CloneFunc(slices.Clone[[]int])
+//	top, _ := c.Peek()
+//	top[0] = 9  // s still holds [[1, 2], [3]]
+func (s *SliceStack[T]) CloneFunc(clone func(T) T) *SliceStack[T] {
+	c := s.Clone()
+	for i := range c.curr {
+		c.data[i] = clone(c.data[i])
+	}
+
+	return c
+}
+
+// Reverse reverses the order of the elements in place, so the bottom
+// element becomes the top. Useful when a stack is being converted to
+// processing order.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	s := NewSliceStack(1, 2, 3)  // Top is 3
+//	s.Reverse()                  // Top is 1
+func (s *SliceStack[T]) Reverse() {
+	algorithms.Reverse(s.data[:s.curr])
+}
+
+// Reset removes all elements but keeps the underlying capacity for reuse.
+// Prefer Reset when the stack is about to be refilled to a similar size.
+// Removed elements are zeroed so they can be garbage collected.
+//
+// Time complexity: O(n) where n is the length of the underlying slice
+//
+// Example:
+//
+//	s := NewSliceStack(1, 2, 3)
+//	s.Reset()  // Stack is empty, capacity unchanged
+func (s *SliceStack[T]) Reset() {
+	clear(s.data)
+	s.data = s.data[:0]
+	s.curr = 0
+}
+
+// Clear removes all elements and releases the underlying slice.
+// Prefer Clear when the stack will stay small or unused, so memory is
+// reclaimed immediately instead of waiting for ReallocateOnPop.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	s := NewSliceStack(1, 2, 3)
+//	s.Clear()  // Stack is empty, capacity is 0
+func (s *SliceStack[T]) Clear() {
+	capBefore := cap(s.data)
+	s.data = nil
+	s.curr = 0
+	s.hooks.Resized(capBefore, 0)
+}
+
+// ShrinkToFit reallocates the underlying slice to exactly Size() elements,
+// regardless of the ReallocateOnPop settings. Use it when a burst of
+// pushes is known to be over and the memory is wanted back now rather
+// than when the next pops trigger the heuristic.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	s := NewSliceStack[int]()
+//	s.PushAll(burst...)
+//	s.PopN(len(burst) - 10)
+//	s.ShrinkToFit()  // Capacity is 10
+func (s *SliceStack[T]) ShrinkToFit() {
+	capBefore := cap(s.data)
+	if capBefore == s.curr {
+		return
+	}
+
+	if s.curr == 0 {
+		s.data = nil
+	} else {
+		data := make([]T, s.curr)
+		copy(data, s.data[:s.curr])
+		s.data = data
+	}
+	s.hooks.Resized(capBefore, cap(s.data))
 }
 
 // Peek returns the element at the top of the stack without removing it.
@@ -123,12 +381,152 @@ func (s *SliceStack[T]) Pop() (T, error) {
 func (s *SliceStack[T]) Peek() (T, error) {
 	if s.IsEmpty() {
 		var zero T
-		return zero, errors.New(ErrorEmptyStack)
+		return zero, ErrEmptyStack
 	}
 
 	return s.data[s.curr-1], nil
 }
 
+// All returns an iterator over the elements from top to bottom,
+// in the order they would be popped. The stack is not modified.
+// The stack must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+//
+// Example:
+//
+//	s := NewSliceStack(1, 2, 3)
+//	for v := range s.All() {
+//	    fmt.Println(v)  // Prints 3, 2, 1
+//	}
+func (s *SliceStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := s.curr - 1; i >= 0; i-- {
+			if !yield(s.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// BottomUp returns an iterator over the elements from bottom to top,
+// in the order they were pushed. The stack is not modified.
+// The stack must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+//
+// Example:
+//
+//	s := NewSliceStack(1, 2, 3)
+//	for v := range s.BottomUp() {
+//	    fmt.Println(v)  // Prints 1, 2, 3
+//	}
+func (s *SliceStack[T]) BottomUp() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < s.curr; i++ {
+			if !yield(s.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Stats returns the reallocation counters accumulated so far together
+// with the current waste. See SliceStackStats.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	s := NewSliceStack[int]()
+//	// ... workload ...
+//	stats := s.Stats()
+//	fmt.Println(stats.Reallocations, stats.FreedBytes, stats.WastePercent)
+func (s *SliceStack[T]) Stats() SliceStackStats {
+	var zero T
+	stats := s.stats
+	stats.FreedBytes = stats.FreedCapacity * int(unsafe.Sizeof(zero))
+	if c := cap(s.data); c > 0 {
+		stats.WastePercent = 100 - 100*s.curr/c
+	}
+
+	return stats
+}
+
+// SetHooks installs callbacks for the stack's storage events, replacing
+// any installed before: OnGrow when Push, PushAll or Reserve grows the
+// capacity, and OnShrink when reallocation after popping, Clear or
+// ShrinkToFit reduces it. The stack never compacts, so OnCompact is not
+// called. Clone does not copy the hooks.
+//
+// Example:
+//
+//	s.SetHooks(algorithms.SliceHooks{
+//	    OnGrow: func(oldCap, newCap int) { capacityGauge.Set(float64(newCap)) },
+//	})
+func (s *SliceStack[T]) SetHooks(hooks algorithms.SliceHooks) {
+	s.hooks = hooks
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements from bottom to top in the format of package codec.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (s *SliceStack[T]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("SliceStack", s.curr, slices.Values(s.data[:s.curr]))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the stack with those encoded by MarshalBinary, keeping the
+// configuration. The new slice holds exactly the decoded elements.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded stack, in which case the stack is left unchanged.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	data, _ := NewSliceStack(1, 2, 3).MarshalBinary()
+//	s := NewSliceStack[int]()
+//	s.UnmarshalBinary(data)
+//	s.Peek()  // Returns 3
+func (s *SliceStack[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "SliceStack")
+	if err != nil {
+		return err
+	}
+
+	s.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (s *SliceStack[T]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "SliceStack", s.curr, slices.Values(s.data[:s.curr]))
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// stack with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The stack is
+// left unchanged on error.
+//
+// Time complexity: O(n)
+func (s *SliceStack[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "SliceStack")
+	if err != nil {
+		return n, err
+	}
+
+	s.restore(values)
+	return n, nil
+}
+
 // IsEmpty returns true if the stack contains no elements.
 //
 // Time complexity: O(1)
@@ -142,3 +540,74 @@ func (s *SliceStack[T]) IsEmpty() bool {
 func (s *SliceStack[T]) Size() int {
 	return s.curr
 }
+
+// CheckInvariants verifies the internal consistency of the stack: the
+// top index lies within the slice. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the stack is consistent.
+//
+// Time complexity: O(1)
+func (s *SliceStack[T]) CheckInvariants() error {
+	if s.curr < 0 || s.curr > len(s.data) {
+		return fmt.Errorf("%w: top index %d outside [0, %d]", ErrInvariantViolation, s.curr, len(s.data))
+	}
+
+	return nil
+}
+
+// Releases unused capacity after elements were removed from the top.
+// Resets the slice when the stack becomes empty, otherwise reallocates
+// if ReallocateOnPop is enabled and the configured strategy (waste
+// threshold or capacity halving) triggers.
+func (s *SliceStack[T]) shrink() {
+	if s.curr == 0 {
+		s.data = s.data[:0]
+	} else if s.config.ReallocateOnPop {
+		var freed int
+		if s.config.ReallocateByHalving {
+			capBefore := cap(s.data)
+			s.data, _, s.curr = algorithms.Halve(
+				s.data, algorithms.SliceHalvingParams{
+					UsedStart: 0,
+					UsedEnd:   s.curr,
+					MinSize:   s.config.MinOptimizationLength,
+				})
+			freed = capBefore - cap(s.data)
+		} else {
+			var stats algorithms.SliceStats
+			s.data, _, s.curr, stats = algorithms.ReallocateWithStats(
+				s.data, algorithms.SliceReallocationParams{
+					UsedStart:    0,
+					UsedEnd:      s.curr,
+					MinSize:      s.config.MinOptimizationLength,
+					WastePercent: s.config.ReallocateWastePercent,
+					WasteBuffer:  s.config.ReallocateWasteBuffer,
+				})
+			freed = stats.CapBefore - stats.CapAfter
+		}
+
+		if freed > 0 {
+			s.stats.Reallocations++
+			s.stats.FreedCapacity += freed
+			s.hooks.Resized(cap(s.data)+freed, cap(s.data))
+		}
+	}
+}
+
+// Returns the configuration used by NewSliceStack.
+func defaultSliceStackConfig() SliceStackConfig {
+	return SliceStackConfig{
+		ReallocateOnPop:        true,
+		MinOptimizationLength:  100,
+		ReallocateWastePercent: 75,
+		ReallocateWasteBuffer:  80,
+	}
+}
+
+// Replaces the elements with the decoded values, bottom to top.
+func (s *SliceStack[T]) restore(values []T) {
+	capBefore := cap(s.data)
+	s.data = values
+	s.curr = len(values)
+	s.hooks.Resized(capBefore, cap(values))
+}
@@ -0,0 +1,108 @@
+package structures
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/bench"
+)
+
+// Benchmark configurations representing different shrink strategies.
+// Used across all benchmarks to compare performance characteristics.
+var stackConfigs = map[string]SliceStackConfig{
+	// NoReallocation: Baseline with reallocation disabled.
+	// Expected: Fastest, holds peak capacity forever.
+	"NoReallocation": {
+		ReallocateOnPop: false,
+	},
+	// WasteBuffer: Default waste-threshold reallocation.
+	// Expected: Few large reallocations, best memory reclamation.
+	"WasteBuffer": {
+		ReallocateOnPop:        true,
+		MinOptimizationLength:  100,
+		ReallocateWastePercent: 75,
+		ReallocateWasteBuffer:  80,
+	},
+	// Halving: Capacity halving below 25% usage.
+	// Expected: Cheaper decisions, less shrink/regrow churn than WasteBuffer.
+	"Halving": {
+		ReallocateOnPop:       true,
+		ReallocateByHalving:   true,
+		MinOptimizationLength: 100,
+	},
+}
+
+// BenchmarkSliceStack_Oscillating measures performance when the stack
+// repeatedly grows and shrinks around a large base.
+//
+// Pattern: Start with 10,000 elements → [Pop × 9,000, Push × 9,000]
+// Expected winner: NoReallocation (no shrink/regrow churn)
+func BenchmarkSliceStack_Oscillating(b *testing.B) {
+	for name, config := range stackConfigs {
+		b.Run(name, func(b *testing.B) {
+			s := NewSliceStackWithConfig[int](config)
+			for i := range 10000 {
+				s.Push(i)
+			}
+
+			b.ResetTimer()
+			for b.Loop() {
+				for range 9000 {
+					s.Pop()
+				}
+				for j := range 9000 {
+					s.Push(j)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSliceStack_OnlyShrinking measures performance with pure removal.
+// Tests the cost of the shrink decision and reallocations.
+//
+// Pattern: Start with 1M elements → [Pop] × 1000
+// Expected: Both shrink strategies similar, NoReallocation fastest
+func BenchmarkSliceStack_OnlyShrinking(b *testing.B) {
+	for name, config := range stackConfigs {
+		b.Run(name, func(b *testing.B) {
+			s := NewSliceStackWithConfig[int](config)
+			for i := range 1_000_000 {
+				s.Push(i)
+			}
+
+			b.ResetTimer()
+			for b.Loop() {
+				for range 1000 {
+					s.Pop()
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSliceStack_TotalMemory measures total memory footprint (capacity)
+// after a permanent shrink. Reports custom metrics "retained-KB" for the
+// retained capacity and "reallocs" for the number of reallocations.
+//
+// Pattern: Push 1M → Pop 999,000
+// Expected: WasteBuffer and Halving both reclaim most memory,
+// Halving in fewer steps (each step shrinks 2x)
+func BenchmarkSliceStack_TotalMemory(b *testing.B) {
+	for name, config := range stackConfigs {
+		b.Run(name, func(b *testing.B) {
+			var s *SliceStack[int]
+			for b.Loop() {
+				s = NewSliceStackWithConfig[int](config)
+				for i := range 1_000_000 {
+					s.Push(i)
+				}
+				for range 999_000 {
+					s.Pop()
+				}
+			}
+
+			bench.ReportRetained(b, cap(s.data)*8)
+			b.ReportMetric(float64(s.Stats().Reallocations), "reallocs")
+		})
+	}
+}
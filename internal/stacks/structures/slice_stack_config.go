@@ -1,5 +1,7 @@
 package structures
 
+import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
+
 // SliceStackConfig controls memory optimization behavior for SliceStack.
 //
 // The stack supports one optional optimization strategy:
@@ -63,4 +65,95 @@ type SliceStackConfig struct {
 	//
 	// Valid range: [0, 99]
 	ReallocateWasteBuffer int
+
+	// ReallocateByHalving switches reallocation to simple capacity halving.
+	//
+	// When enabled together with ReallocateOnPop, the capacity is halved
+	// whenever the stack size drops below 25% of the capacity (and is at
+	// least MinOptimizationLength). ReallocateWastePercent and
+	// ReallocateWasteBuffer are ignored.
+	//
+	// Halving is cheaper to evaluate and more predictable than the
+	// waste-buffer computation, but is not tunable: the trigger (25% usage) and the
+	// shrink factor (2x) are fixed.
+	ReallocateByHalving bool
+
+	// InitialCapacity is the capacity allocated by the constructor, so
+	// pushes up to that many elements do not reallocate. The capacity is
+	// never smaller than the number of initial values; 0 sizes the slice
+	// to exactly the initial values.
+	//
+	// Valid range: [0, ...]
+	InitialCapacity int
+}
+
+// Validates the configuration values.
+//
+// Panics if values are invalid:
+//   - MinOptimizationLength < 0
+//   - ReallocateWastePercent outside [0, 100]
+//   - ReallocateWasteBuffer outside [0, 99]
+//   - InitialCapacity < 0
+//
+// Values are validated even when ReallocateOnPop is disabled, so a
+// configuration stays valid when reallocation is toggled on later.
+func (c *SliceStackConfig) validate() {
+	panics.RequireNonNegative(c.MinOptimizationLength, "min optimization length")
+	panics.RequireNonNegative(c.ReallocateWastePercent, "reallocate waste percent")
+	panics.RequireLessThanOrEqualTo(c.ReallocateWastePercent, 100, "reallocate waste percent")
+	panics.RequireNonNegative(c.ReallocateWasteBuffer, "reallocate waste buffer")
+	panics.RequireLessThanOrEqualTo(c.ReallocateWasteBuffer, 99, "reallocate waste buffer")
+	panics.RequireNonNegative(c.InitialCapacity, "initial capacity")
+}
+
+// SliceStackOption configures a SliceStack created with NewSliceStackWith.
+// Options are applied in order on top of the default configuration.
+type SliceStackOption func(*SliceStackConfig)
+
+// WithReallocateOnPop enables or disables reallocation after Pop operations.
+// See SliceStackConfig.ReallocateOnPop.
+func WithReallocateOnPop(enabled bool) SliceStackOption {
+	return func(c *SliceStackConfig) {
+		c.ReallocateOnPop = enabled
+	}
+}
+
+// WithMinOptimizationLength sets the minimum stack size to trigger reallocation.
+// See SliceStackConfig.MinOptimizationLength.
+func WithMinOptimizationLength(length int) SliceStackOption {
+	return func(c *SliceStackConfig) {
+		c.MinOptimizationLength = length
+	}
+}
+
+// WithReallocateWastePercent sets the waste threshold to trigger reallocation.
+// See SliceStackConfig.ReallocateWastePercent.
+func WithReallocateWastePercent(percent int) SliceStackOption {
+	return func(c *SliceStackConfig) {
+		c.ReallocateWastePercent = percent
+	}
+}
+
+// WithReallocateWasteBuffer sets the target waste after reallocation.
+// See SliceStackConfig.ReallocateWasteBuffer.
+func WithReallocateWasteBuffer(buffer int) SliceStackOption {
+	return func(c *SliceStackConfig) {
+		c.ReallocateWasteBuffer = buffer
+	}
+}
+
+// WithReallocateByHalving switches reallocation to capacity halving.
+// See SliceStackConfig.ReallocateByHalving.
+func WithReallocateByHalving(enabled bool) SliceStackOption {
+	return func(c *SliceStackConfig) {
+		c.ReallocateByHalving = enabled
+	}
+}
+
+// WithCapacity sets the capacity allocated by the constructor.
+// See SliceStackConfig.InitialCapacity.
+func WithCapacity(capacity int) SliceStackOption {
+	return func(c *SliceStackConfig) {
+		c.InitialCapacity = capacity
+	}
 }
@@ -0,0 +1,30 @@
+package structures
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/proptest"
+)
+
+// Fuzzes SliceStack with operation sequences decoded from the input. The
+// first byte selects the configuration, the rest drives the operations of
+// the property tests, which compare every step against a slice model.
+//
+// Run: go test ./internal/stacks/structures -run '^$' -fuzz FuzzSliceStack
+func FuzzSliceStack(f *testing.F) {
+	f.Add([]byte{0, 10, 20, 30, 200, 250})
+	f.Add([]byte{1, 90, 90, 90, 90, 180, 180, 255, 0, 130})
+	f.Add([]byte{2, 100, 255, 100, 255, 150, 150, 150, 150, 150, 150})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) == 0 {
+			return
+		}
+
+		config := sliceStackModelConfigs[int(data[0])%len(sliceStackModelConfigs)].config
+		setup := func() (*SliceStack[int], []int) {
+			return NewSliceStackWithConfig[int](config), []int{}
+		}
+		proptest.Replay(t, data[1:], 1000, setup, checkSliceStackModel, sliceStackOps()...)
+	})
+}
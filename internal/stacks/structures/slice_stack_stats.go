@@ -0,0 +1,27 @@
+package structures
+
+// SliceStackStats reports how the ReallocateOnPop optimization has
+// behaved for a SliceStack, so callers can verify it pays off for their
+// workload.
+//
+// Counters accumulate over the lifetime of the stack. Reset, Clear and
+// ShrinkToFit do not count as reallocations and do not reset the counters.
+type SliceStackStats struct {
+	// Reallocations is the number of times Pop-time reallocation
+	// replaced the underlying slice with a smaller one.
+	Reallocations int
+
+	// FreedCapacity is the total number of element slots released by
+	// reallocations (sum of old capacity - new capacity).
+	FreedCapacity int
+
+	// FreedBytes is FreedCapacity multiplied by the in-memory size of one
+	// element. Memory referenced by elements (pointers, slices, strings)
+	// is not included.
+	FreedBytes int
+
+	// WastePercent is the current share of unused capacity (0-100),
+	// calculated as 100 - 100 * size / capacity. It is 0 when the
+	// capacity is 0.
+	WastePercent int
+}
@@ -8,6 +8,10 @@ Constructor (NewSliceStack):
   ✓ Single value
   ✓ Multiple values
 
+Constructor (NewSliceStackWithCapacity):
+  ✓ Negative capacity (panic)
+  ✓ Pushes up to the capacity do not reallocate
+
 Push:
   ✓ Single value to empty stack
   ✓ Single value to non-empty stack
@@ -31,11 +35,120 @@ Peek:
 IsEmpty/Size:
   ✓ Empty stack
   ✓ Non-empty stack
+
+PushAll:
+  ✓ No values
+  ✓ Multiple values to empty stack
+  ✓ Multiple values to non-empty stack (after pops)
+
+PopN:
+  ✓ Negative count (error)
+  ✓ Count greater than size (error)
+  ✓ Zero count
+  ✓ Some elements (pop order)
+  ✓ All elements
+  ✓ Reallocation triggers once
+
+Drain:
+  ✓ Empty stack
+  ✓ Non-empty stack (pop order, stack emptied)
+  ✓ Reusable after draining
+
+All/BottomUp:
+  ✓ Empty stack
+  ✓ Top-to-bottom order
+  ✓ Bottom-to-top order
+  ✓ Early termination
+  ✓ Stack unchanged after iteration
+
+Reset/Clear:
+  ✓ Empty stack
+  ✓ Reset keeps capacity and zeroes elements
+  ✓ Clear releases capacity
+  ✓ Reusable after reset and clear
+
+Reverse:
+  ✓ Empty stack
+  ✓ Single element
+  ✓ Multiple elements (order reversed, size unchanged)
+
+Reserve:
+  ✓ Negative count (panic)
+  ✓ Capacity sized exactly, elements preserved
+  ✓ Pushes within reserve keep capacity
+  ✓ Sufficient capacity left unchanged
+
+Clone:
+  ✓ Empty stack
+  ✓ Same elements and configuration
+  ✓ Copy is independent of the original
+  ✓ CloneFunc copies every element with the given function
+
+Configuration (NewSliceStackWithConfig/NewSliceStackWith):
+  ✓ Invalid values (panic)
+  ✓ No options yields default configuration
+  ✓ Options applied in order
+  ✓ Invalid options (panic)
+  ✓ WithCapacity allocates the capacity, never below the initial values
+
+Stats:
+  ✓ New stack reports no reallocations
+  ✓ Reallocation counted with freed capacity and bytes
+  ✓ Waste percent reflects current usage
+  ✓ Disabled optimization never reallocates
+
+Reallocation by halving:
+  ✓ Capacity halves below 25% usage
+  ✓ Elements preserved
+
+ShrinkToFit:
+  ✓ Capacity equals size, elements preserved, OnShrink reported
+  ✓ Empty stack releases the slice
+  ✓ Exact capacity left unchanged
+
+Hooks:
+  ✓ OnGrow reports every capacity increase of Push, PushAll and Reserve
+  ✓ OnShrink reports reallocation after popping and Clear
+
+CheckInvariants:
+  ✓ Valid stack, top index out of range
+
+Memory:
+  ✓ Default reallocation frees at least 97% of the peak after a permanent
+    shrink
+
+Properties:
+  ✓ Random operation sequences match a slice model, with and without
+    reallocation
+
+Add:
+  ✓ Pushes onto the top and always returns true
+
+Binary encoding:
+  ✓ Round trip preserves order and keeps the configuration
+  ✓ Invalid data leaves the stack unchanged
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom round trip
+  ✓ Invalid stream leaves the stack unchanged
+
+Forged streams:
+  ✓ Count beyond the int range rejected by ReadFrom
 */
 
 import (
+	"bytes"
+	"encoding/binary"
+	"math/rand/v2"
+	"runtime"
+	"slices"
 	"testing"
 
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+	"github.com/apotourlyan/godatastructures/internal/utilities/bench"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/proptest"
 	"github.com/apotourlyan/godatastructures/internal/utilities/test"
 )
 
@@ -60,6 +173,26 @@ func TestSliceStack_NewSliceStack_ManyValues(t *testing.T) {
 	test.GotWant(t, s.IsEmpty(), false)
 }
 
+// Verifies NewSliceStackWithCapacity panics on a negative capacity
+func TestSliceStack_NewSliceStackWithCapacity_Negative(t *testing.T) {
+	test.GotWantPanic(t, func() { NewSliceStackWithCapacity[int](-1) }, `"capacity" must be >= 0, got -1`)
+}
+
+// Verifies pushes up to the requested capacity do not reallocate
+func TestSliceStack_NewSliceStackWithCapacity(t *testing.T) {
+	s := NewSliceStackWithCapacity[int](100)
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, cap(s.data), 100)
+
+	grows := 0
+	s.SetHooks(algorithms.SliceHooks{OnGrow: func(int, int) { grows++ }})
+	for i := range 100 {
+		s.Push(i)
+	}
+	test.GotWant(t, grows, 0)
+	test.GotWant(t, cap(s.data), 100)
+}
+
 // Verifies the pushing of an element in an empty stack
 func TestSliceStack_Push_OneElement_EmptyStack(t *testing.T) {
 	s := NewSliceStack[int]()
@@ -107,7 +240,7 @@ func TestSliceStack_Push_ManyElements_NonEmptyStack(t *testing.T) {
 func TestSliceStack_Pop_OneElement_EmptyStack(t *testing.T) {
 	s := NewSliceStack[int]()
 	d, err := s.Pop()
-	test.GotWantError(t, err, ErrorEmptyStack)
+	test.GotWantErrorIs(t, err, ErrEmptyStack)
 	test.GotWant(t, d, 0)
 	test.GotWant(t, s.Size(), 0)
 	test.GotWant(t, s.IsEmpty(), true)
@@ -117,7 +250,7 @@ func TestSliceStack_Pop_OneElement_EmptyStack(t *testing.T) {
 func TestSliceStack_Pop_OneElement_NonEmptyStack(t *testing.T) {
 	s := NewSliceStack(1, 2, 3)
 	d, err := s.Pop()
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, d, 3)
 	p, _ := s.Peek()
 	test.GotWant(t, p, 2)
@@ -131,7 +264,7 @@ func TestSliceStack_Pop_ManyElements_NonEmptyStack(t *testing.T) {
 	s.Pop()
 	s.Pop()
 	d, err := s.Pop()
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, d, 1)
 	test.GotWant(t, s.Size(), 0)
 	test.GotWant(t, s.IsEmpty(), true)
@@ -170,7 +303,7 @@ func TestSliceStack_PushPop_Reusability(t *testing.T) {
 func TestSliceStack_Peek_EmptyStack(t *testing.T) {
 	s := NewSliceStack[int]()
 	p, err := s.Peek()
-	test.GotWantError(t, err, ErrorEmptyStack)
+	test.GotWantErrorIs(t, err, ErrEmptyStack)
 	test.GotWant(t, p, 0)
 	test.GotWant(t, s.Size(), 0)
 	test.GotWant(t, s.IsEmpty(), true)
@@ -180,7 +313,7 @@ func TestSliceStack_Peek_EmptyStack(t *testing.T) {
 func TestSliceStack_Peek_NonEmptyStack(t *testing.T) {
 	s := NewSliceStack(1, 2, 3)
 	p, err := s.Peek()
-	test.GotWant(t, err, nil)
+	test.GotWantNoError(t, err)
 	test.GotWant(t, p, 3)
 	test.GotWant(t, s.Size(), 3)
 	test.GotWant(t, s.IsEmpty(), false)
@@ -192,7 +325,7 @@ func TestSliceStack_Peek_Many(t *testing.T) {
 
 	for range 3 {
 		p, err := s.Peek()
-		test.GotWant(t, err, nil)
+		test.GotWantNoError(t, err)
 		test.GotWant(t, p, 3)
 	}
 }
@@ -220,3 +353,779 @@ func TestSliceStack_Size_NonEmptyStack(t *testing.T) {
 	s := NewSliceStack(1, 2, 3)
 	test.GotWant(t, s.Size(), 3)
 }
+
+// Verifies pushing no values leaves the stack unchanged
+func TestSliceStack_PushAll_NoValues(t *testing.T) {
+	s := NewSliceStack(1, 2)
+	s.PushAll()
+	p, _ := s.Peek()
+	test.GotWant(t, p, 2)
+	test.GotWant(t, s.Size(), 2)
+}
+
+// Verifies pushing multiple values to an empty stack
+func TestSliceStack_PushAll_EmptyStack(t *testing.T) {
+	s := NewSliceStack[int]()
+	s.PushAll(1, 2, 3)
+	test.GotWant(t, s.Size(), 3)
+	for i := range 3 {
+		d, _ := s.Pop()
+		test.GotWant(t, d, 3-i)
+	}
+}
+
+// Verifies pushing multiple values to a non-empty stack that has spare length
+func TestSliceStack_PushAll_NonEmptyStack(t *testing.T) {
+	s := NewSliceStack(1, 2, 3, 4)
+	s.Pop()
+	s.Pop()
+	s.PushAll(5, 6, 7)
+	test.GotWant(t, s.Size(), 5)
+	for _, want := range []int{7, 6, 5, 2, 1} {
+		d, _ := s.Pop()
+		test.GotWant(t, d, want)
+	}
+}
+
+// Verifies popping a negative count
+func TestSliceStack_PopN_NegativeCount(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	v, err := s.PopN(-1)
+	test.GotWantErrorIs(t, err, ErrCountOutOfRange)
+	test.GotWant(t, len(v), 0)
+	test.GotWant(t, s.Size(), 3)
+}
+
+// Verifies popping more elements than the stack holds
+func TestSliceStack_PopN_CountGreaterThanSize(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	v, err := s.PopN(4)
+	test.GotWantErrorIs(t, err, ErrCountOutOfRange)
+	test.GotWant(t, len(v), 0)
+	test.GotWant(t, s.Size(), 3)
+}
+
+// Verifies popping zero elements
+func TestSliceStack_PopN_Zero(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	v, err := s.PopN(0)
+	test.GotWantNoError(t, err)
+	test.GotWant(t, len(v), 0)
+	test.GotWant(t, s.Size(), 3)
+}
+
+// Verifies popping some elements returns them in pop order
+func TestSliceStack_PopN_Some(t *testing.T) {
+	s := NewSliceStack(1, 2, 3, 4)
+	v, err := s.PopN(2)
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, v, []int{4, 3})
+	p, _ := s.Peek()
+	test.GotWant(t, p, 2)
+	test.GotWant(t, s.Size(), 2)
+}
+
+// Verifies popping all elements empties the stack
+func TestSliceStack_PopN_All(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	v, err := s.PopN(3)
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, v, []int{3, 2, 1})
+	test.GotWant(t, s.IsEmpty(), true)
+	s.Push(4)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 4)
+}
+
+// Verifies a bulk pop evaluates reallocation once at the end
+func TestSliceStack_PopN_Reallocation(t *testing.T) {
+	s := NewSliceStackWithConfig[int](SliceStackConfig{
+		ReallocateOnPop:        true,
+		MinOptimizationLength:  10,
+		ReallocateWastePercent: 75,
+		ReallocateWasteBuffer:  80,
+	})
+	for i := range 1000 {
+		s.Push(i)
+	}
+
+	capBefore := cap(s.data)
+	v, _ := s.PopN(900)
+	test.GotWant(t, len(v), 900)
+	test.GotWant(t, cap(s.data) < capBefore, true)
+	test.GotWant(t, s.Size(), 100)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 99)
+}
+
+// Verifies draining an empty stack
+func TestSliceStack_Drain_EmptyStack(t *testing.T) {
+	s := NewSliceStack[int]()
+	test.GotWant(t, len(s.Drain()), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies draining returns elements in pop order and empties the stack
+func TestSliceStack_Drain_NonEmptyStack(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	v := s.Drain()
+	test.GotWantSlice(t, v, []int{3, 2, 1})
+	test.GotWant(t, cap(v), 3)
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies the stack is reusable after draining
+func TestSliceStack_Drain_Reusability(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	s.Drain()
+	s.Push(4)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 4)
+	test.GotWant(t, s.Size(), 1)
+}
+
+// Verifies iterating an empty stack yields nothing
+func TestSliceStack_All_EmptyStack(t *testing.T) {
+	s := NewSliceStack[int]()
+	for range s.All() {
+		t.Error("unexpected element")
+	}
+	for range s.BottomUp() {
+		t.Error("unexpected element")
+	}
+}
+
+// Verifies All yields elements from top to bottom
+func TestSliceStack_All_Order(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	got := []int{}
+	for v := range s.All() {
+		got = append(got, v)
+	}
+	test.GotWantSlice(t, got, []int{3, 2, 1})
+}
+
+// Verifies BottomUp yields elements from bottom to top
+func TestSliceStack_BottomUp_Order(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	got := []int{}
+	for v := range s.BottomUp() {
+		got = append(got, v)
+	}
+	test.GotWantSlice(t, got, []int{1, 2, 3})
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestSliceStack_All_EarlyTermination(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	got := []int{}
+	for v := range s.All() {
+		got = append(got, v)
+		break
+	}
+	for v := range s.BottomUp() {
+		got = append(got, v)
+		break
+	}
+	test.GotWantSlice(t, got, []int{3, 1})
+}
+
+// Verifies iteration does not modify the stack
+func TestSliceStack_All_NonDestructive(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	for range s.All() {
+	}
+	for range s.BottomUp() {
+	}
+	test.GotWant(t, s.Size(), 3)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 3)
+}
+
+// Verifies resetting and clearing an empty stack
+func TestSliceStack_ResetClear_EmptyStack(t *testing.T) {
+	s := NewSliceStack[int]()
+	s.Reset()
+	test.GotWant(t, s.IsEmpty(), true)
+	s.Clear()
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies Reset empties the stack but keeps its capacity
+func TestSliceStack_Reset_KeepsCapacity(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	capBefore := cap(s.data)
+	s.Reset()
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, cap(s.data), capBefore)
+	test.GotWantSlice(t, s.data[:capBefore], []int{0, 0, 0})
+}
+
+// Verifies Clear empties the stack and releases its capacity
+func TestSliceStack_Clear_ReleasesCapacity(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	s.Clear()
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, cap(s.data), 0)
+}
+
+// Verifies the stack is reusable after Reset and Clear
+func TestSliceStack_ResetClear_Reusability(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	s.Reset()
+	s.Push(4)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 4)
+	test.GotWant(t, s.Size(), 1)
+
+	s.Clear()
+	s.Push(5)
+	p, _ = s.Peek()
+	test.GotWant(t, p, 5)
+	test.GotWant(t, s.Size(), 1)
+}
+
+// Verifies reversing an empty stack
+func TestSliceStack_Reverse_EmptyStack(t *testing.T) {
+	s := NewSliceStack[int]()
+	s.Reverse()
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies reversing a one-element stack
+func TestSliceStack_Reverse_OneElement(t *testing.T) {
+	s := NewSliceStack(1)
+	s.Reverse()
+	p, _ := s.Peek()
+	test.GotWant(t, p, 1)
+	test.GotWant(t, s.Size(), 1)
+}
+
+// Verifies reversing a multi-element stack
+func TestSliceStack_Reverse_ManyElements(t *testing.T) {
+	s := NewSliceStack(1, 2, 3, 4)
+	s.Reverse()
+	test.GotWant(t, s.Size(), 4)
+	for i := range 4 {
+		d, _ := s.Pop()
+		test.GotWant(t, d, i+1)
+	}
+}
+
+// Verifies Reserve panics on a negative count
+func TestSliceStack_Reserve_NegativeCount(t *testing.T) {
+	s := NewSliceStack[int]()
+	test.GotWantPanic(t, func() { s.Reserve(-1) }, `"reserved size" must be >= 0, got -1`)
+}
+
+// Verifies Reserve grows the capacity to exactly size plus n
+func TestSliceStack_Reserve_Grows(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	s.Reserve(100)
+	test.GotWant(t, cap(s.data), 103)
+	test.GotWant(t, s.Size(), 3)
+	for i := range 3 {
+		d, _ := s.Pop()
+		test.GotWant(t, d, 3-i)
+	}
+}
+
+// Verifies pushes within the reserved count do not reallocate
+func TestSliceStack_Reserve_PushesKeepCapacity(t *testing.T) {
+	s := NewSliceStack[int]()
+	s.Reserve(50)
+	capBefore := cap(s.data)
+	for i := range 50 {
+		s.Push(i)
+	}
+	test.GotWant(t, cap(s.data), capBefore)
+	test.GotWant(t, s.Size(), 50)
+}
+
+// Verifies Reserve keeps the slice when the capacity suffices
+func TestSliceStack_Reserve_SufficientCapacity(t *testing.T) {
+	s := NewSliceStack[int]()
+	s.Reserve(20)
+	s.Push(1)
+	data := s.data
+	s.Reserve(19)
+	test.GotWant(t, &s.data[0], &data[0])
+	test.GotWant(t, cap(s.data), 20)
+}
+
+// Verifies cloning an empty stack
+func TestSliceStack_Clone_EmptyStack(t *testing.T) {
+	s := NewSliceStack[int]()
+	c := s.Clone()
+	test.GotWant(t, c.IsEmpty(), true)
+}
+
+// Verifies the clone holds the same elements and configuration
+func TestSliceStack_Clone_Contents(t *testing.T) {
+	config := SliceStackConfig{
+		ReallocateOnPop:        true,
+		MinOptimizationLength:  5,
+		ReallocateWastePercent: 60,
+		ReallocateWasteBuffer:  50,
+	}
+	s := NewSliceStackWithConfig(config, 1, 2, 3, 4)
+	s.Pop()
+	c := s.Clone()
+	test.GotWant(t, c.config, config)
+	test.GotWant(t, c.Size(), 3)
+	for i := range 3 {
+		d, _ := c.Pop()
+		test.GotWant(t, d, 3-i)
+	}
+}
+
+// Verifies changes to the clone and the original do not affect each other
+func TestSliceStack_Clone_Independence(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	c := s.Clone()
+	c.Pop()
+	c.Push(9)
+	s.Push(4)
+
+	test.GotWant(t, s.Size(), 4)
+	test.GotWant(t, c.Size(), 3)
+	sp, _ := s.Peek()
+	cp, _ := c.Peek()
+	test.GotWant(t, sp, 4)
+	test.GotWant(t, cp, 9)
+	test.GotWant(t, s.data[2], 3)
+}
+
+// Verifies CloneFunc deep-copies the elements so the copy shares no
+// backing arrays with the original
+func TestSliceStack_CloneFunc(t *testing.T) {
+	s := NewSliceStack([]int{1, 2}, []int{3})
+	c := s.CloneFunc(slices.Clone[[]int])
+	test.GotWant(t, c.config, s.config)
+	test.GotWant(t, c.Size(), 2)
+
+	top, _ := c.Peek()
+	top[0] = 9
+	orig, _ := s.Peek()
+	test.GotWant(t, orig[0], 3)
+	test.GotWant(t, c.data[0][1], 2)
+}
+
+// Verifies invalid configurations panic at construction
+func TestSliceStack_NewSliceStackWithConfig_InvalidArgs(t *testing.T) {
+	cases := []struct {
+		name   string
+		config SliceStackConfig
+		want   string
+	}{
+		{
+			name:   "negative_min_optimization_length",
+			config: SliceStackConfig{MinOptimizationLength: -1},
+			want:   `"min optimization length" must be >= 0, got -1`,
+		},
+		{
+			name:   "negative_waste_percent",
+			config: SliceStackConfig{ReallocateWastePercent: -1},
+			want:   `"reallocate waste percent" must be >= 0, got -1`,
+		},
+		{
+			name:   "waste_percent_greater_than_100",
+			config: SliceStackConfig{ReallocateWastePercent: 101},
+			want:   `"reallocate waste percent" must be <= 100, got 101`,
+		},
+		{
+			name:   "negative_waste_buffer",
+			config: SliceStackConfig{ReallocateWasteBuffer: -1},
+			want:   `"reallocate waste buffer" must be >= 0, got -1`,
+		},
+		{
+			name:   "waste_buffer_equals_100",
+			config: SliceStackConfig{ReallocateWasteBuffer: 100},
+			want:   `"reallocate waste buffer" must be <= 99, got 100`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			test.GotWantPanic(t, func() {
+				NewSliceStackWithConfig[int](c.config)
+			}, c.want)
+		})
+	}
+}
+
+// Verifies no options yields the default configuration
+func TestSliceStack_NewSliceStackWith_Defaults(t *testing.T) {
+	s := NewSliceStackWith[int]()
+	test.GotWant(t, s.config, NewSliceStack[int]().config)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies options are applied in order on top of the defaults
+func TestSliceStack_NewSliceStackWith_Options(t *testing.T) {
+	s := NewSliceStackWith[int](
+		WithReallocateOnPop(false),
+		WithMinOptimizationLength(500),
+		WithReallocateWastePercent(80),
+		WithReallocateWasteBuffer(70),
+		WithReallocateWastePercent(90),
+	)
+	test.GotWant(t, s.config, SliceStackConfig{
+		ReallocateOnPop:        false,
+		MinOptimizationLength:  500,
+		ReallocateWastePercent: 90,
+		ReallocateWasteBuffer:  70,
+	})
+}
+
+// Verifies invalid options panic at construction
+func TestSliceStack_NewSliceStackWith_InvalidOptions(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewSliceStackWith[int](WithReallocateWasteBuffer(100))
+	}, `"reallocate waste buffer" must be <= 99, got 100`)
+}
+
+// Verifies WithCapacity allocates the requested capacity and the
+// configured capacity never drops below the initial values
+func TestSliceStack_NewSliceStackWith_Capacity(t *testing.T) {
+	s := NewSliceStackWith[int](WithCapacity(64))
+	test.GotWant(t, cap(s.data), 64)
+	test.GotWant(t, s.IsEmpty(), true)
+
+	s = NewSliceStackWithConfig(SliceStackConfig{InitialCapacity: 2}, 1, 2, 3)
+	test.GotWant(t, cap(s.data), 3)
+	test.GotWantSlice(t, slices.Collect(s.BottomUp()), []int{1, 2, 3})
+
+	test.GotWantPanic(t, func() {
+		NewSliceStackWith[int](WithCapacity(-1))
+	}, `"initial capacity" must be >= 0, got -1`)
+}
+
+// Verifies a new stack reports no reallocations
+func TestSliceStack_Stats_NewStack(t *testing.T) {
+	s := NewSliceStack(1, 2, 3)
+	test.GotWant(t, s.Stats(), SliceStackStats{})
+	test.GotWant(t, NewSliceStack[int]().Stats(), SliceStackStats{})
+}
+
+// Verifies reallocations are counted together with the freed capacity
+func TestSliceStack_Stats_Reallocation(t *testing.T) {
+	s := NewSliceStackWithConfig[int64](SliceStackConfig{
+		ReallocateOnPop:        true,
+		MinOptimizationLength:  10,
+		ReallocateWastePercent: 75,
+		ReallocateWasteBuffer:  80,
+	})
+	for i := range 1000 {
+		s.Push(int64(i))
+	}
+
+	capBefore := cap(s.data)
+	for range 900 {
+		s.Pop()
+	}
+
+	stats := s.Stats()
+	test.GotWant(t, stats.Reallocations > 0, true)
+	test.GotWant(t, stats.FreedCapacity, capBefore-cap(s.data))
+	test.GotWant(t, stats.FreedBytes, stats.FreedCapacity*8)
+}
+
+// Verifies the waste percent reflects the current usage
+func TestSliceStack_Stats_WastePercent(t *testing.T) {
+	s := NewSliceStackWithConfig(SliceStackConfig{}, 1, 2, 3, 4)
+	s.Pop()
+	s.Pop()
+	s.Pop()
+	test.GotWant(t, s.Stats().WastePercent, 75)
+}
+
+// Verifies a stack with reallocation disabled never reallocates
+func TestSliceStack_Stats_Disabled(t *testing.T) {
+	s := NewSliceStackWithConfig[int](SliceStackConfig{})
+	for i := range 1000 {
+		s.Push(i)
+	}
+	for range 999 {
+		s.Pop()
+	}
+
+	test.GotWant(t, s.Stats().Reallocations, 0)
+	test.GotWant(t, s.Stats().FreedCapacity, 0)
+}
+
+// Verifies halving reallocation shrinks capacity in halves and keeps elements
+func TestSliceStack_ReallocateByHalving(t *testing.T) {
+	s := NewSliceStackWith[int](
+		WithMinOptimizationLength(10),
+		WithReallocateByHalving(true),
+	)
+	s.PushAll(make([]int, 1000)...)
+	for i := range 1000 {
+		s.data[i] = i
+	}
+
+	capBefore := cap(s.data)
+	// Usage 249/1000 < 25% triggers the first halving
+	s.PopN(751)
+	test.GotWant(t, cap(s.data), capBefore/2)
+	test.GotWant(t, s.Stats().Reallocations, 1)
+
+	// Halving leaves the stack ~50% full, so no immediate second halving
+	s.Pop()
+	test.GotWant(t, s.Stats().Reallocations, 1)
+
+	test.GotWant(t, s.Size(), 248)
+	for i := range 248 {
+		d, _ := s.Pop()
+		test.GotWant(t, d, 247-i)
+	}
+}
+
+// Verifies ShrinkToFit reallocates to exactly the live size, whatever the
+// configuration, and reports the shrink
+func TestSliceStack_ShrinkToFit(t *testing.T) {
+	s := NewSliceStackWithConfig[int](SliceStackConfig{})
+	s.PushAll(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	s.PopN(7)
+
+	var shrunk [2]int
+	s.SetHooks(algorithms.SliceHooks{
+		OnShrink: func(oldCap, newCap int) { shrunk = [2]int{oldCap, newCap} },
+	})
+	capBefore := cap(s.data)
+	s.ShrinkToFit()
+	test.GotWant(t, cap(s.data), 3)
+	test.GotWant(t, shrunk, [2]int{capBefore, 3})
+	test.GotWantSlice(t, slices.Collect(s.BottomUp()), []int{1, 2, 3})
+	test.GotWantNoError(t, s.CheckInvariants())
+
+	s.Push(4)
+	test.GotWantSlice(t, slices.Collect(s.BottomUp()), []int{1, 2, 3, 4})
+}
+
+// Verifies ShrinkToFit on an emptied stack releases the slice
+func TestSliceStack_ShrinkToFit_Empty(t *testing.T) {
+	s := NewSliceStackWithConfig(SliceStackConfig{}, 1, 2, 3)
+	s.Drain()
+	s.ShrinkToFit()
+	test.GotWant(t, cap(s.data), 0)
+	test.GotWant(t, s.data == nil, true)
+}
+
+// Verifies ShrinkToFit keeps a slice whose capacity already fits
+func TestSliceStack_ShrinkToFit_AlreadyFits(t *testing.T) {
+	s := NewSliceStackWithConfig(SliceStackConfig{}, 1, 2, 3)
+	data := s.data
+	s.ShrinkToFit()
+	test.GotWant(t, &s.data[0], &data[0])
+}
+
+// Verifies OnGrow reports every capacity increase of Push, PushAll and
+// Reserve, each continuing from the previous one
+func TestSliceStack_Hooks_OnGrow(t *testing.T) {
+	s := NewSliceStackWithConfig[int](SliceStackConfig{})
+	grows, last := 0, 0
+	s.SetHooks(algorithms.SliceHooks{
+		OnGrow: func(oldCap, newCap int) {
+			test.GotWant(t, oldCap, last)
+			test.GotWant(t, newCap > oldCap, true)
+			grows++
+			last = newCap
+		},
+	})
+
+	for i := range 100 {
+		s.Push(i)
+	}
+	test.GotWant(t, grows > 0, true)
+	test.GotWant(t, last, cap(s.data))
+
+	s.PushAll(make([]int, 1000)...)
+	test.GotWant(t, last, cap(s.data))
+
+	s.Reserve(cap(s.data))
+	test.GotWant(t, last, cap(s.data))
+}
+
+// Verifies OnShrink reports reallocation after popping and Clear, ending
+// at the current capacity
+func TestSliceStack_Hooks_OnShrink(t *testing.T) {
+	s := NewSliceStackWith[int](WithMinOptimizationLength(10))
+	s.PushAll(make([]int, 1000)...)
+
+	shrinks, last := 0, 0
+	s.SetHooks(algorithms.SliceHooks{
+		OnShrink: func(oldCap, newCap int) {
+			test.GotWant(t, newCap < oldCap, true)
+			shrinks++
+			last = newCap
+		},
+	})
+	s.PopN(900)
+	test.GotWant(t, shrinks, s.Stats().Reallocations)
+	test.GotWant(t, last, cap(s.data))
+
+	s.Clear()
+	test.GotWant(t, shrinks, s.Stats().Reallocations+1)
+	test.GotWant(t, last, 0)
+}
+
+// Verifies CheckInvariants accepts a valid stack and reports a top index
+// outside the storage
+func TestSliceStack_CheckInvariants(t *testing.T) {
+	s := NewSliceStackWithConfig(SliceStackConfig{}, 1, 2, 3)
+	test.GotWantNoError(t, s.CheckInvariants())
+
+	s.curr = 4
+	test.GotWantError(t, s.CheckInvariants(), "invariant violation: top index 4 outside [0, 3]")
+	s.curr = -1
+	test.GotWantError(t, s.CheckInvariants(), "invariant violation: top index -1 outside [0, 3]")
+}
+
+// Verifies the default reallocation frees at least 97% of the peak memory
+// after a permanent shrink, as documented on SliceStack
+func TestSliceStack_Reallocation_FreesMemory(t *testing.T) {
+	memory := bench.TrackMemory()
+	s := NewSliceStack[int]()
+	for i := range 1_000_000 {
+		s.Push(i)
+	}
+	peak := memory.Retained()
+
+	s.PopN(999_000)
+	retained := memory.Retained()
+	runtime.KeepAlive(s)
+
+	if freed := bench.FreedPercent(peak, retained); freed < 97 {
+		t.Errorf("freed %.2f%% of %d bytes, want at least 97%%", freed, peak)
+	}
+}
+
+// Verifies random operation sequences keep the stack equivalent to a slice
+// model, with aggressive waste-based and halving reallocation
+func TestSliceStack_Properties(t *testing.T) {
+	for _, c := range sliceStackModelConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			setup := func() (*SliceStack[int], []int) {
+				return NewSliceStackWithConfig[int](c.config), []int{}
+			}
+			proptest.Run(t, proptest.Config{Seed: 61, Runs: 30, Steps: 400}, setup, checkSliceStackModel, sliceStackOps()...)
+		})
+	}
+}
+
+// Returns the operations of the SliceStack property and fuzz tests
+func sliceStackOps() []proptest.Op[*SliceStack[int], []int] {
+	type op = proptest.Op[*SliceStack[int], []int]
+	return []op{
+		{Name: "Push", Weight: 4, Apply: func(t *testing.T, r *rand.Rand, s *SliceStack[int], m *[]int) {
+			v := r.IntN(1000)
+			s.Push(v)
+			*m = append(*m, v)
+		}},
+		{Name: "Pop", Weight: 3, Apply: func(t *testing.T, r *rand.Rand, s *SliceStack[int], m *[]int) {
+			v, err := s.Pop()
+			if len(*m) == 0 {
+				test.GotWantErrorIs(t, err, ErrEmptyStack)
+				return
+			}
+			test.GotWant(t, v, (*m)[len(*m)-1])
+			*m = (*m)[:len(*m)-1]
+		}},
+		{Name: "PushAll", Apply: func(t *testing.T, r *rand.Rand, s *SliceStack[int], m *[]int) {
+			values := make([]int, r.IntN(40))
+			for i := range values {
+				values[i] = r.IntN(1000)
+			}
+			s.PushAll(values...)
+			*m = append(*m, values...)
+		}},
+		{Name: "PopN", Apply: func(t *testing.T, r *rand.Rand, s *SliceStack[int], m *[]int) {
+			n := r.IntN(len(*m) + 1)
+			values, _ := s.PopN(n)
+			want := slices.Clone((*m)[len(*m)-n:])
+			slices.Reverse(want)
+			test.GotWantSlice(t, values, want)
+			*m = (*m)[:len(*m)-n]
+		}},
+		{Name: "Reserve", Apply: func(t *testing.T, r *rand.Rand, s *SliceStack[int], m *[]int) {
+			s.Reserve(r.IntN(50))
+		}},
+		{Name: "Reverse", Apply: func(t *testing.T, r *rand.Rand, s *SliceStack[int], m *[]int) {
+			s.Reverse()
+			slices.Reverse(*m)
+		}},
+	}
+}
+
+// Verifies the stack matches its slice model
+func checkSliceStackModel(t *testing.T, s *SliceStack[int], m []int) {
+	t.Helper()
+	test.GotWantNoError(t, s.CheckInvariants())
+	test.GotWant(t, s.Size(), len(m))
+	test.GotWantSlice(t, slices.Collect(s.BottomUp()), m)
+}
+
+// Configurations the SliceStack property and fuzz tests run under, with
+// aggressive thresholds so reallocation triggers often
+var sliceStackModelConfigs = []struct {
+	name   string
+	config SliceStackConfig
+}{
+	{"no_reallocation", SliceStackConfig{}},
+	{"waste_reallocation", SliceStackConfig{
+		ReallocateOnPop:        true,
+		MinOptimizationLength:  4,
+		ReallocateWastePercent: 50,
+		ReallocateWasteBuffer:  80,
+	}},
+	{"halving", SliceStackConfig{
+		ReallocateOnPop:       true,
+		ReallocateByHalving:   true,
+		MinOptimizationLength: 4,
+	}},
+}
+
+// Verifies Add pushes onto the top of the stack
+func TestSliceStack_Add(t *testing.T) {
+	s := NewSliceStack(1)
+	test.GotWant(t, s.Add(2), true)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{2, 1})
+}
+
+// Verifies a round trip preserves the order and the configuration of the
+// receiving stack, and invalid data is rejected
+func TestSliceStack_MarshalBinary(t *testing.T) {
+	other, _ := codec.EncodeSeq("SliceQueue", 1, slices.Values([]int{1}))
+	dst := NewSliceStackWithConfig(SliceStackConfig{}, 9)
+	codectest.RoundTrip(t, NewSliceStack(1, 2, 3), dst,
+		func(s *SliceStack[int]) []int { return slices.Collect(s.All()) }, other)
+	test.GotWant(t, dst.config, SliceStackConfig{})
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements from top to bottom
+func TestSliceStack_WriteTo_ReadFrom(t *testing.T) {
+	src := NewSliceStack(1, 2, 3)
+	dst := NewSliceStack(9)
+	codectest.StreamRoundTrip(t, src, dst,
+		func(s *SliceStack[int]) []int { return slices.Collect(s.All()) })
+}
+
+// Verifies ReadFrom rejects a header whose count does not fit an int,
+// leaving the stack unchanged
+func TestSliceStack_ReadFrom_CountOverflow(t *testing.T) {
+	data := append([]byte("GDS"), codec.Version)
+	data = binary.AppendUvarint(data, uint64(len("SliceStack")))
+	data = append(data, "SliceStack"...)
+	data = binary.AppendUvarint(data, 1<<64-1)
+
+	s := NewSliceStack(7)
+	_, err := s.ReadFrom(bytes.NewReader(data))
+	test.GotWantErrorIs(t, err, codec.ErrInvalidData)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{7})
+}
@@ -1,6 +1,22 @@
 package structures
 
+import "errors"
+
 const ErrorEmptyStack = "stack is empty"
+const ErrorFullStack = "stack is full"
+const ErrorCountOutOfRange = "count is out of the range of possible values"
+const ErrorInvariantViolation = "invariant violation"
+
+// Errors returned by the stack operations that cannot complete: popping or
+// peeking an empty stack, pushing onto a full fixed-capacity stack, and
+// asking for more elements than a stack holds. ErrInvariantViolation is
+// wrapped by CheckInvariants.
+var (
+	ErrEmptyStack         = errors.New(ErrorEmptyStack)
+	ErrFullStack          = errors.New(ErrorFullStack)
+	ErrCountOutOfRange    = errors.New(ErrorCountOutOfRange)
+	ErrInvariantViolation = errors.New(ErrorInvariantViolation)
+)
 
 // Stack defines the interface for a LIFO (Last-In-First-Out) data structure.
 // Elements are added to the top and removed from the top, maintaining reverse insertion order.
@@ -31,3 +47,18 @@ type Stack[T any] interface {
 	// Size returns the number of elements currently in the stack.
 	Size() int
 }
+
+// SearchableStack defines value-based lookup operations for stacks whose
+// elements are comparable. Positions are reported as distances from the
+// top, matching the classic java.util.Stack semantics.
+type SearchableStack[T comparable] interface {
+	Stack[T]
+
+	// Contains returns true if the stack contains the specified value.
+	Contains(value T) bool
+
+	// Search returns the 1-based distance from the top of the stack to the
+	// topmost occurrence of the specified value. The top element has
+	// distance 1. Returns -1 if the value is not found.
+	Search(value T) int
+}
@@ -0,0 +1,65 @@
+package structures
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &StackSnapshot[int]{}
+var _ collections.Iterable[int] = &StackSnapshot[int]{}
+
+// StackSnapshot is an immutable point-in-time view of a stack, returned by
+// TreiberStack.Snapshot.
+//
+// Every iteration of a snapshot sees the same elements, however the stack
+// changes afterwards, and no method takes a lock, so long scans never
+// hold up writers. All methods are safe for concurrent use by multiple
+// goroutines.
+type StackSnapshot[T any] struct {
+	top  *treiberNode[T]
+	size int
+}
+
+// Peek returns the element that was at the top of the stack.
+// Returns an error if the stack was empty.
+//
+// Time complexity: O(1)
+func (s *StackSnapshot[T]) Peek() (T, error) {
+	if s.top == nil {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+
+	return s.top.value, nil
+}
+
+// All returns an iterator over the elements from top to bottom, in the
+// order they would have been popped.
+//
+// Time complexity: O(n) for a full iteration
+func (s *StackSnapshot[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.top; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// IsEmpty returns true if the stack was empty.
+//
+// Time complexity: O(1)
+func (s *StackSnapshot[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Size returns the number of elements the stack held. Unlike
+// TreiberStack.Size, it is always exact.
+//
+// Time complexity: O(1)
+func (s *StackSnapshot[T]) Size() int {
+	return s.size
+}
@@ -0,0 +1,528 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"math/rand/v2"
+	"sync/atomic"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Compile-time interface verifications
+var _ Stack[int] = &TreiberStack[int]{}
+var _ collections.Iterable[int] = &TreiberStack[int]{}
+var _ collections.Addable[int] = &TreiberStack[int]{}
+
+// Represents a single immutable node in a Treiber stack.
+// Nodes are never modified once published, so readers need no locks.
+type treiberNode[T any] struct {
+	value T
+	next  *treiberNode[T]
+}
+
+// TreiberStack implements a lock-free LIFO stack using atomic
+// compare-and-swap on a singly-linked list of nodes.
+//
+// Every Push allocates a fresh node and every Pop unlinks the top node,
+// so the garbage collector prevents the ABA problem that plagues manual
+// memory-managed Treiber stacks.
+//
+// Optimization Strategy:
+//
+// EliminationBackoff: Pairs contending Push and Pop operations off the top pointer
+//   - Best for: many goroutines hammering the same stack
+//   - Benefit: Push/Pop pairs complete without touching the hot top pointer
+//   - Tradeoff: Bounded spinning on a failed compare-and-swap
+//
+// All methods are safe for concurrent use by multiple goroutines.
+// Size is exact when the stack is quiescent and approximate while
+// concurrent operations are in progress.
+type TreiberStack[T any] struct {
+	top    atomic.Pointer[treiberNode[T]]   // Current top node (nil when empty)
+	size   atomic.Int64                     // Number of elements linked from top
+	slots  []atomic.Pointer[treiberNode[T]] // Elimination array (nil when disabled)
+	config TreiberStackConfig               // Optimization configuration
+}
+
+// NewTreiberStack creates a lock-free stack with elimination back-off enabled.
+// Elimination only engages after a failed compare-and-swap, so it adds no
+// cost to uncontended workloads.
+//
+// For specific workloads, use NewTreiberStackWith:
+//   - Low contention: WithEliminationBackoff(false)
+//   - Many goroutines: increase WithEliminationSlots
+func NewTreiberStack[T any](values ...T) *TreiberStack[T] {
+	return newTreiberStack(defaultTreiberStackConfig(), values...)
+}
+
+// NewTreiberStackWith creates an empty lock-free stack from the default
+// configuration adjusted by the given options. Options are applied in
+// order.
+//
+// Panics if EliminationBackoff ends up enabled with EliminationSlots or
+// EliminationSpins less than 1.
+//
+// Example:
+//
+//	s := NewTreiberStackWith[int](WithEliminationSlots(16))
+func NewTreiberStackWith[T any](opts ...TreiberStackOption) *TreiberStack[T] {
+	c := defaultTreiberStackConfig()
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return newTreiberStack[T](c)
+}
+
+// NewTreiberStackWithConfig creates a lock-free stack with custom settings.
+// See TreiberStackConfig for configuration options and tuning guidance.
+// The last value provided ends up on top of the stack.
+//
+// Panics if EliminationBackoff is enabled and EliminationSlots or
+// EliminationSpins is less than 1.
+//
+// Deprecated: Use NewTreiberStackWith with WithEliminationBackoff,
+// WithEliminationSlots and WithEliminationSpins, then Push.
+func NewTreiberStackWithConfig[T any](config TreiberStackConfig, values ...T) *TreiberStack[T] {
+	return newTreiberStack(config, values...)
+}
+
+// Creates a stack with the given settings holding the values, the last
+// on top. Panics if the elimination settings are invalid.
+func newTreiberStack[T any](config TreiberStackConfig, values ...T) *TreiberStack[T] {
+	if config.EliminationBackoff {
+		panics.RequireGreaterThan(config.EliminationSlots, 0, "elimination slots")
+		panics.RequireGreaterThan(config.EliminationSpins, 0, "elimination spins")
+	}
+
+	s := &TreiberStack[T]{config: config}
+	if config.EliminationBackoff {
+		s.slots = make([]atomic.Pointer[treiberNode[T]], config.EliminationSlots)
+	}
+
+	var top *treiberNode[T]
+	for _, v := range values {
+		top = &treiberNode[T]{value: v, next: top}
+	}
+
+	s.top.Store(top)
+	s.size.Store(int64(len(values)))
+	return s
+}
+
+// Push adds an element to the top of the stack.
+//
+// Time complexity: O(1) expected, retries under contention
+func (s *TreiberStack[T]) Push(value T) {
+	n := &treiberNode[T]{value: value}
+	for {
+		top := s.top.Load()
+		n.next = top
+		if s.top.CompareAndSwap(top, n) {
+			s.size.Add(1)
+			return
+		}
+
+		if s.config.EliminationBackoff && s.eliminatePush(n) {
+			return
+		}
+	}
+}
+
+// Add pushes the element onto the top of the stack, as Push does.
+// Always returns true.
+//
+// Time complexity: O(1) expected, retries under contention
+func (s *TreiberStack[T]) Add(value T) bool {
+	s.Push(value)
+	return true
+}
+
+// Pop removes and returns the element at the top of the stack.
+// Returns an error if the stack is empty.
+//
+// Time complexity: O(1) expected, retries under contention
+func (s *TreiberStack[T]) Pop() (T, error) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var zero T
+			return zero, ErrEmptyStack
+		}
+
+		if s.top.CompareAndSwap(top, top.next) {
+			s.size.Add(-1)
+			return top.value, nil
+		}
+
+		if s.config.EliminationBackoff {
+			if v, ok := s.eliminatePop(); ok {
+				return v, nil
+			}
+		}
+	}
+}
+
+// Drain removes and returns all elements of the stack in pop order,
+// so the former top element is first. The whole chain is detached with a
+// single atomic swap, so concurrent pushes either land before the swap
+// (and are drained) or after it (and remain on the stack).
+// The result slice is allocated exactly once.
+//
+// Time complexity: O(n)
+func (s *TreiberStack[T]) Drain() []T {
+	top := s.top.Swap(nil)
+
+	count := 0
+	for n := top; n != nil; n = n.next {
+		count++
+	}
+
+	values := make([]T, 0, count)
+	for n := top; n != nil; n = n.next {
+		values = append(values, n.value)
+	}
+
+	s.size.Add(-int64(count))
+	return values
+}
+
+// Clear removes all elements. Like Drain, it detaches the whole chain
+// with a single atomic swap, but discards the elements.
+//
+// Time complexity: O(n) to count the detached chain
+func (s *TreiberStack[T]) Clear() {
+	count := 0
+	for n := s.top.Swap(nil); n != nil; n = n.next {
+		count++
+	}
+
+	s.size.Add(-int64(count))
+}
+
+// Clone returns an independent copy of the stack, including its
+// configuration. Published nodes are immutable, so the copy shares the
+// current chain instead of duplicating it; subsequent pushes and pops
+// on either stack are not visible to the other.
+//
+// The copy is shallow: elements are shared with the original, including
+// pointers and reference types inside them. Use CloneFunc for a deep
+// copy.
+//
+// Time complexity: O(n) to count the shared chain
+func (s *TreiberStack[T]) Clone() *TreiberStack[T] {
+	c := newTreiberStack[T](s.config)
+	top := s.top.Load()
+
+	count := 0
+	for n := top; n != nil; n = n.next {
+		count++
+	}
+
+	c.top.Store(top)
+	c.size.Store(int64(count))
+	return c
+}
+
+// CloneFunc returns a deep copy of the stack: like Clone, but the copy
+// gets a chain of its own whose elements are the results of calling
+// clone on the elements of the original, top to bottom.
+//
+// Time complexity: O(n) calls of clone
+func (s *TreiberStack[T]) CloneFunc(clone func(T) T) *TreiberStack[T] {
+	c := newTreiberStack[T](s.config)
+
+	var head, tail *treiberNode[T]
+	count := 0
+	for n := s.top.Load(); n != nil; n = n.next {
+		node := &treiberNode[T]{value: clone(n.value)}
+		if tail == nil {
+			head = node
+		} else {
+			tail.next = node
+		}
+		tail = node
+		count++
+	}
+
+	c.top.Store(head)
+	c.size.Store(int64(count))
+	return c
+}
+
+// Snapshot returns an immutable view of the stack as it is now. Published
+// nodes are never modified, so the snapshot shares the current chain
+// instead of copying it and keeps only its nodes reachable; pushes and
+// pops on the stack are not visible to it.
+//
+// Time complexity: O(n) to count the shared chain
+//
+// Example:
+//
+//	s := NewTreiberStack(1, 2)
+//	snap := s.Snapshot()
+//	s.Push(3)
+//	snap.Size()  // Returns 2
+func (s *TreiberStack[T]) Snapshot() *StackSnapshot[T] {
+	top := s.top.Load()
+
+	count := 0
+	for n := top; n != nil; n = n.next {
+		count++
+	}
+
+	return &StackSnapshot[T]{top: top, size: count}
+}
+
+// Reverse reverses the order of the elements, so the bottom element
+// becomes the top. Published nodes are immutable, so a reversed copy of
+// the chain is built and installed with a single compare-and-swap; the
+// whole reversal is retried if the stack changed in the meantime.
+//
+// Time complexity: O(n) per attempt
+//
+// Space complexity: O(n) - the reversed chain is newly allocated
+func (s *TreiberStack[T]) Reverse() {
+	for {
+		top := s.top.Load()
+
+		var reversed *treiberNode[T]
+		for n := top; n != nil; n = n.next {
+			reversed = &treiberNode[T]{value: n.value, next: reversed}
+		}
+
+		if s.top.CompareAndSwap(top, reversed) {
+			return
+		}
+	}
+}
+
+// Peek returns the element at the top of the stack without removing it.
+// Returns an error if the stack is empty.
+//
+// Time complexity: O(1)
+func (s *TreiberStack[T]) Peek() (T, error) {
+	top := s.top.Load()
+	if top == nil {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+
+	return top.value, nil
+}
+
+// All returns an iterator over the elements from top to bottom,
+// in the order they would be popped. Iteration walks a snapshot of the
+// stack taken when iteration starts; concurrent pushes and pops do not
+// affect it, since published nodes are never modified.
+//
+// Time complexity: O(n) for a full iteration
+func (s *TreiberStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.top.Load(); n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// BottomUp returns an iterator over the elements from bottom to top,
+// in the order they were pushed. Like All, it walks a snapshot taken
+// when iteration starts.
+//
+// Time complexity: O(n) for a full iteration
+//
+// Space complexity: O(n) - the snapshot is buffered to reverse it
+func (s *TreiberStack[T]) BottomUp() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var nodes []*treiberNode[T]
+		for n := s.top.Load(); n != nil; n = n.next {
+			nodes = append(nodes, n)
+		}
+
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !yield(nodes[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// elements from bottom to top in the format of package codec, the same
+// layout as SliceStack. Like All, it encodes a snapshot of the stack
+// taken when encoding starts.
+// Returns an error wrapping codec.ErrUnsupportedType if T cannot be encoded.
+//
+// Time complexity: O(n)
+func (s *TreiberStack[T]) MarshalBinary() ([]byte, error) {
+	count, values := s.snapshot()
+	return codec.EncodeSeq("TreiberStack", count, values)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// elements of the stack with those encoded by MarshalBinary, keeping the
+// configuration. The data is decoded in full first and the new chain is
+// installed with a single atomic swap, so concurrent operations see
+// either the old elements or the new ones.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded stack, in which case the stack is left unchanged.
+//
+// Time complexity: O(n)
+func (s *TreiberStack[T]) UnmarshalBinary(data []byte) error {
+	values, err := codec.DecodeSlice[T](data, "TreiberStack")
+	if err != nil {
+		return err
+	}
+
+	s.restore(values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+//
+// Space complexity: O(n) - the snapshot is buffered to reverse it
+func (s *TreiberStack[T]) WriteTo(w io.Writer) (int64, error) {
+	count, values := s.snapshot()
+	return codec.WriteSeq(w, "TreiberStack", count, values)
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the elements of the
+// stack with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it, as UnmarshalBinary does.
+// Returns the number of bytes read and the first error. The stack is
+// left unchanged on error.
+//
+// Time complexity: O(n)
+func (s *TreiberStack[T]) ReadFrom(r io.Reader) (int64, error) {
+	values, n, err := codec.ReadSlice[T](r, "TreiberStack")
+	if err != nil {
+		return n, err
+	}
+
+	s.restore(values)
+	return n, nil
+}
+
+// IsEmpty returns true if the stack contains no elements.
+//
+// Time complexity: O(1)
+func (s *TreiberStack[T]) IsEmpty() bool {
+	return s.top.Load() == nil
+}
+
+// Size returns the number of elements currently in the stack.
+//
+// Time complexity: O(1)
+func (s *TreiberStack[T]) Size() int {
+	return int(max(s.size.Load(), 0))
+}
+
+// CheckInvariants verifies the internal consistency of the stack: the
+// number of nodes linked from the top matches the size counter, and no
+// node is left parked in an elimination slot. Intended for tests and
+// debugging; the stack must be quiescent, since pushes and pops in
+// progress update the counter after the top and park nodes meanwhile.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the stack is consistent.
+//
+// Time complexity: O(n + s) where s is the number of elimination slots
+func (s *TreiberStack[T]) CheckInvariants() error {
+	count := 0
+	for n := s.top.Load(); n != nil; n = n.next {
+		count++
+	}
+	if size := s.size.Load(); size != int64(count) {
+		return fmt.Errorf("%w: size is %d, top links %d nodes", ErrInvariantViolation, size, count)
+	}
+
+	for i := range s.slots {
+		if s.slots[i].Load() != nil {
+			return fmt.Errorf("%w: elimination slot %d holds a node", ErrInvariantViolation, i)
+		}
+	}
+
+	return nil
+}
+
+// Returns the number of elements and an iterator over them from bottom
+// to top, both taken from the chain linked from top at the time of the
+// call.
+func (s *TreiberStack[T]) snapshot() (int, iter.Seq[T]) {
+	var nodes []*treiberNode[T]
+	for n := s.top.Load(); n != nil; n = n.next {
+		nodes = append(nodes, n)
+	}
+
+	return len(nodes), func(yield func(T) bool) {
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !yield(nodes[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// Replaces the elements with the decoded ones, the last on top.
+func (s *TreiberStack[T]) restore(values []T) {
+	var top *treiberNode[T]
+	for _, v := range values {
+		top = &treiberNode[T]{value: v, next: top}
+	}
+
+	count := 0
+	for n := s.top.Swap(top); n != nil; n = n.next {
+		count++
+	}
+
+	s.size.Add(int64(len(values) - count))
+}
+
+// Parks the node in a random elimination slot and waits for a Pop to take it.
+// Returns true if a Pop consumed the node, false if the push must be retried.
+func (s *TreiberStack[T]) eliminatePush(n *treiberNode[T]) bool {
+	slot := &s.slots[rand.IntN(len(s.slots))]
+	if !slot.CompareAndSwap(nil, n) {
+		return false // Slot busy, retry on the top pointer
+	}
+
+	for range s.config.EliminationSpins {
+		if slot.Load() != n {
+			return true // A Pop took the node
+		}
+	}
+
+	// Withdraw the offer; failure means a Pop took it in the meantime
+	return !slot.CompareAndSwap(n, nil)
+}
+
+// Takes a node parked by a concurrent Push from a random elimination slot.
+// Returns false if no node could be taken and the pop must be retried.
+func (s *TreiberStack[T]) eliminatePop() (T, bool) {
+	slot := &s.slots[rand.IntN(len(s.slots))]
+	n := slot.Load()
+	if n != nil && slot.CompareAndSwap(n, nil) {
+		return n.value, true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// Returns the configuration used by NewTreiberStack.
+func defaultTreiberStackConfig() TreiberStackConfig {
+	return TreiberStackConfig{
+		EliminationBackoff: true,
+		EliminationSlots:   4,
+		EliminationSpins:   100,
+	}
+}
@@ -0,0 +1,114 @@
+package structures
+
+import (
+	"sync"
+	"testing"
+)
+
+// mutexStack guards a SliceStack with a single mutex.
+// Serves as the lock-based baseline for the lock-free benchmarks.
+type mutexStack[T any] struct {
+	mu    sync.Mutex
+	stack *SliceStack[T]
+}
+
+func (s *mutexStack[T]) Push(value T) {
+	s.mu.Lock()
+	s.stack.Push(value)
+	s.mu.Unlock()
+}
+
+func (s *mutexStack[T]) Pop() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Pop()
+}
+
+// concurrentStack is the subset of stack operations exercised by the benchmarks.
+type concurrentStack interface {
+	Push(value int)
+	Pop() (int, error)
+}
+
+// Benchmark stacks representing different synchronization strategies.
+// Each factory returns a fresh, empty stack safe for concurrent use.
+var concurrentStacks = map[string]func() concurrentStack{
+	// Mutex: SliceStack behind a sync.Mutex.
+	// Expected: Competitive at low parallelism, degrades under contention.
+	"Mutex": func() concurrentStack {
+		return &mutexStack[int]{stack: NewSliceStack[int]()}
+	},
+	// Treiber: Lock-free compare-and-swap without elimination.
+	// Expected: Scales better than Mutex, retries on a single hot pointer.
+	"Treiber": func() concurrentStack {
+		return NewTreiberStackWithConfig[int](TreiberStackConfig{})
+	},
+	// TreiberElimination: Lock-free with elimination back-off.
+	// Expected: Best under heavy balanced push/pop contention.
+	"TreiberElimination": func() concurrentStack {
+		return NewTreiberStack[int]()
+	},
+}
+
+// BenchmarkTreiberStack_PushPopParallel measures throughput with all
+// goroutines performing balanced push/pop pairs on a shared stack.
+//
+// Pattern: [Push, Pop] per iteration on every goroutine
+// Expected winner: TreiberElimination (pairs complete off the top pointer)
+func BenchmarkTreiberStack_PushPopParallel(b *testing.B) {
+	for name, factory := range concurrentStacks {
+		b.Run(name, func(b *testing.B) {
+			s := factory()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					s.Push(i)
+					s.Pop()
+					i++
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkTreiberStack_PushOnlyParallel measures throughput with all
+// goroutines pushing onto a shared stack.
+//
+// Pattern: [Push] per iteration on every goroutine
+// Expected: Treiber variants similar (no pops to eliminate against)
+func BenchmarkTreiberStack_PushOnlyParallel(b *testing.B) {
+	for name, factory := range concurrentStacks {
+		b.Run(name, func(b *testing.B) {
+			s := factory()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					s.Push(i)
+					i++
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkTreiberStack_Sequential measures single-goroutine overhead
+// of synchronization when there is no contention.
+//
+// Pattern: [Push, Pop] × 1000
+// Expected winner: Mutex (uncontended lock is cheap, no node allocation)
+func BenchmarkTreiberStack_Sequential(b *testing.B) {
+	for name, factory := range concurrentStacks {
+		b.Run(name, func(b *testing.B) {
+			s := factory()
+			b.ResetTimer()
+			for b.Loop() {
+				for j := range 1000 {
+					s.Push(j)
+					s.Pop()
+				}
+			}
+		})
+	}
+}
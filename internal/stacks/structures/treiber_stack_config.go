@@ -0,0 +1,75 @@
+package structures
+
+// TreiberStackConfig controls contention handling behavior for TreiberStack.
+//
+// The stack supports one optional optimization strategy:
+//
+// Elimination back-off (contention-time optimization):
+//
+// When a Push or Pop loses a compare-and-swap race on the top pointer,
+// instead of immediately retrying it visits a random slot of a small
+// elimination array. A Push parked in a slot can be taken directly by a
+// concurrent Pop, so the pair completes without touching the top pointer
+// at all. Under high contention this spreads traffic away from the single
+// hot memory location; under low contention it is never exercised.
+type TreiberStackConfig struct {
+	// EliminationBackoff enables the elimination array.
+	//
+	// When enabled, a failed compare-and-swap on the top pointer makes the
+	// operation try to pair up with an opposite operation in the elimination
+	// array before retrying.
+	EliminationBackoff bool
+
+	// EliminationSlots represents the number of slots in the elimination array.
+	//
+	// More slots reduce collisions between operations of the same kind but
+	// lower the chance of a Push meeting a Pop.
+	//
+	// Recommended values:
+	//   2-4:  Few contending goroutines
+	//   8-16: Many contending goroutines (roughly GOMAXPROCS / 2)
+	//
+	// Valid range: [1, ...]
+	EliminationSlots int
+
+	// EliminationSpins represents how many times a parked Push polls its slot
+	// waiting for a Pop before withdrawing and retrying on the top pointer.
+	//
+	// Higher values: More eliminations, longer waits under low contention
+	// Lower values:  Fewer eliminations, faster fallback to the top pointer
+	//
+	// Recommended values:
+	//   50-200: Balanced (default: 100)
+	//
+	// Valid range: [1, ...]
+	EliminationSpins int
+}
+
+// TreiberStackOption configures a TreiberStack created with
+// NewTreiberStackWith. Options are applied in order on top of the default
+// configuration.
+type TreiberStackOption func(*TreiberStackConfig)
+
+// WithEliminationBackoff enables or disables the elimination array.
+// See TreiberStackConfig.EliminationBackoff.
+func WithEliminationBackoff(enabled bool) TreiberStackOption {
+	return func(c *TreiberStackConfig) {
+		c.EliminationBackoff = enabled
+	}
+}
+
+// WithEliminationSlots sets the number of slots in the elimination array.
+// See TreiberStackConfig.EliminationSlots.
+func WithEliminationSlots(slots int) TreiberStackOption {
+	return func(c *TreiberStackConfig) {
+		c.EliminationSlots = slots
+	}
+}
+
+// WithEliminationSpins sets how long a parked Push waits for a Pop.
+// See TreiberStackConfig.EliminationSpins.
+func WithEliminationSpins(spins int) TreiberStackOption {
+	return func(c *TreiberStackConfig) {
+		c.EliminationSpins = spins
+	}
+}
@@ -0,0 +1,796 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewTreiberStack):
+  ✓ Empty stack
+  ✓ Single value
+  ✓ Multiple values
+
+Push:
+  ✓ Single value to empty stack
+  ✓ Single value to non-empty stack
+  ✓ Multiple values to empty stack
+  ✓ Multiple values to non-empty stack
+
+Pop:
+  ✓ Single value from empty stack
+  ✓ Single value from non-empty stack
+  ✓ Multiple values from non-empty stack
+
+Push/Pop:
+  ✓ LIFO order
+  ✓ Reusable after emptying the stack
+
+Peek:
+  ✓ Empty stack
+  ✓ Non-empty stack (single peek)
+  ✓ Non-empty stack (multiple peeks)
+
+IsEmpty/Size:
+  ✓ Empty stack
+  ✓ Non-empty stack
+
+Drain:
+  ✓ Empty stack
+  ✓ Non-empty stack (pop order, stack emptied)
+
+All/BottomUp:
+  ✓ Empty stack
+  ✓ Top-to-bottom order
+  ✓ Bottom-to-top order
+  ✓ Early termination
+  ✓ Stack unchanged after iteration
+
+Configuration:
+  ✓ Invalid elimination slots (panic)
+  ✓ Invalid elimination spins (panic)
+  ✓ Elimination settings ignored when disabled
+
+Concurrency (run with -race):
+  ✓ Concurrent pushes keep every element
+  ✓ Concurrent pops return every element exactly once
+  ✓ Mixed push/pop with and without elimination
+  ✓ Random operations from many goroutines conserve elements
+  ✓ Histories of short rounds are linearizable, with and without
+    elimination
+
+Reverse:
+  ✓ Empty stack
+  ✓ Single element
+  ✓ Multiple elements (order reversed, size unchanged)
+
+Clone:
+  ✓ Same elements and configuration
+  ✓ Copy is independent of the original
+  ✓ CloneFunc copies every element with the given function
+
+Add/Clear:
+  ✓ Add pushes onto the top and always returns true
+  ✓ Clear empties the stack and resets its size
+
+CheckInvariants:
+  ✓ Quiescent stacks, size out of step, node left in a slot
+
+Options (NewTreiberStackWith):
+  ✓ No options yields default configuration
+  ✓ Options applied in order
+  ✓ Invalid options (panic)
+
+Snapshot:
+  ✓ Point-in-time view unaffected by later pushes and pops
+  ✓ Empty stack
+  ✓ Concurrent scans alongside writers see a consistent view
+
+Binary encoding:
+  ✓ Round trip replaces the elements, invalid data rejected
+  ✓ Elements encoded bottom-up like SliceStack
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+*/
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/conctest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty stack
+func TestTreiberStack_NewTreiberStack_Empty(t *testing.T) {
+	s := NewTreiberStack[int]()
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies the creation of one-element stack
+func TestTreiberStack_NewTreiberStack_OneValue(t *testing.T) {
+	s := NewTreiberStack(1)
+	test.GotWant(t, s.Size(), 1)
+	test.GotWant(t, s.IsEmpty(), false)
+}
+
+// Verifies the creation of multi-element stack
+func TestTreiberStack_NewTreiberStack_ManyValues(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	test.GotWant(t, s.Size(), 3)
+	test.GotWant(t, s.IsEmpty(), false)
+}
+
+// Verifies the pushing of an element in an empty stack
+func TestTreiberStack_Push_OneElement_EmptyStack(t *testing.T) {
+	s := NewTreiberStack[int]()
+	s.Push(1)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 1)
+	test.GotWant(t, s.Size(), 1)
+	test.GotWant(t, s.IsEmpty(), false)
+}
+
+// Verifies the pushing of multiple elements in an empty stack
+func TestTreiberStack_Push_ManyElements_EmptyStack(t *testing.T) {
+	s := NewTreiberStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 3)
+	test.GotWant(t, s.Size(), 3)
+	test.GotWant(t, s.IsEmpty(), false)
+}
+
+// Verifies the pushing of an element in a non-empty stack
+func TestTreiberStack_Push_OneElement_NonEmptyStack(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	s.Push(4)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 4)
+	test.GotWant(t, s.Size(), 4)
+	test.GotWant(t, s.IsEmpty(), false)
+}
+
+// Verifies the pushing of multiple elements in a non-empty stack
+func TestTreiberStack_Push_ManyElements_NonEmptyStack(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	s.Push(4)
+	s.Push(5)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 5)
+	test.GotWant(t, s.Size(), 5)
+	test.GotWant(t, s.IsEmpty(), false)
+}
+
+// Verifies the poping an element from an empty stack
+func TestTreiberStack_Pop_OneElement_EmptyStack(t *testing.T) {
+	s := NewTreiberStack[int]()
+	d, err := s.Pop()
+	test.GotWantErrorIs(t, err, ErrEmptyStack)
+	test.GotWant(t, d, 0)
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies the poping an element from a non-empty stack
+func TestTreiberStack_Pop_OneElement_NonEmptyStack(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	d, err := s.Pop()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, d, 3)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 2)
+	test.GotWant(t, s.Size(), 2)
+	test.GotWant(t, s.IsEmpty(), false)
+}
+
+// Verifies the poping multiple elements in a non-empty stack
+func TestTreiberStack_Pop_ManyElements_NonEmptyStack(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	s.Pop()
+	s.Pop()
+	d, err := s.Pop()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, d, 1)
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies Last-In-First-Out element order
+func TestTreiberStack_PushPop_Order(t *testing.T) {
+	s := NewTreiberStack[int]()
+
+	for i := range 5 {
+		s.Push(i + 1)
+		p, _ := s.Peek()
+		test.GotWant(t, p, i+1)
+	}
+
+	for i := range 5 {
+		p, _ := s.Peek()
+		test.GotWant(t, p, 5-i)
+		d, _ := s.Pop()
+		test.GotWant(t, d, 5-i)
+	}
+}
+
+// Verifies the stack is reusable
+func TestTreiberStack_PushPop_Reusability(t *testing.T) {
+	s := NewTreiberStack[int]()
+	s.Push(1)
+	s.Pop()
+	test.GotWant(t, s.IsEmpty(), true)
+	s.Push(2)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 2)
+}
+
+// Verifies peeking into an empty stack
+func TestTreiberStack_Peek_EmptyStack(t *testing.T) {
+	s := NewTreiberStack[int]()
+	p, err := s.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyStack)
+	test.GotWant(t, p, 0)
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies peeking into an non-empty stack
+func TestTreiberStack_Peek_NonEmptyStack(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	p, err := s.Peek()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, p, 3)
+	test.GotWant(t, s.Size(), 3)
+	test.GotWant(t, s.IsEmpty(), false)
+}
+
+// Verifies peeking multiple times into an non-empty stack
+func TestTreiberStack_Peek_Many(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+
+	for range 3 {
+		p, err := s.Peek()
+		test.GotWantNoError(t, err)
+		test.GotWant(t, p, 3)
+	}
+}
+
+// Verifies the empty state of an empty stack
+func TestTreiberStack_IsEmpty_EmptyStack(t *testing.T) {
+	s := NewTreiberStack[int]()
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies the empty state of an non-empty stack
+func TestTreiberStack_IsEmpty_NonEmptyStack(t *testing.T) {
+	s := NewTreiberStack(1)
+	test.GotWant(t, s.IsEmpty(), false)
+}
+
+// Verifies the size of an empty stack
+func TestTreiberStack_Size_EmptyStack(t *testing.T) {
+	s := NewTreiberStack[int]()
+	test.GotWant(t, s.Size(), 0)
+}
+
+// Verifies the size of an non-empty stack
+func TestTreiberStack_Size_NonEmptyStack(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	test.GotWant(t, s.Size(), 3)
+}
+
+// Verifies draining an empty stack
+func TestTreiberStack_Drain_EmptyStack(t *testing.T) {
+	s := NewTreiberStack[int]()
+	test.GotWant(t, len(s.Drain()), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies draining returns elements in pop order and empties the stack
+func TestTreiberStack_Drain_NonEmptyStack(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	v := s.Drain()
+	test.GotWantSlice(t, v, []int{3, 2, 1})
+	test.GotWant(t, cap(v), 3)
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies elimination back-off requires at least one slot
+func TestTreiberStack_NewTreiberStackWithConfig_InvalidSlots(t *testing.T) {
+	c := TreiberStackConfig{EliminationBackoff: true, EliminationSpins: 1}
+	test.GotWantPanic(t, func() {
+		NewTreiberStackWithConfig[int](c)
+	}, `"elimination slots" must be > 0, got 0`)
+}
+
+// Verifies elimination back-off requires at least one spin
+func TestTreiberStack_NewTreiberStackWithConfig_InvalidSpins(t *testing.T) {
+	c := TreiberStackConfig{EliminationBackoff: true, EliminationSlots: 1}
+	test.GotWantPanic(t, func() {
+		NewTreiberStackWithConfig[int](c)
+	}, `"elimination spins" must be > 0, got 0`)
+}
+
+// Verifies elimination settings are not validated when elimination is disabled
+func TestTreiberStack_NewTreiberStackWithConfig_Disabled(t *testing.T) {
+	s := NewTreiberStackWithConfig(TreiberStackConfig{}, 1, 2)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 2)
+	test.GotWant(t, s.Size(), 2)
+}
+
+// Verifies no element is lost when many goroutines push concurrently
+func TestTreiberStack_Push_Concurrent(t *testing.T) {
+	const goroutines, perGoroutine = 8, 1000
+	s := NewTreiberStack[int]()
+
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Go(func() {
+			for i := range perGoroutine {
+				s.Push(g*perGoroutine + i)
+			}
+		})
+	}
+	wg.Wait()
+
+	test.GotWant(t, s.Size(), goroutines*perGoroutine)
+	seen := make(map[int]bool)
+	for !s.IsEmpty() {
+		v, _ := s.Pop()
+		seen[v] = true
+	}
+	test.GotWant(t, len(seen), goroutines*perGoroutine)
+}
+
+// Verifies every element is popped exactly once when many goroutines pop concurrently
+func TestTreiberStack_Pop_Concurrent(t *testing.T) {
+	const goroutines, total = 8, 8000
+	values := make([]int, total)
+	for i := range values {
+		values[i] = i
+	}
+	s := NewTreiberStack(values...)
+
+	var mu sync.Mutex
+	counts := make(map[int]int)
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Go(func() {
+			for {
+				v, err := s.Pop()
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				counts[v]++
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	test.GotWant(t, len(counts), total)
+	for v, c := range counts {
+		if c != 1 {
+			t.Errorf("value %d popped %d times", v, c)
+		}
+	}
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, s.Size(), 0)
+}
+
+// Verifies mixed concurrent pushes and pops conserve elements,
+// both with and without elimination back-off
+func TestTreiberStack_PushPop_Concurrent(t *testing.T) {
+	configs := map[string]TreiberStackConfig{
+		"NoElimination": {},
+		"Elimination": {
+			EliminationBackoff: true,
+			EliminationSlots:   2,
+			EliminationSpins:   50,
+		},
+	}
+
+	for name, config := range configs {
+		t.Run(name, func(t *testing.T) {
+			const goroutines, perGoroutine = 8, 2000
+			s := NewTreiberStackWithConfig[int](config)
+
+			var mu sync.Mutex
+			popped := make(map[int]int)
+			var wg sync.WaitGroup
+			for g := range goroutines {
+				wg.Go(func() {
+					for i := range perGoroutine {
+						s.Push(g*perGoroutine + i)
+						if v, err := s.Pop(); err == nil {
+							mu.Lock()
+							popped[v]++
+							mu.Unlock()
+						}
+					}
+				})
+			}
+			wg.Wait()
+			test.GotWantNoError(t, s.CheckInvariants())
+
+			for !s.IsEmpty() {
+				v, _ := s.Pop()
+				popped[v]++
+			}
+
+			test.GotWant(t, len(popped), goroutines*perGoroutine)
+			for v, c := range popped {
+				if c != 1 {
+					t.Errorf("value %d popped %d times", v, c)
+				}
+			}
+			test.GotWant(t, s.Size(), 0)
+		})
+	}
+}
+
+// Verifies iterating an empty stack yields nothing
+func TestTreiberStack_All_EmptyStack(t *testing.T) {
+	s := NewTreiberStack[int]()
+	for range s.All() {
+		t.Error("unexpected element")
+	}
+	for range s.BottomUp() {
+		t.Error("unexpected element")
+	}
+}
+
+// Verifies All yields elements from top to bottom
+func TestTreiberStack_All_Order(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	got := []int{}
+	for v := range s.All() {
+		got = append(got, v)
+	}
+	test.GotWantSlice(t, got, []int{3, 2, 1})
+}
+
+// Verifies BottomUp yields elements from bottom to top
+func TestTreiberStack_BottomUp_Order(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	got := []int{}
+	for v := range s.BottomUp() {
+		got = append(got, v)
+	}
+	test.GotWantSlice(t, got, []int{1, 2, 3})
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestTreiberStack_All_EarlyTermination(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	got := []int{}
+	for v := range s.All() {
+		got = append(got, v)
+		break
+	}
+	for v := range s.BottomUp() {
+		got = append(got, v)
+		break
+	}
+	test.GotWantSlice(t, got, []int{3, 1})
+}
+
+// Verifies iteration does not modify the stack
+func TestTreiberStack_All_NonDestructive(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	for range s.All() {
+	}
+	for range s.BottomUp() {
+	}
+	test.GotWant(t, s.Size(), 3)
+	p, _ := s.Peek()
+	test.GotWant(t, p, 3)
+}
+
+// Verifies reversing an empty stack
+func TestTreiberStack_Reverse_EmptyStack(t *testing.T) {
+	s := NewTreiberStack[int]()
+	s.Reverse()
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies reversing a one-element stack
+func TestTreiberStack_Reverse_OneElement(t *testing.T) {
+	s := NewTreiberStack(1)
+	s.Reverse()
+	p, _ := s.Peek()
+	test.GotWant(t, p, 1)
+	test.GotWant(t, s.Size(), 1)
+}
+
+// Verifies reversing a multi-element stack
+func TestTreiberStack_Reverse_ManyElements(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3, 4)
+	s.Reverse()
+	test.GotWant(t, s.Size(), 4)
+	for i := range 4 {
+		d, _ := s.Pop()
+		test.GotWant(t, d, i+1)
+	}
+}
+
+// Verifies the clone holds the same elements and configuration
+func TestTreiberStack_Clone_Contents(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	c := s.Clone()
+	test.GotWant(t, c.config, s.config)
+	test.GotWant(t, len(c.slots), len(s.slots))
+	test.GotWant(t, c.Size(), 3)
+	for i := range 3 {
+		d, _ := c.Pop()
+		test.GotWant(t, d, 3-i)
+	}
+}
+
+// Verifies changes to the clone and the original do not affect each other
+func TestTreiberStack_Clone_Independence(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	c := s.Clone()
+	c.Pop()
+	c.Push(9)
+	s.Push(4)
+
+	test.GotWant(t, s.Size(), 4)
+	test.GotWant(t, c.Size(), 3)
+	sp, _ := s.Peek()
+	cp, _ := c.Peek()
+	test.GotWant(t, sp, 4)
+	test.GotWant(t, cp, 9)
+}
+
+// Verifies CloneFunc builds a chain of its own from copied elements,
+// keeping the order and sharing no backing arrays with the original
+func TestTreiberStack_CloneFunc(t *testing.T) {
+	s := NewTreiberStack([]int{1}, []int{2}, []int{3})
+	c := s.CloneFunc(slices.Clone[[]int])
+	test.GotWant(t, c.Size(), 3)
+	test.GotWant(t, c.top.Load() != s.top.Load(), true)
+
+	top, _ := c.Peek()
+	top[0] = 9
+	orig, _ := s.Peek()
+	test.GotWant(t, orig[0], 3)
+	for _, want := range []int{9, 2, 1} {
+		d, _ := c.Pop()
+		test.GotWant(t, d[0], want)
+	}
+}
+
+// Verifies random operations from many goroutines neither lose nor
+// duplicate elements, with elimination back-off enabled
+func TestTreiberStack_Hammer(t *testing.T) {
+	s := NewTreiberStack[int]()
+	var pushed, popped atomic.Int64
+	conctest.Hammer(t, conctest.Config{Seed: 1, Goroutines: 8, Ops: 2000}, s,
+		conctest.Op[*TreiberStack[int]]{Name: "Push", Weight: 2, Apply: func(t *testing.T, r *rand.Rand, s *TreiberStack[int]) {
+			s.Push(r.IntN(100))
+			pushed.Add(1)
+		}},
+		conctest.Op[*TreiberStack[int]]{Name: "Pop", Weight: 2, Apply: func(t *testing.T, r *rand.Rand, s *TreiberStack[int]) {
+			if _, err := s.Pop(); err == nil {
+				popped.Add(1)
+			}
+		}},
+		conctest.Op[*TreiberStack[int]]{Name: "Peek", Apply: func(t *testing.T, r *rand.Rand, s *TreiberStack[int]) {
+			s.Peek()
+		}},
+		conctest.Op[*TreiberStack[int]]{Name: "Size", Apply: func(t *testing.T, r *rand.Rand, s *TreiberStack[int]) {
+			if n := s.Size(); n < 0 {
+				t.Errorf("Size() = %d", n)
+			}
+		}},
+	)
+
+	test.GotWantNoError(t, s.CheckInvariants())
+	test.GotWant(t, len(s.Drain()), int(pushed.Load()-popped.Load()))
+}
+
+// Verifies every history of short concurrent rounds can be explained by
+// some sequential order of the calls, with and without elimination
+func TestTreiberStack_Linearizable(t *testing.T) {
+	configs := map[string]TreiberStackConfig{
+		"NoElimination": {},
+		"Elimination": {
+			EliminationBackoff: true,
+			EliminationSlots:   1,
+			EliminationSpins:   50,
+		},
+	}
+
+	for name, config := range configs {
+		t.Run(name, func(t *testing.T) {
+			conctest.CheckLinearizable(t, conctest.Config{Seed: 1, Runs: 300, Goroutines: 4, Ops: 3},
+				func() (*TreiberStack[int], []int) { return NewTreiberStackWithConfig[int](config), nil },
+				conctest.LinOp[*TreiberStack[int], []int]{Name: "Push", Call: func(r *rand.Rand, s *TreiberStack[int]) (string, func([]int) ([]int, bool)) {
+					v := r.IntN(10)
+					s.Push(v)
+					return fmt.Sprintf("Push(%d)", v), func(model []int) ([]int, bool) {
+						return append(slices.Clip(model), v), true
+					}
+				}},
+				conctest.LinOp[*TreiberStack[int], []int]{Name: "Pop", Call: func(r *rand.Rand, s *TreiberStack[int]) (string, func([]int) ([]int, bool)) {
+					v, err := s.Pop()
+					return fmt.Sprintf("Pop() = %d, %v", v, err), func(model []int) ([]int, bool) {
+						if len(model) == 0 {
+							return model, err != nil
+						}
+						return model[:len(model)-1], err == nil && v == model[len(model)-1]
+					}
+				}},
+				conctest.LinOp[*TreiberStack[int], []int]{Name: "Peek", Call: func(r *rand.Rand, s *TreiberStack[int]) (string, func([]int) ([]int, bool)) {
+					v, err := s.Peek()
+					return fmt.Sprintf("Peek() = %d, %v", v, err), func(model []int) ([]int, bool) {
+						if len(model) == 0 {
+							return model, err != nil
+						}
+						return model, err == nil && v == model[len(model)-1]
+					}
+				}},
+			)
+		})
+	}
+}
+
+// Verifies Add pushes onto the top of the stack
+func TestTreiberStack_Add(t *testing.T) {
+	s := NewTreiberStack(1)
+	test.GotWant(t, s.Add(2), true)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{2, 1})
+}
+
+// Verifies Clear empties the stack, resets its size and leaves it usable
+func TestTreiberStack_Clear(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	s.Clear()
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, s.Size(), 0)
+
+	s.Add(4)
+	test.GotWant(t, s.Size(), 1)
+	test.GotWantSlice(t, slices.Collect(s.All()), []int{4})
+}
+
+// Verifies CheckInvariants accepts quiescent stacks and reports a size
+// counter out of step with the nodes and a node left in an elimination
+// slot
+func TestTreiberStack_CheckInvariants(t *testing.T) {
+	s := NewTreiberStack[int]()
+	test.GotWantNoError(t, s.CheckInvariants())
+	s.Push(1)
+	s.Push(2)
+	test.GotWantNoError(t, s.CheckInvariants())
+
+	s.size.Add(1)
+	test.GotWantError(t, s.CheckInvariants(), "invariant violation: size is 3, top links 2 nodes")
+	test.GotWantErrorIs(t, s.CheckInvariants(), ErrInvariantViolation)
+	s.size.Add(-1)
+
+	s.slots[1].Store(&treiberNode[int]{value: 3})
+	test.GotWantError(t, s.CheckInvariants(), "invariant violation: elimination slot 1 holds a node")
+	s.slots[1].Store(nil)
+	test.GotWantNoError(t, s.CheckInvariants())
+}
+
+// Verifies no options yields the default configuration
+func TestTreiberStack_NewTreiberStackWith_Defaults(t *testing.T) {
+	s := NewTreiberStackWith[int]()
+	test.GotWant(t, s.config, NewTreiberStack[int]().config)
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies options are applied in order on top of the defaults
+func TestTreiberStack_NewTreiberStackWith_Options(t *testing.T) {
+	s := NewTreiberStackWith[int](
+		WithEliminationSlots(8),
+		WithEliminationSpins(50),
+		WithEliminationSlots(16),
+	)
+	test.GotWant(t, s.config, TreiberStackConfig{
+		EliminationBackoff: true,
+		EliminationSlots:   16,
+		EliminationSpins:   50,
+	})
+	test.GotWant(t, len(s.slots), 16)
+
+	s = NewTreiberStackWith[int](WithEliminationBackoff(false))
+	test.GotWant(t, s.slots == nil, true)
+}
+
+// Verifies invalid options panic at construction
+func TestTreiberStack_NewTreiberStackWith_InvalidOptions(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewTreiberStackWith[int](WithEliminationSlots(0))
+	}, `"elimination slots" must be > 0, got 0`)
+}
+
+// Verifies a snapshot keeps its elements while the stack changes
+func TestTreiberStack_Snapshot(t *testing.T) {
+	s := NewTreiberStack(1, 2, 3)
+	snap := s.Snapshot()
+	s.Push(4)
+	s.Pop()
+	s.Pop()
+	s.Clear()
+
+	test.GotWant(t, snap.Size(), 3)
+	test.GotWant(t, snap.IsEmpty(), false)
+	v, err := snap.Peek()
+	test.GotWantNoError(t, err)
+	test.GotWant(t, v, 3)
+	test.GotWantSlice(t, slices.Collect(snap.All()), []int{3, 2, 1})
+	test.GotWantSlice(t, slices.Collect(snap.All()), []int{3, 2, 1})
+}
+
+// Verifies a snapshot of an empty stack
+func TestTreiberStack_Snapshot_Empty(t *testing.T) {
+	snap := NewTreiberStack[int]().Snapshot()
+	test.GotWant(t, snap.Size(), 0)
+	test.GotWant(t, snap.IsEmpty(), true)
+	_, err := snap.Peek()
+	test.GotWantErrorIs(t, err, ErrEmptyStack)
+}
+
+// Verifies snapshots taken alongside writers always hold a contiguous run
+// of the pushed values
+func TestTreiberStack_Snapshot_Concurrent(t *testing.T) {
+	s := NewTreiberStack[int]()
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		for i := range 10000 {
+			s.Push(i)
+			if i%3 == 0 {
+				s.Pop()
+			}
+		}
+	})
+
+	for range 100 {
+		snap := s.Snapshot()
+		values := slices.Collect(snap.All())
+		test.GotWant(t, len(values), snap.Size())
+		for i := 1; i < len(values); i++ {
+			if values[i] >= values[i-1] {
+				t.Fatalf("snapshot not in push order: %d after %d", values[i], values[i-1])
+			}
+		}
+	}
+
+	wg.Wait()
+}
+
+// Verifies a round trip replaces the elements, invalid data is rejected
+// and the elements are encoded bottom-up like those of a SliceStack
+func TestTreiberStack_MarshalBinary(t *testing.T) {
+	other, _ := NewSliceStack(1).MarshalBinary()
+	dst := NewTreiberStack(9)
+	codectest.RoundTrip(t, NewTreiberStack(1, 2, 3), dst,
+		func(s *TreiberStack[int]) []int { return slices.Collect(s.All()) }, other)
+	test.GotWant(t, dst.Size(), 3)
+
+	data, _ := dst.MarshalBinary()
+	values, err := codec.DecodeSlice[int](data, "TreiberStack")
+	test.GotWantNoError(t, err)
+	test.GotWantSlice(t, values, []int{1, 2, 3})
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the elements from top to bottom
+func TestTreiberStack_WriteTo_ReadFrom(t *testing.T) {
+	src := NewTreiberStack("a", "b")
+	dst := NewTreiberStack[string]()
+	codectest.StreamRoundTrip(t, src, dst,
+		func(s *TreiberStack[string]) []string { return slices.Collect(s.All()) })
+}
@@ -0,0 +1,177 @@
+package structures
+
+import (
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// TwoStacks implements a pair of LIFO stacks sharing one fixed-size slice.
+//
+// The left stack grows from the front of the slice towards the back and
+// the right stack grows from the back towards the front. Either stack may
+// use any capacity the other leaves free, so the pair only runs out of
+// space when the combined size reaches the capacity. This makes it a
+// memory-efficient fit for paired buffers such as undo/redo histories.
+//
+// Layout:
+//
+//	[L0, L1, L2, _, _, _, R1, R0]
+//	         ^left      ^right
+//
+// Design decisions:
+//   - Fixed capacity: Pushes fail with ErrFullStack instead of growing
+//   - Popped slots are zeroed so the garbage collector can reclaim them
+//
+// Space complexity: O(c) where c is the capacity.
+type TwoStacks[T any] struct {
+	left  int // Exclusive index of the left stack top
+	right int // Inclusive index of the right stack top
+	data  []T // Shared underlying slice storage
+}
+
+// NewTwoStacks creates an empty pair of stacks sharing the given capacity.
+//
+// Panics if capacity is negative.
+//
+// Time complexity: O(c) where c is the capacity
+//
+// Example:
+//
+//	s := NewTwoStacks[string](100)
+//	s.PushLeft("edit")   // Undo history
+//	s.PushRight("edit")  // Redo history
+func NewTwoStacks[T any](capacity int) *TwoStacks[T] {
+	panics.RequireNonNegative(capacity, "capacity")
+	return &TwoStacks[T]{
+		left:  0,
+		right: capacity,
+		data:  make([]T, capacity),
+	}
+}
+
+// PushLeft adds an element to the top of the left stack.
+// Returns ErrFullStack if the stacks have met.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) PushLeft(value T) error {
+	if s.IsFull() {
+		return ErrFullStack
+	}
+
+	s.data[s.left] = value
+	s.left++
+	return nil
+}
+
+// PushRight adds an element to the top of the right stack.
+// Returns ErrFullStack if the stacks have met.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) PushRight(value T) error {
+	if s.IsFull() {
+		return ErrFullStack
+	}
+
+	s.right--
+	s.data[s.right] = value
+	return nil
+}
+
+// PopLeft removes and returns the element at the top of the left stack.
+// Returns ErrEmptyStack if the left stack is empty.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) PopLeft() (T, error) {
+	var zero T
+	if s.left == 0 {
+		return zero, ErrEmptyStack
+	}
+
+	s.left--
+	v := s.data[s.left]
+	s.data[s.left] = zero // Help GC
+	return v, nil
+}
+
+// PopRight removes and returns the element at the top of the right stack.
+// Returns ErrEmptyStack if the right stack is empty.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) PopRight() (T, error) {
+	var zero T
+	if s.right == len(s.data) {
+		return zero, ErrEmptyStack
+	}
+
+	v := s.data[s.right]
+	s.data[s.right] = zero // Help GC
+	s.right++
+	return v, nil
+}
+
+// PeekLeft returns the element at the top of the left stack without
+// removing it. Returns ErrEmptyStack if the left stack is empty.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) PeekLeft() (T, error) {
+	if s.left == 0 {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+
+	return s.data[s.left-1], nil
+}
+
+// PeekRight returns the element at the top of the right stack without
+// removing it. Returns ErrEmptyStack if the right stack is empty.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) PeekRight() (T, error) {
+	if s.right == len(s.data) {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+
+	return s.data[s.right], nil
+}
+
+// SizeLeft returns the number of elements in the left stack.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) SizeLeft() int {
+	return s.left
+}
+
+// SizeRight returns the number of elements in the right stack.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) SizeRight() int {
+	return len(s.data) - s.right
+}
+
+// Size returns the combined number of elements in both stacks.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) Size() int {
+	return s.SizeLeft() + s.SizeRight()
+}
+
+// IsEmpty returns true if both stacks contain no elements.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// IsFull returns true if the stacks have met and no further push can succeed.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) IsFull() bool {
+	return s.left == s.right
+}
+
+// Capacity returns the combined number of elements both stacks can hold.
+//
+// Time complexity: O(1)
+func (s *TwoStacks[T]) Capacity() int {
+	return len(s.data)
+}
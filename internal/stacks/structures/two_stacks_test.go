@@ -0,0 +1,186 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewTwoStacks):
+  ✓ Zero capacity
+  ✓ Negative capacity (panic)
+  ✓ Positive capacity
+
+PushLeft/PushRight:
+  ✓ Push to empty stacks
+  ✓ Push until the stacks meet (error)
+  ✓ One stack uses the space left by the other
+
+PopLeft/PopRight:
+  ✓ Pop from empty stacks (error)
+  ✓ LIFO order per stack
+  ✓ Stacks are independent
+  ✓ Space freed by a pop is reusable
+
+PeekLeft/PeekRight:
+  ✓ Empty stacks (error)
+  ✓ Non-empty stacks
+
+Size/IsEmpty/IsFull/Capacity:
+  ✓ Reflect both stacks
+*/
+
+import (
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of zero-capacity stacks
+func TestTwoStacks_NewTwoStacks_ZeroCapacity(t *testing.T) {
+	s := NewTwoStacks[int](0)
+	test.GotWant(t, s.Capacity(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, s.IsFull(), true)
+	test.GotWantErrorIs(t, s.PushLeft(1), ErrFullStack)
+	test.GotWantErrorIs(t, s.PushRight(1), ErrFullStack)
+}
+
+// Verifies negative capacity is rejected
+func TestTwoStacks_NewTwoStacks_NegativeCapacity(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewTwoStacks[int](-1)
+	}, `"capacity" must be >= 0, got -1`)
+}
+
+// Verifies the creation of stacks with positive capacity
+func TestTwoStacks_NewTwoStacks_PositiveCapacity(t *testing.T) {
+	s := NewTwoStacks[int](4)
+	test.GotWant(t, s.Capacity(), 4)
+	test.GotWant(t, s.Size(), 0)
+	test.GotWant(t, s.IsEmpty(), true)
+	test.GotWant(t, s.IsFull(), false)
+}
+
+// Verifies pushing to both empty stacks
+func TestTwoStacks_Push_EmptyStacks(t *testing.T) {
+	s := NewTwoStacks[int](4)
+	test.GotWant(t, s.PushLeft(1), nil)
+	test.GotWant(t, s.PushRight(2), nil)
+	l, _ := s.PeekLeft()
+	r, _ := s.PeekRight()
+	test.GotWant(t, l, 1)
+	test.GotWant(t, r, 2)
+	test.GotWant(t, s.SizeLeft(), 1)
+	test.GotWant(t, s.SizeRight(), 1)
+}
+
+// Verifies pushes fail once the stacks meet
+func TestTwoStacks_Push_Full(t *testing.T) {
+	s := NewTwoStacks[int](3)
+	s.PushLeft(1)
+	s.PushRight(2)
+	s.PushLeft(3)
+	test.GotWant(t, s.IsFull(), true)
+	test.GotWantErrorIs(t, s.PushLeft(4), ErrFullStack)
+	test.GotWantErrorIs(t, s.PushRight(4), ErrFullStack)
+	test.GotWant(t, s.Size(), 3)
+}
+
+// Verifies one stack can use all the space the other leaves free
+func TestTwoStacks_Push_SharedSpace(t *testing.T) {
+	s := NewTwoStacks[int](4)
+	for i := range 4 {
+		test.GotWant(t, s.PushRight(i), nil)
+	}
+	test.GotWant(t, s.SizeRight(), 4)
+	test.GotWantErrorIs(t, s.PushLeft(5), ErrFullStack)
+}
+
+// Verifies popping from empty stacks
+func TestTwoStacks_Pop_EmptyStacks(t *testing.T) {
+	s := NewTwoStacks[int](2)
+	l, lErr := s.PopLeft()
+	test.GotWantErrorIs(t, lErr, ErrEmptyStack)
+	test.GotWant(t, l, 0)
+	r, rErr := s.PopRight()
+	test.GotWantErrorIs(t, rErr, ErrEmptyStack)
+	test.GotWant(t, r, 0)
+}
+
+// Verifies Last-In-First-Out element order on each stack
+func TestTwoStacks_PushPop_Order(t *testing.T) {
+	s := NewTwoStacks[int](10)
+	for i := range 5 {
+		s.PushLeft(i)
+		s.PushRight(10 + i)
+	}
+
+	for i := range 5 {
+		l, _ := s.PopLeft()
+		test.GotWant(t, l, 4-i)
+		r, _ := s.PopRight()
+		test.GotWant(t, r, 14-i)
+	}
+	test.GotWant(t, s.IsEmpty(), true)
+}
+
+// Verifies operations on one stack do not affect the other
+func TestTwoStacks_PushPop_Independence(t *testing.T) {
+	s := NewTwoStacks[int](4)
+	s.PushLeft(1)
+	s.PushRight(2)
+	s.PopLeft()
+	test.GotWant(t, s.SizeLeft(), 0)
+	test.GotWant(t, s.SizeRight(), 1)
+	r, _ := s.PeekRight()
+	test.GotWant(t, r, 2)
+}
+
+// Verifies space freed by a pop can be reused by the other stack
+func TestTwoStacks_PushPop_Reusability(t *testing.T) {
+	s := NewTwoStacks[int](2)
+	s.PushLeft(1)
+	s.PushLeft(2)
+	s.PopLeft()
+	test.GotWant(t, s.PushRight(3), nil)
+	test.GotWant(t, s.IsFull(), true)
+	r, _ := s.PopRight()
+	test.GotWant(t, r, 3)
+	l, _ := s.PopLeft()
+	test.GotWant(t, l, 1)
+}
+
+// Verifies peeking into empty stacks
+func TestTwoStacks_Peek_EmptyStacks(t *testing.T) {
+	s := NewTwoStacks[int](2)
+	_, lErr := s.PeekLeft()
+	test.GotWantErrorIs(t, lErr, ErrEmptyStack)
+	_, rErr := s.PeekRight()
+	test.GotWantErrorIs(t, rErr, ErrEmptyStack)
+}
+
+// Verifies peeking does not remove elements
+func TestTwoStacks_Peek_NonEmptyStacks(t *testing.T) {
+	s := NewTwoStacks[int](4)
+	s.PushLeft(1)
+	s.PushRight(2)
+	for range 3 {
+		l, _ := s.PeekLeft()
+		r, _ := s.PeekRight()
+		test.GotWant(t, l, 1)
+		test.GotWant(t, r, 2)
+	}
+	test.GotWant(t, s.Size(), 2)
+}
+
+// Verifies the size and state queries reflect both stacks
+func TestTwoStacks_Size(t *testing.T) {
+	s := NewTwoStacks[int](5)
+	s.PushLeft(1)
+	s.PushLeft(2)
+	s.PushRight(3)
+	test.GotWant(t, s.SizeLeft(), 2)
+	test.GotWant(t, s.SizeRight(), 1)
+	test.GotWant(t, s.Size(), 3)
+	test.GotWant(t, s.IsEmpty(), false)
+	test.GotWant(t, s.IsFull(), false)
+	test.GotWant(t, s.Capacity(), 5)
+}
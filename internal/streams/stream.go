@@ -0,0 +1,231 @@
+// Package streams provides lazily evaluated pipelines over the elements
+// of any structure.
+//
+// A Stream describes a chain of operations such as Filter, Map and Take
+// without running it. Nothing is read from the source until a terminal
+// operation such as Collect, Count or First pulls elements through the
+// chain, one at a time: no intermediate collection is built between the
+// steps, and the pipeline stops reading as soon as the result is known.
+//
+// Example:
+//
+//	l := structures.NewDoublyLinkedList(1, 2, 3, 4, 5, 6)
+//	evens := streams.From[int](l).Filter(func(v int) bool { return v%2 == 0 })
+//	squares := streams.Map(evens, func(v int) int { return v * v })
+//	squares.Take(2).Collect()  // Returns [4, 16], reading only 1 to 4
+//
+// Operations that change the element type, Map and Reduce, are functions
+// rather than methods, as Go methods cannot have type parameters.
+package streams
+
+import (
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ collections.Iterable[int] = Stream[int]{}
+
+// Stream is a lazy sequence of elements with chainable operations.
+//
+// Streams are values: every operation returns a new stream and leaves
+// its receiver unchanged, so a partial pipeline can be shared and
+// extended in several ways. A stream reads its source again each time a
+// terminal operation runs, so it sees the source as it is then.
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+// From creates a stream over the elements of any iterable structure, in
+// the order its All method yields them.
+func From[T any](src collections.Iterable[T]) Stream[T] {
+	return Stream[T]{seq: src.All()}
+}
+
+// Of creates a stream over a sequence, such as slices.Values(s) or the
+// Keys of a map.
+func Of[T any](seq iter.Seq[T]) Stream[T] {
+	return Stream[T]{seq: seq}
+}
+
+// Filter returns a stream of the elements for which pred returns true.
+func (s Stream[T]) Filter(pred func(T) bool) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Map returns a stream of the results of f applied to each element.
+func Map[T any, U any](s Stream[T], f func(T) U) Stream[U] {
+	return Stream[U]{seq: func(yield func(U) bool) {
+		for v := range s.seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}}
+}
+
+// Take returns a stream of at most the first n elements. The source is
+// not read past them; a non-positive n yields nothing and reads nothing.
+func (s Stream[T]) Take(n int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		for v := range s.seq {
+			if !yield(v) {
+				return
+			}
+
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}}
+}
+
+// Skip returns a stream without the first n elements.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		skipped := 0
+		for v := range s.seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// TakeWhile returns a stream of the leading elements for which pred
+// returns true, ending at the first element for which it does not.
+func (s Stream[T]) TakeWhile(pred func(T) bool) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			if !pred(v) || !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Peek returns a stream that calls f on each element as it passes, for
+// logging or debugging a pipeline.
+func (s Stream[T]) Peek(f func(T)) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			f(v)
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// All returns the stream as an iterator, so it can be ranged over or
+// passed wherever a collections.Iterable is accepted.
+func (s Stream[T]) All() iter.Seq[T] {
+	return s.seq
+}
+
+// Collect runs the pipeline and returns the elements in a slice.
+//
+// Time complexity: O(n) where n is the number of elements read
+func (s Stream[T]) Collect() []T {
+	var values []T
+	for v := range s.seq {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// CollectInto runs the pipeline and adds the elements to dst.
+// Returns the number of elements dst accepted.
+//
+// Time complexity: O(n) Add operations
+func (s Stream[T]) CollectInto(dst collections.Addable[T]) int {
+	return collections.AddAll(dst, s.seq)
+}
+
+// ForEach runs the pipeline and calls f on every element.
+func (s Stream[T]) ForEach(f func(T)) {
+	for v := range s.seq {
+		f(v)
+	}
+}
+
+// Count runs the pipeline and returns the number of elements.
+func (s Stream[T]) Count() int {
+	n := 0
+	for range s.seq {
+		n++
+	}
+
+	return n
+}
+
+// First runs the pipeline until its first element and returns it, and
+// false if the stream is empty.
+func (s Stream[T]) First() (T, bool) {
+	for v := range s.seq {
+		return v, true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// AnyMatch returns true if pred returns true for some element, reading
+// no further than that element.
+func (s Stream[T]) AnyMatch(pred func(T) bool) bool {
+	for v := range s.seq {
+		if pred(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllMatch returns true if pred returns true for every element, reading
+// no further than the first element for which it does not. An empty
+// stream matches.
+func (s Stream[T]) AllMatch(pred func(T) bool) bool {
+	for v := range s.seq {
+		if !pred(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Reduce runs the pipeline and folds the elements into an accumulator,
+// starting from init.
+//
+// Example:
+//
+//	words := streams.Of(slices.Values([]string{"go", "data"}))
+//	streams.Reduce(words, 0, func(n int, w string) int { return n + len(w) })  // Returns 6
+func Reduce[T any, A any](s Stream[T], init A, f func(acc A, v T) A) A {
+	acc := init
+	for v := range s.seq {
+		acc = f(acc, v)
+	}
+
+	return acc
+}
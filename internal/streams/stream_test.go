@@ -0,0 +1,154 @@
+package streams
+
+/*
+Test Coverage
+=============
+From/Of:
+  ✓ Elements of a structure in iteration order
+  ✓ Source read again by every terminal operation
+
+Filter/Map/Take/Skip/TakeWhile/Peek:
+  ✓ Chained pipeline
+  ✓ Nothing read before a terminal operation
+  ✓ Take reads no further than needed, non-positive n reads nothing
+  ✓ Skip past the end, TakeWhile stops at the first mismatch
+
+Collect/CollectInto/ForEach/Count/First/AnyMatch/AllMatch/Reduce:
+  ✓ Results on empty and non-empty streams
+  ✓ First, AnyMatch and AllMatch short-circuit
+  ✓ Stream usable as an Iterable
+*/
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	lists "github.com/apotourlyan/godatastructures/internal/lists/structures"
+	sets "github.com/apotourlyan/godatastructures/internal/sets/structures"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns a stream over 1 to n that counts the elements it yields
+func counted(n int, read *int) Stream[int] {
+	return Of(func(yield func(int) bool) {
+		for i := 1; i <= n; i++ {
+			*read++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+}
+
+// Verifies a stream yields the elements of a structure and reads it again
+// on every terminal operation
+func TestFrom(t *testing.T) {
+	l := lists.NewDoublyLinkedList(1, 2, 3)
+	s := From[int](l)
+	test.GotWantSlice(t, s.Collect(), []int{1, 2, 3})
+
+	l.PushBack(4)
+	test.GotWant(t, s.Count(), 4)
+}
+
+// Verifies a chained pipeline and that nothing is read before a terminal
+// operation
+func TestPipeline(t *testing.T) {
+	read := 0
+	evens := counted(100, &read).Filter(func(v int) bool { return v%2 == 0 })
+	labels := Map(evens, func(v int) string { return "#" + strconv.Itoa(v) })
+	s := labels.Skip(1).Take(3)
+	test.GotWant(t, read, 0)
+
+	test.GotWantSlice(t, s.Collect(), []string{"#4", "#6", "#8"})
+	test.GotWant(t, read, 8)
+}
+
+// Verifies Take reads no further than needed
+func TestTake(t *testing.T) {
+	read := 0
+	test.GotWantSlice(t, counted(10, &read).Take(3).Collect(), []int{1, 2, 3})
+	test.GotWant(t, read, 3)
+
+	read = 0
+	test.GotWant(t, counted(10, &read).Take(0).Count(), 0)
+	test.GotWant(t, counted(10, &read).Take(-1).Count(), 0)
+	test.GotWant(t, read, 0)
+
+	test.GotWantSlice(t, counted(2, &read).Take(5).Collect(), []int{1, 2})
+}
+
+// Verifies Skip past the end and TakeWhile stopping at the first mismatch
+func TestSkip_TakeWhile(t *testing.T) {
+	read := 0
+	test.GotWant(t, counted(3, &read).Skip(5).Count(), 0)
+	test.GotWantSlice(t, counted(5, &read).Skip(0).Collect(), []int{1, 2, 3, 4, 5})
+
+	read = 0
+	small := func(v int) bool { return v < 3 }
+	test.GotWantSlice(t, counted(10, &read).TakeWhile(small).Collect(), []int{1, 2})
+	test.GotWant(t, read, 3)
+}
+
+// Verifies Peek sees each element that passes through
+func TestPeek(t *testing.T) {
+	var seen []int
+	read := 0
+	counted(10, &read).Peek(func(v int) { seen = append(seen, v) }).Take(2).ForEach(func(int) {})
+	test.GotWantSlice(t, seen, []int{1, 2})
+}
+
+// Verifies terminal operations on empty and non-empty streams
+func TestTerminals(t *testing.T) {
+	empty := Of(slices.Values([]int{}))
+	test.GotWant(t, len(empty.Collect()), 0)
+	test.GotWant(t, empty.Count(), 0)
+	_, ok := empty.First()
+	test.GotWant(t, ok, false)
+	test.GotWant(t, empty.AnyMatch(func(int) bool { return true }), false)
+	test.GotWant(t, empty.AllMatch(func(int) bool { return false }), true)
+	test.GotWant(t, Reduce(empty, 7, func(a, v int) int { return a + v }), 7)
+
+	s := Of(slices.Values([]int{3, 1, 3, 2}))
+	set := sets.NewHashSet[int]()
+	test.GotWant(t, s.CollectInto(set), 3)
+	test.GotWant(t, set.Size(), 3)
+	test.GotWant(t, Reduce(s, "", func(a string, v int) string { return a + strconv.Itoa(v) }), "3132")
+
+	sum := 0
+	s.ForEach(func(v int) { sum += v })
+	test.GotWant(t, sum, 9)
+}
+
+// Verifies First, AnyMatch and AllMatch stop reading once the result is
+// known
+func TestShortCircuit(t *testing.T) {
+	read := 0
+	v, ok := counted(10, &read).First()
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 1)
+	test.GotWant(t, read, 1)
+
+	read = 0
+	test.GotWant(t, counted(10, &read).AnyMatch(func(v int) bool { return v == 4 }), true)
+	test.GotWant(t, read, 4)
+
+	read = 0
+	test.GotWant(t, counted(10, &read).AllMatch(func(v int) bool { return v < 3 }), false)
+	test.GotWant(t, read, 3)
+}
+
+// Verifies a stream can be passed where an Iterable is accepted
+func TestStream_Iterable(t *testing.T) {
+	s := Of(slices.Values([]int{4, 1, 3}))
+	m, _ := collections.Max[int](s)
+	test.GotWant(t, m, 4)
+
+	var got []int
+	for v := range s.All() {
+		got = append(got, v)
+	}
+	test.GotWantSlice(t, got, []int{4, 1, 3})
+}
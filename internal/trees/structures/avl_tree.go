@@ -0,0 +1,934 @@
+package structures
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/debug"
+	"github.com/apotourlyan/godatastructures/internal/memory"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &AVLTree[int, int]{}
+var _ collections.Iterable2[int, int] = &AVLTree[int, int]{}
+var _ collections.Collection[int] = &AVLTree[int, int]{}
+
+// Represents a single node in an AVL tree.
+// Height is the number of nodes on the longest path down to a leaf;
+// count is the number of nodes in the subtree rooted here.
+type avlNode[K cmp.Ordered, V any] struct {
+	key    K
+	value  V
+	left   *avlNode[K, V]
+	right  *avlNode[K, V]
+	height int
+	count  int
+}
+
+// AVLTree implements a height-balanced binary search tree mapping
+// ordered keys to values.
+//
+// The heights of the two subtrees of every node differ by at most one,
+// so the tree height never exceeds ~1.44 log2(n) and every operation has
+// a worst-case logarithmic bound. Unlike the splay tree, reads never
+// restructure the tree.
+//
+// Design decisions:
+//   - Height per node: Rebalancing uses the stored heights of the children
+//   - Recursive insert/delete: The bounded height keeps recursion shallow
+//   - Neighbor queries: Floor, Ceiling, Lower and Higher in a single descent
+//   - Subtree counts: Every node knows its subtree size, which turns the
+//     tree into an order-statistic tree with O(log n) Select and Rank
+//   - Size counter: Enables O(1) Size and IsEmpty operations
+//   - Optional arena: Nodes can come from slabs (see AVLTreeConfig)
+//   - Optional free list: Deleted nodes can be recycled (see AVLTreeConfig)
+//
+// Space complexity: O(n) where n is the number of keys.
+type AVLTree[K cmp.Ordered, V any] struct {
+	root  *avlNode[K, V]
+	size  int
+	arena *memory.Arena[avlNode[K, V]]    // nil unless arena allocation is enabled
+	free  *memory.FreeList[avlNode[K, V]] // nil unless node recycling is enabled
+}
+
+// NewAVLTree creates an empty AVL tree.
+//
+// Example:
+//
+//	t := NewAVLTree[string, int]()
+//	t.Insert("a", 1)
+func NewAVLTree[K cmp.Ordered, V any]() *AVLTree[K, V] {
+	return newAVLTree[K, V](AVLTreeConfig{})
+}
+
+// NewAVLTreeWith creates an empty AVL tree from the default configuration
+// adjusted by the given options. Options are applied in order.
+//
+// Panics if the resulting configuration is invalid.
+//
+// Example:
+//
+//	t := NewAVLTreeWith[int, string](WithArenaSlabSize(1024))
+//	// ... bulk inserts and queries ...
+//	t.Release()  // Drops every node in O(1)
+func NewAVLTreeWith[K cmp.Ordered, V any](opts ...AVLTreeOption) *AVLTree[K, V] {
+	var c AVLTreeConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return newAVLTree[K, V](c)
+}
+
+// NewAVLTreeWithConfig creates an empty AVL tree with custom allocation
+// settings. See AVLTreeConfig for the options.
+//
+// Panics if the configuration is invalid.
+//
+// Deprecated: Use NewAVLTreeWith with WithArenaSlabSize and
+// WithFreeListCapacity.
+func NewAVLTreeWithConfig[K cmp.Ordered, V any](config AVLTreeConfig) *AVLTree[K, V] {
+	return newAVLTree[K, V](config)
+}
+
+// Creates an empty tree with the given settings.
+// Panics if the configuration is invalid.
+func newAVLTree[K cmp.Ordered, V any](config AVLTreeConfig) *AVLTree[K, V] {
+	config.validate()
+	t := &AVLTree[K, V]{}
+	if config.ArenaSlabSize > 0 {
+		t.arena = memory.NewArena[avlNode[K, V]](config.ArenaSlabSize)
+	}
+	if config.FreeListCapacity > 0 {
+		t.free = memory.NewFreeListWith(
+			memory.WithCapacity[avlNode[K, V]](config.FreeListCapacity),
+		)
+	}
+
+	return t
+}
+
+// Insert associates the value with the key.
+// Returns true if the key was added, false if an existing value was replaced.
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	t := NewAVLTree[string, int]()
+//	t.Insert("a", 1)  // Returns true
+//	t.Insert("a", 2)  // Returns false, "a" now maps to 2
+func (t *AVLTree[K, V]) Insert(key K, value V) bool {
+	var added bool
+	t.root, added = t.insert(t.root, key, value)
+	if added {
+		t.size++
+	}
+
+	return added
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not present.
+//
+// Time complexity: O(log n)
+func (t *AVLTree[K, V]) Get(key K) (V, bool) {
+	n := t.root
+	for n != nil {
+		switch {
+		case key < n.key:
+			n = n.left
+		case key > n.key:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Clear removes all keys. It is equivalent to Release.
+//
+// Time complexity: O(1)
+func (t *AVLTree[K, V]) Clear() {
+	t.Release()
+}
+
+// Contains returns true if the key is present.
+//
+// Time complexity: O(log n)
+func (t *AVLTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete removes the key and its value.
+// Returns true if the key was found and removed, false otherwise.
+//
+// Time complexity: O(log n)
+func (t *AVLTree[K, V]) Delete(key K) bool {
+	var removed bool
+	t.root, removed = t.delete(t.root, key)
+	if removed {
+		t.size--
+	}
+
+	return removed
+}
+
+// Min returns the smallest key and its value.
+// Returns false if the tree is empty.
+//
+// Time complexity: O(log n)
+func (t *AVLTree[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		return t.none()
+	}
+
+	n := t.minOf(t.root)
+	return n.key, n.value, true
+}
+
+// Max returns the largest key and its value.
+// Returns false if the tree is empty.
+//
+// Time complexity: O(log n)
+func (t *AVLTree[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		return t.none()
+	}
+
+	n := t.root
+	for n.right != nil {
+		n = n.right
+	}
+
+	return n.key, n.value, true
+}
+
+// Floor returns the largest key less than or equal to the given key,
+// and its value. Returns false if there is no such key.
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	// Tree with keys 10, 20, 30
+//	t.Floor(25)  // Returns 20
+//	t.Floor(20)  // Returns 20
+//	t.Floor(5)   // Returns false
+func (t *AVLTree[K, V]) Floor(key K) (K, V, bool) {
+	return t.below(key, true)
+}
+
+// Lower returns the largest key strictly less than the given key,
+// and its value. Returns false if there is no such key.
+//
+// Time complexity: O(log n)
+func (t *AVLTree[K, V]) Lower(key K) (K, V, bool) {
+	return t.below(key, false)
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key,
+// and its value. Returns false if there is no such key.
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	// Tree with keys 10, 20, 30
+//	t.Ceiling(25)  // Returns 30
+//	t.Ceiling(20)  // Returns 20
+//	t.Ceiling(35)  // Returns false
+func (t *AVLTree[K, V]) Ceiling(key K) (K, V, bool) {
+	return t.above(key, true)
+}
+
+// Higher returns the smallest key strictly greater than the given key,
+// and its value. Returns false if there is no such key.
+//
+// Time complexity: O(log n)
+func (t *AVLTree[K, V]) Higher(key K) (K, V, bool) {
+	return t.above(key, false)
+}
+
+// Select returns the key with the given rank, the k-th smallest key
+// counting from 0, and its value. Returns false if k is out of range.
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	// Tree with keys 10, 20, 30
+//	t.Select(0)  // Returns 10
+//	t.Select(2)  // Returns 30
+//	t.Select(3)  // Returns false
+func (t *AVLTree[K, V]) Select(k int) (K, V, bool) {
+	if k < 0 || k >= t.size {
+		return t.none()
+	}
+
+	n := t.root
+	for {
+		left := t.countOf(n.left)
+		switch {
+		case k < left:
+			n = n.left
+		case k > left:
+			k -= left + 1
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+}
+
+// Rank returns the number of keys strictly less than the given key, which
+// is the key's position in sorted order if it is present.
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	// Tree with keys 10, 20, 30
+//	t.Rank(20)  // Returns 1
+//	t.Rank(25)  // Returns 2
+//	t.Rank(5)   // Returns 0
+func (t *AVLTree[K, V]) Rank(key K) int {
+	rank := 0
+	n := t.root
+	for n != nil {
+		if key <= n.key {
+			n = n.left
+		} else {
+			rank += t.countOf(n.left) + 1
+			n = n.right
+		}
+	}
+
+	return rank
+}
+
+// All returns an iterator over all key-value pairs in ascending key order.
+// The tree must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (t *AVLTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, nil, nil, yield)
+	}
+}
+
+// Backward returns an iterator over all key-value pairs in descending
+// key order. The tree must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (t *AVLTree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.descend(t.root, nil, nil, yield)
+	}
+}
+
+// Range returns an iterator over the key-value pairs with from <= key < to
+// in ascending key order. The tree must not be modified during iteration.
+//
+// Time complexity: O(log n + k) where k is the number of pairs yielded
+func (t *AVLTree[K, V]) Range(from K, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, &from, &to, yield)
+	}
+}
+
+// RangeBackward returns an iterator over the key-value pairs with
+// from <= key < to in descending key order. The tree must not be modified
+// during iteration.
+//
+// Time complexity: O(log n + k) where k is the number of pairs yielded
+func (t *AVLTree[K, V]) RangeBackward(from K, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.descend(t.root, &from, &to, yield)
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the nodes
+// in preorder in the format of package codec, each followed by a byte
+// telling which children it has, so the shape of the tree is preserved.
+// Returns an error wrapping codec.ErrUnsupportedType if K or V cannot be
+// encoded.
+//
+// Time complexity: O(n)
+func (t *AVLTree[K, V]) MarshalBinary() ([]byte, error) {
+	e := codec.NewEncoder("AVLTree", t.size)
+	if t.root != nil {
+		t.marshal(e, t.root)
+	}
+
+	return e.Bytes()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// pairs of the tree with those encoded by MarshalBinary, rebuilding the
+// encoded shape in O(n) instead of inserting the keys one by one. The
+// allocation settings are kept; the previous nodes are dropped as by
+// Release.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded tree or the encoded shape is not a valid AVL tree, in which
+// case the tree is left unchanged.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	data, _ := src.MarshalBinary()
+//	t := NewAVLTree[string, int]()
+//	t.UnmarshalBinary(data)  // Same pairs and shape as src
+func (t *AVLTree[K, V]) UnmarshalBinary(data []byte) error {
+	d, n, err := codec.NewDecoder(data, "AVLTree")
+	if err != nil {
+		return err
+	}
+
+	return t.decode(d, n)
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (t *AVLTree[K, V]) WriteTo(w io.Writer) (int64, error) {
+	e := codec.NewStreamEncoder(w, "AVLTree", t.size)
+	if t.root != nil {
+		t.marshal(e, t.root)
+	}
+
+	return e.Flush()
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the pairs of the tree
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it, rebuilding the encoded
+// shape as UnmarshalBinary does.
+// Returns the number of bytes read and the first error. The tree is left
+// unchanged on error.
+//
+// Time complexity: O(n)
+func (t *AVLTree[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	d, n, err := codec.NewStreamDecoder(r, "AVLTree")
+	if err == nil {
+		err = t.decode(d, n)
+	}
+
+	return d.BytesRead(), err
+}
+
+// IsEmpty returns true if the tree contains no keys.
+//
+// Time complexity: O(1)
+func (t *AVLTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Size returns the number of keys in the tree.
+//
+// Time complexity: O(1)
+func (t *AVLTree[K, V]) Size() int {
+	return t.size
+}
+
+// Height returns the number of nodes on the longest root-to-leaf path,
+// or 0 for an empty tree.
+//
+// Time complexity: O(1)
+func (t *AVLTree[K, V]) Height() int {
+	return t.heightOf(t.root)
+}
+
+// Release removes all keys at once. With arena allocation the slabs are
+// released as well, so the nodes are reclaimed together by the garbage
+// collector; later insertions start a new slab. The nodes are not
+// recycled, and with arena allocation the recycled nodes are dropped too,
+// as they would keep the released slabs alive.
+//
+// Time complexity: O(1)
+func (t *AVLTree[K, V]) Release() {
+	t.root = nil
+	t.size = 0
+	if t.arena != nil {
+		t.arena.Release()
+		if t.free != nil {
+			t.free.Clear()
+		}
+	}
+}
+
+// ToDOT writes the nodes of the tree to w in the Graphviz DOT language
+// (see debug.DOT), each labeled with its key and stored height, and the
+// child pointers labeled L and R.
+// Returns the first write error.
+//
+// Time complexity: O(n)
+func (t *AVLTree[K, V]) ToDOT(w io.Writer) error {
+	d := debug.NewDOT(true)
+	t.toDOT(d, t.root)
+	_, err := d.WriteTo(w)
+	return err
+}
+
+// DebugTree returns a snapshot of the tree's shape for debug.PrintTree,
+// each node labeled with its key, stored height and balance factor (the
+// height of the left subtree minus that of the right).
+// Returns nil if the tree is empty.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	debug.PrintTree(os.Stdout, t)
+//	// 2 (h=2, bf=0)
+//	// ├── L: 1 (h=1, bf=0)
+//	// └── R: 3 (h=1, bf=0)
+func (t *AVLTree[K, V]) DebugTree() *debug.TreeNode {
+	return t.debugTree(t.root, "")
+}
+
+// CheckInvariants verifies the internal consistency of the tree: keys
+// are in search order, the stored heights and subtree counts match the
+// subtrees, every node is balanced, and the number of nodes matches Size.
+// Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the tree is consistent.
+//
+// Time complexity: O(n)
+func (t *AVLTree[K, V]) CheckInvariants() error {
+	if err := t.check(t.root, nil, nil); err != nil {
+		return err
+	}
+	if count := t.countOf(t.root); count != t.size {
+		return fmt.Errorf("%w: size is %d, tree holds %d nodes", ErrInvariantViolation, t.size, count)
+	}
+
+	return nil
+}
+
+// Returns a leaf holding the pair, taken from the free list if it has a
+// recycled node, otherwise from the arena if enabled.
+func (t *AVLTree[K, V]) newNode(key K, value V) *avlNode[K, V] {
+	var n *avlNode[K, V]
+	switch {
+	case t.free != nil && (t.free.Size() > 0 || t.arena == nil):
+		n = t.free.Get()
+	case t.arena != nil:
+		n = t.arena.Alloc()
+	default:
+		return &avlNode[K, V]{key: key, value: value, height: 1, count: 1}
+	}
+
+	n.key, n.value, n.height, n.count = key, value, 1, 1
+	return n
+}
+
+// Hands a node that was unlinked from the tree to the free list, if node
+// recycling is enabled. The node must not be used afterwards.
+func (t *AVLTree[K, V]) recycle(n *avlNode[K, V]) {
+	if t.free != nil {
+		t.free.Put(n)
+	}
+}
+
+// Returns the zero key, zero value and false.
+func (t *AVLTree[K, V]) none() (K, V, bool) {
+	var zeroK K
+	var zeroV V
+	return zeroK, zeroV, false
+}
+
+// Returns the largest key below the given key, or equal to it when
+// inclusive is set.
+func (t *AVLTree[K, V]) below(key K, inclusive bool) (K, V, bool) {
+	var best *avlNode[K, V]
+	n := t.root
+	for n != nil {
+		if n.key < key || (inclusive && n.key == key) {
+			best = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+
+	if best == nil {
+		return t.none()
+	}
+
+	return best.key, best.value, true
+}
+
+// Returns the smallest key above the given key, or equal to it when
+// inclusive is set.
+func (t *AVLTree[K, V]) above(key K, inclusive bool) (K, V, bool) {
+	var best *avlNode[K, V]
+	n := t.root
+	for n != nil {
+		if n.key > key || (inclusive && n.key == key) {
+			best = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	if best == nil {
+		return t.none()
+	}
+
+	return best.key, best.value, true
+}
+
+// Inserts the pair into the subtree and returns its new, rebalanced root.
+// Also reports whether the key was added rather than replaced.
+func (t *AVLTree[K, V]) insert(n *avlNode[K, V], key K, value V) (*avlNode[K, V], bool) {
+	if n == nil {
+		return t.newNode(key, value), true
+	}
+
+	var added bool
+	switch {
+	case key < n.key:
+		n.left, added = t.insert(n.left, key, value)
+	case key > n.key:
+		n.right, added = t.insert(n.right, key, value)
+	default:
+		n.value = value
+		return n, false
+	}
+
+	return t.rebalance(n), added
+}
+
+// Removes the key from the subtree and returns its new, rebalanced root.
+// Also reports whether the key was found.
+func (t *AVLTree[K, V]) delete(n *avlNode[K, V], key K) (*avlNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+	switch {
+	case key < n.key:
+		n.left, removed = t.delete(n.left, key)
+	case key > n.key:
+		n.right, removed = t.delete(n.right, key)
+	default:
+		if n.left == nil {
+			right := n.right
+			t.recycle(n)
+			return right, true
+		}
+		if n.right == nil {
+			left := n.left
+			t.recycle(n)
+			return left, true
+		}
+
+		// Replace the node's pair with its in-order successor,
+		// then remove the successor from the right subtree
+		successor := t.minOf(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right, _ = t.delete(n.right, successor.key)
+		removed = true
+	}
+
+	return t.rebalance(n), removed
+}
+
+// Returns the leftmost node of a non-empty subtree.
+func (t *AVLTree[K, V]) minOf(n *avlNode[K, V]) *avlNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+
+	return n
+}
+
+// Verifies the invariants of the subtree, whose keys must lie strictly
+// between from and to where those are not nil.
+func (t *AVLTree[K, V]) check(n *avlNode[K, V], from *K, to *K) error {
+	if n == nil {
+		return nil
+	}
+
+	if from != nil && n.key <= *from || to != nil && n.key >= *to {
+		return fmt.Errorf("%w: key %v is out of search order", ErrInvariantViolation, n.key)
+	}
+	if err := t.check(n.left, from, &n.key); err != nil {
+		return err
+	}
+	if err := t.check(n.right, &n.key, to); err != nil {
+		return err
+	}
+
+	left, right := t.heightOf(n.left), t.heightOf(n.right)
+	if n.height != 1+max(left, right) {
+		return fmt.Errorf("%w: key %v has height %d, want %d", ErrInvariantViolation, n.key, n.height, 1+max(left, right))
+	}
+	if left-right < -1 || left-right > 1 {
+		return fmt.Errorf("%w: key %v has balance factor %d", ErrInvariantViolation, n.key, left-right)
+	}
+	if count := 1 + t.countOf(n.left) + t.countOf(n.right); n.count != count {
+		return fmt.Errorf("%w: key %v has count %d, want %d", ErrInvariantViolation, n.key, n.count, count)
+	}
+
+	return nil
+}
+
+// Returns the height of the subtree, 0 for nil.
+func (t *AVLTree[K, V]) heightOf(n *avlNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+// Adds the subtree rooted at n to the DOT graph in preorder.
+func (t *AVLTree[K, V]) toDOT(d *debug.DOT, n *avlNode[K, V]) {
+	if n == nil || !d.Node(n, fmt.Sprintf("%v\nh=%d", n.key, n.height)) {
+		return
+	}
+
+	if n.left != nil {
+		d.Edge(n, n.left, "L")
+		t.toDOT(d, n.left)
+	}
+	if n.right != nil {
+		d.Edge(n, n.right, "R")
+		t.toDOT(d, n.right)
+	}
+}
+
+// Returns the snapshot of the subtree rooted at n, its label prefixed
+// with the side it hangs on, or nil for an empty subtree.
+func (t *AVLTree[K, V]) debugTree(n *avlNode[K, V], side string) *debug.TreeNode {
+	if n == nil {
+		return nil
+	}
+
+	bf := t.heightOf(n.left) - t.heightOf(n.right)
+	node := &debug.TreeNode{Label: fmt.Sprintf("%s%v (h=%d, bf=%d)", side, n.key, n.height, bf)}
+	if l := t.debugTree(n.left, "L: "); l != nil {
+		node.Children = append(node.Children, l)
+	}
+	if r := t.debugTree(n.right, "R: "); r != nil {
+		node.Children = append(node.Children, r)
+	}
+
+	return node
+}
+
+// Returns the number of nodes in the subtree, 0 for nil.
+func (t *AVLTree[K, V]) countOf(n *avlNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.count
+}
+
+// Recomputes the node's height and subtree count from its children.
+func (t *AVLTree[K, V]) update(n *avlNode[K, V]) {
+	n.height = 1 + max(t.heightOf(n.left), t.heightOf(n.right))
+	n.count = 1 + t.countOf(n.left) + t.countOf(n.right)
+}
+
+// Restores the AVL property at the node, whose subtrees must already be
+// balanced and differ in height by at most two.
+// Returns the new root of the subtree.
+func (t *AVLTree[K, V]) rebalance(n *avlNode[K, V]) *avlNode[K, V] {
+	t.update(n)
+	balance := t.heightOf(n.left) - t.heightOf(n.right)
+
+	if balance > 1 {
+		// Left-right case: straighten the left child first
+		if t.heightOf(n.left.left) < t.heightOf(n.left.right) {
+			n.left = t.rotateLeft(n.left)
+		}
+		return t.rotateRight(n)
+	}
+
+	if balance < -1 {
+		// Right-left case: straighten the right child first
+		if t.heightOf(n.right.right) < t.heightOf(n.right.left) {
+			n.right = t.rotateRight(n.right)
+		}
+		return t.rotateLeft(n)
+	}
+
+	return n
+}
+
+// Rotates the subtree right, lifting the left child to the root.
+func (t *AVLTree[K, V]) rotateRight(n *avlNode[K, V]) *avlNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	t.update(n)
+	t.update(l)
+	return l
+}
+
+// Rotates the subtree left, lifting the right child to the root.
+func (t *AVLTree[K, V]) rotateLeft(n *avlNode[K, V]) *avlNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	t.update(n)
+	t.update(r)
+	return r
+}
+
+// Yields pairs of the subtree in ascending order, restricted to
+// from <= key < to when the bounds are non-nil.
+// Returns false if the iteration must stop.
+func (t *AVLTree[K, V]) ascend(n *avlNode[K, V], from *K, to *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	// Skip subtrees that lie entirely below the lower bound
+	if from != nil && n.key < *from {
+		return t.ascend(n.right, from, to, yield)
+	}
+
+	if !t.ascend(n.left, from, to, yield) {
+		return false
+	}
+
+	if to != nil && n.key >= *to {
+		return false // Every later key is out of range as well
+	}
+
+	if !yield(n.key, n.value) {
+		return false
+	}
+
+	return t.ascend(n.right, from, to, yield)
+}
+
+// Yields pairs of the subtree in descending order, restricted to
+// from <= key < to when the bounds are non-nil.
+// Returns false if the iteration must stop.
+func (t *AVLTree[K, V]) descend(n *avlNode[K, V], from *K, to *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	// Skip subtrees that lie entirely at or above the upper bound
+	if to != nil && n.key >= *to {
+		return t.descend(n.left, from, to, yield)
+	}
+
+	if !t.descend(n.right, from, to, yield) {
+		return false
+	}
+
+	if from != nil && n.key < *from {
+		return false // Every earlier key is out of range as well
+	}
+
+	if !yield(n.key, n.value) {
+		return false
+	}
+
+	return t.descend(n.left, from, to, yield)
+}
+
+// The height no AVL tree of fewer than 2^63 nodes reaches, about
+// 1.44 log2(n).
+const avlMaxHeight = 92
+
+// Child flags written after each node by the binary encodings of the
+// binary search trees.
+const (
+	hasLeftChild  byte = 1 << iota // A left subtree follows
+	hasRightChild                  // A right subtree follows, after the left one
+)
+
+// Returns the child flags of a node with the given children.
+func childFlags(left bool, right bool) byte {
+	var flags byte
+	if left {
+		flags |= hasLeftChild
+	}
+	if right {
+		flags |= hasRightChild
+	}
+
+	return flags
+}
+
+// Replaces the pairs with the n nodes read from the decoder, once they
+// prove to form a valid AVL tree.
+func (t *AVLTree[K, V]) decode(d *codec.Decoder, n int) error {
+	var root *avlNode[K, V]
+	if n > 0 {
+		root = t.unmarshal(d, 1)
+	}
+	if err := d.Finish(); err != nil {
+		return err
+	}
+	if err := t.check(root, nil, nil); err != nil {
+		return fmt.Errorf("%w: %w", codec.ErrInvalidData, err)
+	}
+	if count := t.countOf(root); count != n {
+		return fmt.Errorf("%w: count is %d, tree holds %d nodes", codec.ErrInvalidData, n, count)
+	}
+
+	t.Release()
+	t.root = root
+	t.size = n
+	return nil
+}
+
+// Appends the subtree rooted at n to the encoder in preorder, each node
+// followed by its child flags.
+func (t *AVLTree[K, V]) marshal(e *codec.Encoder, n *avlNode[K, V]) {
+	codec.Append(e, n.key)
+	codec.Append(e, n.value)
+	e.Byte(childFlags(n.left != nil, n.right != nil))
+	if n.left != nil {
+		t.marshal(e, n.left)
+	}
+	if n.right != nil {
+		t.marshal(e, n.right)
+	}
+}
+
+// Reads a subtree written by marshal at the given depth, recomputing
+// heights and counts. Decoding stops at the first error, which the
+// decoder keeps; forged input deeper than any AVL tree is rejected before
+// it can exhaust the stack.
+func (t *AVLTree[K, V]) unmarshal(d *codec.Decoder, depth int) *avlNode[K, V] {
+	n := t.newNode(codec.Read[K](d), codec.Read[V](d))
+	flags := d.Byte()
+	if depth >= avlMaxHeight && flags != 0 {
+		d.Fail("tree is deeper than %d levels", avlMaxHeight)
+		return n
+	}
+
+	if flags&hasLeftChild != 0 {
+		n.left = t.unmarshal(d, depth+1)
+	}
+	if flags&hasRightChild != 0 {
+		n.right = t.unmarshal(d, depth+1)
+	}
+
+	t.update(n)
+	return n
+}
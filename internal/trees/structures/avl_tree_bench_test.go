@@ -0,0 +1,42 @@
+package structures
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// Benchmark AVL tree allocation modes compared against each other.
+var benchAVLTrees = map[string]func() *AVLTree[int, int]{
+	// Heap: One allocation per inserted node.
+	// Expected: Slower builds, more GC work.
+	"Heap": func() *AVLTree[int, int] {
+		return NewAVLTree[int, int]()
+	},
+	// Arena: Nodes carved from slabs of 1024.
+	// Expected: Far fewer allocations, slightly faster builds.
+	"Arena": func() *AVLTree[int, int] {
+		return NewAVLTreeWithConfig[int, int](AVLTreeConfig{ArenaSlabSize: 1024})
+	},
+}
+
+// BenchmarkAVLTree_BuildAndDrop measures building a tree from random keys
+// and discarding it, the lifetime arena allocation is designed for.
+//
+// Pattern: [Insert(random key)] × 10000, Release
+// Expected winner: Arena on allocations (one per slab instead of one per
+// node); the time gain is modest as searching and rebalancing dominate
+func BenchmarkAVLTree_BuildAndDrop(b *testing.B) {
+	keys := rand.New(rand.NewPCG(1, 1)).Perm(10_000)
+	for name, factory := range benchAVLTrees {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				tree := factory()
+				for _, k := range keys {
+					tree.Insert(k, k)
+				}
+				tree.Release()
+			}
+		})
+	}
+}
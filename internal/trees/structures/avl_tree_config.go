@@ -0,0 +1,80 @@
+package structures
+
+import "github.com/apotourlyan/godatastructures/internal/utilities/panics"
+
+// AVLTreeConfig controls node allocation for AVLTree.
+//
+// The tree supports two optional allocation strategies, which can be
+// combined:
+//
+// Arena allocation (bulk-lifetime optimization):
+//
+// Nodes are carved from slabs of a memory.Arena instead of being
+// allocated one by one, so inserting n keys takes n/slab allocations and
+// Release discards the whole tree in O(1). Deleted nodes are not reused
+// until Release, so the mode suits trees that are built, queried and
+// dropped as a whole, such as per-request indexes.
+//
+// Node recycling (churn optimization):
+//
+// Deleted nodes are kept on a memory.FreeList and handed out again by
+// later insertions, so a tree whose size stays around a steady state,
+// such as an index of live sessions, stops allocating once it has warmed
+// up.
+type AVLTreeConfig struct {
+	// ArenaSlabSize represents the number of nodes allocated per slab.
+	//
+	// Larger slabs mean fewer allocations but more memory pinned by a
+	// single live node and more waste in the last, partly used slab.
+	//
+	// Recommended values:
+	//   0:         Disabled, nodes are allocated individually (default)
+	//   256-4096:  Bulk trees of thousands to millions of keys
+	//
+	// Valid range: [0, ...]
+	ArenaSlabSize int
+
+	// FreeListCapacity represents the maximum number of deleted nodes kept
+	// for reuse by later insertions.
+	//
+	// Nodes deleted while the free list is full are left to the garbage
+	// collector, so the capacity bounds the memory held by idle nodes
+	// after the tree shrinks.
+	//
+	// Recommended values:
+	//   0:         Disabled, deleted nodes are dropped (default)
+	//   64-1024:   Trees with a steady stream of inserts and deletes
+	//
+	// Valid range: [0, ...]
+	FreeListCapacity int
+}
+
+// Validates the configuration values.
+//
+// Panics if values are invalid:
+//   - ArenaSlabSize < 0
+//   - FreeListCapacity < 0
+func (c *AVLTreeConfig) validate() {
+	panics.RequireNonNegative(c.ArenaSlabSize, "arena slab size")
+	panics.RequireNonNegative(c.FreeListCapacity, "free list capacity")
+}
+
+// AVLTreeOption configures an AVLTree created with NewAVLTreeWith.
+// Options are applied in order on top of the default configuration.
+type AVLTreeOption func(*AVLTreeConfig)
+
+// WithArenaSlabSize enables arena allocation with the given slab size.
+// See AVLTreeConfig.ArenaSlabSize.
+func WithArenaSlabSize(size int) AVLTreeOption {
+	return func(c *AVLTreeConfig) {
+		c.ArenaSlabSize = size
+	}
+}
+
+// WithFreeListCapacity enables node recycling, keeping up to capacity
+// deleted nodes for reuse. See AVLTreeConfig.FreeListCapacity.
+func WithFreeListCapacity(capacity int) AVLTreeOption {
+	return func(c *AVLTreeConfig) {
+		c.FreeListCapacity = capacity
+	}
+}
@@ -0,0 +1,816 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewAVLTree):
+  ✓ Empty tree
+
+Insert/Get/Contains:
+  ✓ Get from empty tree
+  ✓ Insert new keys
+  ✓ Insert existing key replaces value
+  ✓ Sequential inserts stay balanced
+
+Delete:
+  ✓ Delete from empty tree
+  ✓ Delete absent key
+  ✓ Delete node with two children
+  ✓ Delete all keys (ascending and descending)
+
+Min/Max:
+  ✓ Empty tree
+  ✓ Non-empty tree
+
+Floor/Lower/Ceiling/Higher:
+  ✓ Empty tree
+  ✓ Exact, between, below and above the stored keys
+
+All/Backward/Range/RangeBackward:
+  ✓ Ascending and descending order
+  ✓ Half-open range bounds
+  ✓ Empty range
+  ✓ Early termination
+
+Select/Rank:
+  ✓ Empty tree
+  ✓ Every rank, out-of-range ranks, absent keys
+
+Arena allocation (NewAVLTreeWithConfig/Release):
+  ✓ Invalid slab size panics
+  ✓ Mixed inserts/deletes match a map model, invariants hold
+  ✓ Release empties the tree, tree stays usable
+
+Node recycling (WithFreeListCapacity):
+  ✓ Invalid capacity panics
+  ✓ Deleted nodes are recycled zeroed, insertions reuse them
+  ✓ Free list bounded by its capacity
+  ✓ Mixed inserts/deletes match a map model, invariants hold
+  ✓ Recycled nodes served before the arena, dropped by Release
+
+CheckInvariants:
+  ✓ Valid tree, wrong size, height, count, balance, search order
+
+Randomized:
+  ✓ Mixed inserts/deletes match a map model, invariants hold
+
+ToDOT:
+  ✓ Empty tree, keys with heights, L and R child pointers
+
+DebugTree:
+  ✓ Empty tree, keys with heights and balance factors, lone right child
+
+Options (NewAVLTreeWith):
+  ✓ No options allocates nodes individually
+  ✓ WithArenaSlabSize enables arena allocation
+  ✓ Invalid options (panic)
+
+Binary encoding:
+  ✓ Round trip preserves pairs and shape, arena settings kept, invalid
+    data rejected
+  ✓ Unbalanced shape rejected
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom restores the shape
+  ✓ Invalid stream leaves the tree unchanged
+
+Binary encoding (depth limit):
+  ✓ Forged chain deeper than any AVL tree rejected
+
+Clear:
+  ✓ Removes keys, with an arena, a free list or neither
+*/
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/debug"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies keys are in BST order, stored heights and subtree counts are
+// correct, every node is balanced, and the node count matches the size.
+func checkAVLTree[V any](t *testing.T, tree *AVLTree[int, V]) {
+	t.Helper()
+	test.GotWantNoError(t, tree.CheckInvariants())
+	count := 0
+	var walk func(n *avlNode[int, V], lo *int, hi *int) int
+	walk = func(n *avlNode[int, V], lo *int, hi *int) int {
+		if n == nil {
+			return 0
+		}
+		count++
+		if (lo != nil && n.key <= *lo) || (hi != nil && n.key >= *hi) {
+			t.Errorf("key %d violates BST order", n.key)
+		}
+		l := walk(n.left, lo, &n.key)
+		r := walk(n.right, &n.key, hi)
+		if l-r > 1 || r-l > 1 {
+			t.Errorf("node %d unbalanced: heights %d and %d", n.key, l, r)
+		}
+		if n.height != 1+max(l, r) {
+			t.Errorf("node %d has height %d, want %d", n.key, n.height, 1+max(l, r))
+		}
+		if want := 1 + tree.countOf(n.left) + tree.countOf(n.right); n.count != want {
+			t.Errorf("node %d has count %d, want %d", n.key, n.count, want)
+		}
+		return 1 + max(l, r)
+	}
+	walk(tree.root, nil, nil)
+	test.GotWant(t, count, tree.size)
+}
+
+// Verifies the creation of an empty tree
+func TestAVLTree_NewAVLTree_Empty(t *testing.T) {
+	tree := NewAVLTree[int, string]()
+	test.GotWant(t, tree.Size(), 0)
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, tree.Height(), 0)
+}
+
+// Verifies getting from an empty tree
+func TestAVLTree_Get_EmptyTree(t *testing.T) {
+	tree := NewAVLTree[int, string]()
+	v, ok := tree.Get(1)
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, "")
+	test.GotWant(t, tree.Contains(1), false)
+}
+
+// Verifies inserting new keys
+func TestAVLTree_Insert_NewKeys(t *testing.T) {
+	tree := NewAVLTree[int, string]()
+	test.GotWant(t, tree.Insert(2, "two"), true)
+	test.GotWant(t, tree.Insert(1, "one"), true)
+	test.GotWant(t, tree.Insert(3, "three"), true)
+	test.GotWant(t, tree.Size(), 3)
+
+	v, ok := tree.Get(1)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, "one")
+	test.GotWant(t, tree.Contains(3), true)
+	test.GotWant(t, tree.Contains(4), false)
+	checkAVLTree(t, tree)
+}
+
+// Verifies inserting an existing key replaces its value
+func TestAVLTree_Insert_ExistingKey(t *testing.T) {
+	tree := NewAVLTree[int, string]()
+	for i := range 20 {
+		tree.Insert(i, "old")
+	}
+	for i := range 20 {
+		test.GotWant(t, tree.Insert(i, "new"), false)
+	}
+
+	test.GotWant(t, tree.Size(), 20)
+	for i := range 20 {
+		v, _ := tree.Get(i)
+		test.GotWant(t, v, "new")
+	}
+	checkAVLTree(t, tree)
+}
+
+// Verifies sorted inserts, the worst case for an unbalanced tree,
+// keep the height logarithmic
+func TestAVLTree_Insert_SequentialStaysBalanced(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	for i := range 1023 {
+		tree.Insert(i, i)
+	}
+
+	// A perfectly balanced tree of 2^10-1 keys has height 10
+	test.GotWant(t, tree.Height(), 10)
+	checkAVLTree(t, tree)
+}
+
+// Verifies deleting from an empty tree
+func TestAVLTree_Delete_EmptyTree(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	test.GotWant(t, tree.Delete(1), false)
+}
+
+// Verifies deleting an absent key leaves the tree unchanged
+func TestAVLTree_Delete_AbsentKey(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	for i := range 10 {
+		tree.Insert(i*2, i)
+	}
+	test.GotWant(t, tree.Delete(5), false)
+	test.GotWant(t, tree.Size(), 10)
+	checkAVLTree(t, tree)
+}
+
+// Verifies deleting a node with two children keeps the remaining pairs
+func TestAVLTree_Delete_TwoChildren(t *testing.T) {
+	tree := NewAVLTree[int, string]()
+	tree.Insert(2, "two")
+	tree.Insert(1, "one")
+	tree.Insert(3, "three")
+
+	test.GotWant(t, tree.Delete(2), true)
+	test.GotWant(t, tree.Contains(2), false)
+	v, _ := tree.Get(3)
+	test.GotWant(t, v, "three")
+	test.GotWantSlice(t, collectKeys(tree.All()), []int{1, 3})
+	checkAVLTree(t, tree)
+}
+
+// Verifies deleting every key in ascending and descending order
+func TestAVLTree_Delete_All(t *testing.T) {
+	orders := map[string]func(i int) int{
+		"ascending":  func(i int) int { return i },
+		"descending": func(i int) int { return 99 - i },
+	}
+
+	for name, key := range orders {
+		t.Run(name, func(t *testing.T) {
+			tree := NewAVLTree[int, int]()
+			for i := range 100 {
+				tree.Insert(i, i)
+			}
+			for i := range 100 {
+				test.GotWant(t, tree.Delete(key(i)), true)
+				checkAVLTree(t, tree)
+			}
+			test.GotWant(t, tree.IsEmpty(), true)
+			test.GotWant(t, tree.root == nil, true)
+		})
+	}
+}
+
+// Verifies Min and Max on an empty tree
+func TestAVLTree_MinMax_EmptyTree(t *testing.T) {
+	tree := NewAVLTree[int, string]()
+	_, _, minOk := tree.Min()
+	_, _, maxOk := tree.Max()
+	test.GotWant(t, minOk, false)
+	test.GotWant(t, maxOk, false)
+}
+
+// Verifies Min and Max on a non-empty tree
+func TestAVLTree_MinMax_NonEmptyTree(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	for _, k := range []int{5, 3, 9, 1, 7} {
+		tree.Insert(k, k*10)
+	}
+	k, v, ok := tree.Min()
+	test.GotWant(t, ok, true)
+	test.GotWant(t, k, 1)
+	test.GotWant(t, v, 10)
+	k, v, ok = tree.Max()
+	test.GotWant(t, ok, true)
+	test.GotWant(t, k, 9)
+	test.GotWant(t, v, 90)
+}
+
+// Verifies neighbor queries on an empty tree
+func TestAVLTree_Neighbors_EmptyTree(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	queries := map[string]func(int) (int, int, bool){
+		"Floor":   tree.Floor,
+		"Lower":   tree.Lower,
+		"Ceiling": tree.Ceiling,
+		"Higher":  tree.Higher,
+	}
+
+	for name, query := range queries {
+		t.Run(name, func(t *testing.T) {
+			_, _, ok := query(1)
+			test.GotWant(t, ok, false)
+		})
+	}
+}
+
+// Verifies Floor, Lower, Ceiling and Higher around the stored keys
+func TestAVLTree_Neighbors(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	for _, k := range []int{10, 20, 30, 40, 50} {
+		tree.Insert(k, k*10)
+	}
+
+	cases := []struct {
+		name  string
+		query func(int) (int, int, bool)
+		key   int
+		want  int
+		found bool
+	}{
+		{"Floor exact", tree.Floor, 30, 30, true},
+		{"Floor between", tree.Floor, 35, 30, true},
+		{"Floor below all", tree.Floor, 5, 0, false},
+		{"Floor above all", tree.Floor, 99, 50, true},
+		{"Lower exact", tree.Lower, 30, 20, true},
+		{"Lower between", tree.Lower, 35, 30, true},
+		{"Lower smallest", tree.Lower, 10, 0, false},
+		{"Ceiling exact", tree.Ceiling, 30, 30, true},
+		{"Ceiling between", tree.Ceiling, 35, 40, true},
+		{"Ceiling above all", tree.Ceiling, 55, 0, false},
+		{"Ceiling below all", tree.Ceiling, 1, 10, true},
+		{"Higher exact", tree.Higher, 30, 40, true},
+		{"Higher between", tree.Higher, 35, 40, true},
+		{"Higher largest", tree.Higher, 50, 0, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, v, ok := tc.query(tc.key)
+			test.GotWant(t, ok, tc.found)
+			test.GotWant(t, k, tc.want)
+			test.GotWant(t, v, tc.want*10)
+		})
+	}
+}
+
+// Verifies All yields keys in ascending order and Backward in descending order
+func TestAVLTree_All_Order(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	for _, k := range rand.Perm(50) {
+		tree.Insert(k, k)
+	}
+
+	want := make([]int, 50)
+	for i := range want {
+		want[i] = i
+	}
+	test.GotWantSlice(t, collectKeys(tree.All()), want)
+	slices.Reverse(want)
+	test.GotWantSlice(t, collectKeys(tree.Backward()), want)
+}
+
+// Verifies Range and RangeBackward respect half-open bounds
+func TestAVLTree_Range_Bounds(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	for i := range 50 {
+		tree.Insert(i*2, i)
+	}
+
+	test.GotWantSlice(t, collectKeys(tree.Range(10, 20)), []int{10, 12, 14, 16, 18})
+	test.GotWantSlice(t, collectKeys(tree.Range(11, 19)), []int{12, 14, 16, 18})
+	test.GotWantSlice(t, collectKeys(tree.RangeBackward(10, 20)), []int{18, 16, 14, 12, 10})
+	test.GotWantSlice(t, collectKeys(tree.RangeBackward(11, 19)), []int{18, 16, 14, 12})
+	test.GotWantSlice(t, collectKeys(tree.Range(-10, 3)), []int{0, 2})
+	test.GotWantSlice(t, collectKeys(tree.RangeBackward(95, 200)), []int{98, 96})
+}
+
+// Verifies empty ranges yield nothing
+func TestAVLTree_Range_Empty(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	for i := range 20 {
+		tree.Insert(i*2, i)
+	}
+
+	test.GotWant(t, len(collectKeys(tree.Range(5, 5))), 0)
+	test.GotWant(t, len(collectKeys(tree.Range(7, 3))), 0)
+	test.GotWant(t, len(collectKeys(tree.Range(100, 200))), 0)
+	test.GotWant(t, len(collectKeys(tree.RangeBackward(-5, 0))), 0)
+	test.GotWant(t, len(collectKeys(NewAVLTree[int, int]().Range(0, 10))), 0)
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestAVLTree_All_EarlyTermination(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	for i := range 50 {
+		tree.Insert(i, i)
+	}
+
+	got := []int{}
+	for k := range tree.All() {
+		got = append(got, k)
+		if len(got) == 3 {
+			break
+		}
+	}
+	for k := range tree.RangeBackward(10, 40) {
+		got = append(got, k)
+		if len(got) == 5 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []int{0, 1, 2, 39, 38})
+}
+
+// Verifies selecting and ranking in an empty tree
+func TestAVLTree_SelectRank_EmptyTree(t *testing.T) {
+	tree := NewAVLTree[int, string]()
+	_, _, ok := tree.Select(0)
+	test.GotWant(t, ok, false)
+	test.GotWant(t, tree.Rank(5), 0)
+}
+
+// Verifies every rank selects its key, out-of-range ranks fail, and
+// absent keys rank by the number of smaller keys
+func TestAVLTree_SelectRank(t *testing.T) {
+	tree := NewAVLTree[int, int]()
+	for i := range 100 {
+		tree.Insert(i*10, i)
+	}
+	tree.Delete(500)
+
+	for i, k := range collectKeys(tree.All()) {
+		key, value, ok := tree.Select(i)
+		test.GotWant(t, ok, true)
+		test.GotWant(t, key, k)
+		test.GotWant(t, value, k/10)
+		test.GotWant(t, tree.Rank(k), i)
+	}
+
+	_, _, ok := tree.Select(-1)
+	test.GotWant(t, ok, false)
+	_, _, ok = tree.Select(99)
+	test.GotWant(t, ok, false)
+
+	test.GotWant(t, tree.Rank(-5), 0)
+	test.GotWant(t, tree.Rank(15), 2)
+	test.GotWant(t, tree.Rank(500), 50)
+	test.GotWant(t, tree.Rank(510), 50)
+	test.GotWant(t, tree.Rank(2000), 99)
+	checkAVLTree(t, tree)
+}
+
+// Verifies CheckInvariants accepts a valid tree and reports a wrong size,
+// height, count, balance and search order
+func TestAVLTree_CheckInvariants(t *testing.T) {
+	tree := NewAVLTree[int, string]()
+	test.GotWantNoError(t, tree.CheckInvariants())
+	for k := range 7 {
+		tree.Insert(k, "")
+	}
+	test.GotWantNoError(t, tree.CheckInvariants())
+
+	root := tree.root
+	tree.size = 8
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: size is 8, tree holds 7 nodes")
+	tree.size = 7
+
+	root.height++
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: key 3 has height 4, want 3")
+	root.height--
+
+	root.count--
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: key 3 has count 6, want 7")
+	root.count++
+
+	root.left.key = 4
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: key 4 is out of search order")
+	root.left.key = 1
+
+	right := root.right
+	root.right = nil
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: key 3 has balance factor 2")
+	root.right = right
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies random inserts and deletes match a map model and keep the
+// tree invariants
+func TestAVLTree_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+	tree := NewAVLTree[int, int]()
+	model := map[int]int{}
+
+	for i := range 5000 {
+		k := r.IntN(500)
+		if r.IntN(3) == 0 {
+			_, exists := model[k]
+			test.GotWant(t, tree.Delete(k), exists)
+			delete(model, k)
+		} else {
+			_, exists := model[k]
+			test.GotWant(t, tree.Insert(k, i), !exists)
+			model[k] = i
+		}
+	}
+
+	checkAVLTree(t, tree)
+	test.GotWant(t, tree.Size(), len(model))
+	for k, want := range model {
+		v, ok := tree.Get(k)
+		test.GotWant(t, ok, true)
+		test.GotWant(t, v, want)
+	}
+
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	test.GotWantSlice(t, collectKeys(tree.All()), keys)
+	for i, k := range keys {
+		got, _, _ := tree.Select(i)
+		test.GotWant(t, got, k)
+		test.GotWant(t, tree.Rank(k), i)
+	}
+}
+
+// Verifies a negative arena slab size panics
+func TestAVLTree_NewAVLTreeWithConfig_InvalidSlabSize(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewAVLTreeWithConfig[int, int](AVLTreeConfig{ArenaSlabSize: -1})
+	}, `"arena slab size" must be >= 0, got -1`)
+}
+
+// Verifies an arena-backed tree matches a map model and keeps its
+// invariants
+func TestAVLTree_Arena_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(2, 2))
+	tree := NewAVLTreeWithConfig[int, int](AVLTreeConfig{ArenaSlabSize: 64})
+	model := map[int]int{}
+
+	for i := range 3000 {
+		k := r.IntN(300)
+		if r.IntN(3) == 0 {
+			_, exists := model[k]
+			test.GotWant(t, tree.Delete(k), exists)
+			delete(model, k)
+		} else {
+			_, exists := model[k]
+			test.GotWant(t, tree.Insert(k, i), !exists)
+			model[k] = i
+		}
+	}
+
+	checkAVLTree(t, tree)
+	test.GotWant(t, tree.Size(), len(model))
+	for k, want := range model {
+		v, ok := tree.Get(k)
+		test.GotWant(t, ok, true)
+		test.GotWant(t, v, want)
+	}
+}
+
+// Verifies Release empties the tree and later inserts start a new slab
+func TestAVLTree_Arena_Release(t *testing.T) {
+	tree := NewAVLTreeWithConfig[int, string](AVLTreeConfig{ArenaSlabSize: 4})
+	for i := range 10 {
+		tree.Insert(i, "v")
+	}
+	test.GotWant(t, tree.arena.Slabs(), 3)
+
+	tree.Release()
+	test.GotWant(t, tree.Size(), 0)
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, tree.arena.Slabs(), 0)
+	_, ok := tree.Get(3)
+	test.GotWant(t, ok, false)
+
+	tree.Insert(2, "b")
+	tree.Insert(1, "a")
+	test.GotWantSlice(t, collectKeys(tree.All()), []int{1, 2})
+	test.GotWant(t, tree.arena.Slabs(), 1)
+	checkAVLTree(t, tree)
+}
+
+// Verifies a negative free list capacity panics
+func TestAVLTree_NewAVLTreeWith_InvalidFreeListCapacity(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewAVLTreeWith[int, int](WithFreeListCapacity(-1))
+	}, `"free list capacity" must be >= 0, got -1`)
+}
+
+// Verifies every deleted node, including the successor unlinked in place
+// of a node with two children, is handed to the free list zeroed, and
+// insertions take recycled nodes before allocating
+func TestAVLTree_FreeList_Reuse(t *testing.T) {
+	tree := NewAVLTreeWith[int, string](WithFreeListCapacity(8))
+	for _, k := range []int{2, 1, 4, 3, 5} {
+		tree.Insert(k, "v")
+	}
+	leaf := tree.root.left
+
+	tree.Delete(1) // Leaf, the tree rotates to 4(2(-, 3), 5)
+	tree.Delete(4) // Two children, successor 5 is unlinked: 3(2, 5)
+	tree.Delete(5) // Leaf: 3(2, -)
+	tree.Delete(3) // One child: 2
+	test.GotWant(t, tree.free.Size(), 4)
+	test.GotWant(t, *leaf, avlNode[int, string]{})
+	test.GotWantNoError(t, tree.CheckInvariants())
+
+	tree.Insert(6, "w")
+	test.GotWant(t, tree.free.Size(), 3)
+	test.GotWant(t, tree.free.Stats().Reused, 1)
+	test.GotWantSlice(t, collectKeys(tree.All()), []int{2, 6})
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies nodes deleted while the free list is full are dropped
+func TestAVLTree_FreeList_Capacity(t *testing.T) {
+	tree := NewAVLTreeWith[int, int](WithFreeListCapacity(2))
+	for i := range 4 {
+		tree.Insert(i, i)
+	}
+	for i := range 4 {
+		tree.Delete(i)
+	}
+
+	test.GotWant(t, tree.free.Size(), 2)
+	test.GotWant(t, tree.free.Stats().Dropped, 2)
+}
+
+// Verifies a tree recycling its nodes matches a map model and keeps its
+// invariants
+func TestAVLTree_FreeList_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 3))
+	tree := NewAVLTreeWith[int, int](WithFreeListCapacity(16))
+	model := map[int]int{}
+
+	for i := range 3000 {
+		k := r.IntN(300)
+		if r.IntN(2) == 0 {
+			_, exists := model[k]
+			test.GotWant(t, tree.Delete(k), exists)
+			delete(model, k)
+		} else {
+			_, exists := model[k]
+			test.GotWant(t, tree.Insert(k, i), !exists)
+			model[k] = i
+		}
+	}
+
+	checkAVLTree(t, tree)
+	test.GotWant(t, tree.Size(), len(model))
+	for k, want := range model {
+		v, ok := tree.Get(k)
+		test.GotWant(t, ok, true)
+		test.GotWant(t, v, want)
+	}
+	test.GotWant(t, tree.free.Stats().Reused > 0, true)
+}
+
+// Verifies recycled nodes are served before the arena, and Release drops
+// them along with the slabs they point into
+func TestAVLTree_FreeList_Arena(t *testing.T) {
+	tree := NewAVLTreeWith[int, int](WithArenaSlabSize(4), WithFreeListCapacity(4))
+	for i := range 4 {
+		tree.Insert(i, i)
+	}
+	tree.Delete(0)
+	tree.Insert(4, 4)
+	test.GotWant(t, tree.arena.Slabs(), 1)
+	test.GotWant(t, tree.free.Stats().Reused, 1)
+
+	tree.Delete(1)
+	test.GotWant(t, tree.free.Size(), 1)
+	tree.Release()
+	test.GotWant(t, tree.free.Size(), 0)
+	test.GotWant(t, tree.arena.Slabs(), 0)
+}
+
+// Verifies ToDOT draws the keys with their heights and the child pointers
+func TestAVLTree_ToDOT(t *testing.T) {
+	var b strings.Builder
+	tree := NewAVLTree[int, string]()
+	test.GotWantNoError(t, tree.ToDOT(&b))
+	test.GotWant(t, b.String(), "digraph {\n}\n")
+
+	for _, k := range []int{2, 1, 3, 4} {
+		tree.Insert(k, "")
+	}
+	b.Reset()
+	test.GotWantNoError(t, tree.ToDOT(&b))
+	test.GotWant(t, b.String(), `digraph {
+    n0 [label="2\nh=3"];
+    n0 -> n1 [label="L"];
+    n1 [label="1\nh=1"];
+    n0 -> n2 [label="R"];
+    n2 [label="3\nh=2"];
+    n2 -> n3 [label="R"];
+    n3 [label="4\nh=1"];
+}
+`)
+}
+
+// Verifies DebugTree annotates heights and balance factors and marks a
+// lone child's side
+func TestAVLTree_DebugTree(t *testing.T) {
+	tree := NewAVLTree[int, string]()
+	test.GotWant(t, tree.DebugTree() == nil, true)
+
+	for _, k := range []int{2, 1, 3, 4} {
+		tree.Insert(k, "")
+	}
+	var b strings.Builder
+	test.GotWantNoError(t, debug.PrintTree(&b, tree))
+	test.GotWant(t, b.String(), `2 (h=3, bf=-1)
+├── L: 1 (h=1, bf=0)
+└── R: 3 (h=2, bf=-1)
+    └── R: 4 (h=1, bf=0)
+`)
+}
+
+// Verifies no options allocates nodes individually
+func TestAVLTree_NewAVLTreeWith_Defaults(t *testing.T) {
+	test.GotWant(t, NewAVLTreeWith[int, int]().arena == nil, true)
+}
+
+// Verifies WithArenaSlabSize enables arena allocation
+func TestAVLTree_NewAVLTreeWith_ArenaSlabSize(t *testing.T) {
+	tree := NewAVLTreeWith[int, int](WithArenaSlabSize(16))
+	test.GotWant(t, tree.arena != nil, true)
+
+	tree.Insert(1, 10)
+	v, _ := tree.Get(1)
+	test.GotWant(t, v, 10)
+}
+
+// Verifies invalid options panic at construction
+func TestAVLTree_NewAVLTreeWith_InvalidOptions(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewAVLTreeWith[int, int](WithArenaSlabSize(-1))
+	}, `"arena slab size" must be >= 0, got -1`)
+}
+
+// Verifies a round trip preserves the pairs and the exact shape, the
+// receiving tree keeps its arena and invalid data is rejected
+func TestAVLTree_MarshalBinary(t *testing.T) {
+	src := NewAVLTree[int, string]()
+	for i := range 20 {
+		src.Insert(i*7%20, strconv.Itoa(i))
+	}
+	other, _ := codec.EncodeSeq("AVLTree", 1, slices.Values([]int{1}))
+	dst := NewAVLTreeWith[int, string](WithArenaSlabSize(8))
+	dst.Insert(100, "x")
+	codectest.RoundTrip(t, src, dst, (*AVLTree[int, string]).DebugTree, other)
+	test.GotWant(t, dst.arena != nil, true)
+	test.GotWantNoError(t, dst.CheckInvariants())
+
+	empty, _ := NewAVLTree[int, string]().MarshalBinary()
+	test.GotWantNoError(t, dst.UnmarshalBinary(empty))
+	test.GotWant(t, dst.IsEmpty(), true)
+}
+
+// Verifies an encoded shape that is not balanced is rejected
+func TestAVLTree_UnmarshalBinary_Unbalanced(t *testing.T) {
+	e := codec.NewEncoder("AVLTree", 3)
+	for i, flags := range []byte{hasRightChild, hasRightChild, 0} {
+		codec.Append(e, i)
+		codec.Append(e, "")
+		e.Byte(flags)
+	}
+	data, _ := e.Bytes()
+
+	tree := NewAVLTree[int, string]()
+	err := tree.UnmarshalBinary(data)
+	test.GotWantErrorIs(t, err, codec.ErrInvalidData)
+	test.GotWantErrorIs(t, err, ErrInvariantViolation)
+	test.GotWant(t, tree.IsEmpty(), true)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the exact shape of the tree
+func TestAVLTree_WriteTo_ReadFrom(t *testing.T) {
+	src := NewAVLTree[int, int]()
+	for i := range 100 {
+		src.Insert(i*37%100, i)
+	}
+	dst := NewAVLTree[int, int]()
+	codectest.StreamRoundTrip(t, src, dst, (*AVLTree[int, int]).DebugTree)
+}
+
+// Verifies a forged chain deeper than any AVL tree is rejected while
+// decoding rather than recursing through it
+func TestAVLTree_ReadFrom_TooDeep(t *testing.T) {
+	const depth = avlMaxHeight + 10
+	e := codec.NewEncoder("AVLTree", depth)
+	for i := range depth {
+		codec.Append(e, i)
+		codec.Append(e, 0)
+		e.Byte(hasRightChild)
+	}
+	data, _ := e.Bytes()
+
+	tree := NewAVLTree[int, int]()
+	_, err := tree.ReadFrom(bytes.NewReader(data))
+	test.GotWantErrorIs(t, err, codec.ErrInvalidData)
+	test.GotWant(t, tree.IsEmpty(), true)
+}
+
+// Verifies Clear empties the tree with either allocation strategy
+func TestAVLTree_Clear(t *testing.T) {
+	for name, tree := range map[string]*AVLTree[int, int]{
+		"Heap":     NewAVLTree[int, int](),
+		"Arena":    NewAVLTreeWith[int, int](WithArenaSlabSize(8)),
+		"FreeList": NewAVLTreeWith[int, int](WithFreeListCapacity(8)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for i := range 20 {
+				tree.Insert(i, i)
+			}
+
+			tree.Clear()
+			test.GotWant(t, tree.Size(), 0)
+			test.GotWant(t, tree.Height(), 0)
+			test.GotWant(t, tree.Contains(5), false)
+
+			tree.Insert(5, 5)
+			test.GotWant(t, tree.Contains(5), true)
+			test.GotWantNoError(t, tree.CheckInvariants())
+		})
+	}
+}
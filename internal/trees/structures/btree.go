@@ -0,0 +1,707 @@
+// Package structures provides generic tree data structures and their implementations.
+package structures
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &BTree[int, int]{}
+var _ collections.Iterable2[int, int] = &BTree[int, int]{}
+var _ collections.Collection[int] = &BTree[int, int]{}
+
+const ErrorInvariantViolation = "invariant violation"
+
+// ErrInvariantViolation is wrapped by CheckInvariants when a tree of this
+// package is found corrupt.
+var ErrInvariantViolation = errors.New(ErrorInvariantViolation)
+
+// Represents a single node in a B-tree.
+// Keys are kept sorted; values[i] belongs to keys[i].
+// Internal nodes have exactly len(keys)+1 children, leaves have none.
+type bTreeNode[K cmp.Ordered, V any] struct {
+	keys     []K
+	values   []V
+	children []*bTreeNode[K, V]
+}
+
+// Returns true if the node has no children.
+func (n *bTreeNode[K, V]) isLeaf() bool {
+	return len(n.children) == 0
+}
+
+// BTree implements an in-memory B-tree mapping ordered keys to values.
+//
+// Each node stores between degree-1 and 2*degree-1 keys (the root may
+// hold fewer), so the tree stays shallow and each node visit scans a
+// small contiguous array. This makes the B-tree cache-friendly and a good
+// fit for large sorted datasets.
+//
+// Design decisions:
+//   - Minimum degree t: Nodes hold [t-1, 2t-1] keys and [t, 2t] children
+//   - Proactive split/merge: Insert and Delete make a single pass from
+//     the root without backtracking
+//   - Binary search within nodes: O(log t) per node visited
+//   - Size counter: Enables O(1) Size and IsEmpty operations
+//
+// Space complexity: O(n) where n is the number of keys.
+type BTree[K cmp.Ordered, V any] struct {
+	root   *bTreeNode[K, V]
+	degree int // Minimum degree t
+	size   int
+}
+
+// NewBTree creates an empty B-tree with a default minimum degree of 32,
+// which keeps nodes around a few cache lines for small key types.
+//
+// Example:
+//
+//	t := NewBTree[int, string]()
+//	t.Insert(1, "one")
+func NewBTree[K cmp.Ordered, V any]() *BTree[K, V] {
+	return NewBTreeWithDegree[K, V](32)
+}
+
+// NewBTreeWithDegree creates an empty B-tree with the given minimum degree.
+// Each node holds between degree-1 and 2*degree-1 keys.
+//
+// Lower degrees: Taller tree, cheaper node splits and merges
+// Higher degrees: Shallower tree, better cache locality, costlier shifts
+//
+// Panics if degree < 2.
+//
+// Example:
+//
+//	t := NewBTreeWithDegree[int, string](2)  // 2-3-4 tree
+func NewBTreeWithDegree[K cmp.Ordered, V any](degree int) *BTree[K, V] {
+	panics.RequireGreaterThan(degree, 1, "degree")
+	return &BTree[K, V]{degree: degree}
+}
+
+// Insert associates the value with the key.
+// Returns true if the key was added, false if an existing value was replaced.
+//
+// Time complexity: O(t log_t n) where t is the degree
+//
+// Example:
+//
+//	t := NewBTree[string, int]()
+//	t.Insert("a", 1)  // Returns true
+//	t.Insert("a", 2)  // Returns false, "a" now maps to 2
+func (t *BTree[K, V]) Insert(key K, value V) bool {
+	if t.root == nil {
+		t.root = &bTreeNode[K, V]{keys: []K{key}, values: []V{value}}
+		t.size++
+		return true
+	}
+
+	// Split a full root first, growing the tree by one level
+	if len(t.root.keys) == t.maxKeys() {
+		root := &bTreeNode[K, V]{children: []*bTreeNode[K, V]{t.root}}
+		t.splitChild(root, 0)
+		t.root = root
+	}
+
+	added := t.insertNonFull(t.root, key, value)
+	if added {
+		t.size++
+	}
+
+	return added
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not present.
+//
+// Time complexity: O(log n)
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	n := t.root
+	for n != nil {
+		i, found := algorithms.BinarySearch(n.keys, key, cmp.Less[K])
+		if found {
+			return n.values[i], true
+		}
+
+		if n.isLeaf() {
+			break
+		}
+
+		n = n.children[i]
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Clear removes all keys. The minimum degree is kept.
+//
+// Time complexity: O(1)
+func (t *BTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Contains returns true if the key is present.
+//
+// Time complexity: O(log n)
+func (t *BTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete removes the key and its value.
+// Returns true if the key was found and removed, false otherwise.
+//
+// Time complexity: O(t log_t n) where t is the degree
+func (t *BTree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+
+	removed := t.delete(t.root, key)
+	if removed {
+		t.size--
+	}
+
+	// Shrink the tree by one level when the root runs out of keys
+	if len(t.root.keys) == 0 {
+		if t.root.isLeaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+
+	return removed
+}
+
+// Min returns the smallest key and its value.
+// Returns false if the tree is empty.
+//
+// Time complexity: O(log n)
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+
+	n := t.root
+	for !n.isLeaf() {
+		n = n.children[0]
+	}
+
+	return n.keys[0], n.values[0], true
+}
+
+// Max returns the largest key and its value.
+// Returns false if the tree is empty.
+//
+// Time complexity: O(log n)
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+
+	n := t.root
+	for !n.isLeaf() {
+		n = n.children[len(n.children)-1]
+	}
+
+	last := len(n.keys) - 1
+	return n.keys[last], n.values[last], true
+}
+
+// All returns an iterator over all key-value pairs in ascending key order.
+// The tree must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, nil, nil, yield)
+	}
+}
+
+// Backward returns an iterator over all key-value pairs in descending
+// key order. The tree must not be modified during iteration.
+//
+// Time complexity: O(n) for a full iteration
+func (t *BTree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.descend(t.root, nil, nil, yield)
+	}
+}
+
+// Range returns an iterator over the key-value pairs with from <= key < to
+// in ascending key order. The tree must not be modified during iteration.
+//
+// Time complexity: O(log n + k) where k is the number of pairs yielded
+//
+// Example:
+//
+//	t := NewBTree[int, string]()
+//	// ... insert 1..10 ...
+//	for k, v := range t.Range(3, 6) {
+//	    fmt.Println(k, v)  // Keys 3, 4, 5
+//	}
+func (t *BTree[K, V]) Range(from K, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, &from, &to, yield)
+	}
+}
+
+// RangeBackward returns an iterator over the key-value pairs with
+// from <= key < to in descending key order. The tree must not be modified
+// during iteration.
+//
+// Time complexity: O(log n + k) where k is the number of pairs yielded
+func (t *BTree[K, V]) RangeBackward(from K, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.descend(t.root, &from, &to, yield)
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// pairs in ascending key order in the format of package codec.
+// Returns an error wrapping codec.ErrUnsupportedType if K or V cannot be
+// encoded.
+//
+// Time complexity: O(n)
+func (t *BTree[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq2("BTree", t.size, t.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// pairs of the tree with those encoded by MarshalBinary, keeping the
+// minimum degree.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded tree, in which case the tree is left unchanged.
+//
+// Time complexity: O(n log n)
+func (t *BTree[K, V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := codec.DecodeSlice2[K, V](data, "BTree")
+	if err != nil {
+		return err
+	}
+
+	t.restore(keys, values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (t *BTree[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq2(w, "BTree", t.size, t.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the pairs of the tree
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The tree is left
+// unchanged on error.
+//
+// Time complexity: O(n log n)
+func (t *BTree[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	keys, values, n, err := codec.ReadSlice2[K, V](r, "BTree")
+	if err != nil {
+		return n, err
+	}
+
+	t.restore(keys, values)
+	return n, nil
+}
+
+// IsEmpty returns true if the tree contains no keys.
+//
+// Time complexity: O(1)
+func (t *BTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Size returns the number of keys in the tree.
+//
+// Time complexity: O(1)
+func (t *BTree[K, V]) Size() int {
+	return t.size
+}
+
+// Degree returns the minimum degree of the tree.
+//
+// Time complexity: O(1)
+func (t *BTree[K, V]) Degree() int {
+	return t.degree
+}
+
+// CheckInvariants verifies the internal consistency of the tree: keys
+// are sorted within and across nodes, every node except the root holds
+// between degree-1 and 2*degree-1 keys, internal nodes have one child
+// more than keys, all leaves are at the same depth, and the number of
+// keys matches Size. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the tree is consistent.
+//
+// Time complexity: O(n)
+func (t *BTree[K, V]) CheckInvariants() error {
+	if t.root == nil {
+		if t.size != 0 {
+			return fmt.Errorf("%w: size is %d, tree has no root", ErrInvariantViolation, t.size)
+		}
+		return nil
+	}
+
+	leafDepth := -1
+	count, err := t.check(t.root, 0, nil, nil, &leafDepth)
+	if err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("%w: size is %d, tree holds %d keys", ErrInvariantViolation, t.size, count)
+	}
+
+	return nil
+}
+
+// Verifies the invariants of the subtree, whose keys must lie strictly
+// between from and to where those are not nil. leafDepth holds the depth
+// of the first leaf visited, -1 before that.
+// Returns the number of keys in the subtree.
+func (t *BTree[K, V]) check(n *bTreeNode[K, V], depth int, from *K, to *K, leafDepth *int) (int, error) {
+	keys := len(n.keys)
+	if n != t.root && keys < t.degree-1 || keys > t.maxKeys() {
+		return 0, fmt.Errorf("%w: node at depth %d holds %d keys", ErrInvariantViolation, depth, keys)
+	}
+	if len(n.values) != keys {
+		return 0, fmt.Errorf("%w: node at depth %d holds %d keys and %d values", ErrInvariantViolation, depth, keys, len(n.values))
+	}
+	for i, k := range n.keys {
+		if i > 0 && k <= n.keys[i-1] || from != nil && k <= *from || to != nil && k >= *to {
+			return 0, fmt.Errorf("%w: key %v is out of search order", ErrInvariantViolation, k)
+		}
+	}
+
+	if n.isLeaf() {
+		if *leafDepth == -1 {
+			*leafDepth = depth
+		} else if depth != *leafDepth {
+			return 0, fmt.Errorf("%w: leaves at depths %d and %d", ErrInvariantViolation, *leafDepth, depth)
+		}
+		return keys, nil
+	}
+
+	if len(n.children) != keys+1 {
+		return 0, fmt.Errorf("%w: node at depth %d holds %d keys and %d children", ErrInvariantViolation, depth, keys, len(n.children))
+	}
+
+	count := keys
+	for i, child := range n.children {
+		lower, upper := from, to
+		if i > 0 {
+			lower = &n.keys[i-1]
+		}
+		if i < keys {
+			upper = &n.keys[i]
+		}
+
+		c, err := t.check(child, depth+1, lower, upper, leafDepth)
+		if err != nil {
+			return 0, err
+		}
+		count += c
+	}
+
+	return count, nil
+}
+
+// Replaces the pairs with the decoded ones; a repeated key keeps its
+// last value.
+func (t *BTree[K, V]) restore(keys []K, values []V) {
+	t.Clear()
+	for i, k := range keys {
+		t.Insert(k, values[i])
+	}
+}
+
+// Returns the maximum number of keys a node can hold.
+func (t *BTree[K, V]) maxKeys() int {
+	return 2*t.degree - 1
+}
+
+// Inserts into the subtree rooted at a node that is known not to be full.
+// Returns true if the key was added, false if its value was replaced.
+func (t *BTree[K, V]) insertNonFull(n *bTreeNode[K, V], key K, value V) bool {
+	for {
+		i, found := algorithms.BinarySearch(n.keys, key, cmp.Less[K])
+		if found {
+			n.values[i] = value
+			return false
+		}
+
+		if n.isLeaf() {
+			n.keys = slices.Insert(n.keys, i, key)
+			n.values = slices.Insert(n.values, i, value)
+			return true
+		}
+
+		// Split a full child before descending so it can absorb the key
+		if len(n.children[i].keys) == t.maxKeys() {
+			t.splitChild(n, i)
+			switch cmp.Compare(key, n.keys[i]) {
+			case 0:
+				n.values[i] = value
+				return false
+			case 1:
+				i++
+			}
+		}
+
+		n = n.children[i]
+	}
+}
+
+// Splits the full child at index i around its median key,
+// moving the median up into the parent.
+func (t *BTree[K, V]) splitChild(parent *bTreeNode[K, V], i int) {
+	child := parent.children[i]
+	mid := t.degree - 1
+
+	right := &bTreeNode[K, V]{
+		keys:   slices.Clone(child.keys[mid+1:]),
+		values: slices.Clone(child.values[mid+1:]),
+	}
+	if !child.isLeaf() {
+		right.children = slices.Clone(child.children[mid+1:])
+	}
+
+	parent.keys = slices.Insert(parent.keys, i, child.keys[mid])
+	parent.values = slices.Insert(parent.values, i, child.values[mid])
+	parent.children = slices.Insert(parent.children, i+1, right)
+
+	child.keys = slices.Delete(child.keys, mid, len(child.keys))
+	child.values = slices.Delete(child.values, mid, len(child.values))
+	if !child.isLeaf() {
+		child.children = slices.Delete(child.children, mid+1, len(child.children))
+	}
+}
+
+// Deletes the key from the subtree rooted at n.
+// Every node visited below the root has at least degree keys on entry,
+// so a key can always be removed without underflow.
+func (t *BTree[K, V]) delete(n *bTreeNode[K, V], key K) bool {
+	for {
+		i, found := algorithms.BinarySearch(n.keys, key, cmp.Less[K])
+
+		if n.isLeaf() {
+			if !found {
+				return false
+			}
+
+			n.keys = slices.Delete(n.keys, i, i+1)
+			n.values = slices.Delete(n.values, i, i+1)
+			return true
+		}
+
+		if found {
+			left, right := n.children[i], n.children[i+1]
+			switch {
+			case len(left.keys) >= t.degree:
+				// Replace with the predecessor and delete it from the left subtree
+				pk, pv := t.maxOf(left)
+				n.keys[i], n.values[i] = pk, pv
+				n, key = left, pk
+			case len(right.keys) >= t.degree:
+				// Replace with the successor and delete it from the right subtree
+				sk, sv := t.minOf(right)
+				n.keys[i], n.values[i] = sk, sv
+				n, key = right, sk
+			default:
+				// Both neighbors are minimal: merge them around the key
+				t.merge(n, i)
+				n = left
+			}
+			continue
+		}
+
+		// Ensure the child we descend into can lose a key
+		if len(n.children[i].keys) < t.degree {
+			i = t.fill(n, i)
+		}
+
+		n = n.children[i]
+	}
+}
+
+// Grows the minimal child at index i to at least degree keys by borrowing
+// from a sibling or merging with one. Returns the index of the child that
+// now covers the original key range.
+func (t *BTree[K, V]) fill(parent *bTreeNode[K, V], i int) int {
+	switch {
+	case i > 0 && len(parent.children[i-1].keys) >= t.degree:
+		t.borrowFromLeft(parent, i)
+		return i
+	case i < len(parent.keys) && len(parent.children[i+1].keys) >= t.degree:
+		t.borrowFromRight(parent, i)
+		return i
+	case i < len(parent.keys):
+		t.merge(parent, i)
+		return i
+	default:
+		t.merge(parent, i-1)
+		return i - 1
+	}
+}
+
+// Rotates the last key of the left sibling through the parent into the
+// front of the child at index i.
+func (t *BTree[K, V]) borrowFromLeft(parent *bTreeNode[K, V], i int) {
+	child, sibling := parent.children[i], parent.children[i-1]
+	last := len(sibling.keys) - 1
+
+	child.keys = slices.Insert(child.keys, 0, parent.keys[i-1])
+	child.values = slices.Insert(child.values, 0, parent.values[i-1])
+	parent.keys[i-1], parent.values[i-1] = sibling.keys[last], sibling.values[last]
+	sibling.keys = sibling.keys[:last]
+	sibling.values = sibling.values[:last]
+
+	if !sibling.isLeaf() {
+		lastChild := len(sibling.children) - 1
+		child.children = slices.Insert(child.children, 0, sibling.children[lastChild])
+		sibling.children[lastChild] = nil // Help GC
+		sibling.children = sibling.children[:lastChild]
+	}
+}
+
+// Rotates the first key of the right sibling through the parent onto the
+// end of the child at index i.
+func (t *BTree[K, V]) borrowFromRight(parent *bTreeNode[K, V], i int) {
+	child, sibling := parent.children[i], parent.children[i+1]
+
+	child.keys = append(child.keys, parent.keys[i])
+	child.values = append(child.values, parent.values[i])
+	parent.keys[i], parent.values[i] = sibling.keys[0], sibling.values[0]
+	sibling.keys = slices.Delete(sibling.keys, 0, 1)
+	sibling.values = slices.Delete(sibling.values, 0, 1)
+
+	if !sibling.isLeaf() {
+		child.children = append(child.children, sibling.children[0])
+		sibling.children = slices.Delete(sibling.children, 0, 1)
+	}
+}
+
+// Merges the child at index i+1 and the separating parent key into the
+// child at index i.
+func (t *BTree[K, V]) merge(parent *bTreeNode[K, V], i int) {
+	left, right := parent.children[i], parent.children[i+1]
+
+	left.keys = append(left.keys, parent.keys[i])
+	left.keys = append(left.keys, right.keys...)
+	left.values = append(left.values, parent.values[i])
+	left.values = append(left.values, right.values...)
+	left.children = append(left.children, right.children...)
+
+	parent.keys = slices.Delete(parent.keys, i, i+1)
+	parent.values = slices.Delete(parent.values, i, i+1)
+	parent.children = slices.Delete(parent.children, i+1, i+2)
+}
+
+// Returns the smallest key and value in the subtree rooted at n.
+func (t *BTree[K, V]) minOf(n *bTreeNode[K, V]) (K, V) {
+	for !n.isLeaf() {
+		n = n.children[0]
+	}
+
+	return n.keys[0], n.values[0]
+}
+
+// Returns the largest key and value in the subtree rooted at n.
+func (t *BTree[K, V]) maxOf(n *bTreeNode[K, V]) (K, V) {
+	for !n.isLeaf() {
+		n = n.children[len(n.children)-1]
+	}
+
+	last := len(n.keys) - 1
+	return n.keys[last], n.values[last]
+}
+
+// Yields pairs of the subtree in ascending order, restricted to
+// from <= key < to when the bounds are non-nil.
+// Returns false if the consumer stopped the iteration.
+func (t *BTree[K, V]) ascend(n *bTreeNode[K, V], from *K, to *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	start := 0
+	if from != nil {
+		start = algorithms.LowerBound(n.keys, *from, cmp.Less[K])
+	}
+
+	for i := start; i < len(n.keys); i++ {
+		if !n.isLeaf() && !t.ascend(n.children[i], from, to, yield) {
+			return false
+		}
+
+		if to != nil && n.keys[i] >= *to {
+			return false // Every later key is out of range as well
+		}
+
+		if !yield(n.keys[i], n.values[i]) {
+			return false
+		}
+	}
+
+	if !n.isLeaf() {
+		return t.ascend(n.children[len(n.keys)], from, to, yield)
+	}
+
+	return true
+}
+
+// Yields pairs of the subtree in descending order, restricted to
+// from <= key < to when the bounds are non-nil.
+// Returns false if the consumer stopped the iteration.
+func (t *BTree[K, V]) descend(n *bTreeNode[K, V], from *K, to *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	// Index of the first key >= to; keys before it are below the upper bound
+	end := len(n.keys)
+	if to != nil {
+		end = algorithms.LowerBound(n.keys, *to, cmp.Less[K])
+	}
+
+	if !n.isLeaf() && !t.descend(n.children[end], from, to, yield) {
+		return false
+	}
+
+	for i := end - 1; i >= 0; i-- {
+		if from != nil && n.keys[i] < *from {
+			return false // Every earlier key is out of range as well
+		}
+
+		if !yield(n.keys[i], n.values[i]) {
+			return false
+		}
+
+		if !n.isLeaf() && !t.descend(n.children[i], from, to, yield) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,442 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewBTree/NewBTreeWithDegree):
+  ✓ Empty tree
+  ✓ Default degree
+  ✓ Invalid degree (panic)
+
+Insert/Get/Contains:
+  ✓ Get from empty tree
+  ✓ Insert new keys
+  ✓ Insert existing key replaces value
+  ✓ Root split grows the tree
+
+Delete:
+  ✓ Delete from empty tree
+  ✓ Delete absent key
+  ✓ Delete from leaf root
+  ✓ Delete all keys (ascending and descending)
+
+Min/Max:
+  ✓ Empty tree
+  ✓ Non-empty tree
+
+All/Backward/Range/RangeBackward:
+  ✓ Ascending order
+  ✓ Descending order
+  ✓ Half-open range bounds
+  ✓ Empty range
+  ✓ Early termination
+
+CheckInvariants:
+  ✓ Valid trees, wrong size, missing value, search order
+
+Randomized:
+  ✓ Mixed inserts/deletes match a map model, invariants hold (degrees 2-5)
+
+Clear:
+  ✓ Removes keys
+
+Binary encoding:
+  ✓ Round trip replaces the pairs, keeps the degree, invalid data rejected
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+*/
+
+import (
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/pairs"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Collects the keys yielded by a key-value iterator.
+func collectKeys[K any, V any](seq iter.Seq2[K, V]) []K {
+	keys := []K{}
+	for k := range seq {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Verifies every node has a legal key count, sorted keys, matching
+// children counts, and that all leaves are at the same depth.
+func checkBTree[V any](t *testing.T, tree *BTree[int, V]) {
+	t.Helper()
+	test.GotWantNoError(t, tree.CheckInvariants())
+	if tree.root == nil {
+		test.GotWant(t, tree.size, 0)
+		return
+	}
+
+	count := 0
+	leafDepth := -1
+	var walk func(n *bTreeNode[int, V], depth int, isRoot bool)
+	walk = func(n *bTreeNode[int, V], depth int, isRoot bool) {
+		count += len(n.keys)
+		if !isRoot && len(n.keys) < tree.degree-1 {
+			t.Errorf("node underflow: %d keys", len(n.keys))
+		}
+		if len(n.keys) > 2*tree.degree-1 {
+			t.Errorf("node overflow: %d keys", len(n.keys))
+		}
+		if !slices.IsSorted(n.keys) {
+			t.Errorf("node keys not sorted: %v", n.keys)
+		}
+		if len(n.values) != len(n.keys) {
+			t.Errorf("got %d values for %d keys", len(n.values), len(n.keys))
+		}
+		if n.isLeaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			} else if leafDepth != depth {
+				t.Errorf("leaves at depths %d and %d", leafDepth, depth)
+			}
+			return
+		}
+		if len(n.children) != len(n.keys)+1 {
+			t.Errorf("got %d children for %d keys", len(n.children), len(n.keys))
+		}
+		for _, c := range n.children {
+			walk(c, depth+1, false)
+		}
+	}
+	walk(tree.root, 0, true)
+	test.GotWant(t, count, tree.size)
+}
+
+// Verifies the creation of an empty tree
+func TestBTree_NewBTree_Empty(t *testing.T) {
+	tree := NewBTree[int, string]()
+	test.GotWant(t, tree.Size(), 0)
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, tree.Degree(), 32)
+}
+
+// Verifies degrees below 2 are rejected
+func TestBTree_NewBTreeWithDegree_InvalidDegree(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewBTreeWithDegree[int, int](1)
+	}, `"degree" must be > 1, got 1`)
+}
+
+// Verifies getting from an empty tree
+func TestBTree_Get_EmptyTree(t *testing.T) {
+	tree := NewBTree[int, string]()
+	v, ok := tree.Get(1)
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, "")
+	test.GotWant(t, tree.Contains(1), false)
+}
+
+// Verifies inserting new keys
+func TestBTree_Insert_NewKeys(t *testing.T) {
+	tree := NewBTreeWithDegree[int, string](2)
+	test.GotWant(t, tree.Insert(2, "two"), true)
+	test.GotWant(t, tree.Insert(1, "one"), true)
+	test.GotWant(t, tree.Insert(3, "three"), true)
+	test.GotWant(t, tree.Size(), 3)
+
+	v, ok := tree.Get(1)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, "one")
+	test.GotWant(t, tree.Contains(3), true)
+	test.GotWant(t, tree.Contains(4), false)
+}
+
+// Verifies inserting an existing key replaces its value
+func TestBTree_Insert_ExistingKey(t *testing.T) {
+	tree := NewBTreeWithDegree[int, string](2)
+	for i := range 20 {
+		tree.Insert(i, "old")
+	}
+	for i := range 20 {
+		test.GotWant(t, tree.Insert(i, "new"), false)
+	}
+
+	test.GotWant(t, tree.Size(), 20)
+	for i := range 20 {
+		v, _ := tree.Get(i)
+		test.GotWant(t, v, "new")
+	}
+	checkBTree(t, tree)
+}
+
+// Verifies the root splits once full and the tree stays balanced
+func TestBTree_Insert_RootSplit(t *testing.T) {
+	tree := NewBTreeWithDegree[int, int](2)
+	for i := range 3 {
+		tree.Insert(i, i)
+	}
+	test.GotWant(t, tree.root.isLeaf(), true)
+
+	tree.Insert(3, 3)
+	test.GotWant(t, tree.root.isLeaf(), false)
+	test.GotWantSlice(t, tree.root.keys, []int{1})
+	checkBTree(t, tree)
+}
+
+// Verifies deleting from an empty tree
+func TestBTree_Delete_EmptyTree(t *testing.T) {
+	tree := NewBTree[int, int]()
+	test.GotWant(t, tree.Delete(1), false)
+}
+
+// Verifies deleting an absent key leaves the tree unchanged
+func TestBTree_Delete_AbsentKey(t *testing.T) {
+	tree := NewBTreeWithDegree[int, int](2)
+	for i := range 10 {
+		tree.Insert(i*2, i)
+	}
+	test.GotWant(t, tree.Delete(5), false)
+	test.GotWant(t, tree.Size(), 10)
+	checkBTree(t, tree)
+}
+
+// Verifies deleting from a tree that is a single leaf
+func TestBTree_Delete_LeafRoot(t *testing.T) {
+	tree := NewBTree[int, int]()
+	tree.Insert(1, 1)
+	tree.Insert(2, 2)
+	test.GotWant(t, tree.Delete(1), true)
+	test.GotWant(t, tree.Contains(1), false)
+	test.GotWant(t, tree.Delete(2), true)
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, tree.root == nil, true)
+}
+
+// Verifies deleting every key in ascending and descending order
+func TestBTree_Delete_All(t *testing.T) {
+	orders := map[string]func(i int) int{
+		"ascending":  func(i int) int { return i },
+		"descending": func(i int) int { return 99 - i },
+	}
+
+	for name, key := range orders {
+		t.Run(name, func(t *testing.T) {
+			tree := NewBTreeWithDegree[int, int](2)
+			for i := range 100 {
+				tree.Insert(i, i)
+			}
+			for i := range 100 {
+				test.GotWant(t, tree.Delete(key(i)), true)
+				checkBTree(t, tree)
+			}
+			test.GotWant(t, tree.IsEmpty(), true)
+		})
+	}
+}
+
+// Verifies Min and Max on an empty tree
+func TestBTree_MinMax_EmptyTree(t *testing.T) {
+	tree := NewBTree[int, string]()
+	_, _, minOk := tree.Min()
+	_, _, maxOk := tree.Max()
+	test.GotWant(t, minOk, false)
+	test.GotWant(t, maxOk, false)
+}
+
+// Verifies Min and Max on a non-empty tree
+func TestBTree_MinMax_NonEmptyTree(t *testing.T) {
+	tree := NewBTreeWithDegree[int, int](2)
+	for _, k := range []int{5, 3, 9, 1, 7} {
+		tree.Insert(k, k*10)
+	}
+	k, v, ok := tree.Min()
+	test.GotWant(t, ok, true)
+	test.GotWant(t, k, 1)
+	test.GotWant(t, v, 10)
+	k, v, ok = tree.Max()
+	test.GotWant(t, ok, true)
+	test.GotWant(t, k, 9)
+	test.GotWant(t, v, 90)
+}
+
+// Verifies All yields keys in ascending order and Backward in descending order
+func TestBTree_All_Order(t *testing.T) {
+	tree := NewBTreeWithDegree[int, int](2)
+	for _, k := range rand.Perm(50) {
+		tree.Insert(k, k)
+	}
+
+	want := make([]int, 50)
+	for i := range want {
+		want[i] = i
+	}
+	test.GotWantSlice(t, collectKeys(tree.All()), want)
+	slices.Reverse(want)
+	test.GotWantSlice(t, collectKeys(tree.Backward()), want)
+}
+
+// Verifies Range and RangeBackward respect half-open bounds
+func TestBTree_Range_Bounds(t *testing.T) {
+	tree := NewBTreeWithDegree[int, int](2)
+	for i := range 50 {
+		tree.Insert(i*2, i)
+	}
+
+	test.GotWantSlice(t, collectKeys(tree.Range(10, 20)), []int{10, 12, 14, 16, 18})
+	test.GotWantSlice(t, collectKeys(tree.Range(11, 19)), []int{12, 14, 16, 18})
+	test.GotWantSlice(t, collectKeys(tree.RangeBackward(10, 20)), []int{18, 16, 14, 12, 10})
+	test.GotWantSlice(t, collectKeys(tree.RangeBackward(11, 19)), []int{18, 16, 14, 12})
+	test.GotWantSlice(t, collectKeys(tree.Range(-10, 3)), []int{0, 2})
+	test.GotWantSlice(t, collectKeys(tree.RangeBackward(95, 200)), []int{98, 96})
+}
+
+// Verifies empty ranges yield nothing
+func TestBTree_Range_Empty(t *testing.T) {
+	tree := NewBTreeWithDegree[int, int](2)
+	for i := range 20 {
+		tree.Insert(i*2, i)
+	}
+
+	test.GotWant(t, len(collectKeys(tree.Range(5, 5))), 0)
+	test.GotWant(t, len(collectKeys(tree.Range(7, 3))), 0)
+	test.GotWant(t, len(collectKeys(tree.Range(100, 200))), 0)
+	test.GotWant(t, len(collectKeys(tree.RangeBackward(-5, 0))), 0)
+	test.GotWant(t, len(collectKeys(NewBTree[int, int]().Range(0, 10))), 0)
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestBTree_All_EarlyTermination(t *testing.T) {
+	tree := NewBTreeWithDegree[int, int](2)
+	for i := range 50 {
+		tree.Insert(i, i)
+	}
+
+	got := []int{}
+	for k := range tree.All() {
+		got = append(got, k)
+		if len(got) == 3 {
+			break
+		}
+	}
+	for k := range tree.RangeBackward(10, 40) {
+		got = append(got, k)
+		if len(got) == 5 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []int{0, 1, 2, 39, 38})
+}
+
+// Verifies CheckInvariants accepts valid trees and reports a wrong size,
+// an unsorted node, a missing value and uneven leaf depths
+func TestBTree_CheckInvariants(t *testing.T) {
+	tree := NewBTreeWithDegree[int, int](2)
+	test.GotWantNoError(t, tree.CheckInvariants())
+	tree.size = 1
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: size is 1, tree has no root")
+	tree.size = 0
+
+	for k := range 10 {
+		tree.Insert(k, k)
+	}
+	test.GotWantNoError(t, tree.CheckInvariants())
+
+	tree.size = 11
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: size is 11, tree holds 10 keys")
+	tree.size = 10
+
+	leaf := tree.root.children[0]
+	for len(leaf.children) > 0 {
+		leaf = leaf.children[0]
+	}
+	leaf.values = leaf.values[:len(leaf.values)-1]
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: node at depth 2 holds 1 keys and 0 values")
+	leaf.values = leaf.values[:len(leaf.values)+1]
+
+	leaf.keys[0] = 100
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: key 100 is out of search order")
+	leaf.keys[0] = 0
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies random inserts and deletes match a map model and keep the
+// tree invariants for several degrees
+func TestBTree_Randomized(t *testing.T) {
+	for degree := 2; degree <= 5; degree++ {
+		r := rand.New(rand.NewPCG(uint64(degree), 1))
+		tree := NewBTreeWithDegree[int, int](degree)
+		model := map[int]int{}
+
+		for i := range 3000 {
+			k := r.IntN(300)
+			if r.IntN(3) == 0 {
+				_, exists := model[k]
+				test.GotWant(t, tree.Delete(k), exists)
+				delete(model, k)
+			} else {
+				_, exists := model[k]
+				test.GotWant(t, tree.Insert(k, i), !exists)
+				model[k] = i
+			}
+		}
+
+		checkBTree(t, tree)
+		test.GotWant(t, tree.Size(), len(model))
+		for k, want := range model {
+			v, ok := tree.Get(k)
+			test.GotWant(t, ok, true)
+			test.GotWant(t, v, want)
+		}
+
+		keys := make([]int, 0, len(model))
+		for k := range model {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		test.GotWantSlice(t, collectKeys(tree.All()), keys)
+	}
+}
+
+// Verifies Clear empties the tree and it stays usable
+func TestBTree_Clear(t *testing.T) {
+	tree := NewBTree[int, int]()
+	for i := range 1000 {
+		tree.Insert(i, i)
+	}
+
+	tree.Clear()
+	test.GotWant(t, tree.Size(), 0)
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, tree.Contains(500), false)
+
+	tree.Insert(500, 1)
+	test.GotWant(t, tree.Contains(500), true)
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies a round trip restores the pairs into a tree of another degree
+// and invalid data is rejected
+func TestBTree_MarshalBinary(t *testing.T) {
+	src := NewBTree[int, string]()
+	for i := range 200 {
+		src.Insert(i, fmt.Sprint(i))
+	}
+	other, _ := NewSplayTree[int, string]().MarshalBinary()
+	dst := NewBTreeWithDegree[int, string](2)
+	dst.Insert(-1, "x")
+	codectest.RoundTrip(t, src, dst,
+		func(tree *BTree[int, string]) []pairs.Pair[int, string] { return pairs.Collect(tree.All()) }, other)
+	test.GotWant(t, dst.degree, 2)
+	test.GotWantNoError(t, dst.CheckInvariants())
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the pairs in key order
+func TestBTree_WriteTo_ReadFrom(t *testing.T) {
+	src := NewBTree[int, int]()
+	for i := range 100 {
+		src.Insert(i, -i)
+	}
+	dst := NewBTree[int, int]()
+	codectest.StreamRoundTrip(t, src, dst,
+		func(tree *BTree[int, int]) []pairs.Pair[int, int] { return pairs.Collect(tree.All()) })
+}
@@ -0,0 +1,580 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"math/bits"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/hash"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &HAMT[int, int]{}
+var _ collections.Iterable2[int, int] = &HAMT[int, int]{}
+
+// Number of hash bits consumed at each level of a HAMT.
+const hamtBits = 5
+
+// Represents a key-value pair stored in a HAMT together with its hash.
+type hamtLeaf[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint64
+}
+
+// Represents a slot in a HAMT node: a subtree if node is set, otherwise
+// a single leaf.
+type hamtEntry[K comparable, V any] struct {
+	node *hamtNode[K, V]
+	leaf hamtLeaf[K, V]
+}
+
+// Represents a single node in a HAMT.
+// Bit i of the bitmap is set when the slot for hash chunk i is occupied;
+// entries holds only the occupied slots in chunk order. Nodes below the
+// last hash level store keys with identical hashes in collisions instead.
+type hamtNode[K comparable, V any] struct {
+	bitmap     uint32
+	entries    []hamtEntry[K, V]
+	collisions []hamtLeaf[K, V]
+}
+
+// HAMT implements a persistent hash array mapped trie mapping comparable
+// keys to values.
+//
+// Each level of the trie consumes 5 bits of the key's 64-bit hash and
+// selects one of up to 32 slots, so lookups touch at most 13 nodes. Nodes
+// store only their occupied slots, located through a bitmap and popcount,
+// which keeps sparse levels small.
+//
+// The trie is immutable: Put and Delete return a new version that shares
+// every untouched node with the receiver, copying only the O(log32 n)
+// nodes on the path to the changed key. Old versions stay valid and
+// unchanged, which makes snapshots free and lets any number of goroutines
+// read a version without locking.
+//
+// Design decisions:
+//   - Path copying: Updates never modify nodes reachable from old versions
+//   - Per-trie hash seed: All versions derived from one trie hash alike
+//   - Pluggable hasher: WithHasher replaces the default maphash-based
+//     hash function
+//   - Collision nodes: Keys whose full hashes collide share a linear list
+//   - Compaction on delete: Subtrees left with a single leaf are inlined
+//     into their parent, so deleting keys shrinks the trie again
+//
+// Space complexity: O(n) where n is the number of keys.
+type HAMT[K comparable, V any] struct {
+	root *hamtNode[K, V]
+	size int
+	hash func(key K) uint64
+}
+
+// NewHAMT creates an empty HAMT with a random hash seed.
+//
+// Example:
+//
+//	t := NewHAMT[string, int]()
+//	u := t.Put("a", 1)
+//	t.Size()  // Returns 0
+//	u.Size()  // Returns 1
+func NewHAMT[K comparable, V any]() *HAMT[K, V] {
+	return NewHAMTWith[K, V]()
+}
+
+// NewHAMTWith creates an empty HAMT adjusted by the given options.
+// Options are applied in order.
+//
+// Example:
+//
+//	t := NewHAMTWith[string, int](WithHasher(hash.NewString()))
+func NewHAMTWith[K comparable, V any](opts ...HAMTOption[K]) *HAMT[K, V] {
+	var s hamtSettings[K]
+	for _, opt := range opts {
+		opt(&s)
+	}
+	if s.hasher == nil {
+		s.hasher = hash.NewComparable[K]()
+	}
+
+	return &HAMT[K, V]{
+		root: &hamtNode[K, V]{},
+		hash: s.hasher.Hash,
+	}
+}
+
+// NewHAMTWithHasher creates an empty HAMT that hashes keys with the given
+// hasher. See hash.Hasher for the requirements.
+//
+// Deprecated: Use NewHAMTWith with WithHasher.
+func NewHAMTWithHasher[K comparable, V any](hasher hash.Hasher[K]) *HAMT[K, V] {
+	return NewHAMTWith[K, V](WithHasher(hasher))
+}
+
+// Put returns a new version of the trie in which the key maps to the
+// value. The receiver is unchanged.
+//
+// Time complexity: O(log32 n)
+func (t *HAMT[K, V]) Put(key K, value V) *HAMT[K, V] {
+	leaf := hamtLeaf[K, V]{key: key, value: value, hash: t.hash(key)}
+	root, added := t.put(t.root, leaf, 0)
+
+	size := t.size
+	if added {
+		size++
+	}
+
+	return &HAMT[K, V]{root: root, size: size, hash: t.hash}
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not found.
+//
+// Time complexity: O(log32 n)
+func (t *HAMT[K, V]) Get(key K) (V, bool) {
+	hash := t.hash(key)
+	n := t.root
+	for shift := 0; ; shift += hamtBits {
+		if shift >= 64 {
+			for _, leaf := range n.collisions {
+				if leaf.key == key {
+					return leaf.value, true
+				}
+			}
+			break
+		}
+
+		bit := uint32(1) << ((hash >> shift) & 31)
+		if n.bitmap&bit == 0 {
+			break
+		}
+
+		e := n.entries[n.position(bit)]
+		if e.node == nil {
+			if e.leaf.key == key {
+				return e.leaf.value, true
+			}
+			break
+		}
+		n = e.node
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Contains returns true if the key exists in the trie.
+//
+// Time complexity: O(log32 n)
+func (t *HAMT[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete returns a new version of the trie without the key. The receiver
+// is unchanged. If the key is absent the receiver itself is returned.
+//
+// Time complexity: O(log32 n)
+func (t *HAMT[K, V]) Delete(key K) *HAMT[K, V] {
+	root, removed := t.delete(t.root, key, t.hash(key), 0)
+	if !removed {
+		return t
+	}
+
+	if root == nil {
+		root = &hamtNode[K, V]{}
+	}
+
+	return &HAMT[K, V]{root: root, size: t.size - 1, hash: t.hash}
+}
+
+// All returns an iterator over all key-value pairs in unspecified but
+// stable order: a given version always yields its pairs in the same order.
+//
+// Time complexity: O(n) for a full iteration
+func (t *HAMT[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.walk(t.root, yield)
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// pairs in iteration order in the format of package codec. The hasher is
+// not encoded.
+// Returns an error wrapping codec.ErrUnsupportedType if K or V cannot be
+// encoded.
+//
+// Time complexity: O(n)
+func (t *HAMT[K, V]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq2("HAMT", t.size, t.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// version held by the receiver with one holding the pairs encoded by
+// MarshalBinary, hashed with the receiver's hasher. This is the one
+// method that changes a HAMT in place, as decoding must; versions derived
+// from the receiver earlier share no state with the result and are
+// unaffected. The receiver must come from a constructor.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded trie, in which case the receiver is left unchanged.
+//
+// Time complexity: O(n log32 n)
+func (t *HAMT[K, V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := codec.DecodeSlice2[K, V](data, "HAMT")
+	if err != nil {
+		return err
+	}
+
+	t.restore(keys, values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (t *HAMT[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq2(w, "HAMT", t.size, t.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the version held by the
+// receiver with one holding the pairs read from r, as UnmarshalBinary
+// does. r must hold a single encoding of MarshalBinary or WriteTo and
+// nothing after it.
+// Returns the number of bytes read and the first error. The receiver is
+// left unchanged on error.
+//
+// Time complexity: O(n log32 n)
+func (t *HAMT[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	keys, values, n, err := codec.ReadSlice2[K, V](r, "HAMT")
+	if err != nil {
+		return n, err
+	}
+
+	t.restore(keys, values)
+	return n, nil
+}
+
+// Snapshot returns an immutable point-in-time view of the trie: a version
+// that shares every node with the receiver. Unlike the receiver, which
+// UnmarshalBinary and ReadFrom overwrite in place, the snapshot keeps its
+// pairs for good.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	t := NewHAMT[string, int]().Put("a", 1)
+//	snap := t.Snapshot()
+//	t.UnmarshalBinary(data)  // snap still holds only "a"
+func (t *HAMT[K, V]) Snapshot() *HAMT[K, V] {
+	snap := *t
+	return &snap
+}
+
+// IsEmpty returns true if the trie contains no keys.
+//
+// Time complexity: O(1)
+func (t *HAMT[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Size returns the number of keys in the trie.
+//
+// Time complexity: O(1)
+func (t *HAMT[K, V]) Size() int {
+	return t.size
+}
+
+// CheckInvariants verifies the structure of the trie: every bitmap has one
+// bit per entry, every leaf sits on the path of its stored hash, which is
+// the hash of its key, no subtree below the root is empty or holds a
+// single leaf that should have been inlined, collision nodes appear only
+// below the last hash level with distinct keys, and the number of pairs
+// matches Size. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the trie is consistent.
+//
+// Time complexity: O(n)
+func (t *HAMT[K, V]) CheckInvariants() error {
+	if t.root == nil {
+		return fmt.Errorf("%w: root is nil", ErrInvariantViolation)
+	}
+
+	count, err := t.checkNode(t.root, 0, 0)
+	if err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("%w: size is %d, trie holds %d pairs", ErrInvariantViolation, t.size, count)
+	}
+
+	return nil
+}
+
+// Replaces the version with a new one holding the decoded pairs; a
+// repeated key keeps its last value.
+func (t *HAMT[K, V]) restore(keys []K, values []V) {
+	u := &HAMT[K, V]{root: &hamtNode[K, V]{}, hash: t.hash}
+	for i, k := range keys {
+		u = u.Put(k, values[i])
+	}
+
+	*t = *u
+}
+
+// Returns the index in entries of the occupied slot with the given bit.
+func (n *hamtNode[K, V]) position(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+// Returns a copy of the node with its own entries and collisions slices.
+func (n *hamtNode[K, V]) clone() *hamtNode[K, V] {
+	return &hamtNode[K, V]{
+		bitmap:     n.bitmap,
+		entries:    append([]hamtEntry[K, V](nil), n.entries...),
+		collisions: append([]hamtLeaf[K, V](nil), n.collisions...),
+	}
+}
+
+// Returns a copy of the subtree with the leaf stored, and whether its key
+// was not present before.
+func (t *HAMT[K, V]) put(n *hamtNode[K, V], leaf hamtLeaf[K, V], shift int) (*hamtNode[K, V], bool) {
+	c := n.clone()
+	if shift >= 64 {
+		for i := range c.collisions {
+			if c.collisions[i].key == leaf.key {
+				c.collisions[i] = leaf
+				return c, false
+			}
+		}
+		c.collisions = append(c.collisions, leaf)
+		return c, true
+	}
+
+	bit := uint32(1) << ((leaf.hash >> shift) & 31)
+	pos := n.position(bit)
+	if n.bitmap&bit == 0 {
+		c.bitmap |= bit
+		c.entries = append(c.entries[:pos], append([]hamtEntry[K, V]{{leaf: leaf}}, c.entries[pos:]...)...)
+		return c, true
+	}
+
+	e := n.entries[pos]
+	switch {
+	case e.node != nil:
+		child, added := t.put(e.node, leaf, shift+hamtBits)
+		c.entries[pos] = hamtEntry[K, V]{node: child}
+		return c, added
+	case e.leaf.key == leaf.key:
+		c.entries[pos] = hamtEntry[K, V]{leaf: leaf}
+		return c, false
+	default:
+		c.entries[pos] = hamtEntry[K, V]{node: t.pair(e.leaf, leaf, shift+hamtBits)}
+		return c, true
+	}
+}
+
+// Returns a new subtree holding two leaves with distinct keys, nesting
+// until their hash chunks differ or the hash is exhausted.
+func (t *HAMT[K, V]) pair(a hamtLeaf[K, V], b hamtLeaf[K, V], shift int) *hamtNode[K, V] {
+	if shift >= 64 {
+		return &hamtNode[K, V]{collisions: []hamtLeaf[K, V]{a, b}}
+	}
+
+	ia, ib := (a.hash>>shift)&31, (b.hash>>shift)&31
+	if ia == ib {
+		return &hamtNode[K, V]{
+			bitmap:  uint32(1) << ia,
+			entries: []hamtEntry[K, V]{{node: t.pair(a, b, shift+hamtBits)}},
+		}
+	}
+
+	if ib < ia {
+		a, b, ia, ib = b, a, ib, ia
+	}
+
+	return &hamtNode[K, V]{
+		bitmap:  uint32(1)<<ia | uint32(1)<<ib,
+		entries: []hamtEntry[K, V]{{leaf: a}, {leaf: b}},
+	}
+}
+
+// Returns a copy of the subtree without the key, or nil if the subtree
+// becomes empty, and whether the key was found. Unchanged subtrees are
+// returned as is.
+func (t *HAMT[K, V]) delete(n *hamtNode[K, V], key K, hash uint64, shift int) (*hamtNode[K, V], bool) {
+	if shift >= 64 {
+		for i, leaf := range n.collisions {
+			if leaf.key == key {
+				if len(n.collisions) == 1 {
+					return nil, true
+				}
+				c := n.clone()
+				c.collisions = append(c.collisions[:i], c.collisions[i+1:]...)
+				return c, true
+			}
+		}
+		return n, false
+	}
+
+	bit := uint32(1) << ((hash >> shift) & 31)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	pos := n.position(bit)
+	e := n.entries[pos]
+	var replacement *hamtEntry[K, V]
+	if e.node == nil {
+		if e.leaf.key != key {
+			return n, false
+		}
+	} else {
+		child, removed := t.delete(e.node, key, hash, shift+hamtBits)
+		if !removed {
+			return n, false
+		}
+
+		if child != nil {
+			replacement = &hamtEntry[K, V]{node: child}
+			if leaf, ok := child.single(); ok {
+				replacement = &hamtEntry[K, V]{leaf: leaf}
+			}
+		}
+	}
+
+	if replacement == nil && len(n.entries) == 1 {
+		return nil, true
+	}
+
+	c := n.clone()
+	if replacement != nil {
+		c.entries[pos] = *replacement
+	} else {
+		c.bitmap &^= bit
+		c.entries = append(c.entries[:pos], c.entries[pos+1:]...)
+	}
+
+	return c, true
+}
+
+// Returns the only leaf of a node that holds exactly one leaf and no
+// subtrees, so the parent can store the leaf directly.
+func (n *hamtNode[K, V]) single() (hamtLeaf[K, V], bool) {
+	if len(n.collisions) == 1 {
+		return n.collisions[0], true
+	}
+
+	if len(n.entries) == 1 && n.entries[0].node == nil {
+		return n.entries[0].leaf, true
+	}
+
+	var zero hamtLeaf[K, V]
+	return zero, false
+}
+
+// Visits every pair of the subtree, depth first in slot order.
+// Returns false if iteration was stopped by the consumer.
+func (t *HAMT[K, V]) walk(n *hamtNode[K, V], yield func(K, V) bool) bool {
+	for _, leaf := range n.collisions {
+		if !yield(leaf.key, leaf.value) {
+			return false
+		}
+	}
+
+	for _, e := range n.entries {
+		if e.node != nil {
+			if !t.walk(e.node, yield) {
+				return false
+			}
+		} else if !yield(e.leaf.key, e.leaf.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Checks the subtree whose hashes start with the low shift bits of prefix.
+// Returns the number of pairs in the subtree.
+func (t *HAMT[K, V]) checkNode(n *hamtNode[K, V], prefix uint64, shift int) (int, error) {
+	depth := shift / hamtBits
+	if shift >= 64 {
+		if n.bitmap != 0 || len(n.entries) != 0 {
+			return 0, fmt.Errorf("%w: collision node at depth %d has slots", ErrInvariantViolation, depth)
+		}
+		if len(n.collisions) < 2 {
+			return 0, fmt.Errorf("%w: collision node at depth %d holds %d keys, want at least 2", ErrInvariantViolation, depth, len(n.collisions))
+		}
+		for i, leaf := range n.collisions {
+			if err := t.checkLeaf(leaf, prefix, shift); err != nil {
+				return 0, err
+			}
+			for _, other := range n.collisions[:i] {
+				if other.key == leaf.key {
+					return 0, fmt.Errorf("%w: key %v is stored twice", ErrInvariantViolation, leaf.key)
+				}
+			}
+		}
+		return len(n.collisions), nil
+	}
+
+	if len(n.collisions) != 0 {
+		return 0, fmt.Errorf("%w: node at depth %d holds collisions above the last hash level", ErrInvariantViolation, depth)
+	}
+	if set := bits.OnesCount32(n.bitmap); set != len(n.entries) {
+		return 0, fmt.Errorf("%w: node at depth %d has %d bits set for %d entries", ErrInvariantViolation, depth, set, len(n.entries))
+	}
+	if n != t.root {
+		if len(n.entries) == 0 {
+			return 0, fmt.Errorf("%w: subtree at depth %d is empty", ErrInvariantViolation, depth)
+		}
+		if _, ok := n.single(); ok {
+			return 0, fmt.Errorf("%w: subtree at depth %d holds a single leaf, not inlined", ErrInvariantViolation, depth)
+		}
+	}
+
+	count := 0
+	bitmap := n.bitmap
+	for _, e := range n.entries {
+		path := prefix | uint64(bits.TrailingZeros32(bitmap))<<shift
+		bitmap &= bitmap - 1
+		if e.node == nil {
+			if err := t.checkLeaf(e.leaf, path, shift+hamtBits); err != nil {
+				return 0, err
+			}
+			count++
+			continue
+		}
+
+		c, err := t.checkNode(e.node, path, shift+hamtBits)
+		if err != nil {
+			return 0, err
+		}
+		count += c
+	}
+
+	return count, nil
+}
+
+// Checks that the leaf stores the hash of its key and that the low shift
+// bits of the hash match the path the leaf is stored under.
+func (t *HAMT[K, V]) checkLeaf(leaf hamtLeaf[K, V], path uint64, shift int) error {
+	if h := t.hash(leaf.key); h != leaf.hash {
+		return fmt.Errorf("%w: key %v is stored with hash %#x, hashes to %#x", ErrInvariantViolation, leaf.key, leaf.hash, h)
+	}
+
+	mask := ^uint64(0)
+	if shift < 64 {
+		mask = uint64(1)<<shift - 1
+	}
+	if leaf.hash&mask != path {
+		return fmt.Errorf("%w: key %v is stored off the path of its hash", ErrInvariantViolation, leaf.key)
+	}
+
+	return nil
+}
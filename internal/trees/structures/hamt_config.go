@@ -0,0 +1,29 @@
+package structures
+
+import "github.com/apotourlyan/godatastructures/internal/hash"
+
+// HAMTOption configures a HAMT created with NewHAMTWith. Options are
+// applied in order.
+//
+// Unlike the options of the other trees, HAMTOption is generic in the key
+// type, since its only option carries a hasher for that type.
+type HAMTOption[K comparable] func(*hamtSettings[K])
+
+// Settings collected from the options of a HAMT.
+type hamtSettings[K comparable] struct {
+	hasher hash.Hasher[K] // nil selects the default
+}
+
+// WithHasher hashes keys with the given hasher instead of the default
+// maphash-based one. Every bit of the hash selects a path, so a hasher
+// that spreads keys over all 64 bits keeps the trie shallow. See
+// hash.Hasher for the requirements.
+//
+// Example:
+//
+//	t := NewHAMTWith[string, int](WithHasher(hash.NewString()))
+func WithHasher[K comparable](hasher hash.Hasher[K]) HAMTOption[K] {
+	return func(s *hamtSettings[K]) {
+		s.hasher = hasher
+	}
+}
@@ -0,0 +1,351 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewHAMT/NewHAMTWith):
+  ✓ Empty trie
+
+Put/Get/Contains:
+  ✓ Add new keys and replace existing values
+  ✓ Missing keys
+  ✓ Old versions are unchanged
+
+Delete:
+  ✓ Present and absent keys
+  ✓ Absent key returns the receiver
+  ✓ Deleting every key empties the trie
+
+Collisions:
+  ✓ Keys with identical hashes
+  ✓ Keys sharing hash prefixes nest and compact again
+
+All:
+  ✓ Yields every pair, early termination
+
+CheckInvariants:
+  ✓ Valid tries, wrong size, bitmap out of step, leaf off its hash path,
+    stale hash, subtree not inlined, duplicate colliding key
+
+Randomized:
+  ✓ Every version matches its map snapshot, structure stays compact
+
+Binary encoding:
+  ✓ Round trip replaces the receiver's version, earlier versions intact,
+    invalid data rejected
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+
+Snapshot:
+  ✓ Shares the version, survives decoding into the receiver
+
+Options (NewHAMTWith):
+  ✓ No options selects the default hasher
+  ✓ WithHasher replaces the hash function
+  ✓ Deprecated NewHAMTWithHasher still applies its hasher
+*/
+
+import (
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/hash"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty trie
+func TestHAMT_NewHAMT_Empty(t *testing.T) {
+	h := NewHAMT[string, int]()
+	test.GotWant(t, h.Size(), 0)
+	test.GotWant(t, h.IsEmpty(), true)
+	test.GotWant(t, h.Contains("a"), false)
+}
+
+// Verifies adding keys and replacing values
+func TestHAMT_Put(t *testing.T) {
+	h := NewHAMT[string, int]().Put("a", 1).Put("b", 2).Put("a", 3)
+	test.GotWant(t, h.Size(), 2)
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	v, ok := h.Get("a")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 3)
+	v, ok = h.Get("c")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, 0)
+}
+
+// Verifies updates leave earlier versions unchanged
+func TestHAMT_Put_Persistence(t *testing.T) {
+	v1 := NewHAMT[int, string]().Put(1, "one")
+	v2 := v1.Put(2, "two")
+	v3 := v2.Put(1, "uno")
+	v4 := v3.Delete(2)
+
+	test.GotWant(t, v1.Size(), 1)
+	test.GotWant(t, v1.Contains(2), false)
+	test.GotWant(t, v2.Size(), 2)
+	got, _ := v2.Get(1)
+	test.GotWant(t, got, "one")
+	got, _ = v3.Get(1)
+	test.GotWant(t, got, "uno")
+	test.GotWant(t, v3.Contains(2), true)
+	test.GotWant(t, v4.Contains(2), false)
+	test.GotWant(t, v4.Size(), 1)
+}
+
+// Verifies deleting present and absent keys
+func TestHAMT_Delete(t *testing.T) {
+	h := NewHAMT[int, int]()
+	for i := range 100 {
+		h = h.Put(i, i*i)
+	}
+
+	same := h.Delete(1000)
+	test.GotWant(t, same, h)
+
+	for i := 0; i < 100; i += 2 {
+		h = h.Delete(i)
+	}
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWant(t, h.Size(), 50)
+	for i := range 100 {
+		test.GotWant(t, h.Contains(i), i%2 == 1)
+	}
+}
+
+// Verifies deleting every key leaves an empty trie that can be reused
+func TestHAMT_Delete_All(t *testing.T) {
+	h := NewHAMT[int, int]()
+	for i := range 50 {
+		h = h.Put(i, i)
+	}
+	for i := range 50 {
+		h = h.Delete(i)
+	}
+	test.GotWant(t, h.IsEmpty(), true)
+	test.GotWant(t, len(h.root.entries), 0)
+
+	h = h.Put(7, 7)
+	test.GotWant(t, h.Contains(7), true)
+}
+
+// Verifies keys with identical hashes are stored and removed correctly
+func TestHAMT_Collisions_FullHash(t *testing.T) {
+	h := NewHAMTWith[int, int](WithHasher(hash.Func[int](func(int) uint64 { return 42 })))
+	for i := range 5 {
+		h = h.Put(i, i*10)
+	}
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWant(t, h.Size(), 5)
+	for i := range 5 {
+		v, ok := h.Get(i)
+		test.GotWant(t, ok, true)
+		test.GotWant(t, v, i*10)
+	}
+	test.GotWant(t, h.Contains(5), false)
+
+	h = h.Put(2, -2)
+	v, _ := h.Get(2)
+	test.GotWant(t, v, -2)
+	test.GotWant(t, h.Size(), 5)
+
+	for i := range 4 {
+		h = h.Delete(i)
+		test.GotWantNoError(t, h.CheckInvariants())
+	}
+	test.GotWant(t, h.Size(), 1)
+	test.GotWant(t, h.Contains(4), true)
+	test.GotWant(t, h.root.entries[0].node, nil) // Compacted to the root
+}
+
+// Verifies keys sharing long hash prefixes nest deeply and compact again
+// when deleted
+func TestHAMT_Collisions_SharedPrefix(t *testing.T) {
+	// Hashes differ only in the top bits
+	h := NewHAMTWith[uint64, bool](WithHasher(hash.Func[uint64](func(k uint64) uint64 { return k << 58 })))
+	h = h.Put(1, true).Put(2, true).Put(3, true)
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	h = h.Delete(2).Delete(3)
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWant(t, h.Size(), 1)
+	test.GotWant(t, h.root.entries[0].node, nil)
+	test.GotWant(t, h.Contains(1), true)
+}
+
+// Verifies All yields every pair and stops when the consumer breaks
+func TestHAMT_All(t *testing.T) {
+	h := NewHAMT[int, int]()
+	want := map[int]int{}
+	for i := range 200 {
+		h = h.Put(i, -i)
+		want[i] = -i
+	}
+	test.GotWant(t, maps.Equal(maps.Collect(h.All()), want), true)
+
+	count := 0
+	for range h.All() {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	test.GotWant(t, count, 3)
+}
+
+// Verifies CheckInvariants accepts valid tries and reports each kind of
+// corruption. Nodes are shared between versions, so each corrupted field
+// is restored before the next case.
+func TestHAMT_CheckInvariants(t *testing.T) {
+	identity := hash.Func[int](func(k int) uint64 { return uint64(k) })
+	h := NewHAMTWith[int, int](WithHasher(identity))
+	test.GotWantNoError(t, h.CheckInvariants())
+	for i := range 40 {
+		h = h.Put(i, i) // Keys 32 to 39 nest below keys 0 to 7
+	}
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	h.size = 41
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: size is 41, trie holds 40 pairs")
+	h.size = 40
+
+	root := h.root
+	root.bitmap &^= 1 << 31
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: node at depth 0 has 31 bits set for 32 entries")
+	root.bitmap |= 1 << 31
+
+	root.entries[30], root.entries[31] = root.entries[31], root.entries[30]
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: key 31 is stored off the path of its hash")
+	root.entries[30], root.entries[31] = root.entries[31], root.entries[30]
+
+	root.entries[31].leaf.hash = 99
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: key 31 is stored with hash 0x63, hashes to 0x1f")
+	root.entries[31].leaf.hash = 31
+
+	leaf := root.entries[31]
+	root.entries[31] = hamtEntry[int, int]{node: &hamtNode[int, int]{bitmap: 1, entries: []hamtEntry[int, int]{leaf}}}
+	test.GotWantError(t, h.CheckInvariants(), "invariant violation: subtree at depth 1 holds a single leaf, not inlined")
+	root.entries[31] = leaf
+	test.GotWantNoError(t, h.CheckInvariants())
+
+	colliding := NewHAMTWith[int, int](WithHasher(hash.Func[int](func(int) uint64 { return 0 }))).Put(1, 1).Put(2, 2)
+	test.GotWantNoError(t, colliding.CheckInvariants())
+	n := colliding.root
+	for n.collisions == nil {
+		n = n.entries[0].node
+	}
+	n.collisions[1].key = 1
+	test.GotWantError(t, colliding.CheckInvariants(), "invariant violation: key 1 is stored twice")
+}
+
+// Verifies random updates against map snapshots of every version, with a
+// narrow hash to exercise nesting and collisions
+func TestHAMT_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	h := NewHAMTWith[int, int](WithHasher(hash.Func[int](func(k int) uint64 { return uint64(k%97) * 0x9E3779B97F4A7C15 })))
+	versions := []*HAMT[int, int]{h}
+	snapshots := []map[int]int{{}}
+	model := map[int]int{}
+
+	for i := range 3000 {
+		k := r.IntN(400)
+		if r.IntN(3) == 0 {
+			h = h.Delete(k)
+			delete(model, k)
+		} else {
+			h = h.Put(k, i)
+			model[k] = i
+		}
+		if i%100 == 0 {
+			versions = append(versions, h)
+			snapshots = append(snapshots, maps.Clone(model))
+		}
+	}
+	versions = append(versions, h)
+	snapshots = append(snapshots, model)
+
+	for i, v := range versions {
+		test.GotWantNoError(t, v.CheckInvariants())
+		test.GotWant(t, maps.Equal(maps.Collect(v.All()), snapshots[i]), true)
+		for k, want := range snapshots[i] {
+			got, ok := v.Get(k)
+			test.GotWant(t, ok, true)
+			test.GotWant(t, got, want)
+		}
+	}
+}
+
+// Verifies a round trip replaces the receiver's pairs, keeps its hasher
+// and leaves versions derived from it untouched, and invalid data is
+// rejected
+func TestHAMT_MarshalBinary(t *testing.T) {
+	src := NewHAMT[string, int]()
+	for i := range 100 {
+		src = src.Put(fmt.Sprint(i), i)
+	}
+	other, _ := NewBTree[string, int]().MarshalBinary()
+	dst := NewHAMTWith[string, int](WithHasher(hash.NewString()))
+	old := dst.Put("x", 1)
+	codectest.RoundTrip(t, src, dst,
+		func(h *HAMT[string, int]) map[string]int { return maps.Collect(h.All()) }, other)
+	test.GotWantNoError(t, dst.CheckInvariants())
+
+	test.GotWant(t, old.Size(), 1)
+	test.GotWant(t, old.Contains("x"), true)
+	test.GotWant(t, old.Contains("1"), false)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the pairs of a persistent version
+func TestHAMT_WriteTo_ReadFrom(t *testing.T) {
+	src := NewHAMT[int, int]().Put(1, 10).Put(2, 20)
+	dst := NewHAMT[int, int]()
+	codectest.StreamRoundTrip(t, src, dst,
+		func(h *HAMT[int, int]) map[int]int { return maps.Collect(h.All()) })
+}
+
+// Verifies no options yields a working trie with the default hasher
+func TestHAMT_NewHAMTWith_Defaults(t *testing.T) {
+	h := NewHAMTWith[string, int]().Put("a", 1)
+	v, ok := h.Get("a")
+	test.GotWant(t, v, 1)
+	test.GotWant(t, ok, true)
+}
+
+// Verifies WithHasher replaces the hash function
+func TestHAMT_NewHAMTWith_Hasher(t *testing.T) {
+	h := NewHAMTWith[int, int](WithHasher(hash.Func[int](func(k int) uint64 { return uint64(k) << 5 })))
+	test.GotWant(t, h.hash(3), uint64(3)<<5)
+
+	for i := range 40 {
+		h = h.Put(i, i)
+	}
+	test.GotWantNoError(t, h.CheckInvariants())
+	test.GotWant(t, h.Size(), 40)
+}
+
+// Verifies the deprecated constructor still applies its hasher
+func TestHAMT_NewHAMTWithHasher(t *testing.T) {
+	h := NewHAMTWithHasher[int, int](hash.Func[int](func(int) uint64 { return 42 }))
+	test.GotWant(t, h.hash(7), uint64(42))
+}
+
+// Verifies a snapshot holds the version's pairs and keeps them when the
+// receiver is overwritten by decoding
+func TestHAMT_Snapshot(t *testing.T) {
+	h := NewHAMT[string, int]().Put("a", 1)
+	snap := h.Snapshot()
+	test.GotWant(t, snap.root, h.root)
+
+	data, err := NewHAMT[string, int]().Put("b", 2).Put("c", 3).MarshalBinary()
+	test.GotWantNoError(t, err)
+	test.GotWantNoError(t, h.UnmarshalBinary(data))
+
+	test.GotWant(t, h.Size(), 2)
+	test.GotWant(t, snap.Size(), 1)
+	v, ok := snap.Get("a")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 1)
+}
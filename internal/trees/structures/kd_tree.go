@@ -0,0 +1,381 @@
+package structures
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+	"github.com/apotourlyan/godatastructures/internal/utilities/constraints"
+	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
+)
+
+// Represents a single node in a k-d tree.
+// The node splits space on the coordinate axis chosen by its depth.
+type kdNode[T constraints.Numeric] struct {
+	point []T
+	axis  int
+	left  *kdNode[T] // Points with point[axis] < this point's coordinate
+	right *kdNode[T] // Points with point[axis] >= this point's coordinate
+}
+
+// Represents a candidate in a nearest-neighbor search.
+type kdCandidate[T constraints.Numeric] struct {
+	point    []T
+	distance float64 // Squared Euclidean distance to the query
+}
+
+// KDTree implements a k-dimensional tree for spatial queries over points.
+//
+// Each level of the tree splits space by one coordinate axis, cycling
+// through the axes with depth. Whole subtrees whose region cannot
+// intersect a query are skipped, so range and nearest-neighbor queries
+// typically visit only a small part of the tree.
+//
+// Design decisions:
+//   - Cycling split axis: The axis at depth d is d mod k
+//   - Balanced bulk construction: Median splits give O(log n) height
+//   - Unbalanced incremental inserts: Insert appends below a leaf, so
+//     rebuild with NewKDTreeFromPoints after heavy skewed insertion
+//   - Squared Euclidean distance: Avoids square roots during search
+//   - Defensive copies: Points are cloned on the way in and out
+//
+// Space complexity: O(n·k) where n is the number of points.
+type KDTree[T constraints.Numeric] struct {
+	root       *kdNode[T]
+	dimensions int
+	size       int
+}
+
+// NewKDTree creates an empty k-d tree for points with the given number
+// of dimensions.
+//
+// Panics if dimensions < 1.
+//
+// Example:
+//
+//	t := NewKDTree[float64](2)
+//	t.Insert([]float64{1.5, 2.5})
+func NewKDTree[T constraints.Numeric](dimensions int) *KDTree[T] {
+	panics.RequireGreaterThan(dimensions, 0, "dimensions")
+	return &KDTree[T]{dimensions: dimensions}
+}
+
+// NewKDTreeFromPoints creates a balanced k-d tree containing the points.
+// Each subtree is split at the median of its points along the current
+// axis, so the resulting height is O(log n).
+//
+// Time complexity: O(n log² n)
+//
+// Panics if dimensions < 1 or any point has a different number of
+// coordinates.
+//
+// Example:
+//
+//	t := NewKDTreeFromPoints(2, []int{2, 3}, []int{5, 4}, []int{9, 6})
+func NewKDTreeFromPoints[T constraints.Numeric](dimensions int, points ...[]T) *KDTree[T] {
+	t := NewKDTree[T](dimensions)
+	copies := make([][]T, len(points))
+	for i, p := range points {
+		t.requireDimensions(p)
+		copies[i] = slices.Clone(p)
+	}
+
+	t.root = t.build(copies, 0)
+	t.size = len(points)
+	return t
+}
+
+// Insert adds the point to the tree. Duplicate points are kept.
+//
+// Time complexity: O(h) where h is the current height
+//
+// Panics if the point has the wrong number of coordinates.
+func (t *KDTree[T]) Insert(point []T) {
+	t.requireDimensions(point)
+	n := &kdNode[T]{point: slices.Clone(point)}
+	t.size++
+
+	if t.root == nil {
+		t.root = n
+		return
+	}
+
+	parent := t.root
+	for {
+		next := &parent.right
+		if point[parent.axis] < parent.point[parent.axis] {
+			next = &parent.left
+		}
+
+		if *next == nil {
+			n.axis = (parent.axis + 1) % t.dimensions
+			*next = n
+			return
+		}
+
+		parent = *next
+	}
+}
+
+// Contains returns true if the tree holds a point equal to the given one.
+//
+// Time complexity: O(h) where h is the current height
+//
+// Panics if the point has the wrong number of coordinates.
+func (t *KDTree[T]) Contains(point []T) bool {
+	t.requireDimensions(point)
+	n := t.root
+	for n != nil {
+		if slices.Equal(n.point, point) {
+			return true
+		}
+
+		if point[n.axis] < n.point[n.axis] {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	return false
+}
+
+// RangeSearch returns all points inside the axis-aligned box with the
+// given lower and upper corners, bounds inclusive. The order of the
+// returned points is unspecified.
+//
+// Time complexity: O(n^(1-1/k) + m) for a balanced tree, where m is the
+// number of points returned
+//
+// Panics if either corner has the wrong number of coordinates.
+//
+// Example:
+//
+//	t := NewKDTreeFromPoints(2, []int{1, 1}, []int{3, 4}, []int{8, 2})
+//	t.RangeSearch([]int{0, 0}, []int{5, 5})  // Returns [[1 1] [3 4]]
+func (t *KDTree[T]) RangeSearch(lower []T, upper []T) [][]T {
+	t.requireDimensions(lower)
+	t.requireDimensions(upper)
+
+	result := [][]T{}
+	var search func(n *kdNode[T])
+	search = func(n *kdNode[T]) {
+		if n == nil {
+			return
+		}
+
+		inside := true
+		for i, c := range n.point {
+			if c < lower[i] || c > upper[i] {
+				inside = false
+				break
+			}
+		}
+		if inside {
+			result = append(result, slices.Clone(n.point))
+		}
+
+		// Only descend into the halves that overlap the box
+		c := n.point[n.axis]
+		if lower[n.axis] < c {
+			search(n.left)
+		}
+		if upper[n.axis] >= c {
+			search(n.right)
+		}
+	}
+
+	search(t.root)
+	return result
+}
+
+// NearestNeighbor returns up to k points closest to the query point by
+// Euclidean distance, nearest first. Ties are broken arbitrarily.
+//
+// Time complexity: O(k log n) expected for a balanced tree and
+// well-distributed points, O(n) in the worst case
+//
+// Panics if k < 0 or the point has the wrong number of coordinates.
+//
+// Example:
+//
+//	t := NewKDTreeFromPoints(2, []int{0, 0}, []int{5, 5}, []int{1, 2})
+//	t.NearestNeighbor([]int{1, 1}, 2)  // Returns [[1 2] [0 0]]
+func (t *KDTree[T]) NearestNeighbor(point []T, k int) [][]T {
+	panics.RequireNonNegative(k, "k")
+	t.requireDimensions(point)
+
+	// Sorted by ascending distance, at most k entries
+	best := make([]kdCandidate[T], 0, min(k, t.size))
+	var search func(n *kdNode[T])
+	search = func(n *kdNode[T]) {
+		if n == nil || k == 0 {
+			return
+		}
+
+		d := squaredDistance(n.point, point)
+		if len(best) < k || d < best[len(best)-1].distance {
+			candidate := kdCandidate[T]{point: n.point, distance: d}
+			i := algorithms.UpperBound(best, candidate, func(a, b kdCandidate[T]) bool {
+				return a.distance < b.distance
+			})
+			if len(best) == k {
+				best = best[:k-1]
+			}
+			best = slices.Insert(best, i, candidate)
+		}
+
+		// Search the side containing the query first, then the far side
+		// only if the splitting plane is closer than the current worst
+		diff := float64(point[n.axis]) - float64(n.point[n.axis])
+		near, far := n.right, n.left
+		if diff < 0 {
+			near, far = n.left, n.right
+		}
+
+		search(near)
+		if len(best) < k || diff*diff < best[len(best)-1].distance {
+			search(far)
+		}
+	}
+
+	search(t.root)
+	result := make([][]T, len(best))
+	for i, c := range best {
+		result[i] = slices.Clone(c.point)
+	}
+
+	return result
+}
+
+// IsEmpty returns true if the tree contains no points.
+//
+// Time complexity: O(1)
+func (t *KDTree[T]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Size returns the number of points in the tree.
+//
+// Time complexity: O(1)
+func (t *KDTree[T]) Size() int {
+	return t.size
+}
+
+// Dimensions returns the number of coordinates of every point.
+//
+// Time complexity: O(1)
+func (t *KDTree[T]) Dimensions() int {
+	return t.dimensions
+}
+
+// CheckInvariants verifies the internal consistency of the tree: every
+// point has Dimensions coordinates, the node at depth d splits on axis
+// d mod k, every point lies on its side of the split of each ancestor,
+// strictly below it on the left and at or above it on the right, and the
+// number of nodes matches Size. The walk is iterative, as incremental
+// inserts may leave the tree deep. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the tree is consistent.
+//
+// Time complexity: O(n·k)
+func (t *KDTree[T]) CheckInvariants() error {
+	if t.dimensions < 1 {
+		return fmt.Errorf("%w: dimensions is %d, want at least 1", ErrInvariantViolation, t.dimensions)
+	}
+
+	// The region of a subtree, as the ancestor coordinates bounding it on
+	// each axis: lower inclusive, upper exclusive, nil when unbounded
+	type region struct {
+		node         *kdNode[T]
+		depth        int
+		lower, upper []*T
+	}
+
+	count := 0
+	stack := []region{{t.root, 0, make([]*T, t.dimensions), make([]*T, t.dimensions)}}
+	for len(stack) > 0 {
+		r := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		n := r.node
+		if n == nil {
+			continue
+		}
+
+		count++
+		if len(n.point) != t.dimensions {
+			return fmt.Errorf("%w: point %v at depth %d has %d coordinates, want %d", ErrInvariantViolation, n.point, r.depth, len(n.point), t.dimensions)
+		}
+		if want := r.depth % t.dimensions; n.axis != want {
+			return fmt.Errorf("%w: node at depth %d splits on axis %d, want %d", ErrInvariantViolation, r.depth, n.axis, want)
+		}
+		for a, v := range n.point {
+			if (r.lower[a] != nil && v < *r.lower[a]) || (r.upper[a] != nil && v >= *r.upper[a]) {
+				return fmt.Errorf("%w: point %v at depth %d is on the wrong side of an ancestor on axis %d", ErrInvariantViolation, n.point, r.depth, a)
+			}
+		}
+
+		upper := slices.Clone(r.upper)
+		upper[n.axis] = &n.point[n.axis]
+		lower := slices.Clone(r.lower)
+		lower[n.axis] = &n.point[n.axis]
+		stack = append(stack,
+			region{n.left, r.depth + 1, r.lower, upper},
+			region{n.right, r.depth + 1, lower, r.upper},
+		)
+	}
+	if count != t.size {
+		return fmt.Errorf("%w: size is %d, tree holds %d nodes", ErrInvariantViolation, t.size, count)
+	}
+
+	return nil
+}
+
+// Panics if the point does not have exactly the tree's number of coordinates.
+func (t *KDTree[T]) requireDimensions(point []T) {
+	panics.RequireEqualTo(len(point), t.dimensions, "point dimensions")
+}
+
+// Builds a balanced subtree from the points by splitting at the median
+// along the axis for the given depth.
+func (t *KDTree[T]) build(points [][]T, depth int) *kdNode[T] {
+	if len(points) == 0 {
+		return nil
+	}
+
+	axis := depth % t.dimensions
+	slices.SortFunc(points, func(a []T, b []T) int {
+		switch {
+		case a[axis] < b[axis]:
+			return -1
+		case a[axis] > b[axis]:
+			return 1
+		}
+		return 0
+	})
+
+	// Move the median left past equal coordinates, so the left subtree
+	// holds only strictly smaller ones as Insert and search expect
+	m := len(points) / 2
+	for m > 0 && points[m-1][axis] == points[m][axis] {
+		m--
+	}
+
+	return &kdNode[T]{
+		point: points[m],
+		axis:  axis,
+		left:  t.build(points[:m], depth+1),
+		right: t.build(points[m+1:], depth+1),
+	}
+}
+
+// Returns the squared Euclidean distance between two points.
+func squaredDistance[T constraints.Numeric](a []T, b []T) float64 {
+	sum := 0.0
+	for i := range a {
+		d := float64(a[i]) - float64(b[i])
+		sum += d * d
+	}
+
+	return sum
+}
@@ -0,0 +1,264 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewKDTree/NewKDTreeFromPoints):
+  ✓ Empty tree
+  ✓ Invalid dimensions (panic)
+  ✓ Wrong point dimensions (panic)
+  ✓ Bulk construction is balanced
+  ✓ Bulk construction copies the input points
+
+Insert/Contains:
+  ✓ Insert and find points
+  ✓ Duplicate points are kept
+
+RangeSearch:
+  ✓ Empty tree
+  ✓ Inclusive box bounds
+
+NearestNeighbor:
+  ✓ Empty tree and k = 0
+  ✓ Nearest first
+  ✓ k larger than the size
+  ✓ Negative k (panic)
+
+CheckInvariants:
+  ✓ Valid trees, wrong size, split axis, point on the wrong side of an
+    ancestor, wrong number of coordinates
+
+Randomized:
+  ✓ Range and nearest-neighbor queries match brute force, bulk and
+    incremental trees stay consistent
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the height of the subtree.
+func kdHeight[T int | float64](n *kdNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return 1 + max(kdHeight(n.left), kdHeight(n.right))
+}
+
+// Sorts points lexicographically so results can be compared.
+func sortPoints(points [][]int) [][]int {
+	slices.SortFunc(points, slices.Compare)
+	return points
+}
+
+// Verifies two point lists hold the same points in the same order.
+func gotWantPoints(t *testing.T, got [][]int, want [][]int) {
+	t.Helper()
+	test.GotWant(t, len(got), len(want))
+	for i := range min(len(got), len(want)) {
+		test.GotWantSlice(t, got[i], want[i])
+	}
+}
+
+// Verifies the creation of an empty tree
+func TestKDTree_NewKDTree_Empty(t *testing.T) {
+	tree := NewKDTree[int](3)
+	test.GotWant(t, tree.Size(), 0)
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, tree.Dimensions(), 3)
+}
+
+// Verifies dimensions below 1 are rejected
+func TestKDTree_NewKDTree_InvalidDimensions(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewKDTree[int](0)
+	}, `"dimensions" must be > 0, got 0`)
+}
+
+// Verifies points with the wrong number of coordinates are rejected
+func TestKDTree_Insert_WrongDimensions(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewKDTree[int](2).Insert([]int{1, 2, 3})
+	}, `"point dimensions" must be == 2, got 3`)
+	test.GotWantPanic(t, func() {
+		NewKDTreeFromPoints(2, []int{1, 2}, []int{1})
+	}, `"point dimensions" must be == 2, got 1`)
+}
+
+// Verifies bulk construction produces a balanced tree
+func TestKDTree_NewKDTreeFromPoints_Balanced(t *testing.T) {
+	points := make([][]int, 1023)
+	for i := range points {
+		points[i] = []int{i, 1022 - i}
+	}
+
+	tree := NewKDTreeFromPoints(2, points...)
+	test.GotWant(t, tree.Size(), 1023)
+	test.GotWant(t, kdHeight(tree.root), 10)
+	for _, p := range points {
+		test.GotWant(t, tree.Contains(p), true)
+	}
+}
+
+// Verifies bulk construction does not alias the caller's points
+func TestKDTree_NewKDTreeFromPoints_CopiesPoints(t *testing.T) {
+	p := []int{1, 2}
+	tree := NewKDTreeFromPoints(2, p)
+	p[0] = 100
+
+	test.GotWant(t, tree.Contains([]int{1, 2}), true)
+	test.GotWant(t, tree.Contains([]int{100, 2}), false)
+}
+
+// Verifies inserted points can be found
+func TestKDTree_Insert_Contains(t *testing.T) {
+	tree := NewKDTree[float64](2)
+	tree.Insert([]float64{2, 3})
+	tree.Insert([]float64{5, 4})
+	tree.Insert([]float64{9, 6})
+	tree.Insert([]float64{4, 7})
+
+	test.GotWant(t, tree.Size(), 4)
+	test.GotWant(t, tree.Contains([]float64{4, 7}), true)
+	test.GotWant(t, tree.Contains([]float64{4, 6}), false)
+}
+
+// Verifies duplicate points are stored separately
+func TestKDTree_Insert_Duplicates(t *testing.T) {
+	tree := NewKDTree[int](2)
+	tree.Insert([]int{1, 1})
+	tree.Insert([]int{1, 1})
+
+	test.GotWant(t, tree.Size(), 2)
+	test.GotWant(t, len(tree.RangeSearch([]int{1, 1}, []int{1, 1})), 2)
+}
+
+// Verifies range search on an empty tree
+func TestKDTree_RangeSearch_EmptyTree(t *testing.T) {
+	tree := NewKDTree[int](2)
+	test.GotWant(t, len(tree.RangeSearch([]int{0, 0}, []int{10, 10})), 0)
+}
+
+// Verifies range search includes points on the box boundary
+func TestKDTree_RangeSearch_InclusiveBounds(t *testing.T) {
+	tree := NewKDTreeFromPoints(2,
+		[]int{1, 1}, []int{3, 4}, []int{8, 2}, []int{5, 5}, []int{0, 6})
+
+	got := sortPoints(tree.RangeSearch([]int{1, 1}, []int{5, 5}))
+	gotWantPoints(t, got, [][]int{{1, 1}, {3, 4}, {5, 5}})
+}
+
+// Verifies nearest-neighbor search on an empty tree and for k = 0
+func TestKDTree_NearestNeighbor_Empty(t *testing.T) {
+	test.GotWant(t, len(NewKDTree[int](2).NearestNeighbor([]int{0, 0}, 3)), 0)
+
+	tree := NewKDTreeFromPoints(2, []int{1, 1})
+	test.GotWant(t, len(tree.NearestNeighbor([]int{0, 0}, 0)), 0)
+}
+
+// Verifies neighbors are returned nearest first
+func TestKDTree_NearestNeighbor_Order(t *testing.T) {
+	tree := NewKDTreeFromPoints(2,
+		[]int{0, 0}, []int{5, 5}, []int{1, 2}, []int{9, 9}, []int{3, 3})
+
+	gotWantPoints(t, tree.NearestNeighbor([]int{1, 1}, 3), [][]int{{1, 2}, {0, 0}, {3, 3}})
+	gotWantPoints(t, tree.NearestNeighbor([]int{8, 8}, 1), [][]int{{9, 9}})
+}
+
+// Verifies asking for more neighbors than points returns all points
+func TestKDTree_NearestNeighbor_KLargerThanSize(t *testing.T) {
+	tree := NewKDTreeFromPoints(1, []int{4}, []int{1}, []int{7})
+	gotWantPoints(t, tree.NearestNeighbor([]int{0}, 10), [][]int{{1}, {4}, {7}})
+}
+
+// Verifies negative k is rejected
+func TestKDTree_NearestNeighbor_NegativeK(t *testing.T) {
+	test.GotWantPanic(t, func() {
+		NewKDTree[int](1).NearestNeighbor([]int{0}, -1)
+	}, `"k" must be >= 0, got -1`)
+}
+
+// Verifies CheckInvariants accepts valid trees and reports each kind of
+// corruption
+func TestKDTree_CheckInvariants(t *testing.T) {
+	test.GotWantNoError(t, NewKDTree[int](2).CheckInvariants())
+	tree := NewKDTreeFromPoints(2, []int{5, 5}, []int{2, 8}, []int{8, 1}, []int{1, 1}, []int{9, 9})
+	tree.Insert([]int{5, 0})
+	test.GotWantNoError(t, tree.CheckInvariants())
+
+	tree.size = 5
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: size is 5, tree holds 6 nodes")
+	tree.size = 6
+
+	left := tree.root.left // Point [2 8], splitting on y
+	left.axis = 0
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: node at depth 1 splits on axis 0, want 1")
+	left.axis = 1
+
+	left.point[0] = 6
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: point [6 8] at depth 1 is on the wrong side of an ancestor on axis 0")
+	left.point[0] = 2
+
+	left.point = left.point[:1]
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: point [2] at depth 1 has 1 coordinates, want 2")
+	left.point = left.point[:2]
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies range and nearest-neighbor queries against brute force over
+// random points, for both bulk-built and incrementally built trees
+func TestKDTree_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+	points := make([][]int, 500)
+	for i := range points {
+		points[i] = []int{r.IntN(100), r.IntN(100), r.IntN(100)}
+	}
+
+	incremental := NewKDTree[int](3)
+	for _, p := range points {
+		incremental.Insert(p)
+	}
+	trees := map[string]*KDTree[int]{
+		"Bulk":        NewKDTreeFromPoints(3, points...),
+		"Incremental": incremental,
+	}
+
+	for name, tree := range trees {
+		t.Run(name, func(t *testing.T) {
+			test.GotWantNoError(t, tree.CheckInvariants())
+			for range 50 {
+				lower := []int{r.IntN(100), r.IntN(100), r.IntN(100)}
+				upper := []int{lower[0] + r.IntN(40), lower[1] + r.IntN(40), lower[2] + r.IntN(40)}
+
+				want := [][]int{}
+				for _, p := range points {
+					if p[0] >= lower[0] && p[0] <= upper[0] &&
+						p[1] >= lower[1] && p[1] <= upper[1] &&
+						p[2] >= lower[2] && p[2] <= upper[2] {
+						want = append(want, p)
+					}
+				}
+				gotWantPoints(t, sortPoints(tree.RangeSearch(lower, upper)), sortPoints(want))
+
+				// Compare distances only, since equidistant points may tie
+				query := []int{r.IntN(100), r.IntN(100), r.IntN(100)}
+				k := r.IntN(10) + 1
+				distances := make([]float64, len(points))
+				for i, p := range points {
+					distances[i] = squaredDistance(p, query)
+				}
+				slices.Sort(distances)
+
+				got := tree.NearestNeighbor(query, k)
+				test.GotWant(t, len(got), k)
+				for i, p := range got {
+					test.GotWant(t, squaredDistance(p, query), distances[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package structures
+
+import "iter"
+
+// PersistentTree implements an immutable rose tree: a value with an
+// ordered list of child trees of any length.
+//
+// A tree is never modified after construction. Edits go through a Zipper,
+// which rebuilds only the nodes on the path from the edited node to the
+// root and shares every other subtree with the original, so old versions
+// stay valid and unchanged and can be read concurrently without locking.
+//
+// Design decisions:
+//   - Pointer values: Subtrees are shared between versions by reference
+//   - Copied child slices: A node owns its children slice, so callers
+//     cannot modify a tree through the slice they passed in
+//   - Cached size: Size is O(1) at the cost of O(k) work per rebuilt node
+//
+// Space complexity: O(n) across all versions, where n is the number of
+// distinct nodes built.
+type PersistentTree[T any] struct {
+	value    T
+	children []*PersistentTree[T]
+	size     int // Number of nodes in the subtree
+}
+
+// NewPersistentTree creates a tree with the given root value and child
+// subtrees, in order. Children must not be nil.
+//
+// Time complexity: O(k) where k is the number of children
+//
+// Example:
+//
+//	t := NewPersistentTree("root",
+//	    NewPersistentTree("a"),
+//	    NewPersistentTree("b", NewPersistentTree("c")),
+//	)
+//	t.Size()  // Returns 4
+func NewPersistentTree[T any](value T, children ...*PersistentTree[T]) *PersistentTree[T] {
+	return newPersistentTree(value, append([]*PersistentTree[T](nil), children...))
+}
+
+// Value returns the value stored at the root of the tree.
+//
+// Time complexity: O(1)
+func (t *PersistentTree[T]) Value() T {
+	return t.value
+}
+
+// Child returns the i-th child subtree.
+// Returns false if i is out of range.
+//
+// Time complexity: O(1)
+func (t *PersistentTree[T]) Child(i int) (*PersistentTree[T], bool) {
+	if i < 0 || i >= len(t.children) {
+		return nil, false
+	}
+
+	return t.children[i], true
+}
+
+// Children returns an iterator over the child subtrees in order.
+//
+// Time complexity: O(k) for a full iteration
+func (t *PersistentTree[T]) Children() iter.Seq[*PersistentTree[T]] {
+	return func(yield func(*PersistentTree[T]) bool) {
+		for _, c := range t.children {
+			if !yield(c) {
+				return
+			}
+		}
+	}
+}
+
+// Degree returns the number of children of the root.
+//
+// Time complexity: O(1)
+func (t *PersistentTree[T]) Degree() int {
+	return len(t.children)
+}
+
+// IsLeaf returns true if the root has no children.
+//
+// Time complexity: O(1)
+func (t *PersistentTree[T]) IsLeaf() bool {
+	return len(t.children) == 0
+}
+
+// All returns an iterator over all values in pre-order: every node before
+// its children, children from first to last.
+//
+// Time complexity: O(n) for a full iteration
+func (t *PersistentTree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.preorder(yield)
+	}
+}
+
+// Snapshot returns the tree itself: a persistent tree never changes, so
+// every version is already an immutable point-in-time view.
+//
+// Time complexity: O(1)
+func (t *PersistentTree[T]) Snapshot() *PersistentTree[T] {
+	return t
+}
+
+// Size returns the number of nodes in the tree.
+//
+// Time complexity: O(1)
+func (t *PersistentTree[T]) Size() int {
+	return t.size
+}
+
+// Yields the values of the subtree in pre-order.
+// Returns false if iteration was stopped.
+func (t *PersistentTree[T]) preorder(yield func(T) bool) bool {
+	if !yield(t.value) {
+		return false
+	}
+
+	for _, c := range t.children {
+		if !c.preorder(yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Creates a tree node that takes ownership of the children slice.
+func newPersistentTree[T any](value T, children []*PersistentTree[T]) *PersistentTree[T] {
+	size := 1
+	for _, c := range children {
+		size += c.size
+	}
+
+	return &PersistentTree[T]{value: value, children: children, size: size}
+}
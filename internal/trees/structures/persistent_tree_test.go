@@ -0,0 +1,121 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewPersistentTree):
+  ✓ Leaf
+  ✓ Nested children, sizes
+  ✓ Children slice is copied
+
+Child/Children/Degree/IsLeaf:
+  ✓ In-range and out-of-range children
+
+All:
+  ✓ Pre-order
+  ✓ Early termination
+
+Snapshot:
+  ✓ Returns the receiver
+*/
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the tree in the form value(child, child, ...).
+func formatPersistentTree[T any](t *PersistentTree[T]) string {
+	if t.IsLeaf() {
+		return fmt.Sprint(t.Value())
+	}
+
+	parts := []string{}
+	for c := range t.Children() {
+		parts = append(parts, formatPersistentTree(c))
+	}
+	return fmt.Sprintf("%v(%s)", t.Value(), strings.Join(parts, ", "))
+}
+
+// Verifies every cached size matches the number of nodes in the subtree.
+func checkPersistentTree[T any](t *testing.T, tree *PersistentTree[T]) int {
+	t.Helper()
+	size := 1
+	for c := range tree.Children() {
+		size += checkPersistentTree(t, c)
+	}
+	test.GotWant(t, tree.Size(), size)
+	return size
+}
+
+// Verifies the creation of a single-node tree
+func TestPersistentTree_NewPersistentTree_Leaf(t *testing.T) {
+	tree := NewPersistentTree(1)
+	test.GotWant(t, tree.Value(), 1)
+	test.GotWant(t, tree.Size(), 1)
+	test.GotWant(t, tree.Degree(), 0)
+	test.GotWant(t, tree.IsLeaf(), true)
+}
+
+// Verifies the creation of a nested tree and its sizes
+func TestPersistentTree_NewPersistentTree_Nested(t *testing.T) {
+	tree := NewPersistentTree(1,
+		NewPersistentTree(2, NewPersistentTree(4), NewPersistentTree(5)),
+		NewPersistentTree(3),
+	)
+	test.GotWant(t, formatPersistentTree(tree), "1(2(4, 5), 3)")
+	test.GotWant(t, tree.Size(), 5)
+	test.GotWant(t, tree.Degree(), 2)
+	test.GotWant(t, tree.IsLeaf(), false)
+	checkPersistentTree(t, tree)
+}
+
+// Verifies later changes to the children slice do not affect the tree
+func TestPersistentTree_NewPersistentTree_CopiesChildren(t *testing.T) {
+	children := []*PersistentTree[int]{NewPersistentTree(2), NewPersistentTree(3)}
+	tree := NewPersistentTree(1, children...)
+	children[0] = NewPersistentTree(9)
+	test.GotWant(t, formatPersistentTree(tree), "1(2, 3)")
+}
+
+// Verifies child access by index
+func TestPersistentTree_Child(t *testing.T) {
+	b := NewPersistentTree("b")
+	tree := NewPersistentTree("a", b)
+
+	c, ok := tree.Child(0)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, c, b)
+	_, ok = tree.Child(1)
+	test.GotWant(t, ok, false)
+	_, ok = tree.Child(-1)
+	test.GotWant(t, ok, false)
+}
+
+// Verifies All yields values in pre-order and stops early
+func TestPersistentTree_All(t *testing.T) {
+	tree := NewPersistentTree(1,
+		NewPersistentTree(2, NewPersistentTree(3)),
+		NewPersistentTree(4, NewPersistentTree(5), NewPersistentTree(6)),
+	)
+	test.GotWantSlice(t, slices.Collect(tree.All()), []int{1, 2, 3, 4, 5, 6})
+
+	got := []int{}
+	for v := range tree.All() {
+		got = append(got, v)
+		if v == 3 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []int{1, 2, 3})
+}
+
+// Verifies a snapshot is the tree itself
+func TestPersistentTree_Snapshot(t *testing.T) {
+	tree := NewPersistentTree("root", NewPersistentTree("a"))
+	test.GotWant(t, tree.Snapshot(), tree)
+}
@@ -0,0 +1,460 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &RadixTree[int]{}
+var _ collections.Iterable2[string, int] = &RadixTree[int]{}
+var _ collections.Collection[string] = &RadixTree[int]{}
+
+// Represents a single node in a radix tree.
+// The label is the edge leading into the node from its parent; children
+// are kept sorted by the first byte of their labels, which is unique
+// among siblings.
+type radixNode[V any] struct {
+	label    string
+	children []*radixNode[V]
+	value    V
+	terminal bool // A stored key ends at this node
+}
+
+// Returns the index of the child whose label starts with the byte,
+// or the insertion index and false if there is none.
+func (n *radixNode[V]) childIndex(b byte) (int, bool) {
+	return slices.BinarySearchFunc(n.children, b, func(c *radixNode[V], b byte) int {
+		return int(c.label[0]) - int(b)
+	})
+}
+
+// Returns the child whose label starts with the byte, or nil.
+func (n *radixNode[V]) child(b byte) *radixNode[V] {
+	i, found := n.childIndex(b)
+	if !found {
+		return nil
+	}
+
+	return n.children[i]
+}
+
+// RadixTree implements a compressed prefix tree mapping string keys
+// to values.
+//
+// Unlike the plain Trie, chains of nodes with a single child are merged
+// into one node whose edge carries a multi-byte label. The number of
+// nodes is therefore bounded by twice the number of keys rather than by
+// their total length, which drastically reduces memory for sparse key
+// sets with long keys such as URLs, file paths or IP prefixes.
+//
+// Design decisions:
+//   - Edge labels: Each node stores the substring leading into it
+//   - Sorted child slices: Binary search on the first label byte
+//   - Split on insert, merge on delete: No node ever has a single child
+//     unless it terminates a key
+//   - Longest-prefix match: Routing-table style lookups in one descent
+//
+// Space complexity: O(n) nodes where n is the number of keys.
+type RadixTree[V any] struct {
+	root *radixNode[V]
+	size int
+}
+
+// NewRadixTree creates an empty radix tree.
+//
+// Example:
+//
+//	t := NewRadixTree[string]()
+//	t.Insert("/api", "api")
+//	t.Insert("/api/users", "users")
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{root: &radixNode[V]{}}
+}
+
+// Insert associates the value with the key.
+// Returns true if the key was added, false if an existing value was replaced.
+//
+// Time complexity: O(m) where m is the length of the key
+func (t *RadixTree[V]) Insert(key string, value V) bool {
+	n := t.root
+	for key != "" {
+		i, found := n.childIndex(key[0])
+		if !found {
+			leaf := &radixNode[V]{label: key, value: value, terminal: true}
+			n.children = slices.Insert(n.children, i, leaf)
+			t.size++
+			return true
+		}
+
+		c := n.children[i]
+		common := commonPrefixLength(key, c.label)
+		if common < len(c.label) {
+			// Split the edge, inserting a node at the divergence point
+			mid := &radixNode[V]{label: c.label[:common], children: []*radixNode[V]{c}}
+			c.label = c.label[common:]
+			n.children[i] = mid
+		}
+
+		n = n.children[i]
+		key = key[common:]
+	}
+
+	added := !n.terminal
+	n.value = value
+	n.terminal = true
+	if added {
+		t.size++
+	}
+
+	return added
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not present.
+//
+// Time complexity: O(m) where m is the length of the key
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	n := t.root
+	for n != nil && key != "" {
+		n = n.child(key[0])
+		if n == nil || !strings.HasPrefix(key, n.label) {
+			n = nil
+			break
+		}
+
+		key = key[len(n.label):]
+	}
+
+	if n == nil || !n.terminal {
+		var zero V
+		return zero, false
+	}
+
+	return n.value, true
+}
+
+// Clear removes all keys.
+//
+// Time complexity: O(1)
+func (t *RadixTree[V]) Clear() {
+	t.root = &radixNode[V]{}
+	t.size = 0
+}
+
+// Contains returns true if the key is present.
+//
+// Time complexity: O(m) where m is the length of the key
+func (t *RadixTree[V]) Contains(key string) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete removes the key and its value, merging nodes that are left
+// with a single child.
+// Returns true if the key was found and removed, false otherwise.
+//
+// Time complexity: O(m) where m is the length of the key
+func (t *RadixTree[V]) Delete(key string) bool {
+	var parent *radixNode[V]
+	n := t.root
+	for key != "" {
+		c := n.child(key[0])
+		if c == nil || !strings.HasPrefix(key, c.label) {
+			return false
+		}
+
+		parent, n = n, c
+		key = key[len(c.label):]
+	}
+
+	if !n.terminal {
+		return false
+	}
+
+	var zero V
+	n.value = zero // Help GC
+	n.terminal = false
+	t.size--
+
+	if n == t.root {
+		return true
+	}
+
+	switch len(n.children) {
+	case 0:
+		// Special case: the node led only to the removed key
+		i, _ := parent.childIndex(n.label[0])
+		parent.children = slices.Delete(parent.children, i, i+1)
+		if parent != t.root && !parent.terminal && len(parent.children) == 1 {
+			t.merge(parent)
+		}
+	case 1:
+		t.merge(n)
+	}
+
+	return true
+}
+
+// LongestPrefix returns the longest stored key that is a prefix of the
+// given key, and its value. Returns false if no stored key is a prefix.
+//
+// Time complexity: O(m) where m is the length of the key
+//
+// Example:
+//
+//	t := NewRadixTree[string]()
+//	t.Insert("10.0", "private")
+//	t.Insert("10.0.1", "lab")
+//	t.LongestPrefix("10.0.1.7")  // Returns "10.0.1", "lab"
+//	t.LongestPrefix("10.0.2.7")  // Returns "10.0", "private"
+//	t.LongestPrefix("192.168")   // Returns false
+func (t *RadixTree[V]) LongestPrefix(key string) (string, V, bool) {
+	var best *radixNode[V]
+	bestLength := 0
+	matched := 0
+
+	n := t.root
+	for {
+		if n.terminal {
+			best, bestLength = n, matched
+		}
+
+		if matched == len(key) {
+			break
+		}
+
+		c := n.child(key[matched])
+		if c == nil || !strings.HasPrefix(key[matched:], c.label) {
+			break
+		}
+
+		n = c
+		matched += len(c.label)
+	}
+
+	if best == nil {
+		var zero V
+		return "", zero, false
+	}
+
+	return key[:bestLength], best.value, true
+}
+
+// WithPrefix returns an iterator over the key-value pairs whose keys start
+// with the prefix, in ascending key order. The tree must not be modified
+// during iteration.
+//
+// Time complexity: O(m + k) where m is the length of the prefix and k is
+// the total length of the keys yielded
+func (t *RadixTree[V]) WithPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		n := t.root
+		path := []byte{}
+		rest := prefix
+		for rest != "" {
+			c := n.child(rest[0])
+			if c == nil {
+				return
+			}
+
+			if strings.HasPrefix(c.label, rest) {
+				// The prefix ends inside this edge
+				path = append(path, c.label...)
+				n = c
+				break
+			}
+
+			if !strings.HasPrefix(rest, c.label) {
+				return
+			}
+
+			path = append(path, c.label...)
+			rest = rest[len(c.label):]
+			n = c
+		}
+
+		t.walk(n, path, yield)
+	}
+}
+
+// All returns an iterator over all key-value pairs in ascending key order.
+// The tree must not be modified during iteration.
+//
+// Time complexity: O(L) for a full iteration where L is the total length
+// of all keys
+func (t *RadixTree[V]) All() iter.Seq2[string, V] {
+	return t.WithPrefix("")
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the
+// pairs in lexicographic key order in the format of package codec.
+// Returns an error wrapping codec.ErrUnsupportedType if V cannot be
+// encoded.
+//
+// Time complexity: O(n·m) where m is the average key length
+func (t *RadixTree[V]) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq2("RadixTree", t.size, t.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// pairs of the tree with those encoded by MarshalBinary.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded tree, in which case the tree is left unchanged.
+//
+// Time complexity: O(n·m) where m is the average key length
+func (t *RadixTree[V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := codec.DecodeSlice2[string, V](data, "RadixTree")
+	if err != nil {
+		return err
+	}
+
+	t.restore(keys, values)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n·m) where m is the average key length
+func (t *RadixTree[V]) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq2(w, "RadixTree", t.size, t.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the pairs of the tree
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The tree is left
+// unchanged on error.
+//
+// Time complexity: O(n·m) where m is the average key length
+func (t *RadixTree[V]) ReadFrom(r io.Reader) (int64, error) {
+	keys, values, n, err := codec.ReadSlice2[string, V](r, "RadixTree")
+	if err != nil {
+		return n, err
+	}
+
+	t.restore(keys, values)
+	return n, nil
+}
+
+// IsEmpty returns true if the tree contains no keys.
+//
+// Time complexity: O(1)
+func (t *RadixTree[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Size returns the number of keys in the tree.
+//
+// Time complexity: O(1)
+func (t *RadixTree[V]) Size() int {
+	return t.size
+}
+
+// CheckInvariants verifies the internal consistency of the tree: the root
+// has an empty label and every other node a non-empty one, siblings are
+// sorted by distinct first bytes, every node below the root that is not a
+// key has at least two children, so splits and merges left no chains,
+// and the number of keys matches Size. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the tree is consistent.
+//
+// Time complexity: O(L) where L is the total length of all labels
+func (t *RadixTree[V]) CheckInvariants() error {
+	if t.root.label != "" {
+		return fmt.Errorf("%w: root has label %q", ErrInvariantViolation, t.root.label)
+	}
+
+	count, err := t.check(t.root, nil)
+	if err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("%w: size is %d, tree holds %d keys", ErrInvariantViolation, t.size, count)
+	}
+
+	return nil
+}
+
+// Replaces the pairs with the decoded ones; a repeated key keeps its
+// last value.
+func (t *RadixTree[V]) restore(keys []string, values []V) {
+	t.Clear()
+	for i, k := range keys {
+		t.Insert(k, values[i])
+	}
+}
+
+// Merges a non-terminal node with its only child, concatenating the labels.
+func (t *RadixTree[V]) merge(n *radixNode[V]) {
+	c := n.children[0]
+	n.label += c.label
+	n.children = c.children
+	n.value = c.value
+	n.terminal = c.terminal
+}
+
+// Yields the pairs of the subtree in ascending key order, where path holds
+// the key spelled by the edges down to and including the node.
+// Returns false if the iteration must stop.
+func (t *RadixTree[V]) walk(n *radixNode[V], path []byte, yield func(string, V) bool) bool {
+	if n.terminal && !yield(string(path), n.value) {
+		return false
+	}
+
+	for _, c := range n.children {
+		if !t.walk(c, append(path, c.label...), yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Verifies the invariants of the subtree, where path holds the key
+// spelled by the edges down to and including the node.
+// Returns the number of keys in the subtree.
+func (t *RadixTree[V]) check(n *radixNode[V], path []byte) (int, error) {
+	if n != t.root && !n.terminal && len(n.children) < 2 {
+		return 0, fmt.Errorf("%w: %q is not a key and has fewer than two children", ErrInvariantViolation, path)
+	}
+
+	count := 0
+	if n.terminal {
+		count++
+	}
+	for i, c := range n.children {
+		if c.label == "" {
+			return 0, fmt.Errorf("%w: child of %q has an empty label", ErrInvariantViolation, path)
+		}
+		if i > 0 && c.label[0] <= n.children[i-1].label[0] {
+			return 0, fmt.Errorf("%w: children of %q are not sorted by distinct first bytes", ErrInvariantViolation, path)
+		}
+
+		keys, err := t.check(c, append(path, c.label...))
+		if err != nil {
+			return 0, err
+		}
+		count += keys
+	}
+
+	return count, nil
+}
+
+// Returns the length of the longest common prefix of a and b.
+func commonPrefixLength(a string, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+
+	return i
+}
@@ -0,0 +1,90 @@
+package structures
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/bench"
+)
+
+// Number of keys preloaded into every radix benchmark.
+const benchRadixSize = 20_000
+
+// Returns benchRadixSize long, sparse keys resembling request paths:
+// a few shared segments followed by a long unique tail.
+func benchRadixKeys() []string {
+	keys := make([]string, benchRadixSize)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("/api/v%d/resources/%08x/attachments/details", i%4, i*2654435761)
+	}
+	return keys
+}
+
+// BenchmarkRadixTree_TotalMemory measures the heap retained by a radix
+// tree and a plain trie holding the same sparse key set.
+// Reports the custom metric "retained-KB".
+//
+// Pattern: Insert 20,000 path-like keys
+// Expected winner: Radix (one node per key instead of one per byte)
+func BenchmarkRadixTree_TotalMemory(b *testing.B) {
+	keys := benchRadixKeys()
+	builders := map[string]func() any{
+		"Radix": func() any {
+			t := NewRadixTree[struct{}]()
+			for _, k := range keys {
+				t.Insert(k, struct{}{})
+			}
+			return t
+		},
+		"Trie": func() any {
+			return NewTrie(keys...)
+		},
+	}
+
+	for name, build := range builders {
+		b.Run(name, func(b *testing.B) {
+			var tree any
+			var retained int
+			for b.Loop() {
+				memory := bench.TrackMemory()
+				tree = build()
+				retained = memory.Retained()
+			}
+
+			runtime.KeepAlive(tree)
+			bench.ReportRetained(b, retained)
+		})
+	}
+}
+
+// BenchmarkRadixTree_Contains measures exact lookups on the same key set.
+//
+// Pattern: [Contains(key)] × 20,000
+// Expected winner: Radix (compares whole labels instead of a lookup per byte)
+func BenchmarkRadixTree_Contains(b *testing.B) {
+	keys := benchRadixKeys()
+
+	b.Run("Radix", func(b *testing.B) {
+		t := NewRadixTree[struct{}]()
+		for _, k := range keys {
+			t.Insert(k, struct{}{})
+		}
+		b.ResetTimer()
+		for b.Loop() {
+			for _, k := range keys {
+				t.Contains(k)
+			}
+		}
+	})
+
+	b.Run("Trie", func(b *testing.B) {
+		t := NewTrie(keys...)
+		b.ResetTimer()
+		for b.Loop() {
+			for _, k := range keys {
+				t.Contains(k)
+			}
+		}
+	})
+}
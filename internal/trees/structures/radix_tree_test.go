@@ -0,0 +1,403 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewRadixTree):
+  ✓ Empty tree
+
+Insert/Get/Contains:
+  ✓ Get from empty tree
+  ✓ Insert new keys
+  ✓ Insert existing key replaces value
+  ✓ Insert splits an edge
+  ✓ Insert a key that ends inside an edge
+  ✓ Empty key
+
+Delete:
+  ✓ Delete absent key and inner prefix
+  ✓ Delete merges the remaining single child
+  ✓ Delete leaf merges its parent
+
+LongestPrefix:
+  ✓ Empty tree
+  ✓ Exact, longer and unmatched keys
+
+WithPrefix/All:
+  ✓ Ascending order
+  ✓ Prefix ending inside an edge
+  ✓ Missing prefix
+  ✓ Early termination
+
+Randomized:
+  ✓ Mixed inserts/deletes match a map model, compression invariants hold
+
+CheckInvariants:
+  ✓ Valid trees, bad labels, unsorted siblings, uncompressed node, wrong size
+
+Clear:
+  ✓ Removes keys
+
+Binary encoding:
+  ✓ Round trip replaces the pairs, invalid data rejected
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/pairs"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Collects the keys yielded by a radix tree iterator.
+func radixKeys[V any](tree *RadixTree[V], prefix string) []string {
+	return collectKeys(tree.WithPrefix(prefix))
+}
+
+// Verifies the creation of an empty tree
+func TestRadixTree_NewRadixTree_Empty(t *testing.T) {
+	tree := NewRadixTree[int]()
+	test.GotWant(t, tree.Size(), 0)
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, len(collectKeys(tree.All())), 0)
+}
+
+// Verifies getting from an empty tree
+func TestRadixTree_Get_EmptyTree(t *testing.T) {
+	tree := NewRadixTree[int]()
+	v, ok := tree.Get("a")
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, 0)
+	test.GotWant(t, tree.Contains(""), false)
+}
+
+// Verifies inserting new keys
+func TestRadixTree_Insert_NewKeys(t *testing.T) {
+	tree := NewRadixTree[int]()
+	test.GotWant(t, tree.Insert("romane", 1), true)
+	test.GotWant(t, tree.Insert("romanus", 2), true)
+	test.GotWant(t, tree.Insert("rubens", 3), true)
+	test.GotWant(t, tree.Size(), 3)
+
+	v, ok := tree.Get("romanus")
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, 2)
+	test.GotWant(t, tree.Contains("roman"), false)
+	test.GotWant(t, tree.Contains("rubensx"), false)
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies inserting an existing key replaces its value
+func TestRadixTree_Insert_ExistingKey(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("key", 1)
+	test.GotWant(t, tree.Insert("key", 2), false)
+	test.GotWant(t, tree.Size(), 1)
+
+	v, _ := tree.Get("key")
+	test.GotWant(t, v, 2)
+}
+
+// Verifies inserting a diverging key splits the shared edge
+func TestRadixTree_Insert_SplitsEdge(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("test", 1)
+	tree.Insert("team", 2)
+
+	test.GotWant(t, len(tree.root.children), 1)
+	mid := tree.root.children[0]
+	test.GotWant(t, mid.label, "te")
+	test.GotWant(t, mid.terminal, false)
+	test.GotWant(t, len(mid.children), 2)
+	test.GotWant(t, mid.children[0].label, "am")
+	test.GotWant(t, mid.children[1].label, "st")
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies inserting a key that ends inside an existing edge
+func TestRadixTree_Insert_EndsInsideEdge(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("testing", 1)
+	tree.Insert("test", 2)
+
+	mid := tree.root.children[0]
+	test.GotWant(t, mid.label, "test")
+	test.GotWant(t, mid.terminal, true)
+	test.GotWant(t, mid.children[0].label, "ing")
+	test.GotWantSlice(t, collectKeys(tree.All()), []string{"test", "testing"})
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies the empty key is stored at the root
+func TestRadixTree_Insert_EmptyKey(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("a", 1)
+	test.GotWant(t, tree.Insert("", 0), true)
+	test.GotWant(t, tree.Contains(""), true)
+	test.GotWantSlice(t, collectKeys(tree.All()), []string{"", "a"})
+
+	test.GotWant(t, tree.Delete(""), true)
+	test.GotWant(t, tree.Contains(""), false)
+	test.GotWant(t, tree.Contains("a"), true)
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies deleting absent keys and non-terminal prefixes changes nothing
+func TestRadixTree_Delete_Absent(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("test", 1)
+	tree.Insert("team", 2)
+
+	test.GotWant(t, tree.Delete("te"), false)
+	test.GotWant(t, tree.Delete("tea"), false)
+	test.GotWant(t, tree.Delete("teams"), false)
+	test.GotWant(t, tree.Delete("x"), false)
+	test.GotWant(t, tree.Size(), 2)
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies deleting an inner key merges the node with its only child
+func TestRadixTree_Delete_MergesChild(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("test", 1)
+	tree.Insert("testing", 2)
+
+	test.GotWant(t, tree.Delete("test"), true)
+	test.GotWant(t, len(tree.root.children), 1)
+	test.GotWant(t, tree.root.children[0].label, "testing")
+	v, _ := tree.Get("testing")
+	test.GotWant(t, v, 2)
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies deleting a leaf merges its parent with the remaining sibling
+func TestRadixTree_Delete_MergesParent(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("test", 1)
+	tree.Insert("team", 2)
+
+	test.GotWant(t, tree.Delete("team"), true)
+	test.GotWant(t, len(tree.root.children), 1)
+	test.GotWant(t, tree.root.children[0].label, "test")
+	test.GotWant(t, tree.Contains("test"), true)
+	test.GotWantNoError(t, tree.CheckInvariants())
+
+	test.GotWant(t, tree.Delete("test"), true)
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, len(tree.root.children), 0)
+}
+
+// Verifies LongestPrefix on an empty tree
+func TestRadixTree_LongestPrefix_EmptyTree(t *testing.T) {
+	tree := NewRadixTree[int]()
+	_, _, ok := tree.LongestPrefix("anything")
+	test.GotWant(t, ok, false)
+}
+
+// Verifies LongestPrefix picks the longest stored prefix
+func TestRadixTree_LongestPrefix(t *testing.T) {
+	tree := NewRadixTree[string]()
+	tree.Insert("10.0", "private")
+	tree.Insert("10.0.1", "lab")
+	tree.Insert("192.168.1", "home")
+
+	cases := []struct {
+		key       string
+		wantKey   string
+		wantValue string
+		found     bool
+	}{
+		{"10.0.1.7", "10.0.1", "lab", true},
+		{"10.0.1", "10.0.1", "lab", true},
+		{"10.0.2.7", "10.0", "private", true},
+		{"10.0", "10.0", "private", true},
+		{"10.", "", "", false},
+		{"192.168.1.20", "192.168.1", "home", true},
+		{"192.168.2.1", "", "", false},
+		{"", "", "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.key, func(t *testing.T) {
+			k, v, ok := tree.LongestPrefix(tc.key)
+			test.GotWant(t, ok, tc.found)
+			test.GotWant(t, k, tc.wantKey)
+			test.GotWant(t, v, tc.wantValue)
+		})
+	}
+}
+
+// Verifies WithPrefix yields matching keys in ascending order
+func TestRadixTree_WithPrefix_Order(t *testing.T) {
+	tree := NewRadixTree[int]()
+	for i, k := range []string{"romulus", "rubicon", "romane", "rubens", "romanus", "ruber", "r"} {
+		tree.Insert(k, i)
+	}
+
+	test.GotWantSlice(t, collectKeys(tree.All()),
+		[]string{"r", "romane", "romanus", "romulus", "rubens", "ruber", "rubicon"})
+	test.GotWantSlice(t, radixKeys(tree, "rom"), []string{"romane", "romanus", "romulus"})
+	test.GotWantSlice(t, radixKeys(tree, "rube"), []string{"rubens", "ruber"})
+}
+
+// Verifies WithPrefix when the prefix ends inside an edge label
+func TestRadixTree_WithPrefix_InsideEdge(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("application", 1)
+	tree.Insert("apply", 2)
+
+	test.GotWantSlice(t, radixKeys(tree, "appl"), []string{"application", "apply"})
+	test.GotWantSlice(t, radixKeys(tree, "applic"), []string{"application"})
+	test.GotWantSlice(t, radixKeys(tree, "application"), []string{"application"})
+}
+
+// Verifies WithPrefix yields nothing for a missing prefix
+func TestRadixTree_WithPrefix_Missing(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("application", 1)
+	tree.Insert("apply", 2)
+
+	test.GotWant(t, len(radixKeys(tree, "apps")), 0)
+	test.GotWant(t, len(radixKeys(tree, "applications")), 0)
+	test.GotWant(t, len(radixKeys(tree, "b")), 0)
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestRadixTree_All_EarlyTermination(t *testing.T) {
+	tree := NewRadixTree[int]()
+	for i, k := range []string{"a", "ab", "abc", "b"} {
+		tree.Insert(k, i)
+	}
+
+	got := []string{}
+	for k := range tree.All() {
+		got = append(got, k)
+		if len(got) == 2 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []string{"a", "ab"})
+}
+
+// Verifies random inserts and deletes match a map model and keep the
+// tree compressed
+func TestRadixTree_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+	tree := NewRadixTree[int]()
+	model := map[string]int{}
+
+	randomKey := func() string {
+		var sb strings.Builder
+		for range r.IntN(6) {
+			sb.WriteByte("abc"[r.IntN(3)])
+		}
+		return sb.String()
+	}
+
+	for i := range 5000 {
+		k := randomKey()
+		if r.IntN(3) == 0 {
+			_, exists := model[k]
+			test.GotWant(t, tree.Delete(k), exists)
+			delete(model, k)
+		} else {
+			_, exists := model[k]
+			test.GotWant(t, tree.Insert(k, i), !exists)
+			model[k] = i
+		}
+	}
+
+	test.GotWantNoError(t, tree.CheckInvariants())
+	test.GotWant(t, tree.Size(), len(model))
+	for k, want := range model {
+		v, ok := tree.Get(k)
+		test.GotWant(t, ok, true)
+		test.GotWant(t, v, want)
+	}
+
+	keys := make([]string, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	test.GotWantSlice(t, collectKeys(tree.All()), keys)
+}
+
+// Verifies CheckInvariants accepts valid trees and reports bad labels,
+// unsorted siblings, uncompressed nodes and a wrong size
+func TestRadixTree_CheckInvariants(t *testing.T) {
+	tree := NewRadixTree[int]()
+	test.GotWantNoError(t, tree.CheckInvariants())
+	tree.Insert("test", 1)
+	tree.Insert("team", 2)
+	test.GotWantNoError(t, tree.CheckInvariants())
+
+	tree.root.label = "x"
+	test.GotWantError(t, tree.CheckInvariants(), `invariant violation: root has label "x"`)
+	tree.root.label = ""
+
+	mid := tree.root.children[0]
+	mid.children[0].label = ""
+	test.GotWantError(t, tree.CheckInvariants(), `invariant violation: child of "te" has an empty label`)
+	mid.children[0].label = "am"
+
+	mid.children[0], mid.children[1] = mid.children[1], mid.children[0]
+	test.GotWantError(t, tree.CheckInvariants(), `invariant violation: children of "te" are not sorted by distinct first bytes`)
+	mid.children[0], mid.children[1] = mid.children[1], mid.children[0]
+
+	children := mid.children
+	mid.children = children[:1]
+	test.GotWantError(t, tree.CheckInvariants(), `invariant violation: "te" is not a key and has fewer than two children`)
+	mid.children = children
+
+	tree.size = 3
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: size is 3, tree holds 2 keys")
+	test.GotWantErrorIs(t, tree.CheckInvariants(), ErrInvariantViolation)
+	tree.size = 2
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies Clear empties the tree and it stays usable
+func TestRadixTree_Clear(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("romane", 1)
+	tree.Insert("romanus", 2)
+
+	tree.Clear()
+	test.GotWant(t, tree.Size(), 0)
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, tree.Contains("romane"), false)
+
+	tree.Insert("rubens", 3)
+	v, ok := tree.Get("rubens")
+	test.GotWant(t, v, 3)
+	test.GotWant(t, ok, true)
+}
+
+// Verifies a round trip restores the pairs and invalid data is rejected
+func TestRadixTree_MarshalBinary(t *testing.T) {
+	src := NewRadixTree[int]()
+	for i, k := range []string{"romane", "romanus", "romulus", "rubens", ""} {
+		src.Insert(k, i)
+	}
+	other, _ := NewTrie("a").MarshalBinary()
+	dst := NewRadixTree[int]()
+	dst.Insert("x", 9)
+	codectest.RoundTrip(t, src, dst,
+		func(tree *RadixTree[int]) []pairs.Pair[string, int] { return pairs.Collect(tree.All()) }, other)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the pairs in key order
+func TestRadixTree_WriteTo_ReadFrom(t *testing.T) {
+	src := NewRadixTree[int]()
+	src.Insert("test", 1)
+	src.Insert("team", 2)
+	dst := NewRadixTree[int]()
+	codectest.StreamRoundTrip(t, src, dst,
+		func(tree *RadixTree[int]) []pairs.Pair[string, int] { return pairs.Collect(tree.All()) })
+}
@@ -0,0 +1,528 @@
+package structures
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+	"github.com/apotourlyan/godatastructures/internal/debug"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &SplayTree[int, int]{}
+var _ collections.Iterable2[int, int] = &SplayTree[int, int]{}
+var _ collections.Collection[int] = &SplayTree[int, int]{}
+
+// Represents a single node in a splay tree.
+type splayNode[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	left  *splayNode[K, V]
+	right *splayNode[K, V]
+}
+
+// SplayTree implements a self-adjusting binary search tree mapping
+// ordered keys to values.
+//
+// Every access (Insert, Get, Contains, Delete) rotates the accessed node,
+// or the last node visited if the key is absent, to the root. Recently
+// and frequently used keys therefore stay near the top, giving amortized
+// O(log n) per operation and much better constants on skewed or temporal
+// access patterns (caches, hot keys, sequential scans).
+//
+// Design decisions:
+//   - Top-down splaying: Single pass from the root, no parent pointers
+//   - No balance metadata: Nodes store only key, value and two children
+//   - Size counter: Enables O(1) Size and IsEmpty operations
+//
+// Lookups restructure the tree, so even Get and Contains must not run
+// concurrently with any other operation.
+//
+// Space complexity: O(n) where n is the number of keys.
+type SplayTree[K cmp.Ordered, V any] struct {
+	root *splayNode[K, V]
+	size int
+}
+
+// NewSplayTree creates an empty splay tree.
+//
+// Example:
+//
+//	t := NewSplayTree[string, int]()
+//	t.Insert("a", 1)
+func NewSplayTree[K cmp.Ordered, V any]() *SplayTree[K, V] {
+	return &SplayTree[K, V]{}
+}
+
+// Insert associates the value with the key and splays it to the root.
+// Returns true if the key was added, false if an existing value was replaced.
+//
+// Time complexity: O(log n) amortized
+func (t *SplayTree[K, V]) Insert(key K, value V) bool {
+	if t.root == nil {
+		t.root = &splayNode[K, V]{key: key, value: value}
+		t.size++
+		return true
+	}
+
+	t.root = t.splay(t.root, key)
+	if t.root.key == key {
+		t.root.value = value
+		return false
+	}
+
+	// Split the tree around the new key
+	n := &splayNode[K, V]{key: key, value: value}
+	if key < t.root.key {
+		n.left = t.root.left
+		n.right = t.root
+		t.root.left = nil
+	} else {
+		n.right = t.root.right
+		n.left = t.root
+		t.root.right = nil
+	}
+
+	t.root = n
+	t.size++
+	return true
+}
+
+// Get returns the value associated with the key and splays it to the root.
+// Returns false if the key is not present.
+//
+// Time complexity: O(log n) amortized
+func (t *SplayTree[K, V]) Get(key K) (V, bool) {
+	if t.root == nil {
+		var zero V
+		return zero, false
+	}
+
+	t.root = t.splay(t.root, key)
+	if t.root.key != key {
+		var zero V
+		return zero, false
+	}
+
+	return t.root.value, true
+}
+
+// Clear removes all keys.
+//
+// Time complexity: O(1)
+func (t *SplayTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Contains returns true if the key is present. Like Get, it splays.
+//
+// Time complexity: O(log n) amortized
+func (t *SplayTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete removes the key and its value.
+// Returns true if the key was found and removed, false otherwise.
+//
+// Time complexity: O(log n) amortized
+func (t *SplayTree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+
+	t.root = t.splay(t.root, key)
+	if t.root.key != key {
+		return false
+	}
+
+	if t.root.left == nil {
+		t.root = t.root.right
+	} else {
+		// Splaying the left subtree for the removed key brings its
+		// maximum to the top, which then adopts the right subtree
+		right := t.root.right
+		t.root = t.splay(t.root.left, key)
+		t.root.right = right
+	}
+
+	t.size--
+	return true
+}
+
+// Min returns the smallest key and its value without restructuring.
+// Returns false if the tree is empty.
+//
+// Time complexity: O(h) where h is the current height
+func (t *SplayTree[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+
+	n := t.root
+	for n.left != nil {
+		n = n.left
+	}
+
+	return n.key, n.value, true
+}
+
+// Max returns the largest key and its value without restructuring.
+// Returns false if the tree is empty.
+//
+// Time complexity: O(h) where h is the current height
+func (t *SplayTree[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+
+	n := t.root
+	for n.right != nil {
+		n = n.right
+	}
+
+	return n.key, n.value, true
+}
+
+// All returns an iterator over all key-value pairs in ascending key order.
+// Iteration does not restructure the tree. The tree must not be modified
+// or accessed (Get also splays) during iteration.
+//
+// Time complexity: O(n) for a full iteration
+//
+// Space complexity: O(h) where h is the current height
+func (t *SplayTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		// Iterative in-order walk; splay trees can temporarily be deep
+		var path []*splayNode[K, V]
+		n := t.root
+		for n != nil || len(path) > 0 {
+			for n != nil {
+				path = append(path, n)
+				n = n.left
+			}
+
+			n = path[len(path)-1]
+			path = path[:len(path)-1]
+			if !yield(n.key, n.value) {
+				return
+			}
+
+			n = n.right
+		}
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the nodes
+// in preorder in the format of package codec, each followed by a byte
+// telling which children it has, so the shape left by past accesses, and
+// with it the position of the hot keys, is preserved.
+// Returns an error wrapping codec.ErrUnsupportedType if K or V cannot be
+// encoded.
+//
+// Time complexity: O(n)
+func (t *SplayTree[K, V]) MarshalBinary() ([]byte, error) {
+	e := codec.NewEncoder("SplayTree", t.size)
+	if t.root != nil {
+		t.marshal(e, t.root)
+	}
+
+	return e.Bytes()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// pairs of the tree with those encoded by MarshalBinary, rebuilding the
+// encoded shape in O(n).
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded tree or its keys are out of search order, in which case the
+// tree is left unchanged.
+//
+// Time complexity: O(n)
+func (t *SplayTree[K, V]) UnmarshalBinary(data []byte) error {
+	d, n, err := codec.NewDecoder(data, "SplayTree")
+	if err != nil {
+		return err
+	}
+
+	return t.decode(d, n)
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n)
+func (t *SplayTree[K, V]) WriteTo(w io.Writer) (int64, error) {
+	e := codec.NewStreamEncoder(w, "SplayTree", t.size)
+	if t.root != nil {
+		t.marshal(e, t.root)
+	}
+
+	return e.Flush()
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the pairs of the tree
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it, rebuilding the encoded
+// shape as UnmarshalBinary does.
+// Returns the number of bytes read and the first error. The tree is left
+// unchanged on error.
+//
+// Time complexity: O(n)
+func (t *SplayTree[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	d, n, err := codec.NewStreamDecoder(r, "SplayTree")
+	if err == nil {
+		err = t.decode(d, n)
+	}
+
+	return d.BytesRead(), err
+}
+
+// IsEmpty returns true if the tree contains no keys.
+//
+// Time complexity: O(1)
+func (t *SplayTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Size returns the number of keys in the tree.
+//
+// Time complexity: O(1)
+func (t *SplayTree[K, V]) Size() int {
+	return t.size
+}
+
+// ToDOT writes the nodes of the tree to w in the Graphviz DOT language
+// (see debug.DOT), each labeled with its key, and the child pointers
+// labeled L and R. Useful for watching how accesses reshape the tree.
+// Returns the first write error.
+//
+// Time complexity: O(n)
+func (t *SplayTree[K, V]) ToDOT(w io.Writer) error {
+	d := debug.NewDOT(true)
+	t.toDOT(d, t.root)
+	_, err := d.WriteTo(w)
+	return err
+}
+
+// DebugTree returns a snapshot of the tree's shape for debug.PrintTree,
+// each node labeled with its key and the height of its subtree, which
+// shows how far accesses have flattened the tree.
+// Returns nil if the tree is empty.
+//
+// Time complexity: O(n)
+func (t *SplayTree[K, V]) DebugTree() *debug.TreeNode {
+	node, _ := t.debugTree(t.root, "")
+	return node
+}
+
+// CheckInvariants verifies the internal consistency of the tree: an
+// in-order walk visits the keys in strictly increasing order, and the
+// number of nodes matches Size. The walk is iterative, as the tree may be
+// deep after sequential accesses. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the tree is consistent.
+//
+// Time complexity: O(n)
+func (t *SplayTree[K, V]) CheckInvariants() error {
+	count := 0
+	var prev *splayNode[K, V]
+	var path []*splayNode[K, V]
+	n := t.root
+	for n != nil || len(path) > 0 {
+		for n != nil {
+			path = append(path, n)
+			n = n.left
+		}
+
+		n = path[len(path)-1]
+		path = path[:len(path)-1]
+		if prev != nil && n.key <= prev.key {
+			return fmt.Errorf("%w: key %v is out of search order", ErrInvariantViolation, n.key)
+		}
+		count++
+		prev = n
+		n = n.right
+	}
+	if count != t.size {
+		return fmt.Errorf("%w: size is %d, tree holds %d nodes", ErrInvariantViolation, t.size, count)
+	}
+
+	return nil
+}
+
+// Adds the subtree rooted at n to the DOT graph in preorder.
+func (t *SplayTree[K, V]) toDOT(d *debug.DOT, n *splayNode[K, V]) {
+	if n == nil || !d.Node(n, fmt.Sprint(n.key)) {
+		return
+	}
+
+	if n.left != nil {
+		d.Edge(n, n.left, "L")
+		t.toDOT(d, n.left)
+	}
+	if n.right != nil {
+		d.Edge(n, n.right, "R")
+		t.toDOT(d, n.right)
+	}
+}
+
+// Returns the snapshot of the subtree rooted at n, its label prefixed
+// with the side it hangs on, and the height of the subtree: nil and 0
+// for an empty subtree.
+func (t *SplayTree[K, V]) debugTree(n *splayNode[K, V], side string) (*debug.TreeNode, int) {
+	if n == nil {
+		return nil, 0
+	}
+
+	node := &debug.TreeNode{}
+	l, lh := t.debugTree(n.left, "L: ")
+	r, rh := t.debugTree(n.right, "R: ")
+	for _, c := range []*debug.TreeNode{l, r} {
+		if c != nil {
+			node.Children = append(node.Children, c)
+		}
+	}
+
+	h := 1 + max(lh, rh)
+	node.Label = fmt.Sprintf("%s%v (h=%d)", side, n.key, h)
+	return node, h
+}
+
+// Performs a top-down splay of the subtree rooted at root for the key.
+// Returns the new subtree root: the node holding the key if present,
+// otherwise the last node visited on the search path.
+func (t *SplayTree[K, V]) splay(root *splayNode[K, V], key K) *splayNode[K, V] {
+	// header.right collects the left tree, header.left the right tree
+	var header splayNode[K, V]
+	left, right := &header, &header
+	n := root
+
+	for {
+		if key < n.key {
+			if n.left == nil {
+				break
+			}
+			if key < n.left.key {
+				// Zig-zig: rotate right
+				y := n.left
+				n.left = y.right
+				y.right = n
+				n = y
+				if n.left == nil {
+					break
+				}
+			}
+			// Link right
+			right.left = n
+			right = n
+			n = n.left
+		} else if key > n.key {
+			if n.right == nil {
+				break
+			}
+			if key > n.right.key {
+				// Zag-zag: rotate left
+				y := n.right
+				n.right = y.left
+				y.left = n
+				n = y
+				if n.right == nil {
+					break
+				}
+			}
+			// Link left
+			left.right = n
+			left = n
+			n = n.right
+		} else {
+			break
+		}
+	}
+
+	// Reassemble
+	left.right = n.left
+	right.left = n.right
+	n.left = header.right
+	n.right = header.left
+	return n
+}
+
+// Replaces the pairs with the n nodes read from the decoder, once their
+// keys prove to be in search order.
+func (t *SplayTree[K, V]) decode(d *codec.Decoder, n int) error {
+	var root *splayNode[K, V]
+	count := 0
+	if n > 0 {
+		root = t.unmarshal(d, &count)
+	}
+	if err := d.Finish(); err != nil {
+		return err
+	}
+	if count != n {
+		return fmt.Errorf("%w: count is %d, tree holds %d nodes", codec.ErrInvalidData, n, count)
+	}
+
+	var prev *K
+	for k := range (&SplayTree[K, V]{root: root}).All() {
+		if prev != nil && k <= *prev {
+			return fmt.Errorf("%w: key %v is out of search order", codec.ErrInvalidData, k)
+		}
+		prev = &k
+	}
+
+	t.root = root
+	t.size = n
+	return nil
+}
+
+// Appends the subtree rooted at n to the encoder in preorder, each node
+// followed by its child flags. Iterative, like All, since splay trees
+// can be deep.
+func (t *SplayTree[K, V]) marshal(e *codec.Encoder, n *splayNode[K, V]) {
+	stack := []*splayNode[K, V]{n}
+	for len(stack) > 0 {
+		n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		codec.Append(e, n.key)
+		codec.Append(e, n.value)
+		e.Byte(childFlags(n.left != nil, n.right != nil))
+		if n.right != nil {
+			stack = append(stack, n.right)
+		}
+		if n.left != nil {
+			stack = append(stack, n.left)
+		}
+	}
+}
+
+// Reads a subtree written by marshal, adding its nodes to count.
+// Decoding stops at the first error, which the decoder keeps.
+func (t *SplayTree[K, V]) unmarshal(d *codec.Decoder, count *int) *splayNode[K, V] {
+	var root *splayNode[K, V]
+	slots := []**splayNode[K, V]{&root} // Child links awaiting the next node
+	for len(slots) > 0 {
+		slot := slots[len(slots)-1]
+		slots = slots[:len(slots)-1]
+		n := &splayNode[K, V]{key: codec.Read[K](d), value: codec.Read[V](d)}
+		*slot = n
+		*count++
+		flags := d.Byte()
+		if flags&hasRightChild != 0 {
+			slots = append(slots, &n.right)
+		}
+		if flags&hasLeftChild != 0 {
+			slots = append(slots, &n.left)
+		}
+	}
+
+	return root
+}
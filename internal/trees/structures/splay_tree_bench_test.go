@@ -0,0 +1,125 @@
+package structures
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// orderedTree is the subset of tree operations exercised by the benchmarks.
+type orderedTree interface {
+	Insert(key int, value int) bool
+	Get(key int) (int, bool)
+}
+
+// Benchmark trees compared against each other. The AVL tree and the
+// B-tree serve as the balanced, non-adaptive baselines.
+var benchTrees = map[string]func() orderedTree{
+	// Splay: Self-adjusting, amortized O(log n).
+	// Expected: Wins on sequential access, narrows the gap on skewed access.
+	"Splay": func() orderedTree {
+		return NewSplayTree[int, int]()
+	},
+	// BTree: Balanced, worst-case O(log n), no restructuring on reads.
+	// Expected: Wins on uniform random access.
+	"BTree": func() orderedTree {
+		return NewBTree[int, int]()
+	},
+	// AVL: Balanced binary tree, worst-case O(log n), no restructuring on reads.
+	// Expected: Between the two, one pointer chase per level.
+	"AVL": func() orderedTree {
+		return NewAVLTree[int, int]()
+	},
+}
+
+// Number of keys preloaded into every benchmark tree.
+const benchTreeSize = 100_000
+
+// Returns a tree preloaded with keys [0, benchTreeSize) in random order.
+func preloadedTree(factory func() orderedTree) orderedTree {
+	tree := factory()
+	for _, k := range rand.New(rand.NewPCG(1, 1)).Perm(benchTreeSize) {
+		tree.Insert(k, k)
+	}
+	return tree
+}
+
+// BenchmarkSplayTree_UniformGet measures lookups of uniformly random keys.
+// No key is hotter than another, so splaying cannot pay off.
+//
+// Pattern: [Get(random key)] × 1000
+// Expected winner: BTree (shallow, no writes on reads)
+func BenchmarkSplayTree_UniformGet(b *testing.B) {
+	for name, factory := range benchTrees {
+		b.Run(name, func(b *testing.B) {
+			tree := preloadedTree(factory)
+			r := rand.New(rand.NewPCG(2, 2))
+			b.ResetTimer()
+			for b.Loop() {
+				for range 1000 {
+					tree.Get(r.IntN(benchTreeSize))
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSplayTree_SkewedGet measures lookups following a Zipf
+// distribution, where a handful of keys receive most of the accesses.
+//
+// Pattern: [Get(zipf key)] × 1000
+// Expected: Splay closes most of its uniform-access gap (hot keys stay
+// near the root); the balanced trees still lead at this size
+func BenchmarkSplayTree_SkewedGet(b *testing.B) {
+	for name, factory := range benchTrees {
+		b.Run(name, func(b *testing.B) {
+			tree := preloadedTree(factory)
+			zipf := rand.NewZipf(rand.New(rand.NewPCG(3, 3)), 1.2, 1, benchTreeSize-1)
+			b.ResetTimer()
+			for b.Loop() {
+				for range 1000 {
+					tree.Get(int(zipf.Uint64()))
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSplayTree_SequentialGet measures lookups of consecutive keys,
+// the access pattern of an in-order scan through point queries.
+//
+// Pattern: [Get(i), Get(i+1), ...] × 1000
+// Expected winner: Splay (each next key is adjacent to the root)
+func BenchmarkSplayTree_SequentialGet(b *testing.B) {
+	for name, factory := range benchTrees {
+		b.Run(name, func(b *testing.B) {
+			tree := preloadedTree(factory)
+			k := 0
+			b.ResetTimer()
+			for b.Loop() {
+				for range 1000 {
+					tree.Get(k)
+					k = (k + 1) % benchTreeSize
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSplayTree_Insert measures building a tree from random keys.
+// Reports the amortized cost per insert.
+//
+// Pattern: [Insert(random key)] × 10,000 into an empty tree
+// Expected: Comparable, BTree ahead on cache locality
+func BenchmarkSplayTree_Insert(b *testing.B) {
+	keys := rand.New(rand.NewPCG(4, 4)).Perm(10_000)
+	for name, factory := range benchTrees {
+		b.Run(name, func(b *testing.B) {
+			for b.Loop() {
+				tree := factory()
+				for _, k := range keys {
+					tree.Insert(k, k)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewSplayTree):
+  ✓ Empty tree
+
+Insert/Get/Contains:
+  ✓ Get from empty tree
+  ✓ Insert new keys
+  ✓ Insert existing key replaces value
+  ✓ Accessed key is splayed to the root
+  ✓ Absent key splays the last visited node
+
+Delete:
+  ✓ Delete from empty tree
+  ✓ Delete absent key
+  ✓ Delete root without left subtree
+  ✓ Delete all keys
+
+Min/Max:
+  ✓ Empty tree
+  ✓ Non-empty tree
+
+All:
+  ✓ Ascending order
+  ✓ Early termination
+  ✓ Deep (degenerate) tree
+
+Randomized:
+  ✓ Mixed inserts/deletes/gets match a map model, BST order holds
+
+CheckInvariants:
+  ✓ Valid trees, keys out of search order, wrong size
+
+ToDOT:
+  ✓ Empty tree, keys with L and R child pointers after splaying
+
+DebugTree:
+  ✓ Empty tree, keys with subtree heights after splaying
+
+Binary encoding:
+  ✓ Round trip preserves pairs and shape, invalid data rejected
+  ✓ Keys out of search order rejected
+
+Streaming (WriteTo/ReadFrom):
+  ✓ WriteTo matches MarshalBinary, ReadFrom restores the shape
+  ✓ Invalid stream leaves the tree unchanged
+
+Clear:
+  ✓ Removes keys
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/debug"
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Verifies the creation of an empty tree
+func TestSplayTree_NewSplayTree_Empty(t *testing.T) {
+	tree := NewSplayTree[int, string]()
+	test.GotWant(t, tree.Size(), 0)
+	test.GotWant(t, tree.IsEmpty(), true)
+}
+
+// Verifies getting from an empty tree
+func TestSplayTree_Get_EmptyTree(t *testing.T) {
+	tree := NewSplayTree[int, string]()
+	v, ok := tree.Get(1)
+	test.GotWant(t, ok, false)
+	test.GotWant(t, v, "")
+	test.GotWant(t, tree.Contains(1), false)
+}
+
+// Verifies inserting new keys
+func TestSplayTree_Insert_NewKeys(t *testing.T) {
+	tree := NewSplayTree[int, string]()
+	test.GotWant(t, tree.Insert(2, "two"), true)
+	test.GotWant(t, tree.Insert(1, "one"), true)
+	test.GotWant(t, tree.Insert(3, "three"), true)
+	test.GotWant(t, tree.Size(), 3)
+
+	v, ok := tree.Get(1)
+	test.GotWant(t, ok, true)
+	test.GotWant(t, v, "one")
+	test.GotWant(t, tree.Contains(4), false)
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies inserting an existing key replaces its value
+func TestSplayTree_Insert_ExistingKey(t *testing.T) {
+	tree := NewSplayTree[int, string]()
+	tree.Insert(1, "old")
+	tree.Insert(2, "old")
+	test.GotWant(t, tree.Insert(1, "new"), false)
+	test.GotWant(t, tree.Size(), 2)
+	v, _ := tree.Get(1)
+	test.GotWant(t, v, "new")
+}
+
+// Verifies an accessed key is splayed to the root
+func TestSplayTree_Get_SplaysToRoot(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	for i := range 20 {
+		tree.Insert(i, i)
+	}
+
+	tree.Get(7)
+	test.GotWant(t, tree.root.key, 7)
+	tree.Contains(13)
+	test.GotWant(t, tree.root.key, 13)
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies a miss splays the last node on the search path
+func TestSplayTree_Get_AbsentSplaysNeighbor(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	for i := range 10 {
+		tree.Insert(i*10, i)
+	}
+
+	_, ok := tree.Get(45)
+	test.GotWant(t, ok, false)
+	test.GotWant(t, tree.root.key == 40 || tree.root.key == 50, true)
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies deleting from an empty tree
+func TestSplayTree_Delete_EmptyTree(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	test.GotWant(t, tree.Delete(1), false)
+}
+
+// Verifies deleting an absent key leaves the contents unchanged
+func TestSplayTree_Delete_AbsentKey(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	for i := range 10 {
+		tree.Insert(i*2, i)
+	}
+	test.GotWant(t, tree.Delete(5), false)
+	test.GotWant(t, tree.Size(), 10)
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies deleting the minimum, whose node has no left subtree
+func TestSplayTree_Delete_NoLeftSubtree(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	for i := range 5 {
+		tree.Insert(i, i)
+	}
+	test.GotWant(t, tree.Delete(0), true)
+	test.GotWant(t, tree.Contains(0), false)
+	k, _, _ := tree.Min()
+	test.GotWant(t, k, 1)
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies deleting every key empties the tree
+func TestSplayTree_Delete_All(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	for _, k := range rand.Perm(100) {
+		tree.Insert(k, k)
+	}
+	for _, k := range rand.Perm(100) {
+		test.GotWant(t, tree.Delete(k), true)
+	}
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, tree.root == nil, true)
+}
+
+// Verifies Min and Max on an empty tree
+func TestSplayTree_MinMax_EmptyTree(t *testing.T) {
+	tree := NewSplayTree[int, string]()
+	_, _, minOk := tree.Min()
+	_, _, maxOk := tree.Max()
+	test.GotWant(t, minOk, false)
+	test.GotWant(t, maxOk, false)
+}
+
+// Verifies Min and Max on a non-empty tree
+func TestSplayTree_MinMax_NonEmptyTree(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	for _, k := range []int{5, 3, 9, 1, 7} {
+		tree.Insert(k, k*10)
+	}
+	k, v, _ := tree.Min()
+	test.GotWant(t, k, 1)
+	test.GotWant(t, v, 10)
+	k, v, _ = tree.Max()
+	test.GotWant(t, k, 9)
+	test.GotWant(t, v, 90)
+}
+
+// Verifies All yields keys in ascending order
+func TestSplayTree_All_Order(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	for _, k := range rand.Perm(50) {
+		tree.Insert(k, k)
+	}
+
+	want := make([]int, 50)
+	for i := range want {
+		want[i] = i
+	}
+	test.GotWantSlice(t, collectKeys(tree.All()), want)
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestSplayTree_All_EarlyTermination(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	for i := range 10 {
+		tree.Insert(i, i)
+	}
+
+	got := []int{}
+	for k := range tree.All() {
+		got = append(got, k)
+		if len(got) == 3 {
+			break
+		}
+	}
+	test.GotWantSlice(t, got, []int{0, 1, 2})
+}
+
+// Verifies iteration over a degenerate tree produced by sequential inserts
+func TestSplayTree_All_Degenerate(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	for i := range 100_000 {
+		tree.Insert(i, i)
+	}
+	test.GotWant(t, len(collectKeys(tree.All())), 100_000)
+}
+
+// Verifies random operations match a map model and keep BST ordering
+func TestSplayTree_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	tree := NewSplayTree[int, int]()
+	model := map[int]int{}
+
+	for i := range 5000 {
+		k := r.IntN(300)
+		_, exists := model[k]
+		switch r.IntN(3) {
+		case 0:
+			test.GotWant(t, tree.Delete(k), exists)
+			delete(model, k)
+		case 1:
+			test.GotWant(t, tree.Insert(k, i), !exists)
+			model[k] = i
+		default:
+			v, ok := tree.Get(k)
+			test.GotWant(t, ok, exists)
+			test.GotWant(t, v, model[k])
+		}
+	}
+
+	test.GotWantNoError(t, tree.CheckInvariants())
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	test.GotWantSlice(t, collectKeys(tree.All()), keys)
+}
+
+// Verifies CheckInvariants accepts valid trees and reports keys out of
+// search order and a wrong size
+func TestSplayTree_CheckInvariants(t *testing.T) {
+	tree := NewSplayTree[int, string]()
+	test.GotWantNoError(t, tree.CheckInvariants())
+	for _, k := range []int{1, 2, 3} {
+		tree.Insert(k, "")
+	}
+	test.GotWantNoError(t, tree.CheckInvariants())
+
+	tree.root.left.left.key = 5
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: key 2 is out of search order")
+	test.GotWantErrorIs(t, tree.CheckInvariants(), ErrInvariantViolation)
+	tree.root.left.left.key = 1
+
+	tree.size = 4
+	test.GotWantError(t, tree.CheckInvariants(), "invariant violation: size is 4, tree holds 3 nodes")
+	tree.size = 3
+	test.GotWantNoError(t, tree.CheckInvariants())
+}
+
+// Verifies ToDOT draws the keys and child pointers of the splayed shape
+func TestSplayTree_ToDOT(t *testing.T) {
+	var b strings.Builder
+	tree := NewSplayTree[int, string]()
+	test.GotWantNoError(t, tree.ToDOT(&b))
+	test.GotWant(t, b.String(), "digraph {\n}\n")
+
+	for _, k := range []int{1, 2, 3} {
+		tree.Insert(k, "")
+	}
+	b.Reset()
+	test.GotWantNoError(t, tree.ToDOT(&b))
+	test.GotWant(t, b.String(), `digraph {
+    n0 [label="3"];
+    n0 -> n1 [label="L"];
+    n1 [label="2"];
+    n1 -> n2 [label="L"];
+    n2 [label="1"];
+}
+`)
+}
+
+// Verifies DebugTree annotates subtree heights of the splayed shape
+func TestSplayTree_DebugTree(t *testing.T) {
+	tree := NewSplayTree[int, string]()
+	test.GotWant(t, tree.DebugTree() == nil, true)
+
+	for _, k := range []int{1, 2, 3} {
+		tree.Insert(k, "")
+	}
+	tree.Get(1)
+	var b strings.Builder
+	test.GotWantNoError(t, debug.PrintTree(&b, tree))
+	test.GotWant(t, b.String(), `1 (h=3)
+└── R: 2 (h=2)
+    └── R: 3 (h=1)
+`)
+}
+
+// Verifies a round trip preserves the pairs and the exact shape, hot
+// keys included, and invalid data is rejected
+func TestSplayTree_MarshalBinary(t *testing.T) {
+	src := NewSplayTree[int, int]()
+	for i := range 10 {
+		src.Insert(i, i*i)
+	}
+	src.Get(4)
+	other, _ := NewAVLTree[int, int]().MarshalBinary()
+	dst := NewSplayTree[int, int]()
+	dst.Insert(100, 0)
+	codectest.RoundTrip(t, src, dst, (*SplayTree[int, int]).DebugTree, other)
+	test.GotWant(t, dst.root.key, 4)
+}
+
+// Verifies an encoded shape with keys out of search order is rejected
+func TestSplayTree_UnmarshalBinary_OutOfOrder(t *testing.T) {
+	e := codec.NewEncoder("SplayTree", 2)
+	for _, node := range []struct{ key, flags byte }{{1, hasLeftChild}, {2, 0}} {
+		codec.Append(e, int(node.key))
+		codec.Append(e, 0)
+		e.Byte(node.flags)
+	}
+	data, _ := e.Bytes()
+
+	tree := NewSplayTree[int, int]()
+	test.GotWantErrorIs(t, tree.UnmarshalBinary(data), codec.ErrInvalidData)
+	test.GotWant(t, tree.IsEmpty(), true)
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the exact shape of the tree
+func TestSplayTree_WriteTo_ReadFrom(t *testing.T) {
+	src := NewSplayTree[int, int]()
+	for i := range 100 {
+		src.Insert(i*37%100, i)
+	}
+	dst := NewSplayTree[int, int]()
+	codectest.StreamRoundTrip(t, src, dst, (*SplayTree[int, int]).DebugTree)
+}
+
+// Verifies Clear empties the tree and it stays usable
+func TestSplayTree_Clear(t *testing.T) {
+	tree := NewSplayTree[int, int]()
+	for i := range 10 {
+		tree.Insert(i, i)
+	}
+
+	tree.Clear()
+	test.GotWant(t, tree.Size(), 0)
+	test.GotWant(t, tree.IsEmpty(), true)
+	test.GotWant(t, tree.Contains(5), false)
+
+	tree.Insert(5, 5)
+	test.GotWant(t, tree.Contains(5), true)
+}
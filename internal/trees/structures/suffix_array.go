@@ -0,0 +1,248 @@
+package structures
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/apotourlyan/godatastructures/internal/slices/algorithms"
+)
+
+// SuffixArray implements a static substring index over a text.
+//
+// The suffix array lists the starting positions of all suffixes of the
+// text in lexicographic order, so every occurrence of a pattern forms one
+// contiguous block that binary search can locate. It is the flattened,
+// far more compact counterpart of a suffix tree. The accompanying LCP
+// array stores the length of the longest common prefix of each pair of
+// neighboring suffixes, computed with Kasai's algorithm.
+//
+// Design decisions:
+//   - Prefix doubling construction: O(n log² n) using the standard sort
+//   - Kasai LCP: O(n) from the suffix array and its inverse
+//   - Byte alphabet: Positions are byte offsets, matching Go string indexing
+//   - Immutable: The index is built once and never modified
+//
+// Space complexity: O(n) where n is the length of the text.
+type SuffixArray struct {
+	text     string
+	suffixes []int // Suffix start positions in lexicographic order
+	lcp      []int // lcp[i] is the common prefix length of suffixes i-1 and i
+}
+
+// NewSuffixArray builds the suffix array and LCP array for the text.
+//
+// Time complexity: O(n log² n)
+//
+// Example:
+//
+//	sa := NewSuffixArray("banana")
+//	sa.Count("ana")    // Returns 2
+//	sa.FindAll("ana")  // Returns [1 3]
+func NewSuffixArray(text string) *SuffixArray {
+	sa := &SuffixArray{text: text}
+	sa.suffixes = buildSuffixes(text)
+	sa.lcp = buildLCP(text, sa.suffixes)
+	return sa
+}
+
+// Contains returns true if the pattern occurs in the text.
+//
+// Time complexity: O(m log n) where m is the length of the pattern
+func (sa *SuffixArray) Contains(pattern string) bool {
+	return sa.Count(pattern) > 0
+}
+
+// Count returns the number of possibly overlapping occurrences of the
+// pattern in the text. Like strings.Count, an empty pattern matches at
+// every position including the end, so it counts len(text)+1.
+//
+// Time complexity: O(m log n) where m is the length of the pattern
+func (sa *SuffixArray) Count(pattern string) int {
+	if pattern == "" {
+		return len(sa.text) + 1
+	}
+
+	lo, hi := sa.find(pattern)
+	return hi - lo
+}
+
+// FindAll returns the starting positions of all possibly overlapping
+// occurrences of the pattern, in ascending order. An empty pattern
+// matches at every position from 0 to len(text).
+//
+// Time complexity: O(m log n + k log k) where m is the length of the
+// pattern and k is the number of occurrences
+//
+// Example:
+//
+//	sa := NewSuffixArray("abracadabra")
+//	sa.FindAll("abra")  // Returns [0 7]
+//	sa.FindAll("a")     // Returns [0 3 5 7 10]
+func (sa *SuffixArray) FindAll(pattern string) []int {
+	if pattern == "" {
+		positions := make([]int, len(sa.text)+1)
+		for i := range positions {
+			positions[i] = i
+		}
+		return positions
+	}
+
+	lo, hi := sa.find(pattern)
+	positions := slices.Clone(sa.suffixes[lo:hi])
+	slices.Sort(positions)
+	return positions
+}
+
+// LongestRepeatedSubstring returns the longest substring that occurs at
+// least twice in the text, or "" if no byte repeats. Ties are broken in
+// favor of the lexicographically smallest substring.
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	NewSuffixArray("banana").LongestRepeatedSubstring()  // Returns "ana"
+func (sa *SuffixArray) LongestRepeatedSubstring() string {
+	best := 0
+	for i, l := range sa.lcp {
+		if l > sa.lcp[best] {
+			best = i
+		}
+	}
+
+	// Special case: an empty text has no LCP entries
+	if len(sa.lcp) == 0 || sa.lcp[best] == 0 {
+		return ""
+	}
+
+	start := sa.suffixes[best]
+	return sa.text[start : start+sa.lcp[best]]
+}
+
+// Suffixes returns a copy of the suffix array: the starting positions of
+// all suffixes in lexicographic order.
+//
+// Time complexity: O(n)
+func (sa *SuffixArray) Suffixes() []int {
+	return slices.Clone(sa.suffixes)
+}
+
+// LCP returns a copy of the LCP array, where element i is the length of
+// the longest common prefix of the suffixes at ranks i-1 and i.
+// Element 0 is always 0.
+//
+// Time complexity: O(n)
+func (sa *SuffixArray) LCP() []int {
+	return slices.Clone(sa.lcp)
+}
+
+// Size returns the length of the indexed text in bytes.
+//
+// Time complexity: O(1)
+func (sa *SuffixArray) Size() int {
+	return len(sa.text)
+}
+
+// Returns the half-open range of ranks whose suffixes start with the
+// non-empty pattern.
+func (sa *SuffixArray) find(pattern string) (int, int) {
+	// Compares suffixes truncated to the length of the pattern; the
+	// position -1 stands for the pattern itself
+	prefix := func(start int) string {
+		if start < 0 {
+			return pattern
+		}
+
+		return sa.text[start:min(start+len(pattern), len(sa.text))]
+	}
+
+	return algorithms.EqualRange(sa.suffixes, -1, func(a, b int) bool {
+		return prefix(a) < prefix(b)
+	})
+}
+
+// Sorts the suffixes of the text by prefix doubling: after each round,
+// suffixes are ordered by their first 2k bytes, using the ranks of the
+// two k-byte halves as a sort key.
+func buildSuffixes(text string) []int {
+	n := len(text)
+	suffixes := make([]int, n)
+	rank := make([]int, n)
+	next := make([]int, n)
+	for i := range n {
+		suffixes[i] = i
+		rank[i] = int(text[i])
+	}
+
+	if n == 0 {
+		return suffixes
+	}
+
+	// Rank of the second half, -1 when it runs past the end of the text
+	second := func(i int, k int) int {
+		if i+k < n {
+			return rank[i+k]
+		}
+		return -1
+	}
+
+	for k := 1; ; k *= 2 {
+		less := func(a int, b int) bool {
+			if rank[a] != rank[b] {
+				return rank[a] < rank[b]
+			}
+			return second(a, k) < second(b, k)
+		}
+		sort.Slice(suffixes, func(i int, j int) bool {
+			return less(suffixes[i], suffixes[j])
+		})
+
+		// Re-rank: equal keys share a rank
+		next[suffixes[0]] = 0
+		for i := 1; i < n; i++ {
+			next[suffixes[i]] = next[suffixes[i-1]]
+			if less(suffixes[i-1], suffixes[i]) {
+				next[suffixes[i]]++
+			}
+		}
+		copy(rank, next)
+
+		// Special case: all ranks distinct, the order is final
+		if rank[suffixes[n-1]] == n-1 {
+			return suffixes
+		}
+	}
+}
+
+// Computes the LCP array with Kasai's algorithm. Visiting suffixes in text
+// order, the common prefix with the preceding suffix shrinks by at most
+// one per step, so the total work is linear.
+func buildLCP(text string, suffixes []int) []int {
+	n := len(text)
+	lcp := make([]int, n)
+	rankOf := make([]int, n)
+	for r, start := range suffixes {
+		rankOf[start] = r
+	}
+
+	h := 0
+	for i := range n {
+		r := rankOf[i]
+		if r == 0 {
+			h = 0
+			continue
+		}
+
+		j := suffixes[r-1]
+		for i+h < n && j+h < n && text[i+h] == text[j+h] {
+			h++
+		}
+
+		lcp[r] = h
+		if h > 0 {
+			h--
+		}
+	}
+
+	return lcp
+}
@@ -0,0 +1,164 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewSuffixArray):
+  ✓ Empty text
+  ✓ Suffix order for a known text
+  ✓ LCP values for a known text
+
+Contains/Count/FindAll:
+  ✓ Present, absent and overlapping patterns
+  ✓ Pattern longer than the text
+  ✓ Empty pattern
+
+LongestRepeatedSubstring:
+  ✓ Empty text and text without repeats
+  ✓ Known texts
+
+Randomized:
+  ✓ Suffix order, LCP and queries match brute force
+*/
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the positions of all overlapping occurrences by brute force.
+func bruteForceFindAll(text string, pattern string) []int {
+	positions := []int{}
+	for i := 0; i+len(pattern) <= len(text); i++ {
+		if text[i:i+len(pattern)] == pattern {
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
+
+// Verifies an empty text yields empty arrays and only the empty pattern
+func TestSuffixArray_NewSuffixArray_EmptyText(t *testing.T) {
+	sa := NewSuffixArray("")
+	test.GotWant(t, sa.Size(), 0)
+	test.GotWant(t, len(sa.Suffixes()), 0)
+	test.GotWant(t, len(sa.LCP()), 0)
+	test.GotWant(t, sa.Contains("a"), false)
+	test.GotWant(t, sa.Count(""), 1)
+	test.GotWant(t, sa.LongestRepeatedSubstring(), "")
+}
+
+// Verifies the suffix order for "banana"
+func TestSuffixArray_NewSuffixArray_Suffixes(t *testing.T) {
+	sa := NewSuffixArray("banana")
+	// a, ana, anana, banana, na, nana
+	test.GotWantSlice(t, sa.Suffixes(), []int{5, 3, 1, 0, 4, 2})
+}
+
+// Verifies the LCP values for "banana"
+func TestSuffixArray_NewSuffixArray_LCP(t *testing.T) {
+	sa := NewSuffixArray("banana")
+	test.GotWantSlice(t, sa.LCP(), []int{0, 1, 3, 0, 0, 2})
+}
+
+// Verifies pattern queries for present, absent and overlapping patterns
+func TestSuffixArray_FindAll(t *testing.T) {
+	sa := NewSuffixArray("abracadabra")
+
+	cases := []struct {
+		pattern string
+		want    []int
+	}{
+		{"abra", []int{0, 7}},
+		{"a", []int{0, 3, 5, 7, 10}},
+		{"cad", []int{4}},
+		{"abracadabra", []int{0}},
+		{"abracadabrax", []int{}},
+		{"dab", []int{6}},
+		{"x", []int{}},
+		{"ra", []int{2, 9}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			test.GotWantSlice(t, sa.FindAll(tc.pattern), tc.want)
+			test.GotWant(t, sa.Count(tc.pattern), len(tc.want))
+			test.GotWant(t, sa.Contains(tc.pattern), len(tc.want) > 0)
+		})
+	}
+
+	overlapping := NewSuffixArray("aaaa")
+	test.GotWantSlice(t, overlapping.FindAll("aa"), []int{0, 1, 2})
+}
+
+// Verifies the empty pattern matches at every position, like strings.Count
+func TestSuffixArray_Count_EmptyPattern(t *testing.T) {
+	sa := NewSuffixArray("abc")
+	test.GotWant(t, sa.Count(""), strings.Count("abc", ""))
+	test.GotWant(t, sa.Contains(""), true)
+	test.GotWantSlice(t, sa.FindAll(""), []int{0, 1, 2, 3})
+}
+
+// Verifies texts without repeated substrings
+func TestSuffixArray_LongestRepeatedSubstring_NoRepeats(t *testing.T) {
+	test.GotWant(t, NewSuffixArray("abcdef").LongestRepeatedSubstring(), "")
+	test.GotWant(t, NewSuffixArray("x").LongestRepeatedSubstring(), "")
+}
+
+// Verifies the longest repeated substring of known texts
+func TestSuffixArray_LongestRepeatedSubstring(t *testing.T) {
+	cases := map[string]string{
+		"banana":      "ana",
+		"abracadabra": "abra",
+		"aaaa":        "aaa",
+		"abcabxab":    "ab",
+	}
+
+	for text, want := range cases {
+		t.Run(text, func(t *testing.T) {
+			test.GotWant(t, NewSuffixArray(text).LongestRepeatedSubstring(), want)
+		})
+	}
+}
+
+// Verifies the suffix order, LCP array and queries against brute force
+// on random texts over a small alphabet
+func TestSuffixArray_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+	for range 50 {
+		var sb strings.Builder
+		for range r.IntN(200) {
+			sb.WriteByte("ab"[r.IntN(2)])
+		}
+		text := sb.String()
+		sa := NewSuffixArray(text)
+
+		suffixes := sa.Suffixes()
+		lcp := sa.LCP()
+		for i := 1; i < len(suffixes); i++ {
+			prev, curr := text[suffixes[i-1]:], text[suffixes[i]:]
+			if prev >= curr {
+				t.Fatalf("suffixes out of order at rank %d in %q", i, text)
+			}
+			test.GotWant(t, lcp[i], commonPrefixLength(prev, curr))
+		}
+
+		for range 20 {
+			start := r.IntN(len(text) + 1)
+			end := min(len(text), start+r.IntN(6)+1)
+			// Extending a substring may or may not keep it present
+			pattern := text[start:end] + []string{"", "a", "b"}[r.IntN(3)]
+			test.GotWantSlice(t, sa.FindAll(pattern), bruteForceFindAll(text, pattern))
+		}
+	}
+
+	// Suffixes and positions must be a permutation of the text positions
+	sa := NewSuffixArray("mississippi")
+	got := sa.Suffixes()
+	slices.Sort(got)
+	test.GotWantSlice(t, got, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+}
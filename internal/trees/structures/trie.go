@@ -0,0 +1,427 @@
+package structures
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/collections"
+)
+
+// Compile-time interface verifications
+var _ collections.Sized = &Trie{}
+var _ collections.Iterable[string] = &Trie{}
+var _ collections.Collection[string] = &Trie{}
+
+// Represents the children of a trie node, keyed by the next byte.
+// Implementations must visit children in ascending byte order.
+type trieChildren interface {
+	get(b byte) *trieNode
+	set(b byte, n *trieNode)
+	remove(b byte)
+	each(yield func(byte, *trieNode) bool) bool
+}
+
+// Stores trie children in a map. Pays only for existing edges.
+type trieMapChildren map[byte]*trieNode
+
+func (c trieMapChildren) get(b byte) *trieNode {
+	return c[b]
+}
+
+func (c trieMapChildren) set(b byte, n *trieNode) {
+	c[b] = n
+}
+
+func (c trieMapChildren) remove(b byte) {
+	delete(c, b)
+}
+
+func (c trieMapChildren) each(yield func(byte, *trieNode) bool) bool {
+	for _, b := range slices.Sorted(maps.Keys(c)) {
+		if !yield(b, c[b]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Stores trie children in a fixed array indexed by byte.
+type trieArrayChildren [256]*trieNode
+
+func (c *trieArrayChildren) get(b byte) *trieNode {
+	return c[b]
+}
+
+func (c *trieArrayChildren) set(b byte, n *trieNode) {
+	c[b] = n
+}
+
+func (c *trieArrayChildren) remove(b byte) {
+	c[b] = nil
+}
+
+func (c *trieArrayChildren) each(yield func(byte, *trieNode) bool) bool {
+	for i, n := range c {
+		if n != nil && !yield(byte(i), n) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Represents a single node in a trie.
+// The node's position in the tree spells out the prefix it represents.
+type trieNode struct {
+	children trieChildren
+	terminal bool // A stored word ends at this node
+	count    int  // Number of stored words in this subtree, including this node
+}
+
+// Trie implements a prefix tree over strings.
+//
+// Words sharing a prefix share the path of nodes spelling it, so prefix
+// queries (HasPrefix, CountPrefix, WordsWithPrefix) cost time proportional
+// to the prefix length rather than the number of stored words. This makes
+// the trie a natural fit for autocomplete and dictionary lookups.
+//
+// Design decisions:
+//   - Byte edges: Words are treated as byte sequences, so iteration order
+//     matches Go string ordering
+//   - Subtree word counts: Enable O(m) CountPrefix without traversal
+//   - Selectable child storage: Maps (compact) or arrays (fast), see TrieConfig
+//   - Pruning on delete: Nodes that no longer lead to a word are removed
+//
+// Space complexity: O(L) nodes where L is the total length of all words.
+type Trie struct {
+	root   *trieNode
+	config TrieConfig
+}
+
+// NewTrie creates a trie with map-based child storage, which keeps memory
+// proportional to the number of edges.
+//
+// For lookup-heavy workloads over dense key sets, use NewTrieWith with
+// WithArrayChildren.
+//
+// Example:
+//
+//	t := NewTrie("car", "cart", "dog")
+//	t.CountPrefix("car")  // Returns 2
+func NewTrie(words ...string) *Trie {
+	return newTrie(TrieConfig{}, words...)
+}
+
+// NewTrieWith creates an empty trie from the default configuration
+// adjusted by the given options. Options are applied in order.
+//
+// Example:
+//
+//	t := NewTrieWith(WithArrayChildren(true))
+func NewTrieWith(opts ...TrieOption) *Trie {
+	var c TrieConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return newTrie(c)
+}
+
+// NewTrieWithConfig creates a trie with custom settings.
+// See TrieConfig for configuration options and tuning guidance.
+//
+// Deprecated: Use NewTrieWith with WithArrayChildren, then Insert.
+func NewTrieWithConfig(config TrieConfig, words ...string) *Trie {
+	return newTrie(config, words...)
+}
+
+// Creates a trie with the given settings containing the words.
+func newTrie(config TrieConfig, words ...string) *Trie {
+	t := &Trie{config: config}
+	t.root = t.newNode()
+	for _, w := range words {
+		t.Insert(w)
+	}
+
+	return t
+}
+
+// Insert adds the word to the trie.
+// Returns true if the word was added, false if it was already present.
+//
+// Time complexity: O(m) where m is the length of the word
+func (t *Trie) Insert(word string) bool {
+	if t.Contains(word) {
+		return false
+	}
+
+	n := t.root
+	n.count++
+	for i := 0; i < len(word); i++ {
+		child := n.children.get(word[i])
+		if child == nil {
+			child = t.newNode()
+			n.children.set(word[i], child)
+		}
+
+		n = child
+		n.count++
+	}
+
+	n.terminal = true
+	return true
+}
+
+// Clear removes all words. The child storage setting is kept.
+//
+// Time complexity: O(1)
+func (t *Trie) Clear() {
+	t.root = t.newNode()
+}
+
+// Contains returns true if the word is stored in the trie.
+//
+// Time complexity: O(m) where m is the length of the word
+func (t *Trie) Contains(word string) bool {
+	n := t.find(word)
+	return n != nil && n.terminal
+}
+
+// Delete removes the word from the trie, pruning nodes that no longer
+// lead to any stored word.
+// Returns true if the word was found and removed, false otherwise.
+//
+// Time complexity: O(m) where m is the length of the word
+func (t *Trie) Delete(word string) bool {
+	if !t.Contains(word) {
+		return false
+	}
+
+	n := t.root
+	n.count--
+	for i := 0; i < len(word); i++ {
+		child := n.children.get(word[i])
+		child.count--
+		if child.count == 0 {
+			// Special case: nothing below leads to a word, drop the branch
+			n.children.remove(word[i])
+			return true
+		}
+
+		n = child
+	}
+
+	n.terminal = false
+	return true
+}
+
+// HasPrefix returns true if at least one stored word starts with the prefix.
+// Every trie containing a word has the empty prefix.
+//
+// Time complexity: O(m) where m is the length of the prefix
+func (t *Trie) HasPrefix(prefix string) bool {
+	return t.CountPrefix(prefix) > 0
+}
+
+// CountPrefix returns the number of stored words that start with the prefix.
+//
+// Time complexity: O(m) where m is the length of the prefix
+//
+// Example:
+//
+//	t := NewTrie("car", "cart", "care", "dog")
+//	t.CountPrefix("car")  // Returns 3
+//	t.CountPrefix("ca")   // Returns 3
+//	t.CountPrefix("cat")  // Returns 0
+func (t *Trie) CountPrefix(prefix string) int {
+	n := t.find(prefix)
+	if n == nil {
+		return 0
+	}
+
+	return n.count
+}
+
+// WordsWithPrefix returns an iterator over the stored words that start with
+// the prefix, in ascending order. The trie must not be modified during
+// iteration.
+//
+// Time complexity: O(m + k) where m is the length of the prefix and k is
+// the total length of the words yielded
+//
+// Example:
+//
+//	t := NewTrie("car", "cart", "care", "dog")
+//	for w := range t.WordsWithPrefix("car") {
+//	    fmt.Println(w)  // "car", "care", "cart"
+//	}
+func (t *Trie) WordsWithPrefix(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		n := t.find(prefix)
+		if n == nil {
+			return
+		}
+
+		t.walk(n, []byte(prefix), yield)
+	}
+}
+
+// All returns an iterator over all stored words in ascending order.
+// The trie must not be modified during iteration.
+//
+// Time complexity: O(L) for a full iteration where L is the total length
+// of all words
+func (t *Trie) All() iter.Seq[string] {
+	return t.WordsWithPrefix("")
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It encodes the words
+// in lexicographic order in the format of package codec.
+//
+// Time complexity: O(n·m) where m is the average word length
+func (t *Trie) MarshalBinary() ([]byte, error) {
+	return codec.EncodeSeq("Trie", t.Size(), t.All())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the
+// words of the trie with those encoded by MarshalBinary, keeping the
+// child storage setting.
+// Returns an error wrapping codec.ErrInvalidData if the data is not an
+// encoded trie, in which case the trie is left unchanged.
+//
+// Time complexity: O(n·m) where m is the average word length
+func (t *Trie) UnmarshalBinary(data []byte) error {
+	words, err := codec.DecodeSlice[string](data, "Trie")
+	if err != nil {
+		return err
+	}
+
+	t.restore(words)
+	return nil
+}
+
+// WriteTo implements io.WriterTo. It streams the encoding of
+// MarshalBinary to w in fixed-size chunks, without building it in memory.
+// Returns the number of bytes written and the first error.
+//
+// Time complexity: O(n·m) where m is the average word length
+func (t *Trie) WriteTo(w io.Writer) (int64, error) {
+	return codec.WriteSeq(w, "Trie", t.Size(), t.All())
+}
+
+// ReadFrom implements io.ReaderFrom. It replaces the words of the trie
+// with those read from r, which must hold a single encoding of
+// MarshalBinary or WriteTo and nothing after it.
+// Returns the number of bytes read and the first error. The trie is left
+// unchanged on error.
+//
+// Time complexity: O(n·m) where m is the average word length
+func (t *Trie) ReadFrom(r io.Reader) (int64, error) {
+	words, n, err := codec.ReadSlice[string](r, "Trie")
+	if err != nil {
+		return n, err
+	}
+
+	t.restore(words)
+	return n, nil
+}
+
+// IsEmpty returns true if the trie contains no words.
+//
+// Time complexity: O(1)
+func (t *Trie) IsEmpty() bool {
+	return t.root.count == 0
+}
+
+// Size returns the number of words in the trie.
+//
+// Time complexity: O(1)
+func (t *Trie) Size() int {
+	return t.root.count
+}
+
+// CheckInvariants verifies the internal consistency of the trie: every
+// node's count is the number of words ending at it plus the counts of its
+// children, and every node below the root leads to at least one word, so
+// deleted branches were pruned. Intended for tests and debugging.
+// Returns an error wrapping ErrInvariantViolation that describes
+// the first violation found, or nil if the trie is consistent.
+//
+// Time complexity: O(L) where L is the total length of all words
+func (t *Trie) CheckInvariants() error {
+	return t.check(t.root, nil)
+}
+
+// Replaces the words with the decoded ones.
+func (t *Trie) restore(words []string) {
+	t.Clear()
+	for _, w := range words {
+		t.Insert(w)
+	}
+}
+
+// Creates an empty node using the configured child storage.
+func (t *Trie) newNode() *trieNode {
+	if t.config.ArrayChildren {
+		return &trieNode{children: &trieArrayChildren{}}
+	}
+
+	return &trieNode{children: trieMapChildren{}}
+}
+
+// Returns the node reached by following the prefix from the root,
+// or nil if no stored word starts with the prefix.
+func (t *Trie) find(prefix string) *trieNode {
+	n := t.root
+	for i := 0; i < len(prefix) && n != nil; i++ {
+		n = n.children.get(prefix[i])
+	}
+
+	return n
+}
+
+// Yields the words of the subtree in ascending order, where buf holds
+// the prefix spelled by the path to the node.
+// Returns false if the iteration must stop.
+func (t *Trie) walk(n *trieNode, buf []byte, yield func(string) bool) bool {
+	if n.terminal && !yield(string(buf)) {
+		return false
+	}
+
+	return n.children.each(func(b byte, child *trieNode) bool {
+		return t.walk(child, append(buf, b), yield)
+	})
+}
+
+// Verifies the invariants of the subtree, where buf holds the prefix
+// spelled by the path to the node.
+func (t *Trie) check(n *trieNode, buf []byte) error {
+	want := 0
+	if n.terminal {
+		want = 1
+	}
+
+	var err error
+	n.children.each(func(b byte, child *trieNode) bool {
+		want += child.count
+		err = t.check(child, append(buf, b))
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if n.count != want {
+		return fmt.Errorf("%w: prefix %q has count %d, want %d", ErrInvariantViolation, buf, n.count, want)
+	}
+	if n != t.root && n.count == 0 {
+		return fmt.Errorf("%w: prefix %q leads to no word", ErrInvariantViolation, buf)
+	}
+
+	return nil
+}
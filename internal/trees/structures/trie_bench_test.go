@@ -0,0 +1,92 @@
+package structures
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/bench"
+)
+
+// Benchmark configurations representing different child storage layouts.
+var trieConfigs = map[string]TrieConfig{
+	// Map: Children stored in per-node maps.
+	// Expected: Smallest footprint, slower edge lookups.
+	"Map": {ArrayChildren: false},
+	// Array: Children stored in fixed 256-slot arrays.
+	// Expected: Fastest lookups, largest footprint.
+	"Array": {ArrayChildren: true},
+}
+
+// Number of words preloaded into every benchmark trie.
+const benchTrieSize = 50_000
+
+// Returns benchTrieSize words sharing short common prefixes,
+// resembling a dictionary of identifiers.
+func benchTrieWords() []string {
+	words := make([]string, benchTrieSize)
+	for i := range words {
+		words[i] = fmt.Sprintf("key%06d", i)
+	}
+	return words
+}
+
+// BenchmarkTrie_Contains measures exact lookups of stored words.
+//
+// Pattern: [Contains(word)] × 50,000
+// Expected winner: Array (one index per byte instead of a map lookup)
+func BenchmarkTrie_Contains(b *testing.B) {
+	words := benchTrieWords()
+	for name, config := range trieConfigs {
+		b.Run(name, func(b *testing.B) {
+			trie := NewTrieWithConfig(config, words...)
+			b.ResetTimer()
+			for b.Loop() {
+				for _, w := range words {
+					trie.Contains(w)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkTrie_WordsWithPrefix measures autocomplete-style iteration.
+//
+// Pattern: [WordsWithPrefix("key01")] yielding 10,000 words
+// Expected: Comparable, string building dominates; Array skips sorting keys
+func BenchmarkTrie_WordsWithPrefix(b *testing.B) {
+	words := benchTrieWords()
+	for name, config := range trieConfigs {
+		b.Run(name, func(b *testing.B) {
+			trie := NewTrieWithConfig(config, words...)
+			b.ResetTimer()
+			for b.Loop() {
+				for range trie.WordsWithPrefix("key01") {
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkTrie_TotalMemory measures the heap retained by a loaded trie.
+// Reports the custom metric "retained-KB".
+//
+// Pattern: Insert 50,000 words
+// Expected: Map retains a fraction of Array's memory
+func BenchmarkTrie_TotalMemory(b *testing.B) {
+	words := benchTrieWords()
+	for name, config := range trieConfigs {
+		b.Run(name, func(b *testing.B) {
+			var trie *Trie
+			var retained int
+			for b.Loop() {
+				memory := bench.TrackMemory()
+				trie = NewTrieWithConfig(config, words...)
+				retained = memory.Retained()
+			}
+
+			runtime.KeepAlive(trie)
+			bench.ReportRetained(b, retained)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package structures
+
+// TrieConfig controls how Trie nodes store their children.
+//
+// The trie supports two child storage layouts:
+//
+// Map children (default, memory-optimized):
+//
+// Each node keeps a map from the next byte to the child node. Nodes only
+// pay for the children they actually have, which suits large alphabets
+// and sparse key sets such as arbitrary UTF-8 text.
+//
+// Array children (speed-optimized):
+//
+// Each node keeps a fixed array with one slot for every possible byte.
+// Following an edge is a single index operation and ordered traversal
+// needs no sorting, but every node costs 256 pointers (2 KB on 64-bit
+// platforms) no matter how many children it has. This suits dense key
+// sets with many shared prefixes and lookup-heavy workloads.
+type TrieConfig struct {
+	// ArrayChildren selects fixed 256-slot child arrays instead of maps.
+	//
+	// When enabled, lookups and prefix iteration are faster at the cost of
+	// a much larger per-node footprint.
+	ArrayChildren bool
+}
+
+// TrieOption configures a Trie created with NewTrieWith. Options are
+// applied in order on top of the default configuration.
+type TrieOption func(*TrieConfig)
+
+// WithArrayChildren selects fixed 256-slot child arrays instead of maps.
+// See TrieConfig.ArrayChildren.
+func WithArrayChildren(enabled bool) TrieOption {
+	return func(c *TrieConfig) {
+		c.ArrayChildren = enabled
+	}
+}
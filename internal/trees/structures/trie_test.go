@@ -0,0 +1,307 @@
+package structures
+
+/*
+Test Coverage
+=============
+Constructor (NewTrie/NewTrieWithConfig):
+  ✓ Empty trie
+  ✓ With words (duplicates collapsed)
+
+Insert/Contains:
+  ✓ Insert new and existing words
+  ✓ Prefix of a stored word is not contained
+  ✓ Empty word
+
+Delete:
+  ✓ Delete absent word and stored prefix
+  ✓ Delete leaf word prunes its branch
+  ✓ Delete word that is a prefix of another keeps the branch
+
+HasPrefix/CountPrefix:
+  ✓ Empty trie
+  ✓ Shared and missing prefixes
+
+WordsWithPrefix/All:
+  ✓ Ascending order
+  ✓ Missing prefix
+  ✓ Early termination
+
+CheckInvariants:
+  ✓ Valid tries, wrong counts, unpruned branch
+
+All tests run against both map and array child storage.
+
+Options (NewTrieWith):
+  ✓ No options uses map children, WithArrayChildren selects arrays
+
+Clear:
+  ✓ Removes words, keeps the child storage layout
+
+Binary encoding:
+  ✓ Round trip replaces the words, keeps the child storage layout,
+    invalid data rejected
+  ✓ WriteTo matches the MarshalBinary size, ReadFrom round trip
+*/
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/codectest"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Child storage layouts every test runs against.
+var trieTestConfigs = map[string]TrieConfig{
+	"Map":   {ArrayChildren: false},
+	"Array": {ArrayChildren: true},
+}
+
+// Runs the test body once per child storage layout.
+func forEachTrieConfig(t *testing.T, body func(t *testing.T, config TrieConfig)) {
+	for name, config := range trieTestConfigs {
+		t.Run(name, func(t *testing.T) {
+			body(t, config)
+		})
+	}
+}
+
+// Verifies the creation of an empty trie
+func TestTrie_NewTrie_Empty(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config)
+		test.GotWant(t, trie.Size(), 0)
+		test.GotWant(t, trie.IsEmpty(), true)
+		test.GotWant(t, len(slices.Collect(trie.All())), 0)
+	})
+}
+
+// Verifies the creation of a trie with words, storing duplicates once
+func TestTrie_NewTrie_WithWords(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config, "dog", "car", "dog")
+		test.GotWant(t, trie.Size(), 2)
+		test.GotWant(t, trie.IsEmpty(), false)
+		test.GotWantSlice(t, slices.Collect(trie.All()), []string{"car", "dog"})
+	})
+}
+
+// Verifies inserting new and existing words
+func TestTrie_Insert(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config)
+		test.GotWant(t, trie.Insert("car"), true)
+		test.GotWant(t, trie.Insert("cart"), true)
+		test.GotWant(t, trie.Insert("car"), false)
+		test.GotWant(t, trie.Size(), 2)
+		test.GotWant(t, trie.Contains("car"), true)
+		test.GotWant(t, trie.Contains("cart"), true)
+	})
+}
+
+// Verifies a prefix of a stored word is not itself contained
+func TestTrie_Contains_Prefix(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config, "cart")
+		test.GotWant(t, trie.Contains("car"), false)
+		test.GotWant(t, trie.Contains("carts"), false)
+		test.GotWant(t, trie.Contains(""), false)
+	})
+}
+
+// Verifies the empty word can be stored and removed
+func TestTrie_Insert_EmptyWord(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config, "a")
+		test.GotWant(t, trie.Insert(""), true)
+		test.GotWant(t, trie.Contains(""), true)
+		test.GotWant(t, trie.Size(), 2)
+		test.GotWantSlice(t, slices.Collect(trie.All()), []string{"", "a"})
+
+		test.GotWant(t, trie.Delete(""), true)
+		test.GotWant(t, trie.Contains(""), false)
+		test.GotWant(t, trie.Contains("a"), true)
+	})
+}
+
+// Verifies deleting absent words and stored prefixes changes nothing
+func TestTrie_Delete_Absent(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config, "cart")
+		test.GotWant(t, trie.Delete("dog"), false)
+		test.GotWant(t, trie.Delete("car"), false)
+		test.GotWant(t, trie.Delete("carts"), false)
+		test.GotWant(t, trie.Size(), 1)
+		test.GotWant(t, trie.Contains("cart"), true)
+	})
+}
+
+// Verifies deleting a leaf word prunes the branch only it used
+func TestTrie_Delete_PrunesBranch(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config, "car", "cart", "dog")
+		test.GotWant(t, trie.Delete("dog"), true)
+		test.GotWant(t, trie.root.children.get('d') == nil, true)
+		test.GotWant(t, trie.HasPrefix("d"), false)
+
+		test.GotWant(t, trie.Delete("cart"), true)
+		test.GotWant(t, trie.find("cart") == nil, true)
+		test.GotWant(t, trie.Contains("car"), true)
+		test.GotWant(t, trie.Size(), 1)
+		test.GotWantNoError(t, trie.CheckInvariants())
+	})
+}
+
+// Verifies deleting a word that prefixes another keeps the longer word
+func TestTrie_Delete_PrefixWord(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config, "car", "cart")
+		test.GotWant(t, trie.Delete("car"), true)
+		test.GotWant(t, trie.Contains("car"), false)
+		test.GotWant(t, trie.Contains("cart"), true)
+		test.GotWant(t, trie.CountPrefix("car"), 1)
+	})
+}
+
+// Verifies prefix queries on an empty trie
+func TestTrie_CountPrefix_EmptyTrie(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config)
+		test.GotWant(t, trie.HasPrefix(""), false)
+		test.GotWant(t, trie.HasPrefix("a"), false)
+		test.GotWant(t, trie.CountPrefix(""), 0)
+	})
+}
+
+// Verifies prefix queries for shared and missing prefixes
+func TestTrie_CountPrefix(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config, "car", "cart", "care", "dog")
+
+		cases := map[string]int{
+			"":      4,
+			"c":     3,
+			"car":   3,
+			"cart":  1,
+			"cat":   0,
+			"dog":   1,
+			"dogs":  0,
+			"zebra": 0,
+		}
+		for prefix, want := range cases {
+			test.GotWant(t, trie.CountPrefix(prefix), want)
+			test.GotWant(t, trie.HasPrefix(prefix), want > 0)
+		}
+	})
+}
+
+// Verifies WordsWithPrefix yields matching words in ascending order
+func TestTrie_WordsWithPrefix_Order(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config, "cart", "dog", "car", "care", "c", "carbon")
+		test.GotWantSlice(t, slices.Collect(trie.WordsWithPrefix("car")),
+			[]string{"car", "carbon", "care", "cart"})
+		test.GotWantSlice(t, slices.Collect(trie.All()),
+			[]string{"c", "car", "carbon", "care", "cart", "dog"})
+	})
+}
+
+// Verifies WordsWithPrefix yields nothing for a missing prefix
+func TestTrie_WordsWithPrefix_Missing(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config, "car", "dog")
+		test.GotWant(t, len(slices.Collect(trie.WordsWithPrefix("cat"))), 0)
+		test.GotWant(t, len(slices.Collect(trie.WordsWithPrefix("dogs"))), 0)
+	})
+}
+
+// Verifies iteration stops when the consumer breaks
+func TestTrie_WordsWithPrefix_EarlyTermination(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config, "a", "ab", "abc", "b")
+		got := []string{}
+		for w := range trie.All() {
+			got = append(got, w)
+			if len(got) == 2 {
+				break
+			}
+		}
+		test.GotWantSlice(t, got, []string{"a", "ab"})
+	})
+}
+
+// Verifies CheckInvariants accepts valid tries and reports wrong counts
+// and unpruned branches
+func TestTrie_CheckInvariants(t *testing.T) {
+	forEachTrieConfig(t, func(t *testing.T, config TrieConfig) {
+		trie := NewTrieWithConfig(config)
+		test.GotWantNoError(t, trie.CheckInvariants())
+		trie = NewTrieWithConfig(config, "car", "cart", "dog")
+		test.GotWantNoError(t, trie.CheckInvariants())
+
+		cart := trie.find("cart")
+		cart.count++
+		test.GotWantError(t, trie.CheckInvariants(), `invariant violation: prefix "cart" has count 2, want 1`)
+		cart.count--
+
+		trie.root.count++
+		test.GotWantError(t, trie.CheckInvariants(), `invariant violation: prefix "" has count 4, want 3`)
+		test.GotWantErrorIs(t, trie.CheckInvariants(), ErrInvariantViolation)
+		trie.root.count--
+
+		trie.root.children.set('x', trie.newNode())
+		test.GotWantError(t, trie.CheckInvariants(), `invariant violation: prefix "x" leads to no word`)
+		trie.root.children.remove('x')
+		test.GotWantNoError(t, trie.CheckInvariants())
+	})
+}
+
+// Verifies the child storage selected by the options
+func TestTrie_NewTrieWith(t *testing.T) {
+	test.GotWant(t, NewTrieWith().config, TrieConfig{})
+
+	trie := NewTrieWith(WithArrayChildren(true))
+	test.GotWant(t, trie.config.ArrayChildren, true)
+
+	trie.Insert("car")
+	test.GotWant(t, trie.Contains("car"), true)
+}
+
+// Verifies Clear empties the trie with either child storage layout
+func TestTrie_Clear(t *testing.T) {
+	for name, config := range trieTestConfigs {
+		t.Run(name, func(t *testing.T) {
+			trie := NewTrieWithConfig(config, "car", "cart", "dog")
+
+			trie.Clear()
+			test.GotWant(t, trie.Size(), 0)
+			test.GotWant(t, trie.IsEmpty(), true)
+			test.GotWant(t, trie.Contains("car"), false)
+
+			trie.Insert("cat")
+			test.GotWantSlice(t, slices.Collect(trie.All()), []string{"cat"})
+		})
+	}
+}
+
+// Verifies a round trip restores the words with either child storage
+// layout and invalid data is rejected
+func TestTrie_MarshalBinary(t *testing.T) {
+	other, _ := NewRadixTree[int]().MarshalBinary()
+	for name, config := range trieTestConfigs {
+		t.Run(name, func(t *testing.T) {
+			dst := NewTrieWithConfig(config, "zebra")
+			codectest.RoundTrip(t, NewTrie("car", "cart", "", "dog"), dst,
+				func(trie *Trie) []string { return slices.Collect(trie.All()) }, other)
+			test.GotWant(t, dst.config, config)
+		})
+	}
+}
+
+// Verifies a WriteTo/ReadFrom round trip restores the words
+func TestTrie_WriteTo_ReadFrom(t *testing.T) {
+	src := NewTrie("tea", "ten", "to")
+	dst := NewTrie()
+	codectest.StreamRoundTrip(t, src, dst,
+		func(trie *Trie) []string { return slices.Collect(trie.All()) })
+}
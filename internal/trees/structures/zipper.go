@@ -0,0 +1,297 @@
+package structures
+
+// Represents a single immutable cell in a list of sibling subtrees.
+// Cells are shared between zipper versions and never modified.
+type zipperSibling[T any] struct {
+	tree *PersistentTree[T]
+	next *zipperSibling[T]
+}
+
+// Represents one step of the path from the focus back to the root: the
+// parent's value and the focus's siblings on either side.
+// Left siblings are stored nearest first, so both directions are O(1).
+type zipperPath[T any] struct {
+	value T
+	left  *zipperSibling[T]
+	right *zipperSibling[T]
+	up    *zipperPath[T]
+	depth int // Depth of the focused node, 1 for children of the root
+}
+
+// Zipper implements focused navigation and editing of a PersistentTree.
+//
+// A zipper splits a tree into the subtree in focus and the path back to
+// the root, where each step records the parent's value and the focused
+// subtree's left and right siblings. Moving the focus to a sibling only
+// shifts one subtree between those lists, and editing the focus only
+// replaces it; the tree is reassembled when the focus moves up, rebuilding
+// the nodes on the way. Every method returns a new zipper and leaves the
+// receiver untouched, so earlier zippers keep describing the tree as it
+// was, and rebuilt trees share all unedited subtrees with the original.
+//
+// Design decisions:
+//   - Value type: Zippers are cheap to copy (two pointers)
+//   - Reversed left siblings: Left and Right are O(1)
+//   - Lazy rebuilding: A run of edits at one place costs O(1) each, and
+//     the path is rebuilt once on the way up
+//   - Boolean moves: Moving past an edge returns the receiver and false
+//
+// All methods are safe for concurrent use by multiple goroutines.
+//
+// Space complexity: O(d + k) per version, where d is the depth of the
+// focus and k the number of siblings along the path.
+type Zipper[T any] struct {
+	focus *PersistentTree[T]
+	path  *zipperPath[T] // nil when the focus is the root
+}
+
+// NewZipper creates a zipper focused on the root of the tree.
+//
+// Time complexity: O(1)
+//
+// Example:
+//
+//	t := NewPersistentTree(1, NewPersistentTree(2), NewPersistentTree(3))
+//	z, _ := NewZipper(t).Down()
+//	z, _ = z.Right()
+//	u := z.Set(30).Tree()  // u is 1(2, 30), t is still 1(2, 3)
+func NewZipper[T any](tree *PersistentTree[T]) Zipper[T] {
+	return Zipper[T]{focus: tree}
+}
+
+// Focus returns the subtree in focus, including all edits made below it.
+//
+// Time complexity: O(1)
+func (z Zipper[T]) Focus() *PersistentTree[T] {
+	return z.focus
+}
+
+// Value returns the value of the node in focus.
+//
+// Time complexity: O(1)
+func (z Zipper[T]) Value() T {
+	return z.focus.value
+}
+
+// Depth returns the distance from the root to the node in focus.
+//
+// Time complexity: O(1)
+func (z Zipper[T]) Depth() int {
+	if z.path == nil {
+		return 0
+	}
+
+	return z.path.depth
+}
+
+// IsRoot returns true if the focus is the root of the tree.
+//
+// Time complexity: O(1)
+func (z Zipper[T]) IsRoot() bool {
+	return z.path == nil
+}
+
+// Up moves the focus to the parent, rebuilding it from the focus and its
+// siblings. Returns the receiver and false if the focus is the root.
+//
+// Time complexity: O(k) where k is the number of siblings
+func (z Zipper[T]) Up() (Zipper[T], bool) {
+	p := z.path
+	if p == nil {
+		return z, false
+	}
+
+	children := []*PersistentTree[T]{}
+	for s := p.left; s != nil; s = s.next {
+		children = append(children, s.tree)
+	}
+	for i, j := 0, len(children)-1; i < j; i, j = i+1, j-1 {
+		children[i], children[j] = children[j], children[i]
+	}
+
+	children = append(children, z.focus)
+	for s := p.right; s != nil; s = s.next {
+		children = append(children, s.tree)
+	}
+
+	return Zipper[T]{focus: newPersistentTree(p.value, children), path: p.up}, true
+}
+
+// Down moves the focus to the first child.
+// Returns the receiver and false if the focus is a leaf.
+//
+// Time complexity: O(k) where k is the number of children
+func (z Zipper[T]) Down() (Zipper[T], bool) {
+	return z.DownAt(0)
+}
+
+// DownAt moves the focus to the i-th child.
+// Returns the receiver and false if i is out of range.
+//
+// Time complexity: O(k) where k is the number of children
+func (z Zipper[T]) DownAt(i int) (Zipper[T], bool) {
+	children := z.focus.children
+	if i < 0 || i >= len(children) {
+		return z, false
+	}
+
+	var left, right *zipperSibling[T]
+	for _, c := range children[:i] {
+		left = &zipperSibling[T]{tree: c, next: left}
+	}
+	for j := len(children) - 1; j > i; j-- {
+		right = &zipperSibling[T]{tree: children[j], next: right}
+	}
+
+	path := &zipperPath[T]{
+		value: z.focus.value,
+		left:  left,
+		right: right,
+		up:    z.path,
+		depth: z.Depth() + 1,
+	}
+	return Zipper[T]{focus: children[i], path: path}, true
+}
+
+// Left moves the focus to the previous sibling.
+// Returns the receiver and false if there is none.
+//
+// Time complexity: O(1)
+func (z Zipper[T]) Left() (Zipper[T], bool) {
+	p := z.path
+	if p == nil || p.left == nil {
+		return z, false
+	}
+
+	path := *p
+	path.left = p.left.next
+	path.right = &zipperSibling[T]{tree: z.focus, next: p.right}
+	return Zipper[T]{focus: p.left.tree, path: &path}, true
+}
+
+// Right moves the focus to the next sibling.
+// Returns the receiver and false if there is none.
+//
+// Time complexity: O(1)
+func (z Zipper[T]) Right() (Zipper[T], bool) {
+	p := z.path
+	if p == nil || p.right == nil {
+		return z, false
+	}
+
+	path := *p
+	path.left = &zipperSibling[T]{tree: z.focus, next: p.left}
+	path.right = p.right.next
+	return Zipper[T]{focus: p.right.tree, path: &path}, true
+}
+
+// Root moves the focus to the root of the tree, rebuilding every node on
+// the way.
+//
+// Time complexity: O(d·k) where d is the depth of the focus and k the
+// number of siblings per level
+func (z Zipper[T]) Root() Zipper[T] {
+	for ok := true; ok; {
+		z, ok = z.Up()
+	}
+
+	return z
+}
+
+// Tree returns the whole tree with all edits applied.
+//
+// Time complexity: O(d·k), see Root
+func (z Zipper[T]) Tree() *PersistentTree[T] {
+	return z.Root().focus
+}
+
+// Set replaces the value of the node in focus, keeping its children.
+//
+// Time complexity: O(k) where k is the number of children
+func (z Zipper[T]) Set(value T) Zipper[T] {
+	return Zipper[T]{focus: newPersistentTree(value, z.focus.children), path: z.path}
+}
+
+// Replace replaces the subtree in focus.
+//
+// Time complexity: O(1)
+func (z Zipper[T]) Replace(tree *PersistentTree[T]) Zipper[T] {
+	return Zipper[T]{focus: tree, path: z.path}
+}
+
+// InsertLeft inserts the subtree as the previous sibling of the focus,
+// which stays where it is.
+// Returns the receiver and false if the focus is the root.
+//
+// Time complexity: O(1)
+func (z Zipper[T]) InsertLeft(tree *PersistentTree[T]) (Zipper[T], bool) {
+	if z.path == nil {
+		return z, false
+	}
+
+	path := *z.path
+	path.left = &zipperSibling[T]{tree: tree, next: path.left}
+	return Zipper[T]{focus: z.focus, path: &path}, true
+}
+
+// InsertRight inserts the subtree as the next sibling of the focus,
+// which stays where it is.
+// Returns the receiver and false if the focus is the root.
+//
+// Time complexity: O(1)
+func (z Zipper[T]) InsertRight(tree *PersistentTree[T]) (Zipper[T], bool) {
+	if z.path == nil {
+		return z, false
+	}
+
+	path := *z.path
+	path.right = &zipperSibling[T]{tree: tree, next: path.right}
+	return Zipper[T]{focus: z.focus, path: &path}, true
+}
+
+// InsertChild inserts the subtree as the first child of the focus,
+// which stays where it is.
+//
+// Time complexity: O(k) where k is the number of children
+func (z Zipper[T]) InsertChild(tree *PersistentTree[T]) Zipper[T] {
+	children := make([]*PersistentTree[T], 0, len(z.focus.children)+1)
+	children = append(children, tree)
+	children = append(children, z.focus.children...)
+	return Zipper[T]{focus: newPersistentTree(z.focus.value, children), path: z.path}
+}
+
+// AppendChild inserts the subtree as the last child of the focus,
+// which stays where it is.
+//
+// Time complexity: O(k) where k is the number of children
+func (z Zipper[T]) AppendChild(tree *PersistentTree[T]) Zipper[T] {
+	children := make([]*PersistentTree[T], 0, len(z.focus.children)+1)
+	children = append(children, z.focus.children...)
+	children = append(children, tree)
+	return Zipper[T]{focus: newPersistentTree(z.focus.value, children), path: z.path}
+}
+
+// Remove deletes the subtree in focus and moves the focus to the next
+// sibling, or the previous sibling if it was the last, or the parent if it
+// was an only child.
+// Returns the receiver and false if the focus is the root.
+//
+// Time complexity: O(1) with siblings, O(k) when moving to the parent
+func (z Zipper[T]) Remove() (Zipper[T], bool) {
+	p := z.path
+	if p == nil {
+		return z, false
+	}
+
+	path := *p
+	switch {
+	case p.right != nil:
+		path.right = p.right.next
+		return Zipper[T]{focus: p.right.tree, path: &path}, true
+	case p.left != nil:
+		path.left = p.left.next
+		return Zipper[T]{focus: p.left.tree, path: &path}, true
+	default:
+		return Zipper[T]{focus: newPersistentTree(p.value, nil), path: p.up}, true
+	}
+}
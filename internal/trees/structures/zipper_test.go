@@ -0,0 +1,292 @@
+package structures
+
+/*
+Test Coverage
+=============
+Navigation (Down/DownAt/Up/Left/Right/Root):
+  ✓ Moves across the tree, depth and root tracking
+  ✓ Moves past the edges are rejected
+
+Editing (Set/Replace/InsertLeft/InsertRight/InsertChild/AppendChild):
+  ✓ Edits are reflected in the rebuilt tree
+  ✓ Sibling inserts at the root are rejected
+  ✓ Original tree and earlier zippers are unchanged
+  ✓ Unedited subtrees are shared
+
+Remove:
+  ✓ Focus moves right, then left, then up
+  ✓ Root cannot be removed
+
+Randomized:
+  ✓ Mixed moves and edits match a mutable model, old versions unchanged
+*/
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Returns the tree 1(2(4, 5), 3) used by the tests below.
+func zipperTestTree() *PersistentTree[int] {
+	return NewPersistentTree(1,
+		NewPersistentTree(2, NewPersistentTree(4), NewPersistentTree(5)),
+		NewPersistentTree(3),
+	)
+}
+
+// Verifies navigation follows the tree and tracks depth
+func TestZipper_Navigation(t *testing.T) {
+	z := NewZipper(zipperTestTree())
+	test.GotWant(t, z.IsRoot(), true)
+	test.GotWant(t, z.Depth(), 0)
+
+	z, ok := z.Down()
+	test.GotWant(t, ok, true)
+	test.GotWant(t, z.Value(), 2)
+	test.GotWant(t, z.Depth(), 1)
+
+	z, _ = z.DownAt(1)
+	test.GotWant(t, z.Value(), 5)
+	test.GotWant(t, z.Depth(), 2)
+	z, _ = z.Left()
+	test.GotWant(t, z.Value(), 4)
+
+	z, _ = z.Up()
+	z, _ = z.Right()
+	test.GotWant(t, z.Value(), 3)
+	test.GotWant(t, z.IsRoot(), false)
+
+	z = z.Root()
+	test.GotWant(t, z.Value(), 1)
+	test.GotWant(t, z.IsRoot(), true)
+	test.GotWant(t, formatPersistentTree(z.Focus()), "1(2(4, 5), 3)")
+}
+
+// Verifies moves past the edges return the receiver and false
+func TestZipper_Navigation_Edges(t *testing.T) {
+	root := NewZipper(zipperTestTree())
+	for _, move := range []func(Zipper[int]) (Zipper[int], bool){
+		Zipper[int].Up, Zipper[int].Left, Zipper[int].Right,
+	} {
+		z, ok := move(root)
+		test.GotWant(t, ok, false)
+		test.GotWant(t, z, root)
+	}
+
+	_, ok := root.DownAt(2)
+	test.GotWant(t, ok, false)
+	_, ok = root.DownAt(-1)
+	test.GotWant(t, ok, false)
+
+	leaf, _ := root.DownAt(1)
+	_, ok = leaf.Down()
+	test.GotWant(t, ok, false)
+	_, ok = leaf.Right()
+	test.GotWant(t, ok, false)
+	first, _ := root.Down()
+	_, ok = first.Left()
+	test.GotWant(t, ok, false)
+}
+
+// Verifies edits are applied to the rebuilt tree and leave the original
+// tree and earlier zippers unchanged
+func TestZipper_Edit(t *testing.T) {
+	tree := zipperTestTree()
+	z, _ := NewZipper(tree).Down()
+	before := z
+
+	z = z.Set(20)
+	z, _ = z.InsertLeft(NewPersistentTree(10))
+	z, _ = z.InsertRight(NewPersistentTree(30))
+	z = z.InsertChild(NewPersistentTree(0))
+	z = z.AppendChild(NewPersistentTree(6))
+	test.GotWant(t, formatPersistentTree(z.Tree()), "1(10, 20(0, 4, 5, 6), 30, 3)")
+
+	z, _ = z.DownAt(2)
+	z = z.Replace(NewPersistentTree(7, NewPersistentTree(8)))
+	edited := z.Tree()
+	test.GotWant(t, formatPersistentTree(edited), "1(10, 20(0, 4, 7(8), 6), 30, 3)")
+	checkPersistentTree(t, edited)
+
+	test.GotWant(t, formatPersistentTree(tree), "1(2(4, 5), 3)")
+	test.GotWant(t, formatPersistentTree(before.Tree()), "1(2(4, 5), 3)")
+
+	// The untouched subtree 3 is shared with the original tree
+	last, _ := tree.Child(1)
+	shared, _ := edited.Child(3)
+	test.GotWant(t, shared, last)
+}
+
+// Verifies sibling inserts at the root are rejected
+func TestZipper_Edit_Root(t *testing.T) {
+	root := NewZipper(zipperTestTree())
+	_, ok := root.InsertLeft(NewPersistentTree(0))
+	test.GotWant(t, ok, false)
+	_, ok = root.InsertRight(NewPersistentTree(0))
+	test.GotWant(t, ok, false)
+
+	z := root.Set(9)
+	test.GotWant(t, formatPersistentTree(z.Tree()), "9(2(4, 5), 3)")
+}
+
+// Verifies Remove moves the focus right, then left, then up
+func TestZipper_Remove(t *testing.T) {
+	z, _ := NewZipper(zipperTestTree()).Down()
+	z, _ = z.Down()
+
+	z, ok := z.Remove()
+	test.GotWant(t, ok, true)
+	test.GotWant(t, z.Value(), 5)
+	test.GotWant(t, formatPersistentTree(z.Tree()), "1(2(5), 3)")
+
+	z, _ = z.InsertLeft(NewPersistentTree(4))
+	z, _ = z.Remove()
+	test.GotWant(t, z.Value(), 4)
+
+	z, _ = z.Remove()
+	test.GotWant(t, z.Value(), 2)
+	test.GotWant(t, z.Depth(), 1)
+	test.GotWant(t, formatPersistentTree(z.Tree()), "1(2, 3)")
+
+	_, ok = z.Root().Remove()
+	test.GotWant(t, ok, false)
+}
+
+// Represents a node of the mutable model tree used by the randomized test.
+type zipperModelNode struct {
+	value    int
+	children []*zipperModelNode
+}
+
+// Returns the model tree in the form value(child, child, ...).
+func (n *zipperModelNode) String() string {
+	return formatPersistentTree(n.persistent())
+}
+
+// Converts the model tree into a PersistentTree.
+func (n *zipperModelNode) persistent() *PersistentTree[int] {
+	children := []*PersistentTree[int]{}
+	for _, c := range n.children {
+		children = append(children, c.persistent())
+	}
+	return NewPersistentTree(n.value, children...)
+}
+
+// Verifies random moves and edits match a mutable model tree and leave
+// earlier versions unchanged
+func TestZipper_Randomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(50, 50))
+	model := &zipperModelNode{value: 0}
+	path := []int{} // Child indices from the root to the focus
+	z := NewZipper(NewPersistentTree(0))
+
+	type version struct {
+		tree *PersistentTree[int]
+		want string
+	}
+	versions := []version{}
+
+	// Returns the parent of the model focus, or nil at the root
+	parent := func() *zipperModelNode {
+		if len(path) == 0 {
+			return nil
+		}
+		n := model
+		for _, i := range path[:len(path)-1] {
+			n = n.children[i]
+		}
+		return n
+	}
+	focus := func() *zipperModelNode {
+		if p := parent(); p != nil {
+			return p.children[path[len(path)-1]]
+		}
+		return model
+	}
+
+	for i := 1; i <= 3000; i++ {
+		p, f := parent(), focus()
+		var ok bool
+		switch r.IntN(10) {
+		case 0:
+			z, ok = z.Up()
+			test.GotWant(t, ok, p != nil)
+			if ok {
+				path = path[:len(path)-1]
+			}
+		case 1:
+			j := r.IntN(len(f.children) + 1)
+			z, ok = z.DownAt(j)
+			test.GotWant(t, ok, j < len(f.children))
+			if ok {
+				path = append(path, j)
+			}
+		case 2:
+			z, ok = z.Left()
+			test.GotWant(t, ok, p != nil && path[len(path)-1] > 0)
+			if ok {
+				path[len(path)-1]--
+			}
+		case 3:
+			z, ok = z.Right()
+			test.GotWant(t, ok, p != nil && path[len(path)-1] < len(p.children)-1)
+			if ok {
+				path[len(path)-1]++
+			}
+		case 4:
+			z = z.Set(i)
+			f.value = i
+		case 5:
+			z = z.AppendChild(NewPersistentTree(i))
+			f.children = append(f.children, &zipperModelNode{value: i})
+		case 6:
+			z = z.InsertChild(NewPersistentTree(i))
+			f.children = append([]*zipperModelNode{{value: i}}, f.children...)
+		case 7:
+			z, ok = z.InsertLeft(NewPersistentTree(i))
+			test.GotWant(t, ok, p != nil)
+			if ok {
+				j := path[len(path)-1]
+				p.children = append(p.children[:j:j], append([]*zipperModelNode{{value: i}}, p.children[j:]...)...)
+				path[len(path)-1]++
+			}
+		case 8:
+			z, ok = z.InsertRight(NewPersistentTree(i))
+			test.GotWant(t, ok, p != nil)
+			if ok {
+				j := path[len(path)-1] + 1
+				p.children = append(p.children[:j:j], append([]*zipperModelNode{{value: i}}, p.children[j:]...)...)
+			}
+		case 9:
+			z, ok = z.Remove()
+			test.GotWant(t, ok, p != nil)
+			if ok {
+				j := path[len(path)-1]
+				p.children = append(p.children[:j:j], p.children[j+1:]...)
+				switch {
+				case j < len(p.children):
+				case j > 0:
+					path[len(path)-1]--
+				default:
+					path = path[:len(path)-1]
+				}
+			}
+		}
+
+		test.GotWant(t, z.Value(), focus().value)
+		test.GotWant(t, z.Depth(), len(path))
+		if i%100 == 0 {
+			tree := z.Tree()
+			checkPersistentTree(t, tree)
+			want := model.String()
+			test.GotWant(t, formatPersistentTree(tree), want)
+			versions = append(versions, version{tree, want})
+		}
+	}
+
+	for _, v := range versions {
+		test.GotWant(t, formatPersistentTree(v.tree), v.want)
+	}
+}
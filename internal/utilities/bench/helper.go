@@ -1,5 +1,298 @@
 package bench
 
+import (
+	"maps"
+	"runtime"
+	"slices"
+	"testing"
+	"unsafe"
+)
+
+// Size in bytes of the int elements the workload profiles insert.
+const elementSize = int(unsafe.Sizeof(int(0)))
+
 func ToKiloBytes(totalSize int, unitSize int) float64 {
 	return float64(totalSize) * float64(unitSize) / 1024
 }
+
+// MemoryTracker measures the heap memory kept alive by the structures
+// created after it, as the growth of the live heap over a baseline.
+// Readings force a garbage collection, so they are exact for large
+// structures but too slow to take inside a timed loop.
+type MemoryTracker struct {
+	baseline uint64
+}
+
+// TrackMemory returns a tracker whose baseline is the current live heap.
+//
+// Example:
+//
+//	m := bench.TrackMemory()
+//	q := NewSliceQueue[int]()
+//	// Grow q
+//	peak := m.Retained()
+//	// Shrink q
+//	freed := bench.FreedPercent(peak, m.Retained())
+//	runtime.KeepAlive(q)
+func TrackMemory() *MemoryTracker {
+	return &MemoryTracker{baseline: liveHeap()}
+}
+
+// Retained returns the number of bytes the live heap has grown by since
+// the baseline, or 0 if it shrank. The caller keeps the measured
+// structures reachable, with runtime.KeepAlive if they are not used after
+// the reading.
+func (m *MemoryTracker) Retained() int {
+	return int(max(liveHeap(), m.baseline) - m.baseline)
+}
+
+// FreedPercent returns the percentage of the peak memory that is no longer
+// retained, or 0 if the peak is not positive.
+func FreedPercent(peak int, retained int) float64 {
+	if peak <= 0 {
+		return 0
+	}
+	return 100 * float64(peak-retained) / float64(peak)
+}
+
+// AllocsPerOp returns the average number of heap allocations of one
+// operation, where every call of f performs ops operations. Like
+// testing.AllocsPerRun, which it runs f under, it must not be used in
+// parallel tests.
+func AllocsPerOp(runs int, ops int, f func()) float64 {
+	return testing.AllocsPerRun(runs, f) / float64(ops)
+}
+
+// ReportRetained reports bytes as the retained-KB metric shared by all
+// memory benchmarks.
+func ReportRetained(b *testing.B, bytes int) {
+	b.ReportMetric(ToKiloBytes(bytes, 1), "retained-KB")
+}
+
+// ReportAllocsPerOp reports the allocations of one operation as the
+// allocs/elem-op metric, for benchmarks whose iterations run many
+// operations.
+func ReportAllocsPerOp(b *testing.B, allocs float64) {
+	b.ReportMetric(allocs, "allocs/elem-op")
+}
+
+// Target adapts a structure to the workload profiles. Add inserts a value,
+// Remove takes one value out in the structure's own order, and Size reports
+// the number of values held. Capacity, if set, reports the number of
+// element slots the structure retains and enables the retained-KB metric.
+type Target struct {
+	Add      func(value int)
+	Remove   func()
+	Size     func() int
+	Capacity func() int
+}
+
+// Queue adapts any Queue implementation to a Target.
+func Queue(q interface {
+	Enqueue(value int)
+	Dequeue() (int, error)
+	Size() int
+}) Target {
+	return Target{
+		Add:    q.Enqueue,
+		Remove: func() { q.Dequeue() },
+		Size:   q.Size,
+	}
+}
+
+// Stack adapts any Stack implementation to a Target.
+func Stack(s interface {
+	Push(value int)
+	Pop() (int, error)
+	Size() int
+}) Target {
+	return Target{
+		Add:    s.Push,
+		Remove: func() { s.Pop() },
+		Size:   s.Size,
+	}
+}
+
+// List adapts any BasicList implementation to a Target that appends at the
+// end and removes from the start.
+func List(l interface {
+	AddLast(value int)
+	RemoveFirst() bool
+	Size() int
+}) Target {
+	return Target{
+		Add:    l.AddLast,
+		Remove: func() { l.RemoveFirst() },
+		Size:   l.Size,
+	}
+}
+
+// Profile is a named workload. Setup prepares a fresh target before the
+// timer starts, and Step is the operation pattern timed once per benchmark
+// iteration. A target that holds fewer than Refill values before a step
+// is set up anew with the timer stopped, so shrinking profiles keep
+// measuring removals instead of operations on an empty structure.
+type Profile struct {
+	Name   string
+	Setup  func(t Target)
+	Step   func(t Target)
+	Refill int
+}
+
+// Balanced keeps the size constant around 10,000 values.
+//
+// Pattern: [Add, Remove] × 500
+var Balanced = Profile{
+	Name:  "Balanced",
+	Setup: func(t Target) { add(t, 10_000) },
+	Step: func(t Target) {
+		for i := range 500 {
+			t.Add(i)
+			t.Remove()
+		}
+	},
+}
+
+// Bursty drains and refills in bursts after leaving 70% of the peak size
+// as waste.
+//
+// Pattern: Add 10,000 → Remove 7,000 → [Remove × 500, Add × 500]
+var Bursty = Profile{
+	Name: "Bursty",
+	Setup: func(t Target) {
+		add(t, 10_000)
+		remove(t, 7_000)
+	},
+	Step: func(t Target) {
+		remove(t, 500)
+		add(t, 500)
+	},
+}
+
+// MostlyGrowing adds two values for every one it removes.
+//
+// Pattern: [Remove, Add, Add] × 333
+var MostlyGrowing = Profile{
+	Name:  "MostlyGrowing",
+	Setup: func(t Target) {},
+	Step: func(t Target) {
+		for i := range 1000 {
+			if i%3 == 0 {
+				t.Remove()
+			} else {
+				t.Add(i)
+			}
+		}
+	},
+}
+
+// GrowOnly only adds.
+//
+// Pattern: [Add] × 1000
+var GrowOnly = Profile{
+	Name:  "GrowOnly",
+	Setup: func(t Target) {},
+	Step:  func(t Target) { add(t, 1000) },
+}
+
+// MostlyShrinking removes two values for every one it adds.
+//
+// Pattern: Add 1M → [Add, Remove, Remove] × 333
+var MostlyShrinking = Profile{
+	Name:  "MostlyShrinking",
+	Setup: func(t Target) { add(t, 1_000_000) },
+	Step: func(t Target) {
+		for i := range 1000 {
+			if i%3 == 0 {
+				t.Add(i)
+			} else {
+				t.Remove()
+			}
+		}
+	},
+	Refill: 1000,
+}
+
+// ShrinkOnly only removes.
+//
+// Pattern: Add 1M → [Remove] × 1000
+var ShrinkOnly = Profile{
+	Name:   "ShrinkOnly",
+	Setup:  func(t Target) { add(t, 1_000_000) },
+	Step:   func(t Target) { remove(t, 1000) },
+	Refill: 1000,
+}
+
+// Profiles returns every predefined workload profile.
+func Profiles() []Profile {
+	return []Profile{Balanced, Bursty, MostlyGrowing, GrowOnly, MostlyShrinking, ShrinkOnly}
+}
+
+// Run benchmarks every profile against every target, as sub-benchmarks
+// named profile/target so the targets of one profile are listed together.
+// Each target is created anew for every profile. Besides ns/op, Run
+// reports allocations and, for targets with a Capacity, the memory they
+// retain after the last step as retained-KB.
+//
+// Example:
+//
+//	bench.Run(b, map[string]func() bench.Target{
+//	    "SliceQueue": func() bench.Target { return bench.Queue(NewSliceQueue[int]()) },
+//	    "RingDeque":  func() bench.Target { return bench.Queue(NewRingDeque[int]()) },
+//	}, bench.Profiles()...)
+func Run(b *testing.B, targets map[string]func() Target, profiles ...Profile) {
+	names := slices.Sorted(maps.Keys(targets))
+	for _, p := range profiles {
+		b.Run(p.Name, func(b *testing.B) {
+			for _, name := range names {
+				b.Run(name, func(b *testing.B) {
+					runProfile(b, targets[name], p)
+				})
+			}
+		})
+	}
+}
+
+// Runs one profile against a fresh target from create.
+func runProfile(b *testing.B, create func() Target, p Profile) {
+	t := create()
+	p.Setup(t)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		if p.Refill > 0 && t.Size() < p.Refill {
+			b.StopTimer()
+			t = create()
+			p.Setup(t)
+			b.StartTimer()
+		}
+		p.Step(t)
+	}
+
+	if t.Capacity != nil {
+		ReportRetained(b, t.Capacity()*elementSize)
+	}
+}
+
+// Returns the bytes of live heap objects after a full garbage collection.
+func liveHeap() uint64 {
+	var stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
+
+// Adds n ascending values to the target.
+func add(t Target, n int) {
+	for i := range n {
+		t.Add(i)
+	}
+}
+
+// Removes n values from the target.
+func remove(t Target, n int) {
+	for range n {
+		t.Remove()
+	}
+}
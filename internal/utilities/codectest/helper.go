@@ -0,0 +1,101 @@
+package codectest
+
+import (
+	"bytes"
+	"encoding"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/apotourlyan/godatastructures/internal/codec"
+	"github.com/apotourlyan/godatastructures/internal/utilities/test"
+)
+
+// Codec is the binary encoding the structures implement with the codec
+// package.
+type Codec interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+// Stream is a Codec that also writes itself to and reads itself from
+// streams with WriteTo and ReadFrom.
+type Stream interface {
+	Codec
+	io.WriterTo
+	io.ReaderFrom
+}
+
+// RoundTrip checks the binary encoding of a structure. The encoding of src
+// must decode into dst, replacing what dst held, so that contents reports
+// the same for both. Afterwards UnmarshalBinary must reject the empty
+// input, the encoding cut short by a byte, the encoding followed by an
+// extra byte and each of the given invalid inputs with codec.ErrInvalidData,
+// leaving the contents of dst as they were.
+//
+// contents returns a view of a structure that reflect.DeepEqual compares,
+// such as its elements in iteration order, and must not share memory the
+// structure modifies later.
+//
+// Example:
+//
+//	other, _ := NewSliceQueue(1).MarshalBinary()
+//	codectest.RoundTrip(t, NewSliceStack(1, 2, 3), NewSliceStack(9),
+//	    func(s *SliceStack[int]) []int { return slices.Collect(s.All()) },
+//	    other,
+//	)
+func RoundTrip[S Codec, V any](t *testing.T, src S, dst S, contents func(S) V, invalid ...[]byte) {
+	t.Helper()
+	data, err := src.MarshalBinary()
+	test.GotWantNoError(t, err)
+	test.GotWantNoError(t, dst.UnmarshalBinary(data))
+	want := contents(src)
+	test.GotWantDeep(t, contents(dst), want)
+
+	inputs := [][]byte{nil, data[:len(data)-1], append(data[:len(data):len(data)], 0)}
+	for i, input := range append(inputs, invalid...) {
+		test.GotWantErrorIs(t, dst.UnmarshalBinary(input), codec.ErrInvalidData)
+		if got := contents(dst); !reflect.DeepEqual(got, want) {
+			t.Errorf("invalid input %d changed the contents to %#v, want %#v", i, got, want)
+		}
+	}
+}
+
+// StreamRoundTrip checks the streaming encoding of a structure. WriteTo
+// must write the bytes of MarshalBinary and report their count, and
+// ReadFrom must read them back into dst, reporting the same count, so that
+// contents reports the same for src and dst. Afterwards ReadFrom must
+// reject the stream cut short by a byte with codec.ErrInvalidData,
+// leaving the contents of dst as they were. See RoundTrip for contents.
+//
+// Structures that iterate in no fixed order, such as those backed by Go
+// maps, must be given a src whose encoding is unique, like one with a
+// single element.
+//
+// Example:
+//
+//	codectest.StreamRoundTrip(t, NewSliceStack(1, 2, 3), NewSliceStack(9),
+//	    func(s *SliceStack[int]) []int { return slices.Collect(s.All()) },
+//	)
+func StreamRoundTrip[S Stream, V any](t *testing.T, src S, dst S, contents func(S) V) {
+	t.Helper()
+	data, err := src.MarshalBinary()
+	test.GotWantNoError(t, err)
+	var buf bytes.Buffer
+	written, err := src.WriteTo(&buf)
+	test.GotWantNoError(t, err)
+	test.GotWant(t, written, int64(len(data)))
+	test.GotWant(t, bytes.Equal(buf.Bytes(), data), true)
+
+	read, err := dst.ReadFrom(&buf)
+	test.GotWantNoError(t, err)
+	test.GotWant(t, read, written)
+	want := contents(src)
+	test.GotWantDeep(t, contents(dst), want)
+
+	_, err = dst.ReadFrom(bytes.NewReader(data[:len(data)-1]))
+	test.GotWantErrorIs(t, err, codec.ErrInvalidData)
+	if got := contents(dst); !reflect.DeepEqual(got, want) {
+		t.Errorf("truncated stream changed the contents to %#v, want %#v", got, want)
+	}
+}
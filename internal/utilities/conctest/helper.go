@@ -0,0 +1,215 @@
+package conctest
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// Config controls the goroutines Hammer and CheckLinearizable start.
+type Config struct {
+	Seed       uint64 // Goroutine g of run i draws from rand.NewPCG(Seed, i<<16|g)
+	Runs       int    // Number of rounds, CheckLinearizable only
+	Goroutines int    // Concurrent workers, 0 means GOMAXPROCS
+	Ops        int    // Operations per worker
+}
+
+// Op is an operation Hammer applies to the shared structure. Apply draws
+// any arguments from r and reports wrong results through t, which is safe
+// for concurrent use.
+type Op[S any] struct {
+	Name   string
+	Weight int // Relative frequency, 0 counts as 1
+	Apply  func(t *testing.T, r *rand.Rand, sut S)
+}
+
+// Hammer runs c.Goroutines workers that each apply c.Ops operations drawn
+// from ops to the same structure at once, and returns when all are done.
+// Run under -race, it exposes unsynchronized memory accesses; the caller
+// checks the final state for lost or duplicated updates afterwards.
+//
+// Example:
+//
+//	s := NewTreiberStack[int]()
+//	conctest.Hammer(t, conctest.Config{Seed: 1, Goroutines: 8, Ops: 1000}, s,
+//	    conctest.Op[*TreiberStack[int]]{Name: "Push", Apply: push},
+//	    conctest.Op[*TreiberStack[int]]{Name: "Pop", Apply: pop},
+//	)
+//	checkConsistent(t, s)
+func Hammer[S any](t *testing.T, c Config, sut S, ops ...Op[S]) {
+	t.Helper()
+	total := 0
+	for _, op := range ops {
+		total += max(op.Weight, 1)
+	}
+
+	var wg sync.WaitGroup
+	for g := range goroutines(c) {
+		wg.Go(func() {
+			r := rand.New(rand.NewPCG(c.Seed, uint64(g)))
+			for range c.Ops {
+				pick(r, ops, total, func(op Op[S]) int { return op.Weight }).Apply(t, r, sut)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// LinOp is an operation of a linearizability check. Call draws any
+// arguments from r, performs the operation on the structure under test
+// and returns a description for failure reports together with a function
+// that replays the call on a state of the sequential model. The replay
+// returns the following state and whether the model produces the result
+// the structure returned. It must not modify the state it is given, which
+// other orders of the calls still explore.
+type LinOp[S any, M any] struct {
+	Name   string
+	Weight int // Relative frequency, 0 counts as 1
+	Call   func(r *rand.Rand, sut S) (desc string, replay func(model M) (M, bool))
+}
+
+// Represents one completed call of a round: its replay and the logical
+// times at which it started and returned.
+type call[M any] struct {
+	goroutine int
+	desc      string
+	replay    func(model M) (M, bool)
+	start     int64
+	end       int64
+}
+
+// CheckLinearizable spot-checks that a concurrent structure behaves as if
+// every call took effect atomically at some instant between its start and
+// its return. Each of c.Runs rounds shares a fresh structure from setup
+// between c.Goroutines workers that each make c.Ops calls drawn from ops.
+// The round passes if some order of all calls replays them on the model,
+// starting from the state setup returned, with the observed results, where
+// a call that returned before another started stays ahead of it.
+//
+// The search is exponential in the number of calls per round, so rounds
+// must be small, at most 64 calls and ideally no more than 16; many short
+// rounds cover more interleavings than a few long ones. Model states are
+// deduplicated by their fmt formatting, which must tell states apart.
+//
+// Example:
+//
+//	conctest.CheckLinearizable(t, conctest.Config{Seed: 1, Runs: 200, Goroutines: 4, Ops: 3},
+//	    func() (*TreiberStack[int], []int) { return NewTreiberStack[int](), nil },
+//	    conctest.LinOp[*TreiberStack[int], []int]{Name: "Push", Call: push},
+//	    conctest.LinOp[*TreiberStack[int], []int]{Name: "Pop", Call: pop},
+//	)
+func CheckLinearizable[S any, M any](t *testing.T, c Config, setup func() (S, M), ops ...LinOp[S, M]) {
+	t.Helper()
+	workers := goroutines(c)
+	if workers*c.Ops > 64 {
+		panic(fmt.Sprintf("%d calls per round exceed the limit of 64", workers*c.Ops))
+	}
+
+	total := 0
+	for _, op := range ops {
+		total += max(op.Weight, 1)
+	}
+
+	for run := range c.Runs {
+		sut, model := setup()
+		var clock atomic.Int64
+		calls := make([][]call[M], workers)
+
+		var wg sync.WaitGroup
+		for g := range workers {
+			wg.Go(func() {
+				r := rand.New(rand.NewPCG(c.Seed, uint64(run)<<16|uint64(g)))
+				for range c.Ops {
+					op := pick(r, ops, total, func(op LinOp[S, M]) int { return op.Weight })
+					start := clock.Add(1)
+					desc, replay := op.Call(r, sut)
+					calls[g] = append(calls[g], call[M]{g, desc, replay, start, clock.Add(1)})
+				}
+			})
+		}
+		wg.Wait()
+
+		history := []call[M]{}
+		for _, cs := range calls {
+			history = append(history, cs...)
+		}
+		if !linearize(history, 0, model, map[string]bool{}) {
+			t.Fatalf("seed %d, run %d: history is not linearizable:\n%s", c.Seed, run, describe(history))
+		}
+	}
+}
+
+// Returns true if the calls not in the done mask can be ordered so that
+// each replays successfully from the model state, with every call placed
+// only after all calls that returned before it started. Failed pairs of
+// mask and state are recorded in seen and not explored again.
+func linearize[M any](history []call[M], done uint64, model M, seen map[string]bool) bool {
+	if done == 1<<len(history)-1 {
+		return true
+	}
+
+	key := fmt.Sprintf("%x|%v", done, model)
+	if seen[key] {
+		return false
+	}
+
+	// A call may go next if it started before every pending call returned
+	var firstEnd int64 = 1<<63 - 1
+	for i, c := range history {
+		if done&(1<<i) == 0 {
+			firstEnd = min(firstEnd, c.end)
+		}
+	}
+
+	for i, c := range history {
+		if done&(1<<i) != 0 || c.start > firstEnd {
+			continue
+		}
+		if next, ok := c.replay(model); ok && linearize(history, done|1<<i, next, seen) {
+			return true
+		}
+	}
+
+	seen[key] = true
+	return false
+}
+
+// Returns the calls in start order, one per line with their goroutine and
+// logical start and return times.
+func describe[M any](history []call[M]) string {
+	lines := make([]string, len(history))
+	for i, c := range history {
+		lines[i] = fmt.Sprintf("%04d-%04d  g%d  %s", c.start, c.end, c.goroutine, c.desc)
+	}
+
+	slices.Sort(lines) // Fixed-width times sort numerically
+	return strings.Join(lines, "\n")
+}
+
+// Returns the configured number of workers, GOMAXPROCS if unset.
+func goroutines(c Config) int {
+	if c.Goroutines > 0 {
+		return c.Goroutines
+	}
+
+	return runtime.GOMAXPROCS(0)
+}
+
+// Returns a random operation, chosen with probability proportional to
+// its weight.
+func pick[O any](r *rand.Rand, ops []O, total int, weight func(O) int) O {
+	n := r.IntN(total)
+	for _, op := range ops {
+		n -= max(weight(op), 1)
+		if n < 0 {
+			return op
+		}
+	}
+
+	panic("unreachable")
+}
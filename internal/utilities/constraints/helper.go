@@ -1,5 +1,58 @@
 package constraints
 
+import "cmp"
+
+// Numeric permits the signed integer and floating-point types, the number
+// types that can hold negative values. Unsigned types are excluded so that
+// checks such as panics.RequireNonNegative and differences of coordinates
+// stay meaningful.
 type Numeric interface {
 	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
 }
+
+// Signed permits the signed integer types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned permits the unsigned integer types.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer permits the signed and unsigned integer types.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float permits the floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Complex permits the complex number types. Sums such as collections.Sum
+// and algorithms.WindowSum accept them next to Number, since addition is
+// all they need.
+type Complex interface {
+	~complex64 | ~complex128
+}
+
+// Number permits every integer and floating-point type, for arithmetic
+// that is valid on unsigned values as well, such as sums and counts.
+type Number interface {
+	Integer | Float
+}
+
+// Ordered permits the types that support the < operator. It is an alias
+// of cmp.Ordered, so constraints are spelled from this package while
+// values of an Ordered type parameter still pass to cmp.Compare and
+// cmp.Less, and types constrained by either interoperate.
+type Ordered = cmp.Ordered
+
+// Hashable permits the types that can be map keys and hashed by the
+// structures of this module. It is comparable under a name that states
+// the intent, and the constraint of hash-based keys where Ordered is that
+// of sorted keys.
+type Hashable interface {
+	comparable
+}
@@ -23,19 +23,25 @@ func RequireNonNegative[T constraints.Numeric](pval T, pname string) {
 	}
 }
 
-func RequireEqualTo[T constraints.Numeric](pval T, limit T, pname string) {
+func RequireEqualTo[T constraints.Number](pval T, limit T, pname string) {
 	if pval != limit {
 		panic(fmt.Sprintf("%q must be == %v, got %v", pname, limit, pval))
 	}
 }
 
-func RequireLessThan[T constraints.Numeric](pval T, limit T, pname string) {
+func RequireLessThan[T constraints.Number](pval T, limit T, pname string) {
 	if pval >= limit {
 		panic(fmt.Sprintf("%q must be < %v, got %v", pname, limit, pval))
 	}
 }
 
-func RequireLessThanOrEqualTo[T constraints.Numeric](pval T, limit T, pname string) {
+func RequireGreaterThan[T constraints.Number](pval T, limit T, pname string) {
+	if pval <= limit {
+		panic(fmt.Sprintf("%q must be > %v, got %v", pname, limit, pval))
+	}
+}
+
+func RequireLessThanOrEqualTo[T constraints.Number](pval T, limit T, pname string) {
 	if pval > limit {
 		panic(fmt.Sprintf("%q must be <= %v, got %v", pname, limit, pval))
 	}
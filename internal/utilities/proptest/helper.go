@@ -0,0 +1,161 @@
+package proptest
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// Number of most recent operations reported when a run fails.
+const historyLength = 20
+
+// Op is an operation applied to both the structure under test and its
+// reference model. Apply draws any arguments from r, performs the
+// operation on both, and reports mismatching results through t.
+type Op[S any, M any] struct {
+	Name   string
+	Weight int // Relative frequency, 0 counts as 1
+	Apply  func(t *testing.T, r *rand.Rand, sut S, model *M)
+}
+
+// Config controls how many operation sequences Run generates.
+type Config struct {
+	Seed  uint64 // Run i draws from rand.NewPCG(Seed, i)
+	Runs  int    // Number of sequences, each on fresh values from setup
+	Steps int    // Number of operations per sequence
+}
+
+// Run generates random sequences of the given operations against a
+// structure and its model, and calls check after every step to assert
+// that they are still equivalent. The first failure stops the test and
+// reports the seed, the run and the operations leading up to it, so the
+// sequence can be replayed.
+//
+// Example:
+//
+//	proptest.Run(t, proptest.Config{Seed: 1, Runs: 50, Steps: 500},
+//	    func() (*SliceStack[int], []int) { return NewSliceStack[int](), []int{} },
+//	    func(t *testing.T, s *SliceStack[int], model []int) {
+//	        test.GotWantSlice(t, slices.Collect(s.BottomUp()), model)
+//	    },
+//	    proptest.Op[*SliceStack[int], []int]{Name: "Push", Apply: push},
+//	    proptest.Op[*SliceStack[int], []int]{Name: "Pop", Apply: pop},
+//	)
+func Run[S any, M any](
+	t *testing.T,
+	c Config,
+	setup func() (S, M),
+	check func(t *testing.T, sut S, model M),
+	ops ...Op[S, M],
+) {
+	t.Helper()
+	for run := range c.Runs {
+		r := rand.New(rand.NewPCG(c.Seed, uint64(run)))
+		sut, model := setup()
+		if recent := runSequence(t, r, sut, model, check, ops, c.Steps, nil); recent != nil {
+			t.Fatalf("seed %d, run %d failed after: %s", c.Seed, run, strings.Join(recent, ", "))
+		}
+	}
+}
+
+// Replay runs one sequence of the given operations decoded from data, as
+// produced by a fuzzer, and calls check after every step like Run. Every
+// random draw of the sequence, including the choice of each operation,
+// consumes one byte, so the fuzzer steers the sequence by mutating data.
+// The sequence ends once data is exhausted or after maxSteps operations.
+//
+// Example:
+//
+//	f.Fuzz(func(t *testing.T, data []byte) {
+//	    proptest.Replay(t, data, 1000, setup, check, ops...)
+//	})
+func Replay[S any, M any](
+	t *testing.T,
+	data []byte,
+	maxSteps int,
+	setup func() (S, M),
+	check func(t *testing.T, sut S, model M),
+	ops ...Op[S, M],
+) {
+	t.Helper()
+	src := &byteSource{data: data, fallback: rand.NewPCG(0, 0)}
+	sut, model := setup()
+	if recent := runSequence(t, rand.New(src), sut, model, check, ops, maxSteps, src.exhausted); recent != nil {
+		t.Fatalf("replay failed after: %s", strings.Join(recent, ", "))
+	}
+}
+
+// Source of random numbers that spreads each byte of data over a whole
+// number, so draws such as IntN(n) map byte b to about b·n/256. Once data
+// is exhausted the numbers come from a fixed fallback source, which keeps
+// draws that need more numbers, such as rejection sampling, terminating.
+type byteSource struct {
+	data     []byte
+	fallback rand.Source
+}
+
+func (s *byteSource) Uint64() uint64 {
+	if s.exhausted() {
+		return s.fallback.Uint64()
+	}
+
+	b := s.data[0]
+	s.data = s.data[1:]
+	return uint64(b) * 0x0101010101010101
+}
+
+// Returns true if all bytes of data were consumed.
+func (s *byteSource) exhausted() bool {
+	return len(s.data) == 0
+}
+
+// Applies up to steps operations drawn from r to the structure and its
+// model, checking them after each, and stops early once done, if not nil,
+// returns true. Returns the most recent operations if a check failed, nil
+// otherwise.
+func runSequence[S any, M any](
+	t *testing.T,
+	r *rand.Rand,
+	sut S,
+	model M,
+	check func(t *testing.T, sut S, model M),
+	ops []Op[S, M],
+	steps int,
+	done func() bool,
+) []string {
+	total := 0
+	for _, op := range ops {
+		total += max(op.Weight, 1)
+	}
+
+	history := []string{}
+	for range steps {
+		if done != nil && done() {
+			break
+		}
+
+		op := pick(r, ops, total)
+		history = append(history, op.Name)
+		op.Apply(t, r, sut, &model)
+		check(t, sut, model)
+		if t.Failed() {
+			return history[max(len(history)-historyLength, 0):]
+		}
+	}
+
+	return nil
+}
+
+// Returns a random operation, chosen with probability proportional to
+// its weight.
+func pick[S any, M any](r *rand.Rand, ops []Op[S, M], total int) Op[S, M] {
+	n := r.IntN(total)
+	for _, op := range ops {
+		n -= max(op.Weight, 1)
+		if n < 0 {
+			return op
+		}
+	}
+
+	panic("unreachable")
+}
@@ -1,7 +1,11 @@
 package test
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/apotourlyan/godatastructures/internal/utilities/panics"
@@ -13,6 +17,9 @@ const gotWantBool = "got %t, want %t\n"
 const gotWantString = "got %q, want %q\n"
 const gotWantGeneric = "got %#v, want %#v\n"
 
+// Maximum number of differences GotWantDeep lists in one failure.
+const maxDiffs = 10
+
 func GotWant[T comparable](t *testing.T, got T, want T) {
 	t.Helper()
 	if got != want {
@@ -36,6 +43,39 @@ func GotWantSlice[T comparable](t *testing.T, got []T, want []T) {
 	}
 }
 
+// GotWantDeep compares values of any type with reflect.DeepEqual, for
+// slices, maps and structs that GotWant cannot compare. A failure lists
+// the paths at which got and want differ, such as [2].Name or ["key"].
+func GotWantDeep[T any](t *testing.T, got T, want T) {
+	t.Helper()
+	if reflect.DeepEqual(got, want) {
+		return
+	}
+
+	var d differ
+	d.diff("", reflect.ValueOf(got), reflect.ValueOf(want))
+	if len(d.lines) == 0 {
+		// Differences DeepEqual sees but the walk does not, such as
+		// functions or NaN elements
+		t.Error(getErrorText(got, want))
+		return
+	}
+	if d.omitted > 0 {
+		d.lines = append(d.lines, fmt.Sprintf("... and %d more differences", d.omitted))
+	}
+	t.Errorf("got and want differ:\n%s", strings.Join(d.lines, "\n"))
+}
+
+// GotWantFunc compares values of any type with the given equality
+// function, for types with a notion of equality of their own such as
+// tolerances or order-insensitive collections.
+func GotWantFunc[T any](t *testing.T, got T, want T, equal func(a, b T) bool) {
+	t.Helper()
+	if !equal(got, want) {
+		t.Error(getErrorText(got, want))
+	}
+}
+
 func GotWantError(t *testing.T, err error, want string) {
 	t.Helper()
 	if want == "" {
@@ -49,6 +89,30 @@ func GotWantError(t *testing.T, err error, want string) {
 	}
 }
 
+// GotWantErrorIs checks that err matches target according to errors.Is,
+// so wrapped errors and sentinel errors compare by identity instead of by
+// their text.
+func GotWantErrorIs(t *testing.T, err error, target error) {
+	t.Helper()
+	if errors.Is(err, target) {
+		return
+	}
+
+	if err == nil {
+		t.Errorf("got error 'nil', want error matching %q", target)
+	} else {
+		t.Errorf("got error %q, want error matching %q", err, target)
+	}
+}
+
+// GotWantNoError checks that err is nil.
+func GotWantNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Errorf("got error %q, want no error", err)
+	}
+}
+
 func getErrorText[T any](got T, want T) string {
 	g := any(got)
 	w := any(want)
@@ -75,3 +139,147 @@ func GotWantPanic(t *testing.T, f func(), want string) {
 		t.Errorf("got panic %q, want panic %q", got, want)
 	}
 }
+
+// Collects the differences between two values found by a parallel walk.
+type differ struct {
+	lines   []string
+	omitted int
+	visited map[[2]uintptr]bool // Pointer pairs already compared, for cycles
+}
+
+// Records the difference at path, up to maxDiffs of them.
+func (d *differ) add(path string, format string, args ...any) {
+	if len(d.lines) == maxDiffs {
+		d.omitted++
+		return
+	}
+	if path == "" {
+		path = "value"
+	}
+	d.lines = append(d.lines, path+": "+fmt.Sprintf(format, args...))
+}
+
+// Walks got and want in parallel and records where they differ.
+func (d *differ) diff(path string, got reflect.Value, want reflect.Value) {
+	if !got.IsValid() || !want.IsValid() {
+		if got.IsValid() != want.IsValid() {
+			d.add(path, "got %s, want %s", format(got), format(want))
+		}
+		return
+	}
+	if got.Type() != want.Type() {
+		d.add(path, "got type %s, want type %s", got.Type(), want.Type())
+		return
+	}
+
+	switch got.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if got.IsNil() || want.IsNil() {
+			if got.IsNil() != want.IsNil() {
+				d.add(path, "got %s, want %s", format(got), format(want))
+			}
+			return
+		}
+		if got.Kind() == reflect.Pointer {
+			key := [2]uintptr{got.Pointer(), want.Pointer()}
+			if key[0] == key[1] || d.visited[key] {
+				return
+			}
+			if d.visited == nil {
+				d.visited = map[[2]uintptr]bool{}
+			}
+			d.visited[key] = true
+		}
+		d.diff(path, got.Elem(), want.Elem())
+	case reflect.Slice, reflect.Array:
+		if got.Kind() == reflect.Slice && got.IsNil() != want.IsNil() {
+			d.add(path, "got %s, want %s", format(got), format(want))
+			return
+		}
+		n := min(got.Len(), want.Len())
+		for i := range n {
+			d.diff(fmt.Sprintf("%s[%d]", path, i), got.Index(i), want.Index(i))
+		}
+		for i := n; i < got.Len(); i++ {
+			d.add(fmt.Sprintf("%s[%d]", path, i), "got %s, want nothing", format(got.Index(i)))
+		}
+		for i := n; i < want.Len(); i++ {
+			d.add(fmt.Sprintf("%s[%d]", path, i), "got nothing, want %s", format(want.Index(i)))
+		}
+	case reflect.Map:
+		if got.IsNil() != want.IsNil() {
+			d.add(path, "got %s, want %s", format(got), format(want))
+			return
+		}
+		for _, k := range sortedKeys(got) {
+			elemPath := fmt.Sprintf("%s[%s]", path, format(k))
+			if w := want.MapIndex(k); w.IsValid() {
+				d.diff(elemPath, got.MapIndex(k), w)
+			} else {
+				d.add(elemPath, "got %s, want nothing", format(got.MapIndex(k)))
+			}
+		}
+		for _, k := range sortedKeys(want) {
+			if !got.MapIndex(k).IsValid() {
+				d.add(fmt.Sprintf("%s[%s]", path, format(k)), "got nothing, want %s", format(want.MapIndex(k)))
+			}
+		}
+	case reflect.Struct:
+		for i := range got.NumField() {
+			d.diff(path+"."+got.Type().Field(i).Name, got.Field(i), want.Field(i))
+		}
+	case reflect.Func:
+		// Functions are only equal if both are nil, which reports no path
+		if !got.IsNil() || !want.IsNil() {
+			d.add(path, "functions are not comparable")
+		}
+	default:
+		if !equalScalars(got, want) {
+			d.add(path, "got %s, want %s", format(got), format(want))
+		}
+	}
+}
+
+// Reports whether two values of the same scalar kind are equal.
+func equalScalars(got reflect.Value, want reflect.Value) bool {
+	switch got.Kind() {
+	case reflect.Bool:
+		return got.Bool() == want.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return got.Int() == want.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return got.Uint() == want.Uint()
+	case reflect.Float32, reflect.Float64:
+		return got.Float() == want.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return got.Complex() == want.Complex()
+	case reflect.String:
+		return got.String() == want.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return got.Pointer() == want.Pointer()
+	default:
+		return true
+	}
+}
+
+// Returns the keys of a map ordered by their formatting, which makes the
+// reported differences deterministic.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	texts := make(map[reflect.Value]string, len(keys))
+	for _, k := range keys {
+		texts[k] = format(k)
+	}
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		return strings.Compare(texts[a], texts[b])
+	})
+	return keys
+}
+
+// Formats a value for a difference, including unexported fields.
+func format(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	return fmt.Sprintf("%#v", v)
+}